@@ -0,0 +1,1049 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/ktrysmt/go-bitbucket"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// the maximum page length accepted by the Bitbucket API for most endpoints.
+	maxPageLen = 100
+	// the type field Bitbucket expects in merge requests.
+	pullRequestMergeType = "pullrequest_merge_parameters"
+)
+
+// bitbucketClient is a wrapper around the Bitbucket Cloud 2.0 REST API, which implements higher-level
+// methods operating on the structs defined in types.go. Pagination is implemented for all List* methods,
+// all returned objects are validated, and HTTP errors are handled/wrapped using handleHTTPError.
+// This interface is also fakeable, in order to unit-test the client.
+type bitbucketClient interface {
+	// Client returns the underlying *bitbucket.Client
+	Client() *bitbucket.Client
+
+	// GetUser is a wrapper for "GET /user".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetUser(ctx context.Context) (*User, error)
+
+	// GetWorkspace is a wrapper for "GET /workspaces/{workspace}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetWorkspace(ctx context.Context, workspace string) (*Workspace, error)
+	// ListWorkspaces is a wrapper for "GET /user/permissions/workspaces".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListWorkspaces(ctx context.Context) ([]*Workspace, error)
+	// GetProject is a wrapper for "GET /workspaces/{workspace}/projects/{project_key}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetProject(ctx context.Context, workspace, key string) (*Project, error)
+	// ListProjects is a wrapper for "GET /workspaces/{workspace}/projects".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListProjects(ctx context.Context, workspace string) ([]*Project, error)
+
+	// GetRepo is a wrapper for "GET /repositories/{workspace}/{repo_slug}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetRepo(ctx context.Context, workspace, repo string) (*Repository, error)
+	// ListRepos is a wrapper for "GET /repositories/{workspace}".
+	// If projectKey is set, only repositories in that project are returned.
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListRepos(ctx context.Context, workspace, projectKey string) ([]*Repository, error)
+	// CreateRepo is a wrapper for "POST /repositories/{workspace}/{repo_slug}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateRepo(ctx context.Context, workspace, repo string, req *Repository) (*Repository, error)
+	// UpdateRepo is a wrapper for "PUT /repositories/{workspace}/{repo_slug}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	UpdateRepo(ctx context.Context, workspace, repo string, req *Repository) (*Repository, error)
+	// DeleteRepo is a wrapper for "DELETE /repositories/{workspace}/{repo_slug}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteRepo(ctx context.Context, workspace, repo string) error
+
+	// ListKeys is a wrapper for "GET /repositories/{workspace}/{repo_slug}/deploy-keys".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListKeys(ctx context.Context, workspace, repo string) ([]*DeployKey, error)
+	// CreateKey is a wrapper for "POST /repositories/{workspace}/{repo_slug}/deploy-keys".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateKey(ctx context.Context, workspace, repo string, req *DeployKey) (*DeployKey, error)
+	// DeleteKey is a wrapper for "DELETE /repositories/{workspace}/{repo_slug}/deploy-keys/{key_id}".
+	// This function handles HTTP error wrapping.
+	DeleteKey(ctx context.Context, workspace, repo string, id int) error
+
+	// GetGroupPermission is a wrapper for
+	// "GET /repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetGroupPermission(ctx context.Context, workspace, repo, group string) (*GroupPermission, error)
+	// ListGroupPermissions is a wrapper for "GET /repositories/{workspace}/{repo_slug}/permissions-config/groups".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListGroupPermissions(ctx context.Context, workspace, repo string) ([]*GroupPermission, error)
+	// SetGroupPermission is a wrapper for
+	// "PUT /repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	SetGroupPermission(ctx context.Context, workspace, repo, group, permission string) (*GroupPermission, error)
+	// DeleteGroupPermission is a wrapper for
+	// "DELETE /repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}".
+	// This function handles HTTP error wrapping.
+	DeleteGroupPermission(ctx context.Context, workspace, repo, group string) error
+	// ListUserPermissions is a wrapper for "GET /repositories/{workspace}/{repo_slug}/permissions-config/users".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListUserPermissions(ctx context.Context, workspace, repo string) ([]*UserPermission, error)
+	// SetUserPermission is a wrapper for
+	// "PUT /repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	SetUserPermission(ctx context.Context, workspace, repo, user, permission string) (*UserPermission, error)
+	// DeleteUserPermission is a wrapper for
+	// "DELETE /repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}".
+	// This function handles HTTP error wrapping.
+	DeleteUserPermission(ctx context.Context, workspace, repo, user string) error
+
+	// ListCommitsPage is a wrapper for "GET /repositories/{workspace}/{repo_slug}/commits/{revision}".
+	// This function handles HTTP error wrapping.
+	ListCommitsPage(ctx context.Context, workspace, repo, branch string, perPage, page int) ([]*Commit, error)
+	// GetCommit is a wrapper for "GET /repositories/{workspace}/{repo_slug}/commit/{commit}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetCommit(ctx context.Context, workspace, repo, rev string) (*Commit, error)
+	// CreateCommit is a wrapper for "POST /repositories/{workspace}/{repo_slug}/src".
+	// Files with a nil Content are deleted.
+	// This function handles HTTP error wrapping, and returns the created commit.
+	CreateCommit(ctx context.Context, workspace, repo, branch, message string, files []gitprovider.CommitFile) (*Commit, error)
+
+	// GetBranch is a wrapper for "GET /repositories/{workspace}/{repo_slug}/refs/branches/{name}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetBranch(ctx context.Context, workspace, repo, branch string) (*Branch, error)
+	// ListBranches is a wrapper for "GET /repositories/{workspace}/{repo_slug}/refs/branches".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListBranches(ctx context.Context, workspace, repo string) ([]*Branch, error)
+	// CreateBranch is a wrapper for "POST /repositories/{workspace}/{repo_slug}/refs/branches".
+	// This function handles HTTP error wrapping.
+	CreateBranch(ctx context.Context, workspace, repo, branch, sha string) (*Branch, error)
+	// DeleteBranch is a wrapper for "DELETE /repositories/{workspace}/{repo_slug}/refs/branches/{name}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteBranch(ctx context.Context, workspace, repo, branch string) error
+
+	// GetTag is a wrapper for "GET /repositories/{workspace}/{repo_slug}/refs/tags/{name}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetTag(ctx context.Context, workspace, repo, tag string) (*Tag, error)
+	// ListTags is a wrapper for "GET /repositories/{workspace}/{repo_slug}/refs/tags".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListTags(ctx context.Context, workspace, repo string) ([]*Tag, error)
+	// CreateTag is a wrapper for "POST /repositories/{workspace}/{repo_slug}/refs/tags".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateTag(ctx context.Context, workspace, repo string, req *Tag) (*Tag, error)
+	// DeleteTag is a wrapper for "DELETE /repositories/{workspace}/{repo_slug}/refs/tags/{name}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteTag(ctx context.Context, workspace, repo, tag string) error
+
+	// ListPullRequests is a wrapper for "GET /repositories/{workspace}/{repo_slug}/pullrequests".
+	// Only pull requests in one of the given states are returned, or only the open ones if none are given.
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListPullRequests(ctx context.Context, workspace, repo string, states ...string) ([]*PullRequest, error)
+	// GetPullRequest is a wrapper for "GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetPullRequest(ctx context.Context, workspace, repo string, id int) (*PullRequest, error)
+	// CreatePullRequest is a wrapper for "POST /repositories/{workspace}/{repo_slug}/pullrequests".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreatePullRequest(ctx context.Context, workspace, repo string, req *PullRequest) (*PullRequest, error)
+	// UpdatePullRequest is a wrapper for "PUT /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	UpdatePullRequest(ctx context.Context, workspace, repo string, id int, req *PullRequest) (*PullRequest, error)
+	// MergePullRequest is a wrapper for
+	// "POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/merge".
+	// This function handles HTTP error wrapping, and validates the server result.
+	MergePullRequest(ctx context.Context, workspace, repo string, id int, req *PullRequestMerge) (*PullRequest, error)
+	// DeclinePullRequest is a wrapper for
+	// "POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/decline".
+	// This function handles HTTP error wrapping, and validates the server result.
+	DeclinePullRequest(ctx context.Context, workspace, repo string, id int) (*PullRequest, error)
+	// ListPullRequestComments is a wrapper for
+	// "GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListPullRequestComments(ctx context.Context, workspace, repo string, id int) ([]*PullRequestComment, error)
+	// GetPullRequestComment is a wrapper for
+	// "GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetPullRequestComment(ctx context.Context, workspace, repo string, id, commentID int) (*PullRequestComment, error)
+	// CreatePullRequestComment is a wrapper for
+	// "POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreatePullRequestComment(ctx context.Context, workspace, repo string, id int, body string) (*PullRequestComment, error)
+	// UpdatePullRequestComment is a wrapper for
+	// "PUT /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	UpdatePullRequestComment(ctx context.Context, workspace, repo string, id, commentID int, body string) (*PullRequestComment, error)
+	// DeletePullRequestComment is a wrapper for
+	// "DELETE /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}".
+	// This function handles HTTP error wrapping.
+	DeletePullRequestComment(ctx context.Context, workspace, repo string, id, commentID int) error
+	// ReviewPullRequest is a wrapper for
+	// "POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/{approve,request-changes}",
+	// with action being "approve" or "request-changes".
+	// This function handles HTTP error wrapping.
+	ReviewPullRequest(ctx context.Context, workspace, repo string, id int, action string) (*Participant, error)
+	// ListPullRequestDiffStat is a wrapper for
+	// "GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diffstat".
+	// This function handles pagination, and HTTP error wrapping.
+	ListPullRequestDiffStat(ctx context.Context, workspace, repo string, id int) ([]*DiffStat, error)
+	// GetPullRequestDiff is a wrapper for "GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diff".
+	// This function handles HTTP error wrapping.
+	GetPullRequestDiff(ctx context.Context, workspace, repo string, id int) (string, error)
+
+	// GetSourceMeta is a wrapper for "GET /repositories/{workspace}/{repo_slug}/src/{commit}/{path}?format=meta".
+	// This function handles HTTP error wrapping.
+	GetSourceMeta(ctx context.Context, workspace, repo, rev, filePath string) (*TreeEntry, error)
+	// ListSource is a wrapper for "GET /repositories/{workspace}/{repo_slug}/src/{commit}/{path}/".
+	// This function handles pagination, and HTTP error wrapping.
+	ListSource(ctx context.Context, workspace, repo, rev, dirPath string) ([]*TreeEntry, error)
+	// GetFileContent is a wrapper for "GET /repositories/{workspace}/{repo_slug}/src/{commit}/{path}".
+	// This function handles HTTP error wrapping.
+	GetFileContent(ctx context.Context, workspace, repo, rev, filePath string) ([]byte, error)
+}
+
+// bitbucketClientImpl is a wrapper around *bitbucket.Client, which implements higher-level methods.
+// go-bitbucket neither supports contexts nor returns typed objects for most endpoints, hence the
+// requests are sent using the *http.Client and base URL of the underlying *bitbucket.Client.
+// See the bitbucketClient interface for method documentation.
+type bitbucketClientImpl struct {
+	c                  *bitbucket.Client
+	username           string
+	token              string
+	destructiveActions bool
+}
+
+// bitbucketClientImpl implements bitbucketClient.
+var _ bitbucketClient = &bitbucketClientImpl{}
+
+func (c *bitbucketClientImpl) Client() *bitbucket.Client {
+	return c.c
+}
+
+func (c *bitbucketClientImpl) GetUser(ctx context.Context) (*User, error) {
+	apiObj := &User{}
+	// GET /user
+	if err := c.doJSON(ctx, http.MethodGet, c.apiURL(nil, "user"), nil, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateUserAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) GetWorkspace(ctx context.Context, workspace string) (*Workspace, error) {
+	apiObj := &Workspace{}
+	// GET /workspaces/{workspace}
+	if err := c.doJSON(ctx, http.MethodGet, c.apiURL(nil, "workspaces", workspace), nil, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateWorkspaceAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) ListWorkspaces(ctx context.Context) ([]*Workspace, error) {
+	apiObjs := []*Workspace{}
+	// GET /user/permissions/workspaces
+	err := c.allPages(ctx, c.apiURL(pageLenQuery(), "user", "permissions", "workspaces"), func(values json.RawMessage) error {
+		pageObjs := []*WorkspacePermission{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		for _, pageObj := range pageObjs {
+			if pageObj.Workspace == nil {
+				return fmt.Errorf("workspace permission without workspace: %w", gitprovider.ErrInvalidServerData)
+			}
+			apiObjs = append(apiObjs, pageObj.Workspace)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if err := validateWorkspaceAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) GetProject(ctx context.Context, workspace, key string) (*Project, error) {
+	apiObj := &Project{}
+	// GET /workspaces/{workspace}/projects/{project_key}
+	if err := c.doJSON(ctx, http.MethodGet, c.apiURL(nil, "workspaces", workspace, "projects", key), nil, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateProjectAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) ListProjects(ctx context.Context, workspace string) ([]*Project, error) {
+	apiObjs := []*Project{}
+	// GET /workspaces/{workspace}/projects
+	err := c.allPages(ctx, c.apiURL(pageLenQuery(), "workspaces", workspace, "projects"), func(values json.RawMessage) error {
+		pageObjs := []*Project{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if err := validateProjectAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) GetRepo(ctx context.Context, workspace, repo string) (*Repository, error) {
+	apiObj := &Repository{}
+	// GET /repositories/{workspace}/{repo_slug}
+	if err := c.doJSON(ctx, http.MethodGet, c.apiURL(nil, "repositories", workspace, repo), nil, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateRepositoryAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) ListRepos(ctx context.Context, workspace, projectKey string) ([]*Repository, error) {
+	query := pageLenQuery()
+	if projectKey != "" {
+		query.Set("q", fmt.Sprintf("project.key=%q", projectKey))
+	}
+	apiObjs := []*Repository{}
+	// GET /repositories/{workspace}
+	err := c.allPages(ctx, c.apiURL(query, "repositories", workspace), func(values json.RawMessage) error {
+		pageObjs := []*Repository{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if err := validateRepositoryAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) CreateRepo(ctx context.Context, workspace, repo string, req *Repository) (*Repository, error) {
+	apiObj := &Repository{}
+	// POST /repositories/{workspace}/{repo_slug}
+	if err := c.doJSON(ctx, http.MethodPost, c.apiURL(nil, "repositories", workspace, repo), req, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateRepositoryAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) UpdateRepo(ctx context.Context, workspace, repo string, req *Repository) (*Repository, error) {
+	apiObj := &Repository{}
+	// PUT /repositories/{workspace}/{repo_slug}
+	if err := c.doJSON(ctx, http.MethodPut, c.apiURL(nil, "repositories", workspace, repo), req, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateRepositoryAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) DeleteRepo(ctx context.Context, workspace, repo string) error {
+	// Don't allow deleting repositories if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete repository: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repositories/{workspace}/{repo_slug}
+	return c.doJSON(ctx, http.MethodDelete, c.apiURL(nil, "repositories", workspace, repo), nil, nil)
+}
+
+func (c *bitbucketClientImpl) ListKeys(ctx context.Context, workspace, repo string) ([]*DeployKey, error) {
+	apiObjs := []*DeployKey{}
+	// GET /repositories/{workspace}/{repo_slug}/deploy-keys
+	err := c.allPages(ctx, c.apiURL(pageLenQuery(), "repositories", workspace, repo, "deploy-keys"), func(values json.RawMessage) error {
+		pageObjs := []*DeployKey{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if err := validateDeployKeyAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) CreateKey(ctx context.Context, workspace, repo string, req *DeployKey) (*DeployKey, error) {
+	apiObj := &DeployKey{}
+	// POST /repositories/{workspace}/{repo_slug}/deploy-keys
+	if err := c.doJSON(ctx, http.MethodPost, c.apiURL(nil, "repositories", workspace, repo, "deploy-keys"), req, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateDeployKeyAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) DeleteKey(ctx context.Context, workspace, repo string, id int) error {
+	// DELETE /repositories/{workspace}/{repo_slug}/deploy-keys/{key_id}
+	return c.doJSON(ctx, http.MethodDelete, c.apiURL(nil, "repositories", workspace, repo, "deploy-keys", strconv.Itoa(id)), nil, nil)
+}
+
+func (c *bitbucketClientImpl) GetGroupPermission(ctx context.Context, workspace, repo, group string) (*GroupPermission, error) {
+	apiObj := &GroupPermission{}
+	// GET /repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	u := c.apiURL(nil, "repositories", workspace, repo, "permissions-config", "groups", group)
+	if err := c.doJSON(ctx, http.MethodGet, u, nil, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateGroupPermissionAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) ListGroupPermissions(ctx context.Context, workspace, repo string) ([]*GroupPermission, error) {
+	apiObjs := []*GroupPermission{}
+	// GET /repositories/{workspace}/{repo_slug}/permissions-config/groups
+	u := c.apiURL(pageLenQuery(), "repositories", workspace, repo, "permissions-config", "groups")
+	err := c.allPages(ctx, u, func(values json.RawMessage) error {
+		pageObjs := []*GroupPermission{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if err := validateGroupPermissionAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) SetGroupPermission(ctx context.Context, workspace, repo, group, permission string) (*GroupPermission, error) {
+	apiObj := &GroupPermission{}
+	// PUT /repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	u := c.apiURL(nil, "repositories", workspace, repo, "permissions-config", "groups", group)
+	if err := c.doJSON(ctx, http.MethodPut, u, &GroupPermission{Permission: permission}, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateGroupPermissionAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) DeleteGroupPermission(ctx context.Context, workspace, repo, group string) error {
+	// DELETE /repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	u := c.apiURL(nil, "repositories", workspace, repo, "permissions-config", "groups", group)
+	return c.doJSON(ctx, http.MethodDelete, u, nil, nil)
+}
+
+func (c *bitbucketClientImpl) ListUserPermissions(ctx context.Context, workspace, repo string) ([]*UserPermission, error) {
+	apiObjs := []*UserPermission{}
+	// GET /repositories/{workspace}/{repo_slug}/permissions-config/users
+	u := c.apiURL(pageLenQuery(), "repositories", workspace, repo, "permissions-config", "users")
+	err := c.allPages(ctx, u, func(values json.RawMessage) error {
+		pageObjs := []*UserPermission{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if err := validateUserPermissionAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) SetUserPermission(ctx context.Context, workspace, repo, user, permission string) (*UserPermission, error) {
+	apiObj := &UserPermission{}
+	// PUT /repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+	u := c.apiURL(nil, "repositories", workspace, repo, "permissions-config", "users", user)
+	if err := c.doJSON(ctx, http.MethodPut, u, &UserPermission{Permission: permission}, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateUserPermissionAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) DeleteUserPermission(ctx context.Context, workspace, repo, user string) error {
+	// DELETE /repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+	u := c.apiURL(nil, "repositories", workspace, repo, "permissions-config", "users", user)
+	return c.doJSON(ctx, http.MethodDelete, u, nil, nil)
+}
+
+func (c *bitbucketClientImpl) ListCommitsPage(ctx context.Context, workspace, repo, branch string, perPage, page int) ([]*Commit, error) {
+	query := url.Values{}
+	if perPage > 0 {
+		query.Set("pagelen", strconv.Itoa(perPage))
+	}
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	resp := &paginated{}
+	// GET /repositories/{workspace}/{repo_slug}/commits/{revision}
+	if err := c.doJSON(ctx, http.MethodGet, c.apiURL(query, "repositories", workspace, repo, "commits", branch), nil, resp); err != nil {
+		return nil, err
+	}
+	apiObjs := []*Commit{}
+	if len(resp.Values) == 0 {
+		return apiObjs, nil
+	}
+	if err := json.Unmarshal(resp.Values, &apiObjs); err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) GetCommit(ctx context.Context, workspace, repo, rev string) (*Commit, error) {
+	apiObj := &Commit{}
+	// GET /repositories/{workspace}/{repo_slug}/commit/{commit}
+	if err := c.doJSON(ctx, http.MethodGet, c.apiURL(nil, "repositories", workspace, repo, "commit", rev), nil, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateCommitAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) CreateCommit(ctx context.Context, workspace, repo, branch, message string, files []gitprovider.CommitFile) (*Commit, error) {
+	// The src endpoint takes a form where each field named after a path holds the new file content,
+	// and "files" fields list the paths to delete.
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	fields := [][2]string{{"message", message}, {"branch", branch}}
+	for _, file := range files {
+		if file.Path == nil {
+			return nil, fmt.Errorf("file path is required: %w", gitprovider.ErrInvalidArgument)
+		}
+		if file.Content == nil {
+			fields = append(fields, [2]string{"files", *file.Path})
+			continue
+		}
+		fields = append(fields, [2]string{*file.Path, *file.Content})
+	}
+	for _, field := range fields {
+		if err := w.WriteField(field[0], field[1]); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	// POST /repositories/{workspace}/{repo_slug}/src
+	req, err := c.newRequest(ctx, http.MethodPost, c.apiURL(nil, "repositories", workspace, repo, "src"), &body, w.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	res.Body.Close()
+
+	// The Location header points to the new commit, e.g. ".../repositories/{workspace}/{repo_slug}/commit/{commit}"
+	location := res.Header.Get("Location")
+	if location == "" {
+		return nil, fmt.Errorf("commit created without a Location: %w", gitprovider.ErrMissingHeader)
+	}
+	return c.GetCommit(ctx, workspace, repo, path.Base(location))
+}
+
+func (c *bitbucketClientImpl) GetBranch(ctx context.Context, workspace, repo, branch string) (*Branch, error) {
+	apiObj := &Branch{}
+	// GET /repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	if err := c.doJSON(ctx, http.MethodGet, c.apiURL(nil, "repositories", workspace, repo, "refs", "branches", branch), nil, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateBranchAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) ListBranches(ctx context.Context, workspace, repo string) ([]*Branch, error) {
+	apiObjs := []*Branch{}
+	// GET /repositories/{workspace}/{repo_slug}/refs/branches
+	err := c.allPages(ctx, c.apiURL(pageLenQuery(), "repositories", workspace, repo, "refs", "branches"), func(values json.RawMessage) error {
+		pageObjs := []*Branch{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if err := validateBranchAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) CreateBranch(ctx context.Context, workspace, repo, branch, sha string) (*Branch, error) {
+	apiObj := &Branch{}
+	req := &Branch{Name: branch, Target: &Commit{Hash: sha}}
+	// POST /repositories/{workspace}/{repo_slug}/refs/branches
+	if err := c.doJSON(ctx, http.MethodPost, c.apiURL(nil, "repositories", workspace, repo, "refs", "branches"), req, apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) DeleteBranch(ctx context.Context, workspace, repo, branch string) error {
+	// Don't allow deleting branches if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete branch: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	return c.doJSON(ctx, http.MethodDelete, c.apiURL(nil, "repositories", workspace, repo, "refs", "branches", branch), nil, nil)
+}
+
+func (c *bitbucketClientImpl) GetTag(ctx context.Context, workspace, repo, tag string) (*Tag, error) {
+	apiObj := &Tag{}
+	// GET /repositories/{workspace}/{repo_slug}/refs/tags/{name}
+	if err := c.doJSON(ctx, http.MethodGet, c.apiURL(nil, "repositories", workspace, repo, "refs", "tags", tag), nil, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateTagAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) ListTags(ctx context.Context, workspace, repo string) ([]*Tag, error) {
+	apiObjs := []*Tag{}
+	// GET /repositories/{workspace}/{repo_slug}/refs/tags
+	err := c.allPages(ctx, c.apiURL(pageLenQuery(), "repositories", workspace, repo, "refs", "tags"), func(values json.RawMessage) error {
+		pageObjs := []*Tag{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if err := validateTagAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) CreateTag(ctx context.Context, workspace, repo string, req *Tag) (*Tag, error) {
+	apiObj := &Tag{}
+	// POST /repositories/{workspace}/{repo_slug}/refs/tags
+	if err := c.doJSON(ctx, http.MethodPost, c.apiURL(nil, "repositories", workspace, repo, "refs", "tags"), req, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validateTagAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) DeleteTag(ctx context.Context, workspace, repo, tag string) error {
+	// Don't allow deleting tags if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete tag: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repositories/{workspace}/{repo_slug}/refs/tags/{name}
+	return c.doJSON(ctx, http.MethodDelete, c.apiURL(nil, "repositories", workspace, repo, "refs", "tags", tag), nil, nil)
+}
+
+func (c *bitbucketClientImpl) ListPullRequests(ctx context.Context, workspace, repo string, states ...string) ([]*PullRequest, error) {
+	apiObjs := []*PullRequest{}
+	query := pageLenQuery()
+	for _, state := range states {
+		query.Add("state", state)
+	}
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests
+	u := c.apiURL(query, "repositories", workspace, repo, "pullrequests")
+	err := c.allPages(ctx, u, func(values json.RawMessage) error {
+		pageObjs := []*PullRequest{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if err := validatePullRequestAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) GetPullRequest(ctx context.Context, workspace, repo string, id int) (*PullRequest, error) {
+	return c.pullRequestRequest(ctx, http.MethodGet, nil, "repositories", workspace, repo, "pullrequests", strconv.Itoa(id))
+}
+
+func (c *bitbucketClientImpl) CreatePullRequest(ctx context.Context, workspace, repo string, req *PullRequest) (*PullRequest, error) {
+	return c.pullRequestRequest(ctx, http.MethodPost, req, "repositories", workspace, repo, "pullrequests")
+}
+
+func (c *bitbucketClientImpl) UpdatePullRequest(ctx context.Context, workspace, repo string, id int, req *PullRequest) (*PullRequest, error) {
+	return c.pullRequestRequest(ctx, http.MethodPut, req, "repositories", workspace, repo, "pullrequests", strconv.Itoa(id))
+}
+
+func (c *bitbucketClientImpl) MergePullRequest(ctx context.Context, workspace, repo string, id int, req *PullRequestMerge) (*PullRequest, error) {
+	req.Type = pullRequestMergeType
+	return c.pullRequestRequest(ctx, http.MethodPost, req, "repositories", workspace, repo, "pullrequests", strconv.Itoa(id), "merge")
+}
+
+func (c *bitbucketClientImpl) DeclinePullRequest(ctx context.Context, workspace, repo string, id int) (*PullRequest, error) {
+	return c.pullRequestRequest(ctx, http.MethodPost, nil, "repositories", workspace, repo, "pullrequests", strconv.Itoa(id), "decline")
+}
+
+func (c *bitbucketClientImpl) ListPullRequestComments(ctx context.Context, workspace, repo string, id int) ([]*PullRequestComment, error) {
+	apiObjs := []*PullRequestComment{}
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+	u := c.apiURL(pageLenQuery(), "repositories", workspace, repo, "pullrequests", strconv.Itoa(id), "comments")
+	err := c.allPages(ctx, u, func(values json.RawMessage) error {
+		pageObjs := []*PullRequestComment{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if err := validatePullRequestCommentAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) GetPullRequestComment(ctx context.Context, workspace, repo string, id, commentID int) (*PullRequestComment, error) {
+	return c.pullRequestCommentRequest(ctx, http.MethodGet, nil, "repositories", workspace, repo, "pullrequests", strconv.Itoa(id), "comments", strconv.Itoa(commentID))
+}
+
+func (c *bitbucketClientImpl) CreatePullRequestComment(ctx context.Context, workspace, repo string, id int, body string) (*PullRequestComment, error) {
+	req := &PullRequestComment{Content: &CommentContent{Raw: body}}
+	return c.pullRequestCommentRequest(ctx, http.MethodPost, req, "repositories", workspace, repo, "pullrequests", strconv.Itoa(id), "comments")
+}
+
+func (c *bitbucketClientImpl) UpdatePullRequestComment(ctx context.Context, workspace, repo string, id, commentID int, body string) (*PullRequestComment, error) {
+	req := &PullRequestComment{Content: &CommentContent{Raw: body}}
+	return c.pullRequestCommentRequest(ctx, http.MethodPut, req, "repositories", workspace, repo, "pullrequests", strconv.Itoa(id), "comments", strconv.Itoa(commentID))
+}
+
+func (c *bitbucketClientImpl) DeletePullRequestComment(ctx context.Context, workspace, repo string, id, commentID int) error {
+	// DELETE /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+	return c.doJSON(ctx, http.MethodDelete, c.apiURL(nil, "repositories", workspace, repo, "pullrequests", strconv.Itoa(id), "comments", strconv.Itoa(commentID)), nil, nil)
+}
+
+func (c *bitbucketClientImpl) ReviewPullRequest(ctx context.Context, workspace, repo string, id int, action string) (*Participant, error) {
+	apiObj := &Participant{}
+	// POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/{action}
+	if err := c.doJSON(ctx, http.MethodPost, c.apiURL(nil, "repositories", workspace, repo, "pullrequests", strconv.Itoa(id), action), nil, apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) ListPullRequestDiffStat(ctx context.Context, workspace, repo string, id int) ([]*DiffStat, error) {
+	apiObjs := []*DiffStat{}
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diffstat
+	u := c.apiURL(pageLenQuery(), "repositories", workspace, repo, "pullrequests", strconv.Itoa(id), "diffstat")
+	err := c.allPages(ctx, u, func(values json.RawMessage) error {
+		pageObjs := []*DiffStat{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) GetPullRequestDiff(ctx context.Context, workspace, repo string, id int) (string, error) {
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diff
+	// Bitbucket redirects to the diff of the source and destination commits, which is followed.
+	req, err := c.newRequest(ctx, http.MethodGet, c.apiURL(nil, "repositories", workspace, repo, "pullrequests", strconv.Itoa(id), "diff"), nil, "")
+	if err != nil {
+		return "", err
+	}
+	res, err := c.send(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	diff, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(diff), nil
+}
+
+// pullRequestCommentRequest sends a request to an endpoint returning a single pull request comment.
+func (c *bitbucketClientImpl) pullRequestCommentRequest(ctx context.Context, method string, req interface{}, segments ...string) (*PullRequestComment, error) {
+	apiObj := &PullRequestComment{}
+	if err := c.doJSON(ctx, method, c.apiURL(nil, segments...), req, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validatePullRequestCommentAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// pullRequestRequest sends a request to an endpoint returning a single pull request.
+func (c *bitbucketClientImpl) pullRequestRequest(ctx context.Context, method string, req interface{}, segments ...string) (*PullRequest, error) {
+	apiObj := &PullRequest{}
+	if err := c.doJSON(ctx, method, c.apiURL(nil, segments...), req, apiObj); err != nil {
+		return nil, err
+	}
+	if err := validatePullRequestAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) GetSourceMeta(ctx context.Context, workspace, repo, rev, filePath string) (*TreeEntry, error) {
+	apiObj := &TreeEntry{}
+	query := url.Values{"format": []string{"meta"}}
+	// GET /repositories/{workspace}/{repo_slug}/src/{commit}/{path}?format=meta
+	if err := c.doJSON(ctx, http.MethodGet, c.srcURL(query, workspace, repo, rev, filePath, false), nil, apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *bitbucketClientImpl) ListSource(ctx context.Context, workspace, repo, rev, dirPath string) ([]*TreeEntry, error) {
+	apiObjs := []*TreeEntry{}
+	// GET /repositories/{workspace}/{repo_slug}/src/{commit}/{path}/
+	err := c.allPages(ctx, c.srcURL(pageLenQuery(), workspace, repo, rev, dirPath, true), func(values json.RawMessage) error {
+		pageObjs := []*TreeEntry{}
+		if err := json.Unmarshal(values, &pageObjs); err != nil {
+			return err
+		}
+		apiObjs = append(apiObjs, pageObjs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func (c *bitbucketClientImpl) GetFileContent(ctx context.Context, workspace, repo, rev, filePath string) ([]byte, error) {
+	// GET /repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+	req, err := c.newRequest(ctx, http.MethodGet, c.srcURL(nil, workspace, repo, rev, filePath, false), nil, "")
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	return io.ReadAll(res.Body)
+}
+
+// apiURL returns the URL of the API endpoint made up of the escaped path segments and the query.
+func (c *bitbucketClientImpl) apiURL(query url.Values, segments ...string) string {
+	escaped := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		escaped = append(escaped, url.PathEscape(segment))
+	}
+	u := strings.TrimSuffix(c.c.GetApiBaseURL(), "/") + "/" + strings.Join(escaped, "/")
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// srcURL returns the URL of the src endpoint for the given file or directory.
+// Bitbucket only lists a directory if its path ends with a slash.
+func (c *bitbucketClientImpl) srcURL(query url.Values, workspace, repo, rev, filePath string, dir bool) string {
+	segments := []string{"repositories", workspace, repo, "src", rev}
+	for _, part := range strings.Split(strings.Trim(filePath, "/"), "/") {
+		if part != "" {
+			segments = append(segments, part)
+		}
+	}
+	u := c.apiURL(nil, segments...)
+	if dir {
+		u += "/"
+	}
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// newRequest creates an authenticated request for the given URL.
+func (c *bitbucketClientImpl) newRequest(ctx context.Context, method, urlStr string, body io.Reader, contentType string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, urlStr, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", "application/json")
+	switch {
+	case c.username != "" && c.token != "":
+		req.SetBasicAuth(c.username, c.token)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	return req, nil
+}
+
+// send sends the request, and returns the response if it was successful.
+// Otherwise the response body is closed, and the error is returned through handleHTTPError.
+func (c *bitbucketClientImpl) send(req *http.Request) (*http.Response, error) {
+	res, err := c.c.HttpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
+		return res, nil
+	}
+	defer res.Body.Close()
+
+	message := res.Status
+	apiErr := &apiError{}
+	if body, readErr := io.ReadAll(res.Body); readErr == nil && json.Unmarshal(body, apiErr) == nil && apiErr.Error.Message != "" {
+		message = apiErr.Error.Message
+	}
+	return nil, handleHTTPError(res, fmt.Errorf("%s %s: %d %s", req.Method, req.URL.Path, res.StatusCode, message))
+}
+
+// doJSON sends the request with in encoded as the JSON body (if non-nil), and decodes the response into out (if non-nil).
+func (c *bitbucketClientImpl) doJSON(ctx context.Context, method, urlStr string, in, out interface{}) error {
+	var body io.Reader
+	contentType := ""
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+		contentType = "application/json"
+	}
+	req, err := c.newRequest(ctx, method, urlStr, body, contentType)
+	if err != nil {
+		return err
+	}
+	res, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if out == nil || res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// allPages fetches urlStr and all subsequent pages, handing the values of each page to fn.
+// There is no need to wrap the resulting error in handleHTTPError(err), as that's already done.
+func (c *bitbucketClientImpl) allPages(ctx context.Context, urlStr string, fn func(values json.RawMessage) error) error {
+	for urlStr != "" {
+		page := &paginated{}
+		if err := c.doJSON(ctx, http.MethodGet, urlStr, nil, page); err != nil {
+			return err
+		}
+		if len(page.Values) > 0 {
+			if err := fn(page.Values); err != nil {
+				return err
+			}
+		}
+		urlStr = page.Next
+	}
+	return nil
+}
+
+// pageLenQuery returns a query requesting the largest page size.
+func pageLenQuery() url.Values {
+	return url.Values{"pagelen": []string{strconv.Itoa(maxPageLen)}}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ktrysmt/go-bitbucket"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// DefaultDomain specifies the default domain used as the backend.
+	DefaultDomain = "bitbucket.org"
+	// DefaultAPIURL is the URL of the Bitbucket Cloud API for DefaultDomain.
+	DefaultAPIURL = "https://api.bitbucket.org/2.0"
+	// ProviderID is the provider ID for Bitbucket Cloud.
+	ProviderID = gitprovider.ProviderID("bitbucket")
+)
+
+// NewClient creates a new gitprovider.Client instance for Bitbucket Cloud API endpoints.
+//
+// If username is set, username and token (an app password or API token) are used for basic
+// authentication. Otherwise token is sent as a bearer token, e.g. a repository, project or
+// workspace access token. Passing no credentials allows public read access only.
+//
+// Using WithDomain the API of another host can be used, e.g. a proxy. If the domain includes a
+// scheme it is used as-is, otherwise "https://{domain}/2.0" is used as the API URL.
+func NewClient(username, token string, optFns ...gitprovider.ClientOption) (gitprovider.Client, error) {
+	// Complete the options struct
+	opts, err := gitprovider.MakeClientOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a *http.Client using the transport chain
+	httpClient, err := gitprovider.BuildClientFromTransportChain(opts.GetTransportChain())
+	if err != nil {
+		return nil, err
+	}
+
+	domain := DefaultDomain
+	if opts.Domain != nil {
+		domain = *opts.Domain
+	}
+	apiURL := DefaultAPIURL
+	if domain != DefaultDomain {
+		apiURL = domain
+		if !strings.Contains(domain, "://") {
+			apiURL = fmt.Sprintf("https://%s/2.0", domain)
+		}
+	}
+	baseURL, err := url.Parse(strings.TrimSuffix(apiURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing API URL %q: %w", apiURL, err)
+	}
+
+	var bb *bitbucket.Client
+	if username != "" {
+		bb = bitbucket.NewBasicAuth(username, token)
+	} else {
+		bb = bitbucket.NewOAuthbearerToken(token)
+	}
+	bb.HttpClient = httpClient
+	bb.SetApiBaseURL(*baseURL)
+
+	// By default, turn destructive actions off. But allow overrides.
+	destructiveActions := false
+	if opts.EnableDestructiveAPICalls != nil {
+		destructiveActions = *opts.EnableDestructiveAPICalls
+	}
+
+	c := newClient(bb, domain, username, token, destructiveActions)
+	if opts.DryRun != nil && *opts.DryRun {
+		c.dryRun = gitprovider.NewDryRun(destructiveActions, dryRunValidator{})
+	}
+	return c, nil
+}
+
+func newClient(c *bitbucket.Client, domain, username, token string, destructiveActions bool) *Client {
+	bbClient := &bitbucketClientImpl{c, username, token, destructiveActions}
+	ctx := &clientContext{bbClient, domain, destructiveActions}
+	return &Client{
+		clientContext: ctx,
+		orgs: &OrganizationsClient{
+			clientContext: ctx,
+		},
+		orgRepos: &OrgRepositoriesClient{
+			clientContext: ctx,
+		},
+		userRepos: &UserRepositoriesClient{
+			clientContext: ctx,
+		},
+	}
+}
+
+type clientContext struct {
+	c                  bitbucketClient
+	domain             string
+	destructiveActions bool
+}
+
+// Client implements the gitprovider.Client interface.
+var _ gitprovider.Client = &Client{}
+
+// Client is an interface that allows talking to a Git provider.
+type Client struct {
+	*clientContext
+
+	orgs      *OrganizationsClient
+	orgRepos  *OrgRepositoriesClient
+	userRepos *UserRepositoriesClient
+
+	// dryRun is set if the client was created using gitprovider.WithDryRun(true).
+	dryRun *gitprovider.DryRun
+}
+
+// SupportedDomain returns the domain endpoint for this client, e.g. "bitbucket.org".
+// This allows a higher-level user to know what Client to use for what endpoints.
+// This field is set at client creation time, and can't be changed.
+func (c *Client) SupportedDomain() string {
+	return c.domain
+}
+
+// ProviderID returns the provider ID "bitbucket".
+// This field is set at client creation time, and can't be changed.
+func (c *Client) ProviderID() gitprovider.ProviderID {
+	return ProviderID
+}
+
+// Raw returns the Go Bitbucket client (github.com/ktrysmt/go-bitbucket *Client)
+// used under the hood for accessing Bitbucket.
+func (c *Client) Raw() interface{} {
+	return c.c.Client()
+}
+
+// Organizations returns the OrganizationsClient handling sets of organizations.
+func (c *Client) Organizations() gitprovider.OrganizationsClient {
+	if c.dryRun != nil {
+		return c.dryRun.Organizations(c.orgs)
+	}
+	return c.orgs
+}
+
+// OrgRepositories returns the OrgRepositoriesClient handling sets of repositories in an organization.
+func (c *Client) OrgRepositories() gitprovider.OrgRepositoriesClient {
+	if c.dryRun != nil {
+		return c.dryRun.OrgRepositories(c.orgRepos)
+	}
+	return c.orgRepos
+}
+
+// UserRepositories returns the UserRepositoriesClient handling sets of repositories for a user.
+func (c *Client) UserRepositories() gitprovider.UserRepositoriesClient {
+	if c.dryRun != nil {
+		return c.dryRun.UserRepositories(c.userRepos)
+	}
+	return c.userRepos
+}
+
+// DryRunPlan returns the Plan recording the write API calls skipped by this client, if it was
+// created using gitprovider.WithDryRun(true). Otherwise, nil is returned.
+func (c *Client) DryRunPlan() *gitprovider.Plan {
+	return c.dryRun.Plan()
+}
+
+// HasTokenPermission returns true if the given token has the given permissions.
+func (c *Client) HasTokenPermission(ctx context.Context, permission gitprovider.TokenPermission) (bool, error) {
+	return false, gitprovider.ErrNoProviderSupport
+}
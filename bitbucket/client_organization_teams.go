@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TeamsClient implements the gitprovider.TeamsClient interface.
+var _ gitprovider.TeamsClient = &TeamsClient{}
+
+// TeamsClient handles teams organization-wide.
+//
+// Bitbucket Cloud groups can't be read through the 2.0 API, hence this is not supported.
+type TeamsClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// Get a team within the specific organization.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *TeamsClient) Get(_ context.Context, _ string) (gitprovider.Team, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// List all teams within the specific organization.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *TeamsClient) List(_ context.Context) ([]gitprovider.Team, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Create creates a team within the specific organization.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *TeamsClient) Create(_ context.Context, _ gitprovider.TeamInfo) (gitprovider.Team, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Reconcile makes sure the given desired state becomes the actual state of the team.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *TeamsClient) Reconcile(_ context.Context, _ gitprovider.TeamInfo) (gitprovider.Team, bool, error) {
+	return nil, false, gitprovider.ErrNoProviderSupport
+}
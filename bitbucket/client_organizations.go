@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// OrganizationsClient implements the gitprovider.OrganizationsClient interface.
+var _ gitprovider.OrganizationsClient = &OrganizationsClient{}
+
+// OrganizationsClient operates on the workspaces the user has access to.
+// A top-level organization is a workspace, and a sub-organization is a project in that workspace.
+type OrganizationsClient struct {
+	*clientContext
+}
+
+// Get a specific workspace the user has access to.
+// If ref has a sub-organization, the project with that key in the workspace is returned.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *OrganizationsClient) Get(ctx context.Context, ref gitprovider.OrganizationRef) (gitprovider.Organization, error) {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+
+	if len(ref.SubOrganizations) > 0 {
+		// GET /workspaces/{workspace}/projects/{project_key}
+		apiObj, err := c.c.GetProject(ctx, ref.Organization, ref.SubOrganizations[0])
+		if err != nil {
+			return nil, err
+		}
+		return newProjectOrganization(c.clientContext, apiObj, ref), nil
+	}
+
+	// GET /workspaces/{workspace}
+	apiObj, err := c.c.GetWorkspace(ctx, ref.Organization)
+	if err != nil {
+		return nil, err
+	}
+	return newOrganization(c.clientContext, apiObj, ref), nil
+}
+
+// List all workspaces the specific user has access to.
+//
+// List returns all available workspaces, using multiple paginated requests if needed.
+func (c *OrganizationsClient) List(ctx context.Context) ([]gitprovider.Organization, error) {
+	// GET /user/permissions/workspaces
+	apiObjs, err := c.c.ListWorkspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs := make([]gitprovider.Organization, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj.Slug is already validated to be non-empty in ListWorkspaces
+		orgs = append(orgs, newOrganization(c.clientContext, apiObj, gitprovider.OrganizationRef{
+			Domain:       c.domain,
+			Organization: apiObj.Slug,
+		}))
+	}
+
+	return orgs, nil
+}
+
+// Children returns the projects of the workspace ref points to.
+// Projects can't be nested, so the children of a project are always empty.
+//
+// Children returns all available projects, using multiple paginated requests if needed.
+func (c *OrganizationsClient) Children(ctx context.Context, ref gitprovider.OrganizationRef) ([]gitprovider.Organization, error) {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+	if len(ref.SubOrganizations) > 0 {
+		return []gitprovider.Organization{}, nil
+	}
+
+	// GET /workspaces/{workspace}/projects
+	apiObjs, err := c.c.ListProjects(ctx, ref.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs := make([]gitprovider.Organization, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj.Key is already validated to be non-empty in ListProjects
+		orgs = append(orgs, newProjectOrganization(c.clientContext, apiObj, gitprovider.OrganizationRef{
+			Domain:           c.domain,
+			Organization:     ref.Organization,
+			SubOrganizations: []string{apiObj.Key},
+		}))
+	}
+
+	return orgs, nil
+}
+
+// Create creates a workspace, or a project in a workspace.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *OrganizationsClient) Create(_ context.Context, _ gitprovider.OrganizationRef, _ gitprovider.OrganizationInfo) (gitprovider.Organization, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Reconcile makes sure the given desired state becomes the actual state of the workspace or project.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *OrganizationsClient) Reconcile(_ context.Context, _ gitprovider.OrganizationRef, _ gitprovider.OrganizationInfo) (gitprovider.Organization, bool, error) {
+	return nil, false, gitprovider.ErrNoProviderSupport
+}
+
+// Delete deletes a workspace, or a project in a workspace.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *OrganizationsClient) Delete(_ context.Context, _ gitprovider.OrganizationRef) error {
+	return gitprovider.ErrNoProviderSupport
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// OrgRepositoriesClient implements the gitprovider.OrgRepositoriesClient interface.
+var _ gitprovider.OrgRepositoriesClient = &OrgRepositoriesClient{}
+
+// OrgRepositoriesClient operates on repositories in the workspaces the user has access to.
+type OrgRepositoriesClient struct {
+	*clientContext
+}
+
+// Get returns the repository at the given path.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *OrgRepositoriesClient) Get(ctx context.Context, ref gitprovider.OrgRepositoryRef) (gitprovider.OrgRepository, error) {
+	// Make sure the OrgRepositoryRef is valid
+	if err := validateOrgRepositoryRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+	// GET /repositories/{workspace}/{repo_slug}
+	apiObj, err := c.c.GetRepo(ctx, ref.Organization, ref.RepositoryName)
+	if err != nil {
+		return nil, err
+	}
+	return newOrgRepository(c.clientContext, apiObj, ref), nil
+}
+
+// List all repositories in the given workspace.
+// If the OrganizationRef points to a project, only the repositories in that project are listed.
+//
+// List returns all available repositories, using multiple paginated requests if needed.
+func (c *OrgRepositoriesClient) List(ctx context.Context, ref gitprovider.OrganizationRef) ([]gitprovider.OrgRepository, error) {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+	projectKey := ""
+	if len(ref.SubOrganizations) > 0 {
+		projectKey = ref.SubOrganizations[0]
+	}
+
+	// GET /repositories/{workspace}
+	apiObjs, err := c.c.ListRepos(ctx, ref.Organization, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Traverse the list, and return a list of OrgRepository objects
+	repos := make([]gitprovider.OrgRepository, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at ListRepos
+		repos = append(repos, newOrgRepository(c.clientContext, apiObj, gitprovider.OrgRepositoryRef{
+			OrganizationRef: gitprovider.OrganizationRef{
+				Domain:       ref.Domain,
+				Organization: ref.Organization,
+			},
+			RepositoryName: apiObj.Slug,
+		}))
+	}
+	return repos, nil
+}
+
+// Create creates a repository in the given workspace, with the data and options.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrgRepositoriesClient) Create(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryCreateOption) (gitprovider.OrgRepository, error) {
+	// Make sure the RepositoryRef is valid
+	if err := validateOrgRepositoryRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+
+	apiObj, err := createRepository(ctx, c.c, ref, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newOrgRepository(c.clientContext, apiObj, ref), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, ref, req, opts...)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.OrgRepositoriesClient.ReconcileWithChanges.
+func (c *OrgRepositoriesClient) ReconcileWithChanges(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, []gitprovider.FieldChange, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+
+	actual, err := c.Get(ctx, ref)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
+			return resp, req.Diff(gitprovider.RepositoryInfo{}), err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, nil, err
+	}
+	// Run generic reconciliation
+	changes, err := reconcileRepository(ctx, actual, req)
+	return actual, changes, err
+}
+
+func createRepository(ctx context.Context, c bitbucketClient, ref gitprovider.RepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryCreateOption) (*Repository, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if err := validateRepositorySupport(req); err != nil {
+		return nil, err
+	}
+
+	// Assemble the options struct based on the given options
+	o, err := gitprovider.MakeRepositoryCreateOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if o.LicenseTemplate != nil {
+		return nil, fmt.Errorf("bitbucket doesn't support license templates: %w", gitprovider.ErrNoProviderSupport)
+	}
+
+	// POST /repositories/{workspace}/{repo_slug}
+	apiObj, err := c.CreateRepo(ctx, ref.GetIdentity(), ref.GetRepository(), repositoryToAPI(&req, ref))
+	if err != nil {
+		return nil, err
+	}
+
+	// Bitbucket can't initialize repositories itself, so push a README to the default branch instead.
+	if o.AutoInit != nil && *o.AutoInit {
+		readme := fmt.Sprintf("# %s\n", ref.GetRepository())
+		if _, err := c.CreateCommit(ctx, ref.GetIdentity(), ref.GetRepository(), *req.DefaultBranch, "Initial commit", []gitprovider.CommitFile{
+			{Path: gitprovider.StringVar("README.md"), Content: &readme},
+		}); err != nil {
+			return nil, fmt.Errorf("failed to initialize repository: %w", err)
+		}
+		return c.GetRepo(ctx, ref.GetIdentity(), ref.GetRepository())
+	}
+	return apiObj, nil
+}
+
+func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) ([]gitprovider.FieldChange, error) {
+	// If the desired matches the actual state, just return the actual state
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return nil, err
+	}
+	// Apply the desired state by running Update
+	return changes, actual.Update(ctx)
+}
+
+func toCreateOpts(opts ...gitprovider.RepositoryReconcileOption) []gitprovider.RepositoryCreateOption {
+	// Convert RepositoryReconcileOption => RepositoryCreateOption
+	createOpts := make([]gitprovider.RepositoryCreateOption, 0, len(opts))
+	for _, opt := range opts {
+		createOpts = append(createOpts, opt)
+	}
+	return createOpts
+}
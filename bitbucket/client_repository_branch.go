@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// BranchClient implements the gitprovider.BranchClient interface.
+var _ gitprovider.BranchClient = &BranchClient{}
+
+// BranchClient operates on the branches for a specific repository.
+type BranchClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the branch with the given name.
+//
+// ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Get(ctx context.Context, branch string) (gitprovider.Branch, error) {
+	// GET /repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	apiObj, err := c.c.GetBranch(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch)
+	if err != nil {
+		return nil, err
+	}
+	return newBranch(c, apiObj), nil
+}
+
+// List lists all branches of the repository.
+//
+// List returns all available branches, using multiple paginated requests if needed.
+func (c *BranchClient) List(ctx context.Context) ([]gitprovider.Branch, error) {
+	// GET /repositories/{workspace}/{repo_slug}/refs/branches
+	apiObjs, err := c.c.ListBranches(ctx, c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// Cast to the generic []gitprovider.Branch
+	branches := make([]gitprovider.Branch, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		branches = append(branches, newBranch(c, apiObj))
+	}
+	return branches, nil
+}
+
+// Create creates a branch pointing to the given commit.
+func (c *BranchClient) Create(ctx context.Context, branch, sha string) error {
+	// POST /repositories/{workspace}/{repo_slug}/refs/branches
+	_, err := c.c.CreateBranch(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch, sha)
+	return err
+}
+
+// Delete deletes the branch with the given name irreversibly.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Delete(ctx context.Context, branch string) error {
+	// DELETE /repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	return c.c.DeleteBranch(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch)
+}
+
+// RenameDefault makes a new branch with the given name, pointing to the head of the current
+// default branch, the default branch of the repository, and retargets the open pull requests into
+// the current default branch to it. Bitbucket can't rename branches, so the current default branch
+// is kept.
+//
+// ErrAlreadyExists is returned if a branch with the given name already exists.
+func (c *BranchClient) RenameDefault(ctx context.Context, branch string) error {
+	workspace, repoSlug := c.ref.GetIdentity(), c.ref.GetRepository()
+	if _, err := c.c.GetBranch(ctx, workspace, repoSlug, branch); err == nil {
+		return fmt.Errorf("branch %q: %w", branch, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return err
+	}
+
+	// GET /repositories/{workspace}/{repo_slug}
+	repo, err := c.c.GetRepo(ctx, workspace, repoSlug)
+	if err != nil {
+		return err
+	}
+	if repo.MainBranch == nil {
+		return fmt.Errorf("repository %s/%s has no default branch: %w", workspace, repoSlug, gitprovider.ErrNotFound)
+	}
+	// GET /repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	current, err := c.c.GetBranch(ctx, workspace, repoSlug, repo.MainBranch.Name)
+	if err != nil {
+		return err
+	}
+	// POST /repositories/{workspace}/{repo_slug}/refs/branches
+	if _, err := c.c.CreateBranch(ctx, workspace, repoSlug, branch, current.Target.Hash); err != nil {
+		return err
+	}
+	// PUT /repositories/{workspace}/{repo_slug}
+	if _, err := c.c.UpdateRepo(ctx, workspace, repoSlug, &Repository{MainBranch: &BranchRef{Name: branch}}); err != nil {
+		return err
+	}
+	return gitprovider.RetargetPullRequests(ctx, &PullRequestClient{clientContext: c.clientContext, ref: c.ref}, repo.MainBranch.Name, branch)
+}
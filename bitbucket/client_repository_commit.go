@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CommitClient implements the gitprovider.CommitClient interface.
+var _ gitprovider.CommitClient = &CommitClient{}
+
+// CommitClient operates on the commits for a specific repository.
+type CommitClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// ListPage lists repository commits of the given page and page size.
+func (c *CommitClient) ListPage(ctx context.Context, branch string, perPage, page int) ([]gitprovider.Commit, error) {
+	// GET /repositories/{workspace}/{repo_slug}/commits/{revision}
+	apiObjs, err := c.c.ListCommitsPage(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch, perPage, page)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cast to the generic []gitprovider.Commit
+	commits := make([]gitprovider.Commit, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		commits = append(commits, newCommit(c, apiObj))
+	}
+	return commits, nil
+}
+
+// Create creates a commit with the given specifications.
+// Files with a nil Content are deleted from the branch.
+func (c *CommitClient) Create(ctx context.Context, branch string, message string, files []gitprovider.CommitFile) (gitprovider.Commit, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files added")
+	}
+
+	// POST /repositories/{workspace}/{repo_slug}/src
+	apiObj, err := c.c.CreateCommit(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch, message, files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create commit: %w", err)
+	}
+	return newCommit(c, apiObj), nil
+}
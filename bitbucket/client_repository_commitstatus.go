@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CommitStatusClient implements the gitprovider.CommitStatusClient interface.
+var _ gitprovider.CommitStatusClient = &CommitStatusClient{}
+
+// CommitStatusClient operates on the commit statuses of a specific repository.
+//
+// Managing commit statuses is not yet supported for Bitbucket Cloud.
+type CommitStatusClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists all statuses of the commit with the given sha.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *CommitStatusClient) List(_ context.Context, _ string) ([]gitprovider.CommitStatus, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Create creates a status for the commit with the given sha.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *CommitStatusClient) Create(_ context.Context, _ string, _ gitprovider.CommitStatusInfo) (gitprovider.CommitStatus, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Combined returns the combined status of the commit with the given sha.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *CommitStatusClient) Combined(_ context.Context, _ string) (*gitprovider.CombinedCommitStatusInfo, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
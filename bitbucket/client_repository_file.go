@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// the type of files in source listings.
+	srcTypeFile = "commit_file"
+	// the type of directories in source listings.
+	srcTypeDirectory = "commit_directory"
+)
+
+// FileClient implements the gitprovider.FileClient interface.
+var _ gitprovider.FileClient = &FileClient{}
+
+// FileClient operates on the files for a specific repository.
+type FileClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get fetches and returns the contents of a file or multiple files in a directory from a given branch and path.
+// If a file path is given, the contents of the file are returned
+// If a directory path is given, the contents of the files in the path's root are returned,
+// or the contents of all files below the path with the Recursive option.
+func (c *FileClient) Get(ctx context.Context, path, branch string, optFns ...gitprovider.FilesGetOption) ([]*gitprovider.CommitFile, error) {
+	fileOpts := gitprovider.FilesGetOptions{}
+	for _, opt := range optFns {
+		opt.ApplyFilesGetOptions(&fileOpts)
+	}
+
+	// GET /repositories/{workspace}/{repo_slug}/src/{commit}/{path}?format=meta
+	meta, err := c.c.GetSourceMeta(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch, path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*TreeEntry{meta}
+	if meta.Type == srcTypeDirectory {
+		entries, err = listSource(ctx, c.c, c.ref, branch, path, fileOpts.Recursive)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	files := make([]*gitprovider.CommitFile, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Type != srcTypeFile {
+			continue
+		}
+		// GET /repositories/{workspace}/{repo_slug}/src/{commit}/{path}
+		content, err := c.c.GetFileContent(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch, entry.Path)
+		if err != nil {
+			return nil, err
+		}
+		filePath := entry.Path
+		contentStr := string(content)
+		files = append(files, &gitprovider.CommitFile{
+			Path:    &filePath,
+			Content: &contentStr,
+		})
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files found on this path[%s]", path)
+	}
+	return files, nil
+}
+
+// listSource lists the entries of the directory, descending into subdirectories if recursive is set.
+func listSource(ctx context.Context, c bitbucketClient, ref gitprovider.RepositoryRef, rev, dirPath string, recursive bool) ([]*TreeEntry, error) {
+	// GET /repositories/{workspace}/{repo_slug}/src/{commit}/{path}/
+	apiObjs, err := c.ListSource(ctx, ref.GetIdentity(), ref.GetRepository(), rev, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if !recursive {
+		return apiObjs, nil
+	}
+
+	entries := make([]*TreeEntry, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		entries = append(entries, apiObj)
+		if apiObj.Type != srcTypeDirectory {
+			continue
+		}
+		children, err := listSource(ctx, c, ref, rev, apiObj.Path, recursive)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, children...)
+	}
+	return entries, nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IssueClient implements the gitprovider.IssueClient interface.
+var _ gitprovider.IssueClient = &IssueClient{}
+
+// IssueClient operates on the issues of a specific repository.
+//
+// The issue tracker of Bitbucket Cloud isn't supported.
+type IssueClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the issue with the given number.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *IssueClient) Get(_ context.Context, _ int) (gitprovider.Issue, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// List lists the issues in the repository.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *IssueClient) List(_ context.Context, _ ...gitprovider.IssueListOption) ([]gitprovider.Issue, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Create opens an issue with the given specifications.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *IssueClient) Create(_ context.Context, _ gitprovider.IssueInfo) (gitprovider.Issue, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Edit changes an existing issue.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *IssueClient) Edit(_ context.Context, _ int, _ gitprovider.IssueEditOptions) (gitprovider.Issue, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Close closes the issue.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *IssueClient) Close(_ context.Context, _ int) (gitprovider.Issue, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// LabelClient implements the gitprovider.LabelClient interface.
+var _ gitprovider.LabelClient = &LabelClient{}
+
+// LabelClient operates on the labels of a specific repository.
+//
+// Bitbucket Cloud has no repository labels.
+type LabelClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the label with the given name.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *LabelClient) Get(_ context.Context, _ string) (gitprovider.Label, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// List lists all labels of the repository.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *LabelClient) List(_ context.Context) ([]gitprovider.Label, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Create creates a label with the given specifications.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *LabelClient) Create(_ context.Context, _ gitprovider.LabelInfo) (gitprovider.Label, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Reconcile makes sure the given desired state becomes the actual state.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *LabelClient) Reconcile(_ context.Context, _ gitprovider.LabelInfo) (gitprovider.Label, bool, error) {
+	return nil, false, gitprovider.ErrNoProviderSupport
+}
+
+// ReconcileAll makes sure the labels of the repository become exactly the given desired set.
+//
+// This is not supported in Bitbucket Cloud.
+func (c *LabelClient) ReconcileAll(_ context.Context, _ []gitprovider.LabelInfo, _ ...gitprovider.ReconcileAllOption) (*gitprovider.ReconcileReport, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestClient implements the gitprovider.PullRequestClient interface.
+var _ gitprovider.PullRequestClient = &PullRequestClient{}
+
+// PullRequestClient operates on the pull requests for a specific repository.
+type PullRequestClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists the pull requests in the repository, by default only the open ones.
+//
+// List returns all available pull requests, using multiple paginated requests if needed.
+func (c *PullRequestClient) List(ctx context.Context, optFns ...gitprovider.PullRequestListOption) ([]gitprovider.PullRequest, error) {
+	opts, err := gitprovider.MakePullRequestListOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+	var states []string
+	switch *opts.State {
+	case gitprovider.PullRequestStateOpen:
+		states = []string{pullRequestStateOpen}
+	case gitprovider.PullRequestStateClosed:
+		states = []string{pullRequestStateDeclined, pullRequestStateSuperseded}
+	case gitprovider.PullRequestStateMerged:
+		states = []string{pullRequestStateMerged}
+	default:
+		states = []string{pullRequestStateOpen, pullRequestStateMerged, pullRequestStateDeclined, pullRequestStateSuperseded}
+	}
+
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests
+	apiObjs, err := c.c.ListPullRequests(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), states...)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]gitprovider.PullRequest, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if opts.Matches(pullrequestFromAPI(apiObj)) {
+			requests = append(requests, newPullRequest(c, apiObj))
+		}
+	}
+	return requests, nil
+}
+
+// Create creates a pull request with the given specifications.
+func (c *PullRequestClient) Create(ctx context.Context, title, branch, baseBranch, description string) (gitprovider.PullRequest, error) {
+	req := &PullRequest{
+		Title:       title,
+		Description: description,
+		Source:      &PullRequestEndpoint{Branch: &BranchRef{Name: branch}},
+		Destination: &PullRequestEndpoint{Branch: &BranchRef{Name: baseBranch}},
+	}
+	// POST /repositories/{workspace}/{repo_slug}/pullrequests
+	apiObj, err := c.c.CreatePullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), req)
+	if err != nil {
+		return nil, err
+	}
+	return newPullRequest(c, apiObj), nil
+}
+
+// Get retrieves an existing pull request by number
+func (c *PullRequestClient) Get(ctx context.Context, number int) (gitprovider.PullRequest, error) {
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+	apiObj, err := c.c.GetPullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
+	if err != nil {
+		return nil, err
+	}
+	return newPullRequest(c, apiObj), nil
+}
+
+// Edit modifies an existing PR. Please refer to "EditOptions" for details on which data can be edited.
+// Bitbucket Cloud has no assignees nor labels, can't reopen declined pull requests, and identifies
+// reviewers by their account rather than their login, hence those options aren't supported.
+func (c *PullRequestClient) Edit(ctx context.Context, number int, opts gitprovider.EditOptions) (gitprovider.PullRequest, error) {
+	if err := opts.ValidateOptions(); err != nil {
+		return nil, err
+	}
+	if opts.Assignees != nil || opts.Labels != nil || opts.Reviewers != nil {
+		return nil, fmt.Errorf("pull request assignees, labels and reviewers: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if opts.State != nil && *opts.State == gitprovider.PullRequestStateOpen {
+		return nil, fmt.Errorf("reopening pull requests: %w", gitprovider.ErrNoProviderSupport)
+	}
+	// Bitbucket requires the title in every update, so start from the current state
+	req, err := c.c.GetPullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Title != nil {
+		req.Title = *opts.Title
+	}
+	if opts.Description != nil {
+		req.Description = *opts.Description
+	}
+	update := &PullRequest{
+		Title:       req.Title,
+		Description: req.Description,
+		Draft:       opts.Draft,
+	}
+	if opts.TargetBranch != nil {
+		update.Destination = &PullRequestEndpoint{Branch: &BranchRef{Name: *opts.TargetBranch}}
+	}
+	// PUT /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+	apiObj, err := c.c.UpdatePullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number, update)
+	if err != nil {
+		return nil, err
+	}
+	if opts.State != nil && apiObj.State == pullRequestStateOpen {
+		// POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/decline
+		apiObj, err = c.c.DeclinePullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newPullRequest(c, apiObj), nil
+}
+
+// Merge merges a pull request with the given specifications. All merge methods and options are supported.
+// Bitbucket can't guard the head of the pull request, hence it's compared to the expected head right
+// before merging.
+func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod, message string, optFns ...gitprovider.PullRequestMergeOption) (*gitprovider.PullRequestMergeResult, error) {
+	opts, err := gitprovider.MakePullRequestMergeOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := mergeStrategy(mergeMethod)
+	if err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
+	if opts.ExpectedHeadSha != nil {
+		// GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+		pr, err := c.c.GetPullRequest(ctx, owner, repo, number)
+		if err != nil {
+			return nil, err
+		}
+		// Bitbucket returns abbreviated hashes
+		head := pullrequestFromAPI(pr).HeadSha
+		if head == "" || !strings.HasPrefix(*opts.ExpectedHeadSha, head) {
+			return nil, gitprovider.NewHeadChangedError(number, *opts.ExpectedHeadSha, head)
+		}
+	}
+
+	req := &PullRequestMerge{
+		Message:       message,
+		MergeStrategy: strategy,
+	}
+	if opts.DeleteSourceBranch != nil {
+		req.CloseSourceBranch = *opts.DeleteSourceBranch
+	}
+	// POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/merge
+	apiObj, err := c.c.MergePullRequest(ctx, owner, repo, number, req)
+	if err != nil {
+		return nil, err
+	}
+	if apiObj.MergeCommit == nil {
+		return nil, fmt.Errorf("merged pull request %d has no merge commit: %w", number, gitprovider.ErrInvalidServerData)
+	}
+	// GET /repositories/{workspace}/{repo_slug}/commit/{commit}
+	commit, err := c.c.GetCommit(ctx, owner, repo, apiObj.MergeCommit.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return &gitprovider.PullRequestMergeResult{Sha: commit.Hash}, nil
+}
+
+// mergeStrategy returns the Bitbucket merge strategy of the given merge method.
+func mergeStrategy(mergeMethod gitprovider.MergeMethod) (string, error) {
+	switch mergeMethod {
+	case gitprovider.MergeMethodMerge:
+		return "merge_commit", nil
+	case gitprovider.MergeMethodSquash:
+		return "squash", nil
+	case gitprovider.MergeMethodRebase:
+		return "rebase_fast_forward", nil
+	}
+	return "", fmt.Errorf("unknown merge method %q: %w", mergeMethod, gitprovider.ErrInvalidArgument)
+}
+
+// EnableAutoMerge returns ErrNoProviderSupport, as Bitbucket Cloud can't merge pull requests automatically.
+func (c *PullRequestClient) EnableAutoMerge(_ context.Context, _ int, _ gitprovider.MergeMethod) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+// DisableAutoMerge returns ErrNoProviderSupport, as Bitbucket Cloud can't merge pull requests automatically.
+func (c *PullRequestClient) DisableAutoMerge(_ context.Context, _ int) error {
+	return gitprovider.ErrNoProviderSupport
+}
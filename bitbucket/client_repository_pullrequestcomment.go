@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestCommentClient implements the gitprovider.PullRequestCommentClient interface.
+var _ gitprovider.PullRequestCommentClient = &PullRequestCommentClient{}
+
+// PullRequestCommentClient operates on the general comments of a specific pull request.
+type PullRequestCommentClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int
+}
+
+// Get returns the comment with the given ID.
+//
+// ErrNotFound is returned if the comment does not exist, or isn't a general comment.
+func (c *PullRequestCommentClient) Get(ctx context.Context, id int64) (gitprovider.PullRequestComment, error) {
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+	apiObj, err := c.c.GetPullRequestComment(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), c.number, int(id))
+	if err != nil {
+		return nil, err
+	}
+	if !isConversationComment(apiObj) {
+		return nil, fmt.Errorf("comment %d of pull request %d: %w", id, c.number, gitprovider.ErrNotFound)
+	}
+	return newPullRequestComment(c, apiObj), nil
+}
+
+// List lists the general comments of the pull request, oldest first.
+// Comments on the diff, replies and deleted comments aren't included.
+//
+// List returns all available comments, using multiple paginated requests if needed.
+func (c *PullRequestCommentClient) List(ctx context.Context) ([]gitprovider.PullRequestComment, error) {
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+	apiObjs, err := c.c.ListPullRequestComments(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), c.number)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]gitprovider.PullRequestComment, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if isConversationComment(apiObj) {
+			comments = append(comments, newPullRequestComment(c, apiObj))
+		}
+	}
+	return comments, nil
+}
+
+// Create creates a general comment with the given body.
+func (c *PullRequestCommentClient) Create(ctx context.Context, req gitprovider.PullRequestCommentInfo) (gitprovider.PullRequestComment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+	apiObj, err := c.c.CreatePullRequestComment(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), c.number, req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return newPullRequestComment(c, apiObj), nil
+}
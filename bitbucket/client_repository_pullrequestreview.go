@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestReviewClient implements the gitprovider.PullRequestReviewClient interface.
+var _ gitprovider.PullRequestReviewClient = &PullRequestReviewClient{}
+
+// PullRequestReviewClient operates on the reviews of a specific pull request.
+// Bitbucket only keeps the review state of every participant, hence approving and requesting
+// changes sets the state of the authenticated user, and commenting adds a comment.
+type PullRequestReviewClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int
+}
+
+// List lists one review for every participant that approved the pull request or requested changes.
+func (c *PullRequestReviewClient) List(ctx context.Context) ([]gitprovider.PullRequestReview, error) {
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}
+	apiObj, err := c.c.GetPullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), c.number)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := []gitprovider.PullRequestReview{}
+	for _, participant := range apiObj.Participants {
+		if _, ok := reviewStates[participant.State]; ok && participant.User != nil {
+			reviews = append(reviews, newParticipantReview(participant))
+		}
+	}
+	return reviews, nil
+}
+
+// Create sets the review state of the authenticated user, or adds a comment for a commented review.
+// The body of an approval or changes request is added as a comment.
+func (c *PullRequestReviewClient) Create(ctx context.Context, req gitprovider.PullRequestReviewInfo) (gitprovider.PullRequestReview, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+	if req.State == gitprovider.PullRequestReviewStateCommented {
+		// POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+		comment, err := c.c.CreatePullRequestComment(ctx, owner, repo, c.number, req.Body)
+		if err != nil {
+			return nil, err
+		}
+		return newCommentReview(comment), nil
+	}
+
+	action := reviewActionApprove
+	if req.State == gitprovider.PullRequestReviewStateChangesRequested {
+		action = reviewActionRequestChanges
+	}
+	// POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/{action}
+	participant, err := c.c.ReviewPullRequest(ctx, owner, repo, c.number, action)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != "" {
+		// POST /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments
+		if _, err := c.c.CreatePullRequestComment(ctx, owner, repo, c.number, req.Body); err != nil {
+			return nil, err
+		}
+	}
+	review := newParticipantReview(participant)
+	review.info.Body = req.Body
+	return review, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TagClient implements the gitprovider.TagClient interface.
+var _ gitprovider.TagClient = &TagClient{}
+
+// TagClient operates on the tags for a specific repository.
+type TagClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the tag with the given name.
+//
+// ErrNotFound is returned if the tag does not exist.
+func (c *TagClient) Get(ctx context.Context, name string) (gitprovider.Tag, error) {
+	// GET /repositories/{workspace}/{repo_slug}/refs/tags/{name}
+	apiObj, err := c.c.GetTag(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), name)
+	if err != nil {
+		return nil, err
+	}
+	return newTag(c, apiObj), nil
+}
+
+// List lists all tags of the repository.
+//
+// List returns all available tags, using multiple paginated requests if needed.
+func (c *TagClient) List(ctx context.Context) ([]gitprovider.Tag, error) {
+	// GET /repositories/{workspace}/{repo_slug}/refs/tags
+	apiObjs, err := c.c.ListTags(ctx, c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// Cast to the generic []gitprovider.Tag
+	tags := make([]gitprovider.Tag, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		tags = append(tags, newTag(c, apiObj))
+	}
+	return tags, nil
+}
+
+// Create creates a tag with the given specifications. An annotated tag is created if
+// req.Message is set, otherwise a lightweight tag.
+//
+// ErrAlreadyExists will be returned if the tag already exists.
+func (c *TagClient) Create(ctx context.Context, req gitprovider.TagInfo) (gitprovider.Tag, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	workspace, repoSlug := c.ref.GetIdentity(), c.ref.GetRepository()
+	if _, err := c.c.GetTag(ctx, workspace, repoSlug, req.Name); err == nil {
+		return nil, fmt.Errorf("tag %q: %w", req.Name, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	tag := &Tag{Name: req.Name, Target: &Commit{Hash: req.Sha}}
+	if req.Message != nil {
+		tag.Message = *req.Message
+	}
+	// POST /repositories/{workspace}/{repo_slug}/refs/tags
+	apiObj, err := c.c.CreateTag(ctx, workspace, repoSlug, tag)
+	if err != nil {
+		return nil, err
+	}
+	return newTag(c, apiObj), nil
+}
+
+// Delete deletes the tag with the given name irreversibly.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn\'t created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the tag does not exist.
+func (c *TagClient) Delete(ctx context.Context, name string) error {
+	// DELETE /repositories/{workspace}/{repo_slug}/refs/tags/{name}
+	return c.c.DeleteTag(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), name)
+}
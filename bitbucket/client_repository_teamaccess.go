@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TeamAccessClient implements the gitprovider.TeamAccessClient interface.
+var _ gitprovider.TeamAccessClient = &TeamAccessClient{}
+
+// TeamAccessClient operates on the group permissions of a specific repository.
+// In Bitbucket Cloud, teams are the groups of the workspace, referred to by their slug.
+type TeamAccessClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get the permission level of the group with the given slug on this repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *TeamAccessClient) Get(ctx context.Context, name string) (gitprovider.TeamAccess, error) {
+	// GET /repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	apiObj, err := c.c.GetGroupPermission(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), name)
+	if err != nil {
+		return nil, err
+	}
+	info := teamAccessFromAPI(apiObj)
+	info.Name = name
+	return newTeamAccess(c, info), nil
+}
+
+// List lists the group permissions of this repository.
+//
+// List returns all available team access lists, using multiple paginated requests if needed.
+func (c *TeamAccessClient) List(ctx context.Context) ([]gitprovider.TeamAccess, error) {
+	// GET /repositories/{workspace}/{repo_slug}/permissions-config/groups
+	apiObjs, err := c.c.ListGroupPermissions(ctx, c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	teamAccess := make([]gitprovider.TeamAccess, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at ListGroupPermissions
+		teamAccess = append(teamAccess, newTeamAccess(c, teamAccessFromAPI(apiObj)))
+	}
+	return teamAccess, nil
+}
+
+// Create grants the given group access to the repository.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamAccessClient) Create(ctx context.Context, req gitprovider.TeamAccessInfo) (gitprovider.TeamAccess, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	// Bitbucket overwrites existing permissions with PUT, so check for them explicitly
+	if _, err := c.Get(ctx, req.Name); err == nil {
+		return nil, gitprovider.ErrAlreadyExists
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+	return c.set(ctx, req)
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamAccessClient) Reconcile(ctx context.Context,
+	req gitprovider.TeamAccessInfo,
+) (gitprovider.TeamAccess, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, req)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.TeamAccessClient.ReconcileWithChanges.
+func (c *TeamAccessClient) ReconcileWithChanges(ctx context.Context,
+	req gitprovider.TeamAccessInfo,
+) (gitprovider.TeamAccess, []gitprovider.FieldChange, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, req.Diff(gitprovider.TeamAccessInfo{}), err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, nil, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return actual, nil, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, nil, err
+	}
+	return actual, changes, actual.Update(ctx)
+}
+
+// ReconcileAll implements gitprovider.TeamAccessClient.ReconcileAll.
+func (c *TeamAccessClient) ReconcileAll(ctx context.Context,
+	req []gitprovider.TeamAccessInfo, opts ...gitprovider.ReconcileAllOption,
+) (*gitprovider.ReconcileReport, error) {
+	return gitprovider.ReconcileAllTeamAccess(ctx, c, c.destructiveActions, req, opts...)
+}
+
+// set grants the group the requested permission, overwriting any existing permission.
+func (c *TeamAccessClient) set(ctx context.Context, req gitprovider.TeamAccessInfo) (*teamAccess, error) {
+	permission, err := permissionToAPI(*req.Permission)
+	if err != nil {
+		return nil, err
+	}
+	// PUT /repositories/{workspace}/{repo_slug}/permissions-config/groups/{group_slug}
+	apiObj, err := c.c.SetGroupPermission(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), req.Name, permission)
+	if err != nil {
+		return nil, err
+	}
+	info := teamAccessFromAPI(apiObj)
+	// The group might not be part of the response
+	info.Name = req.Name
+	return newTeamAccess(c, info), nil
+}
+
+// permissionToAPI maps the permission to one of the repository permissions of Bitbucket,
+// which are "read", "write" and "admin".
+func permissionToAPI(permission gitprovider.RepositoryPermission) (string, error) {
+	switch permission {
+	case gitprovider.RepositoryPermissionPull:
+		return "read", nil
+	case gitprovider.RepositoryPermissionPush:
+		return "write", nil
+	case gitprovider.RepositoryPermissionAdmin:
+		return "admin", nil
+	}
+	return "", fmt.Errorf("permission %q: %w", permission, gitprovider.ErrNoProviderSupport)
+}
+
+// permissionFromAPI maps a Bitbucket repository permission to the closest RepositoryPermission.
+func permissionFromAPI(permission string) *gitprovider.RepositoryPermission {
+	switch permission {
+	case "admin":
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin)
+	case "write":
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush)
+	default:
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPull)
+	}
+}
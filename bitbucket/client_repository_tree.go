@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TreeClient implements the gitprovider.TreeClient interface.
+var _ gitprovider.TreeClient = &TreeClient{}
+
+// TreeClient operates on the trees in a specific repository.
+//
+// Bitbucket has no API for Git trees, so trees are assembled from the source listing of a commit.
+// The entries don't carry the SHA of the blobs and subtrees.
+type TreeClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the tree of the given commit, sha may also be a branch name.
+func (c *TreeClient) Get(ctx context.Context, sha string, recursive bool) (*gitprovider.TreeInfo, error) {
+	entries, err := c.list(ctx, sha, "", recursive)
+	if err != nil {
+		return nil, err
+	}
+	return &gitprovider.TreeInfo{
+		SHA:  sha,
+		Tree: entries,
+	}, nil
+}
+
+// List files (blob) in a tree, sha is represented by the branch name
+func (c *TreeClient) List(ctx context.Context, sha string, path string, recursive bool) ([]*gitprovider.TreeEntry, error) {
+	entries, err := c.list(ctx, sha, path, recursive)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*gitprovider.TreeEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Type == "blob" {
+			blobs = append(blobs, entry)
+		}
+	}
+	return blobs, nil
+}
+
+func (c *TreeClient) list(ctx context.Context, sha, path string, recursive bool) ([]*gitprovider.TreeEntry, error) {
+	apiObjs, err := listSource(ctx, c.c, c.ref, sha, path, recursive)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*gitprovider.TreeEntry, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		entries = append(entries, treeEntryFromAPI(apiObj))
+	}
+	return entries, nil
+}
+
+func treeEntryFromAPI(apiObj *TreeEntry) *gitprovider.TreeEntry {
+	entry := &gitprovider.TreeEntry{
+		Path: apiObj.Path,
+		Type: "blob",
+		Mode: "100644",
+		Size: apiObj.Size,
+	}
+	if apiObj.Links != nil && apiObj.Links.Self != nil {
+		entry.URL = apiObj.Links.Self.Href
+	}
+	if apiObj.Type == srcTypeDirectory {
+		entry.Type = "tree"
+		entry.Mode = "040000"
+		entry.Size = 0
+		return entry
+	}
+	for _, attribute := range apiObj.Attributes {
+		switch attribute {
+		case "executable":
+			entry.Mode = "100755"
+		case "link":
+			entry.Mode = "120000"
+		case "subrepository":
+			entry.Type = "commit"
+			entry.Mode = "160000"
+		}
+	}
+	return entry
+}
@@ -0,0 +1,1061 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// setup starts a stand-in for the Bitbucket 2.0 API, and returns a client talking to it.
+func setup(t *testing.T, optFns ...gitprovider.ClientOption) (*http.ServeMux, gitprovider.Client, string) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	domain := server.URL + "/2.0"
+	optFns = append([]gitprovider.ClientOption{gitprovider.WithDomain(domain)}, optFns...)
+	c, err := NewClient("user", "app-password", optFns...)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return mux, c, domain
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Errorf("failed to encode response: %v", err)
+	}
+}
+
+func writeError(t *testing.T, w http.ResponseWriter, status int, message string) {
+	body := apiError{Type: "error"}
+	body.Error.Message = message
+	writeJSON(t, w, status, body)
+}
+
+func page(t *testing.T, values interface{}, next string) *paginated {
+	b, err := json.Marshal(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &paginated{Values: b, Next: next}
+}
+
+func TestNewClient(t *testing.T) {
+	mux, c, _ := setup(t)
+	mux.HandleFunc("/2.0/user", func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "app-password" {
+			writeError(t, w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+		writeJSON(t, w, http.StatusOK, User{Username: "user", DisplayName: "A User"})
+	})
+
+	if c.ProviderID() != ProviderID {
+		t.Errorf("ProviderID() = %q, want %q", c.ProviderID(), ProviderID)
+	}
+	login, err := c.UserRepositories().GetUserLogin(context.Background())
+	if err != nil {
+		t.Fatalf("GetUserLogin() error = %v", err)
+	}
+	if login.GetIdentity() != "user" {
+		t.Errorf("GetUserLogin() = %q, want %q", login.GetIdentity(), "user")
+	}
+
+	dc, err := NewClient("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dc.SupportedDomain() != DefaultDomain {
+		t.Errorf("SupportedDomain() = %q, want %q", dc.SupportedDomain(), DefaultDomain)
+	}
+}
+
+func TestOrganizations(t *testing.T) {
+	mux, c, domain := setup(t)
+	mux.HandleFunc("/2.0/workspaces/flux", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Workspace{Slug: "flux", Name: "Flux"})
+	})
+	mux.HandleFunc("/2.0/workspaces/flux/projects", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, page(t, []Project{{Key: "GITOPS", Name: "GitOps"}, {Key: "INFRA", Name: "Infra"}}, ""))
+	})
+	mux.HandleFunc("/2.0/workspaces/flux/projects/GITOPS", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Project{Key: "GITOPS", Name: "GitOps", Description: "GitOps tooling"})
+	})
+	mux.HandleFunc("/2.0/user/permissions/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, http.StatusOK, page(t, []WorkspacePermission{{Permission: "member", Workspace: &Workspace{Slug: "other"}}}, ""))
+			return
+		}
+		next := "http://" + r.Host + r.URL.Path + "?page=2"
+		writeJSON(t, w, http.StatusOK, page(t, []WorkspacePermission{{Permission: "owner", Workspace: &Workspace{Slug: "flux"}}}, next))
+	})
+
+	ctx := context.Background()
+	ref := gitprovider.OrganizationRef{Domain: domain, Organization: "flux"}
+
+	org, err := c.Organizations().Get(ctx, ref)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if name := *org.Get().Name; name != "Flux" {
+		t.Errorf("Get().Name = %q, want %q", name, "Flux")
+	}
+
+	projectRef := ref
+	projectRef.SubOrganizations = []string{"GITOPS"}
+	project, err := c.Organizations().Get(ctx, projectRef)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if desc := *project.Get().Description; desc != "GitOps tooling" {
+		t.Errorf("Get().Description = %q, want %q", desc, "GitOps tooling")
+	}
+
+	orgs, err := c.Organizations().List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	var slugs []string
+	for _, o := range orgs {
+		slugs = append(slugs, o.Organization().Organization)
+	}
+	if diff := cmp.Diff([]string{"flux", "other"}, slugs); diff != "" {
+		t.Errorf("List() mismatch (-want +got):\n%s", diff)
+	}
+
+	children, err := c.Organizations().Children(ctx, ref)
+	if err != nil {
+		t.Fatalf("Children() error = %v", err)
+	}
+	var keys []string
+	for _, o := range children {
+		keys = append(keys, o.Organization().GetIdentity())
+	}
+	if diff := cmp.Diff([]string{"flux/GITOPS", "flux/INFRA"}, keys); diff != "" {
+		t.Errorf("Children() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := c.Organizations().Get(ctx, gitprovider.OrganizationRef{Domain: "github.com", Organization: "flux"}); !errors.Is(err, gitprovider.ErrDomainUnsupported) {
+		t.Errorf("Get() error = %v, want ErrDomainUnsupported", err)
+	}
+	if _, err := org.Teams().List(ctx); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Teams().List() error = %v, want ErrNoProviderSupport", err)
+	}
+}
+
+func TestOrgRepositories(t *testing.T) {
+	repo := &Repository{
+		Slug:        "podinfo",
+		Name:        "podinfo",
+		Description: "demo",
+		IsPrivate:   true,
+		HasIssues:   true,
+		Website:     "https://fluxcd.io",
+		MainBranch:  &BranchRef{Name: "main"},
+	}
+	var updated *Repository
+	mux, c, domain := setup(t)
+	mux.HandleFunc("/2.0/repositories/flux/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(t, w, http.StatusOK, repo)
+		case http.MethodPost:
+			writeError(t, w, http.StatusBadRequest, "Repository with this Slug and Owner already exists.")
+		case http.MethodPut:
+			updated = &Repository{}
+			if err := json.NewDecoder(r.Body).Decode(updated); err != nil {
+				t.Fatal(err)
+			}
+			updated.Slug = "podinfo"
+			writeJSON(t, w, http.StatusOK, updated)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/2.0/repositories/flux/missing", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			writeError(t, w, http.StatusNotFound, "Repository flux/missing not found")
+			return
+		}
+		created := &Repository{}
+		if err := json.NewDecoder(r.Body).Decode(created); err != nil {
+			t.Fatal(err)
+		}
+		if created.SCM != "git" {
+			t.Errorf("created repository SCM = %q, want git", created.SCM)
+		}
+		created.Slug = "missing"
+		writeJSON(t, w, http.StatusOK, created)
+	})
+	mux.HandleFunc("/2.0/repositories/flux", func(w http.ResponseWriter, r *http.Request) {
+		if q := r.URL.Query().Get("q"); q != `project.key="GITOPS"` {
+			t.Errorf("list query = %q", q)
+		}
+		writeJSON(t, w, http.StatusOK, page(t, []*Repository{repo}, ""))
+	})
+
+	ctx := context.Background()
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "flux"},
+		RepositoryName:  "podinfo",
+	}
+
+	r, err := c.OrgRepositories().Get(ctx, ref)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	want := gitprovider.RepositoryInfo{
+		Description:   gitprovider.StringVar("demo"),
+		DefaultBranch: gitprovider.StringVar("main"),
+		Visibility:    gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate),
+		Homepage:      gitprovider.StringVar("https://fluxcd.io"),
+		HasIssues:     gitprovider.BoolVar(true),
+		HasWiki:       gitprovider.BoolVar(false),
+	}
+	if diff := cmp.Diff(want, r.Get()); diff != "" {
+		t.Errorf("Get() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := c.OrgRepositories().Create(ctx, ref, gitprovider.RepositoryInfo{}); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Create() error = %v, want ErrAlreadyExists", err)
+	}
+
+	_, actionTaken, err := c.OrgRepositories().Reconcile(ctx, ref, want)
+	if err != nil || actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want no action", actionTaken, err)
+	}
+
+	_, actionTaken, err = c.OrgRepositories().Reconcile(ctx, ref, gitprovider.RepositoryInfo{Description: gitprovider.StringVar("changed")})
+	if err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want action", actionTaken, err)
+	}
+	if updated == nil || updated.Description != "changed" || !updated.IsPrivate {
+		t.Errorf("Reconcile() sent %+v", updated)
+	}
+
+	updated = nil
+	_, actionTaken, err = c.OrgRepositories().Reconcile(ctx, ref, gitprovider.RepositoryInfo{HasIssues: gitprovider.BoolVar(false)})
+	if err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want action", actionTaken, err)
+	}
+	if updated == nil || updated.HasIssues || updated.Website != "https://fluxcd.io" {
+		t.Errorf("Reconcile() sent %+v", updated)
+	}
+
+	updated = nil
+	_, _, err = c.OrgRepositories().Reconcile(ctx, ref, gitprovider.RepositoryInfo{Archived: gitprovider.BoolVar(true)})
+	if !errors.Is(err, gitprovider.ErrNoProviderSupport) || updated != nil {
+		t.Errorf("Reconcile() error = %v, want ErrNoProviderSupport without an update", err)
+	}
+
+	missingRef := ref
+	missingRef.RepositoryName = "missing"
+	if _, err := c.OrgRepositories().Get(ctx, missingRef); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() error = %v, want ErrNotFound", err)
+	}
+	_, actionTaken, err = c.OrgRepositories().Reconcile(ctx, missingRef, gitprovider.RepositoryInfo{})
+	if err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want creation", actionTaken, err)
+	}
+
+	repos, err := c.OrgRepositories().List(ctx, gitprovider.OrganizationRef{Domain: domain, Organization: "flux", SubOrganizations: []string{"GITOPS"}})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(repos) != 1 || repos[0].Repository().GetRepository() != "podinfo" {
+		t.Errorf("List() = %v", repos)
+	}
+
+	if err := r.Delete(ctx); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("Delete() error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+}
+
+func TestDeployKeys(t *testing.T) {
+	keys := []*DeployKey{{ID: 1, Label: "flux", Key: "ssh-ed25519 AAAA", Comment: "flux@cluster"}}
+	deleted := []int{}
+	mux, c, domain := setup(t, gitprovider.WithDestructiveAPICalls(true))
+	mux.HandleFunc("/2.0/repositories/flux/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Repository{Slug: "podinfo"})
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/deploy-keys", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			k := &DeployKey{}
+			if err := json.NewDecoder(r.Body).Decode(k); err != nil {
+				t.Fatal(err)
+			}
+			k.ID = len(keys) + 1
+			keys = append(keys, k)
+			writeJSON(t, w, http.StatusOK, k)
+			return
+		}
+		writeJSON(t, w, http.StatusOK, page(t, keys, ""))
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/deploy-keys/", func(w http.ResponseWriter, r *http.Request) {
+		var id int
+		fmt.Sscanf(r.URL.Path, "/2.0/repositories/flux/podinfo/deploy-keys/%d", &id)
+		deleted = append(deleted, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "flux"},
+		RepositoryName:  "podinfo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, actionTaken, err := repo.DeployKeys().Reconcile(ctx, gitprovider.DeployKeyInfo{Name: "flux", Key: []byte("ssh-ed25519 AAAA flux@cluster")})
+	if err != nil || actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want no action", actionTaken, err)
+	}
+
+	_, actionTaken, err = repo.DeployKeys().Reconcile(ctx, gitprovider.DeployKeyInfo{Name: "flux", Key: []byte("ssh-ed25519 BBBB")})
+	if err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want recreation", actionTaken, err)
+	}
+	if diff := cmp.Diff([]int{1}, deleted); diff != "" {
+		t.Errorf("deleted keys mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = repo.DeployKeys().Create(ctx, gitprovider.DeployKeyInfo{Name: "rw", Key: []byte("ssh-ed25519 CCCC"), ReadOnly: gitprovider.BoolVar(false)})
+	if !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Create() error = %v, want ErrNoProviderSupport", err)
+	}
+
+	if _, err := repo.DeployKeys().Get(ctx, "missing"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestCollaborators(t *testing.T) {
+	permissions := map[string]*UserPermission{
+		"{1}": {Permission: "write", User: &User{UUID: "{1}", Nickname: "alice", AccountID: "111"}},
+	}
+	mux, c, domain := setup(t)
+	mux.HandleFunc("/2.0/repositories/flux/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Repository{Slug: "podinfo"})
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/permissions-config/users", func(w http.ResponseWriter, r *http.Request) {
+		list := []*UserPermission{}
+		for _, p := range permissions {
+			list = append(list, p)
+		}
+		writeJSON(t, w, http.StatusOK, page(t, list, ""))
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/permissions-config/users/", func(w http.ResponseWriter, r *http.Request) {
+		var id string
+		fmt.Sscanf(r.URL.Path, "/2.0/repositories/flux/podinfo/permissions-config/users/%s", &id)
+		if id == "111" {
+			id = "{1}"
+		}
+		if r.Method == http.MethodDelete {
+			delete(permissions, id)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		p := &UserPermission{}
+		if err := json.NewDecoder(r.Body).Decode(p); err != nil {
+			t.Fatal(err)
+		}
+		p.User = &User{UUID: id, AccountID: id}
+		if existing, ok := permissions[id]; ok {
+			p.User = existing.User
+		}
+		permissions[id] = p
+		writeJSON(t, w, http.StatusOK, p)
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "flux"},
+		RepositoryName:  "podinfo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collaborator, err := repo.Collaborators().Get(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := gitprovider.CollaboratorInfo{Login: "alice", Permission: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush)}
+	if diff := cmp.Diff(want, collaborator.Get()); diff != "" {
+		t.Errorf("Get() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := repo.Collaborators().Create(ctx, gitprovider.CollaboratorInfo{Login: "111"}); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Create() error = %v, want ErrAlreadyExists", err)
+	}
+
+	_, actionTaken, err := repo.Collaborators().Reconcile(ctx, gitprovider.CollaboratorInfo{
+		Login:      "alice",
+		Permission: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin),
+	})
+	if err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want update", actionTaken, err)
+	}
+	if got := permissions["{1}"].Permission; got != "admin" {
+		t.Errorf("permission = %q, want admin", got)
+	}
+
+	if _, err := repo.Collaborators().Create(ctx, gitprovider.CollaboratorInfo{Login: "{2}"}); err != nil {
+		t.Errorf("Create() error = %v", err)
+	}
+	if got := permissions["{2}"].Permission; got != "read" {
+		t.Errorf("permission = %q, want read", got)
+	}
+
+	_, err = repo.Collaborators().Create(ctx, gitprovider.CollaboratorInfo{
+		Login:      "{3}",
+		Permission: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionTriage),
+	})
+	if !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Create() error = %v, want ErrNoProviderSupport", err)
+	}
+
+	if err := collaborator.Delete(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Collaborators().Get(ctx, "alice"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestCommitsBranchesAndPullRequests(t *testing.T) {
+	mux, c, domain := setup(t)
+	mux.HandleFunc("/2.0/repositories/flux/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Repository{Slug: "podinfo"})
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/commits/main", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("pagelen"); got != "2" {
+			t.Errorf("pagelen = %q, want 2", got)
+		}
+		writeJSON(t, w, http.StatusOK, page(t, []Commit{{Hash: "abc", Message: "first"}, {Hash: "def", Message: "second"}}, ""))
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/src", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string][]string{
+			"message":   {"update"},
+			"branch":    {"feature"},
+			"README.md": {"hello"},
+			"files":     {"old.txt"},
+		}
+		if diff := cmp.Diff(want, r.MultipartForm.Value); diff != "" {
+			t.Errorf("commit form mismatch (-want +got):\n%s", diff)
+		}
+		w.Header().Set("Location", "http://"+r.Host+"/2.0/repositories/flux/podinfo/commit/123abc")
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/commit/123abc", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Commit{Hash: "123abc", Message: "update", Author: &CommitAuthor{Raw: "Flux <flux@example.com>"}})
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/refs/branches", func(w http.ResponseWriter, r *http.Request) {
+		b := &Branch{}
+		if err := json.NewDecoder(r.Body).Decode(b); err != nil {
+			t.Fatal(err)
+		}
+		if b.Name != "feature" || b.Target == nil || b.Target.Hash != "abc" {
+			t.Errorf("CreateBranch() sent %+v", b)
+		}
+		writeJSON(t, w, http.StatusCreated, b)
+	})
+	pr := &PullRequest{
+		ID:          7,
+		Title:       "Add feature",
+		State:       "OPEN",
+		Source:      &PullRequestEndpoint{Branch: &BranchRef{Name: "feature"}, Commit: &CommitRef{Hash: "123abc"}},
+		Destination: &PullRequestEndpoint{Branch: &BranchRef{Name: "main"}},
+		Links:       &Links{HTML: &Link{Href: "https://bitbucket.org/flux/podinfo/pull-requests/7"}},
+	}
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			req := &PullRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Fatal(err)
+			}
+			if req.Source.Branch.Name != "feature" || req.Destination.Branch.Name != "main" {
+				t.Errorf("Create() sent %+v", req)
+			}
+			writeJSON(t, w, http.StatusCreated, pr)
+			return
+		}
+		if states := r.URL.Query()["state"]; len(states) != 1 || states[0] != pr.State {
+			writeJSON(t, w, http.StatusOK, page(t, []*PullRequest{}, ""))
+			return
+		}
+		writeJSON(t, w, http.StatusOK, page(t, []*PullRequest{pr}, ""))
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			req := &PullRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Fatal(err)
+			}
+			pr.Title = req.Title
+		}
+		writeJSON(t, w, http.StatusOK, pr)
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7/merge", func(w http.ResponseWriter, r *http.Request) {
+		req := &PullRequestMerge{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatal(err)
+		}
+		if req.MergeStrategy != "squash" || req.Type != pullRequestMergeType || !req.CloseSourceBranch {
+			t.Errorf("Merge() sent %+v", req)
+		}
+		pr.State = pullRequestStateMerged
+		pr.MergeCommit = &CommitRef{Hash: "123abc"}
+		writeJSON(t, w, http.StatusOK, pr)
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7/decline", func(w http.ResponseWriter, r *http.Request) {
+		pr.State = pullRequestStateDeclined
+		writeJSON(t, w, http.StatusOK, pr)
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "flux"},
+		RepositoryName:  "podinfo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commits, err := repo.Commits().ListPage(ctx, "main", 2, 0)
+	if err != nil || len(commits) != 2 || commits[1].Get().Sha != "def" {
+		t.Errorf("ListPage() = %v, %v", commits, err)
+	}
+	commit, err := repo.Commits().Create(ctx, "feature", "update", []gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("README.md"), Content: gitprovider.StringVar("hello")},
+		{Path: gitprovider.StringVar("old.txt")},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if diff := cmp.Diff(gitprovider.CommitInfo{Sha: "123abc", Message: "update", Author: "Flux <flux@example.com>"}, commit.Get()); diff != "" {
+		t.Errorf("Create() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := repo.Branches().Create(ctx, "feature", "abc"); err != nil {
+		t.Errorf("Branches().Create() error = %v", err)
+	}
+
+	created, err := repo.PullRequests().Create(ctx, "Add feature", "feature", "main", "")
+	if err != nil {
+		t.Fatalf("PullRequests().Create() error = %v", err)
+	}
+	wantPR := gitprovider.PullRequestInfo{
+		Title:        "Add feature",
+		Number:       7,
+		WebURL:       "https://bitbucket.org/flux/podinfo/pull-requests/7",
+		SourceBranch: "feature",
+		TargetBranch: "main",
+		HeadSha:      "123abc",
+		State:        gitprovider.PullRequestStateOpen,
+	}
+	if diff := cmp.Diff(wantPR, created.Get()); diff != "" {
+		t.Errorf("PullRequests().Create() mismatch (-want +got):\n%s", diff)
+	}
+	edited, err := repo.PullRequests().Edit(ctx, 7, gitprovider.EditOptions{Title: gitprovider.StringVar("Renamed")})
+	if err != nil || edited.Get().Title != "Renamed" {
+		t.Errorf("Edit() = %v, %v", edited, err)
+	}
+	if _, err := repo.PullRequests().Edit(ctx, 7, gitprovider.EditOptions{Labels: []string{"bug"}}); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Edit() of the labels error = %v, want ErrNoProviderSupport", err)
+	}
+	prs, err := repo.PullRequests().List(ctx)
+	if err != nil || len(prs) != 1 {
+		t.Errorf("List() = %v, %v", prs, err)
+	}
+	prs, err = repo.PullRequests().List(ctx, &gitprovider.PullRequestListOptions{TargetBranch: gitprovider.StringVar("dev")})
+	if err != nil || len(prs) != 0 {
+		t.Errorf("List() with target branch dev = %v, %v, want none", prs, err)
+	}
+	prs, err = repo.PullRequests().List(ctx, &gitprovider.PullRequestListOptions{State: gitprovider.PullRequestStateVar(gitprovider.PullRequestStateMerged)})
+	if err != nil || len(prs) != 0 {
+		t.Errorf("List() merged = %v, %v, want none", prs, err)
+	}
+	var conflictErr *gitprovider.ConflictError
+	if _, err := repo.PullRequests().Merge(ctx, 7, gitprovider.MergeMethodSquash, "squashed", &gitprovider.PullRequestMergeOptions{
+		ExpectedHeadSha: gitprovider.StringVar("def456"),
+	}); !errors.As(err, &conflictErr) {
+		t.Errorf("Merge() with an outdated head error = %v, want a *ConflictError", err)
+	}
+	result, err := repo.PullRequests().Merge(ctx, 7, gitprovider.MergeMethodSquash, "squashed", &gitprovider.PullRequestMergeOptions{
+		ExpectedHeadSha:    gitprovider.StringVar("123abc456def"),
+		DeleteSourceBranch: gitprovider.BoolVar(true),
+	})
+	if err != nil {
+		t.Errorf("Merge() error = %v", err)
+	} else if result.Sha != "123abc" {
+		t.Errorf("Merge() = %+v, want the hash of the merge commit", result)
+	}
+	merged, err := repo.PullRequests().Get(ctx, 7)
+	if err != nil || !merged.Get().Merged {
+		t.Errorf("Get() = %v, %v, want merged", merged, err)
+	}
+
+	pr.State = pullRequestStateOpen
+	declined, err := repo.PullRequests().Edit(ctx, 7, gitprovider.EditOptions{State: gitprovider.PullRequestStateVar(gitprovider.PullRequestStateClosed)})
+	if err != nil || declined.Get().State != gitprovider.PullRequestStateClosed {
+		t.Errorf("Edit() = %v, %v, want a closed pull request", declined, err)
+	}
+}
+
+func TestPullRequestCommentsAndReviews(t *testing.T) {
+	mux, c, domain := setup(t)
+	mux.HandleFunc("/2.0/repositories/flux/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Repository{Slug: "podinfo"})
+	})
+	pr := &PullRequest{ID: 7, Title: "Add feature", State: pullRequestStateOpen}
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, pr)
+	})
+	inline := json.RawMessage(`{"path":"README.md","to":1}`)
+	comments := []*PullRequestComment{
+		{ID: 1, Content: &CommentContent{Raw: "first"}, User: &User{Nickname: "alice"}},
+		{ID: 2, Content: &CommentContent{Raw: "on the diff"}, Inline: &inline},
+		{ID: 3, Content: &CommentContent{Raw: "reply"}, Parent: &PullRequestComment{ID: 1}},
+	}
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7/comments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			req := &PullRequestComment{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Fatal(err)
+			}
+			comment := &PullRequestComment{ID: 4, Content: req.Content, User: &User{Nickname: "bob"}}
+			comments = append(comments, comment)
+			writeJSON(t, w, http.StatusCreated, comment)
+			return
+		}
+		writeJSON(t, w, http.StatusOK, page(t, comments, ""))
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7/comments/2", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, comments[1])
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7/comments/4", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			req := &PullRequestComment{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Fatal(err)
+			}
+			comments[3].Content = req.Content
+			writeJSON(t, w, http.StatusOK, comments[3])
+		case http.MethodDelete:
+			comments = comments[:3]
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7/request-changes", func(w http.ResponseWriter, r *http.Request) {
+		participant := &Participant{User: &User{Nickname: "bob"}, Role: "REVIEWER", State: "changes_requested"}
+		pr.Participants = append(pr.Participants, participant)
+		writeJSON(t, w, http.StatusOK, participant)
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "flux"},
+		RepositoryName:  "podinfo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := repo.PullRequests().Get(ctx, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := got.Comments().Create(ctx, gitprovider.PullRequestCommentInfo{Body: "second"})
+	if err != nil || created.Get().Author != "bob" {
+		t.Fatalf("Comments().Create() = %v, %v", created, err)
+	}
+	if err := created.Set(gitprovider.PullRequestCommentInfo{Body: "edited"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := created.Update(ctx); err != nil || created.Get().Body != "edited" {
+		t.Errorf("Update() = %v, body %q", err, created.Get().Body)
+	}
+	list, err := got.Comments().List(ctx)
+	if err != nil || len(list) != 2 || list[0].Get().Body != "first" || list[1].Get().Body != "edited" {
+		t.Errorf("Comments().List() = %v, %v, want the first and the edited comment", list, err)
+	}
+	if _, err := got.Comments().Get(ctx, 2); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Comments().Get() of a comment on the diff error = %v, want ErrNotFound", err)
+	}
+	if err := created.Delete(ctx); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+
+	review, err := got.Reviews().Create(ctx, gitprovider.PullRequestReviewInfo{
+		State: gitprovider.PullRequestReviewStateChangesRequested,
+		Body:  "please add tests",
+	})
+	if err != nil {
+		t.Fatalf("Reviews().Create() error = %v", err)
+	}
+	wantReview := gitprovider.PullRequestReviewInfo{
+		State:  gitprovider.PullRequestReviewStateChangesRequested,
+		Body:   "please add tests",
+		Author: "bob",
+	}
+	if diff := cmp.Diff(wantReview, review.Get()); diff != "" {
+		t.Errorf("Reviews().Create() mismatch (-want +got):\n%s", diff)
+	}
+	if comments[len(comments)-1].Content.Raw != "please add tests" {
+		t.Errorf("Reviews().Create() didn't add the body as a comment")
+	}
+	reviews, err := got.Reviews().List(ctx)
+	if err != nil || len(reviews) != 1 || reviews[0].Get().State != gitprovider.PullRequestReviewStateChangesRequested {
+		t.Errorf("Reviews().List() = %v, %v, want the changes request", reviews, err)
+	}
+}
+
+func TestPullRequestFiles(t *testing.T) {
+	mux, c, domain := setup(t)
+	mux.HandleFunc("/2.0/repositories/flux/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Repository{Slug: "podinfo"})
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, &PullRequest{ID: 7, Title: "Add feature", State: pullRequestStateOpen})
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7/diffstat", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			writeJSON(t, w, http.StatusOK, page(t, []*DiffStat{
+				{Status: "removed", LinesRemoved: 3, Old: &DiffStatFile{Path: "LICENSE"}},
+			}, ""))
+			return
+		}
+		writeJSON(t, w, http.StatusOK, page(t, []*DiffStat{
+			{Status: "modified", LinesAdded: 2, LinesRemoved: 1, Old: &DiffStatFile{Path: "README.md"}, New: &DiffStatFile{Path: "README.md"}},
+			{Status: "renamed", Old: &DiffStatFile{Path: "old.txt"}, New: &DiffStatFile{Path: "new.txt"}},
+		}, "http://"+r.Host+r.URL.Path+"?page=2"))
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7/diff", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("diff --git a/README.md b/README.md\n"))
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "flux"},
+		RepositoryName:  "podinfo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr, err := repo.PullRequests().Get(ctx, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := pr.Files(ctx)
+	if err != nil {
+		t.Fatalf("Files() error = %v", err)
+	}
+	want := &gitprovider.PullRequestFiles{Files: []gitprovider.PullRequestFile{
+		{Path: "README.md", Status: gitprovider.PullRequestFileStatusModified, Additions: 2, Deletions: 1},
+		{Path: "new.txt", PreviousPath: "old.txt", Status: gitprovider.PullRequestFileStatusRenamed},
+		{Path: "LICENSE", Status: gitprovider.PullRequestFileStatusRemoved, Deletions: 3},
+	}}
+	if diff := cmp.Diff(want, files); diff != "" {
+		t.Errorf("Files() mismatch (-want +got):\n%s", diff)
+	}
+	diff, err := pr.Diff(ctx)
+	if err != nil || diff != "diff --git a/README.md b/README.md\n" {
+		t.Errorf("Diff() = %q, %v", diff, err)
+	}
+}
+
+func TestFilesAndTrees(t *testing.T) {
+	mux, c, domain := setup(t)
+	mux.HandleFunc("/2.0/repositories/flux/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Repository{Slug: "podinfo"})
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/src/main/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/repositories/flux/podinfo/src/main/":
+			writeJSON(t, w, http.StatusOK, page(t, []TreeEntry{
+				{Type: srcTypeFile, Path: "README.md", Size: 5},
+				{Type: srcTypeDirectory, Path: "deploy"},
+			}, ""))
+		case "/2.0/repositories/flux/podinfo/src/main/deploy":
+			if r.URL.Query().Get("format") != "meta" {
+				t.Errorf("expected a meta request for %s", r.URL)
+			}
+			writeJSON(t, w, http.StatusOK, TreeEntry{Type: srcTypeDirectory, Path: "deploy"})
+		case "/2.0/repositories/flux/podinfo/src/main/deploy/":
+			writeJSON(t, w, http.StatusOK, page(t, []TreeEntry{
+				{Type: srcTypeFile, Path: "deploy/app.yaml", Size: 9},
+				{Type: srcTypeFile, Path: "deploy/run.sh", Size: 4, Attributes: []string{"executable"}},
+			}, ""))
+		case "/2.0/repositories/flux/podinfo/src/main/deploy/app.yaml":
+			io.WriteString(w, "kind: App")
+		case "/2.0/repositories/flux/podinfo/src/main/deploy/run.sh":
+			io.WriteString(w, "exit")
+		default:
+			writeError(t, w, http.StatusNotFound, "No such file or directory")
+		}
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "flux"},
+		RepositoryName:  "podinfo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := repo.Files().Get(ctx, "deploy", "main")
+	if err != nil {
+		t.Fatalf("Files().Get() error = %v", err)
+	}
+	want := []*gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("deploy/app.yaml"), Content: gitprovider.StringVar("kind: App")},
+		{Path: gitprovider.StringVar("deploy/run.sh"), Content: gitprovider.StringVar("exit")},
+	}
+	if diff := cmp.Diff(want, files); diff != "" {
+		t.Errorf("Files().Get() mismatch (-want +got):\n%s", diff)
+	}
+
+	tree, err := repo.Trees().Get(ctx, "main", true)
+	if err != nil {
+		t.Fatalf("Trees().Get() error = %v", err)
+	}
+	wantTree := &gitprovider.TreeInfo{
+		SHA: "main",
+		Tree: []*gitprovider.TreeEntry{
+			{Path: "README.md", Type: "blob", Mode: "100644", Size: 5},
+			{Path: "deploy", Type: "tree", Mode: "040000"},
+			{Path: "deploy/app.yaml", Type: "blob", Mode: "100644", Size: 9},
+			{Path: "deploy/run.sh", Type: "blob", Mode: "100755", Size: 4},
+		},
+	}
+	if diff := cmp.Diff(wantTree, tree); diff != "" {
+		t.Errorf("Trees().Get() mismatch (-want +got):\n%s", diff)
+	}
+
+	blobs, err := repo.Trees().List(ctx, "main", "deploy", false)
+	if err != nil || len(blobs) != 2 {
+		t.Errorf("Trees().List() = %v, %v", blobs, err)
+	}
+
+	if _, err := repo.Files().Get(ctx, "missing", "main"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Files().Get() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestBranches(t *testing.T) {
+	mux, c, domain := setup(t, gitprovider.WithDestructiveAPICalls(true))
+	repoObj := Repository{Slug: "podinfo", MainBranch: &BranchRef{Name: "main"}}
+	mux.HandleFunc("/2.0/repositories/flux/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			req := &Repository{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Fatal(err)
+			}
+			repoObj.MainBranch = req.MainBranch
+		}
+		writeJSON(t, w, http.StatusOK, repoObj)
+	})
+	branches := map[string]*Branch{
+		"main":    {Name: "main", Target: &Commit{Hash: "abc"}},
+		"feature": {Name: "feature", Target: &Commit{Hash: "def"}},
+	}
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/refs/branches", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			b := &Branch{}
+			if err := json.NewDecoder(r.Body).Decode(b); err != nil {
+				t.Fatal(err)
+			}
+			branches[b.Name] = b
+			writeJSON(t, w, http.StatusCreated, b)
+			return
+		}
+		writeJSON(t, w, http.StatusOK, page(t, []*Branch{branches["feature"], branches["main"]}, ""))
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/refs/branches/", func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Path[len("/2.0/repositories/flux/podinfo/refs/branches/"):]
+		b, ok := branches[name]
+		if !ok {
+			writeError(t, w, http.StatusNotFound, "Branch not found")
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(branches, name)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(t, w, http.StatusOK, b)
+	})
+	pr := &PullRequest{
+		ID:          7,
+		State:       "OPEN",
+		Source:      &PullRequestEndpoint{Branch: &BranchRef{Name: "feature"}},
+		Destination: &PullRequestEndpoint{Branch: &BranchRef{Name: "main"}},
+	}
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, page(t, []*PullRequest{pr}, ""))
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/pullrequests/7", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			req := &PullRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Fatal(err)
+			}
+			pr.Destination = req.Destination
+		}
+		writeJSON(t, w, http.StatusOK, pr)
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "flux"},
+		RepositoryName:  "podinfo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := repo.Branches().List(ctx)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("List() = %v, %v", list, err)
+	}
+	b, err := repo.Branches().Get(ctx, "feature")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(gitprovider.BranchInfo{Name: "feature", Sha: "def"}, b.Get()); diff != "" {
+		t.Errorf("Get() mismatch (-want +got):\n%s", diff)
+	}
+	if err := repo.Branches().Delete(ctx, "feature"); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+	if _, err := repo.Branches().Get(ctx, "feature"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() error = %v, want %v", err, gitprovider.ErrNotFound)
+	}
+
+	if err := repo.Branches().RenameDefault(ctx, "main"); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("RenameDefault() error = %v, want %v", err, gitprovider.ErrAlreadyExists)
+	}
+	if err := repo.Branches().RenameDefault(ctx, "trunk"); err != nil {
+		t.Fatalf("RenameDefault() error = %v", err)
+	}
+	if branches["trunk"].Target.Hash != "abc" || repoObj.MainBranch.Name != "trunk" {
+		t.Errorf("RenameDefault() created %+v with default branch %q", branches["trunk"], repoObj.MainBranch.Name)
+	}
+	if target := pr.Destination.Branch.Name; target != "trunk" {
+		t.Errorf("RenameDefault() retargeted the pull request to %q, want trunk", target)
+	}
+}
+
+func TestTags(t *testing.T) {
+	mux, c, domain := setup(t, gitprovider.WithDestructiveAPICalls(true))
+	mux.HandleFunc("/2.0/repositories/flux/podinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Repository{Slug: "podinfo", MainBranch: &BranchRef{Name: "main"}})
+	})
+	tags := map[string]*Tag{
+		"v1.0.0": {Name: "v1.0.0", Target: &Commit{Hash: "abc"}},
+	}
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/refs/tags", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			tag := &Tag{}
+			if err := json.NewDecoder(r.Body).Decode(tag); err != nil {
+				t.Fatal(err)
+			}
+			tags[tag.Name] = tag
+			writeJSON(t, w, http.StatusCreated, tag)
+			return
+		}
+		list := []*Tag{}
+		for _, tag := range tags {
+			list = append(list, tag)
+		}
+		writeJSON(t, w, http.StatusOK, page(t, list, ""))
+	})
+	mux.HandleFunc("/2.0/repositories/flux/podinfo/refs/tags/", func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Path[len("/2.0/repositories/flux/podinfo/refs/tags/"):]
+		tag, ok := tags[name]
+		if !ok {
+			writeError(t, w, http.StatusNotFound, "Tag not found")
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(tags, name)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(t, w, http.StatusOK, tag)
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: domain, Organization: "flux"},
+		RepositoryName:  "podinfo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.Tags().Create(ctx, gitprovider.TagInfo{Name: "v1.0.0", Sha: "def"}); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Create() error = %v, want %v", err, gitprovider.ErrAlreadyExists)
+	}
+	want := gitprovider.TagInfo{Name: "v1.1.0", Sha: "def", Message: gitprovider.StringVar("Release v1.1.0")}
+	if _, err := repo.Tags().Create(ctx, want); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	tag, err := repo.Tags().Get(ctx, "v1.1.0")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff(want, tag.Get()); diff != "" {
+		t.Errorf("Get() mismatch (-want +got):\n%s", diff)
+	}
+	list, err := repo.Tags().List(ctx)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("List() = %v, %v", list, err)
+	}
+	if err := repo.Tags().Delete(ctx, "v1.0.0"); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+	if _, err := repo.Tags().Get(ctx, "v1.0.0"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() error = %v, want %v", err, gitprovider.ErrNotFound)
+	}
+	if _, err := repo.Releases().List(ctx); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Releases().List() error = %v, want %v", err, gitprovider.ErrNoProviderSupport)
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// dryRunValidator implements gitprovider.DryRunValidator for Bitbucket Cloud.
+type dryRunValidator struct{}
+
+var _ gitprovider.DryRunValidator = dryRunValidator{}
+
+func (v dryRunValidator) ValidateCreateOrganization(_ gitprovider.OrganizationRef, _ gitprovider.OrganizationInfo) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func (v dryRunValidator) ValidateUpdateOrganization(_ gitprovider.OrganizationRef, _ gitprovider.OrganizationInfo) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func (v dryRunValidator) ValidateEnableAutoMerge(_ gitprovider.MergeMethod) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func (v dryRunValidator) ValidateDisableAutoMerge() error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func (v dryRunValidator) ValidateRepositoryInfo(info gitprovider.RepositoryInfo) error {
+	return validateRepositorySupport(info)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newBranch(c *BranchClient, branch *Branch) *branchType {
+	return &branchType{
+		b: *branch,
+		c: c,
+	}
+}
+
+var _ gitprovider.Branch = &branchType{}
+
+type branchType struct {
+	b Branch
+	c *BranchClient
+}
+
+// Get returns the branch information.
+func (b *branchType) Get() gitprovider.BranchInfo {
+	return branchFromAPI(&b.b)
+}
+
+// APIObject returns the underlying API object.
+func (b *branchType) APIObject() interface{} {
+	return &b.b
+}
+
+// validateBranchAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateBranchAPI(apiObj *Branch) error {
+	return validateAPIObject("Bitbucket.Branch", func(validator validation.Validator) {
+		if apiObj.Name == "" {
+			validator.Required("Name")
+		}
+		if apiObj.Target == nil || apiObj.Target.Hash == "" {
+			validator.Required("Target.Hash")
+		}
+	})
+}
+
+// branchFromAPI converts the branch, branch protections aren't supported for Bitbucket Cloud
+// so Protected is never set.
+func branchFromAPI(apiObj *Branch) gitprovider.BranchInfo {
+	return gitprovider.BranchInfo{
+		Name: apiObj.Name,
+		Sha:  apiObj.Target.Hash,
+	}
+}
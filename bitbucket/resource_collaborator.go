@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newCollaborator(c *CollaboratorClient, apiObj *UserPermission) *collaborator {
+	info := gitprovider.CollaboratorInfo{
+		Permission: permissionFromAPI(apiObj.Permission),
+	}
+	if apiObj.User != nil {
+		info.Login = userLogin(apiObj.User)
+	}
+	return &collaborator{
+		p:    *apiObj,
+		info: info,
+		c:    c,
+	}
+}
+
+var _ gitprovider.Collaborator = &collaborator{}
+
+type collaborator struct {
+	p    UserPermission
+	info gitprovider.CollaboratorInfo
+	c    *CollaboratorClient
+}
+
+func (c *collaborator) Get() gitprovider.CollaboratorInfo {
+	return c.info
+}
+
+func (c *collaborator) Set(info gitprovider.CollaboratorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	c.info = info
+	return nil
+}
+
+func (c *collaborator) APIObject() interface{} {
+	return &c.p
+}
+
+func (c *collaborator) Repository() gitprovider.RepositoryRef {
+	return c.c.ref
+}
+
+// Delete removes the user from the repository's permissions.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Delete(ctx context.Context) error {
+	// DELETE /repositories/{workspace}/{repo_slug}/permissions-config/users/{selected_user_id}
+	return c.c.c.DeleteUserPermission(ctx, c.c.ref.GetIdentity(), c.c.ref.GetRepository(), c.userID())
+}
+
+func (c *collaborator) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&c.info); err != nil {
+		return err
+	}
+	// Update the actual state to be the desired state
+	// by issuing a PUT for the known user.
+	req := c.info
+	req.Login = c.userID()
+	resp, err := c.c.set(ctx, req)
+	if err != nil {
+		return err
+	}
+	resp.info.Login = c.info.Login
+	*c = *resp
+	return nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *collaborator) Reconcile(ctx context.Context) (bool, error) {
+	req := c.Get()
+	actual, err := c.c.get(ctx, req.Login)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.c.Create(ctx, req)
+			if err != nil {
+				return true, err
+			}
+			*c = *resp.(*collaborator)
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// The account ID of the user is needed to update the permission
+	c.p = actual.p
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return false, nil
+	}
+
+	return true, c.Update(ctx)
+}
+
+// matches returns true if login is the nickname, username, account ID or UUID of the user.
+func (c *collaborator) matches(login string) bool {
+	user := c.p.User
+	if user == nil {
+		return false
+	}
+	for _, id := range []string{userLogin(user), user.AccountID, user.UUID} {
+		if id != "" && id == login {
+			return true
+		}
+	}
+	return false
+}
+
+// userID returns the account ID of the user, which identifies them in requests.
+// The login is used if the server didn't return the user.
+func (c *collaborator) userID() string {
+	if c.p.User != nil && c.p.User.AccountID != "" {
+		return c.p.User.AccountID
+	}
+	if c.p.User != nil && c.p.User.UUID != "" {
+		return c.p.User.UUID
+	}
+	return c.info.Login
+}
+
+// validateUserPermissionAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateUserPermissionAPI(apiObj *UserPermission) error {
+	return validateAPIObject("Bitbucket.UserPermission", func(validator validation.Validator) {
+		if apiObj.Permission == "" {
+			validator.Required("Permission")
+		}
+	})
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newCommit(c *CommitClient, commit *Commit) *commitType {
+	return &commitType{
+		k: *commit,
+		c: c,
+	}
+}
+
+var _ gitprovider.Commit = &commitType{}
+
+type commitType struct {
+	k Commit
+	c *CommitClient
+}
+
+// Get returns the commit information.
+func (c *commitType) Get() gitprovider.CommitInfo {
+	return commitFromAPI(&c.k)
+}
+
+// APIObject returns the underlying API object.
+func (c *commitType) APIObject() interface{} {
+	return &c.k
+}
+
+// commitFromAPI converts the commit, Bitbucket doesn't expose the tree of a commit so TreeSha is never set.
+func commitFromAPI(apiObj *Commit) gitprovider.CommitInfo {
+	info := gitprovider.CommitInfo{
+		Sha:       apiObj.Hash,
+		Message:   apiObj.Message,
+		CreatedAt: apiObj.Date,
+		URL:       apiObj.Links.htmlURL(),
+	}
+	if apiObj.Author != nil {
+		info.Author = apiObj.Author.Raw
+		if apiObj.Author.User != nil {
+			info.Author = apiObj.Author.User.DisplayName
+		}
+	}
+	return info
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newOrganization(ctx *clientContext, apiObj *Workspace, ref gitprovider.OrganizationRef) *organization {
+	return &organization{
+		clientContext: ctx,
+		w:             apiObj,
+		ref:           ref,
+		teams: &TeamsClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+	}
+}
+
+func newProjectOrganization(ctx *clientContext, apiObj *Project, ref gitprovider.OrganizationRef) *organization {
+	return &organization{
+		clientContext: ctx,
+		p:             apiObj,
+		ref:           ref,
+		teams: &TeamsClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+	}
+}
+
+var _ gitprovider.Organization = &organization{}
+
+// organization is either a workspace (w is set) or a project in a workspace (p is set).
+type organization struct {
+	*clientContext
+
+	w   *Workspace
+	p   *Project
+	ref gitprovider.OrganizationRef
+
+	teams *TeamsClient
+}
+
+// Get returns the organization information.
+func (o *organization) Get() gitprovider.OrganizationInfo {
+	if o.p != nil {
+		return projectFromAPI(o.p)
+	}
+	return organizationFromAPI(o.w)
+}
+
+// APIObject returns the underlying API object, either a *Workspace or a *Project.
+func (o *organization) APIObject() interface{} {
+	if o.p != nil {
+		return o.p
+	}
+	return o.w
+}
+
+// Organization returns the organization reference.
+func (o *organization) Organization() gitprovider.OrganizationRef {
+	return o.ref
+}
+
+// Teams returns the teams client.
+func (o *organization) Teams() gitprovider.TeamsClient {
+	return o.teams
+}
+
+func organizationFromAPI(apiObj *Workspace) gitprovider.OrganizationInfo {
+	return gitprovider.OrganizationInfo{
+		Name: &apiObj.Name,
+	}
+}
+
+func projectFromAPI(apiObj *Project) gitprovider.OrganizationInfo {
+	return gitprovider.OrganizationInfo{
+		Name:        &apiObj.Name,
+		Description: &apiObj.Description,
+	}
+}
+
+// validateWorkspaceAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateWorkspaceAPI(apiObj *Workspace) error {
+	return validateAPIObject("Bitbucket.Workspace", func(validator validation.Validator) {
+		if apiObj.Slug == "" {
+			validator.Required("Slug")
+		}
+	})
+}
+
+// validateProjectAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateProjectAPI(apiObj *Project) error {
+	return validateAPIObject("Bitbucket.Project", func(validator validation.Validator) {
+		if apiObj.Key == "" {
+			validator.Required("Key")
+		}
+	})
+}
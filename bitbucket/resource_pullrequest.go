@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+const (
+	// the state of an open pull request.
+	pullRequestStateOpen = "OPEN"
+	// the state of a merged pull request.
+	pullRequestStateMerged = "MERGED"
+	// the state of a pull request closed without merging.
+	pullRequestStateDeclined = "DECLINED"
+	// the state of a pull request closed in favour of another one.
+	pullRequestStateSuperseded = "SUPERSEDED"
+)
+
+func newPullRequest(c *PullRequestClient, apiObj *PullRequest) *pullrequest {
+	return &pullrequest{
+		clientContext: c.clientContext,
+		pr:            *apiObj,
+		ref:           c.ref,
+		comments: &PullRequestCommentClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.ID,
+		},
+		reviews: &PullRequestReviewClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.ID,
+		},
+	}
+}
+
+var _ gitprovider.PullRequest = &pullrequest{}
+
+type pullrequest struct {
+	*clientContext
+
+	pr       PullRequest
+	ref      gitprovider.RepositoryRef
+	comments *PullRequestCommentClient
+	reviews  *PullRequestReviewClient
+}
+
+// Get returns the pull request information.
+func (pr *pullrequest) Get() gitprovider.PullRequestInfo {
+	return pullrequestFromAPI(&pr.pr)
+}
+
+// APIObject returns the underlying API object.
+func (pr *pullrequest) APIObject() interface{} {
+	return &pr.pr
+}
+
+// Comments returns a client for the comments in the conversation of the pull request.
+func (pr *pullrequest) Comments() gitprovider.PullRequestCommentClient {
+	return pr.comments
+}
+
+// Reviews returns a client for the reviews of the pull request.
+func (pr *pullrequest) Reviews() gitprovider.PullRequestReviewClient {
+	return pr.reviews
+}
+
+// Files returns the files changed by the pull request.
+// Bitbucket doesn't return the patches of the files.
+func (pr *pullrequest) Files(ctx context.Context) (*gitprovider.PullRequestFiles, error) {
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diffstat
+	apiObjs, err := pr.c.ListPullRequestDiffStat(ctx, pr.ref.GetIdentity(), pr.ref.GetRepository(), pr.pr.ID)
+	if err != nil {
+		return nil, err
+	}
+	files := &gitprovider.PullRequestFiles{Files: make([]gitprovider.PullRequestFile, 0, len(apiObjs))}
+	for _, apiObj := range apiObjs {
+		files.Files = append(files.Files, pullRequestFileFromAPI(apiObj))
+	}
+	return files, nil
+}
+
+// Diff returns the changes of the pull request as a raw unified diff.
+func (pr *pullrequest) Diff(ctx context.Context) (string, error) {
+	// GET /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/diff
+	return pr.c.GetPullRequestDiff(ctx, pr.ref.GetIdentity(), pr.ref.GetRepository(), pr.pr.ID)
+}
+
+func pullrequestFromAPI(apiObj *PullRequest) gitprovider.PullRequestInfo {
+	info := gitprovider.PullRequestInfo{
+		Title:       apiObj.Title,
+		Description: apiObj.Description,
+		Merged:      apiObj.State == pullRequestStateMerged,
+		Number:      apiObj.ID,
+		WebURL:      apiObj.Links.htmlURL(),
+		Draft:       apiObj.Draft != nil && *apiObj.Draft,
+	}
+	if apiObj.Source != nil && apiObj.Source.Branch != nil {
+		info.SourceBranch = apiObj.Source.Branch.Name
+	}
+	if apiObj.Source != nil && apiObj.Source.Commit != nil {
+		info.HeadSha = apiObj.Source.Commit.Hash
+	}
+	if apiObj.Destination != nil && apiObj.Destination.Branch != nil {
+		info.TargetBranch = apiObj.Destination.Branch.Name
+	}
+	if apiObj.Destination != nil && apiObj.Destination.Commit != nil {
+		info.BaseSha = apiObj.Destination.Commit.Hash
+	}
+	if apiObj.Author != nil {
+		info.Author = userLogin(apiObj.Author)
+	}
+	for _, reviewer := range apiObj.Reviewers {
+		info.Reviewers = append(info.Reviewers, userLogin(reviewer))
+	}
+	if apiObj.CreatedOn != nil {
+		info.CreatedAt = *apiObj.CreatedOn
+	}
+	if apiObj.UpdatedOn != nil {
+		info.UpdatedAt = *apiObj.UpdatedOn
+	}
+
+	switch apiObj.State {
+	case pullRequestStateMerged:
+		info.State = gitprovider.PullRequestStateMerged
+	case pullRequestStateDeclined, pullRequestStateSuperseded:
+		info.State = gitprovider.PullRequestStateClosed
+	default:
+		info.State = gitprovider.PullRequestStateOpen
+	}
+	return info
+}
+
+// validatePullRequestAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validatePullRequestAPI(apiObj *PullRequest) error {
+	return validateAPIObject("Bitbucket.PullRequest", func(validator validation.Validator) {
+		if apiObj.ID == 0 {
+			validator.Required("ID")
+		}
+	})
+}
+
+func pullRequestFileFromAPI(apiObj *DiffStat) gitprovider.PullRequestFile {
+	file := gitprovider.PullRequestFile{
+		Status:    gitprovider.PullRequestFileStatusModified,
+		Additions: apiObj.LinesAdded,
+		Deletions: apiObj.LinesRemoved,
+	}
+	if apiObj.New != nil {
+		file.Path = apiObj.New.Path
+	}
+	switch apiObj.Status {
+	case "added":
+		file.Status = gitprovider.PullRequestFileStatusAdded
+	case "removed":
+		file.Status = gitprovider.PullRequestFileStatusRemoved
+		file.Path = apiObj.Old.Path
+	case "renamed":
+		file.Status = gitprovider.PullRequestFileStatusRenamed
+		file.PreviousPath = apiObj.Old.Path
+	}
+	return file
+}
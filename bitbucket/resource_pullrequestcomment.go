@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newPullRequestComment(c *PullRequestCommentClient, apiObj *PullRequestComment) *pullRequestComment {
+	return &pullRequestComment{
+		pc: *apiObj,
+		c:  c,
+	}
+}
+
+var _ gitprovider.PullRequestComment = &pullRequestComment{}
+
+type pullRequestComment struct {
+	pc PullRequestComment
+	c  *PullRequestCommentClient
+}
+
+// Get returns the comment information.
+func (pc *pullRequestComment) Get() gitprovider.PullRequestCommentInfo {
+	return pullRequestCommentFromAPI(&pc.pc)
+}
+
+// Set sets the comment information.
+func (pc *pullRequestComment) Set(info gitprovider.PullRequestCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	pc.pc.Content = &CommentContent{Raw: info.Body}
+	return nil
+}
+
+// APIObject returns the underlying API object.
+func (pc *pullRequestComment) APIObject() interface{} {
+	return &pc.pc
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (pc *pullRequestComment) Update(ctx context.Context) error {
+	// PUT /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+	apiObj, err := pc.c.c.UpdatePullRequestComment(ctx, pc.c.ref.GetIdentity(), pc.c.ref.GetRepository(), pc.c.number, pc.pc.ID, pullRequestCommentFromAPI(&pc.pc).Body)
+	if err != nil {
+		return err
+	}
+	pc.pc = *apiObj
+	return nil
+}
+
+// Delete deletes the comment.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (pc *pullRequestComment) Delete(ctx context.Context) error {
+	// DELETE /repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}
+	return pc.c.c.DeletePullRequestComment(ctx, pc.c.ref.GetIdentity(), pc.c.ref.GetRepository(), pc.c.number, pc.pc.ID)
+}
+
+// isConversationComment returns true for the top-level comments of a pull request, i.e. not for
+// comments on the diff, replies or deleted comments.
+func isConversationComment(apiObj *PullRequestComment) bool {
+	return apiObj.Inline == nil && apiObj.Parent == nil && !apiObj.Deleted
+}
+
+func pullRequestCommentFromAPI(apiObj *PullRequestComment) gitprovider.PullRequestCommentInfo {
+	info := gitprovider.PullRequestCommentInfo{
+		ID: int64(apiObj.ID),
+	}
+	if apiObj.Content != nil {
+		info.Body = apiObj.Content.Raw
+	}
+	if apiObj.User != nil {
+		info.Author = userLogin(apiObj.User)
+	}
+	if apiObj.CreatedOn != nil {
+		info.CreatedAt = *apiObj.CreatedOn
+	}
+	if apiObj.UpdatedOn != nil {
+		info.UpdatedAt = *apiObj.UpdatedOn
+	}
+	return info
+}
+
+// validatePullRequestCommentAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validatePullRequestCommentAPI(apiObj *PullRequestComment) error {
+	return validateAPIObject("Bitbucket.PullRequestComment", func(validator validation.Validator) {
+		if apiObj.ID == 0 {
+			validator.Required("ID")
+		}
+	})
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// the endpoint approving a pull request.
+	reviewActionApprove = "approve"
+	// the endpoint requesting changes to a pull request.
+	reviewActionRequestChanges = "request-changes"
+)
+
+// reviewStates maps the states of participants to the review states.
+//
+//nolint:gochecknoglobals
+var reviewStates = map[string]gitprovider.PullRequestReviewState{
+	"approved":          gitprovider.PullRequestReviewStateApproved,
+	"changes_requested": gitprovider.PullRequestReviewStateChangesRequested,
+}
+
+// newParticipantReview returns a review for the state of a participant of a pull request.
+func newParticipantReview(apiObj *Participant) *pullRequestReview {
+	info := gitprovider.PullRequestReviewInfo{
+		State:       reviewStates[apiObj.State],
+		SubmittedAt: apiObj.ParticipatedOn,
+	}
+	if apiObj.User != nil {
+		info.Author = userLogin(apiObj.User)
+	}
+	return &pullRequestReview{
+		apiObj: apiObj,
+		info:   info,
+	}
+}
+
+// newCommentReview returns a commented review for a comment of a pull request.
+func newCommentReview(apiObj *PullRequestComment) *pullRequestReview {
+	comment := pullRequestCommentFromAPI(apiObj)
+	createdAt := comment.CreatedAt
+	return &pullRequestReview{
+		apiObj: apiObj,
+		info: gitprovider.PullRequestReviewInfo{
+			ID:          comment.ID,
+			State:       gitprovider.PullRequestReviewStateCommented,
+			Body:        comment.Body,
+			Author:      comment.Author,
+			SubmittedAt: &createdAt,
+		},
+	}
+}
+
+var _ gitprovider.PullRequestReview = &pullRequestReview{}
+
+// pullRequestReview is either the state of a participant or a comment, as Bitbucket doesn't have reviews.
+type pullRequestReview struct {
+	apiObj interface{}
+	info   gitprovider.PullRequestReviewInfo
+}
+
+// Get returns the review information.
+func (r *pullRequestReview) Get() gitprovider.PullRequestReviewInfo {
+	return r.info
+}
+
+// APIObject returns the *Participant of approvals and changes requests, or the *PullRequestComment
+// of commented reviews.
+func (r *pullRequestReview) APIObject() interface{} {
+	return r.apiObj
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newUserRepository(ctx *clientContext, apiObj *Repository, ref gitprovider.RepositoryRef) *userRepository {
+	return &userRepository{
+		clientContext: ctx,
+		r:             *apiObj,
+		ref:           ref,
+		collaborators: &CollaboratorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		deployKeys: &DeployKeyClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		commits: &CommitClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		branches: &BranchClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		pullRequests: &PullRequestClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		issues: &IssueClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		labels: &LabelClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		files: &FileClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		trees: &TreeClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		webhooks: &WebhookClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		commitStatuses: &CommitStatusClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		branchProtections: &BranchProtectionClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		tags: &TagClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		releases: &ReleaseClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+	}
+}
+
+var _ gitprovider.UserRepository = &userRepository{}
+
+type userRepository struct {
+	*clientContext
+
+	r   Repository
+	ref gitprovider.RepositoryRef
+
+	collaborators     *CollaboratorClient
+	deployKeys        *DeployKeyClient
+	commits           *CommitClient
+	branches          *BranchClient
+	pullRequests      *PullRequestClient
+	issues            *IssueClient
+	labels            *LabelClient
+	files             *FileClient
+	trees             *TreeClient
+	webhooks          *WebhookClient
+	commitStatuses    *CommitStatusClient
+	branchProtections *BranchProtectionClient
+	tags              *TagClient
+	releases          *ReleaseClient
+}
+
+// Get returns the repository information.
+func (r *userRepository) Get() gitprovider.RepositoryInfo {
+	return repositoryFromAPI(&r.r)
+}
+
+// Set sets the repository information.
+func (r *userRepository) Set(info gitprovider.RepositoryInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if err := validateRepositorySupport(info); err != nil {
+		return err
+	}
+	repositoryInfoToAPIObj(&info, &r.r)
+	return nil
+}
+
+// APIObject returns the underlying API object.
+func (r *userRepository) APIObject() interface{} {
+	return &r.r
+}
+
+// Repository returns the repository reference.
+func (r *userRepository) Repository() gitprovider.RepositoryRef {
+	return r.ref
+}
+
+// Collaborators returns the collaborator client.
+func (r *userRepository) Collaborators() gitprovider.CollaboratorClient {
+	return r.collaborators
+}
+
+// DeployKeys returns the deploy key client.
+func (r *userRepository) DeployKeys() gitprovider.DeployKeyClient {
+	return r.deployKeys
+}
+
+// DeployTokens returns the deploy token client.
+// ErrNoProviderSupport is returned as the provider does not support deploy tokens.
+func (r *userRepository) DeployTokens() (gitprovider.DeployTokenClient, error) {
+	return nil, gitprovider.ErrNoProviderSupport
+}
+
+// Commits returns the commit client.
+func (r *userRepository) Commits() gitprovider.CommitClient {
+	return r.commits
+}
+
+// Branches returns the branch client.
+func (r *userRepository) Branches() gitprovider.BranchClient {
+	return r.branches
+}
+
+// PullRequests returns the pull request client.
+func (r *userRepository) PullRequests() gitprovider.PullRequestClient {
+	return r.pullRequests
+}
+
+// Issues returns the issue client.
+func (r *userRepository) Issues() gitprovider.IssueClient {
+	return r.issues
+}
+
+// Labels returns the label client.
+func (r *userRepository) Labels() gitprovider.LabelClient {
+	return r.labels
+}
+
+// Files returns the file client.
+func (r *userRepository) Files() gitprovider.FileClient {
+	return r.files
+}
+
+// Trees returns the tree client.
+func (r *userRepository) Trees() gitprovider.TreeClient {
+	return r.trees
+}
+
+// Webhooks returns the webhook client.
+func (r *userRepository) Webhooks() gitprovider.WebhookClient {
+	return r.webhooks
+}
+
+// CommitStatuses returns the commit status client.
+func (r *userRepository) CommitStatuses() gitprovider.CommitStatusClient {
+	return r.commitStatuses
+}
+
+// BranchProtections returns the branch protection client.
+func (r *userRepository) BranchProtections() gitprovider.BranchProtectionClient {
+	return r.branchProtections
+}
+
+// Tags returns the tag client.
+func (r *userRepository) Tags() gitprovider.TagClient {
+	return r.tags
+}
+
+// Releases returns the release client.
+func (r *userRepository) Releases() gitprovider.ReleaseClient {
+	return r.releases
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (r *userRepository) Update(ctx context.Context) error {
+	// PUT /repositories/{workspace}/{repo_slug}
+	apiObj, err := r.c.UpdateRepo(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), newBitbucketRepositorySpec(&r.r).Repository)
+	if err != nil {
+		return err
+	}
+	r.r = *apiObj
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (r *userRepository) Reconcile(ctx context.Context) (bool, error) {
+	apiObj, err := r.c.GetRepo(ctx, r.ref.GetIdentity(), r.ref.GetRepository())
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			repo, err := r.c.CreateRepo(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), newBitbucketRepositorySpec(&r.r).Repository)
+			if err != nil {
+				return true, err
+			}
+			r.r = *repo
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	// Use wrappers here to extract the "spec" part of the object for comparison
+	desiredSpec := newBitbucketRepositorySpec(&r.r)
+	actualSpec := newBitbucketRepositorySpec(apiObj)
+
+	// If desired state already is the actual state, do nothing
+	if desiredSpec.Equals(actualSpec) {
+		return false, nil
+	}
+	// Otherwise, make the desired state the actual state
+	return true, r.Update(ctx)
+}
+
+// Delete deletes the current resource irreversibly.
+//
+// ErrNotFound is returned if the resource doesn't exist anymore.
+func (r *userRepository) Delete(ctx context.Context) error {
+	// DELETE /repositories/{workspace}/{repo_slug}
+	return r.c.DeleteRepo(ctx, r.ref.GetIdentity(), r.ref.GetRepository())
+}
+
+func newOrgRepository(ctx *clientContext, apiObj *Repository, ref gitprovider.RepositoryRef) *orgRepository {
+	return &orgRepository{
+		userRepository: *newUserRepository(ctx, apiObj, ref),
+		teamAccess: &TeamAccessClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+	}
+}
+
+var _ gitprovider.OrgRepository = &orgRepository{}
+
+type orgRepository struct {
+	userRepository
+
+	teamAccess *TeamAccessClient
+}
+
+// TeamAccess returns the team access client.
+func (r *orgRepository) TeamAccess() gitprovider.TeamAccessClient {
+	return r.teamAccess
+}
+
+// validateRepositoryAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateRepositoryAPI(apiObj *Repository) error {
+	return validateAPIObject("Bitbucket.Repository", func(validator validation.Validator) {
+		// Make sure slug is set
+		if apiObj.Slug == "" {
+			validator.Required("Slug")
+		}
+	})
+}
+
+func repositoryFromAPI(apiObj *Repository) gitprovider.RepositoryInfo {
+	repo := gitprovider.RepositoryInfo{
+		Description: &apiObj.Description,
+		Visibility:  gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPublic),
+		Homepage:    &apiObj.Website,
+		HasIssues:   &apiObj.HasIssues,
+		HasWiki:     &apiObj.HasWiki,
+	}
+	if apiObj.IsPrivate {
+		repo.Visibility = gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate)
+	}
+	// Empty repositories don't have a main branch yet
+	if apiObj.MainBranch != nil {
+		repo.DefaultBranch = &apiObj.MainBranch.Name
+	}
+	return repo
+}
+
+func repositoryToAPI(repo *gitprovider.RepositoryInfo, ref gitprovider.RepositoryRef) *Repository {
+	apiObj := &Repository{
+		Name: ref.GetRepository(),
+		SCM:  "git",
+	}
+	repositoryInfoToAPIObj(repo, apiObj)
+	return apiObj
+}
+
+func repositoryInfoToAPIObj(repo *gitprovider.RepositoryInfo, apiObj *Repository) {
+	if repo.Description != nil {
+		apiObj.Description = *repo.Description
+	}
+	if repo.DefaultBranch != nil {
+		apiObj.MainBranch = &BranchRef{Name: *repo.DefaultBranch}
+	}
+	if repo.Visibility != nil {
+		apiObj.IsPrivate = *repo.Visibility == gitprovider.RepositoryVisibilityPrivate
+	}
+	if repo.Homepage != nil {
+		apiObj.Website = *repo.Homepage
+	}
+	if repo.HasIssues != nil {
+		apiObj.HasIssues = *repo.HasIssues
+	}
+	if repo.HasWiki != nil {
+		apiObj.HasWiki = *repo.HasWiki
+	}
+}
+
+// unsupportedRepositoryFields are the gitprovider.RepositoryInfo fields Bitbucket has no
+// equivalent for.
+var unsupportedRepositoryFields = []string{
+	"topics", "archived", "hasProjects", "allowedMergeMethods", "deleteBranchOnMerge", "isTemplate",
+}
+
+// validateRepositorySupport returns ErrNoProviderSupport if info sets fields Bitbucket has no
+// equivalent for.
+func validateRepositorySupport(info gitprovider.RepositoryInfo) error {
+	return gitprovider.ValidateUnsupportedFields(ProviderID, info, unsupportedRepositoryFields...)
+}
+
+// This function copies over the fields that are part of create/update requests of a repository
+// i.e. the desired spec of the repository. This allows us to separate "spec" from "status" fields.
+// See also: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-put
+func newBitbucketRepositorySpec(repo *Repository) *bitbucketRepositorySpec {
+	return &bitbucketRepositorySpec{
+		&Repository{
+			// Generic
+			Name:        repo.Name,
+			SCM:         "git",
+			Description: repo.Description,
+			IsPrivate:   repo.IsPrivate,
+			HasIssues:   repo.HasIssues,
+			HasWiki:     repo.HasWiki,
+			ForkPolicy:  repo.ForkPolicy,
+			Language:    repo.Language,
+			Website:     repo.Website,
+
+			// Update-specific parameters
+			MainBranch: repo.MainBranch,
+		},
+	}
+}
+
+type bitbucketRepositorySpec struct {
+	*Repository
+}
+
+// Equals compares two bitbucketRepositorySpec objects for equality.
+func (s *bitbucketRepositorySpec) Equals(other *bitbucketRepositorySpec) bool {
+	return reflect.DeepEqual(s, other)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newTag(c *TagClient, tag *Tag) *tagType {
+	return &tagType{
+		t: *tag,
+		c: c,
+	}
+}
+
+var _ gitprovider.Tag = &tagType{}
+
+type tagType struct {
+	t Tag
+	c *TagClient
+}
+
+// Get returns the tag information.
+func (t *tagType) Get() gitprovider.TagInfo {
+	return tagFromAPI(&t.t)
+}
+
+// APIObject returns the underlying API object.
+func (t *tagType) APIObject() interface{} {
+	return &t.t
+}
+
+// Repository returns the repository that this tag belongs to.
+func (t *tagType) Repository() gitprovider.RepositoryRef {
+	return t.c.ref
+}
+
+// validateTagAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateTagAPI(apiObj *Tag) error {
+	return validateAPIObject("Bitbucket.Tag", func(validator validation.Validator) {
+		if apiObj.Name == "" {
+			validator.Required("Name")
+		}
+		if apiObj.Target == nil || apiObj.Target.Hash == "" {
+			validator.Required("Target.Hash")
+		}
+	})
+}
+
+func tagFromAPI(apiObj *Tag) gitprovider.TagInfo {
+	info := gitprovider.TagInfo{
+		Name: apiObj.Name,
+		Sha:  apiObj.Target.Hash,
+	}
+	if apiObj.Message != "" {
+		info.Message = gitprovider.StringVar(apiObj.Message)
+	}
+	return info
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// The types below mirror the subset of the Bitbucket Cloud 2.0 API objects used by this package.
+// go-bitbucket decodes most responses into untyped maps, so the provider defines its own
+// structs, see https://developer.atlassian.com/cloud/bitbucket/rest/intro/.
+
+// Link is a hyperlink to a related resource.
+type Link struct {
+	Href string `json:"href,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// Links holds the links of a Bitbucket object.
+type Links struct {
+	Self  *Link  `json:"self,omitempty"`
+	HTML  *Link  `json:"html,omitempty"`
+	Clone []Link `json:"clone,omitempty"`
+}
+
+// User is a Bitbucket account.
+type User struct {
+	UUID        string `json:"uuid,omitempty"`
+	Username    string `json:"username,omitempty"`
+	Nickname    string `json:"nickname,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	AccountID   string `json:"account_id,omitempty"`
+	Links       *Links `json:"links,omitempty"`
+}
+
+// Workspace is a Bitbucket workspace, the top-level owner of projects and repositories.
+type Workspace struct {
+	UUID      string `json:"uuid,omitempty"`
+	Slug      string `json:"slug,omitempty"`
+	Name      string `json:"name,omitempty"`
+	IsPrivate bool   `json:"is_private,omitempty"`
+	Links     *Links `json:"links,omitempty"`
+}
+
+// WorkspacePermission binds the authenticated user to a workspace.
+type WorkspacePermission struct {
+	Permission string     `json:"permission,omitempty"`
+	Workspace  *Workspace `json:"workspace,omitempty"`
+}
+
+// Project groups repositories inside a workspace.
+type Project struct {
+	UUID        string `json:"uuid,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	IsPrivate   bool   `json:"is_private,omitempty"`
+	Links       *Links `json:"links,omitempty"`
+}
+
+// BranchRef points to a branch by name.
+type BranchRef struct {
+	Name string `json:"name"`
+}
+
+// Repository is a Bitbucket repository.
+type Repository struct {
+	UUID        string     `json:"uuid,omitempty"`
+	Slug        string     `json:"slug,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	FullName    string     `json:"full_name,omitempty"`
+	Description string     `json:"description"`
+	SCM         string     `json:"scm,omitempty"`
+	IsPrivate   bool       `json:"is_private"`
+	HasIssues   bool       `json:"has_issues"`
+	HasWiki     bool       `json:"has_wiki"`
+	ForkPolicy  string     `json:"fork_policy,omitempty"`
+	Language    string     `json:"language,omitempty"`
+	Website     string     `json:"website"`
+	MainBranch  *BranchRef `json:"mainbranch,omitempty"`
+	Project     *Project   `json:"project,omitempty"`
+	Workspace   *Workspace `json:"workspace,omitempty"`
+	Links       *Links     `json:"links,omitempty"`
+	CreatedOn   *time.Time `json:"created_on,omitempty"`
+	UpdatedOn   *time.Time `json:"updated_on,omitempty"`
+}
+
+// DeployKey is a read-only SSH key granting access to a single repository.
+type DeployKey struct {
+	ID      int    `json:"id,omitempty"`
+	Key     string `json:"key"`
+	Label   string `json:"label"`
+	Comment string `json:"comment,omitempty"`
+	Links   *Links `json:"links,omitempty"`
+}
+
+// Group is a set of workspace members.
+type Group struct {
+	Slug     string `json:"slug,omitempty"`
+	Name     string `json:"name,omitempty"`
+	FullSlug string `json:"full_slug,omitempty"`
+}
+
+// GroupPermission is the permission a group has on a repository.
+type GroupPermission struct {
+	Permission string `json:"permission"`
+	Group      *Group `json:"group,omitempty"`
+}
+
+// UserPermission is the permission a user has on a repository.
+type UserPermission struct {
+	Permission string `json:"permission"`
+	User       *User  `json:"user,omitempty"`
+}
+
+// CommitAuthor is the author of a commit, as recorded in Git and (if known) the matching account.
+type CommitAuthor struct {
+	Raw  string `json:"raw,omitempty"`
+	User *User  `json:"user,omitempty"`
+}
+
+// CommitRef points to a commit by hash.
+type CommitRef struct {
+	Hash string `json:"hash"`
+}
+
+// Commit is a Git commit.
+type Commit struct {
+	Hash    string        `json:"hash"`
+	Message string        `json:"message,omitempty"`
+	Date    time.Time     `json:"date,omitempty"`
+	Author  *CommitAuthor `json:"author,omitempty"`
+	Parents []CommitRef   `json:"parents,omitempty"`
+	Links   *Links        `json:"links,omitempty"`
+}
+
+// Branch is a Git branch.
+type Branch struct {
+	Name   string  `json:"name"`
+	Target *Commit `json:"target,omitempty"`
+	Links  *Links  `json:"links,omitempty"`
+}
+
+// Tag is a Git tag.
+type Tag struct {
+	Name string `json:"name"`
+	// Message is the message of annotated tags, empty for lightweight tags.
+	Message string  `json:"message,omitempty"`
+	Target  *Commit `json:"target,omitempty"`
+	Links   *Links  `json:"links,omitempty"`
+}
+
+// PullRequestEndpoint is the source or destination of a pull request.
+type PullRequestEndpoint struct {
+	Branch     *BranchRef  `json:"branch,omitempty"`
+	Commit     *CommitRef  `json:"commit,omitempty"`
+	Repository *Repository `json:"repository,omitempty"`
+}
+
+// PullRequest is a Bitbucket pull request.
+type PullRequest struct {
+	ID                int                  `json:"id,omitempty"`
+	Title             string               `json:"title"`
+	Description       string               `json:"description,omitempty"`
+	State             string               `json:"state,omitempty"`
+	Draft             *bool                `json:"draft,omitempty"`
+	Source            *PullRequestEndpoint `json:"source,omitempty"`
+	Destination       *PullRequestEndpoint `json:"destination,omitempty"`
+	Author            *User                `json:"author,omitempty"`
+	Reviewers         []*User              `json:"reviewers,omitempty"`
+	Participants      []*Participant       `json:"participants,omitempty"`
+	MergeCommit       *CommitRef           `json:"merge_commit,omitempty"`
+	CloseSourceBranch bool                 `json:"close_source_branch,omitempty"`
+	CreatedOn         *time.Time           `json:"created_on,omitempty"`
+	UpdatedOn         *time.Time           `json:"updated_on,omitempty"`
+	Links             *Links               `json:"links,omitempty"`
+}
+
+// Participant is a user that took part in a pull request, e.g. by reviewing it.
+type Participant struct {
+	User     *User  `json:"user,omitempty"`
+	Role     string `json:"role,omitempty"`
+	Approved bool   `json:"approved,omitempty"`
+	// State is "approved", "changes_requested" or empty if the participant didn't review the pull request.
+	State          string     `json:"state,omitempty"`
+	ParticipatedOn *time.Time `json:"participated_on,omitempty"`
+}
+
+// CommentContent is the content of a comment.
+type CommentContent struct {
+	Raw string `json:"raw"`
+}
+
+// PullRequestComment is a comment of a pull request.
+type PullRequestComment struct {
+	ID        int             `json:"id,omitempty"`
+	Content   *CommentContent `json:"content,omitempty"`
+	User      *User           `json:"user,omitempty"`
+	Deleted   bool            `json:"deleted,omitempty"`
+	CreatedOn *time.Time      `json:"created_on,omitempty"`
+	UpdatedOn *time.Time      `json:"updated_on,omitempty"`
+	// Inline is the position of comments on the diff, nil for general comments.
+	Inline *json.RawMessage `json:"inline,omitempty"`
+	// Parent is the comment replied to, nil for top-level comments.
+	Parent *PullRequestComment `json:"parent,omitempty"`
+	Links  *Links              `json:"links,omitempty"`
+}
+
+// PullRequestMerge holds the parameters of a pull request merge.
+type PullRequestMerge struct {
+	Type              string `json:"type"`
+	Message           string `json:"message,omitempty"`
+	CloseSourceBranch bool   `json:"close_source_branch,omitempty"`
+	MergeStrategy     string `json:"merge_strategy,omitempty"`
+}
+
+// DiffStatFile is the path of a file in a diffstat entry.
+type DiffStatFile struct {
+	Path string `json:"path"`
+}
+
+// DiffStat is the change of a single file in a diff.
+type DiffStat struct {
+	Status       string        `json:"status"`
+	LinesAdded   int           `json:"lines_added"`
+	LinesRemoved int           `json:"lines_removed"`
+	Old          *DiffStatFile `json:"old,omitempty"`
+	New          *DiffStatFile `json:"new,omitempty"`
+}
+
+// TreeEntry is a file or directory in the source of a repository at a given commit.
+type TreeEntry struct {
+	Type       string     `json:"type"`
+	Path       string     `json:"path"`
+	Size       int        `json:"size,omitempty"`
+	Attributes []string   `json:"attributes,omitempty"`
+	Commit     *CommitRef `json:"commit,omitempty"`
+	Links      *Links     `json:"links,omitempty"`
+}
+
+// paginated is the envelope of all paginated Bitbucket responses.
+type paginated struct {
+	Values  json.RawMessage `json:"values"`
+	Next    string          `json:"next,omitempty"`
+	Page    int             `json:"page,omitempty"`
+	PageLen int             `json:"pagelen,omitempty"`
+	Size    int             `json:"size,omitempty"`
+}
+
+// apiError is the body of a failed Bitbucket API request.
+type apiError struct {
+	Type  string `json:"type"`
+	Error struct {
+		Message string `json:"message"`
+		Detail  string `json:"detail,omitempty"`
+	} `json:"error"`
+}
+
+// htmlURL returns the link to the web interface, if known.
+func (l *Links) htmlURL() string {
+	if l == nil || l.HTML == nil {
+		return ""
+	}
+	return l.HTML.Href
+}
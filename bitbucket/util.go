@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+const (
+	alreadyExistsMagicString = "already exists"
+)
+
+// validateUserRepositoryRef makes sure the UserRepositoryRef is valid for Bitbucket's usage.
+func validateUserRepositoryRef(ref gitprovider.UserRepositoryRef, expectedDomain string) error {
+	// Make sure the RepositoryRef fields are valid
+	if err := validation.ValidateTargets("UserRepositoryRef", ref); err != nil {
+		return err
+	}
+	// Make sure the type is valid, and domain is expected
+	return validateIdentityFields(ref, expectedDomain)
+}
+
+// validateOrgRepositoryRef makes sure the OrgRepositoryRef is valid for Bitbucket's usage.
+func validateOrgRepositoryRef(ref gitprovider.OrgRepositoryRef, expectedDomain string) error {
+	// Make sure the RepositoryRef fields are valid
+	if err := validation.ValidateTargets("OrgRepositoryRef", ref); err != nil {
+		return err
+	}
+	// Make sure the type is valid, and domain is expected
+	return validateIdentityFields(ref, expectedDomain)
+}
+
+// validateOrganizationRef makes sure the OrganizationRef is valid for Bitbucket's usage.
+// A sub-organization refers to a project in the workspace, so at most one level is allowed.
+func validateOrganizationRef(ref gitprovider.OrganizationRef, expectedDomain string) error {
+	// Make sure the OrganizationRef fields are valid
+	if err := validation.ValidateTargets("OrganizationRef", ref); err != nil {
+		return err
+	}
+	// Make sure the expected domain is used
+	if ref.GetDomain() != expectedDomain {
+		return fmt.Errorf("domain %q not supported by this client: %w", ref.GetDomain(), gitprovider.ErrDomainUnsupported)
+	}
+	if len(ref.SubOrganizations) > 1 {
+		return fmt.Errorf("bitbucket projects can't be nested: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
+
+// validateTopLevelOrganizationRef makes sure the OrganizationRef is valid for Bitbucket's usage,
+// and that it points to a workspace.
+func validateTopLevelOrganizationRef(ref gitprovider.OrganizationRef, expectedDomain string) error {
+	if err := validateOrganizationRef(ref, expectedDomain); err != nil {
+		return err
+	}
+	if len(ref.SubOrganizations) > 0 {
+		return gitprovider.ErrNotTopLevelOrganization
+	}
+	return nil
+}
+
+// validateUserRef makes sure the UserRef is valid for Bitbucket's usage.
+func validateUserRef(ref gitprovider.UserRef, expectedDomain string) error {
+	// Make sure the OrganizationRef fields are valid
+	if err := validation.ValidateTargets("UserRef", ref); err != nil {
+		return err
+	}
+	// Make sure the type is valid, and domain is expected
+	return validateIdentityFields(ref, expectedDomain)
+}
+
+// validateIdentityFields makes sure the type of the IdentityRef is supported, and the domain is as expected.
+func validateIdentityFields(ref gitprovider.IdentityRef, expectedDomain string) error {
+	// Make sure the expected domain is used
+	if ref.GetDomain() != expectedDomain {
+		return fmt.Errorf("domain %q not supported by this client: %w", ref.GetDomain(), gitprovider.ErrDomainUnsupported)
+	}
+	// Make sure the right type of identityref is used
+	switch ref.GetType() {
+	case gitprovider.IdentityTypeOrganization, gitprovider.IdentityTypeUser:
+		return nil
+	case gitprovider.IdentityTypeSuborganization:
+		return fmt.Errorf("bitbucket repositories belong to a workspace, not a project: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return fmt.Errorf("invalid identity type: %v: %w", ref.GetType(), gitprovider.ErrInvalidArgument)
+}
+
+// handleHTTPError checks the type of err, and returns typed variants of it
+// However, it _always_ keeps the original error too, and just wraps it in a MultiError
+// The consumer must use errors.Is and errors.As to check for equality and get data out of it.
+func handleHTTPError(res *http.Response, err error) error {
+	// Short-circuit quickly if possible, allow always piping through this function
+	if err == nil {
+		return nil
+	}
+	if res == nil {
+		// Do nothing, just pipe through the unknown err
+		return err
+	}
+	httpErr := gitprovider.HTTPError{
+		Response:         res,
+		ErrorMessage:     err.Error(),
+		Message:          err.Error(),
+		DocumentationURL: "https://developer.atlassian.com/cloud/bitbucket/rest/intro/",
+	}
+	switch res.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		// Check for invalid credentials, and return a typed error in that case
+		return validation.NewMultiError(err, &gitprovider.InvalidCredentialsError{HTTPError: httpErr})
+	case http.StatusNotFound:
+		return validation.NewMultiError(err, gitprovider.ErrNotFound)
+	case http.StatusConflict:
+		return validation.NewMultiError(err, gitprovider.ErrAlreadyExists)
+	case http.StatusTooManyRequests:
+		return validation.NewMultiError(err, &gitprovider.RateLimitError{HTTPError: httpErr})
+	case http.StatusBadRequest:
+		// Bitbucket reports duplicates as a generic bad request
+		if strings.Contains(strings.ToLower(err.Error()), alreadyExistsMagicString) {
+			return validation.NewMultiError(err, gitprovider.ErrAlreadyExists)
+		}
+	}
+	return validation.NewMultiError(err, &httpErr)
+}
+
+// validateAPIObject creates a Validatior with the specified name, gives it to fn, and
+// depending on if any error was registered with it; either returns nil, or a MultiError
+// with both the validation error and ErrInvalidServerData, to mark that the server data
+// was invalid.
+func validateAPIObject(name string, fn func(validation.Validator)) error {
+	v := validation.New(name)
+	fn(v)
+	// If there was a validation error, also mark it specifically as invalid server data
+	if err := v.Error(); err != nil {
+		return validation.NewMultiError(err, gitprovider.ErrInvalidServerData)
+	}
+	return nil
+}
+
+// validateUserAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateUserAPI(apiObj *User) error {
+	return validateAPIObject("Bitbucket.User", func(validator validation.Validator) {
+		if apiObj.Username == "" && apiObj.Nickname == "" {
+			validator.Required("Username")
+		}
+	})
+}
+
+// validateCommitAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateCommitAPI(apiObj *Commit) error {
+	return validateAPIObject("Bitbucket.Commit", func(validator validation.Validator) {
+		if apiObj.Hash == "" {
+			validator.Required("Hash")
+		}
+	})
+}
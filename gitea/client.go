@@ -17,8 +17,13 @@ limitations under the License.
 package gitea
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
 
 	"code.gitea.io/sdk/gitea"
@@ -67,11 +72,18 @@ func NewClient(token string, optFns ...gitprovider.ClientOption) (gitprovider.Cl
 		destructiveActions = *opts.EnableDestructiveAPICalls
 	}
 
-	return newClient(gt, domain, destructiveActions), nil
+	c := newClient(gt, domain, destructiveActions)
+	c.httpClient = httpClient
+	c.baseURL = strings.TrimSuffix(baseURL, "/")
+	c.token = token
+	if opts.DryRun != nil && *opts.DryRun {
+		c.dryRun = gitprovider.NewDryRun(destructiveActions, dryRunValidator{})
+	}
+	return c, nil
 }
 
 func newClient(c *gitea.Client, domain string, destructiveActions bool) *Client {
-	ctx := &clientContext{c, domain, destructiveActions}
+	ctx := &clientContext{c: c, domain: domain, destructiveActions: destructiveActions}
 	return &Client{
 		clientContext: ctx,
 		orgs: &OrganizationsClient{
@@ -90,6 +102,56 @@ type clientContext struct {
 	c                  *gitea.Client
 	domain             string
 	destructiveActions bool
+
+	// httpClient, baseURL and token are used for the endpoints the Gitea SDK doesn't support, see do.
+	httpClient *http.Client
+	baseURL    string
+	token      string
+}
+
+// do sends a request to the given path of the Gitea API, for the endpoints the Gitea SDK doesn't
+// support. body is sent as JSON, unless it's nil. Like the SDK, an error containing the message of
+// the response is returned if the request failed, which can be wrapped using handleHTTPError.
+func (c *clientContext) do(ctx context.Context, method, path string, body interface{}) (*gitea.Response, error) {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+"/api/v1"+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+	httpRes, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpRes.Body.Close()
+	res := &gitea.Response{Response: httpRes}
+	if httpRes.StatusCode/100 == 2 {
+		return res, nil
+	}
+	data, err := io.ReadAll(httpRes.Body)
+	if err != nil {
+		return res, fmt.Errorf("body read on HTTP error %d: %w", httpRes.StatusCode, err)
+	}
+	apiErr := struct {
+		Message string `json:"message"`
+	}{}
+	if err := json.Unmarshal(data, &apiErr); err != nil || apiErr.Message == "" {
+		return res, fmt.Errorf("%s: %s", httpRes.Status, string(data))
+	}
+	return res, errors.New(apiErr.Message)
 }
 
 // Client implements the gitprovider.Client interface.
@@ -102,6 +164,9 @@ type Client struct {
 	orgs      *OrganizationsClient
 	orgRepos  *OrgRepositoriesClient
 	userRepos *UserRepositoriesClient
+
+	// dryRun is set if the client was created using gitprovider.WithDryRun(true).
+	dryRun *gitprovider.DryRun
 }
 
 // SupportedDomain returns the domain endpoint for this client, e.g. "gitea.com", "gitea.dev.com" or
@@ -126,19 +191,34 @@ func (c *Client) Raw() interface{} {
 
 // Organizations returns the OrganizationsClient handling sets of organizations.
 func (c *Client) Organizations() gitprovider.OrganizationsClient {
+	if c.dryRun != nil {
+		return c.dryRun.Organizations(c.orgs)
+	}
 	return c.orgs
 }
 
 // OrgRepositories returns the OrgRepositoriesClient handling sets of repositories in an organization.
 func (c *Client) OrgRepositories() gitprovider.OrgRepositoriesClient {
+	if c.dryRun != nil {
+		return c.dryRun.OrgRepositories(c.orgRepos)
+	}
 	return c.orgRepos
 }
 
 // UserRepositories returns the UserRepositoriesClient handling sets of repositories for a user.
 func (c *Client) UserRepositories() gitprovider.UserRepositoriesClient {
+	if c.dryRun != nil {
+		return c.dryRun.UserRepositories(c.userRepos)
+	}
 	return c.userRepos
 }
 
+// DryRunPlan returns the Plan recording the write API calls skipped by this client, if it was
+// created using gitprovider.WithDryRun(true). Otherwise, nil is returned.
+func (c *Client) DryRunPlan() *gitprovider.Plan {
+	return c.dryRun.Plan()
+}
+
 // HasTokenPermission returns true if the given token has the given permissions.
 func (c *Client) HasTokenPermission(ctx context.Context, permission gitprovider.TokenPermission) (bool, error) {
 	return false, gitprovider.ErrNoProviderSupport
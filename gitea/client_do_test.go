@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestClientContextDo(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantErr     error
+		wantMessage string
+	}{
+		{
+			name:   "success",
+			status: http.StatusNoContent,
+		},
+		{
+			name:        "JSON error message",
+			status:      http.StatusUnprocessableEntity,
+			body:        `{"message":"branch already exists"}`,
+			wantErr:     gitprovider.ErrAlreadyExists,
+			wantMessage: "branch already exists",
+		},
+		{
+			name:        "non-JSON error body",
+			status:      http.StatusBadGateway,
+			body:        "upstream unavailable",
+			wantMessage: "502 Bad Gateway: upstream unavailable",
+		},
+		{
+			name:        "not found",
+			status:      http.StatusNotFound,
+			body:        `{"message":"The target couldn't be found."}`,
+			wantErr:     gitprovider.ErrNotFound,
+			wantMessage: "The target couldn't be found.",
+		},
+		{
+			name:        "invalid credentials",
+			status:      http.StatusUnauthorized,
+			body:        `{"message":"token is required"}`,
+			wantErr:     &gitprovider.InvalidCredentialsError{},
+			wantMessage: "token is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "token secret" {
+					t.Errorf("Authorization header = %q, want %q", got, "token secret")
+				}
+				req := map[string]string{}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Fatal(err)
+				}
+				if got := r.Method + " " + r.URL.Path + " " + req["name"]; got != "PATCH /api/v1/repos/fluxcd/flux2/branches/main trunk" {
+					t.Errorf("request = %q", got)
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+			c := &clientContext{httpClient: server.Client(), baseURL: server.URL, token: "secret"}
+
+			res, err := c.do(context.Background(), http.MethodPatch, "/repos/fluxcd/flux2/branches/main", map[string]string{"name": "trunk"})
+			if res == nil || res.StatusCode != tt.status {
+				t.Fatalf("do() response = %v, want status %d", res, tt.status)
+			}
+			err = handleHTTPError(res, err)
+			if tt.wantMessage == "" {
+				if err != nil {
+					t.Errorf("do() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantMessage) {
+				t.Errorf("do() error = %v, want message %q", err, tt.wantMessage)
+			}
+			var httpErr *gitprovider.HTTPError
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if !errors.As(err, &httpErr) || httpErr.Response.StatusCode != tt.status {
+					t.Errorf("do() error = %v, want a *gitprovider.HTTPError", err)
+				}
+			case *gitprovider.InvalidCredentialsError:
+				if !errors.As(err, &want) {
+					t.Errorf("do() error = %v, want a *gitprovider.InvalidCredentialsError", err)
+				}
+			default:
+				if !errors.Is(err, want) {
+					t.Errorf("do() error = %v, want %v", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPullRequestDisableAutoMerge(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path != "/api/v1/repos/fluxcd/flux2/pulls/1/merge" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"The target couldn't be found."}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	c := &PullRequestClient{
+		clientContext: &clientContext{httpClient: server.Client(), baseURL: server.URL},
+		ref: gitprovider.OrgRepositoryRef{
+			OrganizationRef: gitprovider.OrganizationRef{Domain: server.URL, Organization: "fluxcd"},
+			RepositoryName:  "flux2",
+		},
+	}
+
+	ctx := context.Background()
+	if err := c.DisableAutoMerge(ctx, 1); err != nil {
+		t.Errorf("DisableAutoMerge() error = %v", err)
+	}
+	if err := c.DisableAutoMerge(ctx, 2); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("DisableAutoMerge() error = %v, want ErrNotFound", err)
+	}
+	want := []string{"DELETE /api/v1/repos/fluxcd/flux2/pulls/1/merge", "DELETE /api/v1/repos/fluxcd/flux2/pulls/2/merge"}
+	if len(requests) != len(want) || requests[0] != want[0] || requests[1] != want[1] {
+		t.Errorf("DisableAutoMerge() requests = %v, want %v", requests, want)
+	}
+}
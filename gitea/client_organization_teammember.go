@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TeamMemberClient implements the gitprovider.TeamMemberClient interface.
+var _ gitprovider.TeamMemberClient = &TeamMemberClient{}
+
+// TeamMemberClient operates on the members of a specific team.
+// Gitea teams don't have maintainers, hence only the member role is supported.
+type TeamMemberClient struct {
+	*clientContext
+	teams  *TeamsClient
+	teamID int64
+}
+
+// List all members of the team, all of them having the member role.
+//
+// List returns all available members, using multiple paginated requests if needed.
+func (c *TeamMemberClient) List(ctx context.Context) ([]gitprovider.TeamMemberInfo, error) {
+	// GET /teams/{id}/members
+	apiObjs, err := c.teams.listTeamMembers(c.teamID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]gitprovider.TeamMemberInfo, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		members = append(members, gitprovider.TeamMemberInfo{
+			Login: apiObj.UserName,
+			Role:  gitprovider.TeamMemberRoleVar(gitprovider.TeamMemberRoleMember),
+		})
+	}
+	return members, nil
+}
+
+// Add adds the user to the team. Adding a user that already is a member is a no-op.
+//
+// ErrNoProviderSupport is returned for the maintainer role.
+func (c *TeamMemberClient) Add(ctx context.Context, req gitprovider.TeamMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+	if err := validateTeamMemberRole(req); err != nil {
+		return err
+	}
+	// PUT /teams/{id}/members/{username}
+	res, err := c.c.AddTeamMember(c.teamID, req.Login)
+	return handleHTTPError(res, err)
+}
+
+// Remove removes the user with the given login from the team.
+//
+// ErrNotFound is returned if the user isn't a member of the team.
+func (c *TeamMemberClient) Remove(ctx context.Context, login string) error {
+	// GET /teams/{id}/members/{username}
+	_, res, err := c.c.GetTeamMember(c.teamID, login)
+	if err != nil {
+		return handleHTTPError(res, err)
+	}
+	// DELETE /teams/{id}/members/{username}
+	res, err = c.c.RemoveTeamMember(c.teamID, login)
+	return handleHTTPError(res, err)
+}
+
+// Reconcile makes sure the users in req are members of the team, adding them as needed.
+// With the Exclusive option, the members of the team that aren't in req are removed.
+//
+// ErrNoProviderSupport is returned if any user in req has the maintainer role.
+func (c *TeamMemberClient) Reconcile(ctx context.Context, req []gitprovider.TeamMemberInfo, opts ...gitprovider.TeamMemberReconcileOption) (bool, error) {
+	for _, member := range req {
+		if err := validateTeamMemberRole(member); err != nil {
+			return false, err
+		}
+	}
+	return gitprovider.ReconcileTeamMembers(ctx, c, req, opts...)
+}
+
+// validateTeamMemberRole returns an error if info requests a role Gitea teams don't have.
+func validateTeamMemberRole(info gitprovider.TeamMemberInfo) error {
+	if info.Role != nil && *info.Role != gitprovider.TeamMemberRoleMember {
+		return fmt.Errorf("teams don't have maintainers, %q role: %w", *info.Role, gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
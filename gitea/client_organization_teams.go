@@ -18,6 +18,8 @@ package gitea
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -39,27 +41,23 @@ type TeamsClient struct {
 //
 // ErrNotFound is returned if the resource does not exist.
 func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Team, error) {
-	// GET /orgs/{org}/teams/{team_slug}/members
-	apiObjs, err := c.listOrgTeamMembers(c.ref.Organization, teamName)
+	apiObj, err := c.getTeam(c.ref.Organization, teamName)
+	if err != nil {
+		return nil, err
+	}
+	// GET /teams/{id}/members
+	apiObjs, err := c.listTeamMembers(apiObj.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Collect a list of the members' names.ÒÒ Login is validated to be non-nil in ListOrgTeamMembers.
+	// Collect a list of the members' names.
 	logins := make([]string, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
-		// Login is validated to be non-nil in ListOrgTeamMembers
 		logins = append(logins, apiObj.UserName)
 	}
 
-	return &team{
-		users: apiObjs,
-		info: gitprovider.TeamInfo{
-			Name:    teamName,
-			Members: logins,
-		},
-		ref: c.ref,
-	}, nil
+	return newTeam(c, apiObj, apiObjs, logins), nil
 }
 
 // List all teams (recursively, in terms of subgroups) within the specific organization.
@@ -88,32 +86,113 @@ func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
 	return teams, nil
 }
 
-// listOrgTeamMembers returns all of current team members of the given team.
-func (c *TeamsClient) listOrgTeamMembers(orgName, teamName string) ([]*gitea.User, error) {
+// Create creates a team with the given specifications. Members of req are ignored.
+//
+// The team gets read access to the code, issues, pull requests, releases and wiki of
+// the repositories it's added to. Gitea teams are visible to all members of the
+// organization, hence the secret privacy isn't supported.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamsClient) Create(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if err := validateTeamPrivacy(req); err != nil {
+		return nil, err
+	}
+
+	opts := gitea.CreateTeamOption{
+		Name:       req.Name,
+		Permission: gitea.AccessModeRead,
+		Units: []gitea.RepoUnitType{
+			gitea.RepoUnitCode,
+			gitea.RepoUnitIssues,
+			gitea.RepoUnitPulls,
+			gitea.RepoUnitReleases,
+			gitea.RepoUnitWiki,
+		},
+	}
+	if req.Description != nil {
+		opts.Description = *req.Description
+	}
+	// POST /orgs/{org}/teams
+	apiObj, res, err := c.c.CreateTeam(c.ref.Organization, opts)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newTeam(c, apiObj, nil, nil), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamsClient) Reconcile(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
+// getTeam returns the team of the given organization with the given name.
+func (c *TeamsClient) getTeam(orgName, teamName string) (*gitea.Team, error) {
 	teams, err := c.listOrgTeams(orgName)
 	if err != nil {
 		return nil, err
 	}
-	apiObjs := []*gitea.User{}
-	opts := gitea.ListTeamMembersOptions{}
 	for _, team := range teams {
 		if team.Name == teamName {
-			err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
-				pageObjs, resp, listErr := c.c.ListTeamMembers(team.ID, gitea.ListTeamMembersOptions{})
-				if len(pageObjs) > 0 {
-					apiObjs = append(apiObjs, pageObjs...)
-					return resp, listErr
-				}
-				return nil, nil
-			})
-			if err != nil {
-				return nil, err
-			}
-			return apiObjs, nil
+			return team, nil
 		}
 	}
+	return nil, fmt.Errorf("team %q: %w", teamName, gitprovider.ErrNotFound)
+}
+
+// listTeamMembers returns all of current team members of the team with the given ID.
+func (c *TeamsClient) listTeamMembers(teamID int64) ([]*gitea.User, error) {
+	opts := gitea.ListTeamMembersOptions{}
+	apiObjs := []*gitea.User{}
 
-	return nil, gitprovider.ErrNotFound
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /teams/{id}/members
+		pageObjs, resp, listErr := c.c.ListTeamMembers(teamID, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
 }
 
 // listOrgTeams returns all teams of the given organization the user has access to.
@@ -135,23 +214,3 @@ func (c *TeamsClient) listOrgTeams(orgName string) ([]*gitea.Team, error) {
 	}
 	return apiObjs, nil
 }
-
-var _ gitprovider.Team = &team{}
-
-type team struct {
-	users []*gitea.User
-	info  gitprovider.TeamInfo
-	ref   gitprovider.OrganizationRef
-}
-
-func (t *team) Get() gitprovider.TeamInfo {
-	return t.info
-}
-
-func (t *team) APIObject() interface{} {
-	return t.users
-}
-
-func (t *team) Organization() gitprovider.OrganizationRef {
-	return t.ref
-}
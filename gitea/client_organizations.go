@@ -18,6 +18,8 @@ package gitea
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -72,6 +74,119 @@ func (c *OrganizationsClient) List(ctx context.Context) ([]gitprovider.Organizat
 	return orgs, nil
 }
 
+// Create creates the organization with the given description and visibility. The internal
+// visibility maps to the limited visibility of Gitea, i.e. visible to all signed in users.
+//
+// The name of a Gitea organization is its login, hence the name in req must equal the
+// organization of the OrganizationRef.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrganizationsClient) Create(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, error) {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+	if err := validateOrganizationInfo(ref, req); err != nil {
+		return nil, err
+	}
+
+	opts := gitea.CreateOrgOption{
+		Name: ref.Organization,
+	}
+	if req.Description != nil {
+		opts.Description = *req.Description
+	}
+	if req.Visibility != nil {
+		opts.Visibility = organizationVisibilityToAPI(*req.Visibility)
+	}
+	// POST /orgs
+	apiObj, res, err := c.c.CreateOrg(opts)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newOrganization(c.clientContext, apiObj, ref), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrganizationsClient) Reconcile(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, bool, error) {
+	if err := validateOrganizationInfo(ref, req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, ref)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, ref, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Keep the fields that aren't part of OrganizationInfo
+	apiObj := actual.APIObject().(*gitea.Organization)
+	opts := gitea.EditOrgOption{
+		FullName:   apiObj.FullName,
+		Website:    apiObj.Website,
+		Location:   apiObj.Location,
+		Visibility: gitea.VisibleType(apiObj.Visibility),
+	}
+	if req.Description != nil {
+		opts.Description = *req.Description
+	}
+	if req.Visibility != nil {
+		opts.Visibility = organizationVisibilityToAPI(*req.Visibility)
+	}
+	// PATCH /orgs/{org}
+	res, err := c.c.EditOrg(ref.Organization, opts)
+	if err != nil {
+		return actual, true, handleHTTPError(res, err)
+	}
+	resp, err := c.Get(ctx, ref)
+	return resp, true, err
+}
+
+// Delete deletes the organization. Gitea refuses to delete organizations that still own repositories.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource doesn't exist.
+func (c *OrganizationsClient) Delete(ctx context.Context, ref gitprovider.OrganizationRef) error {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return err
+	}
+	// Don't allow deleting organizations if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete organization: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /orgs/{org}
+	res, err := c.c.DeleteOrg(ref.Organization)
+	return handleHTTPError(res, err)
+}
+
+// validateOrganizationInfo validates req, and returns an error if its name isn't the login
+// of the organization ref points to.
+func validateOrganizationInfo(ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) error {
+	if err := req.ValidateInfo(); err != nil {
+		return err
+	}
+	if *req.Name != ref.Organization {
+		return fmt.Errorf("the name of organization %q is its login, %q: %w", ref.Organization, *req.Name, gitprovider.ErrInvalidArgument)
+	}
+	return nil
+}
+
 // getOrg returns a specific organization the user has access to.
 func (c *OrganizationsClient) getOrg(orgName string) (*gitea.Organization, error) {
 	apiObj, res, err := c.c.GetOrg(orgName)
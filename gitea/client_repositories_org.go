@@ -53,7 +53,12 @@ func (c *OrgRepositoriesClient) Get(ctx context.Context, ref gitprovider.OrgRepo
 	if err != nil {
 		return nil, err
 	}
-	return newOrgRepository(c.clientContext, apiObj, ref), nil
+	// GET /repos/{owner}/{repo}/topics
+	topics, err := getRepoTopics(c.c, ref.GetIdentity(), ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	return newOrgRepository(c.clientContext, apiObj, topics, ref), nil
 }
 
 // List all repositories in the given organization.
@@ -75,7 +80,12 @@ func (c *OrgRepositoriesClient) List(ctx context.Context, ref gitprovider.Organi
 	repos := make([]gitprovider.OrgRepository, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
 		// apiObj is already validated at ListOrgRepos
-		repos = append(repos, newOrgRepository(c.clientContext, apiObj, gitprovider.OrgRepositoryRef{
+		// GET /repos/{owner}/{repo}/topics
+		topics, err := getRepoTopics(c.c, ref.Organization, apiObj.Name)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, newOrgRepository(c.clientContext, apiObj, topics, gitprovider.OrgRepositoryRef{
 			OrganizationRef: ref,
 			RepositoryName:  apiObj.Name,
 		}))
@@ -92,11 +102,11 @@ func (c *OrgRepositoriesClient) Create(ctx context.Context, ref gitprovider.OrgR
 		return nil, err
 	}
 
-	apiObj, err := createRepository(ctx, c.c, ref, ref.Organization, req, opts...)
+	apiObj, topics, err := createRepository(ctx, c.c, ref, ref.Organization, req, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return newOrgRepository(c.clientContext, apiObj, ref), nil
+	return newOrgRepository(c.clientContext, apiObj, topics, ref), nil
 }
 
 // Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
@@ -105,10 +115,16 @@ func (c *OrgRepositoriesClient) Create(ctx context.Context, ref gitprovider.OrgR
 // If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 // If req is already the actual state, this is a no-op (actionTaken == false).
 func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, ref, req, opts...)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.OrgRepositoriesClient.ReconcileWithChanges.
+func (c *OrgRepositoriesClient) ReconcileWithChanges(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, []gitprovider.FieldChange, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	actual, err := c.Get(ctx, ref)
@@ -116,15 +132,15 @@ func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.O
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
-			return resp, true, err
+			return resp, req.Diff(gitprovider.RepositoryInfo{}), err
 		}
 
 		// Unexpected path, Get should succeed or return NotFound
-		return nil, false, err
+		return nil, nil, err
 	}
 	// Run generic reconciliation
-	actionTaken, err := reconcileRepository(ctx, actual, req)
-	return actual, actionTaken, err
+	changes, err := reconcileRepository(ctx, actual, req)
+	return actual, changes, err
 }
 
 // getRepo returns the repository of the given owner by name.
@@ -133,6 +149,30 @@ func getRepo(c *gitea.Client, owner, repo string) (*gitea.Repository, error) {
 	return validateRepositoryAPIResp(apiObj, res, err)
 }
 
+// getRepoTopics returns the topics of the given repository.
+func getRepoTopics(c *gitea.Client, owner, repo string) ([]string, error) {
+	topics := []string{}
+	opts := gitea.ListRepoTopicsOptions{}
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		pageTopics, resp, listErr := c.ListRepoTopics(owner, repo, opts)
+		if len(pageTopics) == 0 && listErr == nil {
+			return nil, nil
+		}
+		topics = append(topics, pageTopics...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
+
+// setRepoTopics replaces the topics of the given repository.
+func setRepoTopics(c *gitea.Client, owner, repo string, topics []string) error {
+	res, err := c.SetRepoTopics(owner, repo, topics)
+	return handleHTTPError(res, err)
+}
+
 // listOrgRepos returns all repositories of the given organization the user has access to.
 func (c *OrgRepositoriesClient) listOrgRepos(org string) ([]*gitea.Repository, error) {
 	opts := gitea.ListOrgReposOptions{}
@@ -153,17 +193,22 @@ func (c *OrgRepositoriesClient) listOrgRepos(org string) ([]*gitea.Repository, e
 	return validateRepositoryObjects(apiObjs)
 }
 
-func createRepository(ctx context.Context, c *gitea.Client, ref gitprovider.RepositoryRef, orgName string, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryCreateOption) (*gitea.Repository, error) {
+// createRepository creates the repository with the settings of req, and returns it together with
+// its topics.
+func createRepository(ctx context.Context, c *gitea.Client, ref gitprovider.RepositoryRef, orgName string, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryCreateOption) (*gitea.Repository, []string, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if err := validateRepositorySupport(req); err != nil {
+		return nil, nil, err
 	}
 
 	// Assemble the options struct based on the given options
 	o, err := gitprovider.MakeRepositoryCreateOptions(opts...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Convert to the API object and apply the options
@@ -175,7 +220,29 @@ func createRepository(ctx context.Context, c *gitea.Client, ref gitprovider.Repo
 		apiOpts.License = knownLicenseTemplateMap[string(*o.LicenseTemplate)]
 	}
 
-	return createRepo(c, orgName, apiOpts)
+	apiObj, err := createRepo(c, orgName, apiOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Set the topics before the repository might get archived below, as that makes it read-only
+	topics := []string{}
+	if req.Topics != nil {
+		if err := setRepoTopics(c, ref.GetIdentity(), ref.GetRepository(), req.Topics); err != nil {
+			return nil, nil, err
+		}
+		topics = req.Topics
+	}
+	// Gitea only takes the other settings when editing the repository
+	if req.Homepage == nil && req.Archived == nil && req.HasIssues == nil && req.HasWiki == nil &&
+		req.HasProjects == nil && req.AllowedMergeMethods == nil {
+		return apiObj, topics, nil
+	}
+	repositoryInfoToAPIObj(&req, apiObj)
+	apiObj, err = updateRepo(c, ref.GetIdentity(), ref.GetRepository(), editRepoOption(apiObj))
+	if err != nil {
+		return nil, nil, err
+	}
+	return apiObj, topics, nil
 }
 
 func createRepo(c *gitea.Client, orgName string, apiOpts gitea.CreateRepoOption) (*gitea.Repository, error) {
@@ -203,17 +270,18 @@ func deleteRepo(c *gitea.Client, owner, repo string, destructiveActions bool) er
 	return handleHTTPError(resp, err)
 }
 
-func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
+func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) ([]gitprovider.FieldChange, error) {
 	// If the desired matches the actual state, just return the actual state
-	if req.Equals(actual.Get()) {
-		return false, nil
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return nil, nil
 	}
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
-		return false, err
+		return nil, err
 	}
 	// Apply the desired state by running Update
-	return true, actual.Update(ctx)
+	return changes, actual.Update(ctx)
 }
 
 func toCreateOpts(opts ...gitprovider.RepositoryReconcileOption) []gitprovider.RepositoryCreateOption {
@@ -46,7 +46,12 @@ func (c *UserRepositoriesClient) Get(ctx context.Context, ref gitprovider.UserRe
 	if err != nil {
 		return nil, err
 	}
-	return newUserRepository(c.clientContext, apiObj, ref), nil
+	// GET /repos/{owner}/{repo}/topics
+	topics, err := getRepoTopics(c.c, ref.GetIdentity(), ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	return newUserRepository(c.clientContext, apiObj, topics, ref), nil
 }
 
 // List all repositories in the given organization.
@@ -68,7 +73,12 @@ func (c *UserRepositoriesClient) List(ctx context.Context, ref gitprovider.UserR
 	repos := make([]gitprovider.UserRepository, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
 		// apiObj is already validated at ListUserRepos
-		repos = append(repos, newUserRepository(c.clientContext, apiObj, gitprovider.UserRepositoryRef{
+		// GET /repos/{owner}/{repo}/topics
+		topics, err := getRepoTopics(c.c, ref.UserLogin, apiObj.Name)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, newUserRepository(c.clientContext, apiObj, topics, gitprovider.UserRepositoryRef{
 			UserRef:        ref,
 			RepositoryName: apiObj.Name,
 		}))
@@ -132,7 +142,7 @@ func (c *UserRepositoriesClient) Create(ctx context.Context,
 		return nil, gitprovider.NewErrIncorrectUser(ref.GetIdentity())
 	}
 
-	apiObj, err := createRepository(ctx, c.c, ref, "", req, opts...)
+	apiObj, topics, err := createRepository(ctx, c.c, ref, "", req, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -142,7 +152,7 @@ func (c *UserRepositoriesClient) Create(ctx context.Context,
 	}
 	ref.UserLogin = apiObj.Owner.UserName
 
-	return newUserRepository(c.clientContext, apiObj, ref), nil
+	return newUserRepository(c.clientContext, apiObj, topics, ref), nil
 }
 
 // Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
@@ -151,10 +161,16 @@ func (c *UserRepositoriesClient) Create(ctx context.Context,
 // If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 // If req is already the actual state, this is a no-op (actionTaken == false).
 func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.UserRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.UserRepository, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, ref, req, opts...)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.UserRepositoriesClient.ReconcileWithChanges.
+func (c *UserRepositoriesClient) ReconcileWithChanges(ctx context.Context, ref gitprovider.UserRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.UserRepository, []gitprovider.FieldChange, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	actual, err := c.Get(ctx, ref)
@@ -162,14 +178,14 @@ func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
-			return resp, true, err
+			return resp, req.Diff(gitprovider.RepositoryInfo{}), err
 		}
 
 		// Unexpected path, Get should succeed or return NotFound
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	// Run generic reconciliation
-	actionTaken, err := reconcileRepository(ctx, actual, req)
-	return actual, actionTaken, err
+	changes, err := reconcileRepository(ctx, actual, req)
+	return actual, changes, err
 }
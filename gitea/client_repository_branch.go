@@ -18,6 +18,10 @@ package gitea
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -32,6 +36,38 @@ type BranchClient struct {
 	ref gitprovider.RepositoryRef
 }
 
+// Get returns the branch with the given name.
+//
+// ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Get(_ context.Context, branch string) (gitprovider.Branch, error) {
+	// GET /repos/{owner}/{repo}/branches/{branch}
+	apiObj, err := c.getBranch(c.ref.GetIdentity(), c.ref.GetRepository(), branch)
+	if err != nil {
+		return nil, err
+	}
+	return newBranch(c, apiObj), nil
+}
+
+// List lists all branches of the repository.
+//
+// List returns all available branches for the given repository,
+// using multiple paginated requests if needed.
+func (c *BranchClient) List(_ context.Context) ([]gitprovider.Branch, error) {
+	// GET /repos/{owner}/{repo}/branches
+	apiObjs, err := c.listBranches(c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Branch type
+	branches := make([]gitprovider.Branch, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at listBranches
+		branches = append(branches, newBranch(c, apiObj))
+	}
+	return branches, nil
+}
+
 // Create creates a branch with the given specifications.
 // Creating a branch from a commit is noy supported by Gitea, the sha refers to the branch to create from.
 // see: https://github.com/go-gitea/gitea/issues/22139
@@ -52,3 +88,81 @@ func (c *BranchClient) Create(ctx context.Context, branch, sha string) error {
 
 	return nil
 }
+
+// Delete deletes the branch with the given name irreversibly.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Delete(_ context.Context, branch string) error {
+	// Don't allow deleting branches if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete branch: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repos/{owner}/{repo}/branches/{branch}
+	_, resp, err := c.c.DeleteRepoBranch(c.ref.GetIdentity(), c.ref.GetRepository(), branch)
+	return handleHTTPError(resp, err)
+}
+
+// RenameDefault renames the default branch of the repository to the given name. Gitea retargets
+// the open pull requests into it, and moves its protection rules. Renaming branches requires Gitea
+// 1.23 or later.
+//
+// ErrAlreadyExists is returned if a branch with the given name already exists.
+func (c *BranchClient) RenameDefault(ctx context.Context, branch string) error {
+	owner, repoName := c.ref.GetIdentity(), c.ref.GetRepository()
+	if _, err := c.getBranch(owner, repoName, branch); err == nil {
+		return fmt.Errorf("branch %q: %w", branch, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return err
+	}
+
+	// GET /repos/{owner}/{repo}
+	repo, err := getRepo(c.c, owner, repoName)
+	if err != nil {
+		return err
+	}
+	// The Gitea SDK doesn't support renaming branches
+	// PATCH /repos/{owner}/{repo}/branches/{branch}
+	res, err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/repos/%s/%s/branches/%s",
+		url.PathEscape(owner), url.PathEscape(repoName), url.PathEscape(repo.DefaultBranch)), map[string]string{"name": branch})
+	return handleHTTPError(res, err)
+}
+
+// listBranches returns all branches of the given repository.
+func (c *BranchClient) listBranches(owner, repo string) ([]*gitea.Branch, error) {
+	opts := gitea.ListRepoBranchesOptions{}
+	apiObjs := []*gitea.Branch{}
+
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/branches
+		pageObjs, resp, listErr := c.c.ListRepoBranches(owner, repo, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateBranchAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+// getBranch returns the branch with the given name.
+func (c *BranchClient) getBranch(owner, repo, branch string) (*gitea.Branch, error) {
+	// GET /repos/{owner}/{repo}/branches/{branch}
+	apiObj, resp, err := c.c.GetRepoBranch(owner, repo, branch)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateBranchAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// BranchProtectionClient implements the gitprovider.BranchProtectionClient interface.
+var _ gitprovider.BranchProtectionClient = &BranchProtectionClient{}
+
+// BranchProtectionClient operates on the branch protections of a specific repository.
+type BranchProtectionClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the protection of the given branch.
+//
+// ErrNotFound is returned if the branch is not protected.
+func (c *BranchProtectionClient) Get(ctx context.Context, branch string) (gitprovider.BranchProtection, error) {
+	return c.get(ctx, branch)
+}
+
+func (c *BranchProtectionClient) get(_ context.Context, branch string) (*branchProtection, error) {
+	// GET /repos/{owner}/{repo}/branch_protections/{name}
+	apiObj, err := c.getBranchProtection(c.ref.GetIdentity(), c.ref.GetRepository(), branch)
+	if err != nil {
+		return nil, err
+	}
+	return newBranchProtection(c, apiObj), nil
+}
+
+// List lists all branch protections of the repository.
+//
+// List returns all available branch protections for the given repository,
+// using multiple paginated requests if needed.
+func (c *BranchProtectionClient) List(_ context.Context) ([]gitprovider.BranchProtection, error) {
+	// GET /repos/{owner}/{repo}/branch_protections
+	apiObjs, err := c.listBranchProtections(c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our BranchProtection type
+	protections := make([]gitprovider.BranchProtection, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at listBranchProtections
+		protections = append(protections, newBranchProtection(c, apiObj))
+	}
+	return protections, nil
+}
+
+// Create protects a branch with the given specifications.
+//
+// ErrAlreadyExists will be returned if the branch is already protected.
+func (c *BranchProtectionClient) Create(ctx context.Context, req gitprovider.BranchProtectionInfo) (gitprovider.BranchProtection, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if _, err := c.get(ctx, req.Branch); err == nil {
+		return nil, fmt.Errorf("branch protection for %q: %w", req.Branch, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	apiObj := &gitea.BranchProtection{}
+	if err := branchProtectionInfoToAPIObj(&req, apiObj); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/branch_protections
+	apiObj, err := c.createBranchProtection(c.ref.GetIdentity(), c.ref.GetRepository(), apiObj)
+	if err != nil {
+		return nil, err
+	}
+	return newBranchProtection(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *BranchProtectionClient) Reconcile(ctx context.Context, req gitprovider.BranchProtectionInfo) (gitprovider.BranchProtection, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the protection of the desired branch
+	actual, err := c.Get(ctx, req.Branch)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// listBranchProtections returns all branch protections of the given repository.
+func (c *BranchProtectionClient) listBranchProtections(owner, repo string) ([]*gitea.BranchProtection, error) {
+	opts := gitea.ListBranchProtectionsOptions{}
+	apiObjs := []*gitea.BranchProtection{}
+
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/branch_protections
+		pageObjs, resp, listErr := c.c.ListBranchProtections(owner, repo, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateBranchProtectionAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+// getBranchProtection returns the protection rule with the given name.
+func (c *BranchProtectionClient) getBranchProtection(owner, repo, name string) (*gitea.BranchProtection, error) {
+	// GET /repos/{owner}/{repo}/branch_protections/{name}
+	apiObj, resp, err := c.c.GetBranchProtection(owner, repo, name)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateBranchProtectionAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// createBranchProtection creates a protection rule for the branch of the given object.
+func (c *BranchProtectionClient) createBranchProtection(owner, repo string, req *gitea.BranchProtection) (*gitea.BranchProtection, error) {
+	opts := gitea.CreateBranchProtectionOption{
+		BranchName:                    req.BranchName,
+		RuleName:                      req.RuleName,
+		EnablePush:                    req.EnablePush,
+		EnablePushWhitelist:           req.EnablePushWhitelist,
+		PushWhitelistUsernames:        req.PushWhitelistUsernames,
+		PushWhitelistTeams:            req.PushWhitelistTeams,
+		PushWhitelistDeployKeys:       req.PushWhitelistDeployKeys,
+		EnableMergeWhitelist:          req.EnableMergeWhitelist,
+		MergeWhitelistUsernames:       req.MergeWhitelistUsernames,
+		MergeWhitelistTeams:           req.MergeWhitelistTeams,
+		EnableStatusCheck:             req.EnableStatusCheck,
+		StatusCheckContexts:           req.StatusCheckContexts,
+		RequiredApprovals:             req.RequiredApprovals,
+		EnableApprovalsWhitelist:      req.EnableApprovalsWhitelist,
+		ApprovalsWhitelistUsernames:   req.ApprovalsWhitelistUsernames,
+		ApprovalsWhitelistTeams:       req.ApprovalsWhitelistTeams,
+		BlockOnRejectedReviews:        req.BlockOnRejectedReviews,
+		BlockOnOfficialReviewRequests: req.BlockOnOfficialReviewRequests,
+		BlockOnOutdatedBranch:         req.BlockOnOutdatedBranch,
+		DismissStaleApprovals:         req.DismissStaleApprovals,
+		RequireSignedCommits:          req.RequireSignedCommits,
+		ProtectedFilePatterns:         req.ProtectedFilePatterns,
+		UnprotectedFilePatterns:       req.UnprotectedFilePatterns,
+	}
+	// POST /repos/{owner}/{repo}/branch_protections
+	apiObj, resp, err := c.c.CreateBranchProtection(owner, repo, opts)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateBranchProtectionAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// editBranchProtection updates the given protection rule, and returns it as seen by the server.
+func (c *BranchProtectionClient) editBranchProtection(owner, repo string, req *gitea.BranchProtection) (*gitea.BranchProtection, error) {
+	opts := gitea.EditBranchProtectionOption{
+		EnablePush:                    &req.EnablePush,
+		EnablePushWhitelist:           &req.EnablePushWhitelist,
+		PushWhitelistUsernames:        req.PushWhitelistUsernames,
+		PushWhitelistTeams:            req.PushWhitelistTeams,
+		PushWhitelistDeployKeys:       &req.PushWhitelistDeployKeys,
+		EnableMergeWhitelist:          &req.EnableMergeWhitelist,
+		MergeWhitelistUsernames:       req.MergeWhitelistUsernames,
+		MergeWhitelistTeams:           req.MergeWhitelistTeams,
+		EnableStatusCheck:             &req.EnableStatusCheck,
+		StatusCheckContexts:           req.StatusCheckContexts,
+		RequiredApprovals:             &req.RequiredApprovals,
+		EnableApprovalsWhitelist:      &req.EnableApprovalsWhitelist,
+		ApprovalsWhitelistUsernames:   req.ApprovalsWhitelistUsernames,
+		ApprovalsWhitelistTeams:       req.ApprovalsWhitelistTeams,
+		BlockOnRejectedReviews:        &req.BlockOnRejectedReviews,
+		BlockOnOfficialReviewRequests: &req.BlockOnOfficialReviewRequests,
+		BlockOnOutdatedBranch:         &req.BlockOnOutdatedBranch,
+		DismissStaleApprovals:         &req.DismissStaleApprovals,
+		RequireSignedCommits:          &req.RequireSignedCommits,
+		ProtectedFilePatterns:         &req.ProtectedFilePatterns,
+		UnprotectedFilePatterns:       &req.UnprotectedFilePatterns,
+	}
+	// PATCH /repos/{owner}/{repo}/branch_protections/{name}
+	apiObj, resp, err := c.c.EditBranchProtection(owner, repo, branchProtectionName(req), opts)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateBranchProtectionAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// deleteBranchProtection deletes the protection rule with the given name.
+func (c *BranchProtectionClient) deleteBranchProtection(owner, repo, name string) error {
+	// DELETE /repos/{owner}/{repo}/branch_protections/{name}
+	res, err := c.c.DeleteBranchProtection(owner, repo, name)
+	return handleHTTPError(res, err)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CollaboratorClient implements the gitprovider.CollaboratorClient interface.
+var _ gitprovider.CollaboratorClient = &CollaboratorClient{}
+
+// CollaboratorClient operates on the collaborators of a specific repository.
+type CollaboratorClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get the permission level of the user with the given login, if they are a collaborator
+// of the repository. Gitea adds collaborators right away, hence they are never pending.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *CollaboratorClient) Get(ctx context.Context, login string) (gitprovider.Collaborator, error) {
+	// GET /repos/{owner}/{repo}/collaborators/{collaborator}
+	ok, res, err := c.c.IsCollaborator(c.ref.GetIdentity(), c.ref.GetRepository(), login)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("collaborator %q: %w", login, gitprovider.ErrNotFound)
+	}
+	return c.getPermission(login)
+}
+
+// getPermission returns the collaborator with the permission the user has on the repository.
+func (c *CollaboratorClient) getPermission(login string) (*collaborator, error) {
+	// GET /repos/{owner}/{repo}/collaborators/{collaborator}/permission
+	apiObj, res, err := c.c.CollaboratorPermission(c.ref.GetIdentity(), c.ref.GetRepository(), login)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newCollaborator(c, login, apiObj), nil
+}
+
+// List lists the collaborators of the repository.
+//
+// List returns all available collaborators, using multiple paginated requests if needed.
+func (c *CollaboratorClient) List(ctx context.Context) ([]gitprovider.Collaborator, error) {
+	opts := gitea.ListCollaboratorsOptions{}
+	apiObjs := []*gitea.User{}
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/collaborators
+		pageObjs, resp, listErr := c.c.ListCollaborators(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := make([]gitprovider.Collaborator, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// The list doesn't contain the permissions, get them for every collaborator
+		collaborator, err := c.getPermission(apiObj.UserName)
+		if err != nil {
+			return nil, err
+		}
+		collaborators = append(collaborators, collaborator)
+	}
+	return collaborators, nil
+}
+
+// Create adds the user as a collaborator of the repository. Gitea only supports the pull,
+// push and admin permissions.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *CollaboratorClient) Create(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if _, err := c.Get(ctx, req.Login); err == nil {
+		return nil, fmt.Errorf("collaborator %q: %w", req.Login, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+	if err := c.add(req); err != nil {
+		return nil, err
+	}
+	return c.getPermission(req.Login)
+}
+
+// add adds the user as a collaborator, or changes the permission of an existing collaborator.
+func (c *CollaboratorClient) add(req gitprovider.CollaboratorInfo) error {
+	accessMode, err := collaboratorPermissionToAPI(*req.Permission)
+	if err != nil {
+		return err
+	}
+	// PUT /repos/{owner}/{repo}/collaborators/{collaborator}
+	res, err := c.c.AddCollaborator(c.ref.GetIdentity(), c.ref.GetRepository(), req.Login, gitea.AddCollaboratorOption{
+		Permission: &accessMode,
+	})
+	return handleHTTPError(res, err)
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *CollaboratorClient) Reconcile(ctx context.Context,
+	req gitprovider.CollaboratorInfo,
+) (gitprovider.Collaborator, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, req.Login)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
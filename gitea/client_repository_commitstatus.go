@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CommitStatusClient implements the gitprovider.CommitStatusClient interface.
+var _ gitprovider.CommitStatusClient = &CommitStatusClient{}
+
+// CommitStatusClient operates on the commit statuses for a specific repository.
+type CommitStatusClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists all statuses of the commit with the given sha.
+//
+// List returns all available statuses, using multiple paginated requests if needed.
+func (c *CommitStatusClient) List(_ context.Context, sha string) ([]gitprovider.CommitStatus, error) {
+	apiObjs, err := c.listStatuses(c.ref.GetIdentity(), c.ref.GetRepository(), sha)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]gitprovider.CommitStatus, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		statuses = append(statuses, newCommitStatus(c, apiObj))
+	}
+	return statuses, nil
+}
+
+// Create creates a status for the commit with the given sha.
+func (c *CommitStatusClient) Create(_ context.Context, sha string, req gitprovider.CommitStatusInfo) (gitprovider.CommitStatus, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	// POST /repos/{owner}/{repo}/statuses/{sha}
+	apiObj, resp, err := c.c.CreateStatus(c.ref.GetIdentity(), c.ref.GetRepository(), sha, commitStatusToAPI(&req))
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateCommitStatusAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newCommitStatus(c, apiObj), nil
+}
+
+// Combined returns the combined status of the commit with the given sha.
+func (c *CommitStatusClient) Combined(_ context.Context, sha string) (*gitprovider.CombinedCommitStatusInfo, error) {
+	// GET /repos/{owner}/{repo}/commits/{ref}/status
+	apiObj, resp, err := c.c.GetCombinedStatus(c.ref.GetIdentity(), c.ref.GetRepository(), sha)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+
+	combined := &gitprovider.CombinedCommitStatusInfo{
+		Sha:      sha,
+		State:    gitprovider.CommitStatusStatePending,
+		Statuses: make([]gitprovider.CommitStatusInfo, 0, len(apiObj.Statuses)),
+	}
+	// Gitea returns an empty state if there are no statuses
+	if apiObj.State != "" {
+		combined.State = commitStatusStates[apiObj.State]
+	}
+	for _, status := range apiObj.Statuses {
+		if err := validateCommitStatusAPI(status); err != nil {
+			return nil, err
+		}
+		combined.Statuses = append(combined.Statuses, commitStatusFromAPI(status))
+	}
+	return combined, nil
+}
+
+func (c *CommitStatusClient) listStatuses(owner, repo, sha string) ([]*gitea.Status, error) {
+	opts := gitea.ListStatusesOption{}
+	apiObjs := []*gitea.Status{}
+
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/commits/{ref}/statuses
+		pageObjs, resp, listErr := c.c.ListStatuses(owner, repo, sha, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateCommitStatusAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
@@ -106,10 +106,16 @@ func (c *DeployKeyClient) Create(ctx context.Context, req gitprovider.DeployKeyI
 // If req doesn't equal the actual state, the resource will be deleted and recreated (actionTaken == true).
 // If req is already the actual state, this is a no-op (actionTaken == false).
 func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployKeyInfo) (gitprovider.DeployKey, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, req)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.DeployKeyClient.ReconcileWithChanges.
+func (c *DeployKeyClient) ReconcileWithChanges(ctx context.Context, req gitprovider.DeployKeyInfo) (gitprovider.DeployKey, []gitprovider.FieldChange, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	// Get the key with the desired name
@@ -118,24 +124,32 @@ func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployK
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
 			resp, err := c.Create(ctx, req)
-			return resp, true, err
+			return resp, req.Diff(gitprovider.DeployKeyInfo{}), err
 		}
 
 		// Unexpected path, Get should succeed or return NotFound
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	// If the desired matches the actual state, just return the actual state
-	if req.Equals(actual.Get()) {
-		return actual, false, nil
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return actual, nil, nil
 	}
 
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
-		return actual, false, err
+		return actual, nil, err
 	}
 	// Apply the desired state by running Update
-	return actual, true, actual.Update(ctx)
+	return actual, changes, actual.Update(ctx)
+}
+
+// ReconcileAll implements gitprovider.DeployKeyClient.ReconcileAll.
+func (c *DeployKeyClient) ReconcileAll(ctx context.Context,
+	req []gitprovider.DeployKeyInfo, opts ...gitprovider.ReconcileAllOption,
+) (*gitprovider.ReconcileReport, error) {
+	return gitprovider.ReconcileAllDeployKeys(ctx, c, c.destructiveActions, req, opts...)
 }
 
 // listKeys returns all deploy keys of the given repository.
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IssueClient implements the gitprovider.IssueClient interface.
+var _ gitprovider.IssueClient = &IssueClient{}
+
+// IssueClient operates on the issues of a specific repository.
+type IssueClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the issue with the given number.
+//
+// ErrNotFound is returned if the issue does not exist, or is a pull request.
+func (c *IssueClient) Get(_ context.Context, number int) (gitprovider.Issue, error) {
+	// GET /repos/{owner}/{repo}/issues/{index}
+	apiObj, res, err := c.c.GetIssue(c.ref.GetIdentity(), c.ref.GetRepository(), int64(number))
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	// Pull requests are issues too in Gitea
+	if apiObj.PullRequest != nil {
+		return nil, fmt.Errorf("issue %d is a pull request: %w", number, gitprovider.ErrNotFound)
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// List lists the issues in the repository, by default only the open ones. All filters are supported.
+//
+// List returns all available issues, using multiple paginated requests if needed.
+func (c *IssueClient) List(_ context.Context, optFns ...gitprovider.IssueListOption) ([]gitprovider.Issue, error) {
+	opts, err := gitprovider.MakeIssueListOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+	// The issue states are named the same in Gitea
+	apiOpts := gitea.ListIssueOption{
+		State:  gitea.StateType(*opts.State),
+		Type:   gitea.IssueTypeIssue,
+		Labels: opts.Labels,
+	}
+	if opts.Assignee != nil {
+		apiOpts.AssignedBy = *opts.Assignee
+	}
+
+	issues := []gitprovider.Issue{}
+	err = allPages(&apiOpts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/issues
+		pageObjs, resp, listErr := c.c.ListRepoIssues(c.ref.GetIdentity(), c.ref.GetRepository(), apiOpts)
+		if len(pageObjs) > 0 {
+			for _, apiObj := range pageObjs {
+				// Gitea lists the issues that have any of the labels, hence filter them here too
+				if opts.Matches(issueFromAPI(apiObj)) {
+					issues = append(issues, newIssue(c, apiObj))
+				}
+			}
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// Create opens an issue with the given specifications.
+func (c *IssueClient) Create(_ context.Context, req gitprovider.IssueInfo) (gitprovider.Issue, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
+	apiReq := gitea.CreateIssueOption{
+		Title:     req.Title,
+		Body:      req.Description,
+		Assignees: req.Assignees,
+	}
+	if len(req.Labels) != 0 {
+		ids, err := c.labelIDs(owner, repo, req.Labels)
+		if err != nil {
+			return nil, err
+		}
+		apiReq.Labels = ids
+	}
+	if req.Milestone != "" {
+		id, err := c.milestoneID(req.Milestone)
+		if err != nil {
+			return nil, err
+		}
+		apiReq.Milestone = id
+	}
+
+	// POST /repos/{owner}/{repo}/issues
+	apiObj, res, err := c.c.CreateIssue(owner, repo, apiReq)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// Edit changes an existing issue. All options are supported.
+func (c *IssueClient) Edit(_ context.Context, number int, opts gitprovider.IssueEditOptions) (gitprovider.Issue, error) {
+	if err := opts.ValidateOptions(); err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
+	apiReq := gitea.EditIssueOption{
+		Body:      opts.Description,
+		Assignees: opts.Assignees,
+	}
+	if opts.Title != nil {
+		apiReq.Title = *opts.Title
+	}
+	if opts.State != nil {
+		// The "open" and "closed" states are named the same in Gitea
+		state := gitea.StateType(*opts.State)
+		apiReq.State = &state
+	}
+	if opts.Milestone != nil {
+		// The milestone is removed by setting its ID to 0
+		var id int64
+		if *opts.Milestone != "" {
+			var err error
+			if id, err = c.milestoneID(*opts.Milestone); err != nil {
+				return nil, err
+			}
+		}
+		apiReq.Milestone = &id
+	}
+	// The labels are set through another endpoint, hence check them before changing anything
+	var labelIDs []int64
+	if opts.Labels != nil {
+		var err error
+		if labelIDs, err = c.labelIDs(owner, repo, opts.Labels); err != nil {
+			return nil, err
+		}
+	}
+
+	// PATCH /repos/{owner}/{repo}/issues/{index}
+	apiObj, res, err := c.c.EditIssue(owner, repo, int64(number), apiReq)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	if opts.Labels != nil {
+		// PUT /repos/{owner}/{repo}/issues/{index}/labels
+		labels, res, err := c.c.ReplaceIssueLabels(owner, repo, int64(number), gitea.IssueLabelsOption{Labels: labelIDs})
+		if err != nil {
+			return nil, handleHTTPError(res, err)
+		}
+		apiObj.Labels = labels
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// Close closes the issue.
+func (c *IssueClient) Close(ctx context.Context, number int) (gitprovider.Issue, error) {
+	return c.Edit(ctx, number, gitprovider.IssueEditOptions{
+		State: gitprovider.IssueStateVar(gitprovider.IssueStateClosed),
+	})
+}
+
+// milestoneID returns the ID of the milestone with the given title.
+func (c *IssueClient) milestoneID(title string) (int64, error) {
+	// GET /repos/{owner}/{repo}/milestones/{name}
+	milestone, res, err := c.c.GetMilestoneByName(c.ref.GetIdentity(), c.ref.GetRepository(), title)
+	if err != nil {
+		return 0, fmt.Errorf("milestone %q: %w", title, handleHTTPError(res, err))
+	}
+	return milestone.ID, nil
+}
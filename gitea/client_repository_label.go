@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// LabelClient implements the gitprovider.LabelClient interface.
+var _ gitprovider.LabelClient = &LabelClient{}
+
+// LabelClient operates on the labels of a specific repository.
+type LabelClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the label with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *LabelClient) Get(ctx context.Context, name string) (gitprovider.Label, error) {
+	return c.get(name)
+}
+
+func (c *LabelClient) get(name string) (*label, error) {
+	labels, err := c.list()
+	if err != nil {
+		return nil, err
+	}
+	// Gitea has no endpoint to get a label by name, hence loop through all of them
+	for _, l := range labels {
+		if l.l.Name == name {
+			return l, nil
+		}
+	}
+	return nil, fmt.Errorf("label %q: %w", name, gitprovider.ErrNotFound)
+}
+
+// List lists all labels of the repository. Labels of the organization aren't included.
+//
+// List returns all available labels for the given repository,
+// using multiple paginated requests if needed.
+func (c *LabelClient) List(ctx context.Context) ([]gitprovider.Label, error) {
+	ls, err := c.list()
+	if err != nil {
+		return nil, err
+	}
+	// Cast to the generic []gitprovider.Label
+	labels := make([]gitprovider.Label, 0, len(ls))
+	for _, l := range ls {
+		labels = append(labels, l)
+	}
+	return labels, nil
+}
+
+func (c *LabelClient) list() ([]*label, error) {
+	opts := gitea.ListLabelsOptions{}
+	apiObjs := []*gitea.Label{}
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/labels
+		pageObjs, resp, listErr := c.c.ListRepoLabels(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Label type
+	labels := make([]*label, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if err := validateLabelAPI(apiObj); err != nil {
+			return nil, err
+		}
+		labels = append(labels, newLabel(c, apiObj))
+	}
+	return labels, nil
+}
+
+// Create creates a label with the given specifications.
+//
+// ErrAlreadyExists will be returned if a label with the same name already exists.
+func (c *LabelClient) Create(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// Gitea allows labels with the same name, hence check for duplicates
+	if _, err := c.get(req.Name); err == nil {
+		return nil, fmt.Errorf("label %q: %w", req.Name, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	apiObj, err := c.createLabel(labelToAPI(&req))
+	if err != nil {
+		return nil, err
+	}
+	return newLabel(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *LabelClient) Reconcile(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the label with the desired name
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// ReconcileAll implements gitprovider.LabelClient.ReconcileAll.
+func (c *LabelClient) ReconcileAll(ctx context.Context, req []gitprovider.LabelInfo, opts ...gitprovider.ReconcileAllOption) (*gitprovider.ReconcileReport, error) {
+	return gitprovider.ReconcileAllLabels(ctx, c, c.destructiveActions, req, opts...)
+}
+
+// createLabel creates a label in the repository, and returns it as seen by the server.
+func (c *LabelClient) createLabel(req *gitea.Label) (*gitea.Label, error) {
+	// POST /repos/{owner}/{repo}/labels
+	apiObj, resp, err := c.c.CreateLabel(c.ref.GetIdentity(), c.ref.GetRepository(), gitea.CreateLabelOption{
+		Name:        req.Name,
+		Color:       req.Color,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateLabelAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// editLabel updates the given label, and returns it as seen by the server.
+func (c *LabelClient) editLabel(req *gitea.Label) (*gitea.Label, error) {
+	// PATCH /repos/{owner}/{repo}/labels/{id}
+	apiObj, resp, err := c.c.EditLabel(c.ref.GetIdentity(), c.ref.GetRepository(), req.ID, gitea.EditLabelOption{
+		Name:        &req.Name,
+		Color:       &req.Color,
+		Description: &req.Description,
+	})
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateLabelAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// deleteLabel deletes the label with the given ID from the repository.
+func (c *LabelClient) deleteLabel(id int64) error {
+	// Don't allow deleting labels if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete label: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repos/{owner}/{repo}/labels/{id}
+	resp, err := c.c.DeleteLabel(c.ref.GetIdentity(), c.ref.GetRepository(), id)
+	return handleHTTPError(resp, err)
+}
@@ -19,6 +19,8 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -33,23 +35,56 @@ type PullRequestClient struct {
 	ref gitprovider.RepositoryRef
 }
 
-// List lists all pull requests in the repository
-func (c *PullRequestClient) List(ctx context.Context) ([]gitprovider.PullRequest, error) {
-	opts := gitea.ListPullRequestsOptions{}
-	prs, _, err := c.c.ListRepoPullRequests(c.ref.GetIdentity(), c.ref.GetRepository(), opts)
+// List lists the pull requests in the repository, by default only the open ones.
+//
+// List returns all available pull requests, using multiple paginated requests if needed.
+func (c *PullRequestClient) List(_ context.Context, optFns ...gitprovider.PullRequestListOption) ([]gitprovider.PullRequest, error) {
+	opts, err := gitprovider.MakePullRequestListOptions(optFns...)
 	if err != nil {
 		return nil, err
 	}
+	// Gitea only filters by open and closed, merged pull requests are closed ones
+	apiOpts := gitea.ListPullRequestsOptions{State: gitea.StateAll}
+	switch *opts.State {
+	case gitprovider.PullRequestStateOpen:
+		apiOpts.State = gitea.StateOpen
+	case gitprovider.PullRequestStateClosed, gitprovider.PullRequestStateMerged:
+		apiOpts.State = gitea.StateClosed
+	}
 
-	requests := make([]gitprovider.PullRequest, len(prs))
-
-	for idx, pr := range prs {
-		requests[idx] = newPullRequest(c.clientContext, pr)
+	// GET /repos/{owner}/{repo}/pulls
+	prs, err := c.listPullRequests(c.ref.GetIdentity(), c.ref.GetRepository(), apiOpts)
+	if err != nil {
+		return nil, err
 	}
 
+	requests := make([]gitprovider.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		if opts.Matches(pullrequestFromAPI(pr)) {
+			requests = append(requests, newPullRequest(c, pr))
+		}
+	}
 	return requests, nil
 }
 
+// listPullRequests returns all pull requests of the given repository matching opts.
+func (c *PullRequestClient) listPullRequests(owner, repo string, opts gitea.ListPullRequestsOptions) ([]*gitea.PullRequest, error) {
+	apiObjs := []*gitea.PullRequest{}
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/pulls
+		pageObjs, resp, listErr := c.c.ListRepoPullRequests(owner, repo, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
 // Create creates a pull request with the given specifications.
 func (c *PullRequestClient) Create(ctx context.Context, title, branch, baseBranch, description string) (gitprovider.PullRequest, error) {
 	prOpts := gitea.CreatePullRequestOption{
@@ -64,49 +99,219 @@ func (c *PullRequestClient) Create(ctx context.Context, title, branch, baseBranc
 		return nil, err
 	}
 
-	return newPullRequest(c.clientContext, pr), nil
+	return newPullRequest(c, pr), nil
 }
 
 // Get retrieves an existing pull request by number
 func (c *PullRequestClient) Get(ctx context.Context, number int) (gitprovider.PullRequest, error) {
-	pr, _, err := c.c.GetPullRequest(c.ref.GetIdentity(), c.ref.GetRepository(), int64(number))
+	// GET /repos/{owner}/{repo}/pulls/{index}
+	pr, res, err := c.c.GetPullRequest(c.ref.GetIdentity(), c.ref.GetRepository(), int64(number))
 	if err != nil {
-		return nil, err
+		return nil, handleHTTPError(res, err)
 	}
 
-	return newPullRequest(c.clientContext, pr), nil
+	return newPullRequest(c, pr), nil
 }
 
 // Edit modifies an existing PR. Please refer to "EditOptions" for details on which data can be edited.
+// All options are supported, drafts are marked using the "WIP: " title prefix.
 func (c *PullRequestClient) Edit(ctx context.Context, number int, opts gitprovider.EditOptions) (gitprovider.PullRequest, error) {
-	editPR := gitea.EditPullRequestOption{}
-	editPR.Title = *opts.Title
-	editedPR, _, err := c.c.EditPullRequest(c.ref.GetIdentity(), c.ref.GetRepository(), int64(number), editPR)
+	if err := opts.ValidateOptions(); err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
+	// Gitea clears the body if it's not sent, hence start from the current state
+	// GET /repos/{owner}/{repo}/pulls/{index}
+	pr, res, err := c.c.GetPullRequest(owner, repo, int64(number))
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	editPR := gitea.EditPullRequestOption{
+		Title:     pr.Title,
+		Body:      pr.Body,
+		Assignees: opts.Assignees,
+	}
+	if opts.Title != nil {
+		editPR.Title = *opts.Title
+	}
+	if opts.Description != nil {
+		editPR.Body = *opts.Description
+	}
+	if opts.Draft != nil {
+		editPR.Title = draftTitle(editPR.Title, *opts.Draft)
+	}
+	if opts.TargetBranch != nil {
+		editPR.Base = *opts.TargetBranch
+	}
+	if opts.State != nil {
+		state := gitea.StateOpen
+		if *opts.State == gitprovider.PullRequestStateClosed {
+			state = gitea.StateClosed
+		}
+		editPR.State = &state
+	}
+	if opts.Labels != nil {
+		editPR.Labels, err = c.labelIDs(owner, repo, opts.Labels)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// PATCH /repos/{owner}/{repo}/pulls/{index}
+	editedPR, res, err := c.c.EditPullRequest(owner, repo, int64(number), editPR)
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+
+	if len(opts.Reviewers) != 0 {
+		// POST /repos/{owner}/{repo}/pulls/{index}/requested_reviewers
+		res, err := c.c.CreateReviewRequests(owner, repo, int64(number), gitea.PullReviewRequestOptions{Reviewers: opts.Reviewers})
+		if err != nil {
+			return nil, handleHTTPError(res, err)
+		}
+	}
+	return newPullRequest(c, editedPR), nil
+}
+
+// labelIDs maps the given label names to the IDs of the labels of the repository.
+func (c *clientContext) labelIDs(owner, repo string, names []string) ([]int64, error) {
+	opts := gitea.ListLabelsOptions{}
+	labels := map[string]int64{}
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/labels
+		pageObjs, resp, listErr := c.c.ListRepoLabels(owner, repo, opts)
+		if len(pageObjs) > 0 {
+			for _, label := range pageObjs {
+				labels[label.Name] = label.ID
+			}
+			return resp, listErr
+		}
+		return nil, listErr
+	})
 	if err != nil {
 		return nil, err
 	}
-	return newPullRequest(c.clientContext, editedPR), nil
+	ids := make([]int64, 0, len(names))
+	for _, name := range names {
+		id, ok := labels[name]
+		if !ok {
+			return nil, fmt.Errorf("label %q: %w", name, gitprovider.ErrNotFound)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
 }
 
-// Merge merges a pull request with the given specifications.
-// Supported merge methods are: MergeMethodMerge and MergeMethodSquash
-func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod, message string) error {
+// Merge merges a pull request with the given specifications. All merge methods and options are supported.
+func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod, message string, optFns ...gitprovider.PullRequestMergeOption) (*gitprovider.PullRequestMergeResult, error) {
+	opts, err := gitprovider.MakePullRequestMergeOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
 	mergeOpts := gitea.MergePullRequestOption{
 		Style:   gitea.MergeStyle(mergeMethod),
 		Message: message,
 	}
+	if opts.ExpectedHeadSha != nil {
+		// Older Gitea versions ignore the head commit, hence check it here too
+		// GET /repos/{owner}/{repo}/pulls/{index}
+		pr, res, err := c.c.GetPullRequest(owner, repo, int64(number))
+		if err != nil {
+			return nil, handleHTTPError(res, err)
+		}
+		if pr.Head != nil && pr.Head.Sha != *opts.ExpectedHeadSha {
+			return nil, gitprovider.NewHeadChangedError(number, *opts.ExpectedHeadSha, pr.Head.Sha)
+		}
+		mergeOpts.HeadCommitId = *opts.ExpectedHeadSha
+	}
+	if opts.DeleteSourceBranch != nil {
+		mergeOpts.DeleteBranchAfterMerge = *opts.DeleteSourceBranch
+	}
 
-	done, resp, err := c.c.MergePullRequest(c.ref.GetIdentity(), c.ref.GetRepository(), int64(number), mergeOpts)
+	// POST /repos/{owner}/{repo}/pulls/{index}/merge
+	done, resp, err := c.c.MergePullRequest(owner, repo, int64(number), mergeOpts)
 	if err != nil {
-		return handleHTTPError(resp, err)
+		return nil, handleHTTPError(resp, err)
 	}
 
 	if !done {
-		if resp.StatusCode != 200 {
-			return fmt.Errorf("merge failed: %s", resp.Status)
+		switch resp.StatusCode {
+		// Gitea responds with 405 if the pull request isn't mergeable, e.g. due to conflicts or
+		// unsatisfied status checks, and with 409 if its head changed
+		case http.StatusConflict, http.StatusMethodNotAllowed:
+			return nil, &gitprovider.ConflictError{
+				HTTPError: gitprovider.HTTPError{
+					Response:     resp.Response,
+					ErrorMessage: fmt.Sprintf("merge failed: %s", resp.Status),
+					Message:      resp.Status,
+				},
+			}
+		case http.StatusOK:
+			return nil, fmt.Errorf("merge failed")
+		default:
+			return nil, fmt.Errorf("merge failed: %s", resp.Status)
+		}
+	}
+
+	// GET /repos/{owner}/{repo}/pulls/{index}
+	pr, res, err := c.c.GetPullRequest(owner, repo, int64(number))
+	if err != nil {
+		return nil, handleHTTPError(res, err)
+	}
+	result := &gitprovider.PullRequestMergeResult{}
+	if pr.MergedCommitID != nil {
+		result.Sha = *pr.MergedCommitID
+	}
+	return result, nil
+}
+
+// EnableAutoMerge schedules the pull request to be merged once its status checks succeeded,
+// or merges it right away if they already did.
+func (c *PullRequestClient) EnableAutoMerge(_ context.Context, number int, mergeMethod gitprovider.MergeMethod) error {
+	if err := validateAutoMergeMethod(mergeMethod); err != nil {
+		return err
+	}
+	mergeOpts := gitea.MergePullRequestOption{
+		Style:                  gitea.MergeStyle(mergeMethod),
+		MergeWhenChecksSucceed: true,
+	}
+	// POST /repos/{owner}/{repo}/pulls/{index}/merge
+	_, resp, err := c.c.MergePullRequest(c.ref.GetIdentity(), c.ref.GetRepository(), int64(number), mergeOpts)
+	if err != nil {
+		return handleHTTPError(resp, err)
+	}
+	// Gitea responds with 200 if it merged right away, and with 201 if it scheduled the merge
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	case http.StatusConflict, http.StatusMethodNotAllowed:
+		return &gitprovider.ConflictError{
+			HTTPError: gitprovider.HTTPError{
+				Response:     resp.Response,
+				ErrorMessage: fmt.Sprintf("enabling auto-merge failed: %s", resp.Status),
+				Message:      resp.Status,
+			},
 		}
-		return fmt.Errorf("merge failed")
+	default:
+		return fmt.Errorf("enabling auto-merge failed: %s", resp.Status)
 	}
+}
 
+// validateAutoMergeMethod validates the merge method of auto-merge.
+func validateAutoMergeMethod(mergeMethod gitprovider.MergeMethod) error {
+	if err := gitprovider.ValidateMergeMethod(mergeMethod); err != nil {
+		return fmt.Errorf("unknown merge method %q: %w", mergeMethod, gitprovider.ErrInvalidArgument)
+	}
 	return nil
 }
+
+// DisableAutoMerge cancels the scheduled merge of the pull request.
+func (c *PullRequestClient) DisableAutoMerge(ctx context.Context, number int) error {
+	// The Gitea SDK doesn't support cancelling scheduled merges
+	// DELETE /repos/{owner}/{repo}/pulls/{index}/merge
+	res, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/repos/%s/%s/pulls/%d/merge",
+		url.PathEscape(c.ref.GetIdentity()), url.PathEscape(c.ref.GetRepository()), number), nil)
+	return handleHTTPError(res, err)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestPullRequestMergeError(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		wantConflict bool
+	}{
+		{
+			name:         "head changed",
+			status:       http.StatusConflict,
+			wantConflict: true,
+		},
+		{
+			name:         "not mergeable",
+			status:       http.StatusMethodNotAllowed,
+			wantConflict: true,
+		},
+		{
+			name:   "server error",
+			status: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/v1/repos/fluxcd/flux2/pulls/1/merge" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"message":"Please try again later"}`)
+			}))
+			defer server.Close()
+			gt, err := gitea.NewClient(server.URL, gitea.SetGiteaVersion(""))
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := &PullRequestClient{
+				clientContext: &clientContext{c: gt},
+				ref: gitprovider.OrgRepositoryRef{
+					OrganizationRef: gitprovider.OrganizationRef{Domain: server.URL, Organization: "fluxcd"},
+					RepositoryName:  "flux2",
+				},
+			}
+
+			_, err = c.Merge(context.Background(), 1, gitprovider.MergeMethodMerge, "")
+			if err == nil {
+				t.Fatal("Merge() error = nil")
+			}
+			var conflict *gitprovider.ConflictError
+			if got := errors.As(err, &conflict); got != tt.wantConflict {
+				t.Errorf("Merge() error = %v, want *ConflictError: %v", err, tt.wantConflict)
+			}
+		})
+	}
+}
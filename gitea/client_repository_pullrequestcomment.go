@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestCommentClient implements the gitprovider.PullRequestCommentClient interface.
+var _ gitprovider.PullRequestCommentClient = &PullRequestCommentClient{}
+
+// PullRequestCommentClient operates on the comments in the conversation of a specific pull request.
+type PullRequestCommentClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int64
+}
+
+// Get returns the comment with the given ID.
+//
+// ErrNotFound is returned if the comment does not exist, or belongs to another pull request or issue.
+func (c *PullRequestCommentClient) Get(_ context.Context, id int64) (gitprovider.PullRequestComment, error) {
+	// GET /repos/{owner}/{repo}/issues/comments/{id}
+	apiObj, resp, err := c.c.GetIssueComment(c.ref.GetIdentity(), c.ref.GetRepository(), id)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	// Comments are looked up in the whole repository, hence make sure it's one of this pull request
+	suffix := fmt.Sprintf("/%d", c.number)
+	if !strings.HasSuffix(apiObj.PRURL, suffix) && !strings.HasSuffix(apiObj.IssueURL, suffix) {
+		return nil, fmt.Errorf("comment %d of pull request %d: %w", id, c.number, gitprovider.ErrNotFound)
+	}
+	return newPullRequestComment(c, apiObj), nil
+}
+
+// List lists all comments in the conversation of the pull request, oldest first.
+//
+// List returns all available comments, using multiple paginated requests if needed.
+func (c *PullRequestCommentClient) List(_ context.Context) ([]gitprovider.PullRequestComment, error) {
+	opts := gitea.ListIssueCommentOptions{}
+	comments := []gitprovider.PullRequestComment{}
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/issues/{index}/comments
+		pageObjs, resp, listErr := c.c.ListIssueComments(c.ref.GetIdentity(), c.ref.GetRepository(), c.number, opts)
+		if len(pageObjs) > 0 {
+			for _, apiObj := range pageObjs {
+				comments = append(comments, newPullRequestComment(c, apiObj))
+			}
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// Create creates a comment with the given body.
+func (c *PullRequestCommentClient) Create(_ context.Context, req gitprovider.PullRequestCommentInfo) (gitprovider.PullRequestComment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/issues/{index}/comments
+	apiObj, resp, err := c.c.CreateIssueComment(c.ref.GetIdentity(), c.ref.GetRepository(), c.number, gitea.CreateIssueCommentOption{
+		Body: req.Body,
+	})
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	return newPullRequestComment(c, apiObj), nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestReviewClient implements the gitprovider.PullRequestReviewClient interface.
+var _ gitprovider.PullRequestReviewClient = &PullRequestReviewClient{}
+
+// PullRequestReviewClient operates on the reviews of a specific pull request.
+type PullRequestReviewClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int64
+}
+
+// List lists all submitted reviews of the pull request, oldest first.
+// Pending reviews and review requests aren't included.
+//
+// List returns all available reviews, using multiple paginated requests if needed.
+func (c *PullRequestReviewClient) List(_ context.Context) ([]gitprovider.PullRequestReview, error) {
+	opts := gitea.ListPullReviewsOptions{}
+	reviews := []gitprovider.PullRequestReview{}
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/pulls/{index}/reviews
+		pageObjs, resp, listErr := c.c.ListPullReviews(c.ref.GetIdentity(), c.ref.GetRepository(), c.number, opts)
+		if len(pageObjs) > 0 {
+			for _, apiObj := range pageObjs {
+				if apiObj.State == gitea.ReviewStatePending || apiObj.State == gitea.ReviewStateRequestReview {
+					continue
+				}
+				reviews = append(reviews, newPullRequestReview(apiObj))
+			}
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// Create submits a review with the given state as the authenticated user.
+func (c *PullRequestReviewClient) Create(_ context.Context, req gitprovider.PullRequestReviewInfo) (gitprovider.PullRequestReview, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/pulls/{index}/reviews
+	apiObj, resp, err := c.c.CreatePullReview(c.ref.GetIdentity(), c.ref.GetRepository(), c.number, gitea.CreatePullReviewOptions{
+		State: reviewStates[req.State],
+		Body:  req.Body,
+	})
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	return newPullRequestReview(apiObj), nil
+}
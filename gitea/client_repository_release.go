@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// ReleaseClient implements the gitprovider.ReleaseClient interface.
+var _ gitprovider.ReleaseClient = &ReleaseClient{}
+
+// ReleaseClient operates on the releases of a specific repository.
+type ReleaseClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the release made from the tag with the given name.
+//
+// ErrNotFound is returned if the release does not exist.
+func (c *ReleaseClient) Get(_ context.Context, tagName string) (gitprovider.Release, error) {
+	// GET /repos/{owner}/{repo}/releases/tags/{tag}
+	apiObj, err := c.getRelease(c.ref.GetIdentity(), c.ref.GetRepository(), tagName)
+	if err != nil {
+		return nil, err
+	}
+	return newRelease(c, apiObj), nil
+}
+
+// List lists all releases of the repository.
+//
+// List returns all available releases for the given repository,
+// using multiple paginated requests if needed.
+func (c *ReleaseClient) List(_ context.Context) ([]gitprovider.Release, error) {
+	// GET /repos/{owner}/{repo}/releases
+	apiObjs, err := c.listReleases(c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Release type
+	releases := make([]gitprovider.Release, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at listReleases
+		releases = append(releases, newRelease(c, apiObj))
+	}
+	return releases, nil
+}
+
+// Create creates a release with the given specifications. If the tag doesn't exist yet,
+// it's created from req.Target.
+//
+// ErrAlreadyExists will be returned if a release for the tag already exists.
+func (c *ReleaseClient) Create(_ context.Context, req gitprovider.ReleaseInfo) (gitprovider.Release, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+	if _, err := c.getRelease(owner, repo, req.TagName); err == nil {
+		return nil, fmt.Errorf("release %q: %w", req.TagName, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	opts := gitea.CreateReleaseOption{
+		TagName:      req.TagName,
+		Title:        *req.Name,
+		Note:         *req.Description,
+		IsDraft:      *req.Draft,
+		IsPrerelease: *req.Prerelease,
+	}
+	// Gitea uses the default branch if no target is given
+	if req.Target != nil {
+		opts.Target = *req.Target
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/releases
+	apiObj, resp, err := c.c.CreateRelease(owner, repo, opts)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateReleaseAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newRelease(c, apiObj), nil
+}
+
+// listReleases returns all releases of the given repository.
+func (c *ReleaseClient) listReleases(owner, repo string) ([]*gitea.Release, error) {
+	opts := gitea.ListReleasesOptions{}
+	apiObjs := []*gitea.Release{}
+
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/releases
+		pageObjs, resp, listErr := c.c.ListReleases(owner, repo, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateReleaseAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+// getRelease returns the release made from the tag with the given name.
+func (c *ReleaseClient) getRelease(owner, repo, tag string) (*gitea.Release, error) {
+	// GET /repos/{owner}/{repo}/releases/tags/{tag}
+	apiObj, resp, err := c.c.GetReleaseByTag(owner, repo, tag)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateReleaseAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
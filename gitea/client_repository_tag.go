@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TagClient implements the gitprovider.TagClient interface.
+var _ gitprovider.TagClient = &TagClient{}
+
+// TagClient operates on the tags of a specific repository.
+type TagClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the tag with the given name.
+//
+// ErrNotFound is returned if the tag does not exist.
+func (c *TagClient) Get(_ context.Context, name string) (gitprovider.Tag, error) {
+	// GET /repos/{owner}/{repo}/tags/{tag}
+	apiObj, err := c.getTag(c.ref.GetIdentity(), c.ref.GetRepository(), name)
+	if err != nil {
+		return nil, err
+	}
+	return newTag(c, apiObj), nil
+}
+
+// List lists all tags of the repository.
+//
+// List returns all available tags for the given repository,
+// using multiple paginated requests if needed.
+func (c *TagClient) List(_ context.Context) ([]gitprovider.Tag, error) {
+	// GET /repos/{owner}/{repo}/tags
+	apiObjs, err := c.listTags(c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Tag type
+	tags := make([]gitprovider.Tag, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at listTags
+		tags = append(tags, newTag(c, apiObj))
+	}
+	return tags, nil
+}
+
+// Create creates a tag with the given specifications. An annotated tag is created if
+// req.Message is set, otherwise a lightweight tag.
+//
+// ErrAlreadyExists will be returned if the tag already exists.
+func (c *TagClient) Create(_ context.Context, req gitprovider.TagInfo) (gitprovider.Tag, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+	if _, err := c.getTag(owner, repo, req.Name); err == nil {
+		return nil, fmt.Errorf("tag %q: %w", req.Name, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	opts := gitea.CreateTagOption{
+		TagName: req.Name,
+		Target:  req.Sha,
+	}
+	if req.Message != nil {
+		opts.Message = *req.Message
+	}
+	// POST /repos/{owner}/{repo}/tags
+	apiObj, resp, err := c.c.CreateTag(owner, repo, opts)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateTagAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newTag(c, apiObj), nil
+}
+
+// Delete deletes the tag with the given name irreversibly.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn\'t created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the tag does not exist.
+func (c *TagClient) Delete(_ context.Context, name string) error {
+	// Don't allow deleting tags if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete tag: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repos/{owner}/{repo}/tags/{tag}
+	resp, err := c.c.DeleteTag(c.ref.GetIdentity(), c.ref.GetRepository(), name)
+	return handleHTTPError(resp, err)
+}
+
+// listTags returns all tags of the given repository.
+func (c *TagClient) listTags(owner, repo string) ([]*gitea.Tag, error) {
+	opts := gitea.ListRepoTagsOptions{}
+	apiObjs := []*gitea.Tag{}
+
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/tags
+		pageObjs, resp, listErr := c.c.ListRepoTags(owner, repo, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateTagAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+// getTag returns the tag with the given name.
+func (c *TagClient) getTag(owner, repo, tag string) (*gitea.Tag, error) {
+	// GET /repos/{owner}/{repo}/tags/{tag}
+	apiObj, resp, err := c.c.GetTag(owner, repo, tag)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateTagAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
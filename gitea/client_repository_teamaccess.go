@@ -104,10 +104,18 @@ func (c *TeamAccessClient) Create(ctx context.Context, req gitprovider.TeamAcces
 func (c *TeamAccessClient) Reconcile(ctx context.Context,
 	req gitprovider.TeamAccessInfo,
 ) (gitprovider.TeamAccess, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, req)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.TeamAccessClient.ReconcileWithChanges.
+func (c *TeamAccessClient) ReconcileWithChanges(ctx context.Context,
+	req gitprovider.TeamAccessInfo,
+) (gitprovider.TeamAccess, []gitprovider.FieldChange, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	actual, err := c.Get(ctx, req.Name)
@@ -115,23 +123,31 @@ func (c *TeamAccessClient) Reconcile(ctx context.Context,
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
 			resp, err := c.Create(ctx, req)
-			return resp, true, err
+			return resp, req.Diff(gitprovider.TeamAccessInfo{}), err
 		}
 
 		// Unexpected path, Get should succeed or return NotFound
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	// If the desired matches the actual state, just return the actual state
-	if req.Equals(actual.Get()) {
-		return actual, false, nil
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return actual, nil, nil
 	}
 
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
-		return actual, false, err
+		return actual, nil, err
 	}
-	return actual, true, actual.Update(ctx)
+	return actual, changes, actual.Update(ctx)
+}
+
+// ReconcileAll implements gitprovider.TeamAccessClient.ReconcileAll.
+func (c *TeamAccessClient) ReconcileAll(ctx context.Context,
+	req []gitprovider.TeamAccessInfo, opts ...gitprovider.ReconcileAllOption,
+) (*gitprovider.ReconcileReport, error) {
+	return gitprovider.ReconcileAllTeamAccess(ctx, c, c.destructiveActions, req, opts...)
 }
 
 // getTeamPermissions returns the permissions of the given team on the given repository.
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// WebhookClient implements the gitprovider.WebhookClient interface.
+var _ gitprovider.WebhookClient = &WebhookClient{}
+
+// WebhookClient operates on the webhooks of a specific repository.
+type WebhookClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the webhook delivering events to the given URL.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *WebhookClient) Get(ctx context.Context, url string) (gitprovider.Webhook, error) {
+	return c.get(ctx, url)
+}
+
+func (c *WebhookClient) get(ctx context.Context, url string) (*webhook, error) {
+	hooks, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Loop through the webhooks until we find one with the right URL
+	for _, hook := range hooks {
+		if hook.h.Config["url"] == url {
+			return hook, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all webhooks of the repository.
+//
+// List returns all available webhooks for the given repository,
+// using multiple paginated requests if needed.
+func (c *WebhookClient) List(ctx context.Context) ([]gitprovider.Webhook, error) {
+	whs, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Cast to the generic []gitprovider.Webhook
+	hooks := make([]gitprovider.Webhook, 0, len(whs))
+	for _, wh := range whs {
+		hooks = append(hooks, wh)
+	}
+	return hooks, nil
+}
+
+func (c *WebhookClient) list(ctx context.Context) ([]*webhook, error) {
+	// GET /repos/{owner}/{repo}/hooks
+	apiObjs, err := c.listHooks(c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Webhook type
+	hooks := make([]*webhook, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at listHooks
+		hooks = append(hooks, newWebhook(c, apiObj))
+	}
+	return hooks, nil
+}
+
+// Create creates a webhook with the given specifications.
+//
+// ErrAlreadyExists will be returned if a webhook for the same URL already exists.
+func (c *WebhookClient) Create(ctx context.Context, req gitprovider.WebhookInfo) (gitprovider.Webhook, error) {
+	// Webhooks are identified by their URL, hence don't allow duplicates
+	if _, err := c.get(ctx, req.URL); err == nil {
+		return nil, fmt.Errorf("webhook for %q: %w", req.URL, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	apiObj, err := c.createWebhook(c.ref, req)
+	if err != nil {
+		return nil, err
+	}
+	return newWebhook(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *WebhookClient) Reconcile(ctx context.Context, req gitprovider.WebhookInfo) (gitprovider.Webhook, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the webhook with the desired URL
+	actual, err := c.Get(ctx, req.URL)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// listHooks returns all webhooks of the given repository.
+func (c *WebhookClient) listHooks(owner, repo string) ([]*gitea.Hook, error) {
+	opts := gitea.ListHooksOptions{}
+	apiObjs := []*gitea.Hook{}
+
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/hooks
+		pageObjs, resp, listErr := c.c.ListRepoHooks(owner, repo, opts)
+		if len(pageObjs) > 0 {
+			apiObjs = append(apiObjs, pageObjs...)
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateWebhookAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *WebhookClient) createWebhook(ref gitprovider.RepositoryRef, req gitprovider.WebhookInfo) (*gitea.Hook, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	apiObj, err := webhookToAPI(&req)
+	if err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/hooks
+	return c.createHook(ref.GetIdentity(), ref.GetRepository(), apiObj)
+}
+
+// createHook creates a new webhook for the given repository.
+func (c *WebhookClient) createHook(owner, repo string, req *gitea.Hook) (*gitea.Hook, error) {
+	opts := gitea.CreateHookOption{
+		Type:   gitea.HookTypeGitea,
+		Config: req.Config,
+		Events: req.Events,
+		Active: req.Active,
+	}
+	apiObj, resp, err := c.c.CreateRepoHook(owner, repo, opts)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateWebhookAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// editHook updates the given webhook, and returns the updated webhook as seen by the server.
+func (c *WebhookClient) editHook(owner, repo string, req *gitea.Hook) (*gitea.Hook, error) {
+	opts := gitea.EditHookOption{
+		Config: req.Config,
+		Events: req.Events,
+		Active: &req.Active,
+	}
+	// PATCH /repos/{owner}/{repo}/hooks/{id}
+	resp, err := c.c.EditRepoHook(owner, repo, req.ID, opts)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	// GET /repos/{owner}/{repo}/hooks/{id}
+	apiObj, resp, err := c.c.GetRepoHook(owner, repo, req.ID)
+	if err != nil {
+		return nil, handleHTTPError(resp, err)
+	}
+	if err := validateWebhookAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// deleteHook deletes the given webhook from the given repository.
+func (c *WebhookClient) deleteHook(owner, repo string, id int64) error {
+	// DELETE /repos/{owner}/{repo}/hooks/{id}
+	res, err := c.c.DeleteRepoHook(owner, repo, id)
+	return handleHTTPError(res, err)
+}
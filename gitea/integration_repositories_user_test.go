@@ -266,7 +266,7 @@ var _ = Describe("Gitea Provider", func() {
 
 		Eventually(func() bool {
 			var err error
-			err = userRepo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodSquash, "squash merged")
+			_, err = userRepo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodSquash, "squash merged")
 			if err == nil && len(commits) == 0 {
 				err = errors.New("pull request not merged")
 			}
@@ -299,7 +299,7 @@ var _ = Describe("Gitea Provider", func() {
 		Expect(pr.Get().WebURL).ToNot(BeEmpty())
 		Expect(pr.Get().Merged).To(BeFalse())
 
-		err = userRepo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodMerge, "merged")
+		_, err = userRepo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodMerge, "merged")
 		Expect(err).ToNot(HaveOccurred())
 
 		getPR, err = userRepo.PullRequests().Get(ctx, pr.Get().Number)
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newBranch(c *BranchClient, branch *gitea.Branch) *branchType {
+	return &branchType{
+		b: *branch,
+		c: c,
+	}
+}
+
+var _ gitprovider.Branch = &branchType{}
+
+type branchType struct {
+	b gitea.Branch
+	c *BranchClient
+}
+
+// Get returns the branch information.
+func (b *branchType) Get() gitprovider.BranchInfo {
+	return branchFromAPI(&b.b)
+}
+
+// APIObject returns the underlying API object.
+func (b *branchType) APIObject() interface{} {
+	return &b.b
+}
+
+func validateBranchAPI(apiObj *gitea.Branch) error {
+	return validateAPIObject("Gitea.Branch", func(validator validation.Validator) {
+		// Make sure the name and head commit are populated as per
+		// https://gitea.com/api/swagger#/repository/repoGetBranch
+		if apiObj.Name == "" {
+			validator.Required("Name")
+		}
+		if apiObj.Commit == nil || apiObj.Commit.ID == "" {
+			validator.Required("Commit.ID")
+		}
+	})
+}
+
+func branchFromAPI(apiObj *gitea.Branch) gitprovider.BranchInfo {
+	return gitprovider.BranchInfo{
+		Name:      apiObj.Name,
+		Sha:       apiObj.Commit.ID,
+		Protected: apiObj.Protected,
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newBranchProtection(c *BranchProtectionClient, apiObj *gitea.BranchProtection) *branchProtection {
+	return &branchProtection{
+		p: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.BranchProtection = &branchProtection{}
+
+type branchProtection struct {
+	p gitea.BranchProtection
+	c *BranchProtectionClient
+}
+
+// Get returns the branch protection information.
+func (bp *branchProtection) Get() gitprovider.BranchProtectionInfo {
+	return branchProtectionFromAPI(&bp.p)
+}
+
+// Set sets the branch protection information.
+func (bp *branchProtection) Set(info gitprovider.BranchProtectionInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	return branchProtectionInfoToAPIObj(&info, &bp.p)
+}
+
+// APIObject returns the underlying API object.
+func (bp *branchProtection) APIObject() interface{} {
+	return &bp.p
+}
+
+// Repository returns the repository that this branch protection belongs to.
+func (bp *branchProtection) Repository() gitprovider.RepositoryRef {
+	return bp.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (bp *branchProtection) Update(_ context.Context) error {
+	apiObj, err := bp.c.editBranchProtection(bp.c.ref.GetIdentity(), bp.c.ref.GetRepository(), &bp.p)
+	if err != nil {
+		return err
+	}
+	bp.p = *apiObj
+	return nil
+}
+
+// Delete removes the protection of the branch.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (bp *branchProtection) Delete(_ context.Context) error {
+	return bp.c.deleteBranchProtection(bp.c.ref.GetIdentity(), bp.c.ref.GetRepository(), branchProtectionName(&bp.p))
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (bp *branchProtection) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := bp.c.get(ctx, branchProtectionName(&bp.p))
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			apiObj, err := bp.c.createBranchProtection(bp.c.ref.GetIdentity(), bp.c.ref.GetRepository(), &bp.p)
+			if err != nil {
+				return true, err
+			}
+			bp.p = *apiObj
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if bp.Get().Equals(actual.Get()) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	return true, bp.Update(ctx)
+}
+
+func validateBranchProtectionAPI(apiObj *gitea.BranchProtection) error {
+	return validateAPIObject("Gitea.BranchProtection", func(validator validation.Validator) {
+		// Make sure the rule is named as per
+		// https://gitea.com/api/swagger#/repository/repoGetBranchProtection
+		if branchProtectionName(apiObj) == "" {
+			validator.Required("RuleName")
+		}
+	})
+}
+
+// branchProtectionName returns the name of the protection rule, which is the name of the
+// protected branch for the rules created by this package. Older Gitea versions only set
+// the branch name.
+func branchProtectionName(apiObj *gitea.BranchProtection) string {
+	if apiObj.RuleName != "" {
+		return apiObj.RuleName
+	}
+	return apiObj.BranchName
+}
+
+func branchProtectionFromAPI(apiObj *gitea.BranchProtection) gitprovider.BranchProtectionInfo {
+	info := gitprovider.BranchProtectionInfo{
+		Branch:                       branchProtectionName(apiObj),
+		RequiredApprovingReviewCount: gitprovider.IntVar(int(apiObj.RequiredApprovals)),
+		RestrictPushes:               gitprovider.BoolVar(!apiObj.EnablePush || apiObj.EnablePushWhitelist),
+		// Gitea never allows force-pushing to or deleting a protected branch,
+		// and lets administrators merge without fulfilling the rules
+		AllowForcePushes: gitprovider.BoolVar(false),
+		AllowDeletions:   gitprovider.BoolVar(false),
+		IncludeAdmins:    gitprovider.BoolVar(false),
+	}
+	if apiObj.EnableStatusCheck {
+		info.RequiredStatusChecks = sortedStrings(apiObj.StatusCheckContexts)
+	}
+	if apiObj.EnablePush && apiObj.EnablePushWhitelist {
+		info.PushAllowances = sortedStrings(apiObj.PushWhitelistUsernames)
+	}
+	return info
+}
+
+func branchProtectionInfoToAPIObj(info *gitprovider.BranchProtectionInfo, apiObj *gitea.BranchProtection) error {
+	if info.AllowForcePushes != nil && *info.AllowForcePushes {
+		return fmt.Errorf("allowing force-pushes to protected branches: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.AllowDeletions != nil && *info.AllowDeletions {
+		return fmt.Errorf("allowing deletions of protected branches: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.IncludeAdmins != nil && *info.IncludeAdmins {
+		return fmt.Errorf("enforcing branch protections for administrators: %w", gitprovider.ErrNoProviderSupport)
+	}
+	// Required fields, we assume info is validated, and hence these are set
+	apiObj.BranchName = info.Branch
+	apiObj.RuleName = info.Branch
+	// optional fields
+	if info.RequiredApprovingReviewCount != nil {
+		apiObj.RequiredApprovals = int64(*info.RequiredApprovingReviewCount)
+	}
+	apiObj.EnableStatusCheck = len(info.RequiredStatusChecks) > 0
+	apiObj.StatusCheckContexts = info.RequiredStatusChecks
+	if info.RestrictPushes != nil {
+		// Pushes are either allowed for everyone with write access, for the users and teams
+		// in the whitelist, or for nobody
+		whitelist := *info.RestrictPushes && (len(info.PushAllowances) > 0 || len(apiObj.PushWhitelistTeams) > 0)
+		apiObj.EnablePush = !*info.RestrictPushes || whitelist
+		apiObj.EnablePushWhitelist = whitelist
+		apiObj.PushWhitelistUsernames = info.PushAllowances
+	}
+	return nil
+}
+
+// sortedStrings returns a sorted copy of s, or nil if s is empty.
+func sortedStrings(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	sorted := append([]string{}, s...)
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_branchProtectionInfoToAPIObj(t *testing.T) {
+	tests := []struct {
+		name            string
+		info            gitprovider.BranchProtectionInfo
+		apiObj          gitea.BranchProtection
+		wantPush        bool
+		wantPushAllowed bool
+	}{
+		{
+			name:     "defaults",
+			info:     gitprovider.BranchProtectionInfo{Branch: "main"},
+			wantPush: true,
+		},
+		{
+			name: "no pushes",
+			info: gitprovider.BranchProtectionInfo{
+				Branch:                       "main",
+				RequiredApprovingReviewCount: gitprovider.IntVar(1),
+				RequiredStatusChecks:         []string{"ci/build"},
+				RestrictPushes:               gitprovider.BoolVar(true),
+			},
+		},
+		{
+			name: "pushes by users",
+			info: gitprovider.BranchProtectionInfo{
+				Branch:         "main",
+				RestrictPushes: gitprovider.BoolVar(true),
+				PushAllowances: []string{"bob", "alice"},
+			},
+			wantPush:        true,
+			wantPushAllowed: true,
+		},
+		{
+			name: "pushes by teams",
+			info: gitprovider.BranchProtectionInfo{
+				Branch:         "main",
+				RestrictPushes: gitprovider.BoolVar(true),
+			},
+			apiObj:          gitea.BranchProtection{PushWhitelistTeams: []string{"owners"}},
+			wantPush:        true,
+			wantPushAllowed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := gitprovider.ValidateAndDefaultInfo(&tt.info); err != nil {
+				t.Fatal(err)
+			}
+			if err := branchProtectionInfoToAPIObj(&tt.info, &tt.apiObj); err != nil {
+				t.Fatal(err)
+			}
+			if tt.apiObj.EnablePush != tt.wantPush || tt.apiObj.EnablePushWhitelist != tt.wantPushAllowed {
+				t.Errorf("EnablePush, EnablePushWhitelist = %v, %v, want %v, %v",
+					tt.apiObj.EnablePush, tt.apiObj.EnablePushWhitelist, tt.wantPush, tt.wantPushAllowed)
+			}
+			// The generic information must survive the round trip
+			if got := branchProtectionFromAPI(&tt.apiObj); !tt.info.Equals(got) {
+				t.Errorf("branchProtectionFromAPI() = %+v, want %+v", got, tt.info)
+			}
+		})
+	}
+}
+
+func Test_branchProtectionInfoToAPIObj_unsupported(t *testing.T) {
+	info := gitprovider.BranchProtectionInfo{Branch: "main", AllowForcePushes: gitprovider.BoolVar(true)}
+	if err := branchProtectionInfoToAPIObj(&info, &gitea.BranchProtection{}); err == nil {
+		t.Error("expected an error for force-pushes")
+	}
+}
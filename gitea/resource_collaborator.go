@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newCollaborator(c *CollaboratorClient, login string, apiObj *gitea.CollaboratorPermissionResult) *collaborator {
+	return &collaborator{
+		p: *apiObj,
+		info: gitprovider.CollaboratorInfo{
+			Login:      login,
+			Permission: getProviderPermission(apiObj.Permission),
+		},
+		c: c,
+	}
+}
+
+var _ gitprovider.Collaborator = &collaborator{}
+
+type collaborator struct {
+	p    gitea.CollaboratorPermissionResult
+	info gitprovider.CollaboratorInfo
+	c    *CollaboratorClient
+}
+
+// Get returns the collaborator information.
+func (c *collaborator) Get() gitprovider.CollaboratorInfo {
+	return c.info
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+func (c *collaborator) Set(info gitprovider.CollaboratorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	c.info = info
+	return nil
+}
+
+// APIObject returns the underlying *gitea.CollaboratorPermissionResult.
+func (c *collaborator) APIObject() interface{} {
+	return &c.p
+}
+
+// Repository returns the repository reference.
+func (c *collaborator) Repository() gitprovider.RepositoryRef {
+	return c.c.ref
+}
+
+// Delete removes the user from the collaborators of the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Delete(ctx context.Context) error {
+	// DELETE /repos/{owner}/{repo}/collaborators/{collaborator}
+	res, err := c.c.c.DeleteCollaborator(c.c.ref.GetIdentity(), c.c.ref.GetRepository(), c.info.Login)
+	return handleHTTPError(res, err)
+}
+
+// Update will apply the desired permission to the collaborator.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&c.info); err != nil {
+		return err
+	}
+	// Update the actual state to be the desired state by issuing a PUT
+	if err := c.c.add(c.info); err != nil {
+		return err
+	}
+	resp, err := c.c.getPermission(c.info.Login)
+	if err != nil {
+		return err
+	}
+	*c = *resp
+	return nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *collaborator) Reconcile(ctx context.Context) (bool, error) {
+	req := c.Get()
+	actual, err := c.c.Get(ctx, req.Login)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.c.Create(ctx, req)
+			if err != nil {
+				return true, err
+			}
+			*c = *resp.(*collaborator)
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if req.Equals(actual.Get()) {
+		return false, nil
+	}
+
+	return true, c.Update(ctx)
+}
+
+// collaboratorPermissionToAPI maps the permission to the Gitea access mode.
+// Gitea doesn't have the triage and maintain permissions.
+func collaboratorPermissionToAPI(permission gitprovider.RepositoryPermission) (gitea.AccessMode, error) {
+	switch permission {
+	case gitprovider.RepositoryPermissionPull:
+		return gitea.AccessModeRead, nil
+	case gitprovider.RepositoryPermissionPush:
+		return gitea.AccessModeWrite, nil
+	case gitprovider.RepositoryPermissionAdmin:
+		return gitea.AccessModeAdmin, nil
+	default:
+		return "", fmt.Errorf("collaborator permission %q: %w", permission, gitprovider.ErrNoProviderSupport)
+	}
+}
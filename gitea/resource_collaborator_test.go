@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"errors"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_collaboratorPermissionToAPI(t *testing.T) {
+	tests := []struct {
+		permission gitprovider.RepositoryPermission
+		want       gitea.AccessMode
+		wantErr    error
+	}{
+		{permission: gitprovider.RepositoryPermissionPull, want: gitea.AccessModeRead},
+		{permission: gitprovider.RepositoryPermissionPush, want: gitea.AccessModeWrite},
+		{permission: gitprovider.RepositoryPermissionAdmin, want: gitea.AccessModeAdmin},
+		{permission: gitprovider.RepositoryPermissionTriage, wantErr: gitprovider.ErrNoProviderSupport},
+		{permission: gitprovider.RepositoryPermissionMaintain, wantErr: gitprovider.ErrNoProviderSupport},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.permission), func(t *testing.T) {
+			got, err := collaboratorPermissionToAPI(tt.permission)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("collaboratorPermissionToAPI() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("collaboratorPermissionToAPI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
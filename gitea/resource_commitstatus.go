@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newCommitStatus(c *CommitStatusClient, status *gitea.Status) *commitStatus {
+	return &commitStatus{
+		s: *status,
+		c: c,
+	}
+}
+
+var _ gitprovider.CommitStatus = &commitStatus{}
+
+type commitStatus struct {
+	s gitea.Status
+	c *CommitStatusClient
+}
+
+// Get returns the commit status information.
+func (s *commitStatus) Get() gitprovider.CommitStatusInfo {
+	return commitStatusFromAPI(&s.s)
+}
+
+// APIObject returns the underlying value that was returned from the server.
+func (s *commitStatus) APIObject() interface{} {
+	return &s.s
+}
+
+// Repository returns the repository reference.
+func (s *commitStatus) Repository() gitprovider.RepositoryRef {
+	return s.c.ref
+}
+
+// commitStatusStates maps the Gitea status states onto the generic commit status states.
+// Gitea ranks warnings worse than failures, so they're mapped to failures.
+//
+//nolint:gochecknoglobals
+var commitStatusStates = map[gitea.StatusState]gitprovider.CommitStatusState{
+	gitea.StatusPending: gitprovider.CommitStatusStatePending,
+	gitea.StatusSuccess: gitprovider.CommitStatusStateSuccess,
+	gitea.StatusError:   gitprovider.CommitStatusStateError,
+	gitea.StatusFailure: gitprovider.CommitStatusStateFailure,
+	gitea.StatusWarning: gitprovider.CommitStatusStateFailure,
+}
+
+// validateCommitStatusAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateCommitStatusAPI(apiObj *gitea.Status) error {
+	return validateAPIObject("Gitea.Status", func(validator validation.Validator) {
+		if _, ok := commitStatusStates[apiObj.State]; !ok {
+			validator.Invalid(apiObj.State, "State")
+		}
+	})
+}
+
+func commitStatusFromAPI(apiObj *gitea.Status) gitprovider.CommitStatusInfo {
+	return gitprovider.CommitStatusInfo{
+		State:       commitStatusStates[apiObj.State],
+		Context:     apiObj.Context,
+		Description: apiObj.Description,
+		TargetURL:   apiObj.TargetURL,
+		CreatedAt:   apiObj.Created,
+	}
+}
+
+func commitStatusToAPI(info *gitprovider.CommitStatusInfo) gitea.CreateStatusOption {
+	return gitea.CreateStatusOption{
+		// The generic states are a subset of the Gitea ones
+		State:       gitea.StatusState(info.State),
+		TargetURL:   info.TargetURL,
+		Description: info.Description,
+		Context:     info.Context,
+	}
+}
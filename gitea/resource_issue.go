@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssue(c *IssueClient, apiObj *gitea.Issue) *issue {
+	return &issue{
+		i: *apiObj,
+		comments: &IssueCommentClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.Index,
+		},
+	}
+}
+
+var _ gitprovider.Issue = &issue{}
+
+type issue struct {
+	i        gitea.Issue
+	comments *IssueCommentClient
+}
+
+// Get returns the issue information.
+func (i *issue) Get() gitprovider.IssueInfo {
+	return issueFromAPI(&i.i)
+}
+
+// APIObject returns the underlying API object.
+func (i *issue) APIObject() interface{} {
+	return &i.i
+}
+
+// Comments returns the comment client of the issue.
+func (i *issue) Comments() gitprovider.IssueCommentClient {
+	return i.comments
+}
+
+func issueFromAPI(apiObj *gitea.Issue) gitprovider.IssueInfo {
+	info := gitprovider.IssueInfo{
+		Number:      int(apiObj.Index),
+		Title:       apiObj.Title,
+		Description: apiObj.Body,
+		State:       gitprovider.IssueStateOpen,
+		WebURL:      apiObj.HTMLURL,
+		CreatedAt:   apiObj.Created,
+		UpdatedAt:   apiObj.Updated,
+	}
+	if apiObj.State == gitea.StateClosed {
+		info.State = gitprovider.IssueStateClosed
+		info.ClosedAt = apiObj.Closed
+	}
+	if apiObj.Poster != nil {
+		info.Author = apiObj.Poster.UserName
+	}
+	if apiObj.Milestone != nil {
+		info.Milestone = apiObj.Milestone.Title
+	}
+	for _, label := range apiObj.Labels {
+		info.Labels = append(info.Labels, label.Name)
+	}
+	for _, user := range apiObj.Assignees {
+		info.Assignees = append(info.Assignees, user.UserName)
+	}
+	return info
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssueComment(c *IssueCommentClient, apiObj *gitea.Comment) *issueComment {
+	return &issueComment{
+		ic: *apiObj,
+		c:  c,
+	}
+}
+
+var _ gitprovider.IssueComment = &issueComment{}
+
+type issueComment struct {
+	ic gitea.Comment
+	c  *IssueCommentClient
+}
+
+// Get returns the comment information.
+func (ic *issueComment) Get() gitprovider.IssueCommentInfo {
+	return issueCommentFromAPI(&ic.ic)
+}
+
+// Set sets the comment information.
+func (ic *issueComment) Set(info gitprovider.IssueCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	ic.ic.Body = info.Body
+	return nil
+}
+
+// APIObject returns the underlying API object.
+func (ic *issueComment) APIObject() interface{} {
+	return &ic.ic
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (ic *issueComment) Update(_ context.Context) error {
+	// PATCH /repos/{owner}/{repo}/issues/comments/{id}
+	apiObj, resp, err := ic.c.c.EditIssueComment(ic.c.ref.GetIdentity(), ic.c.ref.GetRepository(), ic.ic.ID, gitea.EditIssueCommentOption{
+		Body: ic.ic.Body,
+	})
+	if err != nil {
+		return handleHTTPError(resp, err)
+	}
+	ic.ic = *apiObj
+	return nil
+}
+
+// Delete deletes the comment.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (ic *issueComment) Delete(_ context.Context) error {
+	// DELETE /repos/{owner}/{repo}/issues/comments/{id}
+	resp, err := ic.c.c.DeleteIssueComment(ic.c.ref.GetIdentity(), ic.c.ref.GetRepository(), ic.ic.ID)
+	return handleHTTPError(resp, err)
+}
+
+func issueCommentFromAPI(apiObj *gitea.Comment) gitprovider.IssueCommentInfo {
+	info := gitprovider.IssueCommentInfo{
+		ID:        apiObj.ID,
+		Body:      apiObj.Body,
+		CreatedAt: apiObj.Created,
+		UpdatedAt: apiObj.Updated,
+	}
+	if apiObj.Poster != nil {
+		info.Author = apiObj.Poster.UserName
+	}
+	return info
+}
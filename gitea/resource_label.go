@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newLabel(c *LabelClient, apiObj *gitea.Label) *label {
+	return &label{
+		l: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.Label = &label{}
+
+type label struct {
+	l gitea.Label
+	c *LabelClient
+}
+
+// Get returns the label information.
+func (l *label) Get() gitprovider.LabelInfo {
+	return labelFromAPI(&l.l)
+}
+
+// Set sets the label information. Changing the name renames the label on Update.
+func (l *label) Set(info gitprovider.LabelInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	info.Default()
+	labelInfoToAPIObj(&info, &l.l)
+	return nil
+}
+
+// APIObject returns the underlying API object.
+func (l *label) APIObject() interface{} {
+	return &l.l
+}
+
+// Repository returns the repository that this label belongs to.
+func (l *label) Repository() gitprovider.RepositoryRef {
+	return l.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (l *label) Update(ctx context.Context) error {
+	apiObj, err := l.c.editLabel(&l.l)
+	if err != nil {
+		return err
+	}
+	l.l = *apiObj
+	return nil
+}
+
+// Delete deletes the label from the repository, removing it from all issues and pull requests.
+//
+// ErrDestructiveCallDisallowed is returned unless destructive API calls are enabled.
+// ErrNotFound is returned if the resource does not exist.
+func (l *label) Delete(ctx context.Context) error {
+	return l.c.deleteLabel(l.l.ID)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (l *label) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := l.c.get(l.l.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, l.createIntoSelf()
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if l.Get().Equals(actual.Get()) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	l.l.ID = actual.l.ID
+	return true, l.Update(ctx)
+}
+
+func (l *label) createIntoSelf() error {
+	apiObj, err := l.c.createLabel(&l.l)
+	if err != nil {
+		return err
+	}
+	l.l = *apiObj
+	return nil
+}
+
+func validateLabelAPI(apiObj *gitea.Label) error {
+	return validateAPIObject("Gitea.Label", func(validator validation.Validator) {
+		// Make sure ID, name and color are populated as per
+		// https://gitea.com/api/swagger#/issue/issueGetLabel
+		if apiObj.ID == 0 {
+			validator.Required("ID")
+		}
+		if apiObj.Name == "" {
+			validator.Required("Name")
+		}
+		if apiObj.Color == "" {
+			validator.Required("Color")
+		}
+	})
+}
+
+func labelFromAPI(apiObj *gitea.Label) gitprovider.LabelInfo {
+	info := gitprovider.LabelInfo{
+		Name:        apiObj.Name,
+		Color:       apiObj.Color,
+		Description: apiObj.Description,
+	}
+	info.Default()
+	return info
+}
+
+func labelToAPI(info *gitprovider.LabelInfo) *gitea.Label {
+	l := &gitea.Label{}
+	labelInfoToAPIObj(info, l)
+	return l
+}
+
+func labelInfoToAPIObj(info *gitprovider.LabelInfo, apiObj *gitea.Label) {
+	apiObj.Name = info.Name
+	// Not all Gitea versions accept colors without the leading "#"
+	apiObj.Color = "#" + info.Color
+	apiObj.Description = info.Description
+}
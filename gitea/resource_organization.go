@@ -67,10 +67,28 @@ func (o *organization) Teams() gitprovider.TeamsClient {
 }
 
 func organizationFromAPI(apiObj *gitea.Organization) gitprovider.OrganizationInfo {
-	return gitprovider.OrganizationInfo{
+	info := gitprovider.OrganizationInfo{
 		Name:        &apiObj.UserName,
 		Description: &apiObj.Description,
 	}
+	if apiObj.Visibility != "" {
+		info.Visibility = gitprovider.OrganizationVisibilityVar(organizationVisibilityFromAPI(gitea.VisibleType(apiObj.Visibility)))
+	}
+	return info
+}
+
+func organizationVisibilityFromAPI(v gitea.VisibleType) gitprovider.OrganizationVisibility {
+	if v == gitea.VisibleTypeLimited {
+		return gitprovider.OrganizationVisibilityInternal
+	}
+	return gitprovider.OrganizationVisibility(v)
+}
+
+func organizationVisibilityToAPI(v gitprovider.OrganizationVisibility) gitea.VisibleType {
+	if v == gitprovider.OrganizationVisibilityInternal {
+		return gitea.VisibleTypeLimited
+	}
+	return gitea.VisibleType(v)
 }
 
 // validateOrganizationAPI validates the apiObj received from the server, to make sure that it is
@@ -17,14 +17,28 @@ limitations under the License.
 package gitea
 
 import (
+	"context"
+	"strings"
+
 	"code.gitea.io/sdk/gitea"
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
 
-func newPullRequest(ctx *clientContext, apiObj *gitea.PullRequest) *pullrequest {
+func newPullRequest(c *PullRequestClient, apiObj *gitea.PullRequest) *pullrequest {
 	return &pullrequest{
-		clientContext: ctx,
+		clientContext: c.clientContext,
 		pr:            *apiObj,
+		ref:           c.ref,
+		comments: &PullRequestCommentClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.Index,
+		},
+		reviews: &PullRequestReviewClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.Index,
+		},
 	}
 }
 
@@ -33,7 +47,10 @@ var _ gitprovider.PullRequest = &pullrequest{}
 type pullrequest struct {
 	*clientContext
 
-	pr gitea.PullRequest
+	pr       gitea.PullRequest
+	ref      gitprovider.RepositoryRef
+	comments *PullRequestCommentClient
+	reviews  *PullRequestReviewClient
 }
 
 // Get returns the pull request information.
@@ -46,10 +63,128 @@ func (pr *pullrequest) APIObject() interface{} {
 	return &pr.pr
 }
 
+// Comments returns a client for the comments in the conversation of the pull request.
+func (pr *pullrequest) Comments() gitprovider.PullRequestCommentClient {
+	return pr.comments
+}
+
+// Reviews returns a client for the reviews of the pull request.
+func (pr *pullrequest) Reviews() gitprovider.PullRequestReviewClient {
+	return pr.reviews
+}
+
+// Files returns the files changed by the pull request.
+// Gitea doesn't return the patches of the files.
+func (pr *pullrequest) Files(_ context.Context) (*gitprovider.PullRequestFiles, error) {
+	opts := gitea.ListPullRequestFilesOptions{}
+	files := &gitprovider.PullRequestFiles{Files: []gitprovider.PullRequestFile{}}
+	err := allPages(&opts.ListOptions, func() (*gitea.Response, error) {
+		// GET /repos/{owner}/{repo}/pulls/{index}/files
+		pageObjs, resp, listErr := pr.c.ListPullRequestFiles(pr.ref.GetIdentity(), pr.ref.GetRepository(), pr.pr.Index, opts)
+		if len(pageObjs) > 0 {
+			for _, apiObj := range pageObjs {
+				files.Files = append(files.Files, pullRequestFileFromAPI(apiObj))
+			}
+			return resp, listErr
+		}
+		return nil, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// Diff returns the changes of the pull request as a raw unified diff.
+func (pr *pullrequest) Diff(_ context.Context) (string, error) {
+	// GET /repos/{owner}/{repo}/pulls/{index}.diff
+	diff, res, err := pr.c.GetPullRequestDiff(pr.ref.GetIdentity(), pr.ref.GetRepository(), pr.pr.Index, gitea.PullRequestDiffOptions{})
+	if err != nil {
+		return "", handleHTTPError(res, err)
+	}
+	return string(diff), nil
+}
+
+// draftPrefixes are the title prefixes Gitea marks pull requests as work in progress with,
+// by default.
+var draftPrefixes = []string{"WIP:", "[WIP]"}
+
 func pullrequestFromAPI(apiObj *gitea.PullRequest) gitprovider.PullRequestInfo {
-	return gitprovider.PullRequestInfo{
-		Merged: apiObj.HasMerged,
-		Number: int(apiObj.Index),
-		WebURL: apiObj.HTMLURL,
+	info := gitprovider.PullRequestInfo{
+		Title:       apiObj.Title,
+		Description: apiObj.Body,
+		Merged:      apiObj.HasMerged,
+		Number:      int(apiObj.Index),
+		WebURL:      apiObj.HTMLURL,
+		MergedAt:    apiObj.Merged,
+	}
+	if apiObj.Head != nil {
+		info.SourceBranch = apiObj.Head.Ref
+		info.HeadSha = apiObj.Head.Sha
+	}
+	if apiObj.Base != nil {
+		info.TargetBranch = apiObj.Base.Ref
+		info.BaseSha = apiObj.Base.Sha
+	}
+	info.Draft = draftTitle(apiObj.Title, false) != apiObj.Title
+	if apiObj.Poster != nil {
+		info.Author = apiObj.Poster.UserName
+	}
+	for _, label := range apiObj.Labels {
+		info.Labels = append(info.Labels, label.Name)
+	}
+	for _, user := range apiObj.Assignees {
+		info.Assignees = append(info.Assignees, user.UserName)
+	}
+	if apiObj.Created != nil {
+		info.CreatedAt = *apiObj.Created
+	}
+	if apiObj.Updated != nil {
+		info.UpdatedAt = *apiObj.Updated
+	}
+
+	switch {
+	case apiObj.HasMerged:
+		info.State = gitprovider.PullRequestStateMerged
+	case apiObj.State == gitea.StateClosed:
+		info.State = gitprovider.PullRequestStateClosed
+	default:
+		info.State = gitprovider.PullRequestStateOpen
+		info.Mergeable = gitprovider.BoolVar(apiObj.Mergeable)
+	}
+	return info
+}
+
+// draftTitle returns the title with its draft prefix removed, and a "WIP: " one added if draft is true.
+func draftTitle(title string, draft bool) string {
+	for _, prefix := range draftPrefixes {
+		if strings.HasPrefix(strings.ToUpper(title), prefix) {
+			title = strings.TrimSpace(title[len(prefix):])
+			break
+		}
+	}
+	if draft {
+		return "WIP: " + title
+	}
+	return title
+}
+
+func pullRequestFileFromAPI(apiObj *gitea.ChangedFile) gitprovider.PullRequestFile {
+	file := gitprovider.PullRequestFile{
+		Path:      apiObj.Filename,
+		Status:    gitprovider.PullRequestFileStatusModified,
+		Additions: apiObj.Additions,
+		Deletions: apiObj.Deletions,
+	}
+	switch apiObj.Status {
+	// Copied files are new files, even though Gitea returns the path they were copied from
+	case "added", "copied":
+		file.Status = gitprovider.PullRequestFileStatusAdded
+	case "deleted":
+		file.Status = gitprovider.PullRequestFileStatusRemoved
+	case "renamed":
+		file.Status = gitprovider.PullRequestFileStatusRenamed
+		file.PreviousPath = apiObj.PreviousFilename
 	}
+	return file
 }
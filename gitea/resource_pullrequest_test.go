@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"testing"
+)
+
+func Test_draftTitle(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		draft bool
+		want  string
+	}{
+		{
+			name:  "mark as draft",
+			title: "Add feature",
+			draft: true,
+			want:  "WIP: Add feature",
+		},
+		{
+			name:  "normalize the draft prefix",
+			title: "[WIP] Add feature",
+			draft: true,
+			want:  "WIP: Add feature",
+		},
+		{
+			name:  "mark as ready",
+			title: "wip: Add feature",
+			draft: false,
+			want:  "Add feature",
+		},
+		{
+			name:  "already ready",
+			title: "Add feature",
+			draft: false,
+			want:  "Add feature",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := draftTitle(tt.title, tt.draft); got != tt.want {
+				t.Errorf("draftTitle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
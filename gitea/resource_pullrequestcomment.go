@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newPullRequestComment(c *PullRequestCommentClient, apiObj *gitea.Comment) *pullRequestComment {
+	return &pullRequestComment{
+		pc: *apiObj,
+		c:  c,
+	}
+}
+
+var _ gitprovider.PullRequestComment = &pullRequestComment{}
+
+type pullRequestComment struct {
+	pc gitea.Comment
+	c  *PullRequestCommentClient
+}
+
+// Get returns the comment information.
+func (pc *pullRequestComment) Get() gitprovider.PullRequestCommentInfo {
+	return pullRequestCommentFromAPI(&pc.pc)
+}
+
+// Set sets the comment information.
+func (pc *pullRequestComment) Set(info gitprovider.PullRequestCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	pc.pc.Body = info.Body
+	return nil
+}
+
+// APIObject returns the underlying API object.
+func (pc *pullRequestComment) APIObject() interface{} {
+	return &pc.pc
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (pc *pullRequestComment) Update(_ context.Context) error {
+	// PATCH /repos/{owner}/{repo}/issues/comments/{id}
+	apiObj, resp, err := pc.c.c.EditIssueComment(pc.c.ref.GetIdentity(), pc.c.ref.GetRepository(), pc.pc.ID, gitea.EditIssueCommentOption{
+		Body: pc.pc.Body,
+	})
+	if err != nil {
+		return handleHTTPError(resp, err)
+	}
+	pc.pc = *apiObj
+	return nil
+}
+
+// Delete deletes the comment.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (pc *pullRequestComment) Delete(_ context.Context) error {
+	// DELETE /repos/{owner}/{repo}/issues/comments/{id}
+	resp, err := pc.c.c.DeleteIssueComment(pc.c.ref.GetIdentity(), pc.c.ref.GetRepository(), pc.pc.ID)
+	return handleHTTPError(resp, err)
+}
+
+func pullRequestCommentFromAPI(apiObj *gitea.Comment) gitprovider.PullRequestCommentInfo {
+	info := gitprovider.PullRequestCommentInfo{
+		ID:        apiObj.ID,
+		Body:      apiObj.Body,
+		CreatedAt: apiObj.Created,
+		UpdatedAt: apiObj.Updated,
+	}
+	if apiObj.Poster != nil {
+		info.Author = apiObj.Poster.UserName
+	}
+	return info
+}
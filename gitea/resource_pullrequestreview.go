@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// reviewStates maps the review states to the Gitea review states.
+//
+//nolint:gochecknoglobals
+var reviewStates = map[gitprovider.PullRequestReviewState]gitea.ReviewStateType{
+	gitprovider.PullRequestReviewStateApproved:         gitea.ReviewStateApproved,
+	gitprovider.PullRequestReviewStateChangesRequested: gitea.ReviewStateRequestChanges,
+	gitprovider.PullRequestReviewStateCommented:        gitea.ReviewStateComment,
+}
+
+func newPullRequestReview(apiObj *gitea.PullReview) *pullRequestReview {
+	return &pullRequestReview{
+		r: *apiObj,
+	}
+}
+
+var _ gitprovider.PullRequestReview = &pullRequestReview{}
+
+type pullRequestReview struct {
+	r gitea.PullReview
+}
+
+// Get returns the review information.
+func (r *pullRequestReview) Get() gitprovider.PullRequestReviewInfo {
+	return pullRequestReviewFromAPI(&r.r)
+}
+
+// APIObject returns the underlying API object.
+func (r *pullRequestReview) APIObject() interface{} {
+	return &r.r
+}
+
+func pullRequestReviewFromAPI(apiObj *gitea.PullReview) gitprovider.PullRequestReviewInfo {
+	info := gitprovider.PullRequestReviewInfo{
+		ID:        apiObj.ID,
+		Body:      apiObj.Body,
+		CommitSha: apiObj.CommitID,
+	}
+	for state, apiState := range reviewStates {
+		if apiObj.State == apiState {
+			info.State = state
+		}
+	}
+	// Dismissed reviews keep their state
+	if apiObj.Dismissed {
+		info.State = gitprovider.PullRequestReviewStateDismissed
+	}
+	if apiObj.Reviewer != nil {
+		info.Author = apiObj.Reviewer.UserName
+	}
+	if !apiObj.Submitted.IsZero() {
+		submittedAt := apiObj.Submitted
+		info.SubmittedAt = &submittedAt
+	}
+	return info
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"testing"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_pullRequestReviewFromAPI(t *testing.T) {
+	submitted := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name   string
+		apiObj *gitea.PullReview
+		want   gitprovider.PullRequestReviewInfo
+	}{
+		{
+			name: "approved",
+			apiObj: &gitea.PullReview{
+				ID:        1,
+				Reviewer:  &gitea.User{UserName: "alice"},
+				State:     gitea.ReviewStateApproved,
+				CommitID:  "sha",
+				Submitted: submitted,
+			},
+			want: gitprovider.PullRequestReviewInfo{
+				ID:          1,
+				State:       gitprovider.PullRequestReviewStateApproved,
+				Author:      "alice",
+				CommitSha:   "sha",
+				SubmittedAt: &submitted,
+			},
+		},
+		{
+			name: "dismissed changes request",
+			apiObj: &gitea.PullReview{
+				ID:        2,
+				Reviewer:  &gitea.User{UserName: "bob"},
+				State:     gitea.ReviewStateRequestChanges,
+				Body:      "please add tests",
+				Dismissed: true,
+			},
+			want: gitprovider.PullRequestReviewInfo{
+				ID:     2,
+				State:  gitprovider.PullRequestReviewStateDismissed,
+				Body:   "please add tests",
+				Author: "bob",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := pullRequestReviewFromAPI(tc.apiObj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("pullRequestReviewFromAPI() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
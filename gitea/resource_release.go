@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newRelease(c *ReleaseClient, r *gitea.Release) *release {
+	return &release{
+		r: *r,
+		c: c,
+	}
+}
+
+var _ gitprovider.Release = &release{}
+
+type release struct {
+	r gitea.Release
+	c *ReleaseClient
+}
+
+// Get returns the release information.
+func (r *release) Get() gitprovider.ReleaseInfo {
+	return releaseFromAPI(&r.r)
+}
+
+// Set sets the release information. The tag of the release can't be changed.
+func (r *release) Set(info gitprovider.ReleaseInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.TagName != r.r.TagName {
+		return fmt.Errorf("cannot change the tag of release %q: %w", r.r.TagName, gitprovider.ErrInvalidArgument)
+	}
+	releaseInfoToAPIObj(&info, &r.r)
+	return nil
+}
+
+// APIObject returns the underlying API object.
+func (r *release) APIObject() interface{} {
+	return &r.r
+}
+
+// Repository returns the repository that this release belongs to.
+func (r *release) Repository() gitprovider.RepositoryRef {
+	return r.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (r *release) Update(_ context.Context) error {
+	// PATCH /repos/{owner}/{repo}/releases/{id}
+	apiObj, resp, err := r.c.c.EditRelease(r.c.ref.GetIdentity(), r.c.ref.GetRepository(), r.r.ID, gitea.EditReleaseOption{
+		Title:        r.r.Title,
+		Note:         r.r.Note,
+		IsDraft:      &r.r.IsDraft,
+		IsPrerelease: &r.r.IsPrerelease,
+	})
+	if err != nil {
+		return handleHTTPError(resp, err)
+	}
+	if err := validateReleaseAPI(apiObj); err != nil {
+		return err
+	}
+	r.r = *apiObj
+	return nil
+}
+
+// Delete deletes the release from the repository irreversibly, the tag is kept.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource does not exist.
+func (r *release) Delete(_ context.Context) error {
+	// Don't allow deleting releases if the user didn't explicitly allow dangerous API calls.
+	if !r.c.destructiveActions {
+		return fmt.Errorf("cannot delete release: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repos/{owner}/{repo}/releases/{id}
+	resp, err := r.c.c.DeleteRelease(r.c.ref.GetIdentity(), r.c.ref.GetRepository(), r.r.ID)
+	return handleHTTPError(resp, err)
+}
+
+// UploadAsset attaches the content to the release as a file with the given name.
+func (r *release) UploadAsset(_ context.Context, name string, content io.Reader) (gitprovider.ReleaseAssetInfo, error) {
+	// POST /repos/{owner}/{repo}/releases/{id}/assets
+	apiObj, resp, err := r.c.c.CreateReleaseAttachment(r.c.ref.GetIdentity(), r.c.ref.GetRepository(), r.r.ID, content, name)
+	if err != nil {
+		return gitprovider.ReleaseAssetInfo{}, handleHTTPError(resp, err)
+	}
+	r.r.Attachments = append(r.r.Attachments, apiObj)
+	return releaseAssetFromAPI(apiObj), nil
+}
+
+func validateReleaseAPI(apiObj *gitea.Release) error {
+	return validateAPIObject("Gitea.Release", func(validator validation.Validator) {
+		if apiObj.ID == 0 {
+			validator.Required("ID")
+		}
+		if apiObj.TagName == "" {
+			validator.Required("TagName")
+		}
+	})
+}
+
+func releaseFromAPI(apiObj *gitea.Release) gitprovider.ReleaseInfo {
+	info := gitprovider.ReleaseInfo{
+		TagName:     apiObj.TagName,
+		Name:        gitprovider.StringVar(apiObj.Title),
+		Description: gitprovider.StringVar(apiObj.Note),
+		Draft:       gitprovider.BoolVar(apiObj.IsDraft),
+		Prerelease:  gitprovider.BoolVar(apiObj.IsPrerelease),
+	}
+	for _, attachment := range apiObj.Attachments {
+		info.Assets = append(info.Assets, releaseAssetFromAPI(attachment))
+	}
+	return info
+}
+
+func releaseAssetFromAPI(apiObj *gitea.Attachment) gitprovider.ReleaseAssetInfo {
+	return gitprovider.ReleaseAssetInfo{
+		Name: apiObj.Name,
+		URL:  apiObj.DownloadURL,
+		Size: apiObj.Size,
+	}
+}
+
+func releaseInfoToAPIObj(info *gitprovider.ReleaseInfo, apiObj *gitea.Release) {
+	// Only set fields that are set in the info, i.e. PATCH behaviour
+	if info.Name != nil {
+		apiObj.Title = *info.Name
+	}
+	if info.Description != nil {
+		apiObj.Note = *info.Description
+	}
+	if info.Draft != nil {
+		apiObj.IsDraft = *info.Draft
+	}
+	if info.Prerelease != nil {
+		apiObj.IsPrerelease = *info.Prerelease
+	}
+}
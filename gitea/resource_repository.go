@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"sort"
 
 	"code.gitea.io/sdk/gitea"
 
@@ -27,11 +28,16 @@ import (
 	"github.com/fluxcd/go-git-providers/validation"
 )
 
-func newUserRepository(ctx *clientContext, apiObj *gitea.Repository, ref gitprovider.RepositoryRef) *userRepository {
+func newUserRepository(ctx *clientContext, apiObj *gitea.Repository, topics []string, ref gitprovider.RepositoryRef) *userRepository {
 	return &userRepository{
 		clientContext: ctx,
 		r:             *apiObj,
+		topics:        topics,
 		ref:           ref,
+		collaborators: &CollaboratorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 		deployKeys: &DeployKeyClient{
 			clientContext: ctx,
 			ref:           ref,
@@ -48,10 +54,38 @@ func newUserRepository(ctx *clientContext, apiObj *gitea.Repository, ref gitprov
 			clientContext: ctx,
 			ref:           ref,
 		},
+		issues: &IssueClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		labels: &LabelClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 		files: &FileClient{
 			clientContext: ctx,
 			ref:           ref,
 		},
+		webhooks: &WebhookClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		commitStatuses: &CommitStatusClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		branchProtections: &BranchProtectionClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		tags: &TagClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		releases: &ReleaseClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 	}
 }
 
@@ -62,18 +96,30 @@ type userRepository struct {
 
 	r   gitea.Repository // gitea
 	ref gitprovider.RepositoryRef
-
-	deployKeys   *DeployKeyClient
-	commits      *CommitClient
-	branches     *BranchClient
-	pullRequests *PullRequestClient
-	files        *FileClient
-	trees        *TreeClient
+	// topics are the actual topics of the repository, as gitea.Repository doesn't contain them.
+	topics []string
+	// topicsUpdate are the topics to replace the actual ones with at Update, if set.
+	topicsUpdate []string
+
+	collaborators     *CollaboratorClient
+	deployKeys        *DeployKeyClient
+	commits           *CommitClient
+	branches          *BranchClient
+	pullRequests      *PullRequestClient
+	issues            *IssueClient
+	labels            *LabelClient
+	files             *FileClient
+	trees             *TreeClient
+	webhooks          *WebhookClient
+	commitStatuses    *CommitStatusClient
+	branchProtections *BranchProtectionClient
+	tags              *TagClient
+	releases          *ReleaseClient
 }
 
 // Get returns the repository information.
 func (r *userRepository) Get() gitprovider.RepositoryInfo {
-	return repositoryFromAPI(&r.r)
+	return repositoryFromAPI(&r.r, r.topics)
 }
 
 // Set sets the repository information.
@@ -81,7 +127,11 @@ func (r *userRepository) Set(info gitprovider.RepositoryInfo) error {
 	if err := info.ValidateInfo(); err != nil {
 		return err
 	}
+	if err := validateRepositorySupport(info); err != nil {
+		return err
+	}
 	repositoryInfoToAPIObj(&info, &r.r)
+	r.topicsUpdate = info.Topics
 	return nil
 }
 
@@ -95,6 +145,11 @@ func (r *userRepository) Repository() gitprovider.RepositoryRef {
 	return r.ref
 }
 
+// Collaborators returns the collaborator client.
+func (r *userRepository) Collaborators() gitprovider.CollaboratorClient {
+	return r.collaborators
+}
+
 // DeployKeys returns the deploy key client.
 func (r *userRepository) DeployKeys() gitprovider.DeployKeyClient {
 	return r.deployKeys
@@ -121,6 +176,16 @@ func (r *userRepository) PullRequests() gitprovider.PullRequestClient {
 	return r.pullRequests
 }
 
+// Issues returns the issue client.
+func (r *userRepository) Issues() gitprovider.IssueClient {
+	return r.issues
+}
+
+// Labels returns the label client.
+func (r *userRepository) Labels() gitprovider.LabelClient {
+	return r.labels
+}
+
 // Files returns the file client.
 func (r *userRepository) Files() gitprovider.FileClient {
 	return r.files
@@ -131,6 +196,31 @@ func (r *userRepository) Trees() gitprovider.TreeClient {
 	return r.trees
 }
 
+// Webhooks returns the webhook client.
+func (r *userRepository) Webhooks() gitprovider.WebhookClient {
+	return r.webhooks
+}
+
+// CommitStatuses returns the commit status client.
+func (r *userRepository) CommitStatuses() gitprovider.CommitStatusClient {
+	return r.commitStatuses
+}
+
+// BranchProtections returns the branch protection client.
+func (r *userRepository) BranchProtections() gitprovider.BranchProtectionClient {
+	return r.branchProtections
+}
+
+// Tags returns the tag client.
+func (r *userRepository) Tags() gitprovider.TagClient {
+	return r.tags
+}
+
+// Releases returns the release client.
+func (r *userRepository) Releases() gitprovider.ReleaseClient {
+	return r.releases
+}
+
 // Update will apply the desired state in this object to the server.
 // Only set fields will be respected (i.e. PATCH behaviour).
 // In order to apply changes to this object, use the .Set({Resource}Info) error
@@ -141,40 +231,83 @@ func (r *userRepository) Trees() gitprovider.TreeClient {
 //
 // The internal API object will be overridden with the received server data.
 func (r *userRepository) Update(ctx context.Context) error {
+	if r.topicsUpdate != nil && sameTopics(r.topicsUpdate, r.topics) {
+		r.topicsUpdate = nil
+	}
+	// Archived repositories are read-only, so replace the topics before archiving, and after
+	// unarchiving the repository
+	if r.r.Archived {
+		if err := r.replaceTopics(); err != nil {
+			return err
+		}
+	}
 	// PATCH /repos/{owner}/{repo}
-	opts := gitea.EditRepoOption{
-		Name:                      &r.r.Name,
-		Description:               &r.r.Description,
-		Website:                   &r.r.Website,
-		Private:                   &r.r.Private,
-		Template:                  &r.r.Template,
-		HasIssues:                 &r.r.HasIssues,
-		InternalTracker:           r.r.InternalTracker,
-		ExternalTracker:           r.r.ExternalTracker,
-		HasWiki:                   &r.r.HasWiki,
-		ExternalWiki:              r.r.ExternalWiki,
-		DefaultBranch:             &r.r.DefaultBranch,
-		HasPullRequests:           &r.r.HasPullRequests,
-		HasProjects:               &r.r.HasProjects,
-		IgnoreWhitespaceConflicts: &r.r.IgnoreWhitespaceConflicts,
-		AllowMerge:                &r.r.AllowMerge,
-		AllowRebase:               &r.r.AllowRebase,
-		AllowRebaseMerge:          &r.r.AllowRebaseMerge,
-		AllowSquash:               &r.r.AllowSquash,
-		Archived:                  &r.r.Archived,
-		DefaultMergeStyle:         &r.r.DefaultMergeStyle,
-	}
-	if r.r.Mirror {
-		opts.MirrorInterval = &r.r.MirrorInterval
-	}
-	apiObj, err := updateRepo(r.c, r.ref.GetIdentity(), r.ref.GetRepository(), &opts)
+	opts := editRepoOption(&r.r)
+	apiObj, err := updateRepo(r.c, r.ref.GetIdentity(), r.ref.GetRepository(), opts)
 	if err != nil {
 		return err
 	}
 	r.r = *apiObj
+	return r.replaceTopics()
+}
+
+// replaceTopics replaces the topics of the repository with the ones given to Set, if they weren't
+// replaced yet.
+func (r *userRepository) replaceTopics() error {
+	if r.topicsUpdate == nil {
+		return nil
+	}
+	// PUT /repos/{owner}/{repo}/topics
+	if err := setRepoTopics(r.c, r.ref.GetIdentity(), r.ref.GetRepository(), r.topicsUpdate); err != nil {
+		return err
+	}
+	r.topics = r.topicsUpdate
+	r.topicsUpdate = nil
 	return nil
 }
 
+// sameTopics returns whether a and b contain the same topics, ignoring their order.
+func sameTopics(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return reflect.DeepEqual(a, b)
+}
+
+// editRepoOption returns the options for editing the repository so that it matches apiObj.
+func editRepoOption(apiObj *gitea.Repository) *gitea.EditRepoOption {
+	opts := &gitea.EditRepoOption{
+		Name:                      &apiObj.Name,
+		Description:               &apiObj.Description,
+		Website:                   &apiObj.Website,
+		Private:                   &apiObj.Private,
+		Template:                  &apiObj.Template,
+		HasIssues:                 &apiObj.HasIssues,
+		InternalTracker:           apiObj.InternalTracker,
+		ExternalTracker:           apiObj.ExternalTracker,
+		HasWiki:                   &apiObj.HasWiki,
+		ExternalWiki:              apiObj.ExternalWiki,
+		DefaultBranch:             &apiObj.DefaultBranch,
+		HasPullRequests:           &apiObj.HasPullRequests,
+		HasProjects:               &apiObj.HasProjects,
+		IgnoreWhitespaceConflicts: &apiObj.IgnoreWhitespaceConflicts,
+		AllowMerge:                &apiObj.AllowMerge,
+		AllowRebase:               &apiObj.AllowRebase,
+		AllowRebaseMerge:          &apiObj.AllowRebaseMerge,
+		AllowSquash:               &apiObj.AllowSquash,
+		Archived:                  &apiObj.Archived,
+		DefaultMergeStyle:         &apiObj.DefaultMergeStyle,
+	}
+	if apiObj.Mirror {
+		opts.MirrorInterval = &apiObj.MirrorInterval
+	}
+	return opts
+}
+
 // Reconcile makes sure the desired state in this object (called "req" here) becomes
 // the actual state in the backing Git provider.
 //
@@ -204,18 +337,25 @@ func (r *userRepository) Reconcile(ctx context.Context) (bool, error) {
 				return true, err
 			}
 			r.r = *repo
-			return true, nil
+			r.topics = []string{}
+			return true, r.replaceTopics()
 		}
 
 		return false, err
 	}
+	// GET /repos/{owner}/{repo}/topics
+	topics, err := getRepoTopics(r.c, r.ref.GetIdentity(), r.ref.GetRepository())
+	if err != nil {
+		return false, err
+	}
+	r.topics = topics
 
 	// Use wrappers here to extract the "spec" part of the object for comparison
 	desiredSpec := newGiteaRepositorySpec(&r.r)
 	actualSpec := newGiteaRepositorySpec(apiObj)
 
 	// If desired state already is the actual state, do nothing
-	if desiredSpec.Equals(actualSpec) {
+	if desiredSpec.Equals(actualSpec) && (r.topicsUpdate == nil || sameTopics(r.topicsUpdate, topics)) {
 		return false, nil
 	}
 	// Otherwise, make the desired state the actual state
@@ -229,9 +369,9 @@ func (r *userRepository) Delete(ctx context.Context) error {
 	return deleteRepo(r.c, r.ref.GetIdentity(), r.ref.GetRepository(), r.destructiveActions)
 }
 
-func newOrgRepository(ctx *clientContext, apiObj *gitea.Repository, ref gitprovider.RepositoryRef) *orgRepository {
+func newOrgRepository(ctx *clientContext, apiObj *gitea.Repository, topics []string, ref gitprovider.RepositoryRef) *orgRepository {
 	return &orgRepository{
-		userRepository: *newUserRepository(ctx, apiObj, ref),
+		userRepository: *newUserRepository(ctx, apiObj, topics, ref),
 		teamAccess: &TeamAccessClient{
 			clientContext: ctx,
 			ref:           ref,
@@ -271,7 +411,7 @@ func validateRepositoryAPI(apiObj *gitea.Repository) error {
 	})
 }
 
-func repositoryFromAPI(apiObj *gitea.Repository) gitprovider.RepositoryInfo {
+func repositoryFromAPI(apiObj *gitea.Repository, topics []string) gitprovider.RepositoryInfo {
 	repo := gitprovider.RepositoryInfo{
 		Description:   &apiObj.Description,
 		DefaultBranch: &apiObj.DefaultBranch,
@@ -281,6 +421,22 @@ func repositoryFromAPI(apiObj *gitea.Repository) gitprovider.RepositoryInfo {
 	} else {
 		repo.Visibility = gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibility("private"))
 	}
+	repo.Topics = topics
+	repo.Homepage = &apiObj.Website
+	repo.Archived = &apiObj.Archived
+	repo.HasIssues = &apiObj.HasIssues
+	repo.HasWiki = &apiObj.HasWiki
+	repo.HasProjects = &apiObj.HasProjects
+	if apiObj.AllowMerge {
+		repo.AllowedMergeMethods = append(repo.AllowedMergeMethods, gitprovider.MergeMethodMerge)
+	}
+	if apiObj.AllowSquash {
+		repo.AllowedMergeMethods = append(repo.AllowedMergeMethods, gitprovider.MergeMethodSquash)
+	}
+	if apiObj.AllowRebase {
+		repo.AllowedMergeMethods = append(repo.AllowedMergeMethods, gitprovider.MergeMethodRebase)
+	}
+	repo.IsTemplate = &apiObj.Template
 	return repo
 }
 
@@ -302,6 +458,10 @@ func repositoryInfoToCreateOption(repo *gitprovider.RepositoryInfo, apiObj *gite
 	if repo.Visibility != nil {
 		apiObj.Private = *gitprovider.BoolVar(string(*repo.Visibility) == "private")
 	}
+	// The other settings are set by editing the repository after creating it
+	if repo.IsTemplate != nil {
+		apiObj.Template = *repo.IsTemplate
+	}
 }
 
 func repositoryInfoToAPIObj(repo *gitprovider.RepositoryInfo, apiObj *gitea.Repository) {
@@ -314,6 +474,48 @@ func repositoryInfoToAPIObj(repo *gitprovider.RepositoryInfo, apiObj *gitea.Repo
 	if repo.Visibility != nil {
 		apiObj.Private = *gitprovider.BoolVar(string(*repo.Visibility) == "private")
 	}
+	if repo.Homepage != nil {
+		apiObj.Website = *repo.Homepage
+	}
+	if repo.Archived != nil {
+		apiObj.Archived = *repo.Archived
+	}
+	if repo.HasIssues != nil {
+		apiObj.HasIssues = *repo.HasIssues
+	}
+	if repo.HasWiki != nil {
+		apiObj.HasWiki = *repo.HasWiki
+	}
+	if repo.HasProjects != nil {
+		apiObj.HasProjects = *repo.HasProjects
+	}
+	if repo.AllowedMergeMethods != nil {
+		apiObj.AllowMerge = hasMergeMethod(repo.AllowedMergeMethods, gitprovider.MergeMethodMerge)
+		apiObj.AllowSquash = hasMergeMethod(repo.AllowedMergeMethods, gitprovider.MergeMethodSquash)
+		apiObj.AllowRebase = hasMergeMethod(repo.AllowedMergeMethods, gitprovider.MergeMethodRebase)
+	}
+	if repo.IsTemplate != nil {
+		apiObj.Template = *repo.IsTemplate
+	}
+}
+
+func hasMergeMethod(methods []gitprovider.MergeMethod, method gitprovider.MergeMethod) bool {
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// unsupportedRepositoryFields are the gitprovider.RepositoryInfo fields the Gitea SDK has no
+// equivalent for.
+var unsupportedRepositoryFields = []string{"deleteBranchOnMerge"}
+
+// validateRepositorySupport returns ErrNoProviderSupport if info sets fields the Gitea SDK has no
+// equivalent for.
+func validateRepositorySupport(info gitprovider.RepositoryInfo) error {
+	return gitprovider.ValidateUnsupportedFields(ProviderID, info, unsupportedRepositoryFields...)
 }
 
 // This function copies over the fields that are part of create/update requests of a repository
@@ -331,9 +533,11 @@ func newGiteaRepositorySpec(repo *gitea.Repository) *giteaRepositorySpec {
 			HasProjects: repo.HasProjects,
 			HasWiki:     repo.HasWiki,
 			Internal:    repo.Internal,
+			Template:    repo.Template,
 
 			// Update-specific parameters
 			DefaultBranch: repo.DefaultBranch,
+			Archived:      repo.Archived,
 
 			// Create-specific parameters
 
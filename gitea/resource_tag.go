@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newTag(c *TagClient, tag *gitea.Tag) *tagType {
+	return &tagType{
+		t: *tag,
+		c: c,
+	}
+}
+
+var _ gitprovider.Tag = &tagType{}
+
+type tagType struct {
+	t gitea.Tag
+	c *TagClient
+}
+
+// Get returns the tag information.
+func (t *tagType) Get() gitprovider.TagInfo {
+	return tagFromAPI(&t.t)
+}
+
+// APIObject returns the underlying API object.
+func (t *tagType) APIObject() interface{} {
+	return &t.t
+}
+
+// Repository returns the repository that this tag belongs to.
+func (t *tagType) Repository() gitprovider.RepositoryRef {
+	return t.c.ref
+}
+
+func validateTagAPI(apiObj *gitea.Tag) error {
+	return validateAPIObject("Gitea.Tag", func(validator validation.Validator) {
+		// Make sure the name and commit are populated as per
+		// https://gitea.com/api/swagger#/repository/repoGetTag
+		if apiObj.Name == "" {
+			validator.Required("Name")
+		}
+		if apiObj.Commit == nil || apiObj.Commit.SHA == "" {
+			validator.Required("Commit.SHA")
+		}
+	})
+}
+
+func tagFromAPI(apiObj *gitea.Tag) gitprovider.TagInfo {
+	info := gitprovider.TagInfo{
+		Name: apiObj.Name,
+		Sha:  apiObj.Commit.SHA,
+	}
+	// Lightweight tags are returned with the commit message, and the commit sha as ID
+	if apiObj.ID != apiObj.Commit.SHA {
+		info.Message = gitprovider.StringVar(apiObj.Message)
+	}
+	return info
+}
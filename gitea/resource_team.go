@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newTeam(c *TeamsClient, apiObj *gitea.Team, users []*gitea.User, members []string) *team {
+	return &team{
+		t:       *apiObj,
+		users:   users,
+		members: members,
+		c:       c,
+	}
+}
+
+var _ gitprovider.Team = &team{}
+
+type team struct {
+	t       gitea.Team
+	users   []*gitea.User
+	members []string
+	c       *TeamsClient
+}
+
+// Get returns the team information.
+func (t *team) Get() gitprovider.TeamInfo {
+	info := gitprovider.TeamInfo{
+		Name: t.t.Name,
+		// Teams are visible to all members of their organization
+		Privacy: gitprovider.TeamPrivacyVar(gitprovider.TeamPrivacyClosed),
+		Members: t.members,
+	}
+	if t.t.Description != "" {
+		info.Description = gitea.OptionalString(t.t.Description)
+	}
+	return info
+}
+
+// Set sets the team information. The name of the team can't be changed.
+func (t *team) Set(info gitprovider.TeamInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.Name != t.t.Name {
+		return fmt.Errorf("cannot rename team %q: %w", t.t.Name, gitprovider.ErrInvalidArgument)
+	}
+	if err := validateTeamPrivacy(info); err != nil {
+		return err
+	}
+	t.t.Description = ""
+	if info.Description != nil {
+		t.t.Description = *info.Description
+	}
+	return nil
+}
+
+// APIObject returns the members of the team.
+func (t *team) APIObject() interface{} {
+	return t.users
+}
+
+// Organization returns the organization that this team belongs to.
+func (t *team) Organization() gitprovider.OrganizationRef {
+	return t.c.ref
+}
+
+// Members returns the client operating on the members of the team.
+func (t *team) Members() gitprovider.TeamMemberClient {
+	return &TeamMemberClient{
+		clientContext: t.c.clientContext,
+		teams:         t.c,
+		teamID:        t.t.ID,
+	}
+}
+
+// Update will apply the desired description in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (t *team) Update(_ context.Context) error {
+	// PATCH /teams/{id}
+	res, err := t.c.c.EditTeam(t.t.ID, gitea.EditTeamOption{
+		Name:        t.t.Name,
+		Description: &t.t.Description,
+		Permission:  t.t.Permission,
+		Units:       t.t.Units,
+	})
+	return handleHTTPError(res, err)
+}
+
+// Delete deletes the team from the organization.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource does not exist.
+func (t *team) Delete(_ context.Context) error {
+	// Don't allow deleting teams if the user didn't explicitly allow dangerous API calls.
+	if !t.c.destructiveActions {
+		return fmt.Errorf("cannot delete team: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /teams/{id}
+	res, err := t.c.c.DeleteTeam(t.t.ID)
+	return handleHTTPError(res, err)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (t *team) Reconcile(ctx context.Context) (bool, error) {
+	req := t.Get()
+	actual, err := t.c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := t.c.Create(ctx, req)
+			if err != nil {
+				return true, err
+			}
+			*t = *resp.(*team)
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if req.Equals(actual.Get()) {
+		return false, nil
+	}
+	// Update the actual team, keeping its permission and units
+	desired := t.t.Description
+	*t = *actual.(*team)
+	t.t.Description = desired
+	return true, t.Update(ctx)
+}
+
+// validateTeamPrivacy returns an error if info requests a privacy Gitea teams can't have.
+func validateTeamPrivacy(info gitprovider.TeamInfo) error {
+	if info.Privacy != nil && *info.Privacy != gitprovider.TeamPrivacyClosed {
+		return fmt.Errorf("teams are visible to all members of the organization, %q privacy: %w",
+			*info.Privacy, gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"code.gitea.io/sdk/gitea"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// webhookEvents maps the generic webhook events to Gitea's event names, see
+// https://docs.gitea.com/usage/webhooks
+//
+//nolint:gochecknoglobals
+var webhookEvents = map[gitprovider.WebhookEvent][]string{
+	gitprovider.WebhookEventPush:        {"push"},
+	gitprovider.WebhookEventTagPush:     {"create", "delete"},
+	gitprovider.WebhookEventPullRequest: {"pull_request"},
+	gitprovider.WebhookEventIssues:      {"issues"},
+	gitprovider.WebhookEventComment:     {"issue_comment"},
+	gitprovider.WebhookEventRelease:     {"release"},
+}
+
+func newWebhook(c *WebhookClient, hook *gitea.Hook) *webhook {
+	return &webhook{
+		h: *hook,
+		c: c,
+	}
+}
+
+var _ gitprovider.Webhook = &webhook{}
+
+type webhook struct {
+	h gitea.Hook
+	c *WebhookClient
+}
+
+// Get returns the webhook information.
+func (wh *webhook) Get() gitprovider.WebhookInfo {
+	return webhookFromAPI(&wh.h)
+}
+
+// Set sets the webhook information.
+func (wh *webhook) Set(info gitprovider.WebhookInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	return webhookInfoToAPIObj(&info, &wh.h)
+}
+
+// APIObject returns the underlying API object.
+func (wh *webhook) APIObject() interface{} {
+	return &wh.h
+}
+
+// Repository returns the repository that this webhook belongs to.
+func (wh *webhook) Repository() gitprovider.RepositoryRef {
+	return wh.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (wh *webhook) Update(ctx context.Context) error {
+	apiObj, err := wh.c.editHook(wh.c.ref.GetIdentity(), wh.c.ref.GetRepository(), &wh.h)
+	if err != nil {
+		return err
+	}
+	wh.h = *apiObj
+	return nil
+}
+
+// Delete deletes a webhook from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (wh *webhook) Delete(ctx context.Context) error {
+	return wh.c.deleteHook(wh.c.ref.GetIdentity(), wh.c.ref.GetRepository(), wh.h.ID)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (wh *webhook) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := wh.c.get(ctx, wh.h.Config["url"])
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, wh.createIntoSelf(ctx)
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// Use wrappers here to extract the "spec" part of the object for comparison
+	desiredSpec := newGiteaHookSpec(&wh.h)
+	actualSpec := newGiteaHookSpec(&actual.h)
+
+	// If the desired matches the actual state, do nothing
+	if desiredSpec.Equals(actualSpec) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	wh.h.ID = actual.h.ID
+	return true, wh.Update(ctx)
+}
+
+func (wh *webhook) createIntoSelf(ctx context.Context) error {
+	apiObj, err := wh.c.createHook(wh.c.ref.GetIdentity(), wh.c.ref.GetRepository(), &wh.h)
+	if err != nil {
+		return err
+	}
+	wh.h = *apiObj
+	return nil
+}
+
+func validateWebhookAPI(apiObj *gitea.Hook) error {
+	return validateAPIObject("Gitea.Hook", func(validator validation.Validator) {
+		// Make sure ID and the config URL are populated as per
+		// https://gitea.com/api/swagger#/repository/repoGetHook
+		if apiObj.ID == 0 {
+			validator.Required("ID")
+		}
+		if apiObj.Config["url"] == "" {
+			validator.Required("Config.URL")
+		}
+	})
+}
+
+func webhookFromAPI(apiObj *gitea.Hook) gitprovider.WebhookInfo {
+	info := gitprovider.WebhookInfo{
+		URL:    apiObj.Config["url"],
+		Events: webhookEventsFromAPI(apiObj.Events),
+		// Gitea has no per-webhook setting for skipping TLS verification
+		InsecureSSL: gitprovider.BoolVar(false),
+		Active:      gitprovider.BoolVar(apiObj.Active),
+	}
+	if contentType, ok := apiObj.Config["content_type"]; ok {
+		info.ContentType = gitprovider.WebhookContentTypeVar(gitprovider.WebhookContentType(contentType))
+	}
+	return info
+}
+
+func webhookToAPI(info *gitprovider.WebhookInfo) (*gitea.Hook, error) {
+	h := &gitea.Hook{}
+	if err := webhookInfoToAPIObj(info, h); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func webhookInfoToAPIObj(info *gitprovider.WebhookInfo, apiObj *gitea.Hook) error {
+	if info.InsecureSSL != nil && *info.InsecureSSL {
+		return fmt.Errorf("skipping TLS verification for a webhook: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if apiObj.Config == nil {
+		apiObj.Config = map[string]string{}
+	}
+	// Required fields, we assume info is validated, and hence these are set
+	apiObj.Config["url"] = info.URL
+	// optional fields
+	if info.Secret != nil {
+		apiObj.Config["secret"] = *info.Secret
+	}
+	if info.ContentType != nil {
+		apiObj.Config["content_type"] = string(*info.ContentType)
+	}
+	if info.Events != nil {
+		events, err := webhookEventsToAPI(info.Events)
+		if err != nil {
+			return err
+		}
+		apiObj.Events = events
+	}
+	if info.Active != nil {
+		apiObj.Active = *info.Active
+	}
+	return nil
+}
+
+func webhookEventsToAPI(events []gitprovider.WebhookEvent) ([]string, error) {
+	apiEvents := []string{}
+	for _, event := range events {
+		names, ok := webhookEvents[event]
+		if !ok {
+			return nil, fmt.Errorf("webhook event %q: %w", event, gitprovider.ErrNoProviderSupport)
+		}
+		apiEvents = append(apiEvents, names...)
+	}
+	return apiEvents, nil
+}
+
+// webhookEventsFromAPI returns the generic events for which all of Gitea's
+// corresponding events are subscribed to. Unknown events are ignored.
+func webhookEventsFromAPI(apiEvents []string) []gitprovider.WebhookEvent {
+	subscribed := make(map[string]struct{}, len(apiEvents))
+	for _, name := range apiEvents {
+		subscribed[name] = struct{}{}
+	}
+	events := []gitprovider.WebhookEvent{}
+	for event, names := range webhookEvents {
+		found := true
+		for _, name := range names {
+			if _, ok := subscribed[name]; !ok {
+				found = false
+			}
+		}
+		if found {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i] < events[j] })
+	return events
+}
+
+// This function copies over the fields that are part of create/update requests of a webhook
+// i.e. the desired spec of the webhook. This allows us to separate "spec" from "status" fields.
+// The secret is not part of the spec, as Gitea doesn't return it.
+func newGiteaHookSpec(hook *gitea.Hook) *giteaHookSpec {
+	config := map[string]string{}
+	for _, key := range []string{"url", "content_type"} {
+		if value, ok := hook.Config[key]; ok {
+			config[key] = value
+		}
+	}
+	events := append([]string{}, hook.Events...)
+	sort.Strings(events)
+	return &giteaHookSpec{
+		&gitea.Hook{
+			// Create-specific parameters
+			// See: https://gitea.com/api/swagger#/repository/repoCreateHook
+			Config: config,
+			Events: events,
+			Active: hook.Active,
+		},
+	}
+}
+
+type giteaHookSpec struct {
+	*gitea.Hook
+}
+
+// Equals compares two giteaHookSpec objects for equality.
+func (s *giteaHookSpec) Equals(other *giteaHookSpec) bool {
+	return reflect.DeepEqual(s, other)
+}
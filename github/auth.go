@@ -86,5 +86,9 @@ func NewClient(optFns ...gitprovider.ClientOption) (gitprovider.Client, error) {
 		destructiveActions = *opts.EnableDestructiveAPICalls
 	}
 
-	return newClient(gh, domain, destructiveActions), nil
+	c := newClient(gh, domain, destructiveActions)
+	if opts.DryRun != nil && *opts.DryRun {
+		c.dryRun = gitprovider.NewDryRun(destructiveActions, dryRunValidator{domain: domain})
+	}
+	return c, nil
 }
@@ -61,6 +61,9 @@ type Client struct {
 	orgs      *OrganizationsClient
 	orgRepos  *OrgRepositoriesClient
 	userRepos *UserRepositoriesClient
+
+	// dryRun is set if the client was created using gitprovider.WithDryRun(true).
+	dryRun *gitprovider.DryRun
 }
 
 // SupportedDomain returns the domain endpoint for this client, e.g. "github.com", "enterprise.github.com" or
@@ -85,19 +88,34 @@ func (c *Client) Raw() interface{} {
 
 // Organizations returns the OrganizationsClient handling sets of organizations.
 func (c *Client) Organizations() gitprovider.OrganizationsClient {
+	if c.dryRun != nil {
+		return c.dryRun.Organizations(c.orgs)
+	}
 	return c.orgs
 }
 
 // OrgRepositories returns the OrgRepositoriesClient handling sets of repositories in an organization.
 func (c *Client) OrgRepositories() gitprovider.OrgRepositoriesClient {
+	if c.dryRun != nil {
+		return c.dryRun.OrgRepositories(c.orgRepos)
+	}
 	return c.orgRepos
 }
 
 // UserRepositories returns the UserRepositoriesClient handling sets of repositories for a user.
 func (c *Client) UserRepositories() gitprovider.UserRepositoriesClient {
+	if c.dryRun != nil {
+		return c.dryRun.UserRepositories(c.userRepos)
+	}
 	return c.userRepos
 }
 
+// DryRunPlan returns the Plan recording the write API calls skipped by this client, if it was
+// created using gitprovider.WithDryRun(true). Otherwise, nil is returned.
+func (c *Client) DryRunPlan() *gitprovider.Plan {
+	return c.dryRun.Plan()
+}
+
 //nolint:gochecknoglobals
 var permissionScopes = map[gitprovider.TokenPermission]string{
 	gitprovider.TokenPermissionRWRepository: "repo",
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TeamMemberClient implements the gitprovider.TeamMemberClient interface.
+var _ gitprovider.TeamMemberClient = &TeamMemberClient{}
+
+// TeamMemberClient operates on the members of a specific team.
+type TeamMemberClient struct {
+	*clientContext
+	ref  gitprovider.OrganizationRef
+	team string
+}
+
+// List all members of the team, with their roles.
+//
+// List returns all available members, using multiple paginated requests if needed.
+func (c *TeamMemberClient) List(ctx context.Context) ([]gitprovider.TeamMemberInfo, error) {
+	members := []gitprovider.TeamMemberInfo{}
+	// List the maintainers and the regular members separately, as the role isn't returned
+	for _, role := range []gitprovider.TeamMemberRole{gitprovider.TeamMemberRoleMaintainer, gitprovider.TeamMemberRoleMember} {
+		// GET /orgs/{org}/teams/{team_slug}/members
+		apiObjs, err := c.c.ListOrgTeamMembers(ctx, c.ref.Organization, c.team, string(role))
+		if err != nil {
+			return nil, err
+		}
+		for _, apiObj := range apiObjs {
+			// Login is validated to be non-nil in ListOrgTeamMembers
+			members = append(members, gitprovider.TeamMemberInfo{
+				Login: *apiObj.Login,
+				Role:  gitprovider.TeamMemberRoleVar(role),
+			})
+		}
+	}
+	return members, nil
+}
+
+// Add adds the user to the team with the given role. If the user already is a member of
+// the team, their role is changed to the given one.
+//
+// Users that aren't members of the organization yet are invited to it, they only show up
+// as members of the team once they accept the invitation.
+func (c *TeamMemberClient) Add(ctx context.Context, req gitprovider.TeamMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+	// PUT /orgs/{org}/teams/{team_slug}/memberships/{username}
+	return c.c.AddTeamMembership(ctx, c.ref.Organization, c.team, req.Login, string(*req.Role))
+}
+
+// Remove removes the user with the given login from the team.
+//
+// ErrNotFound is returned if the user isn't a member of the team.
+func (c *TeamMemberClient) Remove(ctx context.Context, login string) error {
+	// DELETE /orgs/{org}/teams/{team_slug}/memberships/{username}
+	return c.c.RemoveTeamMembership(ctx, c.ref.Organization, c.team, login)
+}
+
+// Reconcile makes sure the users in req are members of the team with the desired roles,
+// adding them or changing their role as needed. With the Exclusive option, the members of
+// the team that aren't in req are removed.
+func (c *TeamMemberClient) Reconcile(ctx context.Context, req []gitprovider.TeamMemberInfo, opts ...gitprovider.TeamMemberReconcileOption) (bool, error) {
+	return gitprovider.ReconcileTeamMembers(ctx, c, req, opts...)
+}
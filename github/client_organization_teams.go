@@ -18,8 +18,7 @@ package github
 
 import (
 	"context"
-
-	"github.com/google/go-github/v57/github"
+	"errors"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
@@ -40,8 +39,13 @@ type TeamsClient struct {
 //
 // ErrNotFound is returned if the resource does not exist.
 func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Team, error) {
+	// GET /orgs/{org}/teams/{team_slug}
+	apiObj, err := c.c.GetTeam(ctx, c.ref.Organization, teamName)
+	if err != nil {
+		return nil, err
+	}
 	// GET /orgs/{org}/teams/{team_slug}/members
-	apiObjs, err := c.c.ListOrgTeamMembers(ctx, c.ref.Organization, teamName)
+	apiObjs, err := c.c.ListOrgTeamMembers(ctx, c.ref.Organization, teamName, "all")
 	if err != nil {
 		return nil, err
 	}
@@ -53,14 +57,10 @@ func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Tea
 		logins = append(logins, *apiObj.Login)
 	}
 
-	return &team{
-		users: apiObjs,
-		info: gitprovider.TeamInfo{
-			Name:    teamName,
-			Members: logins,
-		},
-		ref: c.ref,
-	}, nil
+	info := teamFromAPI(apiObj)
+	info.Name = teamName
+	info.Members = logins
+	return newTeam(c, apiObj, apiObjs, info), nil
 }
 
 // List all teams (recursively, in terms of subgroups) within the specific organization.
@@ -89,22 +89,60 @@ func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
 	return teams, nil
 }
 
-var _ gitprovider.Team = &team{}
+// Create creates a team with the given specifications. Members of req are ignored.
+//
+// The name of the team in req is used as the name of the new team, its slug is derived from it.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamsClient) Create(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
 
-type team struct {
-	users []*github.User
-	info  gitprovider.TeamInfo
-	ref   gitprovider.OrganizationRef
+	// POST /orgs/{org}/teams
+	apiObj, err := c.c.CreateTeam(ctx, c.ref.Organization, teamToAPI(req.Name, req))
+	if err != nil {
+		return nil, err
+	}
+	info := teamFromAPI(apiObj)
+	info.Name = apiObj.GetSlug()
+	return newTeam(c, apiObj, nil, info), nil
 }
 
-func (t *team) Get() gitprovider.TeamInfo {
-	return t.info
-}
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamsClient) Reconcile(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
 
-func (t *team) APIObject() interface{} {
-	return t.users
-}
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
 
-func (t *team) Organization() gitprovider.OrganizationRef {
-	return t.ref
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
 }
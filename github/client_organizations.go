@@ -18,6 +18,10 @@ package github
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v57/github"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
@@ -80,3 +84,121 @@ func (c *OrganizationsClient) List(ctx context.Context) ([]gitprovider.Organizat
 func (c *OrganizationsClient) Children(_ context.Context, _ gitprovider.OrganizationRef) ([]gitprovider.Organization, error) {
 	return nil, gitprovider.ErrNoProviderSupport
 }
+
+// Create creates an organization with the given name and description, owned by the
+// authenticated user. GitHub organizations are visible to everyone, hence only the public
+// visibility is supported.
+//
+// Organizations can only be created using the admin API of GitHub Enterprise, hence
+// ErrNoProviderSupport is returned for GitHub.com.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrganizationsClient) Create(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, error) {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+	if err := validateOrganizationCreate(req, c.domain); err != nil {
+		return nil, err
+	}
+
+	// GET /user
+	user, err := c.c.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// POST /admin/organizations
+	if _, err := c.c.CreateOrg(ctx, ref.Organization, user.GetLogin()); err != nil {
+		return nil, err
+	}
+	// The name and description can't be given when creating the organization
+	// PATCH /orgs/{org}
+	apiObj, err := c.c.EditOrg(ctx, ref.Organization, organizationToAPI(req))
+	if err != nil {
+		return nil, err
+	}
+	return newOrganization(c.clientContext, apiObj, ref), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrganizationsClient) Reconcile(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, bool, error) {
+	if err := validateOrganizationInfo(req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, ref)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, ref, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// PATCH /orgs/{org}
+	apiObj, err := c.c.EditOrg(ctx, ref.Organization, organizationToAPI(req))
+	if err != nil {
+		return actual, true, err
+	}
+	return newOrganization(c.clientContext, apiObj, ref), true, nil
+}
+
+// Delete deletes the organization, including all its repositories.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource doesn't exist.
+func (c *OrganizationsClient) Delete(ctx context.Context, ref gitprovider.OrganizationRef) error {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return err
+	}
+	// DELETE /orgs/{org}
+	return c.c.DeleteOrg(ctx, ref.Organization)
+}
+
+// validateOrganizationCreate validates creating an organization on the given domain.
+func validateOrganizationCreate(req gitprovider.OrganizationInfo, domain string) error {
+	if err := validateOrganizationInfo(req); err != nil {
+		return err
+	}
+	if domain == DefaultDomain {
+		return fmt.Errorf("organizations can only be created in GitHub Enterprise: %w", gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
+
+// validateOrganizationInfo validates req, and returns an error if it requests a visibility
+// GitHub organizations can't have.
+func validateOrganizationInfo(req gitprovider.OrganizationInfo) error {
+	if err := req.ValidateInfo(); err != nil {
+		return err
+	}
+	if req.Visibility != nil && *req.Visibility != gitprovider.OrganizationVisibilityPublic {
+		return fmt.Errorf("organizations are visible to everyone, %q visibility: %w", *req.Visibility, gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
+
+func organizationToAPI(req gitprovider.OrganizationInfo) *github.Organization {
+	// An empty description removes it
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+	return &github.Organization{
+		Name:        req.Name,
+		Description: &description,
+	}
+}
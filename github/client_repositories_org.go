@@ -98,10 +98,16 @@ func (c *OrgRepositoriesClient) Create(ctx context.Context, ref gitprovider.OrgR
 // If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 // If req is already the actual state, this is a no-op (actionTaken == false).
 func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, ref, req, opts...)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.OrgRepositoriesClient.ReconcileWithChanges.
+func (c *OrgRepositoriesClient) ReconcileWithChanges(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, []gitprovider.FieldChange, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	actual, err := c.Get(ctx, ref)
@@ -109,15 +115,15 @@ func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.O
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
-			return resp, true, err
+			return resp, req.Diff(gitprovider.RepositoryInfo{}), err
 		}
 
 		// Unexpected path, Get should succeed or return NotFound
-		return nil, false, err
+		return nil, nil, err
 	}
 	// Run generic reconciliation
-	actionTaken, err := reconcileRepository(ctx, actual, req)
-	return actual, actionTaken, err
+	changes, err := reconcileRepository(ctx, actual, req)
+	return actual, changes, err
 }
 
 func createRepository(ctx context.Context, c githubClient, ref gitprovider.RepositoryRef, orgName string, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryCreateOption) (*github.Repository, error) {
@@ -137,20 +143,39 @@ func createRepository(ctx context.Context, c githubClient, ref gitprovider.Repos
 	data := repositoryToAPI(&req, ref)
 	applyRepoCreateOptions(&data, o)
 
-	return c.CreateRepo(ctx, orgName, &data)
+	apiObj, err := c.CreateRepo(ctx, orgName, &data)
+	if err != nil {
+		return nil, err
+	}
+	// GitHub ignores the topics and archived fields when creating a repository, so set them
+	// afterwards, archiving the repository last as it becomes read-only
+	if req.Topics != nil {
+		// PUT /repos/{owner}/{repo}/topics
+		topics, err := c.ReplaceRepoTopics(ctx, ref.GetIdentity(), ref.GetRepository(), req.Topics)
+		if err != nil {
+			return nil, err
+		}
+		apiObj.Topics = topics
+	}
+	if req.Archived != nil && *req.Archived {
+		// PATCH /repos/{owner}/{repo}
+		return c.UpdateRepo(ctx, ref.GetIdentity(), ref.GetRepository(), &github.Repository{Archived: req.Archived})
+	}
+	return apiObj, nil
 }
 
-func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
+func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) ([]gitprovider.FieldChange, error) {
 	// If the desired matches the actual state, just return the actual state
-	if req.Equals(actual.Get()) {
-		return false, nil
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return nil, nil
 	}
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
-		return false, err
+		return nil, err
 	}
 	// Apply the desired state by running Update
-	return true, actual.Update(ctx)
+	return changes, actual.Update(ctx)
 }
 
 func toCreateOpts(opts ...gitprovider.RepositoryReconcileOption) []gitprovider.RepositoryCreateOption {
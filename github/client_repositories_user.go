@@ -132,10 +132,16 @@ func (c *UserRepositoriesClient) Create(ctx context.Context,
 // If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 // If req is already the actual state, this is a no-op (actionTaken == false).
 func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.UserRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.UserRepository, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, ref, req, opts...)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.UserRepositoriesClient.ReconcileWithChanges.
+func (c *UserRepositoriesClient) ReconcileWithChanges(ctx context.Context, ref gitprovider.UserRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.UserRepository, []gitprovider.FieldChange, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	actual, err := c.Get(ctx, ref)
@@ -143,14 +149,14 @@ func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
-			return resp, true, err
+			return resp, req.Diff(gitprovider.RepositoryInfo{}), err
 		}
 
 		// Unexpected path, Get should succeed or return NotFound
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	// Run generic reconciliation
-	actionTaken, err := reconcileRepository(ctx, actual, req)
-	return actual, actionTaken, err
+	changes, err := reconcileRepository(ctx, actual, req)
+	return actual, changes, err
 }
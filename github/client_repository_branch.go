@@ -18,6 +18,8 @@ package github
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/google/go-github/v57/github"
@@ -32,6 +34,38 @@ type BranchClient struct {
 	ref gitprovider.RepositoryRef
 }
 
+// Get returns the branch with the given name.
+//
+// ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Get(ctx context.Context, branch string) (gitprovider.Branch, error) {
+	// GET /repos/{owner}/{repo}/branches/{branch}
+	apiObj, err := c.c.GetBranch(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch)
+	if err != nil {
+		return nil, err
+	}
+	return newBranch(c, apiObj), nil
+}
+
+// List lists all branches of the repository.
+//
+// List returns all available branches for the given repository,
+// using multiple paginated requests if needed.
+func (c *BranchClient) List(ctx context.Context) ([]gitprovider.Branch, error) {
+	// GET /repos/{owner}/{repo}/branches
+	apiObjs, err := c.c.ListBranches(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Branch type
+	branches := make([]gitprovider.Branch, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at ListBranches
+		branches = append(branches, newBranch(c, apiObj))
+	}
+	return branches, nil
+}
+
 // Create creates a branch with the given specifications.
 func (c *BranchClient) Create(ctx context.Context, branch, sha string) error {
 
@@ -50,3 +84,33 @@ func (c *BranchClient) Create(ctx context.Context, branch, sha string) error {
 
 	return nil
 }
+
+// Delete deletes the branch with the given name irreversibly.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Delete(ctx context.Context, branch string) error {
+	// DELETE /repos/{owner}/{repo}/git/refs/heads/{branch}
+	return c.c.DeleteBranch(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch)
+}
+
+// RenameDefault renames the default branch of the repository to the given name. GitHub retargets
+// the open pull requests into it, and moves its protection rules.
+//
+// ErrAlreadyExists is returned if a branch with the given name already exists.
+func (c *BranchClient) RenameDefault(ctx context.Context, branch string) error {
+	owner, repoName := c.ref.GetIdentity(), c.ref.GetRepository()
+	if _, err := c.c.GetBranch(ctx, owner, repoName, branch); err == nil {
+		return fmt.Errorf("branch %q: %w", branch, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return err
+	}
+
+	// GET /repos/{owner}/{repo}
+	repo, err := c.c.GetRepo(ctx, owner, repoName)
+	if err != nil {
+		return err
+	}
+	// POST /repos/{owner}/{repo}/branches/{branch}/rename
+	return c.c.RenameBranch(ctx, owner, repoName, repo.GetDefaultBranch(), branch)
+}
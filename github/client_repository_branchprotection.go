@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// BranchProtectionClient implements the gitprovider.BranchProtectionClient interface.
+var _ gitprovider.BranchProtectionClient = &BranchProtectionClient{}
+
+// BranchProtectionClient operates on the branch protections of a specific repository.
+type BranchProtectionClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the protection of the given branch.
+//
+// ErrNotFound is returned if the branch is not protected.
+func (c *BranchProtectionClient) Get(ctx context.Context, branch string) (gitprovider.BranchProtection, error) {
+	return c.get(ctx, branch)
+}
+
+func (c *BranchProtectionClient) get(ctx context.Context, branch string) (*branchProtection, error) {
+	// GET /repos/{owner}/{repo}/branches/{branch}/protection
+	apiObj, err := c.c.GetBranchProtection(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), branch)
+	if err != nil {
+		return nil, err
+	}
+	return newBranchProtection(c, branch, apiObj), nil
+}
+
+// List lists all branch protections of the repository.
+//
+// List returns all available branch protections for the given repository,
+// using multiple paginated requests if needed. GitHub doesn't list the protections
+// themselves, hence one additional request is made per protected branch.
+func (c *BranchProtectionClient) List(ctx context.Context) ([]gitprovider.BranchProtection, error) {
+	// GET /repos/{owner}/{repo}/branches?protected=true
+	branches, err := c.c.ListBranches(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	protections := make([]gitprovider.BranchProtection, 0, len(branches))
+	for _, branch := range branches {
+		// branch.Name is validated to be non-nil at ListBranches
+		protection, err := c.get(ctx, *branch.Name)
+		if err != nil {
+			return nil, err
+		}
+		protections = append(protections, protection)
+	}
+	return protections, nil
+}
+
+// Create protects a branch with the given specifications.
+//
+// ErrAlreadyExists will be returned if the branch is already protected.
+func (c *BranchProtectionClient) Create(ctx context.Context, req gitprovider.BranchProtectionInfo) (gitprovider.BranchProtection, error) {
+	// GitHub doesn't differentiate between creating and updating a protection, hence check first
+	if _, err := c.get(ctx, req.Branch); err == nil {
+		return nil, fmt.Errorf("branch protection for %q: %w", req.Branch, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	apiObj, err := createBranchProtection(ctx, c.c, c.ref, req)
+	if err != nil {
+		return nil, err
+	}
+	return newBranchProtection(c, req.Branch, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *BranchProtectionClient) Reconcile(ctx context.Context, req gitprovider.BranchProtectionInfo) (gitprovider.BranchProtection, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the protection of the desired branch
+	actual, err := c.Get(ctx, req.Branch)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+func createBranchProtection(ctx context.Context, c githubClient, ref gitprovider.RepositoryRef, req gitprovider.BranchProtectionInfo) (*github.Protection, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	apiObj := &github.Protection{}
+	branchProtectionInfoToAPIObj(&req, apiObj)
+	// PUT /repos/{owner}/{repo}/branches/{branch}/protection
+	return c.UpdateBranchProtection(ctx, ref.GetIdentity(), ref.GetRepository(), req.Branch, protectionRequestFromAPI(apiObj))
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CollaboratorClient implements the gitprovider.CollaboratorClient interface.
+var _ gitprovider.CollaboratorClient = &CollaboratorClient{}
+
+// CollaboratorClient operates on the users with direct access to a specific repository.
+type CollaboratorClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get the permission level of the user with the given login, if they are a direct collaborator
+// of the repository, or have been invited to it.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *CollaboratorClient) Get(ctx context.Context, login string) (gitprovider.Collaborator, error) {
+	return c.get(ctx, login)
+}
+
+func (c *CollaboratorClient) get(ctx context.Context, login string) (*collaborator, error) {
+	collaborators, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Loop through the collaborators until we find one with the right login, logins are case-insensitive
+	for _, collaborator := range collaborators {
+		if strings.EqualFold(collaborator.info.Login, login) {
+			return collaborator, nil
+		}
+	}
+	return nil, fmt.Errorf("collaborator %q: %w", login, gitprovider.ErrNotFound)
+}
+
+// List lists the direct collaborators of the repository, followed by the pending invitations.
+// For repositories owned by a user, the owner is included as an admin.
+//
+// List returns all available collaborators, using multiple paginated requests if needed.
+func (c *CollaboratorClient) List(ctx context.Context) ([]gitprovider.Collaborator, error) {
+	collaborators, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Cast to the generic []gitprovider.Collaborator
+	result := make([]gitprovider.Collaborator, 0, len(collaborators))
+	for _, collaborator := range collaborators {
+		result = append(result, collaborator)
+	}
+	return result, nil
+}
+
+func (c *CollaboratorClient) list(ctx context.Context) ([]*collaborator, error) {
+	// GET /repos/{owner}/{repo}/collaborators
+	users, err := c.c.ListCollaborators(ctx, c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	// GET /repos/{owner}/{repo}/invitations
+	invitations, err := c.c.ListRepoInvitations(ctx, c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := make([]*collaborator, 0, len(users)+len(invitations))
+	for _, user := range users {
+		collaborators = append(collaborators, newCollaborator(c, user))
+	}
+	for _, invitation := range invitations {
+		collaborators = append(collaborators, newInvitedCollaborator(c, invitation))
+	}
+	return collaborators, nil
+}
+
+// Create adds the user as a collaborator of the repository. GitHub invites users who aren't members
+// of the organization owning the repository, in which case the returned collaborator is pending.
+// Updating or deleting a pending collaborator updates or revokes the invitation.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *CollaboratorClient) Create(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if err := c.validateCollaboratorInfo(req); err != nil {
+		return nil, err
+	}
+
+	// A PUT to an existing collaborator would silently change its permission
+	if _, err := c.get(ctx, req.Login); err == nil {
+		return nil, fmt.Errorf("collaborator %q: %w", req.Login, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+	return c.add(ctx, req)
+}
+
+// add adds the user as a collaborator, or changes the permission of an existing collaborator.
+func (c *CollaboratorClient) add(ctx context.Context, req gitprovider.CollaboratorInfo) (*collaborator, error) {
+	// PUT /repos/{owner}/{repo}/collaborators/{username}
+	apiObj, err := c.c.AddCollaborator(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), req.Login, *req.Permission)
+	if err != nil {
+		return nil, err
+	}
+	// No invitation is returned if the user got access right away
+	if apiObj == nil {
+		return newCollaborator(c, &github.User{
+			Login:       &req.Login,
+			Permissions: map[string]bool{string(*req.Permission): true},
+		}), nil
+	}
+	return newInvitedCollaborator(c, &github.RepositoryInvitation{
+		ID:          apiObj.ID,
+		Repo:        apiObj.Repo,
+		Invitee:     apiObj.Invitee,
+		Inviter:     apiObj.Inviter,
+		Permissions: apiObj.Permissions,
+		CreatedAt:   apiObj.CreatedAt,
+		URL:         apiObj.URL,
+		HTMLURL:     apiObj.HTMLURL,
+	}), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *CollaboratorClient) Reconcile(ctx context.Context,
+	req gitprovider.CollaboratorInfo,
+) (gitprovider.Collaborator, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Login)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
+// validateCollaboratorInfo makes sure the permission can be granted on the repository.
+// GitHub always grants push access to the collaborators of repositories owned by a user.
+func (c *CollaboratorClient) validateCollaboratorInfo(info gitprovider.CollaboratorInfo) error {
+	if _, ok := c.ref.(gitprovider.OrgRepositoryRef); ok {
+		return nil
+	}
+	if info.Permission != nil && *info.Permission != gitprovider.RepositoryPermissionPush {
+		return fmt.Errorf("collaborators of a user repository always have permission %q: %w",
+			gitprovider.RepositoryPermissionPush, gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
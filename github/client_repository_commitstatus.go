@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CommitStatusClient implements the gitprovider.CommitStatusClient interface.
+var _ gitprovider.CommitStatusClient = &CommitStatusClient{}
+
+// CommitStatusClient operates on the commit statuses for a specific repository.
+type CommitStatusClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists all statuses of the commit with the given sha, newest first.
+//
+// List returns all available statuses, using multiple paginated requests if needed.
+func (c *CommitStatusClient) List(ctx context.Context, sha string) ([]gitprovider.CommitStatus, error) {
+	// GET /repos/{owner}/{repo}/commits/{ref}/statuses
+	apiObjs, err := c.c.ListStatuses(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), sha)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]gitprovider.CommitStatus, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		statuses = append(statuses, newCommitStatus(c, apiObj))
+	}
+	return statuses, nil
+}
+
+// Create creates a status for the commit with the given sha.
+func (c *CommitStatusClient) Create(ctx context.Context, sha string, req gitprovider.CommitStatusInfo) (gitprovider.CommitStatus, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	// POST /repos/{owner}/{repo}/statuses/{sha}
+	apiObj, err := c.c.CreateStatus(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), sha, commitStatusToAPI(&req))
+	if err != nil {
+		return nil, err
+	}
+	return newCommitStatus(c, apiObj), nil
+}
+
+// Combined returns the combined status of the commit with the given sha.
+func (c *CommitStatusClient) Combined(ctx context.Context, sha string) (*gitprovider.CombinedCommitStatusInfo, error) {
+	// GET /repos/{owner}/{repo}/commits/{ref}/status
+	apiObj, err := c.c.GetCombinedStatus(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), sha)
+	if err != nil {
+		return nil, err
+	}
+
+	combined := &gitprovider.CombinedCommitStatusInfo{
+		Sha:      sha,
+		State:    gitprovider.CommitStatusState(apiObj.GetState()),
+		Statuses: make([]gitprovider.CommitStatusInfo, 0, len(apiObj.Statuses)),
+	}
+	for _, status := range apiObj.Statuses {
+		combined.Statuses = append(combined.Statuses, commitStatusFromAPI(status))
+	}
+	return combined, nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IssueClient implements the gitprovider.IssueClient interface.
+var _ gitprovider.IssueClient = &IssueClient{}
+
+// IssueClient operates on the issues of a specific repository.
+type IssueClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the issue with the given number.
+//
+// ErrNotFound is returned if the issue does not exist, or is a pull request.
+func (c *IssueClient) Get(ctx context.Context, number int) (gitprovider.Issue, error) {
+	// GET /repos/{owner}/{repo}/issues/{issue_number}
+	apiObj, err := c.c.GetIssue(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
+	if err != nil {
+		return nil, err
+	}
+	// Pull requests are issues too in GitHub
+	if apiObj.IsPullRequest() {
+		return nil, fmt.Errorf("issue %d is a pull request: %w", number, gitprovider.ErrNotFound)
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// List lists the issues in the repository, by default only the open ones. All filters are supported.
+//
+// List returns all available issues, using multiple paginated requests if needed.
+func (c *IssueClient) List(ctx context.Context, optFns ...gitprovider.IssueListOption) ([]gitprovider.Issue, error) {
+	opts, err := gitprovider.MakeIssueListOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+	// The issue states are named the same in GitHub
+	apiOpts := &github.IssueListByRepoOptions{
+		State:  string(*opts.State),
+		Labels: opts.Labels,
+	}
+	if opts.Assignee != nil {
+		apiOpts.Assignee = *opts.Assignee
+	}
+
+	// GET /repos/{owner}/{repo}/issues
+	apiObjs, err := c.c.ListIssues(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), apiOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]gitprovider.Issue, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if !apiObj.IsPullRequest() {
+			issues = append(issues, newIssue(c, apiObj))
+		}
+	}
+	return issues, nil
+}
+
+// Create opens an issue with the given specifications.
+func (c *IssueClient) Create(ctx context.Context, req gitprovider.IssueInfo) (gitprovider.Issue, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	apiReq := &github.IssueRequest{
+		Title: &req.Title,
+		Body:  &req.Description,
+	}
+	if len(req.Labels) != 0 {
+		apiReq.Labels = &req.Labels
+	}
+	if len(req.Assignees) != 0 {
+		apiReq.Assignees = &req.Assignees
+	}
+	if req.Milestone != "" {
+		milestone, err := c.milestoneNumber(ctx, req.Milestone)
+		if err != nil {
+			return nil, err
+		}
+		apiReq.Milestone = &milestone
+	}
+
+	// POST /repos/{owner}/{repo}/issues
+	apiObj, err := c.c.CreateIssue(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), apiReq)
+	if err != nil {
+		return nil, err
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// Edit changes an existing issue. All options are supported.
+func (c *IssueClient) Edit(ctx context.Context, number int, opts gitprovider.IssueEditOptions) (gitprovider.Issue, error) {
+	if err := opts.ValidateOptions(); err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
+	apiReq := &github.IssueRequest{
+		Title: opts.Title,
+		Body:  opts.Description,
+	}
+	if opts.State != nil {
+		// The "open" and "closed" states are named the same in GitHub
+		apiReq.State = github.String(string(*opts.State))
+	}
+	if opts.Assignees != nil {
+		apiReq.Assignees = &opts.Assignees
+	}
+	if opts.Labels != nil {
+		apiReq.Labels = &opts.Labels
+	}
+	if opts.Milestone != nil && *opts.Milestone != "" {
+		milestone, err := c.milestoneNumber(ctx, *opts.Milestone)
+		if err != nil {
+			return nil, err
+		}
+		apiReq.Milestone = &milestone
+	}
+
+	// PATCH /repos/{owner}/{repo}/issues/{issue_number}
+	apiObj, err := c.c.EditIssue(ctx, owner, repo, number, apiReq)
+	if err != nil {
+		return nil, err
+	}
+	// The milestone can't be removed in the same request, as go-github omits it if it's nil
+	if opts.Milestone != nil && *opts.Milestone == "" && apiObj.Milestone != nil {
+		// PATCH /repos/{owner}/{repo}/issues/{issue_number}
+		if apiObj, err = c.c.RemoveIssueMilestone(ctx, owner, repo, number); err != nil {
+			return nil, err
+		}
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// Close closes the issue as completed.
+func (c *IssueClient) Close(ctx context.Context, number int) (gitprovider.Issue, error) {
+	return c.Edit(ctx, number, gitprovider.IssueEditOptions{
+		State: gitprovider.IssueStateVar(gitprovider.IssueStateClosed),
+	})
+}
+
+// milestoneNumber returns the number of the milestone with the given title.
+func (c *IssueClient) milestoneNumber(ctx context.Context, title string) (int, error) {
+	// GET /repos/{owner}/{repo}/milestones
+	milestones, err := c.c.ListMilestones(ctx, c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return 0, err
+	}
+	for _, milestone := range milestones {
+		if milestone.GetTitle() == title {
+			return milestone.GetNumber(), nil
+		}
+	}
+	return 0, fmt.Errorf("milestone %q: %w", title, gitprovider.ErrNotFound)
+}
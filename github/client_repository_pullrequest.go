@@ -18,6 +18,8 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/google/go-github/v57/github"
@@ -32,19 +34,43 @@ type PullRequestClient struct {
 	ref gitprovider.RepositoryRef
 }
 
-// List lists all pull requests in the repository
-func (c *PullRequestClient) List(ctx context.Context) ([]gitprovider.PullRequest, error) {
-	prs, _, err := c.c.Client().PullRequests.List(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), nil)
+// List lists the pull requests in the repository, by default only the open ones. GitHub filters
+// by source branch, which only matches pull requests from branches in this repository.
+//
+// List returns all available pull requests, using multiple paginated requests if needed.
+func (c *PullRequestClient) List(ctx context.Context, optFns ...gitprovider.PullRequestListOption) ([]gitprovider.PullRequest, error) {
+	opts, err := gitprovider.MakePullRequestListOptions(optFns...)
 	if err != nil {
 		return nil, err
 	}
+	// GitHub only filters by open and closed, merged pull requests are closed ones
+	apiOpts := &github.PullRequestListOptions{State: "all"}
+	switch *opts.State {
+	case gitprovider.PullRequestStateOpen:
+		apiOpts.State = "open"
+	case gitprovider.PullRequestStateClosed, gitprovider.PullRequestStateMerged:
+		apiOpts.State = "closed"
+	}
+	// Source branches are matched in this repository, i.e. pull requests from forks are left out
+	if opts.SourceBranch != nil {
+		apiOpts.Head = c.ref.GetIdentity() + ":" + *opts.SourceBranch
+	}
+	if opts.TargetBranch != nil {
+		apiOpts.Base = *opts.TargetBranch
+	}
 
-	requests := make([]gitprovider.PullRequest, len(prs))
-
-	for idx, pr := range prs {
-		requests[idx] = newPullRequest(c.clientContext, pr)
+	// GET /repos/{owner}/{repo}/pulls
+	prs, err := c.c.ListPullRequests(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), apiOpts)
+	if err != nil {
+		return nil, err
 	}
 
+	requests := make([]gitprovider.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		if opts.Matches(pullrequestFromAPI(pr)) {
+			requests = append(requests, newPullRequest(c, pr))
+		}
+	}
 	return requests, nil
 }
 
@@ -63,44 +89,142 @@ func (c *PullRequestClient) Create(ctx context.Context, title, branch, baseBranc
 		return nil, err
 	}
 
-	return newPullRequest(c.clientContext, pr), nil
+	return newPullRequest(c, pr), nil
 }
 
 // Edit modifies an existing PR. Please refer to "EditOptions" for details on which data can be edited.
+// All options are supported.
 func (c *PullRequestClient) Edit(ctx context.Context, number int, opts gitprovider.EditOptions) (gitprovider.PullRequest, error) {
-	editPR := &github.PullRequest{}
-	editPR.Title = opts.Title
-	editedPR, _, err := c.c.Client().PullRequests.Edit(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number, editPR)
+	if err := opts.ValidateOptions(); err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+
+	editPR := &github.PullRequest{
+		Title: opts.Title,
+		Body:  opts.Description,
+	}
+	if opts.TargetBranch != nil {
+		editPR.Base = &github.PullRequestBranch{Ref: opts.TargetBranch}
+	}
+	if opts.State != nil {
+		// The "open" and "closed" states are named the same in GitHub
+		editPR.State = github.String(string(*opts.State))
+	}
+	// PATCH /repos/{owner}/{repo}/pulls/{pull_number}
+	editedPR, err := c.c.EditPullRequest(ctx, owner, repo, number, editPR)
 	if err != nil {
 		return nil, err
 	}
-	return newPullRequest(c.clientContext, editedPR), nil
+
+	// The rest of the options are changed through other endpoints, hence the pull request is
+	// retrieved again at the end if any of them is set
+	if opts.Draft == nil && opts.Assignees == nil && opts.Labels == nil && opts.Reviewers == nil {
+		return newPullRequest(c, editedPR), nil
+	}
+	if opts.Draft != nil && *opts.Draft != editedPR.GetDraft() {
+		// POST /graphql
+		if err := c.c.SetPullRequestDraft(ctx, editedPR.GetNodeID(), *opts.Draft); err != nil {
+			return nil, err
+		}
+	}
+	if opts.Assignees != nil || opts.Labels != nil {
+		req := &github.IssueRequest{}
+		if opts.Assignees != nil {
+			req.Assignees = &opts.Assignees
+		}
+		if opts.Labels != nil {
+			req.Labels = &opts.Labels
+		}
+		// PATCH /repos/{owner}/{repo}/issues/{issue_number}
+		if _, err := c.c.EditIssue(ctx, owner, repo, number, req); err != nil {
+			return nil, err
+		}
+	}
+	if len(opts.Reviewers) != 0 {
+		// POST /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers
+		if err := c.c.RequestReviewers(ctx, owner, repo, number, opts.Reviewers); err != nil {
+			return nil, err
+		}
+	}
+	return c.Get(ctx, number)
 }
 
 // Get retrieves an existing pull request by number
 func (c *PullRequestClient) Get(ctx context.Context, number int) (gitprovider.PullRequest, error) {
-
-	pr, _, err := c.c.Client().PullRequests.Get(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
+	// GET /repos/{owner}/{repo}/pulls/{pull_number}
+	pr, err := c.c.GetPullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
 	if err != nil {
 		return nil, err
 	}
 
-	return newPullRequest(c.clientContext, pr), nil
+	return newPullRequest(c, pr), nil
 }
 
-// Merge merges a pull request with the given specifications.
-func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod, message string) error {
+// Merge merges a pull request with the given specifications. All merge methods and options are supported.
+func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod, message string, optFns ...gitprovider.PullRequestMergeOption) (*gitprovider.PullRequestMergeResult, error) {
+	opts, err := gitprovider.MakePullRequestMergeOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
 
 	prOpts := &github.PullRequestOptions{
-		CommitTitle: "",
-		SHA:         "",
 		MergeMethod: string(mergeMethod),
 	}
+	// GitHub refuses to merge if the head isn't at the given SHA
+	if opts.ExpectedHeadSha != nil {
+		prOpts.SHA = *opts.ExpectedHeadSha
+	}
+
+	// PUT /repos/{owner}/{repo}/pulls/{pull_number}/merge
+	apiObj, err := c.c.MergePullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number, message, prOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DeleteSourceBranch != nil && *opts.DeleteSourceBranch {
+		// GET /repos/{owner}/{repo}/pulls/{pull_number}
+		pr, err := c.c.GetPullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
+		if err != nil {
+			return nil, err
+		}
+		// DELETE /repos/{owner}/{repo}/git/refs/heads/{branch}
+		if err := c.c.DeletePullRequestSourceBranch(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), pr); err != nil {
+			return nil, err
+		}
+	}
+
+	return &gitprovider.PullRequestMergeResult{Sha: apiObj.GetSHA()}, nil
+}
 
-	_, _, err := c.c.Client().PullRequests.Merge(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number, message, prOpts)
+// EnableAutoMerge enables auto-merge of a pull request, which has to be allowed in the repository settings.
+func (c *PullRequestClient) EnableAutoMerge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod) error {
+	if err := validateAutoMergeMethod(mergeMethod); err != nil {
+		return err
+	}
+	// GET /repos/{owner}/{repo}/pulls/{pull_number}
+	pr, err := c.c.GetPullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
 	if err != nil {
 		return err
 	}
+	// The GraphQL merge methods are upper case, e.g. "SQUASH"
+	return c.c.EnablePullRequestAutoMerge(ctx, pr.GetNodeID(), strings.ToUpper(string(mergeMethod)))
+}
 
+// validateAutoMergeMethod validates the merge method of auto-merge.
+func validateAutoMergeMethod(mergeMethod gitprovider.MergeMethod) error {
+	if err := gitprovider.ValidateMergeMethod(mergeMethod); err != nil {
+		return fmt.Errorf("unknown merge method %q: %w", mergeMethod, gitprovider.ErrInvalidArgument)
+	}
 	return nil
 }
+
+// DisableAutoMerge disables auto-merge of a pull request.
+func (c *PullRequestClient) DisableAutoMerge(ctx context.Context, number int) error {
+	// GET /repos/{owner}/{repo}/pulls/{pull_number}
+	pr, err := c.c.GetPullRequest(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), number)
+	if err != nil {
+		return err
+	}
+	return c.c.DisablePullRequestAutoMerge(ctx, pr.GetNodeID())
+}
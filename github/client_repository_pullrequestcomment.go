@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestCommentClient implements the gitprovider.PullRequestCommentClient interface.
+var _ gitprovider.PullRequestCommentClient = &PullRequestCommentClient{}
+
+// PullRequestCommentClient operates on the comments in the conversation of a specific pull request.
+type PullRequestCommentClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int
+}
+
+// Get returns the comment with the given ID.
+//
+// ErrNotFound is returned if the comment does not exist, or belongs to another pull request or issue.
+func (c *PullRequestCommentClient) Get(ctx context.Context, id int64) (gitprovider.PullRequestComment, error) {
+	// GET /repos/{owner}/{repo}/issues/comments/{comment_id}
+	apiObj, err := c.c.GetIssueComment(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), id)
+	if err != nil {
+		return nil, err
+	}
+	// Comments are looked up in the whole repository, hence make sure it's one of this pull request
+	if !strings.HasSuffix(apiObj.GetIssueURL(), fmt.Sprintf("/issues/%d", c.number)) {
+		return nil, fmt.Errorf("comment %d of pull request %d: %w", id, c.number, gitprovider.ErrNotFound)
+	}
+	return newPullRequestComment(c, apiObj), nil
+}
+
+// List lists all comments in the conversation of the pull request, oldest first.
+// Review comments on the diff aren't included.
+//
+// List returns all available comments, using multiple paginated requests if needed.
+func (c *PullRequestCommentClient) List(ctx context.Context) ([]gitprovider.PullRequestComment, error) {
+	// GET /repos/{owner}/{repo}/issues/{issue_number}/comments
+	apiObjs, err := c.c.ListIssueComments(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), c.number)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]gitprovider.PullRequestComment, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		comments = append(comments, newPullRequestComment(c, apiObj))
+	}
+	return comments, nil
+}
+
+// Create creates a comment with the given body.
+func (c *PullRequestCommentClient) Create(ctx context.Context, req gitprovider.PullRequestCommentInfo) (gitprovider.PullRequestComment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/issues/{issue_number}/comments
+	apiObj, err := c.c.CreateIssueComment(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), c.number, &github.IssueComment{
+		Body: &req.Body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newPullRequestComment(c, apiObj), nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// ReleaseClient implements the gitprovider.ReleaseClient interface.
+var _ gitprovider.ReleaseClient = &ReleaseClient{}
+
+// ReleaseClient operates on the releases of a specific repository.
+type ReleaseClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the release made from the tag with the given name. Draft releases
+// aren't returned, as they don't have a tag until they are published.
+//
+// ErrNotFound is returned if the release does not exist.
+func (c *ReleaseClient) Get(ctx context.Context, tagName string) (gitprovider.Release, error) {
+	// GET /repos/{owner}/{repo}/releases/tags/{tag}
+	apiObj, err := c.c.GetReleaseByTag(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), tagName)
+	if err != nil {
+		return nil, err
+	}
+	return newRelease(c, apiObj), nil
+}
+
+// List lists all releases of the repository.
+//
+// List returns all available releases for the given repository,
+// using multiple paginated requests if needed.
+func (c *ReleaseClient) List(ctx context.Context) ([]gitprovider.Release, error) {
+	// GET /repos/{owner}/{repo}/releases
+	apiObjs, err := c.c.ListReleases(ctx, c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Release type
+	releases := make([]gitprovider.Release, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at ListReleases
+		releases = append(releases, newRelease(c, apiObj))
+	}
+	return releases, nil
+}
+
+// Create creates a release with the given specifications. If the tag doesn't exist yet,
+// it's created from req.Target.
+//
+// ErrAlreadyExists will be returned if a release for the tag already exists.
+func (c *ReleaseClient) Create(ctx context.Context, req gitprovider.ReleaseInfo) (gitprovider.Release, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+	// GitHub only reports a validation error for the tag name of existing releases
+	if _, err := c.c.GetReleaseByTag(ctx, owner, repo, req.TagName); err == nil {
+		return nil, fmt.Errorf("release %q: %w", req.TagName, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	// POST /repos/{owner}/{repo}/releases
+	apiObj, err := c.c.CreateRelease(ctx, owner, repo, releaseToAPI(&req))
+	if err != nil {
+		return nil, err
+	}
+	return newRelease(c, apiObj), nil
+}
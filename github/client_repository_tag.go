@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TagClient implements the gitprovider.TagClient interface.
+var _ gitprovider.TagClient = &TagClient{}
+
+// TagClient operates on the tags of a specific repository.
+type TagClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the tag with the given name.
+//
+// ErrNotFound is returned if the tag does not exist.
+func (c *TagClient) Get(ctx context.Context, name string) (gitprovider.Tag, error) {
+	// GET /repos/{owner}/{repo}/git/ref/tags/{tag}
+	apiObj, err := c.c.GetTag(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), name)
+	if err != nil {
+		return nil, err
+	}
+	return c.newTag(ctx, apiObj)
+}
+
+// List lists all tags of the repository.
+//
+// List returns all available tags for the given repository,
+// using multiple paginated requests if needed.
+func (c *TagClient) List(ctx context.Context) ([]gitprovider.Tag, error) {
+	// GET /repos/{owner}/{repo}/git/matching-refs/tags
+	apiObjs, err := c.c.ListTags(ctx, c.ref.GetIdentity(), c.ref.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Tag type
+	tags := make([]gitprovider.Tag, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at ListTags
+		tag, err := c.newTag(ctx, apiObj)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// Create creates a tag with the given specifications. An annotated tag is created if
+// req.Message is set, otherwise a lightweight tag.
+//
+// ErrAlreadyExists will be returned if the tag already exists.
+func (c *TagClient) Create(ctx context.Context, req gitprovider.TagInfo) (gitprovider.Tag, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	owner, repo := c.ref.GetIdentity(), c.ref.GetRepository()
+	// GitHub doesn't tell existing references apart from other validation errors
+	if _, err := c.c.GetTag(ctx, owner, repo, req.Name); err == nil {
+		return nil, fmt.Errorf("tag %q: %w", req.Name, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	sha := req.Sha
+	var annotation *github.Tag
+	if req.Message != nil {
+		// POST /repos/{owner}/{repo}/git/tags
+		obj, err := c.c.CreateTagObject(ctx, owner, repo, &github.Tag{
+			Tag:     &req.Name,
+			Message: req.Message,
+			Object: &github.GitObject{
+				Type: github.String("commit"),
+				SHA:  &req.Sha,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		annotation = obj
+		sha = obj.GetSHA()
+	}
+
+	// POST /repos/{owner}/{repo}/git/refs
+	apiObj, err := c.c.CreateTag(ctx, owner, repo, req.Name, sha)
+	if err != nil {
+		return nil, err
+	}
+	return newTag(c, apiObj, annotation), nil
+}
+
+// Delete deletes the tag with the given name irreversibly.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn\'t created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the tag does not exist.
+func (c *TagClient) Delete(ctx context.Context, name string) error {
+	// DELETE /repos/{owner}/{repo}/git/refs/tags/{tag}
+	return c.c.DeleteTag(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), name)
+}
+
+// newTag returns the tag for the given reference, getting the tag object of annotated tags.
+func (c *TagClient) newTag(ctx context.Context, apiObj *github.Reference) (*tagType, error) {
+	if apiObj.GetObject().GetType() != tagObjectType {
+		return newTag(c, apiObj, nil), nil
+	}
+	// GET /repos/{owner}/{repo}/git/tags/{tag_sha}
+	annotation, err := c.c.GetTagObject(ctx, c.ref.GetIdentity(), c.ref.GetRepository(), apiObj.GetObject().GetSHA())
+	if err != nil {
+		return nil, err
+	}
+	return newTag(c, apiObj, annotation), nil
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+var testRepoRef = gitprovider.OrgRepositoryRef{
+	OrganizationRef: gitprovider.OrganizationRef{Domain: DefaultDomain, Organization: "fluxcd"},
+	RepositoryName:  "flux2",
+}
+
+// setup starts a stand-in for the GitHub API, and returns a client talking to it.
+func setup(t *testing.T) (*http.ServeMux, *Client) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	gh := github.NewClient(nil)
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gh.BaseURL = baseURL
+	return mux, newClient(gh, DefaultDomain, false)
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Errorf("failed to encode response: %v", err)
+	}
+}
+
+func TestRepositoryUpdateArchived(t *testing.T) {
+	tests := []struct {
+		name         string
+		archived     bool
+		topics       []string
+		req          gitprovider.RepositoryInfo
+		wantRequests []string
+	}{
+		{
+			name:         "update archived repository",
+			archived:     true,
+			topics:       []string{"gitops"},
+			req:          gitprovider.RepositoryInfo{Archived: gitprovider.BoolVar(true), Topics: []string{"gitops"}},
+			wantRequests: []string{"PATCH /repos/fluxcd/flux2"},
+		},
+		{
+			name:         "archive repository",
+			req:          gitprovider.RepositoryInfo{Archived: gitprovider.BoolVar(true), Topics: []string{"gitops"}},
+			wantRequests: []string{"PUT /repos/fluxcd/flux2/topics", "PATCH /repos/fluxcd/flux2"},
+		},
+		{
+			name:         "unarchive repository",
+			archived:     true,
+			req:          gitprovider.RepositoryInfo{Archived: gitprovider.BoolVar(false), Topics: []string{"gitops"}},
+			wantRequests: []string{"PATCH /repos/fluxcd/flux2", "PUT /repos/fluxcd/flux2/topics"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux, c := setup(t)
+			var requests []string
+			repo := &github.Repository{
+				Name:          github.String("flux2"),
+				DefaultBranch: github.String("main"),
+				Archived:      github.Bool(tt.archived),
+				Topics:        tt.topics,
+			}
+			mux.HandleFunc("/repos/fluxcd/flux2", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					requests = append(requests, r.Method+" "+r.URL.Path)
+				}
+				if r.Method == http.MethodPatch {
+					update := &github.Repository{}
+					if err := json.NewDecoder(r.Body).Decode(update); err != nil {
+						t.Fatal(err)
+					}
+					if update.Archived != nil {
+						repo.Archived = update.Archived
+					}
+				}
+				writeJSON(t, w, http.StatusOK, repo)
+			})
+			mux.HandleFunc("/repos/fluxcd/flux2/topics", func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				if repo.GetArchived() {
+					writeJSON(t, w, http.StatusForbidden, map[string]string{"message": "Repository was archived so is read-only."})
+					return
+				}
+				topics := struct {
+					Names []string `json:"names"`
+				}{}
+				if err := json.NewDecoder(r.Body).Decode(&topics); err != nil {
+					t.Fatal(err)
+				}
+				repo.Topics = topics.Names
+				writeJSON(t, w, http.StatusOK, topics)
+			})
+
+			ctx := context.Background()
+			r, err := c.OrgRepositories().Get(ctx, testRepoRef)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if err := r.Set(tt.req); err != nil {
+				t.Fatalf("Set() error = %v", err)
+			}
+			if err := r.Update(ctx); err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantRequests, requests); diff != "" {
+				t.Errorf("Update() requests mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPullRequestMergeDeleteSourceBranch(t *testing.T) {
+	tests := []struct {
+		name         string
+		headRepoID   int64
+		wantRequests []string
+	}{
+		{
+			name:         "delete source branch",
+			headRepoID:   1,
+			wantRequests: []string{"PUT /repos/fluxcd/flux2/pulls/1/merge", "DELETE /repos/fluxcd/flux2/git/refs/heads/feature"},
+		},
+		{
+			name:         "keep source branch of fork",
+			headRepoID:   2,
+			wantRequests: []string{"PUT /repos/fluxcd/flux2/pulls/1/merge"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Destructive API calls are disabled, which doesn't apply to merged source branches
+			mux, c := setup(t)
+			var requests []string
+			mux.HandleFunc("/repos/fluxcd/flux2", func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(t, w, http.StatusOK, &github.Repository{Name: github.String("flux2"), DefaultBranch: github.String("main")})
+			})
+			mux.HandleFunc("/repos/fluxcd/flux2/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				writeJSON(t, w, http.StatusOK, &github.PullRequestMergeResult{SHA: github.String("abc"), Merged: github.Bool(true)})
+			})
+			mux.HandleFunc("/repos/fluxcd/flux2/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(t, w, http.StatusOK, &github.PullRequest{
+					Number: github.Int(1),
+					Merged: github.Bool(true),
+					Head:   &github.PullRequestBranch{Ref: github.String("feature"), Repo: &github.Repository{ID: github.Int64(tt.headRepoID)}},
+					Base:   &github.PullRequestBranch{Ref: github.String("main"), Repo: &github.Repository{ID: github.Int64(1)}},
+				})
+			})
+			mux.HandleFunc("/repos/fluxcd/flux2/git/refs/heads/feature", func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			ctx := context.Background()
+			repo, err := c.OrgRepositories().Get(ctx, testRepoRef)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			result, err := repo.PullRequests().Merge(ctx, 1, gitprovider.MergeMethodMerge, "", &gitprovider.PullRequestMergeOptions{
+				DeleteSourceBranch: gitprovider.BoolVar(true),
+			})
+			if err != nil {
+				t.Fatalf("Merge() error = %v", err)
+			}
+			if result.Sha != "abc" {
+				t.Errorf("Merge() sha = %q, want %q", result.Sha, "abc")
+			}
+			if diff := cmp.Diff(tt.wantRequests, requests); diff != "" {
+				t.Errorf("Merge() requests mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBranchesRenameDefault(t *testing.T) {
+	mux, c := setup(t)
+	mux.HandleFunc("/repos/fluxcd/flux2", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, &github.Repository{Name: github.String("flux2"), DefaultBranch: github.String("main")})
+	})
+	mux.HandleFunc("/repos/fluxcd/flux2/branches/feature", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, &github.Branch{Name: github.String("feature"), Commit: &github.RepositoryCommit{SHA: github.String("abc")}})
+	})
+	mux.HandleFunc("/repos/fluxcd/flux2/branches/trunk", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusNotFound, map[string]string{"message": "Branch not found"})
+	})
+	var renamed string
+	mux.HandleFunc("/repos/fluxcd/flux2/branches/main/rename", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("rename method = %s, want POST", r.Method)
+		}
+		req := struct {
+			NewName string `json:"new_name"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		renamed = req.NewName
+		writeJSON(t, w, http.StatusCreated, &github.Branch{Name: github.String(req.NewName)})
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, testRepoRef)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if err := repo.Branches().RenameDefault(ctx, "feature"); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("RenameDefault() error = %v, want ErrAlreadyExists", err)
+	}
+	if err := repo.Branches().RenameDefault(ctx, "trunk"); err != nil {
+		t.Fatalf("RenameDefault() error = %v", err)
+	}
+	if renamed != "trunk" {
+		t.Errorf("RenameDefault() renamed main to %q, want trunk", renamed)
+	}
+}
+
+func TestPullRequestListSourceBranch(t *testing.T) {
+	mux, c := setup(t)
+	mux.HandleFunc("/repos/fluxcd/flux2", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, &github.Repository{Name: github.String("flux2"), DefaultBranch: github.String("main")})
+	})
+	var query url.Values
+	mux.HandleFunc("/repos/fluxcd/flux2/pulls", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		writeJSON(t, w, http.StatusOK, []*github.PullRequest{{
+			Number: github.Int(1),
+			State:  github.String("open"),
+			Head:   &github.PullRequestBranch{Ref: github.String("feature")},
+			Base:   &github.PullRequestBranch{Ref: github.String("main")},
+		}})
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, testRepoRef)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	prs, err := repo.PullRequests().List(ctx, &gitprovider.PullRequestListOptions{SourceBranch: gitprovider.StringVar("feature")})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(prs) != 1 {
+		t.Errorf("List() = %v, want one pull request", prs)
+	}
+	want := url.Values{"state": {"open"}, "head": {"fluxcd:feature"}}
+	if diff := cmp.Diff(want, query); diff != "" {
+		t.Errorf("List() query mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPullRequestAutoMerge(t *testing.T) {
+	mux, c := setup(t)
+	mux.HandleFunc("/repos/fluxcd/flux2", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, &github.Repository{Name: github.String("flux2"), DefaultBranch: github.String("main")})
+	})
+	mux.HandleFunc("/repos/fluxcd/flux2/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, &github.PullRequest{Number: github.Int(1), NodeID: github.String("PR_1")})
+	})
+	var mutations []string
+	var graphQLErrors []map[string]string
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("GraphQL method = %s, want POST", r.Method)
+		}
+		req := struct {
+			Query     string            `json:"query"`
+			Variables map[string]string `json:"variables"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if id := req.Variables["id"]; id != "PR_1" {
+			t.Errorf("GraphQL pull request ID = %q, want PR_1", id)
+		}
+		mutations = append(mutations, req.Query)
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"errors": graphQLErrors})
+	})
+
+	ctx := context.Background()
+	repo, err := c.OrgRepositories().Get(ctx, testRepoRef)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if err := repo.PullRequests().EnableAutoMerge(ctx, 1, "fast-forward"); !errors.Is(err, gitprovider.ErrInvalidArgument) {
+		t.Errorf("EnableAutoMerge() error = %v, want ErrInvalidArgument", err)
+	}
+	if err := repo.PullRequests().EnableAutoMerge(ctx, 1, gitprovider.MergeMethodSquash); err != nil {
+		t.Fatalf("EnableAutoMerge() error = %v", err)
+	}
+	if err := repo.PullRequests().DisableAutoMerge(ctx, 1); err != nil {
+		t.Fatalf("DisableAutoMerge() error = %v", err)
+	}
+	want := []string{
+		"mutation($id: ID!) { enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: SQUASH}) { clientMutationId } }",
+		"mutation($id: ID!) { disablePullRequestAutoMerge(input: {pullRequestId: $id}) { clientMutationId } }",
+	}
+	if diff := cmp.Diff(want, mutations); diff != "" {
+		t.Errorf("GraphQL mutations mismatch (-want +got):\n%s", diff)
+	}
+
+	// GraphQL reports errors in the body of a successful response
+	graphQLErrors = []map[string]string{{"message": "Auto merge is not allowed for this repository"}}
+	err = repo.PullRequests().EnableAutoMerge(ctx, 1, gitprovider.MergeMethodMerge)
+	if err == nil || !strings.Contains(err.Error(), "Auto merge is not allowed for this repository") {
+		t.Errorf("EnableAutoMerge() error = %v, want the GraphQL error", err)
+	}
+}
+
+func TestPullRequestFilesTruncated(t *testing.T) {
+	tests := []struct {
+		name          string
+		changedFiles  int
+		wantTruncated bool
+	}{
+		{
+			name:         "all files returned",
+			changedFiles: 2,
+		},
+		{
+			name:          "more files changed than returned",
+			changedFiles:  3,
+			wantTruncated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux, c := setup(t)
+			mux.HandleFunc("/repos/fluxcd/flux2", func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(t, w, http.StatusOK, &github.Repository{Name: github.String("flux2"), DefaultBranch: github.String("main")})
+			})
+			mux.HandleFunc("/repos/fluxcd/flux2/pulls", func(w http.ResponseWriter, r *http.Request) {
+				// Listed pull requests don't include the number of changed files
+				writeJSON(t, w, http.StatusOK, []*github.PullRequest{{Number: github.Int(1), State: github.String("open")}})
+			})
+			mux.HandleFunc("/repos/fluxcd/flux2/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(t, w, http.StatusOK, &github.PullRequest{Number: github.Int(1), ChangedFiles: github.Int(tt.changedFiles)})
+			})
+			mux.HandleFunc("/repos/fluxcd/flux2/pulls/1/files", func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(t, w, http.StatusOK, []*github.CommitFile{
+					{Filename: github.String("README.md"), Status: github.String("modified")},
+					{Filename: github.String("LICENSE"), Status: github.String("added")},
+				})
+			})
+
+			ctx := context.Background()
+			repo, err := c.OrgRepositories().Get(ctx, testRepoRef)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			pr, err := repo.PullRequests().Get(ctx, 1)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			prs, err := repo.PullRequests().List(ctx)
+			if err != nil || len(prs) != 1 {
+				t.Fatalf("List() = %v, %v", prs, err)
+			}
+			for _, pr := range []gitprovider.PullRequest{pr, prs[0]} {
+				files, err := pr.Files(ctx)
+				if err != nil {
+					t.Fatalf("Files() error = %v", err)
+				}
+				if len(files.Files) != 2 || files.Truncated != tt.wantTruncated {
+					t.Errorf("Files() = %d files, truncated %v, want 2 files, truncated %v", len(files.Files), files.Truncated, tt.wantTruncated)
+				}
+			}
+		})
+	}
+}
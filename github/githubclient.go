@@ -18,7 +18,11 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/google/go-github/v57/github"
@@ -38,13 +42,44 @@ type githubClient interface {
 	// ListOrgs is a wrapper for "GET /user/orgs".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
 	ListOrgs(ctx context.Context) ([]*github.Organization, error)
+	// CreateOrg is a wrapper for "POST /admin/organizations", only available in GitHub Enterprise.
+	// admin is the login of the user that becomes the owner of the organization.
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateOrg(ctx context.Context, orgName, admin string) (*github.Organization, error)
+	// EditOrg is a wrapper for "PATCH /orgs/{org}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	EditOrg(ctx context.Context, orgName string, req *github.Organization) (*github.Organization, error)
+	// DeleteOrg is a wrapper for "DELETE /orgs/{org}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteOrg(ctx context.Context, orgName string) error
 
 	// ListOrgTeamMembers is a wrapper for "GET /orgs/{org}/teams/{team_slug}/members".
+	// role filters the members by role, it is one of "member", "maintainer" and "all".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
-	ListOrgTeamMembers(ctx context.Context, orgName, teamName string) ([]*github.User, error)
+	ListOrgTeamMembers(ctx context.Context, orgName, teamName, role string) ([]*github.User, error)
+	// AddTeamMembership is a wrapper for "PUT /orgs/{org}/teams/{team_slug}/memberships/{username}".
+	// This function handles HTTP error wrapping.
+	AddTeamMembership(ctx context.Context, orgName, teamName, user, role string) error
+	// RemoveTeamMembership is a wrapper for "DELETE /orgs/{org}/teams/{team_slug}/memberships/{username}".
+	// This function handles HTTP error wrapping.
+	RemoveTeamMembership(ctx context.Context, orgName, teamName, user string) error
 	// ListOrgTeams is a wrapper for "GET /orgs/{org}/teams".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
 	ListOrgTeams(ctx context.Context, orgName string) ([]*github.Team, error)
+	// GetTeam is a wrapper for "GET /orgs/{org}/teams/{team_slug}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetTeam(ctx context.Context, orgName, teamName string) (*github.Team, error)
+	// CreateTeam is a wrapper for "POST /orgs/{org}/teams".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateTeam(ctx context.Context, orgName string, req *github.NewTeam) (*github.Team, error)
+	// EditTeam is a wrapper for "PATCH /orgs/{org}/teams/{team_slug}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	EditTeam(ctx context.Context, orgName, teamName string, req *github.NewTeam) (*github.Team, error)
+	// DeleteTeam is a wrapper for "DELETE /orgs/{org}/teams/{team_slug}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteTeam(ctx context.Context, orgName, teamName string) error
 
 	// GetRepo is a wrapper for "GET /repos/{owner}/{repo}".
 	// This function handles HTTP error wrapping, and validates the server result.
@@ -62,6 +97,9 @@ type githubClient interface {
 	// UpdateRepo is a wrapper for "PATCH /repos/{owner}/{repo}".
 	// This function handles HTTP error wrapping, and validates the server result.
 	UpdateRepo(ctx context.Context, owner, repo string, req *github.Repository) (*github.Repository, error)
+	// ReplaceRepoTopics is a wrapper for "PUT /repos/{owner}/{repo}/topics".
+	// This function handles HTTP error wrapping.
+	ReplaceRepoTopics(ctx context.Context, owner, repo string, topics []string) ([]string, error)
 	// DeleteRepo is a wrapper for "DELETE /repos/{owner}/{repo}".
 	// This function handles HTTP error wrapping.
 	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
@@ -83,6 +121,199 @@ type githubClient interface {
 	// This function handles HTTP error wrapping.
 	DeleteKey(ctx context.Context, owner, repo string, id int64) error
 
+	// ListHooks is a wrapper for "GET /repos/{owner}/{repo}/hooks".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListHooks(ctx context.Context, owner, repo string) ([]*github.Hook, error)
+	// CreateHook is a wrapper for "POST /repos/{owner}/{repo}/hooks".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateHook(ctx context.Context, owner, repo string, req *github.Hook) (*github.Hook, error)
+	// EditHook is a wrapper for "PATCH /repos/{owner}/{repo}/hooks/{hook_id}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	EditHook(ctx context.Context, owner, repo string, id int64, req *github.Hook) (*github.Hook, error)
+	// DeleteHook is a wrapper for "DELETE /repos/{owner}/{repo}/hooks/{hook_id}".
+	// This function handles HTTP error wrapping.
+	DeleteHook(ctx context.Context, owner, repo string, id int64) error
+
+	// ListStatuses is a wrapper for "GET /repos/{owner}/{repo}/commits/{ref}/statuses".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListStatuses(ctx context.Context, owner, repo, ref string) ([]*github.RepoStatus, error)
+	// CreateStatus is a wrapper for "POST /repos/{owner}/{repo}/statuses/{sha}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateStatus(ctx context.Context, owner, repo, sha string, req *github.RepoStatus) (*github.RepoStatus, error)
+	// GetCombinedStatus is a wrapper for "GET /repos/{owner}/{repo}/commits/{ref}/status".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	GetCombinedStatus(ctx context.Context, owner, repo, ref string) (*github.CombinedStatus, error)
+
+	// ListBranches is a wrapper for "GET /repos/{owner}/{repo}/branches".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	// If protectedOnly is true, only protected branches are returned.
+	ListBranches(ctx context.Context, owner, repo string, protectedOnly bool) ([]*github.Branch, error)
+	// GetBranch is a wrapper for "GET /repos/{owner}/{repo}/branches/{branch}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetBranch(ctx context.Context, owner, repo, branch string) (*github.Branch, error)
+	// DeleteBranch is a wrapper for "DELETE /repos/{owner}/{repo}/git/refs/heads/{branch}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteBranch(ctx context.Context, owner, repo, branch string) error
+	// RenameBranch is a wrapper for "POST /repos/{owner}/{repo}/branches/{branch}/rename".
+	// This function handles HTTP error wrapping.
+	RenameBranch(ctx context.Context, owner, repo, branch, newName string) error
+
+	// GetBranchProtection is a wrapper for "GET /repos/{owner}/{repo}/branches/{branch}/protection".
+	// This function handles HTTP error wrapping. ErrNotFound is returned if the branch is not protected.
+	GetBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Protection, error)
+	// UpdateBranchProtection is a wrapper for "PUT /repos/{owner}/{repo}/branches/{branch}/protection".
+	// This function handles HTTP error wrapping.
+	UpdateBranchProtection(ctx context.Context, owner, repo, branch string, req *github.ProtectionRequest) (*github.Protection, error)
+	// RemoveBranchProtection is a wrapper for "DELETE /repos/{owner}/{repo}/branches/{branch}/protection".
+	// This function handles HTTP error wrapping.
+	RemoveBranchProtection(ctx context.Context, owner, repo, branch string) error
+
+	// ListTags is a wrapper for "GET /repos/{owner}/{repo}/git/matching-refs/tags".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListTags(ctx context.Context, owner, repo string) ([]*github.Reference, error)
+	// GetTag is a wrapper for "GET /repos/{owner}/{repo}/git/ref/tags/{tag}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetTag(ctx context.Context, owner, repo, tag string) (*github.Reference, error)
+	// CreateTag is a wrapper for "POST /repos/{owner}/{repo}/git/refs", creating a reference
+	// to the given commit or tag object sha.
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateTag(ctx context.Context, owner, repo, tag, sha string) (*github.Reference, error)
+	// DeleteTag is a wrapper for "DELETE /repos/{owner}/{repo}/git/refs/tags/{tag}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteTag(ctx context.Context, owner, repo, tag string) error
+	// GetTagObject is a wrapper for "GET /repos/{owner}/{repo}/git/tags/{tag_sha}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetTagObject(ctx context.Context, owner, repo, sha string) (*github.Tag, error)
+	// CreateTagObject is a wrapper for "POST /repos/{owner}/{repo}/git/tags".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateTagObject(ctx context.Context, owner, repo string, req *github.Tag) (*github.Tag, error)
+
+	// ListReleases is a wrapper for "GET /repos/{owner}/{repo}/releases".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListReleases(ctx context.Context, owner, repo string) ([]*github.RepositoryRelease, error)
+	// GetReleaseByTag is a wrapper for "GET /repos/{owner}/{repo}/releases/tags/{tag}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetReleaseByTag(ctx context.Context, owner, repo, tag string) (*github.RepositoryRelease, error)
+	// CreateRelease is a wrapper for "POST /repos/{owner}/{repo}/releases".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateRelease(ctx context.Context, owner, repo string, req *github.RepositoryRelease) (*github.RepositoryRelease, error)
+	// EditRelease is a wrapper for "PATCH /repos/{owner}/{repo}/releases/{release_id}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	EditRelease(ctx context.Context, owner, repo string, id int64, req *github.RepositoryRelease) (*github.RepositoryRelease, error)
+	// DeleteRelease is a wrapper for "DELETE /repos/{owner}/{repo}/releases/{release_id}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteRelease(ctx context.Context, owner, repo string, id int64) error
+	// UploadReleaseAsset is a wrapper for "POST /repos/{owner}/{repo}/releases/{release_id}/assets".
+	// Contrary to go-github, the content can be read from any io.Reader.
+	// This function handles HTTP error wrapping.
+	UploadReleaseAsset(ctx context.Context, owner, repo string, id int64, name string, content io.Reader, size int64) (*github.ReleaseAsset, error)
+
+	// ListPullRequests is a wrapper for "GET /repos/{owner}/{repo}/pulls".
+	// This function handles pagination, and HTTP error wrapping.
+	ListPullRequests(ctx context.Context, owner, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, error)
+	// GetPullRequest is a wrapper for "GET /repos/{owner}/{repo}/pulls/{pull_number}".
+	// This function handles HTTP error wrapping.
+	GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error)
+	// EditPullRequest is a wrapper for "PATCH /repos/{owner}/{repo}/pulls/{pull_number}".
+	// This function handles HTTP error wrapping.
+	EditPullRequest(ctx context.Context, owner, repo string, number int, req *github.PullRequest) (*github.PullRequest, error)
+	// MergePullRequest is a wrapper for "PUT /repos/{owner}/{repo}/pulls/{pull_number}/merge".
+	// This function handles HTTP error wrapping, returning a *gitprovider.ConflictError if the head
+	// of the pull request isn't the expected commit.
+	MergePullRequest(ctx context.Context, owner, repo string, number int, message string, opts *github.PullRequestOptions) (*github.PullRequestMergeResult, error)
+	// DeletePullRequestSourceBranch is a wrapper for "DELETE /repos/{owner}/{repo}/git/refs/heads/{branch}",
+	// deleting the source branch of the given merged pull request. As its changes are part of the base
+	// branch, this is allowed even if destructive API calls are disabled. Nothing is deleted if the pull
+	// request isn't merged, or its source branch is in a fork.
+	// This function handles HTTP error wrapping.
+	DeletePullRequestSourceBranch(ctx context.Context, owner, repo string, pr *github.PullRequest) error
+	// SetPullRequestDraft marks the pull request with the given node ID as a draft, or as ready for review.
+	// There's no REST endpoint for this, it's a wrapper for the "convertPullRequestToDraft" and
+	// "markPullRequestReadyForReview" GraphQL mutations.
+	// This function handles HTTP error wrapping.
+	SetPullRequestDraft(ctx context.Context, nodeID string, draft bool) error
+	// EnablePullRequestAutoMerge enables auto-merge of the pull request with the given node ID, via the given
+	// merge method, i.e. "MERGE", "SQUASH" or "REBASE". It's a wrapper for the "enablePullRequestAutoMerge"
+	// GraphQL mutation.
+	// This function handles HTTP error wrapping.
+	EnablePullRequestAutoMerge(ctx context.Context, nodeID, mergeMethod string) error
+	// DisablePullRequestAutoMerge disables auto-merge of the pull request with the given node ID.
+	// It's a wrapper for the "disablePullRequestAutoMerge" GraphQL mutation.
+	// This function handles HTTP error wrapping.
+	DisablePullRequestAutoMerge(ctx context.Context, nodeID string) error
+	// RequestReviewers is a wrapper for "POST /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers".
+	// This function handles HTTP error wrapping.
+	RequestReviewers(ctx context.Context, owner, repo string, number int, logins []string) error
+	// EditIssue is a wrapper for "PATCH /repos/{owner}/{repo}/issues/{issue_number}", pull requests
+	// are issues too.
+	// This function handles HTTP error wrapping.
+	EditIssue(ctx context.Context, owner, repo string, number int, req *github.IssueRequest) (*github.Issue, error)
+	// ListIssues is a wrapper for "GET /repos/{owner}/{repo}/issues", pull requests are included.
+	// This function handles pagination, and HTTP error wrapping.
+	ListIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, error)
+	// GetIssue is a wrapper for "GET /repos/{owner}/{repo}/issues/{issue_number}".
+	// This function handles HTTP error wrapping.
+	GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error)
+	// CreateIssue is a wrapper for "POST /repos/{owner}/{repo}/issues".
+	// This function handles HTTP error wrapping.
+	CreateIssue(ctx context.Context, owner, repo string, req *github.IssueRequest) (*github.Issue, error)
+	// RemoveIssueMilestone is a wrapper for "PATCH /repos/{owner}/{repo}/issues/{issue_number}", setting
+	// the milestone to null.
+	// This function handles HTTP error wrapping.
+	RemoveIssueMilestone(ctx context.Context, owner, repo string, number int) (*github.Issue, error)
+	// ListMilestones is a wrapper for "GET /repos/{owner}/{repo}/milestones", in any state.
+	// This function handles pagination, and HTTP error wrapping.
+	ListMilestones(ctx context.Context, owner, repo string) ([]*github.Milestone, error)
+	// ListIssueComments is a wrapper for "GET /repos/{owner}/{repo}/issues/{issue_number}/comments".
+	// This function handles pagination, and HTTP error wrapping.
+	ListIssueComments(ctx context.Context, owner, repo string, number int) ([]*github.IssueComment, error)
+	// GetIssueComment is a wrapper for "GET /repos/{owner}/{repo}/issues/comments/{comment_id}".
+	// This function handles HTTP error wrapping.
+	GetIssueComment(ctx context.Context, owner, repo string, id int64) (*github.IssueComment, error)
+	// CreateIssueComment is a wrapper for "POST /repos/{owner}/{repo}/issues/{issue_number}/comments".
+	// This function handles HTTP error wrapping.
+	CreateIssueComment(ctx context.Context, owner, repo string, number int, req *github.IssueComment) (*github.IssueComment, error)
+	// EditIssueComment is a wrapper for "PATCH /repos/{owner}/{repo}/issues/comments/{comment_id}".
+	// This function handles HTTP error wrapping.
+	EditIssueComment(ctx context.Context, owner, repo string, id int64, req *github.IssueComment) (*github.IssueComment, error)
+	// DeleteIssueComment is a wrapper for "DELETE /repos/{owner}/{repo}/issues/comments/{comment_id}".
+	// This function handles HTTP error wrapping.
+	DeleteIssueComment(ctx context.Context, owner, repo string, id int64) error
+
+	// ListLabels is a wrapper for "GET /repos/{owner}/{repo}/labels".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListLabels(ctx context.Context, owner, repo string) ([]*github.Label, error)
+	// GetLabel is a wrapper for "GET /repos/{owner}/{repo}/labels/{name}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetLabel(ctx context.Context, owner, repo, name string) (*github.Label, error)
+	// CreateLabel is a wrapper for "POST /repos/{owner}/{repo}/labels".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateLabel(ctx context.Context, owner, repo string, req *github.Label) (*github.Label, error)
+	// EditLabel is a wrapper for "PATCH /repos/{owner}/{repo}/labels/{name}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	EditLabel(ctx context.Context, owner, repo, name string, req *github.Label) (*github.Label, error)
+	// DeleteLabel is a wrapper for "DELETE /repos/{owner}/{repo}/labels/{name}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteLabel(ctx context.Context, owner, repo, name string) error
+
+	// ListPullRequestReviews is a wrapper for "GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews".
+	// This function handles pagination, and HTTP error wrapping.
+	ListPullRequestReviews(ctx context.Context, owner, repo string, number int) ([]*github.PullRequestReview, error)
+	// CreatePullRequestReview is a wrapper for "POST /repos/{owner}/{repo}/pulls/{pull_number}/reviews".
+	// This function handles HTTP error wrapping.
+	CreatePullRequestReview(ctx context.Context, owner, repo string, number int, req *github.PullRequestReviewRequest) (*github.PullRequestReview, error)
+	// ListPullRequestFiles is a wrapper for "GET /repos/{owner}/{repo}/pulls/{pull_number}/files".
+	// This function handles pagination, and HTTP error wrapping.
+	ListPullRequestFiles(ctx context.Context, owner, repo string, number int) ([]*github.CommitFile, error)
+	// GetPullRequestDiff is a wrapper for "GET /repos/{owner}/{repo}/pulls/{pull_number}", requesting
+	// the diff media type.
+	// This function handles HTTP error wrapping.
+	GetPullRequestDiff(ctx context.Context, owner, repo string, number int) (string, error)
+
 	// GetTeamPermissions is a wrapper for "GET /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}".
 	// This function handles HTTP error wrapping, and validates the server result.
 	GetTeamPermissions(ctx context.Context, orgName, repo, teamName string) (map[string]bool, error)
@@ -95,6 +326,27 @@ type githubClient interface {
 	// RemoveTeam is a wrapper for "DELETE /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}".
 	// This function handles HTTP error wrapping.
 	RemoveTeam(ctx context.Context, orgName, repo, teamName string) error
+
+	// ListCollaborators is a wrapper for "GET /repos/{owner}/{repo}/collaborators", only listing the
+	// collaborators with direct access.
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListCollaborators(ctx context.Context, owner, repo string) ([]*github.User, error)
+	// AddCollaborator is a wrapper for "PUT /repos/{owner}/{repo}/collaborators/{username}".
+	// The returned invitation is nil if the user got access without having to accept an invitation.
+	// This function handles HTTP error wrapping.
+	AddCollaborator(ctx context.Context, owner, repo, user string, permission gitprovider.RepositoryPermission) (*github.CollaboratorInvitation, error)
+	// RemoveCollaborator is a wrapper for "DELETE /repos/{owner}/{repo}/collaborators/{username}".
+	// This function handles HTTP error wrapping.
+	RemoveCollaborator(ctx context.Context, owner, repo, user string) error
+	// ListRepoInvitations is a wrapper for "GET /repos/{owner}/{repo}/invitations".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListRepoInvitations(ctx context.Context, owner, repo string) ([]*github.RepositoryInvitation, error)
+	// UpdateRepoInvitation is a wrapper for "PATCH /repos/{owner}/{repo}/invitations/{invitation_id}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	UpdateRepoInvitation(ctx context.Context, owner, repo string, id int64, permissions string) (*github.RepositoryInvitation, error)
+	// DeleteRepoInvitation is a wrapper for "DELETE /repos/{owner}/{repo}/invitations/{invitation_id}".
+	// This function handles HTTP error wrapping.
+	DeleteRepoInvitation(ctx context.Context, owner, repo string, id int64) error
 }
 
 // githubClientImpl is a wrapper around *github.Client, which implements higher-level methods,
@@ -126,6 +378,40 @@ func (c *githubClientImpl) GetOrg(ctx context.Context, orgName string) (*github.
 	return apiObj, nil
 }
 
+func (c *githubClientImpl) CreateOrg(ctx context.Context, orgName, admin string) (*github.Organization, error) {
+	// POST /admin/organizations
+	apiObj, _, err := c.c.Admin.CreateOrg(ctx, &github.Organization{Login: &orgName}, admin)
+	return validateOrganizationAPIResp(apiObj, err)
+}
+
+func (c *githubClientImpl) EditOrg(ctx context.Context, orgName string, req *github.Organization) (*github.Organization, error) {
+	// PATCH /orgs/{org}
+	apiObj, _, err := c.c.Organizations.Edit(ctx, orgName, req)
+	return validateOrganizationAPIResp(apiObj, err)
+}
+
+func validateOrganizationAPIResp(apiObj *github.Organization, err error) (*github.Organization, error) {
+	// If the response contained an error, return
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// Make sure apiObj is valid
+	if err := validateOrganizationAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) DeleteOrg(ctx context.Context, orgName string) error {
+	// Don't allow deleting organizations if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete organization: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /orgs/{org}
+	_, err := c.c.Organizations.Delete(ctx, orgName)
+	return handleHTTPError(err)
+}
+
 func (c *githubClientImpl) ListOrgs(ctx context.Context) ([]*github.Organization, error) {
 	apiObjs := []*github.Organization{}
 	opts := &github.ListOptions{}
@@ -148,9 +434,9 @@ func (c *githubClientImpl) ListOrgs(ctx context.Context) ([]*github.Organization
 	return apiObjs, nil
 }
 
-func (c *githubClientImpl) ListOrgTeamMembers(ctx context.Context, orgName, teamName string) ([]*github.User, error) {
+func (c *githubClientImpl) ListOrgTeamMembers(ctx context.Context, orgName, teamName, role string) ([]*github.User, error) {
 	apiObjs := []*github.User{}
-	opts := &github.TeamListTeamMembersOptions{}
+	opts := &github.TeamListTeamMembersOptions{Role: role}
 	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
 		// GET /orgs/{org}/teams/{team_slug}/members
 		pageObjs, resp, listErr := c.c.Teams.ListTeamMembersBySlug(ctx, orgName, teamName, opts)
@@ -171,6 +457,20 @@ func (c *githubClientImpl) ListOrgTeamMembers(ctx context.Context, orgName, team
 	return apiObjs, nil
 }
 
+func (c *githubClientImpl) AddTeamMembership(ctx context.Context, orgName, teamName, user, role string) error {
+	// PUT /orgs/{org}/teams/{team_slug}/memberships/{username}
+	_, _, err := c.c.Teams.AddTeamMembershipBySlug(ctx, orgName, teamName, user, &github.TeamAddTeamMembershipOptions{
+		Role: role,
+	})
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) RemoveTeamMembership(ctx context.Context, orgName, teamName, user string) error {
+	// DELETE /orgs/{org}/teams/{team_slug}/memberships/{username}
+	_, err := c.c.Teams.RemoveTeamMembershipBySlug(ctx, orgName, teamName, user)
+	return handleHTTPError(err)
+}
+
 func (c *githubClientImpl) ListOrgTeams(ctx context.Context, orgName string) ([]*github.Team, error) {
 	// List all teams, using pagination. This does not contain information about the members
 	apiObjs := []*github.Team{}
@@ -194,6 +494,46 @@ func (c *githubClientImpl) ListOrgTeams(ctx context.Context, orgName string) ([]
 	return apiObjs, nil
 }
 
+func (c *githubClientImpl) GetTeam(ctx context.Context, orgName, teamName string) (*github.Team, error) {
+	// GET /orgs/{org}/teams/{team_slug}
+	apiObj, _, err := c.c.Teams.GetTeamBySlug(ctx, orgName, teamName)
+	return validateTeamAPIResp(apiObj, err)
+}
+
+func (c *githubClientImpl) CreateTeam(ctx context.Context, orgName string, req *github.NewTeam) (*github.Team, error) {
+	// POST /orgs/{org}/teams
+	apiObj, _, err := c.c.Teams.CreateTeam(ctx, orgName, *req)
+	return validateTeamAPIResp(apiObj, err)
+}
+
+func (c *githubClientImpl) EditTeam(ctx context.Context, orgName, teamName string, req *github.NewTeam) (*github.Team, error) {
+	// PATCH /orgs/{org}/teams/{team_slug}
+	apiObj, _, err := c.c.Teams.EditTeamBySlug(ctx, orgName, teamName, *req, false)
+	return validateTeamAPIResp(apiObj, err)
+}
+
+func validateTeamAPIResp(apiObj *github.Team, err error) (*github.Team, error) {
+	// If the response contained an error, return
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// Make sure apiObj is valid
+	if err := validateTeamAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) DeleteTeam(ctx context.Context, orgName, teamName string) error {
+	// Don't allow deleting teams if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete team: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /orgs/{org}/teams/{team_slug}
+	_, err := c.c.Teams.DeleteTeamBySlug(ctx, orgName, teamName)
+	return handleHTTPError(err)
+}
+
 func (c *githubClientImpl) GetRepo(ctx context.Context, owner, repo string) (*github.Repository, error) {
 	// GET /repos/{owner}/{repo}
 	apiObj, _, err := c.c.Repositories.Get(ctx, owner, repo)
@@ -269,6 +609,15 @@ func (c *githubClientImpl) UpdateRepo(ctx context.Context, owner, repo string, r
 	return validateRepositoryAPIResp(apiObj, err)
 }
 
+func (c *githubClientImpl) ReplaceRepoTopics(ctx context.Context, owner, repo string, topics []string) ([]string, error) {
+	// PUT /repos/{owner}/{repo}/topics
+	apiObj, _, err := c.c.Repositories.ReplaceAllTopics(ctx, owner, repo, topics)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
 func (c *githubClientImpl) DeleteRepo(ctx context.Context, owner, repo string) error {
 	// Don't allow deleting repositories if the user didn't explicitly allow dangerous API calls.
 	if !c.destructiveActions {
@@ -354,26 +703,63 @@ func (c *githubClientImpl) DeleteKey(ctx context.Context, owner, repo string, id
 	return handleHTTPError(err)
 }
 
-func (c *githubClientImpl) GetTeamPermissions(ctx context.Context, orgName, repo, teamName string) (map[string]bool, error) {
-	// GET /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
-	apiObj, _, err := c.c.Teams.IsTeamRepoBySlug(ctx, orgName, teamName, orgName, repo)
+func (c *githubClientImpl) ListHooks(ctx context.Context, owner, repo string) ([]*github.Hook, error) {
+	apiObjs := []*github.Hook{}
+	opts := &github.ListOptions{}
+	err := allPages(opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/hooks
+		pageObjs, resp, listErr := c.c.Repositories.ListHooks(ctx, owner, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateWebhookAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) CreateHook(ctx context.Context, owner, repo string, req *github.Hook) (*github.Hook, error) {
+	// POST /repos/{owner}/{repo}/hooks
+	apiObj, _, err := c.c.Repositories.CreateHook(ctx, owner, repo, req)
 	if err != nil {
 		return nil, handleHTTPError(err)
 	}
+	if err := validateWebhookAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
 
-	// Make sure permissions isn't nil
-	if apiObj.Permissions == nil {
-		return nil, fmt.Errorf("didn't expect permissions to be nil for team: %+v: %w", apiObj, gitprovider.ErrInvalidServerData)
+func (c *githubClientImpl) EditHook(ctx context.Context, owner, repo string, id int64, req *github.Hook) (*github.Hook, error) {
+	// PATCH /repos/{owner}/{repo}/hooks/{hook_id}
+	apiObj, _, err := c.c.Repositories.EditHook(ctx, owner, repo, id, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
 	}
-	return apiObj.Permissions, nil
+	if err := validateWebhookAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
 }
 
-func (c *githubClientImpl) ListRepoTeams(ctx context.Context, orgName, repo string) ([]*github.Team, error) {
-	apiObjs := []*github.Team{}
+func (c *githubClientImpl) DeleteHook(ctx context.Context, owner, repo string, id int64) error {
+	// DELETE /repos/{owner}/{repo}/hooks/{hook_id}
+	_, err := c.c.Repositories.DeleteHook(ctx, owner, repo, id)
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) ListStatuses(ctx context.Context, owner, repo, ref string) ([]*github.RepoStatus, error) {
+	apiObjs := []*github.RepoStatus{}
 	opts := &github.ListOptions{}
 	err := allPages(opts, func() (*github.Response, error) {
-		// GET /repos/{owner}/{repo}/teams
-		pageObjs, resp, listErr := c.c.Repositories.ListTeams(ctx, orgName, repo, opts)
+		// GET /repos/{owner}/{repo}/commits/{ref}/statuses
+		pageObjs, resp, listErr := c.c.Repositories.ListStatuses(ctx, owner, repo, ref, opts)
 		apiObjs = append(apiObjs, pageObjs...)
 		return resp, listErr
 	})
@@ -381,25 +767,753 @@ func (c *githubClientImpl) ListRepoTeams(ctx context.Context, orgName, repo stri
 		return nil, err
 	}
 
-	// Make sure the Slug field isn't nil
 	for _, apiObj := range apiObjs {
-		if apiObj.Slug == nil {
-			return nil, fmt.Errorf("didn't expect slug to be nil for team: %+v: %w", apiObj, gitprovider.ErrInvalidServerData)
+		if err := validateCommitStatusAPI(apiObj); err != nil {
+			return nil, err
 		}
 	}
 	return apiObjs, nil
 }
 
-func (c *githubClientImpl) AddTeam(ctx context.Context, orgName, repo, teamName string, permission gitprovider.RepositoryPermission) error {
-	// PUT /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
-	_, err := c.c.Teams.AddTeamRepoBySlug(ctx, orgName, teamName, orgName, repo, &github.TeamAddTeamRepoOptions{
-		Permission: string(permission),
+func (c *githubClientImpl) CreateStatus(ctx context.Context, owner, repo, sha string, req *github.RepoStatus) (*github.RepoStatus, error) {
+	// POST /repos/{owner}/{repo}/statuses/{sha}
+	apiObj, _, err := c.c.Repositories.CreateStatus(ctx, owner, repo, sha, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateCommitStatusAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) GetCombinedStatus(ctx context.Context, owner, repo, ref string) (*github.CombinedStatus, error) {
+	var apiObj *github.CombinedStatus
+	opts := &github.ListOptions{}
+	err := allPages(opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/commits/{ref}/status
+		pageObj, resp, getErr := c.c.Repositories.GetCombinedStatus(ctx, owner, repo, ref, opts)
+		if getErr != nil {
+			return resp, getErr
+		}
+		// The statuses of the combined status are paginated
+		if apiObj == nil {
+			apiObj = pageObj
+		} else {
+			apiObj.Statuses = append(apiObj.Statuses, pageObj.Statuses...)
+		}
+		return resp, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	if apiObj.State == nil {
+		return nil, fmt.Errorf("didn't expect state to be nil for combined status: %+v: %w", apiObj, gitprovider.ErrInvalidServerData)
+	}
+	for _, status := range apiObj.Statuses {
+		if err := validateCommitStatusAPI(status); err != nil {
+			return nil, err
+		}
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) ListBranches(ctx context.Context, owner, repo string, protectedOnly bool) ([]*github.Branch, error) {
+	apiObjs := []*github.Branch{}
+	opts := &github.BranchListOptions{}
+	if protectedOnly {
+		opts.Protected = gitprovider.BoolVar(true)
+	}
+	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/branches
+		pageObjs, resp, listErr := c.c.Repositories.ListBranches(ctx, owner, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateBranchAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) GetBranch(ctx context.Context, owner, repo, branch string) (*github.Branch, error) {
+	// GET /repos/{owner}/{repo}/branches/{branch}
+	apiObj, resp, err := c.c.Repositories.GetBranch(ctx, owner, repo, branch, 0)
+	if err != nil {
+		// go-github replaces the error response of missing branches with its own error
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("branch %q: %w", branch, gitprovider.ErrNotFound)
+		}
+		return nil, handleHTTPError(err)
+	}
+	if err := validateBranchAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) DeleteBranch(ctx context.Context, owner, repo, branch string) error {
+	// Don't allow deleting branches if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete branch: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repos/{owner}/{repo}/git/refs/heads/{branch}
+	_, err := c.c.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+branch)
 	return handleHTTPError(err)
 }
 
-func (c *githubClientImpl) RemoveTeam(ctx context.Context, orgName, repo, teamName string) error {
-	// DELETE /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
-	_, err := c.c.Teams.RemoveTeamRepoBySlug(ctx, orgName, teamName, orgName, repo)
+func (c *githubClientImpl) RenameBranch(ctx context.Context, owner, repo, branch, newName string) error {
+	// POST /repos/{owner}/{repo}/branches/{branch}/rename
+	_, _, err := c.c.Repositories.RenameBranch(ctx, owner, repo, branch, newName)
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) GetBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Protection, error) {
+	// GET /repos/{owner}/{repo}/branches/{branch}/protection
+	apiObj, _, err := c.c.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+	if err != nil {
+		// go-github replaces the 404 response of unprotected branches with its own error
+		if errors.Is(err, github.ErrBranchNotProtected) {
+			return nil, fmt.Errorf("branch %q: %w", branch, gitprovider.ErrNotFound)
+		}
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) UpdateBranchProtection(ctx context.Context, owner, repo, branch string, req *github.ProtectionRequest) (*github.Protection, error) {
+	// PUT /repos/{owner}/{repo}/branches/{branch}/protection
+	apiObj, _, err := c.c.Repositories.UpdateBranchProtection(ctx, owner, repo, branch, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) RemoveBranchProtection(ctx context.Context, owner, repo, branch string) error {
+	// DELETE /repos/{owner}/{repo}/branches/{branch}/protection
+	_, err := c.c.Repositories.RemoveBranchProtection(ctx, owner, repo, branch)
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) ListTags(ctx context.Context, owner, repo string) ([]*github.Reference, error) {
+	apiObjs := []*github.Reference{}
+	opts := &github.ReferenceListOptions{Ref: "tags"}
+	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/git/matching-refs/tags
+		pageObjs, resp, listErr := c.c.Git.ListMatchingRefs(ctx, owner, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateTagRefAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) GetTag(ctx context.Context, owner, repo, tag string) (*github.Reference, error) {
+	// GET /repos/{owner}/{repo}/git/ref/tags/{tag}
+	apiObj, _, err := c.c.Git.GetRef(ctx, owner, repo, "tags/"+tag)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateTagRefAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) CreateTag(ctx context.Context, owner, repo, tag, sha string) (*github.Reference, error) {
+	req := &github.Reference{
+		Ref:    github.String("refs/tags/" + tag),
+		Object: &github.GitObject{SHA: &sha},
+	}
+	// POST /repos/{owner}/{repo}/git/refs
+	apiObj, _, err := c.c.Git.CreateRef(ctx, owner, repo, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateTagRefAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) DeleteTag(ctx context.Context, owner, repo, tag string) error {
+	// Don't allow deleting tags if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete tag: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repos/{owner}/{repo}/git/refs/tags/{tag}
+	_, err := c.c.Git.DeleteRef(ctx, owner, repo, "refs/tags/"+tag)
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) GetTagObject(ctx context.Context, owner, repo, sha string) (*github.Tag, error) {
+	// GET /repos/{owner}/{repo}/git/tags/{tag_sha}
+	apiObj, _, err := c.c.Git.GetTag(ctx, owner, repo, sha)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateTagObjectAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) CreateTagObject(ctx context.Context, owner, repo string, req *github.Tag) (*github.Tag, error) {
+	// POST /repos/{owner}/{repo}/git/tags
+	apiObj, _, err := c.c.Git.CreateTag(ctx, owner, repo, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateTagObjectAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) ListReleases(ctx context.Context, owner, repo string) ([]*github.RepositoryRelease, error) {
+	apiObjs := []*github.RepositoryRelease{}
+	opts := &github.ListOptions{}
+	err := allPages(opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/releases
+		pageObjs, resp, listErr := c.c.Repositories.ListReleases(ctx, owner, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateReleaseAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) GetReleaseByTag(ctx context.Context, owner, repo, tag string) (*github.RepositoryRelease, error) {
+	// GET /repos/{owner}/{repo}/releases/tags/{tag}
+	apiObj, _, err := c.c.Repositories.GetReleaseByTag(ctx, owner, repo, tag)
+	return validateReleaseAPIResp(apiObj, err)
+}
+
+func (c *githubClientImpl) CreateRelease(ctx context.Context, owner, repo string, req *github.RepositoryRelease) (*github.RepositoryRelease, error) {
+	// POST /repos/{owner}/{repo}/releases
+	apiObj, _, err := c.c.Repositories.CreateRelease(ctx, owner, repo, req)
+	return validateReleaseAPIResp(apiObj, err)
+}
+
+func (c *githubClientImpl) EditRelease(ctx context.Context, owner, repo string, id int64, req *github.RepositoryRelease) (*github.RepositoryRelease, error) {
+	// PATCH /repos/{owner}/{repo}/releases/{release_id}
+	apiObj, _, err := c.c.Repositories.EditRelease(ctx, owner, repo, id, req)
+	return validateReleaseAPIResp(apiObj, err)
+}
+
+func validateReleaseAPIResp(apiObj *github.RepositoryRelease, err error) (*github.RepositoryRelease, error) {
+	// If the response contained an error, return
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// Make sure apiObj is valid
+	if err := validateReleaseAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) DeleteRelease(ctx context.Context, owner, repo string, id int64) error {
+	// Don't allow deleting releases if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete release: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repos/{owner}/{repo}/releases/{release_id}
+	_, err := c.c.Repositories.DeleteRelease(ctx, owner, repo, id)
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) UploadReleaseAsset(ctx context.Context, owner, repo string, id int64, name string, content io.Reader, size int64) (*github.ReleaseAsset, error) {
+	// go-github only accepts an *os.File, hence build the request the same way it does
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets?name=%s", owner, repo, id, url.QueryEscape(name))
+	req, err := c.c.NewUploadRequest(u, content, size, "application/octet-stream")
+	if err != nil {
+		return nil, err
+	}
+	// POST /repos/{owner}/{repo}/releases/{release_id}/assets
+	apiObj := &github.ReleaseAsset{}
+	if _, err := c.c.Do(ctx, req, apiObj); err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) ListPullRequests(ctx context.Context, owner, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, error) {
+	apiObjs := []*github.PullRequest{}
+	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/pulls
+		pageObjs, resp, listErr := c.c.PullRequests.List(ctx, owner, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, error) {
+	// GET /repos/{owner}/{repo}/pulls/{pull_number}
+	apiObj, _, err := c.c.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) EditPullRequest(ctx context.Context, owner, repo string, number int, req *github.PullRequest) (*github.PullRequest, error) {
+	// PATCH /repos/{owner}/{repo}/pulls/{pull_number}
+	apiObj, _, err := c.c.PullRequests.Edit(ctx, owner, repo, number, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) MergePullRequest(ctx context.Context, owner, repo string, number int, message string, opts *github.PullRequestOptions) (*github.PullRequestMergeResult, error) {
+	// PUT /repos/{owner}/{repo}/pulls/{pull_number}/merge
+	apiObj, _, err := c.c.PullRequests.Merge(ctx, owner, repo, number, message, opts)
+	if err != nil {
+		return nil, handleMergeError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) DeletePullRequestSourceBranch(ctx context.Context, owner, repo string, pr *github.PullRequest) error {
+	// Only the source branch of a merged pull request may bypass the guard of DeleteBranch, and
+	// branches of forks can't be deleted
+	if !pr.GetMerged() || pr.GetHead().GetRepo().GetID() != pr.GetBase().GetRepo().GetID() {
+		return nil
+	}
+	// DELETE /repos/{owner}/{repo}/git/refs/heads/{branch}
+	_, err := c.c.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+pr.GetHead().GetRef())
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) SetPullRequestDraft(ctx context.Context, nodeID string, draft bool) error {
+	mutation := "markPullRequestReadyForReview"
+	if draft {
+		mutation = "convertPullRequestToDraft"
+	}
+	return c.mutatePullRequest(ctx, mutation, nodeID, "")
+}
+
+func (c *githubClientImpl) EnablePullRequestAutoMerge(ctx context.Context, nodeID, mergeMethod string) error {
+	return c.mutatePullRequest(ctx, "enablePullRequestAutoMerge", nodeID, "mergeMethod: "+mergeMethod)
+}
+
+func (c *githubClientImpl) DisablePullRequestAutoMerge(ctx context.Context, nodeID string) error {
+	return c.mutatePullRequest(ctx, "disablePullRequestAutoMerge", nodeID, "")
+}
+
+// mutatePullRequest runs the given GraphQL mutation on the pull request with the given node ID.
+// extraInput is appended to the input of the mutation, e.g. for enum values.
+func (c *githubClientImpl) mutatePullRequest(ctx context.Context, mutation, nodeID, extraInput string) error {
+	input := "pullRequestId: $id"
+	if extraInput != "" {
+		input += ", " + extraInput
+	}
+	body := map[string]interface{}{
+		"query":     fmt.Sprintf("mutation($id: ID!) { %s(input: {%s}) { clientMutationId } }", mutation, input),
+		"variables": map[string]string{"id": nodeID},
+	}
+	// The GraphQL endpoint is "/graphql" on GitHub.com, and "/api/graphql" on GitHub Enterprise, next to "/api/v3/"
+	req, err := c.c.NewRequest(http.MethodPost, "../graphql", body)
+	if err != nil {
+		return err
+	}
+	// POST /graphql
+	resp := &struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}{}
+	if _, err := c.c.Do(ctx, req, resp); err != nil {
+		return handleHTTPError(err)
+	}
+	if len(resp.Errors) != 0 {
+		return fmt.Errorf("GraphQL mutation %s failed: %s", mutation, resp.Errors[0].Message)
+	}
+	return nil
+}
+
+func (c *githubClientImpl) RequestReviewers(ctx context.Context, owner, repo string, number int, logins []string) error {
+	// POST /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers
+	_, _, err := c.c.PullRequests.RequestReviewers(ctx, owner, repo, number, github.ReviewersRequest{Reviewers: logins})
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) EditIssue(ctx context.Context, owner, repo string, number int, req *github.IssueRequest) (*github.Issue, error) {
+	// PATCH /repos/{owner}/{repo}/issues/{issue_number}
+	apiObj, _, err := c.c.Issues.Edit(ctx, owner, repo, number, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) ListIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, error) {
+	apiObjs := []*github.Issue{}
+	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/issues
+		pageObjs, resp, listErr := c.c.Issues.ListByRepo(ctx, owner, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	// GET /repos/{owner}/{repo}/issues/{issue_number}
+	apiObj, _, err := c.c.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) CreateIssue(ctx context.Context, owner, repo string, req *github.IssueRequest) (*github.Issue, error) {
+	// POST /repos/{owner}/{repo}/issues
+	apiObj, _, err := c.c.Issues.Create(ctx, owner, repo, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) RemoveIssueMilestone(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	// PATCH /repos/{owner}/{repo}/issues/{issue_number}
+	apiObj, _, err := c.c.Issues.RemoveMilestone(ctx, owner, repo, number)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) ListMilestones(ctx context.Context, owner, repo string) ([]*github.Milestone, error) {
+	apiObjs := []*github.Milestone{}
+	opts := &github.MilestoneListOptions{State: "all"}
+	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/milestones
+		pageObjs, resp, listErr := c.c.Issues.ListMilestones(ctx, owner, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) ListIssueComments(ctx context.Context, owner, repo string, number int) ([]*github.IssueComment, error) {
+	apiObjs := []*github.IssueComment{}
+	opts := &github.IssueListCommentsOptions{}
+	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/issues/{issue_number}/comments
+		pageObjs, resp, listErr := c.c.Issues.ListComments(ctx, owner, repo, number, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) GetIssueComment(ctx context.Context, owner, repo string, id int64) (*github.IssueComment, error) {
+	// GET /repos/{owner}/{repo}/issues/comments/{comment_id}
+	apiObj, _, err := c.c.Issues.GetComment(ctx, owner, repo, id)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) CreateIssueComment(ctx context.Context, owner, repo string, number int, req *github.IssueComment) (*github.IssueComment, error) {
+	// POST /repos/{owner}/{repo}/issues/{issue_number}/comments
+	apiObj, _, err := c.c.Issues.CreateComment(ctx, owner, repo, number, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) EditIssueComment(ctx context.Context, owner, repo string, id int64, req *github.IssueComment) (*github.IssueComment, error) {
+	// PATCH /repos/{owner}/{repo}/issues/comments/{comment_id}
+	apiObj, _, err := c.c.Issues.EditComment(ctx, owner, repo, id, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) DeleteIssueComment(ctx context.Context, owner, repo string, id int64) error {
+	// DELETE /repos/{owner}/{repo}/issues/comments/{comment_id}
+	_, err := c.c.Issues.DeleteComment(ctx, owner, repo, id)
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) ListLabels(ctx context.Context, owner, repo string) ([]*github.Label, error) {
+	apiObjs := []*github.Label{}
+	opts := &github.ListOptions{}
+	err := allPages(opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/labels
+		pageObjs, resp, listErr := c.c.Issues.ListLabels(ctx, owner, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateLabelAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) GetLabel(ctx context.Context, owner, repo, name string) (*github.Label, error) {
+	// GET /repos/{owner}/{repo}/labels/{name}
+	apiObj, _, err := c.c.Issues.GetLabel(ctx, owner, repo, name)
+	return validateLabelAPIResp(apiObj, err)
+}
+
+func (c *githubClientImpl) CreateLabel(ctx context.Context, owner, repo string, req *github.Label) (*github.Label, error) {
+	// POST /repos/{owner}/{repo}/labels
+	apiObj, _, err := c.c.Issues.CreateLabel(ctx, owner, repo, req)
+	return validateLabelAPIResp(apiObj, err)
+}
+
+func (c *githubClientImpl) EditLabel(ctx context.Context, owner, repo, name string, req *github.Label) (*github.Label, error) {
+	// PATCH /repos/{owner}/{repo}/labels/{name}
+	apiObj, _, err := c.c.Issues.EditLabel(ctx, owner, repo, name, req)
+	return validateLabelAPIResp(apiObj, err)
+}
+
+func validateLabelAPIResp(apiObj *github.Label, err error) (*github.Label, error) {
+	// If the response contained an error, return
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// Make sure apiObj is valid
+	if err := validateLabelAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) DeleteLabel(ctx context.Context, owner, repo, name string) error {
+	// Don't allow deleting labels if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete label: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /repos/{owner}/{repo}/labels/{name}
+	_, err := c.c.Issues.DeleteLabel(ctx, owner, repo, name)
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) ListPullRequestReviews(ctx context.Context, owner, repo string, number int) ([]*github.PullRequestReview, error) {
+	apiObjs := []*github.PullRequestReview{}
+	opts := &github.ListOptions{}
+	err := allPages(opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews
+		pageObjs, resp, listErr := c.c.PullRequests.ListReviews(ctx, owner, repo, number, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) CreatePullRequestReview(ctx context.Context, owner, repo string, number int, req *github.PullRequestReviewRequest) (*github.PullRequestReview, error) {
+	// POST /repos/{owner}/{repo}/pulls/{pull_number}/reviews
+	apiObj, _, err := c.c.PullRequests.CreateReview(ctx, owner, repo, number, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) ListPullRequestFiles(ctx context.Context, owner, repo string, number int) ([]*github.CommitFile, error) {
+	apiObjs := []*github.CommitFile{}
+	// Use the largest page size, GitHub returns up to 3000 files in total
+	opts := &github.ListOptions{PerPage: 100}
+	err := allPages(opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/pulls/{pull_number}/files
+		pageObjs, resp, listErr := c.c.PullRequests.ListFiles(ctx, owner, repo, number, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) GetPullRequestDiff(ctx context.Context, owner, repo string, number int) (string, error) {
+	// GET /repos/{owner}/{repo}/pulls/{pull_number}
+	diff, _, err := c.c.PullRequests.GetRaw(ctx, owner, repo, number, github.RawOptions{Type: github.Diff})
+	if err != nil {
+		return "", handleHTTPError(err)
+	}
+	return diff, nil
+}
+
+func (c *githubClientImpl) GetTeamPermissions(ctx context.Context, orgName, repo, teamName string) (map[string]bool, error) {
+	// GET /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
+	apiObj, _, err := c.c.Teams.IsTeamRepoBySlug(ctx, orgName, teamName, orgName, repo)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	// Make sure permissions isn't nil
+	if apiObj.Permissions == nil {
+		return nil, fmt.Errorf("didn't expect permissions to be nil for team: %+v: %w", apiObj, gitprovider.ErrInvalidServerData)
+	}
+	return apiObj.Permissions, nil
+}
+
+func (c *githubClientImpl) ListRepoTeams(ctx context.Context, orgName, repo string) ([]*github.Team, error) {
+	apiObjs := []*github.Team{}
+	opts := &github.ListOptions{}
+	err := allPages(opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/teams
+		pageObjs, resp, listErr := c.c.Repositories.ListTeams(ctx, orgName, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the Slug field isn't nil
+	for _, apiObj := range apiObjs {
+		if apiObj.Slug == nil {
+			return nil, fmt.Errorf("didn't expect slug to be nil for team: %+v: %w", apiObj, gitprovider.ErrInvalidServerData)
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) AddTeam(ctx context.Context, orgName, repo, teamName string, permission gitprovider.RepositoryPermission) error {
+	// PUT /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
+	_, err := c.c.Teams.AddTeamRepoBySlug(ctx, orgName, teamName, orgName, repo, &github.TeamAddTeamRepoOptions{
+		Permission: string(permission),
+	})
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) RemoveTeam(ctx context.Context, orgName, repo, teamName string) error {
+	// DELETE /orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}
+	_, err := c.c.Teams.RemoveTeamRepoBySlug(ctx, orgName, teamName, orgName, repo)
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) ListCollaborators(ctx context.Context, owner, repo string) ([]*github.User, error) {
+	apiObjs := []*github.User{}
+	opts := &github.ListCollaboratorsOptions{Affiliation: "direct"}
+	err := allPages(&opts.ListOptions, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/collaborators
+		pageObjs, resp, listErr := c.c.Repositories.ListCollaborators(ctx, owner, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the Login and Permissions fields aren't nil
+	for _, apiObj := range apiObjs {
+		if apiObj.Login == nil || apiObj.Permissions == nil {
+			return nil, fmt.Errorf("didn't expect login or permissions to be nil for collaborator: %+v: %w", apiObj, gitprovider.ErrInvalidServerData)
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) AddCollaborator(ctx context.Context, owner, repo, user string, permission gitprovider.RepositoryPermission) (*github.CollaboratorInvitation, error) {
+	// PUT /repos/{owner}/{repo}/collaborators/{username}
+	apiObj, _, err := c.c.Repositories.AddCollaborator(ctx, owner, repo, user, &github.RepositoryAddCollaboratorOptions{
+		Permission: string(permission),
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) RemoveCollaborator(ctx context.Context, owner, repo, user string) error {
+	// DELETE /repos/{owner}/{repo}/collaborators/{username}
+	_, err := c.c.Repositories.RemoveCollaborator(ctx, owner, repo, user)
+	return handleHTTPError(err)
+}
+
+func (c *githubClientImpl) ListRepoInvitations(ctx context.Context, owner, repo string) ([]*github.RepositoryInvitation, error) {
+	apiObjs := []*github.RepositoryInvitation{}
+	opts := &github.ListOptions{}
+	err := allPages(opts, func() (*github.Response, error) {
+		// GET /repos/{owner}/{repo}/invitations
+		pageObjs, resp, listErr := c.c.Repositories.ListInvitations(ctx, owner, repo, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateRepoInvitationAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *githubClientImpl) UpdateRepoInvitation(ctx context.Context, owner, repo string, id int64, permissions string) (*github.RepositoryInvitation, error) {
+	// PATCH /repos/{owner}/{repo}/invitations/{invitation_id}
+	apiObj, _, err := c.c.Repositories.UpdateInvitation(ctx, owner, repo, id, permissions)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateRepoInvitationAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *githubClientImpl) DeleteRepoInvitation(ctx context.Context, owner, repo string, id int64) error {
+	// DELETE /repos/{owner}/{repo}/invitations/{invitation_id}
+	_, err := c.c.Repositories.DeleteInvitation(ctx, owner, repo, id)
 	return handleHTTPError(err)
 }
@@ -0,0 +1,50 @@
+//go:build e2e
+
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"os"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	token := os.Getenv("GITHUB_TOKEN")
+	org := os.Getenv("GIT_PROVIDER_ORGANIZATION")
+	if token == "" || org == "" {
+		t.Skip("GITHUB_TOKEN and GIT_PROVIDER_ORGANIZATION must be set")
+	}
+
+	conformance.Run(t, conformance.Config{
+		NewClient: func(destructive bool) (gitprovider.Client, error) {
+			return NewClient(
+				gitprovider.WithOAuth2Token(token),
+				gitprovider.WithDestructiveAPICalls(destructive),
+			)
+		},
+		Organization: gitprovider.OrganizationRef{Domain: githubDomain, Organization: org},
+		Team:         os.Getenv("GITHUB_TEST_TEAM_NAME"),
+		Skip: map[string]string{
+			"Commits/DeleteFile":       "a nil CommitFile.Content isn't treated as a delete",
+			"PullRequests/GetNotFound": "pull request calls return raw go-github errors",
+		},
+	})
+}
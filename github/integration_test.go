@@ -509,7 +509,7 @@ var _ = Describe("GitHub Provider", func() {
 		Expect(len(prs)).To(Equal(1))
 		Expect(prs[0].Get().WebURL).To(Equal(pr.Get().WebURL))
 
-		err = userRepo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodSquash, "squash merged")
+		_, err = userRepo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodSquash, "squash merged")
 		Expect(err).ToNot(HaveOccurred())
 
 		getPR, err := userRepo.PullRequests().Get(ctx, pr.Get().Number)
@@ -539,7 +539,7 @@ var _ = Describe("GitHub Provider", func() {
 		})
 		Expect(err).ToNot(HaveOccurred())
 
-		err = userRepo.PullRequests().Merge(ctx, editedPR.Get().Number, gitprovider.MergeMethodMerge, "merged")
+		_, err = userRepo.PullRequests().Merge(ctx, editedPR.Get().Number, gitprovider.MergeMethodMerge, "merged")
 		Expect(err).ToNot(HaveOccurred())
 
 		getPR, err = userRepo.PullRequests().Get(ctx, editedPR.Get().Number)
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newBranch(c *BranchClient, branch *github.Branch) *branchType {
+	return &branchType{
+		b: *branch,
+		c: c,
+	}
+}
+
+var _ gitprovider.Branch = &branchType{}
+
+type branchType struct {
+	b github.Branch
+	c *BranchClient
+}
+
+func (b *branchType) Get() gitprovider.BranchInfo {
+	return branchFromAPI(&b.b)
+}
+
+func (b *branchType) APIObject() interface{} {
+	return &b.b
+}
+
+func validateBranchAPI(apiObj *github.Branch) error {
+	return validateAPIObject("GitHub.Branch", func(validator validation.Validator) {
+		// Make sure the name and head commit are populated as per
+		// https://docs.github.com/en/rest/branches/branches#get-a-branch
+		if apiObj.Name == nil {
+			validator.Required("Name")
+		}
+		if apiObj.GetCommit().GetSHA() == "" {
+			validator.Required("Commit.SHA")
+		}
+	})
+}
+
+func branchFromAPI(apiObj *github.Branch) gitprovider.BranchInfo {
+	return gitprovider.BranchInfo{
+		Name:      apiObj.GetName(),
+		Sha:       apiObj.GetCommit().GetSHA(),
+		Protected: apiObj.GetProtected(),
+	}
+}
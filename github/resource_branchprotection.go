@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newBranchProtection(c *BranchProtectionClient, branch string, protection *github.Protection) *branchProtection {
+	return &branchProtection{
+		branch: branch,
+		p:      *protection,
+		c:      c,
+	}
+}
+
+var _ gitprovider.BranchProtection = &branchProtection{}
+
+type branchProtection struct {
+	// branch is the name of the protected branch, which GitHub doesn't return as part of the protection.
+	branch string
+	p      github.Protection
+	c      *BranchProtectionClient
+}
+
+func (bp *branchProtection) Get() gitprovider.BranchProtectionInfo {
+	return branchProtectionFromAPI(bp.branch, &bp.p)
+}
+
+func (bp *branchProtection) Set(info gitprovider.BranchProtectionInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	bp.branch = info.Branch
+	branchProtectionInfoToAPIObj(&info, &bp.p)
+	return nil
+}
+
+func (bp *branchProtection) APIObject() interface{} {
+	return &bp.p
+}
+
+func (bp *branchProtection) Repository() gitprovider.RepositoryRef {
+	return bp.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (bp *branchProtection) Update(ctx context.Context) error {
+	// PUT /repos/{owner}/{repo}/branches/{branch}/protection
+	apiObj, err := bp.c.c.UpdateBranchProtection(ctx, bp.c.ref.GetIdentity(), bp.c.ref.GetRepository(), bp.branch, protectionRequestFromAPI(&bp.p))
+	if err != nil {
+		return err
+	}
+	bp.p = *apiObj
+	return nil
+}
+
+// Delete unprotects the branch.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (bp *branchProtection) Delete(ctx context.Context) error {
+	// DELETE /repos/{owner}/{repo}/branches/{branch}/protection
+	return bp.c.c.RemoveBranchProtection(ctx, bp.c.ref.GetIdentity(), bp.c.ref.GetRepository(), bp.branch)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (bp *branchProtection) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := bp.c.c.GetBranchProtection(ctx, bp.c.ref.GetIdentity(), bp.c.ref.GetRepository(), bp.branch)
+	if err != nil {
+		// Create if not found, which is the same request as an update
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, bp.Update(ctx)
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// Compare the "spec" part of the objects, i.e. what would be sent to the server
+	if reflect.DeepEqual(protectionRequestFromAPI(&bp.p), protectionRequestFromAPI(actual)) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	return true, bp.Update(ctx)
+}
+
+func branchProtectionFromAPI(branch string, apiObj *github.Protection) gitprovider.BranchProtectionInfo {
+	info := gitprovider.BranchProtectionInfo{
+		Branch:                       branch,
+		RequiredApprovingReviewCount: gitprovider.IntVar(0),
+		RestrictPushes:               gitprovider.BoolVar(apiObj.Restrictions != nil),
+		AllowForcePushes:             gitprovider.BoolVar(apiObj.AllowForcePushes != nil && apiObj.AllowForcePushes.Enabled),
+		AllowDeletions:               gitprovider.BoolVar(apiObj.AllowDeletions != nil && apiObj.AllowDeletions.Enabled),
+		IncludeAdmins:                gitprovider.BoolVar(apiObj.EnforceAdmins != nil && apiObj.EnforceAdmins.Enabled),
+	}
+	if apiObj.RequiredPullRequestReviews != nil {
+		info.RequiredApprovingReviewCount = gitprovider.IntVar(apiObj.RequiredPullRequestReviews.RequiredApprovingReviewCount)
+	}
+	if apiObj.RequiredStatusChecks != nil {
+		for _, check := range requiredStatusChecks(apiObj.RequiredStatusChecks) {
+			info.RequiredStatusChecks = append(info.RequiredStatusChecks, check.Context)
+		}
+	}
+	if apiObj.Restrictions != nil {
+		for _, user := range apiObj.Restrictions.Users {
+			info.PushAllowances = append(info.PushAllowances, user.GetLogin())
+		}
+	}
+	return info
+}
+
+func branchProtectionInfoToAPIObj(info *gitprovider.BranchProtectionInfo, apiObj *github.Protection) {
+	if info.RequiredApprovingReviewCount != nil {
+		if *info.RequiredApprovingReviewCount == 0 {
+			apiObj.RequiredPullRequestReviews = nil
+		} else {
+			if apiObj.RequiredPullRequestReviews == nil {
+				apiObj.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcement{}
+			}
+			apiObj.RequiredPullRequestReviews.RequiredApprovingReviewCount = *info.RequiredApprovingReviewCount
+		}
+	}
+	if len(info.RequiredStatusChecks) == 0 {
+		apiObj.RequiredStatusChecks = nil
+	} else {
+		if apiObj.RequiredStatusChecks == nil {
+			apiObj.RequiredStatusChecks = &github.RequiredStatusChecks{}
+		}
+		checks := make([]*github.RequiredStatusCheck, 0, len(info.RequiredStatusChecks))
+		for _, check := range info.RequiredStatusChecks {
+			checks = append(checks, &github.RequiredStatusCheck{Context: check})
+		}
+		apiObj.RequiredStatusChecks.Contexts = append([]string{}, info.RequiredStatusChecks...)
+		apiObj.RequiredStatusChecks.Checks = checks
+	}
+	if info.RestrictPushes != nil {
+		if !*info.RestrictPushes {
+			apiObj.Restrictions = nil
+		} else {
+			// Keep the teams and apps allowed to push, as they're not part of the generic model
+			if apiObj.Restrictions == nil {
+				apiObj.Restrictions = &github.BranchRestrictions{}
+			}
+			users := make([]*github.User, 0, len(info.PushAllowances))
+			for _, login := range info.PushAllowances {
+				users = append(users, &github.User{Login: gitprovider.StringVar(login)})
+			}
+			apiObj.Restrictions.Users = users
+		}
+	}
+	if info.AllowForcePushes != nil {
+		apiObj.AllowForcePushes = &github.AllowForcePushes{Enabled: *info.AllowForcePushes}
+	}
+	if info.AllowDeletions != nil {
+		apiObj.AllowDeletions = &github.AllowDeletions{Enabled: *info.AllowDeletions}
+	}
+	if info.IncludeAdmins != nil {
+		if apiObj.EnforceAdmins == nil {
+			apiObj.EnforceAdmins = &github.AdminEnforcement{}
+		}
+		apiObj.EnforceAdmins.Enabled = *info.IncludeAdmins
+	}
+}
+
+// requiredStatusChecks returns the required checks, falling back to the deprecated contexts.
+func requiredStatusChecks(apiObj *github.RequiredStatusChecks) []*github.RequiredStatusCheck {
+	if len(apiObj.Checks) != 0 {
+		return apiObj.Checks
+	}
+	checks := make([]*github.RequiredStatusCheck, 0, len(apiObj.Contexts))
+	for _, check := range apiObj.Contexts {
+		checks = append(checks, &github.RequiredStatusCheck{Context: check})
+	}
+	return checks
+}
+
+// protectionRequestFromAPI returns the request which (re)creates the given protection. GitHub
+// replaces the whole protection on every request, hence settings that are not part of the
+// generic model, e.g. teams allowed to push, are copied over as well.
+func protectionRequestFromAPI(apiObj *github.Protection) *github.ProtectionRequest {
+	req := &github.ProtectionRequest{
+		EnforceAdmins:                  apiObj.EnforceAdmins != nil && apiObj.EnforceAdmins.Enabled,
+		RequireLinearHistory:           gitprovider.BoolVar(apiObj.RequireLinearHistory != nil && apiObj.RequireLinearHistory.Enabled),
+		AllowForcePushes:               gitprovider.BoolVar(apiObj.AllowForcePushes != nil && apiObj.AllowForcePushes.Enabled),
+		AllowDeletions:                 gitprovider.BoolVar(apiObj.AllowDeletions != nil && apiObj.AllowDeletions.Enabled),
+		RequiredConversationResolution: gitprovider.BoolVar(apiObj.RequiredConversationResolution != nil && apiObj.RequiredConversationResolution.Enabled),
+	}
+	if apiObj.RequiredStatusChecks != nil {
+		req.RequiredStatusChecks = &github.RequiredStatusChecks{
+			Strict: apiObj.RequiredStatusChecks.Strict,
+			Checks: requiredStatusChecks(apiObj.RequiredStatusChecks),
+		}
+	}
+	if reviews := apiObj.RequiredPullRequestReviews; reviews != nil {
+		req.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{
+			DismissStaleReviews:          reviews.DismissStaleReviews,
+			RequireCodeOwnerReviews:      reviews.RequireCodeOwnerReviews,
+			RequiredApprovingReviewCount: reviews.RequiredApprovingReviewCount,
+			RequireLastPushApproval:      gitprovider.BoolVar(reviews.RequireLastPushApproval),
+		}
+	}
+	if restrictions := apiObj.Restrictions; restrictions != nil {
+		// GitHub requires all lists to be set, even if empty
+		req.Restrictions = &github.BranchRestrictionsRequest{
+			Users: []string{},
+			Teams: []string{},
+			Apps:  []string{},
+		}
+		for _, user := range restrictions.Users {
+			req.Restrictions.Users = append(req.Restrictions.Users, user.GetLogin())
+		}
+		for _, team := range restrictions.Teams {
+			req.Restrictions.Teams = append(req.Restrictions.Teams, team.GetSlug())
+		}
+		for _, app := range restrictions.Apps {
+			req.Restrictions.Apps = append(req.Restrictions.Apps, app.GetSlug())
+		}
+	}
+	return req
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_branchProtectionInfoToAPIObj(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    gitprovider.BranchProtectionInfo
+		apiObj  github.Protection
+		wantReq *github.ProtectionRequest
+	}{
+		{
+			name: "defaults",
+			info: gitprovider.BranchProtectionInfo{Branch: "main"},
+			wantReq: &github.ProtectionRequest{
+				RequireLinearHistory:           gitprovider.BoolVar(false),
+				AllowForcePushes:               gitprovider.BoolVar(false),
+				AllowDeletions:                 gitprovider.BoolVar(false),
+				RequiredConversationResolution: gitprovider.BoolVar(false),
+			},
+		},
+		{
+			name: "all rules",
+			info: gitprovider.BranchProtectionInfo{
+				Branch:                       "main",
+				RequiredApprovingReviewCount: gitprovider.IntVar(2),
+				RequiredStatusChecks:         []string{"ci/build"},
+				RestrictPushes:               gitprovider.BoolVar(true),
+				PushAllowances:               []string{"octocat"},
+				AllowForcePushes:             gitprovider.BoolVar(true),
+				AllowDeletions:               gitprovider.BoolVar(true),
+				IncludeAdmins:                gitprovider.BoolVar(true),
+			},
+			wantReq: &github.ProtectionRequest{
+				RequiredStatusChecks: &github.RequiredStatusChecks{
+					Checks: []*github.RequiredStatusCheck{{Context: "ci/build"}},
+				},
+				RequiredPullRequestReviews: &github.PullRequestReviewsEnforcementRequest{
+					RequiredApprovingReviewCount: 2,
+					RequireLastPushApproval:      gitprovider.BoolVar(false),
+				},
+				EnforceAdmins: true,
+				Restrictions: &github.BranchRestrictionsRequest{
+					Users: []string{"octocat"},
+					Teams: []string{},
+					Apps:  []string{},
+				},
+				RequireLinearHistory:           gitprovider.BoolVar(false),
+				AllowForcePushes:               gitprovider.BoolVar(true),
+				AllowDeletions:                 gitprovider.BoolVar(true),
+				RequiredConversationResolution: gitprovider.BoolVar(false),
+			},
+		},
+		{
+			name: "keeps settings outside of the generic model",
+			info: gitprovider.BranchProtectionInfo{
+				Branch:         "main",
+				RestrictPushes: gitprovider.BoolVar(true),
+			},
+			apiObj: github.Protection{
+				Restrictions: &github.BranchRestrictions{
+					Users: []*github.User{{Login: gitprovider.StringVar("octocat")}},
+					Teams: []*github.Team{{Slug: gitprovider.StringVar("maintainers")}},
+				},
+				RequireLinearHistory: &github.RequireLinearHistory{Enabled: true},
+			},
+			wantReq: &github.ProtectionRequest{
+				Restrictions: &github.BranchRestrictionsRequest{
+					Users: []string{},
+					Teams: []string{"maintainers"},
+					Apps:  []string{},
+				},
+				RequireLinearHistory:           gitprovider.BoolVar(true),
+				AllowForcePushes:               gitprovider.BoolVar(false),
+				AllowDeletions:                 gitprovider.BoolVar(false),
+				RequiredConversationResolution: gitprovider.BoolVar(false),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := gitprovider.ValidateAndDefaultInfo(&tt.info); err != nil {
+				t.Fatal(err)
+			}
+			branchProtectionInfoToAPIObj(&tt.info, &tt.apiObj)
+			if diff := cmp.Diff(tt.wantReq, protectionRequestFromAPI(&tt.apiObj)); diff != "" {
+				t.Errorf("protectionRequestFromAPI() returned diff (want -> got):\n%s", diff)
+			}
+			// The generic information must survive the round trip
+			if got := branchProtectionFromAPI(tt.info.Branch, &tt.apiObj); !tt.info.Equals(got) {
+				t.Errorf("branchProtectionFromAPI() = %+v, want %+v", got, tt.info)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+const (
+	// invitationPermissionRead and invitationPermissionWrite are the names of the pull and push
+	// permissions in repository invitations.
+	invitationPermissionRead  = "read"
+	invitationPermissionWrite = "write"
+)
+
+func newCollaborator(c *CollaboratorClient, user *github.User) *collaborator {
+	return &collaborator{
+		user: user,
+		info: gitprovider.CollaboratorInfo{
+			Login:      *user.Login,
+			Permission: getPermissionFromMap(user.Permissions),
+		},
+		c: c,
+	}
+}
+
+func newInvitedCollaborator(c *CollaboratorClient, invitation *github.RepositoryInvitation) *collaborator {
+	return &collaborator{
+		invitation: invitation,
+		info: gitprovider.CollaboratorInfo{
+			Login:      *invitation.Invitee.Login,
+			Permission: invitationPermissionFromAPI(invitation.GetPermissions()),
+			Pending:    true,
+		},
+		c: c,
+	}
+}
+
+var _ gitprovider.Collaborator = &collaborator{}
+
+type collaborator struct {
+	// Exactly one of user and invitation is set, depending on whether the collaborator is pending.
+	user       *github.User
+	invitation *github.RepositoryInvitation
+	info       gitprovider.CollaboratorInfo
+	c          *CollaboratorClient
+}
+
+func (c *collaborator) Get() gitprovider.CollaboratorInfo {
+	return c.info
+}
+
+func (c *collaborator) Set(info gitprovider.CollaboratorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	// Pending is read-only
+	info.Pending = c.info.Pending
+	c.info = info
+	return nil
+}
+
+// APIObject returns the *github.RepositoryInvitation if the collaborator is pending,
+// and the *github.User otherwise.
+func (c *collaborator) APIObject() interface{} {
+	if c.invitation != nil {
+		return c.invitation
+	}
+	return c.user
+}
+
+func (c *collaborator) Repository() gitprovider.RepositoryRef {
+	return c.c.ref
+}
+
+// Delete removes the user from the repository's collaborators, or revokes the invitation if
+// the collaborator is pending.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Delete(ctx context.Context) error {
+	if c.invitation != nil {
+		// DELETE /repos/{owner}/{repo}/invitations/{invitation_id}
+		return c.c.c.DeleteRepoInvitation(ctx, c.c.ref.GetIdentity(), c.c.ref.GetRepository(), c.invitation.GetID())
+	}
+	// DELETE /repos/{owner}/{repo}/collaborators/{username}
+	return c.c.c.RemoveCollaborator(ctx, c.c.ref.GetIdentity(), c.c.ref.GetRepository(), c.info.Login)
+}
+
+// Update will apply the desired permission to the collaborator, or to the invitation if the
+// collaborator is pending.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&c.info); err != nil {
+		return err
+	}
+	if err := c.c.validateCollaboratorInfo(c.info); err != nil {
+		return err
+	}
+
+	if c.invitation != nil {
+		// PATCH /repos/{owner}/{repo}/invitations/{invitation_id}
+		apiObj, err := c.c.c.UpdateRepoInvitation(ctx, c.c.ref.GetIdentity(), c.c.ref.GetRepository(),
+			c.invitation.GetID(), invitationPermissionToAPI(*c.info.Permission))
+		if err != nil {
+			return err
+		}
+		*c = *newInvitedCollaborator(c.c, apiObj)
+		return nil
+	}
+
+	// Update the actual state to be the desired state by issuing a PUT
+	resp, err := c.c.add(ctx, c.info)
+	if err != nil {
+		return err
+	}
+	*c = *resp
+	return nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *collaborator) Reconcile(ctx context.Context) (bool, error) {
+	req := c.Get()
+	actual, err := c.c.get(ctx, req.Login)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.c.Create(ctx, req)
+			if err != nil {
+				return true, err
+			}
+			*c = *resp.(*collaborator)
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// Use the actual state to know whether the collaborator is pending
+	c.user, c.invitation = actual.user, actual.invitation
+	c.info.Pending = actual.info.Pending
+	// If the desired matches the actual state, do nothing
+	if req.Equals(actual.Get()) {
+		return false, nil
+	}
+
+	return true, c.Update(ctx)
+}
+
+func validateRepoInvitationAPI(apiObj *github.RepositoryInvitation) error {
+	return validateAPIObject("GitHub.RepositoryInvitation", func(validator validation.Validator) {
+		// Make sure the ID and invitee login are populated, they identify the pending collaborator
+		if apiObj.ID == nil {
+			validator.Required("ID")
+		}
+		if apiObj.Invitee == nil || apiObj.Invitee.Login == nil {
+			validator.Required("Invitee.Login")
+		}
+	})
+}
+
+// invitationPermissionFromAPI maps the permission of a repository invitation to a RepositoryPermission.
+// Invitations call the pull and push permissions read and write.
+func invitationPermissionFromAPI(permission string) *gitprovider.RepositoryPermission {
+	switch permission {
+	case invitationPermissionRead:
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPull)
+	case invitationPermissionWrite:
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush)
+	default:
+		return gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermission(permission))
+	}
+}
+
+// invitationPermissionToAPI is the inverse of invitationPermissionFromAPI.
+func invitationPermissionToAPI(permission gitprovider.RepositoryPermission) string {
+	switch permission {
+	case gitprovider.RepositoryPermissionPull:
+		return invitationPermissionRead
+	case gitprovider.RepositoryPermissionPush:
+		return invitationPermissionWrite
+	default:
+		return string(permission)
+	}
+}
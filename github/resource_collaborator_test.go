@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_invitationPermission(t *testing.T) {
+	tests := []struct {
+		apiPermission string
+		want          gitprovider.RepositoryPermission
+	}{
+		{apiPermission: "read", want: gitprovider.RepositoryPermissionPull},
+		{apiPermission: "triage", want: gitprovider.RepositoryPermissionTriage},
+		{apiPermission: "write", want: gitprovider.RepositoryPermissionPush},
+		{apiPermission: "maintain", want: gitprovider.RepositoryPermissionMaintain},
+		{apiPermission: "admin", want: gitprovider.RepositoryPermissionAdmin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.apiPermission, func(t *testing.T) {
+			got := invitationPermissionFromAPI(tt.apiPermission)
+			if got == nil || *got != tt.want {
+				t.Errorf("invitationPermissionFromAPI() = %v, want %v", got, tt.want)
+			}
+			if got := invitationPermissionToAPI(tt.want); got != tt.apiPermission {
+				t.Errorf("invitationPermissionToAPI() = %v, want %v", got, tt.apiPermission)
+			}
+		})
+	}
+}
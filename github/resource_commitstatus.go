@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newCommitStatus(c *CommitStatusClient, status *github.RepoStatus) *commitStatus {
+	return &commitStatus{
+		s: *status,
+		c: c,
+	}
+}
+
+var _ gitprovider.CommitStatus = &commitStatus{}
+
+type commitStatus struct {
+	s github.RepoStatus
+	c *CommitStatusClient
+}
+
+// Get returns the commit status information.
+func (s *commitStatus) Get() gitprovider.CommitStatusInfo {
+	return commitStatusFromAPI(&s.s)
+}
+
+// APIObject returns the underlying value that was returned from the server.
+func (s *commitStatus) APIObject() interface{} {
+	return &s.s
+}
+
+// Repository returns the repository reference.
+func (s *commitStatus) Repository() gitprovider.RepositoryRef {
+	return s.c.ref
+}
+
+// validateCommitStatusAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateCommitStatusAPI(apiObj *github.RepoStatus) error {
+	return validateAPIObject("GitHub.RepoStatus", func(validator validation.Validator) {
+		if apiObj.State == nil {
+			validator.Required("State")
+		} else {
+			state := gitprovider.CommitStatusState(*apiObj.State)
+			validator.Append(gitprovider.ValidateCommitStatusState(state), state, "State")
+		}
+	})
+}
+
+func commitStatusFromAPI(apiObj *github.RepoStatus) gitprovider.CommitStatusInfo {
+	return gitprovider.CommitStatusInfo{
+		State:       gitprovider.CommitStatusState(apiObj.GetState()),
+		Context:     apiObj.GetContext(),
+		Description: apiObj.GetDescription(),
+		TargetURL:   apiObj.GetTargetURL(),
+		CreatedAt:   apiObj.GetCreatedAt().Time,
+	}
+}
+
+func commitStatusToAPI(info *gitprovider.CommitStatusInfo) *github.RepoStatus {
+	apiObj := &github.RepoStatus{
+		State:   github.String(string(info.State)),
+		Context: github.String(info.Context),
+	}
+	// GitHub rejects empty URLs
+	if info.Description != "" {
+		apiObj.Description = github.String(info.Description)
+	}
+	if info.TargetURL != "" {
+		apiObj.TargetURL = github.String(info.TargetURL)
+	}
+	return apiObj
+}
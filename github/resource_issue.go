@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssue(c *IssueClient, apiObj *github.Issue) *issue {
+	return &issue{
+		i: *apiObj,
+		comments: &IssueCommentClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.GetNumber(),
+		},
+	}
+}
+
+var _ gitprovider.Issue = &issue{}
+
+type issue struct {
+	i        github.Issue
+	comments *IssueCommentClient
+}
+
+func (i *issue) Get() gitprovider.IssueInfo {
+	return issueFromAPI(&i.i)
+}
+
+func (i *issue) APIObject() interface{} {
+	return &i.i
+}
+
+func (i *issue) Comments() gitprovider.IssueCommentClient {
+	return i.comments
+}
+
+func issueFromAPI(apiObj *github.Issue) gitprovider.IssueInfo {
+	info := gitprovider.IssueInfo{
+		Number:      apiObj.GetNumber(),
+		Title:       apiObj.GetTitle(),
+		Description: apiObj.GetBody(),
+		State:       gitprovider.IssueStateOpen,
+		Milestone:   apiObj.GetMilestone().GetTitle(),
+		Author:      apiObj.GetUser().GetLogin(),
+		WebURL:      apiObj.GetHTMLURL(),
+		CreatedAt:   apiObj.GetCreatedAt().Time,
+		UpdatedAt:   apiObj.GetUpdatedAt().Time,
+	}
+	if apiObj.GetState() == "closed" {
+		info.State = gitprovider.IssueStateClosed
+		closedAt := apiObj.GetClosedAt().Time
+		info.ClosedAt = &closedAt
+	}
+	for _, label := range apiObj.Labels {
+		info.Labels = append(info.Labels, label.GetName())
+	}
+	for _, user := range apiObj.Assignees {
+		info.Assignees = append(info.Assignees, user.GetLogin())
+	}
+	return info
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssueComment(c *IssueCommentClient, apiObj *github.IssueComment) *issueComment {
+	return &issueComment{
+		ic: *apiObj,
+		c:  c,
+	}
+}
+
+var _ gitprovider.IssueComment = &issueComment{}
+
+type issueComment struct {
+	ic github.IssueComment
+	c  *IssueCommentClient
+}
+
+func (ic *issueComment) Get() gitprovider.IssueCommentInfo {
+	return issueCommentFromAPI(&ic.ic)
+}
+
+func (ic *issueComment) Set(info gitprovider.IssueCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	ic.ic.Body = &info.Body
+	return nil
+}
+
+func (ic *issueComment) APIObject() interface{} {
+	return &ic.ic
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (ic *issueComment) Update(ctx context.Context) error {
+	// PATCH /repos/{owner}/{repo}/issues/comments/{comment_id}
+	apiObj, err := ic.c.c.EditIssueComment(ctx, ic.c.ref.GetIdentity(), ic.c.ref.GetRepository(), ic.ic.GetID(), &github.IssueComment{
+		Body: ic.ic.Body,
+	})
+	if err != nil {
+		return err
+	}
+	ic.ic = *apiObj
+	return nil
+}
+
+// Delete deletes the comment.
+//
+// ErrNotFound is returned if the resource doesn't exist anymore.
+func (ic *issueComment) Delete(ctx context.Context) error {
+	// DELETE /repos/{owner}/{repo}/issues/comments/{comment_id}
+	return ic.c.c.DeleteIssueComment(ctx, ic.c.ref.GetIdentity(), ic.c.ref.GetRepository(), ic.ic.GetID())
+}
+
+func issueCommentFromAPI(apiObj *github.IssueComment) gitprovider.IssueCommentInfo {
+	return gitprovider.IssueCommentInfo{
+		ID:        apiObj.GetID(),
+		Body:      apiObj.GetBody(),
+		Author:    apiObj.GetUser().GetLogin(),
+		CreatedAt: apiObj.GetCreatedAt().Time,
+		UpdatedAt: apiObj.GetUpdatedAt().Time,
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newLabel(c *LabelClient, apiObj *github.Label) *label {
+	return &label{
+		l:    *apiObj,
+		name: apiObj.GetName(),
+		c:    c,
+	}
+}
+
+var _ gitprovider.Label = &label{}
+
+type label struct {
+	l github.Label
+	// name is the name of the label on the server, which differs from the one in l if it's renamed.
+	name string
+	c    *LabelClient
+}
+
+func (l *label) Get() gitprovider.LabelInfo {
+	return labelFromAPI(&l.l)
+}
+
+func (l *label) Set(info gitprovider.LabelInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	info.Default()
+	labelInfoToAPIObj(&info, &l.l)
+	return nil
+}
+
+func (l *label) APIObject() interface{} {
+	return &l.l
+}
+
+func (l *label) Repository() gitprovider.RepositoryRef {
+	return l.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (l *label) Update(ctx context.Context) error {
+	// PATCH /repos/{owner}/{repo}/labels/{name}
+	apiObj, err := l.c.c.EditLabel(ctx, l.c.ref.GetIdentity(), l.c.ref.GetRepository(), l.name, labelSpecForWrite(&l.l))
+	if err != nil {
+		return err
+	}
+	l.l, l.name = *apiObj, apiObj.GetName()
+	return nil
+}
+
+// Delete deletes the label from the repository, removing it from all issues and pull requests.
+//
+// ErrDestructiveCallDisallowed is returned unless destructive API calls are enabled.
+// ErrNotFound is returned if the resource does not exist.
+func (l *label) Delete(ctx context.Context) error {
+	// DELETE /repos/{owner}/{repo}/labels/{name}
+	return l.c.c.DeleteLabel(ctx, l.c.ref.GetIdentity(), l.c.ref.GetRepository(), l.name)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (l *label) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := l.c.get(ctx, l.l.GetName())
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, l.createIntoSelf(ctx)
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if l.Get().Equals(actual.Get()) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	l.name = actual.name
+	return true, l.Update(ctx)
+}
+
+func (l *label) createIntoSelf(ctx context.Context) error {
+	// POST /repos/{owner}/{repo}/labels
+	apiObj, err := l.c.c.CreateLabel(ctx, l.c.ref.GetIdentity(), l.c.ref.GetRepository(), labelSpecForWrite(&l.l))
+	if err != nil {
+		return err
+	}
+	l.l, l.name = *apiObj, apiObj.GetName()
+	return nil
+}
+
+func validateLabelAPI(apiObj *github.Label) error {
+	return validateAPIObject("GitHub.Label", func(validator validation.Validator) {
+		// Make sure the name and color are populated as per
+		// https://docs.github.com/en/rest/issues/labels#get-a-label
+		if apiObj.Name == nil {
+			validator.Required("Name")
+		}
+		if apiObj.Color == nil {
+			validator.Required("Color")
+		}
+	})
+}
+
+func labelFromAPI(apiObj *github.Label) gitprovider.LabelInfo {
+	info := gitprovider.LabelInfo{
+		Name:        apiObj.GetName(),
+		Color:       apiObj.GetColor(),
+		Description: apiObj.GetDescription(),
+	}
+	info.Default()
+	return info
+}
+
+func labelToAPI(info *gitprovider.LabelInfo) *github.Label {
+	l := &github.Label{}
+	labelInfoToAPIObj(info, l)
+	return l
+}
+
+func labelInfoToAPIObj(info *gitprovider.LabelInfo, apiObj *github.Label) {
+	// All fields are set, as an empty description is a valid desired state
+	apiObj.Name = gitprovider.StringVar(info.Name)
+	apiObj.Color = gitprovider.StringVar(info.Color)
+	apiObj.Description = gitprovider.StringVar(info.Description)
+}
+
+// labelSpecForWrite returns a copy of the label's writable fields, i.e. the fields that are part of
+// create/update requests, see https://docs.github.com/en/rest/issues/labels#update-a-label.
+func labelSpecForWrite(apiObj *github.Label) *github.Label {
+	return &github.Label{
+		Name:        apiObj.Name,
+		Color:       apiObj.Color,
+		Description: apiObj.Description,
+	}
+}
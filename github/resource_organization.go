@@ -66,6 +66,8 @@ func organizationFromAPI(apiObj *github.Organization) gitprovider.OrganizationIn
 	return gitprovider.OrganizationInfo{
 		Name:        apiObj.Name,
 		Description: apiObj.Description,
+		// Organizations are visible to everyone
+		Visibility: gitprovider.OrganizationVisibilityVar(gitprovider.OrganizationVisibilityPublic),
 	}
 }
 
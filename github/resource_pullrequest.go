@@ -17,14 +17,32 @@ limitations under the License.
 package github
 
 import (
+	"context"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/google/go-github/v57/github"
 )
 
-func newPullRequest(ctx *clientContext, apiObj *github.PullRequest) *pullrequest {
+// maxPullRequestFiles is the maximum number of files GitHub returns for a pull request. Files
+// compares the number of returned files with the number of changed files of the pull request
+// instead, which also covers pull requests with exactly this many files.
+const maxPullRequestFiles = 3000
+
+func newPullRequest(c *PullRequestClient, apiObj *github.PullRequest) *pullrequest {
 	return &pullrequest{
-		clientContext: ctx,
+		clientContext: c.clientContext,
 		pr:            *apiObj,
+		ref:           c.ref,
+		comments: &PullRequestCommentClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.GetNumber(),
+		},
+		reviews: &PullRequestReviewClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.GetNumber(),
+		},
 	}
 }
 
@@ -33,7 +51,10 @@ var _ gitprovider.PullRequest = &pullrequest{}
 type pullrequest struct {
 	*clientContext
 
-	pr github.PullRequest
+	pr       github.PullRequest
+	ref      gitprovider.RepositoryRef
+	comments *PullRequestCommentClient
+	reviews  *PullRequestReviewClient
 }
 
 func (pr *pullrequest) Get() gitprovider.PullRequestInfo {
@@ -44,20 +65,104 @@ func (pr *pullrequest) APIObject() interface{} {
 	return &pr.pr
 }
 
-func pullrequestFromAPI(apiObj *github.PullRequest) gitprovider.PullRequestInfo {
-	var sourceBranch string
-	head := apiObj.Head
-	if head != nil {
-		if head.Ref != nil {
-			sourceBranch = *head.Ref
+func (pr *pullrequest) Comments() gitprovider.PullRequestCommentClient {
+	return pr.comments
+}
+
+func (pr *pullrequest) Reviews() gitprovider.PullRequestReviewClient {
+	return pr.reviews
+}
+
+func (pr *pullrequest) Files(ctx context.Context) (*gitprovider.PullRequestFiles, error) {
+	// GET /repos/{owner}/{repo}/pulls/{pull_number}/files
+	apiObjs, err := pr.c.ListPullRequestFiles(ctx, pr.ref.GetIdentity(), pr.ref.GetRepository(), pr.pr.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	// Pull requests returned by List don't include the number of changed files
+	if pr.pr.ChangedFiles == nil {
+		// GET /repos/{owner}/{repo}/pulls/{pull_number}
+		apiObj, err := pr.c.GetPullRequest(ctx, pr.ref.GetIdentity(), pr.ref.GetRepository(), pr.pr.GetNumber())
+		if err != nil {
+			return nil, err
 		}
+		pr.pr.ChangedFiles = apiObj.ChangedFiles
+	}
+
+	files := &gitprovider.PullRequestFiles{
+		Files:     make([]gitprovider.PullRequestFile, 0, len(apiObjs)),
+		Truncated: pr.pr.GetChangedFiles() > len(apiObjs),
+	}
+	for _, apiObj := range apiObjs {
+		files.Files = append(files.Files, pullRequestFileFromAPI(apiObj))
 	}
-	return gitprovider.PullRequestInfo{
+	return files, nil
+}
+
+func (pr *pullrequest) Diff(ctx context.Context) (string, error) {
+	// GET /repos/{owner}/{repo}/pulls/{pull_number}
+	return pr.c.GetPullRequestDiff(ctx, pr.ref.GetIdentity(), pr.ref.GetRepository(), pr.pr.GetNumber())
+}
+
+func pullrequestFromAPI(apiObj *github.PullRequest) gitprovider.PullRequestInfo {
+	info := gitprovider.PullRequestInfo{
 		Title:        apiObj.GetTitle(),
 		Description:  apiObj.GetBody(),
-		Merged:       apiObj.GetMerged(),
+		Merged:       apiObj.GetMerged() || apiObj.MergedAt != nil,
 		Number:       apiObj.GetNumber(),
 		WebURL:       apiObj.GetHTMLURL(),
-		SourceBranch: sourceBranch,
+		SourceBranch: apiObj.GetHead().GetRef(),
+		TargetBranch: apiObj.GetBase().GetRef(),
+		HeadSha:      apiObj.GetHead().GetSHA(),
+		BaseSha:      apiObj.GetBase().GetSHA(),
+		State:        gitprovider.PullRequestStateOpen,
+		Draft:        apiObj.GetDraft(),
+		AutoMerge:    apiObj.AutoMerge != nil,
+		Author:       apiObj.GetUser().GetLogin(),
+		CreatedAt:    apiObj.GetCreatedAt().Time,
+		UpdatedAt:    apiObj.GetUpdatedAt().Time,
+	}
+	// The list endpoint doesn't return the merged field, but the merge time
+	if info.Merged {
+		info.State = gitprovider.PullRequestStateMerged
+		mergedAt := apiObj.GetMergedAt().Time
+		info.MergedAt = &mergedAt
+	} else if apiObj.GetState() == "closed" {
+		info.State = gitprovider.PullRequestStateClosed
+	} else {
+		// GitHub computes mergeability in the background, it's nil until it's done
+		info.Mergeable = apiObj.Mergeable
+	}
+	for _, label := range apiObj.Labels {
+		info.Labels = append(info.Labels, label.GetName())
+	}
+	for _, user := range apiObj.Assignees {
+		info.Assignees = append(info.Assignees, user.GetLogin())
+	}
+	for _, user := range apiObj.RequestedReviewers {
+		info.Reviewers = append(info.Reviewers, user.GetLogin())
+	}
+	return info
+}
+
+func pullRequestFileFromAPI(apiObj *github.CommitFile) gitprovider.PullRequestFile {
+	file := gitprovider.PullRequestFile{
+		Path:      apiObj.GetFilename(),
+		Status:    gitprovider.PullRequestFileStatusModified,
+		Additions: apiObj.GetAdditions(),
+		Deletions: apiObj.GetDeletions(),
+		Patch:     apiObj.GetPatch(),
+	}
+	switch apiObj.GetStatus() {
+	// Copied files are new files, even though GitHub returns the path they were copied from
+	case "added", "copied":
+		file.Status = gitprovider.PullRequestFileStatusAdded
+	case "removed":
+		file.Status = gitprovider.PullRequestFileStatusRemoved
+	case "renamed":
+		file.Status = gitprovider.PullRequestFileStatusRenamed
+		file.PreviousPath = apiObj.GetPreviousFilename()
 	}
+	return file
 }
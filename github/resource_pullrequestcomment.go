@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newPullRequestComment(c *PullRequestCommentClient, apiObj *github.IssueComment) *pullRequestComment {
+	return &pullRequestComment{
+		pc: *apiObj,
+		c:  c,
+	}
+}
+
+var _ gitprovider.PullRequestComment = &pullRequestComment{}
+
+type pullRequestComment struct {
+	pc github.IssueComment
+	c  *PullRequestCommentClient
+}
+
+func (pc *pullRequestComment) Get() gitprovider.PullRequestCommentInfo {
+	return pullRequestCommentFromAPI(&pc.pc)
+}
+
+func (pc *pullRequestComment) Set(info gitprovider.PullRequestCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	pc.pc.Body = &info.Body
+	return nil
+}
+
+func (pc *pullRequestComment) APIObject() interface{} {
+	return &pc.pc
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (pc *pullRequestComment) Update(ctx context.Context) error {
+	// PATCH /repos/{owner}/{repo}/issues/comments/{comment_id}
+	apiObj, err := pc.c.c.EditIssueComment(ctx, pc.c.ref.GetIdentity(), pc.c.ref.GetRepository(), pc.pc.GetID(), &github.IssueComment{
+		Body: pc.pc.Body,
+	})
+	if err != nil {
+		return err
+	}
+	pc.pc = *apiObj
+	return nil
+}
+
+// Delete deletes the comment.
+//
+// ErrNotFound is returned if the resource doesn't exist anymore.
+func (pc *pullRequestComment) Delete(ctx context.Context) error {
+	// DELETE /repos/{owner}/{repo}/issues/comments/{comment_id}
+	return pc.c.c.DeleteIssueComment(ctx, pc.c.ref.GetIdentity(), pc.c.ref.GetRepository(), pc.pc.GetID())
+}
+
+func pullRequestCommentFromAPI(apiObj *github.IssueComment) gitprovider.PullRequestCommentInfo {
+	return gitprovider.PullRequestCommentInfo{
+		ID:        apiObj.GetID(),
+		Body:      apiObj.GetBody(),
+		Author:    apiObj.GetUser().GetLogin(),
+		CreatedAt: apiObj.GetCreatedAt().Time,
+		UpdatedAt: apiObj.GetUpdatedAt().Time,
+	}
+}
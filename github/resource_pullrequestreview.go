@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const reviewStatePending = "PENDING"
+
+// reviewEvents maps the review states that can be submitted to the GitHub review events.
+//
+//nolint:gochecknoglobals
+var reviewEvents = map[gitprovider.PullRequestReviewState]string{
+	gitprovider.PullRequestReviewStateApproved:         "APPROVE",
+	gitprovider.PullRequestReviewStateChangesRequested: "REQUEST_CHANGES",
+	gitprovider.PullRequestReviewStateCommented:        "COMMENT",
+}
+
+// reviewStates maps the states of submitted GitHub reviews to the review states.
+//
+//nolint:gochecknoglobals
+var reviewStates = map[string]gitprovider.PullRequestReviewState{
+	"APPROVED":          gitprovider.PullRequestReviewStateApproved,
+	"CHANGES_REQUESTED": gitprovider.PullRequestReviewStateChangesRequested,
+	"COMMENTED":         gitprovider.PullRequestReviewStateCommented,
+	"DISMISSED":         gitprovider.PullRequestReviewStateDismissed,
+}
+
+func newPullRequestReview(apiObj *github.PullRequestReview) *pullRequestReview {
+	return &pullRequestReview{
+		r: *apiObj,
+	}
+}
+
+var _ gitprovider.PullRequestReview = &pullRequestReview{}
+
+type pullRequestReview struct {
+	r github.PullRequestReview
+}
+
+func (r *pullRequestReview) Get() gitprovider.PullRequestReviewInfo {
+	return pullRequestReviewFromAPI(&r.r)
+}
+
+func (r *pullRequestReview) APIObject() interface{} {
+	return &r.r
+}
+
+func pullRequestReviewFromAPI(apiObj *github.PullRequestReview) gitprovider.PullRequestReviewInfo {
+	info := gitprovider.PullRequestReviewInfo{
+		ID:        apiObj.GetID(),
+		State:     reviewStates[apiObj.GetState()],
+		Body:      apiObj.GetBody(),
+		Author:    apiObj.GetUser().GetLogin(),
+		CommitSha: apiObj.GetCommitID(),
+	}
+	if apiObj.SubmittedAt != nil {
+		submittedAt := apiObj.GetSubmittedAt().Time
+		info.SubmittedAt = &submittedAt
+	}
+	return info
+}
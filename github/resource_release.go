@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newRelease(c *ReleaseClient, r *github.RepositoryRelease) *release {
+	return &release{
+		r: *r,
+		c: c,
+	}
+}
+
+var _ gitprovider.Release = &release{}
+
+type release struct {
+	r github.RepositoryRelease
+	c *ReleaseClient
+}
+
+func (r *release) Get() gitprovider.ReleaseInfo {
+	return releaseFromAPI(&r.r)
+}
+
+func (r *release) Set(info gitprovider.ReleaseInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.TagName != r.r.GetTagName() {
+		return fmt.Errorf("cannot change the tag of release %q: %w", r.r.GetTagName(), gitprovider.ErrInvalidArgument)
+	}
+	releaseInfoToAPIObj(&info, &r.r)
+	return nil
+}
+
+func (r *release) APIObject() interface{} {
+	return &r.r
+}
+
+func (r *release) Repository() gitprovider.RepositoryRef {
+	return r.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (r *release) Update(ctx context.Context) error {
+	// PATCH /repos/{owner}/{repo}/releases/{release_id}
+	apiObj, err := r.c.c.EditRelease(ctx, r.c.ref.GetIdentity(), r.c.ref.GetRepository(), r.r.GetID(), &github.RepositoryRelease{
+		Name:       r.r.Name,
+		Body:       r.r.Body,
+		Draft:      r.r.Draft,
+		Prerelease: r.r.Prerelease,
+	})
+	if err != nil {
+		return err
+	}
+	r.r = *apiObj
+	return nil
+}
+
+// Delete deletes the release from the repository irreversibly, the tag is kept.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource does not exist.
+func (r *release) Delete(ctx context.Context) error {
+	// DELETE /repos/{owner}/{repo}/releases/{release_id}
+	return r.c.c.DeleteRelease(ctx, r.c.ref.GetIdentity(), r.c.ref.GetRepository(), r.r.GetID())
+}
+
+// UploadAsset reads the content until EOF and attaches it to the release as a file with the
+// given name.
+func (r *release) UploadAsset(ctx context.Context, name string, content io.Reader) (gitprovider.ReleaseAssetInfo, error) {
+	// GitHub requires the size of the asset upfront
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, content); err != nil {
+		return gitprovider.ReleaseAssetInfo{}, err
+	}
+	// POST /repos/{owner}/{repo}/releases/{release_id}/assets
+	apiObj, err := r.c.c.UploadReleaseAsset(ctx, r.c.ref.GetIdentity(), r.c.ref.GetRepository(), r.r.GetID(), name, &buf, int64(buf.Len()))
+	if err != nil {
+		return gitprovider.ReleaseAssetInfo{}, err
+	}
+	r.r.Assets = append(r.r.Assets, apiObj)
+	return releaseAssetFromAPI(apiObj), nil
+}
+
+func validateReleaseAPI(apiObj *github.RepositoryRelease) error {
+	return validateAPIObject("GitHub.RepositoryRelease", func(validator validation.Validator) {
+		if apiObj.ID == nil {
+			validator.Required("ID")
+		}
+		if apiObj.TagName == nil {
+			validator.Required("TagName")
+		}
+	})
+}
+
+func releaseFromAPI(apiObj *github.RepositoryRelease) gitprovider.ReleaseInfo {
+	info := gitprovider.ReleaseInfo{
+		TagName:     apiObj.GetTagName(),
+		Name:        gitprovider.StringVar(apiObj.GetName()),
+		Description: gitprovider.StringVar(apiObj.GetBody()),
+		Draft:       gitprovider.BoolVar(apiObj.GetDraft()),
+		Prerelease:  gitprovider.BoolVar(apiObj.GetPrerelease()),
+	}
+	for _, asset := range apiObj.Assets {
+		info.Assets = append(info.Assets, releaseAssetFromAPI(asset))
+	}
+	return info
+}
+
+func releaseAssetFromAPI(apiObj *github.ReleaseAsset) gitprovider.ReleaseAssetInfo {
+	return gitprovider.ReleaseAssetInfo{
+		Name: apiObj.GetName(),
+		URL:  apiObj.GetBrowserDownloadURL(),
+		Size: int64(apiObj.GetSize()),
+	}
+}
+
+func releaseToAPI(info *gitprovider.ReleaseInfo) *github.RepositoryRelease {
+	apiObj := &github.RepositoryRelease{
+		TagName:         &info.TagName,
+		TargetCommitish: info.Target,
+	}
+	releaseInfoToAPIObj(info, apiObj)
+	return apiObj
+}
+
+func releaseInfoToAPIObj(info *gitprovider.ReleaseInfo, apiObj *github.RepositoryRelease) {
+	// Only set fields that are set in the info, i.e. PATCH behaviour
+	if info.Name != nil {
+		apiObj.Name = info.Name
+	}
+	if info.Description != nil {
+		apiObj.Body = info.Description
+	}
+	if info.Draft != nil {
+		apiObj.Draft = info.Draft
+	}
+	if info.Prerelease != nil {
+		apiObj.Prerelease = info.Prerelease
+	}
+}
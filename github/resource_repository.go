@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"sort"
 
 	"github.com/google/go-github/v57/github"
 
@@ -51,6 +52,7 @@ var githubRepositoryKnownFields = map[string]struct{}{
 	"AllowMergeCommit":    {},
 	"AllowRebaseMerge":    {},
 	"DeleteBranchOnMerge": {},
+	"Archived":            {},
 }
 
 func newUserRepository(ctx *clientContext, apiObj *github.Repository, ref gitprovider.RepositoryRef) *userRepository {
@@ -58,6 +60,10 @@ func newUserRepository(ctx *clientContext, apiObj *github.Repository, ref gitpro
 		clientContext: ctx,
 		r:             *apiObj,
 		ref:           ref,
+		collaborators: &CollaboratorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 		deployKeys: &DeployKeyClient{
 			clientContext: ctx,
 			ref:           ref,
@@ -74,6 +80,14 @@ func newUserRepository(ctx *clientContext, apiObj *github.Repository, ref gitpro
 			clientContext: ctx,
 			ref:           ref,
 		},
+		issues: &IssueClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		labels: &LabelClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 		files: &FileClient{
 			clientContext: ctx,
 			ref:           ref,
@@ -82,6 +96,26 @@ func newUserRepository(ctx *clientContext, apiObj *github.Repository, ref gitpro
 			clientContext: ctx,
 			ref:           ref,
 		},
+		webhooks: &WebhookClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		commitStatuses: &CommitStatusClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		branchProtections: &BranchProtectionClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		tags: &TagClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		releases: &ReleaseClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 	}
 }
 
@@ -92,14 +126,25 @@ type userRepository struct {
 
 	r         github.Repository // go-github
 	topUpdate *github.Repository
-	ref       gitprovider.RepositoryRef
-
-	deployKeys   *DeployKeyClient
-	commits      *CommitClient
-	branches     *BranchClient
-	pullRequests *PullRequestClient
-	files        *FileClient
-	trees        *TreeClient
+	// topics are the topics to replace the actual ones with at Update, if set, as GitHub
+	// doesn't update the topics of a repository together with its other fields.
+	topics []string
+	ref    gitprovider.RepositoryRef
+
+	collaborators     *CollaboratorClient
+	deployKeys        *DeployKeyClient
+	commits           *CommitClient
+	branches          *BranchClient
+	pullRequests      *PullRequestClient
+	issues            *IssueClient
+	labels            *LabelClient
+	files             *FileClient
+	trees             *TreeClient
+	webhooks          *WebhookClient
+	commitStatuses    *CommitStatusClient
+	branchProtections *BranchProtectionClient
+	tags              *TagClient
+	releases          *ReleaseClient
 }
 
 func (r *userRepository) Get() gitprovider.RepositoryInfo {
@@ -114,6 +159,10 @@ func (r *userRepository) Set(info gitprovider.RepositoryInfo) error {
 		return err
 	}
 	r.topUpdate = updateApiObjWithRepositoryInfo(&info, &r.r)
+	r.topics = nil
+	if info.Topics != nil {
+		r.topics = info.Topics
+	}
 	return nil
 }
 
@@ -125,6 +174,11 @@ func (r *userRepository) Repository() gitprovider.RepositoryRef {
 	return r.ref
 }
 
+// Collaborators gives access to manipulating the users with direct access to this specific repository.
+func (r *userRepository) Collaborators() gitprovider.CollaboratorClient {
+	return r.collaborators
+}
+
 func (r *userRepository) DeployKeys() gitprovider.DeployKeyClient {
 	return r.deployKeys
 }
@@ -145,6 +199,14 @@ func (r *userRepository) PullRequests() gitprovider.PullRequestClient {
 	return r.pullRequests
 }
 
+func (r *userRepository) Issues() gitprovider.IssueClient {
+	return r.issues
+}
+
+func (r *userRepository) Labels() gitprovider.LabelClient {
+	return r.labels
+}
+
 func (r *userRepository) Files() gitprovider.FileClient {
 	return r.files
 }
@@ -153,6 +215,26 @@ func (r *userRepository) Trees() gitprovider.TreeClient {
 	return r.trees
 }
 
+func (r *userRepository) Webhooks() gitprovider.WebhookClient {
+	return r.webhooks
+}
+
+func (r *userRepository) CommitStatuses() gitprovider.CommitStatusClient {
+	return r.commitStatuses
+}
+
+func (r *userRepository) BranchProtections() gitprovider.BranchProtectionClient {
+	return r.branchProtections
+}
+
+func (r *userRepository) Tags() gitprovider.TagClient {
+	return r.tags
+}
+
+func (r *userRepository) Releases() gitprovider.ReleaseClient {
+	return r.releases
+}
+
 // Update will apply the desired state in this object to the server.
 // Only set fields will be respected (i.e. PATCH behaviour).
 // In order to apply changes to this object, use the .Set({Resource}Info) error
@@ -163,15 +245,60 @@ func (r *userRepository) Trees() gitprovider.TreeClient {
 //
 // The internal API object will be overridden with the received server data.
 func (r *userRepository) Update(ctx context.Context) error {
+	return r.update(ctx, r.r.GetArchived(), r.r.Topics)
+}
+
+// update applies r.topUpdate and the topics given to Set to the repository, which is archived and
+// has the given topics before the update.
+func (r *userRepository) update(ctx context.Context, archived bool, topics []string) error {
+	if r.topics != nil && sameTopics(r.topics, topics) {
+		r.topics = nil
+	}
+	// Archived repositories are read-only, so replace the topics before archiving, and after
+	// unarchiving the repository
+	archiving := !archived && r.topUpdate.GetArchived()
+	if archiving {
+		if err := r.replaceTopics(ctx); err != nil {
+			return err
+		}
+	}
 	// PATCH /repos/{owner}/{repo}
 	apiObj, err := r.c.UpdateRepo(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), r.topUpdate)
 	if err != nil {
 		return err
 	}
 	r.r = *apiObj
+	return r.replaceTopics(ctx)
+}
+
+// replaceTopics replaces the topics of the repository with the ones given to Set, if they weren't
+// replaced yet.
+func (r *userRepository) replaceTopics(ctx context.Context) error {
+	if r.topics == nil {
+		return nil
+	}
+	// PUT /repos/{owner}/{repo}/topics
+	topics, err := r.c.ReplaceRepoTopics(ctx, r.ref.GetIdentity(), r.ref.GetRepository(), r.topics)
+	if err != nil {
+		return err
+	}
+	r.r.Topics = topics
+	r.topics = nil
 	return nil
 }
 
+// sameTopics returns whether a and b contain the same topics, ignoring their order.
+func sameTopics(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return reflect.DeepEqual(a, b)
+}
+
 // Reconcile makes sure the desired state in this object (called "req" here) becomes
 // the actual state in the backing Git provider.
 //
@@ -212,7 +339,7 @@ func (r *userRepository) Reconcile(ctx context.Context) (bool, error) {
 	// create the update repository
 	r.topUpdate = updateGithubRepository(desiredSpec.Repository, actualSpec.Repository)
 
-	return true, r.Update(ctx)
+	return true, r.update(ctx, apiObj.GetArchived(), apiObj.Topics)
 }
 
 // Delete deletes the current resource irreversibly.
@@ -262,8 +389,17 @@ func validateRepositoryAPI(apiObj *github.Repository) error {
 
 func repositoryFromAPI(apiObj *github.Repository) gitprovider.RepositoryInfo {
 	repo := gitprovider.RepositoryInfo{
-		Description:   apiObj.Description,
-		DefaultBranch: apiObj.DefaultBranch,
+		Description:         apiObj.Description,
+		DefaultBranch:       apiObj.DefaultBranch,
+		Topics:              apiObj.Topics,
+		Homepage:            apiObj.Homepage,
+		Archived:            apiObj.Archived,
+		HasIssues:           apiObj.HasIssues,
+		HasWiki:             apiObj.HasWiki,
+		HasProjects:         apiObj.HasProjects,
+		AllowedMergeMethods: mergeMethodsFromAPI(apiObj),
+		DeleteBranchOnMerge: apiObj.DeleteBranchOnMerge,
+		IsTemplate:          apiObj.IsTemplate,
 	}
 	if apiObj.Visibility != nil {
 		repo.Visibility = gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibility(*apiObj.Visibility))
@@ -289,21 +425,68 @@ func repositoryInfoToAPIObj(repo *gitprovider.RepositoryInfo, apiObj *github.Rep
 	if repo.Visibility != nil {
 		apiObj.Visibility = gitprovider.StringVar(string(*repo.Visibility))
 	}
+	// The topics are set separately, see replaceTopics
+	if repo.Homepage != nil {
+		apiObj.Homepage = repo.Homepage
+	}
+	if repo.Archived != nil {
+		apiObj.Archived = repo.Archived
+	}
+	if repo.HasIssues != nil {
+		apiObj.HasIssues = repo.HasIssues
+	}
+	if repo.HasWiki != nil {
+		apiObj.HasWiki = repo.HasWiki
+	}
+	if repo.HasProjects != nil {
+		apiObj.HasProjects = repo.HasProjects
+	}
+	if repo.AllowedMergeMethods != nil {
+		apiObj.AllowMergeCommit = gitprovider.BoolVar(hasMergeMethod(repo.AllowedMergeMethods, gitprovider.MergeMethodMerge))
+		apiObj.AllowSquashMerge = gitprovider.BoolVar(hasMergeMethod(repo.AllowedMergeMethods, gitprovider.MergeMethodSquash))
+		apiObj.AllowRebaseMerge = gitprovider.BoolVar(hasMergeMethod(repo.AllowedMergeMethods, gitprovider.MergeMethodRebase))
+	}
+	if repo.DeleteBranchOnMerge != nil {
+		apiObj.DeleteBranchOnMerge = repo.DeleteBranchOnMerge
+	}
+	if repo.IsTemplate != nil {
+		apiObj.IsTemplate = repo.IsTemplate
+	}
+}
+
+// mergeMethodsFromAPI returns the allowed merge methods of the repository, or nil if they aren't
+// known, as GitHub only returns them to administrators.
+func mergeMethodsFromAPI(apiObj *github.Repository) []gitprovider.MergeMethod {
+	if apiObj.AllowMergeCommit == nil && apiObj.AllowSquashMerge == nil && apiObj.AllowRebaseMerge == nil {
+		return nil
+	}
+	methods := []gitprovider.MergeMethod{}
+	if apiObj.GetAllowMergeCommit() {
+		methods = append(methods, gitprovider.MergeMethodMerge)
+	}
+	if apiObj.GetAllowSquashMerge() {
+		methods = append(methods, gitprovider.MergeMethodSquash)
+	}
+	if apiObj.GetAllowRebaseMerge() {
+		methods = append(methods, gitprovider.MergeMethodRebase)
+	}
+	return methods
+}
+
+func hasMergeMethod(methods []gitprovider.MergeMethod, method gitprovider.MergeMethod) bool {
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
 }
 
 func updateApiObjWithRepositoryInfo(repo *gitprovider.RepositoryInfo, apiObj *github.Repository) *github.Repository {
 	actual := newGithubRepositorySpec(apiObj).Repository
 	desired := newGithubRepositorySpec(apiObj).Repository
 
-	if repo.Description != nil {
-		desired.Description = repo.Description
-	}
-	if repo.DefaultBranch != nil {
-		desired.DefaultBranch = repo.DefaultBranch
-	}
-	if repo.Visibility != nil {
-		desired.Visibility = gitprovider.StringVar(string(*repo.Visibility))
-	}
+	repositoryInfoToAPIObj(repo, desired)
 
 	// create the update repository
 	return updateGithubRepository(desired, actual)
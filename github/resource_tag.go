@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"strings"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// tagObjectType is the type of the git objects annotated tags point to.
+const tagObjectType = "tag"
+
+func newTag(c *TagClient, ref *github.Reference, annotation *github.Tag) *tagType {
+	return &tagType{
+		t:          *ref,
+		annotation: annotation,
+		c:          c,
+	}
+}
+
+var _ gitprovider.Tag = &tagType{}
+
+type tagType struct {
+	t github.Reference
+	// annotation is the tag object of annotated tags, nil for lightweight tags.
+	annotation *github.Tag
+	c          *TagClient
+}
+
+func (t *tagType) Get() gitprovider.TagInfo {
+	return tagFromAPI(&t.t, t.annotation)
+}
+
+func (t *tagType) APIObject() interface{} {
+	return &t.t
+}
+
+func (t *tagType) Repository() gitprovider.RepositoryRef {
+	return t.c.ref
+}
+
+func validateTagRefAPI(apiObj *github.Reference) error {
+	return validateAPIObject("GitHub.Reference", func(validator validation.Validator) {
+		if apiObj.Ref == nil {
+			validator.Required("Ref")
+		}
+		if apiObj.Object == nil || apiObj.Object.SHA == nil {
+			validator.Required("Object.SHA")
+		}
+	})
+}
+
+func validateTagObjectAPI(apiObj *github.Tag) error {
+	return validateAPIObject("GitHub.Tag", func(validator validation.Validator) {
+		if apiObj.SHA == nil {
+			validator.Required("SHA")
+		}
+		if apiObj.Object == nil || apiObj.Object.SHA == nil {
+			validator.Required("Object.SHA")
+		}
+	})
+}
+
+func tagFromAPI(ref *github.Reference, annotation *github.Tag) gitprovider.TagInfo {
+	info := gitprovider.TagInfo{
+		Name: strings.TrimPrefix(ref.GetRef(), "refs/tags/"),
+		Sha:  ref.GetObject().GetSHA(),
+	}
+	// Annotated tags point to a tag object, which points to the commit
+	if annotation != nil {
+		info.Sha = annotation.GetObject().GetSHA()
+		info.Message = annotation.Message
+	}
+	return info
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newTeam(c *TeamsClient, apiObj *github.Team, users []*github.User, info gitprovider.TeamInfo) *team {
+	return &team{
+		t:     apiObj,
+		users: users,
+		info:  info,
+		c:     c,
+	}
+}
+
+var _ gitprovider.Team = &team{}
+
+type team struct {
+	// t is the team as returned by the server, its name is kept when updating the team.
+	t     *github.Team
+	users []*github.User
+	info  gitprovider.TeamInfo
+	c     *TeamsClient
+}
+
+func (t *team) Get() gitprovider.TeamInfo {
+	return t.info
+}
+
+func (t *team) Set(info gitprovider.TeamInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.Name != t.info.Name {
+		return fmt.Errorf("cannot rename team %q: %w", t.info.Name, gitprovider.ErrInvalidArgument)
+	}
+	t.info = info
+	return nil
+}
+
+func (t *team) Members() gitprovider.TeamMemberClient {
+	return &TeamMemberClient{
+		clientContext: t.c.clientContext,
+		ref:           t.c.ref,
+		team:          t.info.Name,
+	}
+}
+
+func (t *team) APIObject() interface{} {
+	return t.users
+}
+
+func (t *team) Organization() gitprovider.OrganizationRef {
+	return t.c.ref
+}
+
+// Update will apply the desired description and privacy in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (t *team) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&t.info); err != nil {
+		return err
+	}
+	// PATCH /orgs/{org}/teams/{team_slug}
+	apiObj, err := t.c.c.EditTeam(ctx, t.c.ref.Organization, t.info.Name, teamToAPI(t.t.GetName(), t.info))
+	if err != nil {
+		return err
+	}
+	t.t = apiObj
+	members := t.info.Members
+	t.info = teamFromAPI(apiObj)
+	t.info.Name = apiObj.GetSlug()
+	t.info.Members = members
+	return nil
+}
+
+// Delete deletes the team from the organization.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource does not exist.
+func (t *team) Delete(ctx context.Context) error {
+	// DELETE /orgs/{org}/teams/{team_slug}
+	return t.c.c.DeleteTeam(ctx, t.c.ref.Organization, t.info.Name)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (t *team) Reconcile(ctx context.Context) (bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&t.info); err != nil {
+		return false, err
+	}
+
+	actual, err := t.c.Get(ctx, t.info.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := t.c.Create(ctx, t.info)
+			if err != nil {
+				return true, err
+			}
+			*t = *resp.(*team)
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if t.info.Equals(actual.Get()) {
+		return false, nil
+	}
+	// Keep the name of the actual team when updating it
+	t.t = actual.(*team).t
+	return true, t.Update(ctx)
+}
+
+func validateTeamAPI(apiObj *github.Team) error {
+	return validateAPIObject("GitHub.Team", func(validator validation.Validator) {
+		// Make sure the name and slug are populated as per
+		// https://docs.github.com/en/rest/teams/teams#get-a-team-by-name
+		if apiObj.Name == nil {
+			validator.Required("Name")
+		}
+		if apiObj.Slug == nil {
+			validator.Required("Slug")
+		}
+	})
+}
+
+func teamFromAPI(apiObj *github.Team) gitprovider.TeamInfo {
+	info := gitprovider.TeamInfo{
+		Name:        apiObj.GetSlug(),
+		Description: apiObj.Description,
+	}
+	if apiObj.Privacy != nil {
+		info.Privacy = gitprovider.TeamPrivacyVar(gitprovider.TeamPrivacy(*apiObj.Privacy))
+	}
+	// GitHub returns an empty description if the team has none
+	if info.Description != nil && *info.Description == "" {
+		info.Description = nil
+	}
+	return info
+}
+
+// teamToAPI returns the request to create or edit the team called name with the given info.
+func teamToAPI(name string, info gitprovider.TeamInfo) *github.NewTeam {
+	// An empty description removes it
+	description := ""
+	if info.Description != nil {
+		description = *info.Description
+	}
+	req := &github.NewTeam{
+		Name:        name,
+		Description: &description,
+	}
+	if info.Privacy != nil {
+		req.Privacy = github.String(string(*info.Privacy))
+	}
+	return req
+}
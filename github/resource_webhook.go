@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/google/go-github/v57/github"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+const (
+	// hookName is the only name GitHub accepts for repository webhooks.
+	hookName = "web"
+	// maskedSecret is what GitHub returns in place of a webhook's secret.
+	maskedSecret = "********"
+)
+
+// webhookEvents maps the generic webhook events to GitHub's event names, see
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads
+//
+//nolint:gochecknoglobals
+var webhookEvents = map[gitprovider.WebhookEvent][]string{
+	gitprovider.WebhookEventPush:        {"push"},
+	gitprovider.WebhookEventTagPush:     {"create", "delete"},
+	gitprovider.WebhookEventPullRequest: {"pull_request"},
+	gitprovider.WebhookEventIssues:      {"issues"},
+	gitprovider.WebhookEventComment:     {"issue_comment", "pull_request_review_comment"},
+	gitprovider.WebhookEventRelease:     {"release"},
+}
+
+func newWebhook(c *WebhookClient, hook *github.Hook) *webhook {
+	return &webhook{
+		h: *hook,
+		c: c,
+	}
+}
+
+var _ gitprovider.Webhook = &webhook{}
+
+type webhook struct {
+	h github.Hook
+	c *WebhookClient
+}
+
+func (wh *webhook) Get() gitprovider.WebhookInfo {
+	return webhookFromAPI(&wh.h)
+}
+
+func (wh *webhook) Set(info gitprovider.WebhookInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	return webhookInfoToAPIObj(&info, &wh.h)
+}
+
+func (wh *webhook) APIObject() interface{} {
+	return &wh.h
+}
+
+func (wh *webhook) Repository() gitprovider.RepositoryRef {
+	return wh.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (wh *webhook) Update(ctx context.Context) error {
+	// We can use the same Webhook ID that we got from the GET calls. Make sure it's non-nil.
+	// This _should never_ happen, but just check for it anyways to avoid panicing.
+	if wh.h.ID == nil {
+		return fmt.Errorf("didn't expect ID to be nil: %w", gitprovider.ErrUnexpectedEvent)
+	}
+
+	// PATCH /repos/{owner}/{repo}/hooks/{hook_id}
+	apiObj, err := wh.c.c.EditHook(ctx, wh.c.ref.GetIdentity(), wh.c.ref.GetRepository(), *wh.h.ID, hookSpecForWrite(&wh.h))
+	if err != nil {
+		return err
+	}
+	wh.h = *apiObj
+	return nil
+}
+
+// Delete deletes a webhook from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (wh *webhook) Delete(ctx context.Context) error {
+	// We can use the same Webhook ID that we got from the GET calls. Make sure it's non-nil.
+	// This _should never_ happen, but just check for it anyways to avoid panicing.
+	if wh.h.ID == nil {
+		return fmt.Errorf("didn't expect ID to be nil: %w", gitprovider.ErrUnexpectedEvent)
+	}
+
+	// DELETE /repos/{owner}/{repo}/hooks/{hook_id}
+	return wh.c.c.DeleteHook(ctx, wh.c.ref.GetIdentity(), wh.c.ref.GetRepository(), *wh.h.ID)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (wh *webhook) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := wh.c.get(ctx, hookURL(&wh.h))
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, wh.createIntoSelf(ctx)
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// Use wrappers here to extract the "spec" part of the object for comparison
+	desiredSpec := newGithubHookSpec(&wh.h)
+	actualSpec := newGithubHookSpec(&actual.h)
+
+	// If the desired matches the actual state, do nothing
+	if desiredSpec.Equals(actualSpec) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	wh.h.ID = actual.h.ID
+	return true, wh.Update(ctx)
+}
+
+func (wh *webhook) createIntoSelf(ctx context.Context) error {
+	// POST /repos/{owner}/{repo}/hooks
+	apiObj, err := wh.c.c.CreateHook(ctx, wh.c.ref.GetIdentity(), wh.c.ref.GetRepository(), hookSpecForWrite(&wh.h))
+	if err != nil {
+		return err
+	}
+	wh.h = *apiObj
+	return nil
+}
+
+func validateWebhookAPI(apiObj *github.Hook) error {
+	return validateAPIObject("GitHub.Hook", func(validator validation.Validator) {
+		// Make sure ID and the config URL are populated as per
+		// https://docs.github.com/en/rest/repos/webhooks#get-a-repository-webhook
+		if apiObj.ID == nil {
+			validator.Required("ID")
+		}
+		if hookURL(apiObj) == "" {
+			validator.Required("Config.URL")
+		}
+	})
+}
+
+// hookURL returns the URL events are delivered to, or an empty string if unset.
+func hookURL(apiObj *github.Hook) string {
+	url, _ := apiObj.Config["url"].(string)
+	return url
+}
+
+func webhookFromAPI(apiObj *github.Hook) gitprovider.WebhookInfo {
+	info := gitprovider.WebhookInfo{
+		URL:    hookURL(apiObj),
+		Events: webhookEventsFromAPI(apiObj.Events),
+		Active: apiObj.Active,
+	}
+	if contentType, ok := apiObj.Config["content_type"].(string); ok {
+		info.ContentType = gitprovider.WebhookContentTypeVar(gitprovider.WebhookContentType(contentType))
+	}
+	if insecureSSL, ok := apiObj.Config["insecure_ssl"].(string); ok {
+		info.InsecureSSL = gitprovider.BoolVar(insecureSSL == "1")
+	}
+	return info
+}
+
+func webhookToAPI(info *gitprovider.WebhookInfo) (*github.Hook, error) {
+	h := &github.Hook{
+		Name: gitprovider.StringVar(hookName),
+	}
+	if err := webhookInfoToAPIObj(info, h); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func webhookInfoToAPIObj(info *gitprovider.WebhookInfo, apiObj *github.Hook) error {
+	if apiObj.Config == nil {
+		apiObj.Config = map[string]interface{}{}
+	}
+	// Required fields, we assume info is validated, and hence these are set
+	apiObj.Config["url"] = info.URL
+	// optional fields
+	if info.Secret != nil {
+		apiObj.Config["secret"] = *info.Secret
+	}
+	if info.ContentType != nil {
+		apiObj.Config["content_type"] = string(*info.ContentType)
+	}
+	if info.InsecureSSL != nil {
+		insecureSSL := "0"
+		if *info.InsecureSSL {
+			insecureSSL = "1"
+		}
+		apiObj.Config["insecure_ssl"] = insecureSSL
+	}
+	if info.Events != nil {
+		events, err := webhookEventsToAPI(info.Events)
+		if err != nil {
+			return err
+		}
+		apiObj.Events = events
+	}
+	if info.Active != nil {
+		apiObj.Active = info.Active
+	}
+	return nil
+}
+
+func webhookEventsToAPI(events []gitprovider.WebhookEvent) ([]string, error) {
+	apiEvents := []string{}
+	for _, event := range events {
+		names, ok := webhookEvents[event]
+		if !ok {
+			return nil, fmt.Errorf("webhook event %q: %w", event, gitprovider.ErrNoProviderSupport)
+		}
+		apiEvents = append(apiEvents, names...)
+	}
+	return apiEvents, nil
+}
+
+// webhookEventsFromAPI returns the generic events for which all of GitHub's
+// corresponding events are subscribed to. Unknown events are ignored.
+func webhookEventsFromAPI(apiEvents []string) []gitprovider.WebhookEvent {
+	subscribed := make(map[string]struct{}, len(apiEvents))
+	for _, name := range apiEvents {
+		subscribed[name] = struct{}{}
+	}
+	events := []gitprovider.WebhookEvent{}
+	for event, names := range webhookEvents {
+		found := true
+		for _, name := range names {
+			if _, ok := subscribed[name]; !ok {
+				found = false
+			}
+		}
+		if found {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i] < events[j] })
+	return events
+}
+
+// hookSpecForWrite returns a copy of the hook's writable fields. The secret is left out
+// if it's GitHub's masked placeholder, in order to not overwrite the actual secret with it.
+func hookSpecForWrite(apiObj *github.Hook) *github.Hook {
+	spec := newGithubHookSpec(apiObj).Hook
+	spec.Name = apiObj.Name
+	if secret, ok := apiObj.Config["secret"].(string); ok && secret != maskedSecret {
+		spec.Config["secret"] = secret
+	}
+	return spec
+}
+
+// This function copies over the fields that are part of create/update requests of a webhook
+// i.e. the desired spec of the webhook. This allows us to separate "spec" from "status" fields.
+// The secret is not part of the spec, as GitHub doesn't return it.
+func newGithubHookSpec(hook *github.Hook) *githubHookSpec {
+	config := map[string]interface{}{}
+	for _, key := range []string{"url", "content_type", "insecure_ssl"} {
+		if value, ok := hook.Config[key]; ok {
+			config[key] = value
+		}
+	}
+	events := append([]string{}, hook.Events...)
+	sort.Strings(events)
+	return &githubHookSpec{
+		&github.Hook{
+			// Create-specific parameters
+			// See: https://docs.github.com/en/rest/repos/webhooks#create-a-repository-webhook
+			Config: config,
+			Events: events,
+			Active: hook.Active,
+		},
+	}
+}
+
+type githubHookSpec struct {
+	*github.Hook
+}
+
+func (s *githubHookSpec) Equals(other *githubHookSpec) bool {
+	return reflect.DeepEqual(s, other)
+}
@@ -29,7 +29,9 @@ import (
 
 const (
 	alreadyExistsMagicString = "name already exists on this account"
-	rateLimitDocURL          = "https://developer.github.com/v3/#rate-limiting"
+	// alreadyExistsCode is the code of validation errors about e.g. labels that already exist.
+	alreadyExistsCode = "already_exists"
+	rateLimitDocURL   = "https://developer.github.com/v3/#rate-limiting"
 )
 
 // TODO: Guard better against nil pointer dereference panics in this package, also
@@ -134,7 +136,7 @@ func handleHTTPError(err error) error {
 		}
 		// Check for already exists errors
 		for _, validationErr := range ghErrorResponse.Errors {
-			if validationErr.Message == alreadyExistsMagicString {
+			if validationErr.Message == alreadyExistsMagicString || validationErr.Code == alreadyExistsCode {
 				return validation.NewMultiError(err, gitprovider.ErrAlreadyExists)
 			}
 		}
@@ -145,6 +147,23 @@ func handleHTTPError(err error) error {
 	return err
 }
 
+// handleMergeError wraps err like handleHTTPError, except for the 409 Conflict response returned when
+// merging a pull request whose head isn't the expected commit, which is returned as a *gitprovider.ConflictError.
+func handleMergeError(err error) error {
+	ghErrorResponse := &github.ErrorResponse{}
+	if errors.As(err, &ghErrorResponse) && ghErrorResponse.Response.StatusCode == http.StatusConflict {
+		return validation.NewMultiError(err, &gitprovider.ConflictError{
+			HTTPError: gitprovider.HTTPError{
+				Response:         ghErrorResponse.Response,
+				ErrorMessage:     ghErrorResponse.Error(),
+				Message:          ghErrorResponse.Message,
+				DocumentationURL: ghErrorResponse.DocumentationURL,
+			},
+		})
+	}
+	return handleHTTPError(err)
+}
+
 // allPages runs fn for each page, expecting a HTTP request to be made and returned during that call.
 // allPages expects that the data is saved in fn to an outer variable.
 // allPages calls fn as many times as needed to get all pages, and modifies opts for each call.
@@ -81,5 +81,9 @@ func NewClient(token string, tokenType string, optFns ...gitprovider.ClientOptio
 		destructiveActions = *opts.EnableDestructiveAPICalls
 	}
 
-	return newClient(gl, domain, sshDomain, destructiveActions), nil
+	c := newClient(gl, domain, sshDomain, destructiveActions)
+	if opts.DryRun != nil && *opts.DryRun {
+		c.dryRun = gitprovider.NewDryRun(destructiveActions, dryRunValidator{})
+	}
+	return c, nil
 }
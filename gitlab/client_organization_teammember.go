@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TeamMemberClient implements the gitprovider.TeamMemberClient interface.
+var _ gitprovider.TeamMemberClient = &TeamMemberClient{}
+
+// TeamMemberClient operates on the members of the subgroup of a specific team.
+type TeamMemberClient struct {
+	*clientContext
+	teams *TeamsClient
+	team  string
+}
+
+// List all direct members of the subgroup, with their roles.
+// Members with at least the Maintainer access level have the maintainer role.
+//
+// List returns all available members, using multiple paginated requests if needed.
+func (c *TeamMemberClient) List(ctx context.Context) ([]gitprovider.TeamMemberInfo, error) {
+	// GET /groups/{group}/members
+	apiObjs, err := c.c.ListGroupMembers(ctx, c.teams.teamPath(c.team))
+	if err != nil {
+		return nil, err
+	}
+	members := make([]gitprovider.TeamMemberInfo, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		members = append(members, teamMemberFromAPI(apiObj))
+	}
+	return members, nil
+}
+
+// Add adds the user to the subgroup with the given role. If the user already is a member
+// with a different role, their access level is changed. The member role maps to the Developer
+// access level, and the maintainer role to the Maintainer access level.
+//
+// ErrNotFound is returned if no user with the given login exists.
+func (c *TeamMemberClient) Add(ctx context.Context, req gitprovider.TeamMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+	accessLevel := teamMemberRoleToAccessLevel(*req.Role)
+
+	actual, err := c.getMember(ctx, req.Login)
+	if err != nil {
+		return err
+	}
+	if actual != nil {
+		// Keep e.g. owners as they are, if they already have the requested role
+		if teamMemberFromAPI(actual).Equals(req) {
+			return nil
+		}
+		// PUT /groups/{group}/members/{user_id}
+		return c.c.EditGroupMember(ctx, c.teams.teamPath(c.team), actual.ID, accessLevel)
+	}
+
+	// GET /users?username={username}
+	user, err := c.c.GetUserByUsername(ctx, req.Login)
+	if err != nil {
+		return err
+	}
+	// POST /groups/{group}/members
+	return c.c.AddGroupMember(ctx, c.teams.teamPath(c.team), user.ID, accessLevel)
+}
+
+// Remove removes the user with the given login from the subgroup.
+//
+// ErrNotFound is returned if the user isn't a member of the subgroup.
+func (c *TeamMemberClient) Remove(ctx context.Context, login string) error {
+	actual, err := c.getMember(ctx, login)
+	if err != nil {
+		return err
+	}
+	if actual == nil {
+		return fmt.Errorf("user %q isn't a member of team %q: %w", login, c.team, gitprovider.ErrNotFound)
+	}
+	// DELETE /groups/{group}/members/{user_id}
+	return c.c.RemoveGroupMember(ctx, c.teams.teamPath(c.team), actual.ID)
+}
+
+// Reconcile makes sure the users in req are members of the subgroup with the desired roles,
+// adding them or changing their access level as needed. With the Exclusive option, the members
+// of the subgroup that aren't in req are removed.
+func (c *TeamMemberClient) Reconcile(ctx context.Context, req []gitprovider.TeamMemberInfo, opts ...gitprovider.TeamMemberReconcileOption) (bool, error) {
+	return gitprovider.ReconcileTeamMembers(ctx, c, req, opts...)
+}
+
+// getMember returns the direct member of the subgroup with the given login, or nil if there is none.
+func (c *TeamMemberClient) getMember(ctx context.Context, login string) (*gitlab.GroupMember, error) {
+	// GET /groups/{group}/members
+	apiObjs, err := c.c.ListGroupMembers(ctx, c.teams.teamPath(c.team))
+	if err != nil {
+		return nil, err
+	}
+	for _, apiObj := range apiObjs {
+		if apiObj.Username == login {
+			return apiObj, nil
+		}
+	}
+	return nil, nil
+}
+
+func teamMemberFromAPI(apiObj *gitlab.GroupMember) gitprovider.TeamMemberInfo {
+	role := gitprovider.TeamMemberRoleMember
+	if apiObj.AccessLevel >= gitlab.MaintainerPermissions {
+		role = gitprovider.TeamMemberRoleMaintainer
+	}
+	return gitprovider.TeamMemberInfo{
+		Login: apiObj.Username,
+		Role:  gitprovider.TeamMemberRoleVar(role),
+	}
+}
+
+func teamMemberRoleToAccessLevel(role gitprovider.TeamMemberRole) gitlab.AccessLevelValue {
+	if role == gitprovider.TeamMemberRoleMaintainer {
+		return gitlab.MaintainerPermissions
+	}
+	return gitlab.DeveloperPermissions
+}
@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"context"
+	"errors"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/xanzy/go-gitlab"
@@ -39,40 +40,41 @@ type TeamsClient struct {
 //
 // ErrNotFound is returned if the resource does not exist.
 func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Team, error) {
-	apiObjs, err := c.c.ListGroupMembers(ctx, c.ref.Organization)
+	// GET /groups/{group}
+	apiObj, err := c.c.GetGroup(ctx, c.teamPath(teamName))
+	if err != nil {
+		return nil, err
+	}
+	// GET /groups/{group}/members
+	apiObjs, err := c.c.ListGroupMembers(ctx, c.teamPath(teamName))
 	if err != nil {
 		return nil, err
 	}
 
-	// Collect a list of the members' names. Login is validated to be non-nil in ListOrgTeamMembers.
+	// Collect a list of the members' names.
 	logins := make([]string, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
-		// Login is validated to be non-nil in ListOrgTeamMembers
 		logins = append(logins, apiObj.Username)
 	}
 
-	return &team{
-		users: apiObjs,
-		info: gitprovider.TeamInfo{
-			Name:    teamName,
-			Members: logins,
-		},
-		ref: c.ref,
-	}, nil
+	info := teamFromAPI(apiObj)
+	info.Name = teamName
+	info.Members = logins
+	return newTeam(c, apiObjs, info), nil
 }
 
 // List all teams (recursively, in terms of subgroups) within the specific organization.
 //
 // List returns all available organizations, using multiple paginated requests if needed.
 func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
-	subgroups, err := c.c.ListSubgroups(ctx, c.ref.Organization)
+	subgroups, err := c.c.ListSubgroups(ctx, c.ref.GetIdentity())
 	if err != nil {
 		return nil, err
 	}
 
 	teams := make([]gitprovider.Team, 0, len(subgroups))
 	for _, subgroup := range subgroups {
-		team, err := c.Get(ctx, subgroup.Name)
+		team, err := c.Get(ctx, subgroup.Path)
 		if err != nil {
 			return nil, err
 		}
@@ -83,22 +85,81 @@ func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
 	return teams, nil
 }
 
-var _ gitprovider.Team = &team{}
+// Create creates a subgroup of the organization with the given specifications.
+// Members of req are ignored.
+//
+// The name of the team in req is used as both name and path of the subgroup.
+// GitLab subgroups are visible to all members of the organization, hence the
+// secret privacy isn't supported.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamsClient) Create(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if err := validateTeamPrivacy(req); err != nil {
+		return nil, err
+	}
 
-type team struct {
-	users []*gitlab.GroupMember
-	info  gitprovider.TeamInfo
-	ref   gitprovider.OrganizationRef
+	// GET /groups/{group}
+	parent, err := c.c.GetGroup(ctx, c.ref.GetIdentity())
+	if err != nil {
+		return nil, err
+	}
+	// POST /groups
+	apiObj, err := c.c.CreateGroup(ctx, &gitlab.CreateGroupOptions{
+		Name:        &req.Name,
+		Path:        &req.Name,
+		Description: req.Description,
+		ParentID:    &parent.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	info := teamFromAPI(apiObj)
+	info.Name = apiObj.Path
+	return newTeam(c, nil, info), nil
 }
 
-func (t *team) Get() gitprovider.TeamInfo {
-	return t.info
-}
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamsClient) Reconcile(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
 
-func (t *team) APIObject() interface{} {
-	return t.users
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
 }
 
-func (t *team) Organization() gitprovider.OrganizationRef {
-	return t.ref
+// teamPath returns the full path of the subgroup of the team called teamName.
+func (c *TeamsClient) teamPath(teamName string) string {
+	return c.ref.GetIdentity() + "/" + teamName
 }
@@ -18,6 +18,11 @@ package gitlab
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
@@ -36,7 +41,7 @@ type OrganizationsClient struct {
 // ErrNotFound is returned if the resource does not exist.
 func (c *OrganizationsClient) Get(ctx context.Context, ref gitprovider.OrganizationRef) (gitprovider.Organization, error) {
 	// GET /groups/{group}
-	apiObj, err := c.c.GetGroup(ctx, ref.Organization)
+	apiObj, err := c.c.GetGroup(ctx, ref.GetIdentity())
 	if err != nil {
 		return nil, err
 	}
@@ -87,3 +92,95 @@ func (c *OrganizationsClient) Children(ctx context.Context, ref gitprovider.Orga
 
 	return subgroups, nil
 }
+
+// Create creates the group o with the given data. If o is a sub-organization, a subgroup of its
+// parent group is created, which must exist. The last element of the path of o is used as path
+// of the group.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrganizationsClient) Create(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+
+	fullPath := ref.GetIdentity()
+	opts := &gitlab.CreateGroupOptions{
+		Name:        req.Name,
+		Path:        gitlab.String(fullPath),
+		Description: req.Description,
+	}
+	if req.Visibility != nil {
+		opts.Visibility = gitlab.Visibility(gitlab.VisibilityValue(*req.Visibility))
+	}
+	if i := strings.LastIndex(fullPath, "/"); i >= 0 {
+		// GET /groups/{group}
+		parent, err := c.c.GetGroup(ctx, fullPath[:i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent group %q: %w", fullPath[:i], err)
+		}
+		opts.Path = gitlab.String(fullPath[i+1:])
+		opts.ParentID = &parent.ID
+	}
+	// POST /groups
+	apiObj, err := c.c.CreateGroup(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newOrganization(c.clientContext, apiObj, ref), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrganizationsClient) Reconcile(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, bool, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, ref)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, ref, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// An empty description removes it
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+	opts := &gitlab.UpdateGroupOptions{
+		Name:        req.Name,
+		Description: &description,
+	}
+	if req.Visibility != nil {
+		opts.Visibility = gitlab.Visibility(gitlab.VisibilityValue(*req.Visibility))
+	}
+	// PUT /groups/{group}
+	apiObj, err := c.c.UpdateGroup(ctx, ref.GetIdentity(), opts)
+	if err != nil {
+		return actual, true, err
+	}
+	return newOrganization(c.clientContext, apiObj, ref), true, nil
+}
+
+// Delete deletes the group, including all its subgroups and projects.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource doesn't exist.
+func (c *OrganizationsClient) Delete(ctx context.Context, ref gitprovider.OrganizationRef) error {
+	// DELETE /groups/{group}
+	return c.c.DeleteGroup(ctx, ref.GetIdentity())
+}
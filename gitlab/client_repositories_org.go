@@ -97,10 +97,16 @@ func (c *OrgRepositoriesClient) Create(ctx context.Context, ref gitprovider.OrgR
 // If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 // If req is already the actual state, this is a no-op (actionTaken == false).
 func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, ref, req, opts...)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.OrgRepositoriesClient.ReconcileWithChanges.
+func (c *OrgRepositoriesClient) ReconcileWithChanges(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, []gitprovider.FieldChange, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	actual, err := c.Get(ctx, ref)
@@ -108,14 +114,14 @@ func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.O
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
-			return resp, true, err
+			return resp, req.Diff(gitprovider.RepositoryInfo{}), err
 		}
 
 		// Unexpected path, Get should succeed or return NotFound
-		return nil, false, err
+		return nil, nil, err
 	}
-	actionTaken, err := reconcileRepository(ctx, actual, req)
-	return actual, actionTaken, err
+	changes, err := reconcileRepository(ctx, actual, req)
+	return actual, changes, err
 }
 
 // nolint
@@ -125,6 +131,9 @@ func createProject(ctx context.Context, c gitlabClient, ref gitprovider.Reposito
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
 		return nil, err
 	}
+	if err := validateRepositorySupport(req); err != nil {
+		return nil, err
+	}
 
 	// Convert to the API object and apply the options
 	data := repositoryToAPI(&req, ref)
@@ -140,23 +149,42 @@ func createProject(ctx context.Context, c gitlabClient, ref gitprovider.Reposito
 		return nil, err
 	}
 	apiOpts := gitlab.CreateProjectOptions{
-		InitializeWithReadme: o.AutoInit,
+		InitializeWithReadme:         o.AutoInit,
+		RemoveSourceBranchAfterMerge: req.DeleteBranchOnMerge,
+	}
+	if req.Topics != nil {
+		apiOpts.Topics = &req.Topics
+	}
+	if req.HasIssues != nil {
+		apiOpts.IssuesAccessLevel = gitlab.AccessControl(featureAccessLevel(*req.HasIssues, ""))
+	}
+	if req.HasWiki != nil {
+		apiOpts.WikiAccessLevel = gitlab.AccessControl(featureAccessLevel(*req.HasWiki, ""))
 	}
 
-	return c.CreateProject(ctx, &data, &apiOpts)
+	apiObj, err := c.CreateProject(ctx, &data, &apiOpts)
+	if err != nil {
+		return nil, err
+	}
+	// Projects can only be archived once they exist
+	if req.Archived != nil && *req.Archived {
+		return c.SetProjectArchived(ctx, apiObj.ID, true)
+	}
+	return apiObj, nil
 }
 
-func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
+func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) ([]gitprovider.FieldChange, error) {
 	// If the desired matches the actual state, just return the actual state
-	if req.Equals(actual.Get()) {
-		return false, nil
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return nil, nil
 	}
 	// Populate the desired state to the current-actual object
 	if err := actual.Set(req); err != nil {
-		return false, err
+		return nil, err
 	}
 	// Apply the desired state by running Update
-	return true, actual.Update(ctx)
+	return changes, actual.Update(ctx)
 }
 
 func toCreateOpts(opts ...gitprovider.RepositoryReconcileOption) []gitprovider.RepositoryCreateOption {
@@ -18,6 +18,8 @@ package gitlab
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/xanzy/go-gitlab"
@@ -32,6 +34,38 @@ type BranchClient struct {
 	ref gitprovider.RepositoryRef
 }
 
+// Get returns the branch with the given name.
+//
+// ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Get(_ context.Context, branch string) (gitprovider.Branch, error) {
+	// GET /projects/{project}/repository/branches/{branch}
+	apiObj, err := c.c.GetBranch(getRepoPath(c.ref), branch)
+	if err != nil {
+		return nil, err
+	}
+	return newBranch(c, apiObj), nil
+}
+
+// List lists all branches of the repository.
+//
+// List returns all available branches for the given repository,
+// using multiple paginated requests if needed.
+func (c *BranchClient) List(_ context.Context) ([]gitprovider.Branch, error) {
+	// GET /projects/{project}/repository/branches
+	apiObjs, err := c.c.ListBranches(getRepoPath(c.ref))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Branch type
+	branches := make([]gitprovider.Branch, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at ListBranches
+		branches = append(branches, newBranch(c, apiObj))
+	}
+	return branches, nil
+}
+
 // Create creates a branch with the given specifications.
 func (c *BranchClient) Create(_ context.Context, branch, sha string) error {
 
@@ -46,3 +80,48 @@ func (c *BranchClient) Create(_ context.Context, branch, sha string) error {
 
 	return nil
 }
+
+// Delete deletes the branch with the given name irreversibly.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Delete(_ context.Context, branch string) error {
+	// DELETE /projects/{project}/repository/branches/{branch}
+	return c.c.DeleteBranch(getRepoPath(c.ref), branch)
+}
+
+// RenameDefault makes a new branch with the given name, pointing to the head of the current
+// default branch, the default branch of the repository, and retargets the open pull requests into
+// the current default branch to it. GitLab can't rename branches, so the current default branch
+// is kept.
+//
+// ErrAlreadyExists is returned if a branch with the given name already exists.
+func (c *BranchClient) RenameDefault(ctx context.Context, branch string) error {
+	if _, err := c.c.GetBranch(getRepoPath(c.ref), branch); err == nil {
+		return fmt.Errorf("branch %q: %w", branch, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return err
+	}
+
+	// GET /projects/{project}
+	project, err := c.c.GetUserProject(ctx, getRepoPath(c.ref))
+	if err != nil {
+		return err
+	}
+	// GET /projects/{project}/repository/branches/{branch}
+	current, err := c.c.GetBranch(getRepoPath(c.ref), project.DefaultBranch)
+	if err != nil {
+		return err
+	}
+	// POST /projects/{project}/repository/branches
+	if err := c.Create(ctx, branch, current.Commit.ID); err != nil {
+		return handleHTTPError(err)
+	}
+	// PUT /projects/{project}
+	previous := project.DefaultBranch
+	project.DefaultBranch = branch
+	if _, err := c.c.UpdateProject(ctx, project); err != nil {
+		return err
+	}
+	return gitprovider.RetargetPullRequests(ctx, &PullRequestClient{clientContext: c.clientContext, ref: c.ref}, previous, branch)
+}
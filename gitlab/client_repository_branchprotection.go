@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// BranchProtectionClient implements the gitprovider.BranchProtectionClient interface.
+var _ gitprovider.BranchProtectionClient = &BranchProtectionClient{}
+
+// BranchProtectionClient operates on the branch protections of a specific repository.
+type BranchProtectionClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the protection of the given branch.
+//
+// ErrNotFound is returned if the branch is not protected.
+func (c *BranchProtectionClient) Get(ctx context.Context, branch string) (gitprovider.BranchProtection, error) {
+	return c.get(ctx, branch)
+}
+
+func (c *BranchProtectionClient) get(ctx context.Context, branch string) (*branchProtection, error) {
+	// GET /projects/{project}/protected_branches/{name}
+	apiObj, err := c.c.GetProtectedBranch(getRepoPath(c.ref), branch)
+	if err != nil {
+		return nil, err
+	}
+	logins, err := c.userLogins(ctx, apiObj)
+	if err != nil {
+		return nil, err
+	}
+	return newBranchProtection(c, apiObj, logins), nil
+}
+
+// List lists all branch protections of the repository.
+//
+// List returns all available branch protections for the given repository,
+// using multiple paginated requests if needed.
+func (c *BranchProtectionClient) List(ctx context.Context) ([]gitprovider.BranchProtection, error) {
+	// GET /projects/{project}/protected_branches
+	apiObjs, err := c.c.ListProtectedBranches(getRepoPath(c.ref))
+	if err != nil {
+		return nil, err
+	}
+	logins, err := c.userLogins(ctx, apiObjs...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our BranchProtection type
+	protections := make([]gitprovider.BranchProtection, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at ListProtectedBranches
+		protections = append(protections, newBranchProtection(c, apiObj, logins))
+	}
+	return protections, nil
+}
+
+// Create protects a branch with the given specifications.
+//
+// ErrAlreadyExists will be returned if the branch is already protected.
+func (c *BranchProtectionClient) Create(ctx context.Context, req gitprovider.BranchProtectionInfo) (gitprovider.BranchProtection, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if _, err := c.get(ctx, req.Branch); err == nil {
+		return nil, fmt.Errorf("branch protection for %q: %w", req.Branch, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	bp := newBranchProtection(c, &gitlab.ProtectedBranch{}, nil)
+	if err := bp.Set(req); err != nil {
+		return nil, err
+	}
+	// POST /projects/{project}/protected_branches
+	return bp, bp.createIntoSelf(ctx)
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *BranchProtectionClient) Reconcile(ctx context.Context, req gitprovider.BranchProtectionInfo) (gitprovider.BranchProtection, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the protection of the desired branch
+	actual, err := c.Get(ctx, req.Branch)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// userLogins maps the IDs of the users allowed to push to the given protected branches
+// to their logins, as GitLab only returns the user IDs.
+func (c *BranchProtectionClient) userLogins(ctx context.Context, apiObjs ...*gitlab.ProtectedBranch) (map[int]string, error) {
+	needed := false
+	for _, apiObj := range apiObjs {
+		for _, level := range apiObj.PushAccessLevels {
+			needed = needed || level.UserID != 0
+		}
+	}
+	if !needed {
+		return nil, nil
+	}
+	// GET /projects/{project}/users
+	users, err := c.c.ListProjectUsers(ctx, getRepoPath(c.ref))
+	if err != nil {
+		return nil, err
+	}
+	logins := make(map[int]string, len(users))
+	for _, user := range users {
+		logins[user.ID] = user.Username
+	}
+	return logins, nil
+}
+
+// userIDs is the reverse of userLogins, it maps the given logins to the IDs of the users.
+func (c *BranchProtectionClient) userIDs(ctx context.Context, logins []string) ([]int, error) {
+	ids, err := projectUserIDs(ctx, c.c, c.ref, logins)
+	if err != nil {
+		return nil, fmt.Errorf("push allowances: %w", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CommitStatusClient implements the gitprovider.CommitStatusClient interface.
+var _ gitprovider.CommitStatusClient = &CommitStatusClient{}
+
+// CommitStatusClient operates on the commit statuses for a specific repository.
+type CommitStatusClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists all statuses of the commit with the given sha.
+//
+// List returns all available statuses, using multiple paginated requests if needed.
+func (c *CommitStatusClient) List(_ context.Context, sha string) ([]gitprovider.CommitStatus, error) {
+	css, err := c.list(sha)
+	if err != nil {
+		return nil, err
+	}
+	// Cast to the generic []gitprovider.CommitStatus
+	statuses := make([]gitprovider.CommitStatus, 0, len(css))
+	for _, cs := range css {
+		statuses = append(statuses, cs)
+	}
+	return statuses, nil
+}
+
+func (c *CommitStatusClient) list(sha string) ([]*commitStatus, error) {
+	// GET /projects/{project}/repository/commits/{sha}/statuses
+	apiObjs, err := c.c.ListCommitStatuses(getRepoPath(c.ref), sha)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*commitStatus, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		statuses = append(statuses, newCommitStatus(c, apiObj))
+	}
+	return statuses, nil
+}
+
+// Create creates a status for the commit with the given sha.
+func (c *CommitStatusClient) Create(_ context.Context, sha string, req gitprovider.CommitStatusInfo) (gitprovider.CommitStatus, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	// POST /projects/{project}/statuses/{sha}
+	apiObj, err := c.c.SetCommitStatus(getRepoPath(c.ref), sha, commitStatusToAPI(&req))
+	if err != nil {
+		return nil, err
+	}
+	return newCommitStatus(c, apiObj), nil
+}
+
+// Combined returns the combined status of the commit with the given sha.
+// GitLab has no API for it, so it's computed from all statuses of the commit.
+func (c *CommitStatusClient) Combined(_ context.Context, sha string) (*gitprovider.CombinedCommitStatusInfo, error) {
+	css, err := c.list(sha)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]gitprovider.CommitStatusInfo, 0, len(css))
+	for _, cs := range css {
+		infos = append(infos, cs.Get())
+	}
+	combined := gitprovider.CombineCommitStatuses(sha, infos)
+	return &combined, nil
+}
@@ -106,10 +106,16 @@ func (c *DeployTokenClient) Create(_ context.Context, req gitprovider.DeployToke
 // If req doesn't equal the actual state, the resource will be deleted and recreated (actionTaken == true).
 // If req is already the actual state, this is a no-op (actionTaken == false).
 func (c *DeployTokenClient) Reconcile(ctx context.Context, req gitprovider.DeployTokenInfo) (gitprovider.DeployToken, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, req)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.DeployTokenClient.ReconcileWithChanges.
+func (c *DeployTokenClient) ReconcileWithChanges(ctx context.Context, req gitprovider.DeployTokenInfo) (gitprovider.DeployToken, []gitprovider.FieldChange, error) {
 	// First thing, validate and default the request to ensure a valid and fully-populated object
 	// (to minimize any possible diffs between desired and actual state)
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
-		return nil, false, err
+		return nil, nil, err
 	}
 
 	// Get the token with the desired name
@@ -118,31 +124,19 @@ func (c *DeployTokenClient) Reconcile(ctx context.Context, req gitprovider.Deplo
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
 			resp, err := c.Create(ctx, req)
-			return resp, true, err
+			return resp, req.Diff(gitprovider.DeployTokenInfo{}), err
 		}
 
 		// Unexpected path, Get should succeed or return NotFound
-		return nil, false, err
+		return nil, nil, err
 	}
 
-	actionTaken, err := actual.Reconcile(ctx)
-	if err != nil {
-		return nil, false, err
+	old := actual.Get()
+	if _, err := actual.Reconcile(ctx); err != nil {
+		return nil, nil, err
 	}
 
-	return actual, actionTaken, nil
-	//
-	// // If the desired matches the actual state, just return the actual state
-	// if req.Equals(actual.Get()) {
-	// 	return actual, false, nil
-	// }
-	//
-	// // Populate the desired state to the current-actual object
-	// if err := actual.Set(req); err != nil {
-	// 	return actual, false, err
-	// }
-	// // Apply the desired state by running Update
-	// return actual, true, actual.Update(ctx)
+	return actual, actual.Get().Diff(old), nil
 }
 
 func createDeployToken(c gitlabClient, ref gitprovider.RepositoryRef, req gitprovider.DeployTokenInfo) (*gitlab.DeployToken, error) {
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IssueClient implements the gitprovider.IssueClient interface.
+var _ gitprovider.IssueClient = &IssueClient{}
+
+// IssueClient operates on the issues of a specific project.
+type IssueClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the issue with the given number, i.e. its IID.
+//
+// ErrNotFound is returned if the issue does not exist.
+func (c *IssueClient) Get(_ context.Context, number int) (gitprovider.Issue, error) {
+	// GET /projects/{project}/issues/{issue_iid}
+	apiObj, err := c.c.GetIssue(getRepoPath(c.ref), number)
+	if err != nil {
+		return nil, err
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// List lists the issues in the project, by default only the open ones. All filters are supported.
+//
+// List returns all available issues, using multiple paginated requests if needed.
+func (c *IssueClient) List(_ context.Context, optFns ...gitprovider.IssueListOption) ([]gitprovider.Issue, error) {
+	opts, err := gitprovider.MakeIssueListOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+	apiOpts := &gitlab.ListProjectIssuesOptions{
+		AssigneeUsername: opts.Assignee,
+	}
+	switch *opts.State {
+	case gitprovider.IssueStateOpen:
+		apiOpts.State = gitlab.String(openedState)
+	case gitprovider.IssueStateClosed:
+		apiOpts.State = gitlab.String(closedState)
+	}
+	if len(opts.Labels) != 0 {
+		labels := gitlab.LabelOptions(opts.Labels)
+		apiOpts.Labels = &labels
+	}
+
+	// GET /projects/{project}/issues
+	apiObjs, err := c.c.ListIssues(getRepoPath(c.ref), apiOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]gitprovider.Issue, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		issues = append(issues, newIssue(c, apiObj))
+	}
+	return issues, nil
+}
+
+// Create opens an issue with the given specifications. The assignees must be members of the project.
+func (c *IssueClient) Create(ctx context.Context, req gitprovider.IssueInfo) (gitprovider.Issue, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	apiReq := &gitlab.CreateIssueOptions{
+		Title:       &req.Title,
+		Description: &req.Description,
+	}
+	if len(req.Labels) != 0 {
+		labels := gitlab.LabelOptions(req.Labels)
+		apiReq.Labels = &labels
+	}
+	if len(req.Assignees) != 0 {
+		ids, err := projectUserIDs(ctx, c.c, c.ref, req.Assignees)
+		if err != nil {
+			return nil, fmt.Errorf("assignees: %w", err)
+		}
+		apiReq.AssigneeIDs = &ids
+	}
+	if req.Milestone != "" {
+		id, err := c.milestoneID(req.Milestone)
+		if err != nil {
+			return nil, err
+		}
+		apiReq.MilestoneID = &id
+	}
+
+	// POST /projects/{project}/issues
+	apiObj, err := c.c.CreateIssue(getRepoPath(c.ref), apiReq)
+	if err != nil {
+		return nil, err
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// Edit changes an existing issue. All options are supported, the assignees must be members
+// of the project.
+func (c *IssueClient) Edit(ctx context.Context, number int, opts gitprovider.IssueEditOptions) (gitprovider.Issue, error) {
+	if err := opts.ValidateOptions(); err != nil {
+		return nil, err
+	}
+	apiReq := &gitlab.UpdateIssueOptions{
+		Title:       opts.Title,
+		Description: opts.Description,
+	}
+	if opts.State != nil {
+		stateEvent := "reopen"
+		if *opts.State == gitprovider.IssueStateClosed {
+			stateEvent = "close"
+		}
+		apiReq.StateEvent = &stateEvent
+	}
+	if opts.Labels != nil {
+		labels := gitlab.LabelOptions(opts.Labels)
+		apiReq.Labels = &labels
+	}
+	if opts.Assignees != nil {
+		ids, err := projectUserIDs(ctx, c.c, c.ref, opts.Assignees)
+		if err != nil {
+			return nil, fmt.Errorf("assignees: %w", err)
+		}
+		if ids == nil {
+			ids = []int{}
+		}
+		apiReq.AssigneeIDs = &ids
+	}
+	if opts.Milestone != nil {
+		// The milestone is removed by setting its ID to 0
+		id := 0
+		if *opts.Milestone != "" {
+			var err error
+			if id, err = c.milestoneID(*opts.Milestone); err != nil {
+				return nil, err
+			}
+		}
+		apiReq.MilestoneID = &id
+	}
+
+	// PUT /projects/{project}/issues/{issue_iid}
+	apiObj, err := c.c.UpdateIssue(getRepoPath(c.ref), number, apiReq)
+	if err != nil {
+		return nil, err
+	}
+	return newIssue(c, apiObj), nil
+}
+
+// Close closes the issue.
+func (c *IssueClient) Close(ctx context.Context, number int) (gitprovider.Issue, error) {
+	return c.Edit(ctx, number, gitprovider.IssueEditOptions{
+		State: gitprovider.IssueStateVar(gitprovider.IssueStateClosed),
+	})
+}
+
+// milestoneID returns the ID of the project milestone with the given title.
+func (c *IssueClient) milestoneID(title string) (int, error) {
+	// GET /projects/{project}/milestones
+	milestones, err := c.c.ListMilestones(getRepoPath(c.ref), title)
+	if err != nil {
+		return 0, err
+	}
+	if len(milestones) == 0 {
+		return 0, fmt.Errorf("milestone %q: %w", title, gitprovider.ErrNotFound)
+	}
+	return milestones[0].ID, nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IssueCommentClient implements the gitprovider.IssueCommentClient interface.
+var _ gitprovider.IssueCommentClient = &IssueCommentClient{}
+
+// IssueCommentClient operates on the notes of a specific issue.
+type IssueCommentClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int
+}
+
+// Get returns the note with the given ID.
+//
+// ErrNotFound is returned if the note does not exist, or is a system note.
+func (c *IssueCommentClient) Get(_ context.Context, id int64) (gitprovider.IssueComment, error) {
+	// GET /projects/{project}/issues/{issue_iid}/notes/{note_id}
+	apiObj, err := c.c.GetIssueNote(getRepoPath(c.ref), c.number, int(id))
+	if err != nil {
+		return nil, err
+	}
+	if !isConversationNote(apiObj) {
+		return nil, fmt.Errorf("note %d of issue %d: %w", id, c.number, gitprovider.ErrNotFound)
+	}
+	return newIssueComment(c, apiObj), nil
+}
+
+// List lists all notes written by users in the conversation of the issue, oldest first.
+// System notes, e.g. about changed labels, aren't included.
+//
+// List returns all available notes, using multiple paginated requests if needed.
+func (c *IssueCommentClient) List(_ context.Context) ([]gitprovider.IssueComment, error) {
+	// GET /projects/{project}/issues/{issue_iid}/notes
+	apiObjs, err := c.c.ListIssueNotes(getRepoPath(c.ref), c.number)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]gitprovider.IssueComment, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if isConversationNote(apiObj) {
+			comments = append(comments, newIssueComment(c, apiObj))
+		}
+	}
+	return comments, nil
+}
+
+// Create creates a note with the given body.
+func (c *IssueCommentClient) Create(_ context.Context, req gitprovider.IssueCommentInfo) (gitprovider.IssueComment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /projects/{project}/issues/{issue_iid}/notes
+	apiObj, err := c.c.CreateIssueNote(getRepoPath(c.ref), c.number, req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return newIssueComment(c, apiObj), nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// LabelClient implements the gitprovider.LabelClient interface.
+var _ gitprovider.LabelClient = &LabelClient{}
+
+// LabelClient operates on the labels of a specific repository.
+type LabelClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the label with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *LabelClient) Get(_ context.Context, name string) (gitprovider.Label, error) {
+	return c.get(name)
+}
+
+func (c *LabelClient) get(name string) (*label, error) {
+	// GET /projects/{project}/labels/{label_id}
+	apiObj, err := c.c.GetLabel(getRepoPath(c.ref), name)
+	if err != nil {
+		return nil, err
+	}
+	return newLabel(c, apiObj), nil
+}
+
+// List lists all labels of the repository. Labels inherited from groups aren't included.
+//
+// List returns all available labels for the given repository,
+// using multiple paginated requests if needed.
+func (c *LabelClient) List(_ context.Context) ([]gitprovider.Label, error) {
+	// GET /projects/{project}/labels
+	apiObjs, err := c.c.ListLabels(getRepoPath(c.ref))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Label type
+	labels := make([]gitprovider.Label, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at ListLabels
+		labels = append(labels, newLabel(c, apiObj))
+	}
+	return labels, nil
+}
+
+// Create creates a label with the given specifications.
+//
+// ErrAlreadyExists will be returned if a label with the same name already exists.
+func (c *LabelClient) Create(_ context.Context, req gitprovider.LabelInfo) (gitprovider.Label, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	// POST /projects/{project}/labels
+	apiObj, err := c.c.CreateLabel(getRepoPath(c.ref), &gitlab.CreateLabelOptions{
+		Name:        &req.Name,
+		Color:       gitlab.String(labelColorToAPI(req.Color)),
+		Description: &req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newLabel(c, apiObj), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *LabelClient) Reconcile(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the label with the desired name
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// ReconcileAll implements gitprovider.LabelClient.ReconcileAll.
+func (c *LabelClient) ReconcileAll(ctx context.Context, req []gitprovider.LabelInfo, opts ...gitprovider.ReconcileAllOption) (*gitprovider.ReconcileReport, error) {
+	return gitprovider.ReconcileAllLabels(ctx, c, c.destructiveActions, req, opts...)
+}
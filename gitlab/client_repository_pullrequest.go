@@ -37,19 +37,37 @@ type PullRequestClient struct {
 	ref gitprovider.RepositoryRef
 }
 
-// List lists all pull requests in the repository
-func (c *PullRequestClient) List(_ context.Context) ([]gitprovider.PullRequest, error) {
-	mrs, _, err := c.c.Client().MergeRequests.ListProjectMergeRequests(getRepoPath(c.ref), nil)
+// List lists the pull requests in the repository, by default only the open ones.
+//
+// List returns all available pull requests, using multiple paginated requests if needed.
+func (c *PullRequestClient) List(_ context.Context, optFns ...gitprovider.PullRequestListOption) ([]gitprovider.PullRequest, error) {
+	opts, err := gitprovider.MakePullRequestListOptions(optFns...)
 	if err != nil {
 		return nil, err
 	}
+	apiOpts := &gitlab.ListProjectMergeRequestsOptions{
+		SourceBranch: opts.SourceBranch,
+		TargetBranch: opts.TargetBranch,
+	}
+	switch *opts.State {
+	case gitprovider.PullRequestStateOpen:
+		apiOpts.State = gitlab.String(openedState)
+	case gitprovider.PullRequestStateClosed:
+		apiOpts.State = gitlab.String(closedState)
+	case gitprovider.PullRequestStateMerged:
+		apiOpts.State = gitlab.String(mergedState)
+	}
 
-	requests := make([]gitprovider.PullRequest, len(mrs))
-
-	for idx, mr := range mrs {
-		requests[idx] = newPullRequest(c.clientContext, mr)
+	// GET /projects/{project}/merge_requests
+	mrs, err := c.c.ListMergeRequests(getRepoPath(c.ref), apiOpts)
+	if err != nil {
+		return nil, err
 	}
 
+	requests := make([]gitprovider.PullRequest, 0, len(mrs))
+	for _, mr := range mrs {
+		requests = append(requests, newPullRequest(c, mr))
+	}
 	return requests, nil
 }
 
@@ -68,68 +86,225 @@ func (c *PullRequestClient) Create(_ context.Context, title, branch, baseBranch,
 		return nil, err
 	}
 
-	return newPullRequest(c.clientContext, mr), nil
+	return newPullRequest(c, mr), nil
 }
 
 // Edit modifies an existing MR. Please refer to "EditOptions" for details on which data can be edited.
+// All options are supported, drafts are marked using the "Draft: " title prefix.
 func (c *PullRequestClient) Edit(ctx context.Context, number int, opts gitprovider.EditOptions) (gitprovider.PullRequest, error) {
+	if err := opts.ValidateOptions(); err != nil {
+		return nil, err
+	}
 	mrUpdate := &gitlab.UpdateMergeRequestOptions{
-		Title: opts.Title,
+		Title:        opts.Title,
+		Description:  opts.Description,
+		TargetBranch: opts.TargetBranch,
 	}
-	editedMR, _, err := c.c.Client().MergeRequests.UpdateMergeRequest(getRepoPath(c.ref), number, mrUpdate)
+	if opts.State != nil {
+		stateEvent := "reopen"
+		if *opts.State == gitprovider.PullRequestStateClosed {
+			stateEvent = "close"
+		}
+		mrUpdate.StateEvent = &stateEvent
+	}
+	if opts.Labels != nil {
+		labels := gitlab.LabelOptions(opts.Labels)
+		mrUpdate.Labels = &labels
+	}
+	if opts.Assignees != nil {
+		ids, err := projectUserIDs(ctx, c.c, c.ref, opts.Assignees)
+		if err != nil {
+			return nil, fmt.Errorf("assignees: %w", err)
+		}
+		if ids == nil {
+			ids = []int{}
+		}
+		mrUpdate.AssigneeIDs = &ids
+	}
+
+	// Marking drafts and adding reviewers depends on the current state of the merge request
+	if opts.Draft != nil || len(opts.Reviewers) != 0 {
+		// GET /projects/{project}/merge_requests/{merge_request_iid}
+		mr, err := c.c.GetMergeRequest(getRepoPath(c.ref), number)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Draft != nil {
+			title := mr.Title
+			if opts.Title != nil {
+				title = *opts.Title
+			}
+			title = draftTitle(title, *opts.Draft)
+			mrUpdate.Title = &title
+		}
+		if len(opts.Reviewers) != 0 {
+			ids, err := projectUserIDs(ctx, c.c, c.ref, opts.Reviewers)
+			if err != nil {
+				return nil, fmt.Errorf("reviewers: %w", err)
+			}
+			for _, reviewer := range mr.Reviewers {
+				ids = append(ids, reviewer.ID)
+			}
+			mrUpdate.ReviewerIDs = &ids
+		}
+	}
+
+	// PUT /projects/{project}/merge_requests/{merge_request_iid}
+	editedMR, err := c.c.UpdateMergeRequest(getRepoPath(c.ref), number, mrUpdate)
 	if err != nil {
 		return nil, err
 	}
-	return newPullRequest(c.clientContext, editedMR), nil
+	return newPullRequest(c, editedMR), nil
 }
 
 // Get retrieves an existing pull request by number
 func (c *PullRequestClient) Get(_ context.Context, number int) (gitprovider.PullRequest, error) {
-
-	mr, _, err := c.c.Client().MergeRequests.GetMergeRequest(getRepoPath(c.ref), number, &gitlab.GetMergeRequestsOptions{})
+	// GET /projects/{project}/merge_requests/{merge_request_iid}
+	mr, err := c.c.GetMergeRequest(getRepoPath(c.ref), number)
 	if err != nil {
 		return nil, err
 	}
 
-	return newPullRequest(c.clientContext, mr), nil
+	return newPullRequest(c, mr), nil
 }
 
 // Merge merges a pull request with the given specifications.
-func (c *PullRequestClient) Merge(_ context.Context, number int, mergeMethod gitprovider.MergeMethod, message string) error {
+// GitLab merges according to the merge method of the project, MergeMethodRebase rebases the
+// source branch before merging, which results in a linear history if the project uses
+// fast-forward merges.
+func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod, message string, optFns ...gitprovider.PullRequestMergeOption) (*gitprovider.PullRequestMergeResult, error) {
+	opts, err := gitprovider.MakePullRequestMergeOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
 	if err := c.waitForMergeRequestToBeMergeable(number); err != nil {
-		return err
+		return nil, err
 	}
 
-	var squash bool
-
-	var mergeCommitMessage *string
-	var squashCommitMessage *string
-
+	amrOpts := &gitlab.AcceptMergeRequestOptions{
+		Squash:                   gitlab.Bool(false),
+		ShouldRemoveSourceBranch: opts.DeleteSourceBranch,
+		// GitLab refuses to merge if the head isn't at the given SHA
+		SHA: opts.ExpectedHeadSha,
+	}
 	switch mergeMethod {
 	case gitprovider.MergeMethodSquash:
-		squashCommitMessage = &message
-		squash = true
+		amrOpts.SquashCommitMessage = &message
+		amrOpts.Squash = gitlab.Bool(true)
 	case gitprovider.MergeMethodMerge:
-		mergeCommitMessage = &message
+		amrOpts.MergeCommitMessage = &message
+	case gitprovider.MergeMethodRebase:
+		sha, err := c.rebase(ctx, number, opts.ExpectedHeadSha)
+		if err != nil {
+			return nil, err
+		}
+		amrOpts.MergeCommitMessage = &message
+		amrOpts.SHA = &sha
 	default:
-		return fmt.Errorf("unknown merge method: %s", mergeMethod)
+		return nil, fmt.Errorf("unknown merge method %q: %w", mergeMethod, gitprovider.ErrInvalidArgument)
 	}
 
-	amrOpts := &gitlab.AcceptMergeRequestOptions{
-		MergeCommitMessage:        mergeCommitMessage,
-		SquashCommitMessage:       squashCommitMessage,
-		Squash:                    &squash,
-		ShouldRemoveSourceBranch:  nil,
-		MergeWhenPipelineSucceeds: nil,
-		SHA:                       nil,
+	// PUT /projects/{project}/merge_requests/{merge_request_iid}/merge
+	mr, err := c.c.AcceptMergeRequest(getRepoPath(c.ref), number, amrOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// The source branch is the base branch with fast-forward merges
+	sha := mr.SHA
+	if mr.MergeCommitSHA != "" {
+		sha = mr.MergeCommitSHA
+	} else if mr.SquashCommitSHA != "" {
+		sha = mr.SquashCommitSHA
 	}
+	return &gitprovider.PullRequestMergeResult{Sha: sha}, nil
+}
 
-	_, _, err := c.c.Client().MergeRequests.AcceptMergeRequest(getRepoPath(c.ref), number, amrOpts)
+// EnableAutoMerge sets the merge request to merge when its pipeline succeeds, or merges it right away
+// if there's no pipeline running. The merge methods MergeMethodMerge and MergeMethodSquash are
+// supported, GitLab can't rebase automatically.
+func (c *PullRequestClient) EnableAutoMerge(_ context.Context, number int, mergeMethod gitprovider.MergeMethod) error {
+	amrOpts, err := autoMergeOptions(mergeMethod)
 	if err != nil {
 		return err
 	}
+	if err := c.waitForMergeRequestToBeMergeable(number); err != nil {
+		return err
+	}
+
+	// PUT /projects/{project}/merge_requests/{merge_request_iid}/merge
+	_, err = c.c.AcceptMergeRequest(getRepoPath(c.ref), number, amrOpts)
+	return err
+}
+
+// autoMergeOptions returns the options for merging a merge request via the given method when its
+// pipeline succeeds.
+func autoMergeOptions(mergeMethod gitprovider.MergeMethod) (*gitlab.AcceptMergeRequestOptions, error) {
+	amrOpts := &gitlab.AcceptMergeRequestOptions{
+		MergeWhenPipelineSucceeds: gitlab.Bool(true),
+	}
+	switch mergeMethod {
+	case gitprovider.MergeMethodSquash:
+		amrOpts.Squash = gitlab.Bool(true)
+	case gitprovider.MergeMethodMerge:
+		amrOpts.Squash = gitlab.Bool(false)
+	case gitprovider.MergeMethodRebase:
+		return nil, fmt.Errorf("merge method %q: %w", mergeMethod, gitprovider.ErrNoProviderSupport)
+	default:
+		return nil, fmt.Errorf("unknown merge method %q: %w", mergeMethod, gitprovider.ErrInvalidArgument)
+	}
+	return amrOpts, nil
+}
+
+// DisableAutoMerge cancels merging the merge request when its pipeline succeeds.
+func (c *PullRequestClient) DisableAutoMerge(_ context.Context, number int) error {
+	// POST /projects/{project}/merge_requests/{merge_request_iid}/cancel_merge_when_pipeline_succeeds
+	return c.c.CancelMergeWhenPipelineSucceeds(getRepoPath(c.ref), number)
+}
+
+// rebasePollInterval is the interval at which rebase polls whether the rebase of a merge request
+// is done.
+var rebasePollInterval = 2 * time.Second
+
+// rebase rebases the source branch of the merge request onto its target branch, and returns the
+// resulting head. A *gitprovider.ConflictError is returned if the head isn't the expected commit
+// before rebasing, or if the rebase fails. Waiting for the rebase stops when ctx is done.
+func (c *PullRequestClient) rebase(ctx context.Context, number int, expectedHeadSha *string) (string, error) {
+	if expectedHeadSha != nil {
+		// GET /projects/{project}/merge_requests/{merge_request_iid}
+		mr, err := c.c.GetMergeRequest(getRepoPath(c.ref), number)
+		if err != nil {
+			return "", err
+		}
+		if mr.SHA != *expectedHeadSha {
+			return "", gitprovider.NewHeadChangedError(number, *expectedHeadSha, mr.SHA)
+		}
+	}
+	// PUT /projects/{project}/merge_requests/{merge_request_iid}/rebase
+	if err := c.c.RebaseMergeRequest(getRepoPath(c.ref), number); err != nil {
+		return "", err
+	}
+
+	// gitlab rebases asynchronously, poll until it's done
+	for retries := 0; retries < 10; retries++ {
+		// GET /projects/{project}/merge_requests/{merge_request_iid}
+		mr, err := c.c.GetMergeRequestRebase(getRepoPath(c.ref), number)
+		if err != nil || mr.RebaseInProgress {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(rebasePollInterval):
+			}
+			continue
+		}
+		if mr.MergeError != "" {
+			msg := fmt.Sprintf("rebase of merge request %d failed: %s", number, mr.MergeError)
+			return "", &gitprovider.ConflictError{HTTPError: gitprovider.HTTPError{ErrorMessage: msg, Message: msg}}
+		}
+		return mr.SHA, nil
+	}
 
-	return nil
+	return "", fmt.Errorf("rebase unfinished for pull request number: %d", number)
 }
 
 func (c *PullRequestClient) waitForMergeRequestToBeMergeable(number int) error {
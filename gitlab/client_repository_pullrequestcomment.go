@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestCommentClient implements the gitprovider.PullRequestCommentClient interface.
+var _ gitprovider.PullRequestCommentClient = &PullRequestCommentClient{}
+
+// PullRequestCommentClient operates on the notes of a specific merge request.
+type PullRequestCommentClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int
+}
+
+// Get returns the note with the given ID.
+//
+// ErrNotFound is returned if the note does not exist, or is a system or diff note.
+func (c *PullRequestCommentClient) Get(_ context.Context, id int64) (gitprovider.PullRequestComment, error) {
+	// GET /projects/{project}/merge_requests/{merge_request_iid}/notes/{note_id}
+	apiObj, err := c.c.GetMergeRequestNote(getRepoPath(c.ref), c.number, int(id))
+	if err != nil {
+		return nil, err
+	}
+	if !isConversationNote(apiObj) {
+		return nil, fmt.Errorf("note %d of merge request %d: %w", id, c.number, gitprovider.ErrNotFound)
+	}
+	return newPullRequestComment(c, apiObj), nil
+}
+
+// List lists all notes written by users in the conversation of the merge request, oldest first.
+// System notes, e.g. about pushed commits, and notes on the diff aren't included.
+//
+// List returns all available notes, using multiple paginated requests if needed.
+func (c *PullRequestCommentClient) List(_ context.Context) ([]gitprovider.PullRequestComment, error) {
+	// GET /projects/{project}/merge_requests/{merge_request_iid}/notes
+	apiObjs, err := c.c.ListMergeRequestNotes(getRepoPath(c.ref), c.number)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]gitprovider.PullRequestComment, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if isConversationNote(apiObj) {
+			comments = append(comments, newPullRequestComment(c, apiObj))
+		}
+	}
+	return comments, nil
+}
+
+// Create creates a note with the given body.
+func (c *PullRequestCommentClient) Create(_ context.Context, req gitprovider.PullRequestCommentInfo) (gitprovider.PullRequestComment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	// POST /projects/{project}/merge_requests/{merge_request_iid}/notes
+	apiObj, err := c.c.CreateMergeRequestNote(getRepoPath(c.ref), c.number, req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return newPullRequestComment(c, apiObj), nil
+}
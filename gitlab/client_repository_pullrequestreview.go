@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestReviewClient implements the gitprovider.PullRequestReviewClient interface.
+var _ gitprovider.PullRequestReviewClient = &PullRequestReviewClient{}
+
+// PullRequestReviewClient operates on the approvals of a specific merge request.
+// GitLab doesn't have reviews, hence approvals are mapped to approved reviews, and
+// other reviews to notes.
+type PullRequestReviewClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int
+}
+
+// List lists the approvals of the merge request, one approved review per approver.
+func (c *PullRequestReviewClient) List(_ context.Context) ([]gitprovider.PullRequestReview, error) {
+	// GET /projects/{project}/merge_requests/{merge_request_iid}/approvals
+	apiObj, err := c.c.GetMergeRequestApprovals(getRepoPath(c.ref), c.number)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]gitprovider.PullRequestReview, 0, len(apiObj.ApprovedBy))
+	for _, approver := range apiObj.ApprovedBy {
+		if approver.User != nil {
+			reviews = append(reviews, newApprovalReview(approver))
+		}
+	}
+	return reviews, nil
+}
+
+// Create approves the merge request, or creates a note for a commented review. The body of an
+// approval is added as a note.
+//
+// ErrNoProviderSupport is returned when requesting changes.
+func (c *PullRequestReviewClient) Create(ctx context.Context, req gitprovider.PullRequestReviewInfo) (gitprovider.PullRequestReview, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if req.State == gitprovider.PullRequestReviewStateChangesRequested {
+		return nil, fmt.Errorf("requesting changes on a merge request: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if req.State == gitprovider.PullRequestReviewStateCommented {
+		// POST /projects/{project}/merge_requests/{merge_request_iid}/notes
+		note, err := c.c.CreateMergeRequestNote(getRepoPath(c.ref), c.number, req.Body)
+		if err != nil {
+			return nil, err
+		}
+		return newNoteReview(note), nil
+	}
+
+	// GET /user
+	user, err := c.c.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// POST /projects/{project}/merge_requests/{merge_request_iid}/approve
+	approvals, err := c.c.ApproveMergeRequest(getRepoPath(c.ref), c.number)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != "" {
+		// POST /projects/{project}/merge_requests/{merge_request_iid}/notes
+		if _, err := c.c.CreateMergeRequestNote(getRepoPath(c.ref), c.number, req.Body); err != nil {
+			return nil, err
+		}
+	}
+	for _, approver := range approvals.ApprovedBy {
+		if approver.User != nil && approver.User.ID == user.ID {
+			review := newApprovalReview(approver)
+			review.info.Body = req.Body
+			return review, nil
+		}
+	}
+	return nil, fmt.Errorf("approval of user %q is missing in the server response: %w", user.Username, gitprovider.ErrInvalidServerData)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// ReleaseClient implements the gitprovider.ReleaseClient interface.
+var _ gitprovider.ReleaseClient = &ReleaseClient{}
+
+// ReleaseClient operates on the releases of a specific repository.
+type ReleaseClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the release made from the tag with the given name.
+//
+// ErrNotFound is returned if the release does not exist.
+func (c *ReleaseClient) Get(_ context.Context, tagName string) (gitprovider.Release, error) {
+	// GET /projects/{project}/releases/{tag}
+	apiObj, err := c.c.GetRelease(getRepoPath(c.ref), tagName)
+	if err != nil {
+		return nil, err
+	}
+	return newRelease(c, apiObj), nil
+}
+
+// List lists all releases of the repository.
+//
+// List returns all available releases for the given repository,
+// using multiple paginated requests if needed.
+func (c *ReleaseClient) List(_ context.Context) ([]gitprovider.Release, error) {
+	// GET /projects/{project}/releases
+	apiObjs, err := c.c.ListReleases(getRepoPath(c.ref))
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the api object to our Release type
+	releases := make([]gitprovider.Release, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		// apiObj is already validated at ListReleases
+		releases = append(releases, newRelease(c, apiObj))
+	}
+	return releases, nil
+}
+
+// Create creates a release with the given specifications. If the tag doesn't exist yet,
+// it's created from req.Target.
+//
+// GitLab doesn't support draft releases and prereleases, ErrNoProviderSupport is returned
+// if req.Draft or req.Prerelease is true.
+//
+// ErrAlreadyExists will be returned if a release for the tag already exists.
+func (c *ReleaseClient) Create(ctx context.Context, req gitprovider.ReleaseInfo) (gitprovider.Release, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if *req.Draft || *req.Prerelease {
+		return nil, fmt.Errorf("draft releases and prereleases: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if _, err := c.c.GetRelease(getRepoPath(c.ref), req.TagName); err == nil {
+		return nil, fmt.Errorf("release %q: %w", req.TagName, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	// GitLab requires a ref if the tag doesn't exist yet
+	target := req.Target
+	if target == nil {
+		// GET /projects/{project}
+		project, err := c.c.GetUserProject(ctx, getRepoPath(c.ref))
+		if err != nil {
+			return nil, err
+		}
+		target = &project.DefaultBranch
+	}
+
+	// POST /projects/{project}/releases
+	apiObj, err := c.c.CreateRelease(getRepoPath(c.ref), &gitlab.CreateReleaseOptions{
+		TagName:     &req.TagName,
+		Ref:         target,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newRelease(c, apiObj), nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+var testRepoRef = gitprovider.OrgRepositoryRef{
+	OrganizationRef: gitprovider.OrganizationRef{Domain: DefaultDomain, Organization: "fluxcd"},
+	RepositoryName:  "flux2",
+}
+
+// setup starts a stand-in for the GitLab API, and returns a client talking to it.
+func setup(t *testing.T) (*http.ServeMux, *Client) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	gl, err := gitlab.NewClient("", gitlab.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mux, newClient(gl, DefaultDomain, "", false)
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Errorf("failed to encode response: %v", err)
+	}
+}
+
+func TestPullRequestAutoMerge(t *testing.T) {
+	mux, c := setup(t)
+	mux.HandleFunc("/api/v4/projects/fluxcd/flux2/merge_requests/1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, &gitlab.MergeRequest{IID: 1, MergeStatus: "can_be_merged"})
+	})
+	var accepted []gitlab.AcceptMergeRequestOptions
+	mux.HandleFunc("/api/v4/projects/fluxcd/flux2/merge_requests/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("merge method = %s, want PUT", r.Method)
+		}
+		req := gitlab.AcceptMergeRequestOptions{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		accepted = append(accepted, req)
+		writeJSON(t, w, http.StatusOK, &gitlab.MergeRequest{IID: 1, MergeWhenPipelineSucceeds: true})
+	})
+	canceled := 0
+	mux.HandleFunc("/api/v4/projects/fluxcd/flux2/merge_requests/1/cancel_merge_when_pipeline_succeeds", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("cancel method = %s, want POST", r.Method)
+		}
+		canceled++
+		writeJSON(t, w, http.StatusOK, &gitlab.MergeRequest{IID: 1})
+	})
+	mux.HandleFunc("/api/v4/projects/fluxcd/flux2/merge_requests/2/cancel_merge_when_pipeline_succeeds", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusNotFound, map[string]string{"message": "404 Not found"})
+	})
+
+	ctx := context.Background()
+	prs := &PullRequestClient{clientContext: c.clientContext, ref: testRepoRef}
+	if err := prs.EnableAutoMerge(ctx, 1, gitprovider.MergeMethodRebase); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("EnableAutoMerge() error = %v, want ErrNoProviderSupport", err)
+	}
+	if err := prs.EnableAutoMerge(ctx, 1, gitprovider.MergeMethodSquash); err != nil {
+		t.Fatalf("EnableAutoMerge() error = %v", err)
+	}
+	if err := prs.EnableAutoMerge(ctx, 1, gitprovider.MergeMethodMerge); err != nil {
+		t.Fatalf("EnableAutoMerge() error = %v", err)
+	}
+	want := []gitlab.AcceptMergeRequestOptions{
+		{MergeWhenPipelineSucceeds: gitlab.Bool(true), Squash: gitlab.Bool(true)},
+		{MergeWhenPipelineSucceeds: gitlab.Bool(true), Squash: gitlab.Bool(false)},
+	}
+	if diff := cmp.Diff(want, accepted); diff != "" {
+		t.Errorf("EnableAutoMerge() requests mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := prs.DisableAutoMerge(ctx, 1); err != nil {
+		t.Fatalf("DisableAutoMerge() error = %v", err)
+	}
+	if canceled != 1 {
+		t.Errorf("DisableAutoMerge() canceled %d times, want 1", canceled)
+	}
+	if err := prs.DisableAutoMerge(ctx, 2); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("DisableAutoMerge() error = %v, want ErrNotFound", err)
+	}
+}
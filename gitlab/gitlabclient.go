@@ -19,6 +19,7 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -47,6 +48,25 @@ type gitlabClient interface {
 	// ListGroupMembers is a wrapper for "GET /groups/{group}/members".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
 	ListGroupMembers(ctx context.Context, groupName string) ([]*gitlab.GroupMember, error)
+	// CreateGroup is a wrapper for "POST /groups".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateGroup(ctx context.Context, req *gitlab.CreateGroupOptions) (*gitlab.Group, error)
+	// UpdateGroup is a wrapper for "PUT /groups/{group}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	UpdateGroup(ctx context.Context, groupName string, req *gitlab.UpdateGroupOptions) (*gitlab.Group, error)
+	// DeleteGroup is a wrapper for "DELETE /groups/{group}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteGroup(ctx context.Context, groupName string) error
+	// AddGroupMember is a wrapper for "POST /groups/{group}/members".
+	// This function handles HTTP error wrapping.
+	AddGroupMember(ctx context.Context, groupName string, userID int, accessLevel gitlab.AccessLevelValue) error
+	// EditGroupMember is a wrapper for "PUT /groups/{group}/members/{user_id}".
+	// This function handles HTTP error wrapping.
+	EditGroupMember(ctx context.Context, groupName string, userID int, accessLevel gitlab.AccessLevelValue) error
+	// RemoveGroupMember is a wrapper for "DELETE /groups/{group}/members/{user_id}".
+	// This function handles HTTP error wrapping.
+	RemoveGroupMember(ctx context.Context, groupName string, userID int) error
 
 	// Project methods
 
@@ -65,12 +85,28 @@ type gitlabClient interface {
 	// ListProjectUsers is a wrapper for "GET /projects/{project}/users".
 	// This function handles pagination, HTTP error wrapping, and validates the server result.
 	ListProjectUsers(ctx context.Context, projectName string) ([]*gitlab.ProjectUser, error)
+	// ListProjectMembers is a wrapper for "GET /projects/{project}/members".
+	// This function handles pagination, and HTTP error wrapping.
+	ListProjectMembers(ctx context.Context, projectName string) ([]*gitlab.ProjectMember, error)
+	// AddProjectMember is a wrapper for "POST /projects/{project}/members".
+	// This function handles HTTP error wrapping.
+	AddProjectMember(ctx context.Context, projectName string, userID int, accessLevel gitlab.AccessLevelValue) (*gitlab.ProjectMember, error)
+	// EditProjectMember is a wrapper for "PUT /projects/{project}/members/{user_id}".
+	// This function handles HTTP error wrapping.
+	EditProjectMember(ctx context.Context, projectName string, userID int, accessLevel gitlab.AccessLevelValue) (*gitlab.ProjectMember, error)
+	// RemoveProjectMember is a wrapper for "DELETE /projects/{project}/members/{user_id}".
+	// This function handles HTTP error wrapping.
+	RemoveProjectMember(ctx context.Context, projectName string, userID int) error
 	// CreateProject is a wrapper for "POST /projects"
 	// This function handles HTTP error wrapping, and validates the server result.
 	CreateProject(ctx context.Context, req *gitlab.Project, opts *gitlab.CreateProjectOptions) (*gitlab.Project, error)
 	// UpdateProject is a wrapper for "PUT /projects/{project}".
 	// This function handles HTTP error wrapping, and validates the server result.
 	UpdateProject(ctx context.Context, req *gitlab.Project) (*gitlab.Project, error)
+	// SetProjectArchived is a wrapper for "POST /projects/{project}/archive" (if archived is true)
+	// or "POST /projects/{project}/unarchive" (if archived is false).
+	// This function handles HTTP error wrapping, and validates the server result.
+	SetProjectArchived(ctx context.Context, projectID int, archived bool) (*gitlab.Project, error)
 	// DeleteProject is a wrapper for "DELETE /projects/{project}".
 	// This function handles HTTP error wrapping.
 	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
@@ -78,6 +114,9 @@ type gitlabClient interface {
 
 	// GetUser is a wrapper for "GET /user"
 	GetUser(ctx context.Context) (*gitlab.User, error)
+	// GetUserByUsername is a wrapper for "GET /users?username={username}".
+	// This function handles HTTP error wrapping, and returns ErrNotFound if no user has the given username.
+	GetUserByUsername(ctx context.Context, username string) (*gitlab.User, error)
 
 	// Deploy key methods
 
@@ -103,6 +142,21 @@ type gitlabClient interface {
 	// This function handles HTTP error wrapping.
 	DeleteToken(projectName string, keyID int) error
 
+	// Webhook methods
+
+	// ListHooks is a wrapper for "GET /projects/{project}/hooks".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListHooks(projectName string) ([]*gitlab.ProjectHook, error)
+	// CreateHook is a wrapper for "POST /projects/{project}/hooks".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateHook(projectName string, req *gitlab.AddProjectHookOptions) (*gitlab.ProjectHook, error)
+	// EditHook is a wrapper for "PUT /projects/{project}/hooks/{hook_id}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	EditHook(projectName string, hookID int, req *gitlab.EditProjectHookOptions) (*gitlab.ProjectHook, error)
+	// DeleteHook is a wrapper for "DELETE /projects/{project}/hooks/{hook_id}".
+	// This function handles HTTP error wrapping.
+	DeleteHook(projectName string, hookID int) error
+
 	// Team related methods
 
 	// ShareGroup is a wrapper for ""
@@ -117,6 +171,184 @@ type gitlabClient interface {
 	// ListCommitsPage is a wrapper for "GET /projects/{project}/repository/commits".
 	// This function handles pagination, HTTP error wrapping.
 	ListCommitsPage(projectName, branch string, perPage int, page int) ([]*gitlab.Commit, error)
+
+	// Commit statuses
+
+	// ListCommitStatuses is a wrapper for "GET /projects/{project}/repository/commits/{sha}/statuses".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListCommitStatuses(projectName, sha string) ([]*gitlab.CommitStatus, error)
+	// SetCommitStatus is a wrapper for "POST /projects/{project}/statuses/{sha}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	SetCommitStatus(projectName, sha string, req *gitlab.SetCommitStatusOptions) (*gitlab.CommitStatus, error)
+
+	// Branches
+
+	// ListBranches is a wrapper for "GET /projects/{project}/repository/branches".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListBranches(projectName string) ([]*gitlab.Branch, error)
+	// GetBranch is a wrapper for "GET /projects/{project}/repository/branches/{branch}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetBranch(projectName, branch string) (*gitlab.Branch, error)
+	// DeleteBranch is a wrapper for "DELETE /projects/{project}/repository/branches/{branch}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteBranch(projectName, branch string) error
+
+	// Protected branches
+
+	// ListProtectedBranches is a wrapper for "GET /projects/{project}/protected_branches".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListProtectedBranches(projectName string) ([]*gitlab.ProtectedBranch, error)
+	// GetProtectedBranch is a wrapper for "GET /projects/{project}/protected_branches/{name}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetProtectedBranch(projectName, branch string) (*gitlab.ProtectedBranch, error)
+	// ProtectBranch is a wrapper for "POST /projects/{project}/protected_branches".
+	// This function handles HTTP error wrapping, and validates the server result.
+	ProtectBranch(projectName string, req *gitlab.ProtectRepositoryBranchesOptions) (*gitlab.ProtectedBranch, error)
+	// UnprotectBranch is a wrapper for "DELETE /projects/{project}/protected_branches/{name}".
+	// This function handles HTTP error wrapping.
+	UnprotectBranch(projectName, branch string) error
+
+	// Tags
+
+	// ListTags is a wrapper for "GET /projects/{project}/repository/tags".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListTags(projectName string) ([]*gitlab.Tag, error)
+	// GetTag is a wrapper for "GET /projects/{project}/repository/tags/{tag}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetTag(projectName, tag string) (*gitlab.Tag, error)
+	// CreateTag is a wrapper for "POST /projects/{project}/repository/tags".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateTag(projectName string, req *gitlab.CreateTagOptions) (*gitlab.Tag, error)
+	// DeleteTag is a wrapper for "DELETE /projects/{project}/repository/tags/{tag}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteTag(projectName, tag string) error
+
+	// Releases
+
+	// ListReleases is a wrapper for "GET /projects/{project}/releases".
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListReleases(projectName string) ([]*gitlab.Release, error)
+	// GetRelease is a wrapper for "GET /projects/{project}/releases/{tag}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetRelease(projectName, tag string) (*gitlab.Release, error)
+	// CreateRelease is a wrapper for "POST /projects/{project}/releases".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateRelease(projectName string, req *gitlab.CreateReleaseOptions) (*gitlab.Release, error)
+	// UpdateRelease is a wrapper for "PUT /projects/{project}/releases/{tag}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	UpdateRelease(projectName, tag string, req *gitlab.UpdateReleaseOptions) (*gitlab.Release, error)
+	// DeleteRelease is a wrapper for "DELETE /projects/{project}/releases/{tag}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteRelease(projectName, tag string) error
+	// UploadFile is a wrapper for "POST /projects/{project}/uploads".
+	// This function handles HTTP error wrapping.
+	UploadFile(projectName, fileName string, content io.Reader) (*gitlab.ProjectFile, error)
+	// CreateReleaseLink is a wrapper for "POST /projects/{project}/releases/{tag}/assets/links".
+	// This function handles HTTP error wrapping.
+	CreateReleaseLink(projectName, tag string, req *gitlab.CreateReleaseLinkOptions) (*gitlab.ReleaseLink, error)
+
+	// Merge requests
+
+	// ListMergeRequests is a wrapper for "GET /projects/{project}/merge_requests".
+	// This function handles pagination, and HTTP error wrapping.
+	ListMergeRequests(projectName string, opts *gitlab.ListProjectMergeRequestsOptions) ([]*gitlab.MergeRequest, error)
+	// GetMergeRequest is a wrapper for "GET /projects/{project}/merge_requests/{merge_request_iid}".
+	// This function handles HTTP error wrapping.
+	GetMergeRequest(projectName string, number int) (*gitlab.MergeRequest, error)
+	// UpdateMergeRequest is a wrapper for "PUT /projects/{project}/merge_requests/{merge_request_iid}".
+	// This function handles HTTP error wrapping.
+	UpdateMergeRequest(projectName string, number int, req *gitlab.UpdateMergeRequestOptions) (*gitlab.MergeRequest, error)
+	// AcceptMergeRequest is a wrapper for "PUT /projects/{project}/merge_requests/{merge_request_iid}/merge".
+	// This function handles HTTP error wrapping, returning a *gitprovider.ConflictError if the head
+	// of the merge request isn't the expected commit, or if it can't be merged due to conflicts.
+	AcceptMergeRequest(projectName string, number int, req *gitlab.AcceptMergeRequestOptions) (*gitlab.MergeRequest, error)
+	// RebaseMergeRequest is a wrapper for "PUT /projects/{project}/merge_requests/{merge_request_iid}/rebase".
+	// The rebase happens asynchronously.
+	// This function handles HTTP error wrapping.
+	RebaseMergeRequest(projectName string, number int) error
+	// GetMergeRequestRebase is a wrapper for "GET /projects/{project}/merge_requests/{merge_request_iid}",
+	// including whether a rebase of the merge request is in progress.
+	// This function handles HTTP error wrapping.
+	GetMergeRequestRebase(projectName string, number int) (*gitlab.MergeRequest, error)
+	// CancelMergeWhenPipelineSucceeds is a wrapper for
+	// "POST /projects/{project}/merge_requests/{merge_request_iid}/cancel_merge_when_pipeline_succeeds".
+	// This function handles HTTP error wrapping.
+	CancelMergeWhenPipelineSucceeds(projectName string, number int) error
+	// ListMergeRequestDiffs is a wrapper for "GET /projects/{project}/merge_requests/{merge_request_iid}/diffs".
+	// This function handles pagination, and HTTP error wrapping.
+	ListMergeRequestDiffs(projectName string, number int) ([]*gitlab.MergeRequestDiff, error)
+	// ListMergeRequestNotes is a wrapper for "GET /projects/{project}/merge_requests/{merge_request_iid}/notes".
+	// This function handles pagination, and HTTP error wrapping.
+	ListMergeRequestNotes(projectName string, number int) ([]*gitlab.Note, error)
+	// GetMergeRequestNote is a wrapper for "GET /projects/{project}/merge_requests/{merge_request_iid}/notes/{note_id}".
+	// This function handles HTTP error wrapping.
+	GetMergeRequestNote(projectName string, number, id int) (*gitlab.Note, error)
+	// CreateMergeRequestNote is a wrapper for "POST /projects/{project}/merge_requests/{merge_request_iid}/notes".
+	// This function handles HTTP error wrapping.
+	CreateMergeRequestNote(projectName string, number int, body string) (*gitlab.Note, error)
+	// UpdateMergeRequestNote is a wrapper for "PUT /projects/{project}/merge_requests/{merge_request_iid}/notes/{note_id}".
+	// This function handles HTTP error wrapping.
+	UpdateMergeRequestNote(projectName string, number, id int, body string) (*gitlab.Note, error)
+	// DeleteMergeRequestNote is a wrapper for "DELETE /projects/{project}/merge_requests/{merge_request_iid}/notes/{note_id}".
+	// This function handles HTTP error wrapping.
+	DeleteMergeRequestNote(projectName string, number, id int) error
+	// ListIssues is a wrapper for "GET /projects/{project}/issues".
+	// This function handles pagination, and HTTP error wrapping.
+	ListIssues(projectName string, opts *gitlab.ListProjectIssuesOptions) ([]*gitlab.Issue, error)
+	// GetIssue is a wrapper for "GET /projects/{project}/issues/{issue_iid}".
+	// This function handles HTTP error wrapping.
+	GetIssue(projectName string, number int) (*gitlab.Issue, error)
+	// CreateIssue is a wrapper for "POST /projects/{project}/issues".
+	// This function handles HTTP error wrapping.
+	CreateIssue(projectName string, req *gitlab.CreateIssueOptions) (*gitlab.Issue, error)
+	// UpdateIssue is a wrapper for "PUT /projects/{project}/issues/{issue_iid}".
+	// This function handles HTTP error wrapping.
+	UpdateIssue(projectName string, number int, req *gitlab.UpdateIssueOptions) (*gitlab.Issue, error)
+	// ListMilestones is a wrapper for "GET /projects/{project}/milestones", returning the milestones
+	// with the given title.
+	// This function handles pagination, and HTTP error wrapping.
+	ListMilestones(projectName, title string) ([]*gitlab.Milestone, error)
+	// ListIssueNotes is a wrapper for "GET /projects/{project}/issues/{issue_iid}/notes".
+	// This function handles pagination, and HTTP error wrapping.
+	ListIssueNotes(projectName string, number int) ([]*gitlab.Note, error)
+	// GetIssueNote is a wrapper for "GET /projects/{project}/issues/{issue_iid}/notes/{note_id}".
+	// This function handles HTTP error wrapping.
+	GetIssueNote(projectName string, number, id int) (*gitlab.Note, error)
+	// CreateIssueNote is a wrapper for "POST /projects/{project}/issues/{issue_iid}/notes".
+	// This function handles HTTP error wrapping.
+	CreateIssueNote(projectName string, number int, body string) (*gitlab.Note, error)
+	// UpdateIssueNote is a wrapper for "PUT /projects/{project}/issues/{issue_iid}/notes/{note_id}".
+	// This function handles HTTP error wrapping.
+	UpdateIssueNote(projectName string, number, id int, body string) (*gitlab.Note, error)
+	// DeleteIssueNote is a wrapper for "DELETE /projects/{project}/issues/{issue_iid}/notes/{note_id}".
+	// This function handles HTTP error wrapping.
+	DeleteIssueNote(projectName string, number, id int) error
+	// ListLabels is a wrapper for "GET /projects/{project}/labels", leaving out the labels
+	// inherited from groups.
+	// This function handles pagination, HTTP error wrapping, and validates the server result.
+	ListLabels(projectName string) ([]*gitlab.Label, error)
+	// GetLabel is a wrapper for "GET /projects/{project}/labels/{label_id}".
+	// This function handles HTTP error wrapping, and validates the server result.
+	GetLabel(projectName, name string) (*gitlab.Label, error)
+	// CreateLabel is a wrapper for "POST /projects/{project}/labels".
+	// This function handles HTTP error wrapping, and validates the server result.
+	CreateLabel(projectName string, req *gitlab.CreateLabelOptions) (*gitlab.Label, error)
+	// UpdateLabel is a wrapper for "PUT /projects/{project}/labels", req.Name identifies the label.
+	// This function handles HTTP error wrapping, and validates the server result.
+	UpdateLabel(projectName string, req *gitlab.UpdateLabelOptions) (*gitlab.Label, error)
+	// DeleteLabel is a wrapper for "DELETE /projects/{project}/labels/{label_id}".
+	// This function handles HTTP error wrapping.
+	// DANGEROUS COMMAND: In order to use this, you must set destructiveActions to true.
+	DeleteLabel(projectName string, labelID int) error
+	// GetMergeRequestApprovals is a wrapper for "GET /projects/{project}/merge_requests/{merge_request_iid}/approvals".
+	// This function handles HTTP error wrapping.
+	GetMergeRequestApprovals(projectName string, number int) (*gitlab.MergeRequestApprovals, error)
+	// ApproveMergeRequest is a wrapper for "POST /projects/{project}/merge_requests/{merge_request_iid}/approve".
+	// This function handles HTTP error wrapping.
+	ApproveMergeRequest(projectName string, number int) (*gitlab.MergeRequestApprovals, error)
 }
 
 // gitlabClientImpl is a wrapper around *gitlab.Client, which implements higher-level methods,
@@ -137,10 +369,15 @@ func (c *gitlabClientImpl) Client() *gitlab.Client {
 
 func (c *gitlabClientImpl) GetGroup(ctx context.Context, groupID interface{}) (*gitlab.Group, error) {
 	apiObj, _, err := c.c.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
+	return validateGroupAPIResp(apiObj, err)
+}
+
+func validateGroupAPIResp(apiObj *gitlab.Group, err error) (*gitlab.Group, error) {
+	// If the response contained an error, return
 	if err != nil {
-		return nil, err
+		return nil, handleHTTPError(err)
 	}
-	// Validate the API object
+	// Make sure apiObj is valid
 	if err := validateGroupAPI(apiObj); err != nil {
 		return nil, err
 	}
@@ -234,6 +471,51 @@ func (c *gitlabClientImpl) ListGroupMembers(ctx context.Context, groupName strin
 	return apiObjs, nil
 }
 
+func (c *gitlabClientImpl) CreateGroup(ctx context.Context, req *gitlab.CreateGroupOptions) (*gitlab.Group, error) {
+	// POST /groups
+	apiObj, _, err := c.c.Groups.CreateGroup(req, gitlab.WithContext(ctx))
+	return validateGroupAPIResp(apiObj, err)
+}
+
+func (c *gitlabClientImpl) UpdateGroup(ctx context.Context, groupName string, req *gitlab.UpdateGroupOptions) (*gitlab.Group, error) {
+	// PUT /groups/{group}
+	apiObj, _, err := c.c.Groups.UpdateGroup(groupName, req, gitlab.WithContext(ctx))
+	return validateGroupAPIResp(apiObj, err)
+}
+
+func (c *gitlabClientImpl) DeleteGroup(ctx context.Context, groupName string) error {
+	// Don't allow deleting groups if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete group: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /groups/{group}
+	_, err := c.c.Groups.DeleteGroup(groupName, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) AddGroupMember(ctx context.Context, groupName string, userID int, accessLevel gitlab.AccessLevelValue) error {
+	// POST /groups/{group}/members
+	_, _, err := c.c.GroupMembers.AddGroupMember(groupName, &gitlab.AddGroupMemberOptions{
+		UserID:      &userID,
+		AccessLevel: &accessLevel,
+	}, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) EditGroupMember(ctx context.Context, groupName string, userID int, accessLevel gitlab.AccessLevelValue) error {
+	// PUT /groups/{group}/members/{user_id}
+	_, _, err := c.c.GroupMembers.EditGroupMember(groupName, userID, &gitlab.EditGroupMemberOptions{
+		AccessLevel: &accessLevel,
+	}, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) RemoveGroupMember(ctx context.Context, groupName string, userID int) error {
+	// DELETE /groups/{group}/members/{user_id}
+	_, err := c.c.GroupMembers.RemoveGroupMember(groupName, userID, nil, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
 func (c *gitlabClientImpl) GetUserProject(ctx context.Context, projectName string) (*gitlab.Project, error) {
 	opts := &gitlab.GetProjectOptions{}
 	apiObj, _, err := c.c.Projects.GetProject(projectName, opts, gitlab.WithContext(ctx))
@@ -282,6 +564,50 @@ func (c *gitlabClientImpl) ListProjectUsers(ctx context.Context, projectName str
 	return apiObjs, nil
 }
 
+func (c *gitlabClientImpl) ListProjectMembers(ctx context.Context, projectName string) ([]*gitlab.ProjectMember, error) {
+	var apiObjs []*gitlab.ProjectMember
+	opts := &gitlab.ListProjectMembersOptions{}
+	err := allProjectMemberPages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/members
+		pageObjs, resp, listErr := c.c.ProjectMembers.ListProjectMembers(projectName, opts, gitlab.WithContext(ctx))
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) AddProjectMember(ctx context.Context, projectName string, userID int, accessLevel gitlab.AccessLevelValue) (*gitlab.ProjectMember, error) {
+	// POST /projects/{project}/members
+	apiObj, _, err := c.c.ProjectMembers.AddProjectMember(projectName, &gitlab.AddProjectMemberOptions{
+		UserID:      userID,
+		AccessLevel: &accessLevel,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) EditProjectMember(ctx context.Context, projectName string, userID int, accessLevel gitlab.AccessLevelValue) (*gitlab.ProjectMember, error) {
+	// PUT /projects/{project}/members/{user_id}
+	apiObj, _, err := c.c.ProjectMembers.EditProjectMember(projectName, userID, &gitlab.EditProjectMemberOptions{
+		AccessLevel: &accessLevel,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) RemoveProjectMember(ctx context.Context, projectName string, userID int) error {
+	// DELETE /projects/{project}/members/{user_id}
+	_, err := c.c.ProjectMembers.DeleteProjectMember(projectName, userID, gitlab.WithContext(ctx))
+	return handleHTTPError(err)
+}
+
 func (c *gitlabClientImpl) ListUserProjects(ctx context.Context, username string) ([]*gitlab.Project, error) {
 	var apiObjs []*gitlab.Project
 	opts := &gitlab.ListProjectsOptions{}
@@ -330,7 +656,41 @@ func (c *gitlabClientImpl) UpdateProject(ctx context.Context, req *gitlab.Projec
 		Description: &req.Description,
 		Visibility:  &req.Visibility,
 	}
+	// Projects without commits have no default branch
+	if req.DefaultBranch != "" {
+		opts.DefaultBranch = &req.DefaultBranch
+	}
+	// Only send the settings the server returned, to not reset them on older servers
+	if req.Topics != nil {
+		opts.Topics = &req.Topics
+	}
+	if req.IssuesAccessLevel != "" {
+		opts.IssuesAccessLevel = &req.IssuesAccessLevel
+	}
+	if req.WikiAccessLevel != "" {
+		opts.WikiAccessLevel = &req.WikiAccessLevel
+	}
+	opts.RemoveSourceBranchAfterMerge = &req.RemoveSourceBranchAfterMerge
 	apiObj, _, err := c.c.Projects.EditProject(req.ID, opts, gitlab.WithContext(ctx))
+	apiObj, err = validateProjectAPIResp(apiObj, err)
+	if err != nil {
+		return nil, err
+	}
+	// Archiving isn't a project setting, but a separate endpoint
+	if apiObj.Archived != req.Archived {
+		return c.SetProjectArchived(ctx, apiObj.ID, req.Archived)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) SetProjectArchived(ctx context.Context, projectID int, archived bool) (*gitlab.Project, error) {
+	if archived {
+		// POST /projects/{project}/archive
+		apiObj, _, err := c.c.Projects.ArchiveProject(projectID, gitlab.WithContext(ctx))
+		return validateProjectAPIResp(apiObj, err)
+	}
+	// POST /projects/{project}/unarchive
+	apiObj, _, err := c.c.Projects.UnarchiveProject(projectID, gitlab.WithContext(ctx))
 	return validateProjectAPIResp(apiObj, err)
 }
 
@@ -350,6 +710,20 @@ func (c *gitlabClientImpl) GetUser(ctx context.Context) (*gitlab.User, error) {
 	return proj, err
 }
 
+func (c *gitlabClientImpl) GetUserByUsername(ctx context.Context, username string) (*gitlab.User, error) {
+	// GET /users?username={username}
+	apiObjs, _, err := c.c.Users.ListUsers(&gitlab.ListUsersOptions{
+		Username: &username,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if len(apiObjs) == 0 {
+		return nil, fmt.Errorf("user %q: %w", username, gitprovider.ErrNotFound)
+	}
+	return apiObjs[0], nil
+}
+
 func (c *gitlabClientImpl) ListKeys(projectName string) ([]*gitlab.ProjectDeployKey, error) {
 	apiObjs := []*gitlab.ProjectDeployKey{}
 	opts := &gitlab.ListProjectDeployKeysOptions{}
@@ -442,6 +816,57 @@ func (c *gitlabClientImpl) DeleteToken(projectName string, keyID int) error {
 	return handleHTTPError(err)
 }
 
+func (c *gitlabClientImpl) ListHooks(projectName string) ([]*gitlab.ProjectHook, error) {
+	apiObjs := []*gitlab.ProjectHook{}
+	opts := &gitlab.ListProjectHooksOptions{}
+	err := allProjectHookPages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/hooks
+		pageObjs, resp, listErr := c.c.Projects.ListProjectHooks(projectName, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateWebhookAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) CreateHook(projectName string, req *gitlab.AddProjectHookOptions) (*gitlab.ProjectHook, error) {
+	// POST /projects/{project}/hooks
+	apiObj, _, err := c.c.Projects.AddProjectHook(projectName, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateWebhookAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) EditHook(projectName string, hookID int, req *gitlab.EditProjectHookOptions) (*gitlab.ProjectHook, error) {
+	// PUT /projects/{project}/hooks/{hook_id}
+	apiObj, _, err := c.c.Projects.EditProjectHook(projectName, hookID, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateWebhookAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) DeleteHook(projectName string, hookID int) error {
+	// DELETE /projects/{project}/hooks/{hook_id}
+	_, err := c.c.Projects.DeleteProjectHook(projectName, hookID)
+	return handleHTTPError(err)
+}
+
 func (c *gitlabClientImpl) ShareProject(projectName string, groupIDObj, groupAccessObj int) error {
 	groupAccess := gitlab.AccessLevel(gitlab.AccessLevelValue(groupAccessObj))
 	groupID := &groupIDObj
@@ -487,3 +912,603 @@ func (c *gitlabClientImpl) ListCommitsPage(projectName string, branch string, pe
 	}
 	return apiObjs, nil
 }
+
+func (c *gitlabClientImpl) ListCommitStatuses(projectName, sha string) ([]*gitlab.CommitStatus, error) {
+	apiObjs := []*gitlab.CommitStatus{}
+	opts := &gitlab.GetCommitStatusesOptions{
+		// Include the statuses replaced by newer ones with the same name
+		All: gitlab.Bool(true),
+	}
+	err := allCommitStatusPages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/repository/commits/{sha}/statuses
+		pageObjs, resp, listErr := c.c.Commits.GetCommitStatuses(projectName, sha, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateCommitStatusAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) SetCommitStatus(projectName, sha string, req *gitlab.SetCommitStatusOptions) (*gitlab.CommitStatus, error) {
+	// POST /projects/{project}/statuses/{sha}
+	apiObj, _, err := c.c.Commits.SetCommitStatus(projectName, sha, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateCommitStatusAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) ListBranches(projectName string) ([]*gitlab.Branch, error) {
+	apiObjs := []*gitlab.Branch{}
+	opts := &gitlab.ListBranchesOptions{}
+	err := allBranchPages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/repository/branches
+		pageObjs, resp, listErr := c.c.Branches.ListBranches(projectName, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateBranchAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) GetBranch(projectName, branch string) (*gitlab.Branch, error) {
+	// GET /projects/{project}/repository/branches/{branch}
+	apiObj, _, err := c.c.Branches.GetBranch(projectName, branch)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateBranchAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) DeleteBranch(projectName, branch string) error {
+	// Don't allow deleting branches if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete branch: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /projects/{project}/repository/branches/{branch}
+	_, err := c.c.Branches.DeleteBranch(projectName, branch)
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) ListProtectedBranches(projectName string) ([]*gitlab.ProtectedBranch, error) {
+	apiObjs := []*gitlab.ProtectedBranch{}
+	opts := &gitlab.ListProtectedBranchesOptions{}
+	err := allProtectedBranchPages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/protected_branches
+		pageObjs, resp, listErr := c.c.ProtectedBranches.ListProtectedBranches(projectName, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateProtectedBranchAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) GetProtectedBranch(projectName, branch string) (*gitlab.ProtectedBranch, error) {
+	// GET /projects/{project}/protected_branches/{name}
+	apiObj, _, err := c.c.ProtectedBranches.GetProtectedBranch(projectName, branch)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateProtectedBranchAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) ProtectBranch(projectName string, req *gitlab.ProtectRepositoryBranchesOptions) (*gitlab.ProtectedBranch, error) {
+	// POST /projects/{project}/protected_branches
+	apiObj, _, err := c.c.ProtectedBranches.ProtectRepositoryBranches(projectName, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateProtectedBranchAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) UnprotectBranch(projectName, branch string) error {
+	// DELETE /projects/{project}/protected_branches/{name}
+	_, err := c.c.ProtectedBranches.UnprotectRepositoryBranches(projectName, branch)
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) ListTags(projectName string) ([]*gitlab.Tag, error) {
+	apiObjs := []*gitlab.Tag{}
+	opts := &gitlab.ListTagsOptions{}
+	err := allTagPages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/repository/tags
+		pageObjs, resp, listErr := c.c.Tags.ListTags(projectName, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateTagAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) GetTag(projectName, tag string) (*gitlab.Tag, error) {
+	// GET /projects/{project}/repository/tags/{tag}
+	apiObj, _, err := c.c.Tags.GetTag(projectName, tag)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateTagAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) CreateTag(projectName string, req *gitlab.CreateTagOptions) (*gitlab.Tag, error) {
+	// POST /projects/{project}/repository/tags
+	apiObj, _, err := c.c.Tags.CreateTag(projectName, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateTagAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) DeleteTag(projectName, tag string) error {
+	// Don't allow deleting tags if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete tag: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /projects/{project}/repository/tags/{tag}
+	_, err := c.c.Tags.DeleteTag(projectName, tag)
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) ListReleases(projectName string) ([]*gitlab.Release, error) {
+	apiObjs := []*gitlab.Release{}
+	opts := &gitlab.ListReleasesOptions{}
+	err := allReleasePages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/releases
+		pageObjs, resp, listErr := c.c.Releases.ListReleases(projectName, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateReleaseAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) GetRelease(projectName, tag string) (*gitlab.Release, error) {
+	// GET /projects/{project}/releases/{tag}
+	apiObj, _, err := c.c.Releases.GetRelease(projectName, tag)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateReleaseAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) CreateRelease(projectName string, req *gitlab.CreateReleaseOptions) (*gitlab.Release, error) {
+	// POST /projects/{project}/releases
+	apiObj, _, err := c.c.Releases.CreateRelease(projectName, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateReleaseAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) UpdateRelease(projectName, tag string, req *gitlab.UpdateReleaseOptions) (*gitlab.Release, error) {
+	// PUT /projects/{project}/releases/{tag}
+	apiObj, _, err := c.c.Releases.UpdateRelease(projectName, tag, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	if err := validateReleaseAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) DeleteRelease(projectName, tag string) error {
+	// Don't allow deleting releases if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete release: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /projects/{project}/releases/{tag}
+	_, _, err := c.c.Releases.DeleteRelease(projectName, tag)
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) UploadFile(projectName, fileName string, content io.Reader) (*gitlab.ProjectFile, error) {
+	// POST /projects/{project}/uploads
+	apiObj, _, err := c.c.Projects.UploadFile(projectName, content, fileName)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) CreateReleaseLink(projectName, tag string, req *gitlab.CreateReleaseLinkOptions) (*gitlab.ReleaseLink, error) {
+	// POST /projects/{project}/releases/{tag}/assets/links
+	apiObj, _, err := c.c.ReleaseLinks.CreateReleaseLink(projectName, tag, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) ListMergeRequests(projectName string, opts *gitlab.ListProjectMergeRequestsOptions) ([]*gitlab.MergeRequest, error) {
+	apiObjs := []*gitlab.MergeRequest{}
+	err := allMergeRequestPages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/merge_requests
+		pageObjs, resp, listErr := c.c.MergeRequests.ListProjectMergeRequests(projectName, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) GetMergeRequest(projectName string, number int) (*gitlab.MergeRequest, error) {
+	// GET /projects/{project}/merge_requests/{merge_request_iid}
+	apiObj, _, err := c.c.MergeRequests.GetMergeRequest(projectName, number, &gitlab.GetMergeRequestsOptions{})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) UpdateMergeRequest(projectName string, number int, req *gitlab.UpdateMergeRequestOptions) (*gitlab.MergeRequest, error) {
+	// PUT /projects/{project}/merge_requests/{merge_request_iid}
+	apiObj, _, err := c.c.MergeRequests.UpdateMergeRequest(projectName, number, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) AcceptMergeRequest(projectName string, number int, req *gitlab.AcceptMergeRequestOptions) (*gitlab.MergeRequest, error) {
+	// PUT /projects/{project}/merge_requests/{merge_request_iid}/merge
+	apiObj, _, err := c.c.MergeRequests.AcceptMergeRequest(projectName, number, req)
+	if err != nil {
+		return nil, handleMergeError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) RebaseMergeRequest(projectName string, number int) error {
+	// PUT /projects/{project}/merge_requests/{merge_request_iid}/rebase
+	_, err := c.c.MergeRequests.RebaseMergeRequest(projectName, number)
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) GetMergeRequestRebase(projectName string, number int) (*gitlab.MergeRequest, error) {
+	// GET /projects/{project}/merge_requests/{merge_request_iid}?include_rebase_in_progress=true
+	apiObj, _, err := c.c.MergeRequests.GetMergeRequest(projectName, number, &gitlab.GetMergeRequestsOptions{
+		IncludeRebaseInProgress: gitlab.Bool(true),
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) CancelMergeWhenPipelineSucceeds(projectName string, number int) error {
+	// POST /projects/{project}/merge_requests/{merge_request_iid}/cancel_merge_when_pipeline_succeeds
+	_, _, err := c.c.MergeRequests.CancelMergeWhenPipelineSucceeds(projectName, number)
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) ListMergeRequestDiffs(projectName string, number int) ([]*gitlab.MergeRequestDiff, error) {
+	apiObjs := []*gitlab.MergeRequestDiff{}
+	opts := &gitlab.ListMergeRequestDiffsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+	}
+	err := allMergeRequestDiffPages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/merge_requests/{merge_request_iid}/diffs
+		pageObjs, resp, listErr := c.c.MergeRequests.ListMergeRequestDiffs(projectName, number, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) ListMergeRequestNotes(projectName string, number int) ([]*gitlab.Note, error) {
+	apiObjs := []*gitlab.Note{}
+	opts := &gitlab.ListMergeRequestNotesOptions{
+		OrderBy: gitlab.String("created_at"),
+		Sort:    gitlab.String("asc"),
+	}
+	err := allMergeRequestNotePages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/merge_requests/{merge_request_iid}/notes
+		pageObjs, resp, listErr := c.c.Notes.ListMergeRequestNotes(projectName, number, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) GetMergeRequestNote(projectName string, number, id int) (*gitlab.Note, error) {
+	// GET /projects/{project}/merge_requests/{merge_request_iid}/notes/{note_id}
+	apiObj, _, err := c.c.Notes.GetMergeRequestNote(projectName, number, id)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) CreateMergeRequestNote(projectName string, number int, body string) (*gitlab.Note, error) {
+	// POST /projects/{project}/merge_requests/{merge_request_iid}/notes
+	apiObj, _, err := c.c.Notes.CreateMergeRequestNote(projectName, number, &gitlab.CreateMergeRequestNoteOptions{
+		Body: &body,
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) UpdateMergeRequestNote(projectName string, number, id int, body string) (*gitlab.Note, error) {
+	// PUT /projects/{project}/merge_requests/{merge_request_iid}/notes/{note_id}
+	apiObj, _, err := c.c.Notes.UpdateMergeRequestNote(projectName, number, id, &gitlab.UpdateMergeRequestNoteOptions{
+		Body: &body,
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) DeleteMergeRequestNote(projectName string, number, id int) error {
+	// DELETE /projects/{project}/merge_requests/{merge_request_iid}/notes/{note_id}
+	_, err := c.c.Notes.DeleteMergeRequestNote(projectName, number, id)
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) ListIssues(projectName string, opts *gitlab.ListProjectIssuesOptions) ([]*gitlab.Issue, error) {
+	apiObjs := []*gitlab.Issue{}
+	err := allIssuePages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/issues
+		pageObjs, resp, listErr := c.c.Issues.ListProjectIssues(projectName, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) GetIssue(projectName string, number int) (*gitlab.Issue, error) {
+	// GET /projects/{project}/issues/{issue_iid}
+	apiObj, _, err := c.c.Issues.GetIssue(projectName, number)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) CreateIssue(projectName string, req *gitlab.CreateIssueOptions) (*gitlab.Issue, error) {
+	// POST /projects/{project}/issues
+	apiObj, _, err := c.c.Issues.CreateIssue(projectName, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) UpdateIssue(projectName string, number int, req *gitlab.UpdateIssueOptions) (*gitlab.Issue, error) {
+	// PUT /projects/{project}/issues/{issue_iid}
+	apiObj, _, err := c.c.Issues.UpdateIssue(projectName, number, req)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) ListMilestones(projectName, title string) ([]*gitlab.Milestone, error) {
+	apiObjs := []*gitlab.Milestone{}
+	opts := &gitlab.ListMilestonesOptions{
+		Title: &title,
+	}
+	err := allMilestonePages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/milestones
+		pageObjs, resp, listErr := c.c.Milestones.ListMilestones(projectName, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) ListIssueNotes(projectName string, number int) ([]*gitlab.Note, error) {
+	apiObjs := []*gitlab.Note{}
+	opts := &gitlab.ListIssueNotesOptions{
+		OrderBy: gitlab.String("created_at"),
+		Sort:    gitlab.String("asc"),
+	}
+	err := allIssueNotePages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/issues/{issue_iid}/notes
+		pageObjs, resp, listErr := c.c.Notes.ListIssueNotes(projectName, number, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) GetIssueNote(projectName string, number, id int) (*gitlab.Note, error) {
+	// GET /projects/{project}/issues/{issue_iid}/notes/{note_id}
+	apiObj, _, err := c.c.Notes.GetIssueNote(projectName, number, id)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) CreateIssueNote(projectName string, number int, body string) (*gitlab.Note, error) {
+	// POST /projects/{project}/issues/{issue_iid}/notes
+	apiObj, _, err := c.c.Notes.CreateIssueNote(projectName, number, &gitlab.CreateIssueNoteOptions{
+		Body: &body,
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) UpdateIssueNote(projectName string, number, id int, body string) (*gitlab.Note, error) {
+	// PUT /projects/{project}/issues/{issue_iid}/notes/{note_id}
+	apiObj, _, err := c.c.Notes.UpdateIssueNote(projectName, number, id, &gitlab.UpdateIssueNoteOptions{
+		Body: &body,
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) DeleteIssueNote(projectName string, number, id int) error {
+	// DELETE /projects/{project}/issues/{issue_iid}/notes/{note_id}
+	_, err := c.c.Notes.DeleteIssueNote(projectName, number, id)
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) ListLabels(projectName string) ([]*gitlab.Label, error) {
+	apiObjs := []*gitlab.Label{}
+	opts := &gitlab.ListLabelsOptions{
+		IncludeAncestorGroups: gitlab.Bool(false),
+	}
+	err := allLabelPages(opts, func() (*gitlab.Response, error) {
+		// GET /projects/{project}/labels
+		pageObjs, resp, listErr := c.c.Labels.ListLabels(projectName, opts)
+		apiObjs = append(apiObjs, pageObjs...)
+		return resp, listErr
+	})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateLabelAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+func (c *gitlabClientImpl) GetLabel(projectName, name string) (*gitlab.Label, error) {
+	// GET /projects/{project}/labels/{label_id}
+	apiObj, _, err := c.c.Labels.GetLabel(projectName, name)
+	return validateLabelAPIResp(apiObj, err)
+}
+
+func (c *gitlabClientImpl) CreateLabel(projectName string, req *gitlab.CreateLabelOptions) (*gitlab.Label, error) {
+	// POST /projects/{project}/labels
+	apiObj, _, err := c.c.Labels.CreateLabel(projectName, req)
+	return validateLabelAPIResp(apiObj, err)
+}
+
+func (c *gitlabClientImpl) UpdateLabel(projectName string, req *gitlab.UpdateLabelOptions) (*gitlab.Label, error) {
+	// PUT /projects/{project}/labels
+	apiObj, _, err := c.c.Labels.UpdateLabel(projectName, req)
+	return validateLabelAPIResp(apiObj, err)
+}
+
+func validateLabelAPIResp(apiObj *gitlab.Label, err error) (*gitlab.Label, error) {
+	// If the response contained an error, return
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	// Make sure apiObj is valid
+	if err := validateLabelAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) DeleteLabel(projectName string, labelID int) error {
+	// Don't allow deleting labels if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete label: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	// DELETE /projects/{project}/labels/{label_id}
+	_, err := c.c.Labels.DeleteLabel(projectName, labelID, nil)
+	return handleHTTPError(err)
+}
+
+func (c *gitlabClientImpl) GetMergeRequestApprovals(projectName string, number int) (*gitlab.MergeRequestApprovals, error) {
+	// GET /projects/{project}/merge_requests/{merge_request_iid}/approvals
+	apiObj, _, err := c.c.MergeRequestApprovals.GetConfiguration(projectName, number)
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
+
+func (c *gitlabClientImpl) ApproveMergeRequest(projectName string, number int) (*gitlab.MergeRequestApprovals, error) {
+	// POST /projects/{project}/merge_requests/{merge_request_iid}/approve
+	apiObj, _, err := c.c.MergeRequestApprovals.ApproveMergeRequest(projectName, number, &gitlab.ApproveMergeRequestOptions{})
+	if err != nil {
+		return nil, handleHTTPError(err)
+	}
+	return apiObj, nil
+}
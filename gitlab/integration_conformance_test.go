@@ -0,0 +1,53 @@
+//go:build e2e
+
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"os"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	token := os.Getenv("GITLAB_TOKEN")
+	org := os.Getenv("GIT_PROVIDER_ORGANIZATION")
+	if token == "" || org == "" {
+		t.Skip("GITLAB_TOKEN and GIT_PROVIDER_ORGANIZATION must be set")
+	}
+	domain := DefaultDomain
+	if baseURL := os.Getenv("GITLAB_BASE_URL"); baseURL != "" {
+		domain = baseURL
+	}
+
+	conformance.Run(t, conformance.Config{
+		NewClient: func(destructive bool) (gitprovider.Client, error) {
+			return NewClient(token, "",
+				gitprovider.WithDomain(domain),
+				gitprovider.WithDestructiveAPICalls(destructive),
+			)
+		},
+		Organization: gitprovider.OrganizationRef{Domain: domain, Organization: org},
+		Team:         os.Getenv("GITLAB_TEST_TEAM_NAME"),
+		Skip: map[string]string{
+			"PullRequests/GetNotFound": "merge request calls return raw go-gitlab errors",
+		},
+	})
+}
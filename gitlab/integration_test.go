@@ -947,7 +947,7 @@ var _ = Describe("GitLab Provider", func() {
 		Expect(pr.Get().WebURL).ToNot(BeEmpty())
 		Expect(pr.Get().Merged).To(BeFalse())
 		Expect(pr.Get().SourceBranch).To(Equal(branchName))
-		err = userRepo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodSquash, "squash merged")
+		_, err = userRepo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodSquash, "squash merged")
 		Expect(err).ToNot(HaveOccurred())
 
 		expectPRToBeMerged(ctx, userRepo, pr.Get().Number)
@@ -987,7 +987,7 @@ var _ = Describe("GitLab Provider", func() {
 		})
 		Expect(err).ToNot(HaveOccurred())
 
-		err = userRepo.PullRequests().Merge(ctx, editedPR.Get().Number, gitprovider.MergeMethodMerge, "merged")
+		_, err = userRepo.PullRequests().Merge(ctx, editedPR.Get().Number, gitprovider.MergeMethodMerge, "merged")
 		Expect(err).ToNot(HaveOccurred())
 
 		getPR, err := userRepo.PullRequests().Get(ctx, editedPR.Get().Number)
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newBranchProtection(c *BranchProtectionClient, apiObj *gitlab.ProtectedBranch, logins map[int]string) *branchProtection {
+	pushAllowances := []string{}
+	for _, level := range apiObj.PushAccessLevels {
+		if login, ok := logins[level.UserID]; ok && level.UserID != 0 {
+			pushAllowances = append(pushAllowances, login)
+		}
+	}
+	sort.Strings(pushAllowances)
+	return &branchProtection{
+		p:              *apiObj,
+		pushAllowances: pushAllowances,
+		c:              c,
+	}
+}
+
+var _ gitprovider.BranchProtection = &branchProtection{}
+
+type branchProtection struct {
+	p gitlab.ProtectedBranch
+	// pushAllowances are the logins of the users allowed to push. GitLab only returns the IDs
+	// of these users, hence they're resolved when the object is fetched or set.
+	pushAllowances []string
+	c              *BranchProtectionClient
+}
+
+func (bp *branchProtection) Get() gitprovider.BranchProtectionInfo {
+	return branchProtectionFromAPI(&bp.p, bp.pushAllowances)
+}
+
+func (bp *branchProtection) Set(info gitprovider.BranchProtectionInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	return branchProtectionInfoToAPIObj(&info, bp)
+}
+
+func (bp *branchProtection) APIObject() interface{} {
+	return &bp.p
+}
+
+func (bp *branchProtection) Repository() gitprovider.RepositoryRef {
+	return bp.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (bp *branchProtection) Update(ctx context.Context) error {
+	opts, err := bp.protectOptions(ctx)
+	if err != nil {
+		return err
+	}
+	// GitLab can't update the access levels of a protected branch in place,
+	// hence unprotect the branch and protect it again with the desired state.
+	// DELETE /projects/{project}/protected_branches/{name}
+	if err := bp.c.c.UnprotectBranch(getRepoPath(bp.c.ref), bp.p.Name); err != nil {
+		return err
+	}
+	return bp.protect(opts)
+}
+
+// Delete removes the protection of the branch.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (bp *branchProtection) Delete(_ context.Context) error {
+	// DELETE /projects/{project}/protected_branches/{name}
+	return bp.c.c.UnprotectBranch(getRepoPath(bp.c.ref), bp.p.Name)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (bp *branchProtection) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := bp.c.get(ctx, bp.p.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, bp.createIntoSelf(ctx)
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if bp.Get().Equals(actual.Get()) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	return true, bp.Update(ctx)
+}
+
+func (bp *branchProtection) createIntoSelf(ctx context.Context) error {
+	opts, err := bp.protectOptions(ctx)
+	if err != nil {
+		return err
+	}
+	return bp.protect(opts)
+}
+
+func (bp *branchProtection) protect(opts *gitlab.ProtectRepositoryBranchesOptions) error {
+	// POST /projects/{project}/protected_branches
+	apiObj, err := bp.c.c.ProtectBranch(getRepoPath(bp.c.ref), opts)
+	if err != nil {
+		return err
+	}
+	bp.p = *apiObj
+	return nil
+}
+
+// protectOptions builds the request protecting the branch as described by bp. The users allowed
+// to push are taken from bp.pushAllowances, and resolved to their IDs.
+func (bp *branchProtection) protectOptions(ctx context.Context) (*gitlab.ProtectRepositoryBranchesOptions, error) {
+	userIDs, err := bp.c.userIDs(ctx, bp.pushAllowances)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &gitlab.ProtectRepositoryBranchesOptions{
+		Name:           &bp.p.Name,
+		AllowForcePush: &bp.p.AllowForcePush,
+	}
+	// The options below require GitLab Premium, hence only send them if they're in use
+	if bp.p.CodeOwnerApprovalRequired {
+		opts.CodeOwnerApprovalRequired = &bp.p.CodeOwnerApprovalRequired
+	}
+	pushLevel, allowedToPush := accessLevelOptions(bp.p.PushAccessLevels, false)
+	for _, id := range userIDs {
+		allowedToPush = append(allowedToPush, &gitlab.BranchPermissionOptions{UserID: gitlab.Int(id)})
+	}
+	opts.PushAccessLevel = pushLevel
+	if len(allowedToPush) > 0 {
+		opts.AllowedToPush = &allowedToPush
+	}
+	mergeLevel, allowedToMerge := accessLevelOptions(bp.p.MergeAccessLevels, true)
+	opts.MergeAccessLevel = mergeLevel
+	if len(allowedToMerge) > 0 {
+		opts.AllowedToMerge = &allowedToMerge
+	}
+	unprotectLevel, allowedToUnprotect := accessLevelOptions(bp.p.UnprotectAccessLevels, true)
+	opts.UnprotectAccessLevel = unprotectLevel
+	if len(allowedToUnprotect) > 0 {
+		opts.AllowedToUnprotect = &allowedToUnprotect
+	}
+	return opts, nil
+}
+
+// accessLevelOptions splits the given access levels into the role-based access level, and the
+// list of users and groups given access. Users are only included if includeUsers is true.
+func accessLevelOptions(levels []*gitlab.BranchAccessDescription, includeUsers bool) (*gitlab.AccessLevelValue, []*gitlab.BranchPermissionOptions) {
+	var role *gitlab.AccessLevelValue
+	allowed := []*gitlab.BranchPermissionOptions{}
+	for _, level := range levels {
+		switch {
+		case level.GroupID != 0:
+			allowed = append(allowed, &gitlab.BranchPermissionOptions{GroupID: gitlab.Int(level.GroupID)})
+		case level.UserID != 0:
+			if includeUsers {
+				allowed = append(allowed, &gitlab.BranchPermissionOptions{UserID: gitlab.Int(level.UserID)})
+			}
+		case role == nil:
+			role = gitlab.AccessLevel(level.AccessLevel)
+		}
+	}
+	return role, allowed
+}
+
+func validateProtectedBranchAPI(apiObj *gitlab.ProtectedBranch) error {
+	return validateAPIObject("GitLab.ProtectedBranch", func(validator validation.Validator) {
+		// Make sure the name is populated as per
+		// https://docs.gitlab.com/ee/api/protected_branches.html#get-a-single-protected-branch-or-wildcard-protected-branch
+		if apiObj.Name == "" {
+			validator.Required("Name")
+		}
+	})
+}
+
+// pushesRestricted returns true if developers can't push to the branch.
+func pushesRestricted(apiObj *gitlab.ProtectedBranch) bool {
+	for _, level := range apiObj.PushAccessLevels {
+		if level.UserID == 0 && level.GroupID == 0 &&
+			level.AccessLevel > gitlab.NoPermissions && level.AccessLevel <= gitlab.DeveloperPermissions {
+			return false
+		}
+	}
+	return true
+}
+
+func branchProtectionFromAPI(apiObj *gitlab.ProtectedBranch, pushAllowances []string) gitprovider.BranchProtectionInfo {
+	info := gitprovider.BranchProtectionInfo{
+		Branch: apiObj.Name,
+		// GitLab configures approvals and status checks per project rather than per branch,
+		// and never lets protected branches be deleted by pushes
+		RequiredApprovingReviewCount: gitprovider.IntVar(0),
+		RestrictPushes:               gitprovider.BoolVar(pushesRestricted(apiObj)),
+		AllowForcePushes:             gitprovider.BoolVar(apiObj.AllowForcePush),
+		AllowDeletions:               gitprovider.BoolVar(false),
+		IncludeAdmins:                gitprovider.BoolVar(false),
+	}
+	if len(pushAllowances) > 0 {
+		info.PushAllowances = append([]string{}, pushAllowances...)
+	}
+	return info
+}
+
+func branchProtectionInfoToAPIObj(info *gitprovider.BranchProtectionInfo, bp *branchProtection) error {
+	if info.RequiredApprovingReviewCount != nil && *info.RequiredApprovingReviewCount > 0 {
+		return fmt.Errorf("required approving reviews: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if len(info.RequiredStatusChecks) > 0 {
+		return fmt.Errorf("required status checks: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.AllowDeletions != nil && *info.AllowDeletions {
+		return fmt.Errorf("allowing deletions of protected branches: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.IncludeAdmins != nil && *info.IncludeAdmins {
+		return fmt.Errorf("enforcing branch protections for administrators: %w", gitprovider.ErrNoProviderSupport)
+	}
+	// Required fields, we assume info is validated, and hence these are set
+	bp.p.Name = info.Branch
+	// optional fields
+	if info.AllowForcePushes != nil {
+		bp.p.AllowForcePush = *info.AllowForcePushes
+	}
+	if info.RestrictPushes != nil {
+		// Keep the groups allowed to push, and replace the role-based access level
+		levels := []*gitlab.BranchAccessDescription{}
+		for _, level := range bp.p.PushAccessLevels {
+			if level.GroupID != 0 {
+				levels = append(levels, level)
+			}
+		}
+		role := gitlab.DeveloperPermissions
+		if *info.RestrictPushes {
+			role = gitlab.NoPermissions
+		}
+		bp.p.PushAccessLevels = append(levels, &gitlab.BranchAccessDescription{AccessLevel: role})
+		bp.pushAllowances = append([]string{}, info.PushAllowances...)
+		sort.Strings(bp.pushAllowances)
+	}
+	return nil
+}
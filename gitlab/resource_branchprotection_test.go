@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func Test_branchProtectionInfoToAPIObj(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    gitprovider.BranchProtectionInfo
+		apiObj  gitlab.ProtectedBranch
+		wantErr error
+	}{
+		{
+			name: "defaults",
+			info: gitprovider.BranchProtectionInfo{Branch: "main"},
+		},
+		{
+			name: "restricted pushes",
+			info: gitprovider.BranchProtectionInfo{
+				Branch:           "main",
+				RestrictPushes:   gitprovider.BoolVar(true),
+				PushAllowances:   []string{"bob", "alice"},
+				AllowForcePushes: gitprovider.BoolVar(true),
+			},
+			apiObj: gitlab.ProtectedBranch{
+				PushAccessLevels: []*gitlab.BranchAccessDescription{
+					{AccessLevel: gitlab.MaintainerPermissions},
+					{UserID: 42},
+					{GroupID: 7},
+				},
+			},
+		},
+		{
+			name: "required reviews",
+			info: gitprovider.BranchProtectionInfo{
+				Branch:                       "main",
+				RequiredApprovingReviewCount: gitprovider.IntVar(1),
+			},
+			wantErr: gitprovider.ErrNoProviderSupport,
+		},
+		{
+			name: "deletions",
+			info: gitprovider.BranchProtectionInfo{
+				Branch:         "main",
+				AllowDeletions: gitprovider.BoolVar(true),
+			},
+			wantErr: gitprovider.ErrNoProviderSupport,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := gitprovider.ValidateAndDefaultInfo(&tt.info); err != nil {
+				t.Fatal(err)
+			}
+			bp := &branchProtection{p: tt.apiObj}
+			err := branchProtectionInfoToAPIObj(&tt.info, bp)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("branchProtectionInfoToAPIObj() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			// The groups allowed to push are kept, the roles and users replaced
+			for _, level := range bp.p.PushAccessLevels {
+				if level.UserID != 0 {
+					t.Errorf("unexpected user access level %+v", level)
+				}
+			}
+			// The generic information must survive the round trip
+			if got := bp.Get(); !tt.info.Equals(got) {
+				t.Errorf("Get() = %+v, want %+v", got, tt.info)
+			}
+		})
+	}
+}
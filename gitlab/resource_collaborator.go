@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newCollaborator(c *CollaboratorClient, apiObj *gitlab.ProjectMember) (*collaborator, error) {
+	permission, err := getGitProviderPermission(int(apiObj.AccessLevel))
+	if err != nil {
+		return nil, err
+	}
+	return &collaborator{
+		m: *apiObj,
+		info: gitprovider.CollaboratorInfo{
+			Login:      apiObj.Username,
+			Permission: permission,
+		},
+		c: c,
+	}, nil
+}
+
+var _ gitprovider.Collaborator = &collaborator{}
+
+type collaborator struct {
+	m    gitlab.ProjectMember
+	info gitprovider.CollaboratorInfo
+	c    *CollaboratorClient
+}
+
+func (c *collaborator) Get() gitprovider.CollaboratorInfo {
+	return c.info
+}
+
+func (c *collaborator) Set(info gitprovider.CollaboratorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	c.info = info
+	return nil
+}
+
+func (c *collaborator) APIObject() interface{} {
+	return &c.m
+}
+
+func (c *collaborator) Repository() gitprovider.RepositoryRef {
+	return c.c.ref
+}
+
+// Delete removes the user from the direct members of the project.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Delete(ctx context.Context) error {
+	// DELETE /projects/{project}/members/{user_id}
+	return c.c.c.RemoveProjectMember(ctx, getRepoPath(c.c.ref), c.m.ID)
+}
+
+// Update will apply the desired permission to the member.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&c.info); err != nil {
+		return err
+	}
+	accessLevel, err := getGitlabPermission(*c.info.Permission)
+	if err != nil {
+		return err
+	}
+	// PUT /projects/{project}/members/{user_id}
+	apiObj, err := c.c.c.EditProjectMember(ctx, getRepoPath(c.c.ref), c.m.ID, gitlab.AccessLevelValue(accessLevel))
+	if err != nil {
+		return err
+	}
+	resp, err := newCollaborator(c.c, apiObj)
+	if err != nil {
+		return err
+	}
+	*c = *resp
+	return nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *collaborator) Reconcile(ctx context.Context) (bool, error) {
+	req := c.Get()
+	actual, err := c.c.get(ctx, req.Login)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.c.Create(ctx, req)
+			if err != nil {
+				return true, err
+			}
+			*c = *resp.(*collaborator)
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// The member ID is needed to update the member
+	c.m = actual.m
+	// If the desired matches the actual state, do nothing
+	if req.Equals(actual.Get()) {
+		return false, nil
+	}
+
+	return true, c.Update(ctx)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newCommitStatus(c *CommitStatusClient, status *gitlab.CommitStatus) *commitStatus {
+	return &commitStatus{
+		s: *status,
+		c: c,
+	}
+}
+
+var _ gitprovider.CommitStatus = &commitStatus{}
+
+type commitStatus struct {
+	s gitlab.CommitStatus
+	c *CommitStatusClient
+}
+
+// Get returns the commit status information.
+func (s *commitStatus) Get() gitprovider.CommitStatusInfo {
+	return commitStatusFromAPI(&s.s)
+}
+
+// APIObject returns the underlying value that was returned from the server.
+func (s *commitStatus) APIObject() interface{} {
+	return &s.s
+}
+
+// Repository returns the repository reference.
+func (s *commitStatus) Repository() gitprovider.RepositoryRef {
+	return s.c.ref
+}
+
+// commitStatusStates maps the GitLab pipeline states onto the generic commit status states.
+//
+//nolint:gochecknoglobals
+var commitStatusStates = map[gitlab.BuildStateValue]gitprovider.CommitStatusState{
+	gitlab.Created:            gitprovider.CommitStatusStatePending,
+	gitlab.WaitingForResource: gitprovider.CommitStatusStatePending,
+	gitlab.Preparing:          gitprovider.CommitStatusStatePending,
+	gitlab.Pending:            gitprovider.CommitStatusStatePending,
+	gitlab.Running:            gitprovider.CommitStatusStatePending,
+	gitlab.Manual:             gitprovider.CommitStatusStatePending,
+	gitlab.Scheduled:          gitprovider.CommitStatusStatePending,
+	gitlab.Success:            gitprovider.CommitStatusStateSuccess,
+	gitlab.Skipped:            gitprovider.CommitStatusStateSuccess,
+	gitlab.Failed:             gitprovider.CommitStatusStateFailure,
+	gitlab.Canceled:           gitprovider.CommitStatusStateError,
+}
+
+// validateCommitStatusAPI validates the apiObj received from the server, to make sure that it is
+// valid for our use.
+func validateCommitStatusAPI(apiObj *gitlab.CommitStatus) error {
+	return validateAPIObject("GitLab.CommitStatus", func(validator validation.Validator) {
+		if _, ok := commitStatusStates[gitlab.BuildStateValue(apiObj.Status)]; !ok {
+			validator.Invalid(apiObj.Status, "Status")
+		}
+	})
+}
+
+func commitStatusFromAPI(apiObj *gitlab.CommitStatus) gitprovider.CommitStatusInfo {
+	info := gitprovider.CommitStatusInfo{
+		State:       commitStatusStates[gitlab.BuildStateValue(apiObj.Status)],
+		Context:     apiObj.Name,
+		Description: apiObj.Description,
+		TargetURL:   apiObj.TargetURL,
+	}
+	if apiObj.CreatedAt != nil {
+		info.CreatedAt = *apiObj.CreatedAt
+	}
+	return info
+}
+
+func commitStatusToAPI(info *gitprovider.CommitStatusInfo) *gitlab.SetCommitStatusOptions {
+	opts := &gitlab.SetCommitStatusOptions{
+		Name: gitlab.String(info.Context),
+	}
+	switch info.State {
+	case gitprovider.CommitStatusStatePending:
+		opts.State = gitlab.Pending
+	case gitprovider.CommitStatusStateSuccess:
+		opts.State = gitlab.Success
+	case gitprovider.CommitStatusStateFailure:
+		opts.State = gitlab.Failed
+	case gitprovider.CommitStatusStateError:
+		// GitLab has no error state, a canceled pipeline is the closest match
+		opts.State = gitlab.Canceled
+	}
+	if info.Description != "" {
+		opts.Description = gitlab.String(info.Description)
+	}
+	if info.TargetURL != "" {
+		opts.TargetURL = gitlab.String(info.TargetURL)
+	}
+	return opts
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssue(c *IssueClient, apiObj *gitlab.Issue) *issue {
+	return &issue{
+		i: *apiObj,
+		comments: &IssueCommentClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.IID,
+		},
+	}
+}
+
+var _ gitprovider.Issue = &issue{}
+
+type issue struct {
+	i        gitlab.Issue
+	comments *IssueCommentClient
+}
+
+func (i *issue) Get() gitprovider.IssueInfo {
+	return issueFromAPI(&i.i)
+}
+
+func (i *issue) APIObject() interface{} {
+	return &i.i
+}
+
+func (i *issue) Comments() gitprovider.IssueCommentClient {
+	return i.comments
+}
+
+func issueFromAPI(apiObj *gitlab.Issue) gitprovider.IssueInfo {
+	info := gitprovider.IssueInfo{
+		Number:      apiObj.IID,
+		Title:       apiObj.Title,
+		Description: apiObj.Description,
+		State:       gitprovider.IssueStateOpen,
+		WebURL:      apiObj.WebURL,
+	}
+	if apiObj.State == closedState {
+		info.State = gitprovider.IssueStateClosed
+		info.ClosedAt = apiObj.ClosedAt
+	}
+	if apiObj.Author != nil {
+		info.Author = apiObj.Author.Username
+	}
+	if apiObj.Milestone != nil {
+		info.Milestone = apiObj.Milestone.Title
+	}
+	if len(apiObj.Labels) > 0 {
+		info.Labels = append([]string{}, apiObj.Labels...)
+	}
+	for _, user := range apiObj.Assignees {
+		info.Assignees = append(info.Assignees, user.Username)
+	}
+	if apiObj.CreatedAt != nil {
+		info.CreatedAt = *apiObj.CreatedAt
+	}
+	if apiObj.UpdatedAt != nil {
+		info.UpdatedAt = *apiObj.UpdatedAt
+	}
+	return info
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssueComment(c *IssueCommentClient, apiObj *gitlab.Note) *issueComment {
+	return &issueComment{
+		n: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.IssueComment = &issueComment{}
+
+type issueComment struct {
+	n gitlab.Note
+	c *IssueCommentClient
+}
+
+func (ic *issueComment) Get() gitprovider.IssueCommentInfo {
+	return issueCommentFromAPI(&ic.n)
+}
+
+func (ic *issueComment) Set(info gitprovider.IssueCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	ic.n.Body = info.Body
+	return nil
+}
+
+func (ic *issueComment) APIObject() interface{} {
+	return &ic.n
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (ic *issueComment) Update(_ context.Context) error {
+	// PUT /projects/{project}/issues/{issue_iid}/notes/{note_id}
+	apiObj, err := ic.c.c.UpdateIssueNote(getRepoPath(ic.c.ref), ic.c.number, ic.n.ID, ic.n.Body)
+	if err != nil {
+		return err
+	}
+	ic.n = *apiObj
+	return nil
+}
+
+// Delete deletes the note.
+//
+// ErrNotFound is returned if the resource doesn't exist anymore.
+func (ic *issueComment) Delete(_ context.Context) error {
+	// DELETE /projects/{project}/issues/{issue_iid}/notes/{note_id}
+	return ic.c.c.DeleteIssueNote(getRepoPath(ic.c.ref), ic.c.number, ic.n.ID)
+}
+
+func issueCommentFromAPI(apiObj *gitlab.Note) gitprovider.IssueCommentInfo {
+	info := gitprovider.IssueCommentInfo{
+		ID:     int64(apiObj.ID),
+		Body:   apiObj.Body,
+		Author: apiObj.Author.Username,
+	}
+	if apiObj.CreatedAt != nil {
+		info.CreatedAt = *apiObj.CreatedAt
+	}
+	if apiObj.UpdatedAt != nil {
+		info.UpdatedAt = *apiObj.UpdatedAt
+	}
+	return info
+}
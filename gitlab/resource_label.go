@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newLabel(c *LabelClient, apiObj *gitlab.Label) *label {
+	return &label{
+		l:    *apiObj,
+		name: apiObj.Name,
+		c:    c,
+	}
+}
+
+var _ gitprovider.Label = &label{}
+
+type label struct {
+	l gitlab.Label
+	// name is the name of the label on the server, which differs from the one in l if it's renamed.
+	name string
+	c    *LabelClient
+}
+
+func (l *label) Get() gitprovider.LabelInfo {
+	return labelFromAPI(&l.l)
+}
+
+func (l *label) Set(info gitprovider.LabelInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	info.Default()
+	labelInfoToAPIObj(&info, &l.l)
+	return nil
+}
+
+func (l *label) APIObject() interface{} {
+	return &l.l
+}
+
+func (l *label) Repository() gitprovider.RepositoryRef {
+	return l.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (l *label) Update(_ context.Context) error {
+	opts := &gitlab.UpdateLabelOptions{
+		Name:        &l.name,
+		Color:       &l.l.Color,
+		Description: &l.l.Description,
+	}
+	if l.l.Name != l.name {
+		opts.NewName = &l.l.Name
+	}
+	// PUT /projects/{project}/labels
+	apiObj, err := l.c.c.UpdateLabel(getRepoPath(l.c.ref), opts)
+	if err != nil {
+		return err
+	}
+	l.l, l.name = *apiObj, apiObj.Name
+	return nil
+}
+
+// Delete deletes the label from the repository, removing it from all issues and merge requests.
+//
+// ErrDestructiveCallDisallowed is returned unless destructive API calls are enabled.
+// ErrNotFound is returned if the resource does not exist.
+func (l *label) Delete(_ context.Context) error {
+	// DELETE /projects/{project}/labels/{label_id}
+	return l.c.c.DeleteLabel(getRepoPath(l.c.ref), l.l.ID)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (l *label) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := l.c.get(l.l.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, l.createIntoSelf()
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if l.Get().Equals(actual.Get()) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	l.l.ID, l.name = actual.l.ID, actual.name
+	return true, l.Update(ctx)
+}
+
+func (l *label) createIntoSelf() error {
+	// POST /projects/{project}/labels
+	apiObj, err := l.c.c.CreateLabel(getRepoPath(l.c.ref), &gitlab.CreateLabelOptions{
+		Name:        &l.l.Name,
+		Color:       &l.l.Color,
+		Description: &l.l.Description,
+	})
+	if err != nil {
+		return err
+	}
+	l.l, l.name = *apiObj, apiObj.Name
+	return nil
+}
+
+func validateLabelAPI(apiObj *gitlab.Label) error {
+	return validateAPIObject("GitLab.Label", func(validator validation.Validator) {
+		// Make sure the name and color are populated as per
+		// https://docs.gitlab.com/ee/api/labels.html#get-a-single-project-label
+		if apiObj.Name == "" {
+			validator.Required("Name")
+		}
+		if apiObj.Color == "" {
+			validator.Required("Color")
+		}
+	})
+}
+
+func labelFromAPI(apiObj *gitlab.Label) gitprovider.LabelInfo {
+	info := gitprovider.LabelInfo{
+		Name:        apiObj.Name,
+		Color:       apiObj.Color,
+		Description: apiObj.Description,
+	}
+	info.Default()
+	return info
+}
+
+func labelInfoToAPIObj(info *gitprovider.LabelInfo, apiObj *gitlab.Label) {
+	apiObj.Name = info.Name
+	apiObj.Color = labelColorToAPI(info.Color)
+	apiObj.Description = info.Description
+}
+
+// labelColorToAPI returns the defaulted color in GitLab's notation, which requires the leading "#".
+func labelColorToAPI(color string) string {
+	return "#" + color
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package gitlab
 
 import (
+	"strings"
+
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -63,10 +65,16 @@ func (o *organization) Teams() gitprovider.TeamsClient {
 }
 
 func organizationFromAPI(apiObj *gitlab.Group) gitprovider.OrganizationInfo {
-	return gitprovider.OrganizationInfo{
+	info := gitprovider.OrganizationInfo{
 		Name:        &apiObj.Name,
 		Description: &apiObj.Description,
+		Visibility:  gitprovider.OrganizationVisibilityVar(gitprovider.OrganizationVisibility(apiObj.Visibility)),
+	}
+	// The full path of subgroups ends with their own path
+	if apiObj.ParentID != 0 {
+		info.Parent = gitlab.String(strings.TrimSuffix(apiObj.FullPath, "/"+apiObj.Path))
 	}
+	return info
 }
 
 // validateOrganizationAPI validates the apiObj received from the server, to make sure that it is
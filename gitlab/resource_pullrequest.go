@@ -17,17 +17,42 @@ limitations under the License.
 package gitlab
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/xanzy/go-gitlab"
 )
 
-// The value of the "State" field of a gitlab merge request after it has been merged"
-const mergedState = "merged"
+const (
+	// The value of the "State" field of a gitlab merge request after it has been merged"
+	mergedState = "merged"
+	// The value of the "State" field of a gitlab merge request that is open
+	openedState = "opened"
+	// The value of the "State" field of a gitlab merge request that was closed without merging
+	closedState = "closed"
+
+	// The values of the "MergeStatus" field once gitlab has checked the merge request for conflicts
+	mergeStatusCanBeMerged    = "can_be_merged"
+	mergeStatusCannotBeMerged = "cannot_be_merged"
+)
 
-func newPullRequest(ctx *clientContext, apiObj *gitlab.MergeRequest) *pullrequest {
+func newPullRequest(c *PullRequestClient, apiObj *gitlab.MergeRequest) *pullrequest {
 	return &pullrequest{
-		clientContext: ctx,
+		clientContext: c.clientContext,
 		pr:            *apiObj,
+		ref:           c.ref,
+		comments: &PullRequestCommentClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.IID,
+		},
+		reviews: &PullRequestReviewClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        apiObj.IID,
+		},
 	}
 }
 
@@ -36,7 +61,10 @@ var _ gitprovider.PullRequest = &pullrequest{}
 type pullrequest struct {
 	*clientContext
 
-	pr gitlab.MergeRequest
+	pr       gitlab.MergeRequest
+	ref      gitprovider.RepositoryRef
+	comments *PullRequestCommentClient
+	reviews  *PullRequestReviewClient
 }
 
 func (pr *pullrequest) Get() gitprovider.PullRequestInfo {
@@ -47,13 +75,178 @@ func (pr *pullrequest) APIObject() interface{} {
 	return &pr.pr
 }
 
+func (pr *pullrequest) Comments() gitprovider.PullRequestCommentClient {
+	return pr.comments
+}
+
+func (pr *pullrequest) Reviews() gitprovider.PullRequestReviewClient {
+	return pr.reviews
+}
+
+func (pr *pullrequest) Files(ctx context.Context) (*gitprovider.PullRequestFiles, error) {
+	// GET /projects/{project}/merge_requests/{merge_request_iid}/diffs
+	apiObjs, err := pr.c.ListMergeRequestDiffs(getRepoPath(pr.ref), pr.pr.IID)
+	if err != nil {
+		return nil, err
+	}
+	// The changes count isn't returned when listing merge requests, hence get it from the server.
+	// GET /projects/{project}/merge_requests/{merge_request_iid}
+	mr, err := pr.c.GetMergeRequest(getRepoPath(pr.ref), pr.pr.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	files := &gitprovider.PullRequestFiles{
+		Files: make([]gitprovider.PullRequestFile, 0, len(apiObjs)),
+		// GitLab stops collecting diffs at a configurable limit, and returns e.g. "1000+" then
+		Truncated: strings.HasSuffix(mr.ChangesCount, "+"),
+	}
+	for _, apiObj := range apiObjs {
+		files.Files = append(files.Files, pullRequestFileFromAPI(apiObj))
+	}
+	return files, nil
+}
+
+func (pr *pullrequest) Diff(ctx context.Context) (string, error) {
+	// GET /projects/{project}/merge_requests/{merge_request_iid}/diffs
+	apiObjs, err := pr.c.ListMergeRequestDiffs(getRepoPath(pr.ref), pr.pr.IID)
+	if err != nil {
+		return "", err
+	}
+	// GitLab only returns the hunks of every file, hence add the git headers to them
+	var diff strings.Builder
+	for _, apiObj := range apiObjs {
+		writeFileDiff(&diff, apiObj)
+	}
+	return diff.String(), nil
+}
+
 func pullrequestFromAPI(apiObj *gitlab.MergeRequest) gitprovider.PullRequestInfo {
-	return gitprovider.PullRequestInfo{
+	info := gitprovider.PullRequestInfo{
 		Title:        apiObj.Title,
 		Description:  apiObj.Description,
 		Merged:       apiObj.State == mergedState,
 		Number:       apiObj.IID,
 		WebURL:       apiObj.WebURL,
 		SourceBranch: apiObj.SourceBranch,
+		TargetBranch: apiObj.TargetBranch,
+		HeadSha:      apiObj.SHA,
+		BaseSha:      apiObj.DiffRefs.BaseSha,
+		Draft:        apiObj.Draft || apiObj.WorkInProgress,
+		AutoMerge:    apiObj.MergeWhenPipelineSucceeds,
+		MergedAt:     apiObj.MergedAt,
+	}
+	if apiObj.Author != nil {
+		info.Author = apiObj.Author.Username
+	}
+	if len(apiObj.Labels) > 0 {
+		info.Labels = append([]string{}, apiObj.Labels...)
+	}
+	for _, user := range apiObj.Assignees {
+		info.Assignees = append(info.Assignees, user.Username)
+	}
+	for _, user := range apiObj.Reviewers {
+		info.Reviewers = append(info.Reviewers, user.Username)
+	}
+	if apiObj.CreatedAt != nil {
+		info.CreatedAt = *apiObj.CreatedAt
+	}
+	if apiObj.UpdatedAt != nil {
+		info.UpdatedAt = *apiObj.UpdatedAt
+	}
+
+	switch apiObj.State {
+	case mergedState:
+		info.State = gitprovider.PullRequestStateMerged
+	case closedState:
+		info.State = gitprovider.PullRequestStateClosed
+	default:
+		// Locked merge requests are about to be merged, hence still open
+		info.State = gitprovider.PullRequestStateOpen
+		switch apiObj.MergeStatus {
+		case mergeStatusCanBeMerged:
+			info.Mergeable = gitprovider.BoolVar(true)
+		case mergeStatusCannotBeMerged:
+			info.Mergeable = gitprovider.BoolVar(false)
+		}
+	}
+	return info
+}
+
+// draftPrefixes are the title prefixes GitLab marks merge requests as drafts with.
+var draftPrefixes = []string{"draft:", "[draft]", "(draft)", "wip:", "[wip]"}
+
+// draftTitle returns the title with its draft prefix removed, and a "Draft: " one added if draft is true.
+func draftTitle(title string, draft bool) string {
+	for _, prefix := range draftPrefixes {
+		if strings.HasPrefix(strings.ToLower(title), prefix) {
+			title = strings.TrimSpace(title[len(prefix):])
+			break
+		}
+	}
+	if draft {
+		return "Draft: " + title
+	}
+	return title
+}
+
+func pullRequestFileFromAPI(apiObj *gitlab.MergeRequestDiff) gitprovider.PullRequestFile {
+	file := gitprovider.PullRequestFile{
+		Path:   apiObj.NewPath,
+		Status: gitprovider.PullRequestFileStatusModified,
+		Patch:  apiObj.Diff,
+	}
+	switch {
+	case apiObj.NewFile:
+		file.Status = gitprovider.PullRequestFileStatusAdded
+	case apiObj.DeletedFile:
+		file.Status = gitprovider.PullRequestFileStatusRemoved
+		file.Path = apiObj.OldPath
+	case apiObj.RenamedFile:
+		file.Status = gitprovider.PullRequestFileStatusRenamed
+		file.PreviousPath = apiObj.OldPath
+	}
+	file.Additions, file.Deletions = countChangedLines(apiObj.Diff)
+	return file
+}
+
+// countChangedLines returns the number of added and removed lines in the given hunks.
+func countChangedLines(patch string) (additions, deletions int) {
+	for _, line := range strings.Split(patch, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			additions++
+		case strings.HasPrefix(line, "-"):
+			deletions++
+		}
+	}
+	return additions, deletions
+}
+
+// writeFileDiff writes the diff of a file in the format of "git diff".
+func writeFileDiff(b *strings.Builder, apiObj *gitlab.MergeRequestDiff) {
+	fmt.Fprintf(b, "diff --git a/%s b/%s\n", apiObj.OldPath, apiObj.NewPath)
+	oldPath, newPath := "a/"+apiObj.OldPath, "b/"+apiObj.NewPath
+	switch {
+	case apiObj.NewFile:
+		fmt.Fprintf(b, "new file mode %s\n", apiObj.BMode)
+		oldPath = "/dev/null"
+	case apiObj.DeletedFile:
+		fmt.Fprintf(b, "deleted file mode %s\n", apiObj.AMode)
+		newPath = "/dev/null"
+	case apiObj.AMode != apiObj.BMode:
+		fmt.Fprintf(b, "old mode %s\nnew mode %s\n", apiObj.AMode, apiObj.BMode)
+	}
+	if apiObj.RenamedFile {
+		fmt.Fprintf(b, "rename from %s\nrename to %s\n", apiObj.OldPath, apiObj.NewPath)
+	}
+	// Pure renames and mode changes don't have any hunks
+	if len(apiObj.Diff) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "--- %s\n+++ %s\n", oldPath, newPath)
+	b.WriteString(apiObj.Diff)
+	if !strings.HasSuffix(apiObj.Diff, "\n") {
+		b.WriteString("\n")
 	}
 }
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+)
+
+func Test_draftTitle(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		draft bool
+		want  string
+	}{
+		{
+			name:  "mark as draft",
+			title: "Add feature",
+			draft: true,
+			want:  "Draft: Add feature",
+		},
+		{
+			name:  "already a draft",
+			title: "Draft: Add feature",
+			draft: true,
+			want:  "Draft: Add feature",
+		},
+		{
+			name:  "mark work in progress as ready",
+			title: "[WIP] Add feature",
+			draft: false,
+			want:  "Add feature",
+		},
+		{
+			name:  "already ready",
+			title: "Add feature",
+			draft: false,
+			want:  "Add feature",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := draftTitle(tt.title, tt.draft); got != tt.want {
+				t.Errorf("draftTitle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_pullRequestFileFromAPI(t *testing.T) {
+	tests := []struct {
+		name     string
+		apiObj   *gitlab.MergeRequestDiff
+		want     gitprovider.PullRequestFile
+		wantDiff string
+	}{
+		{
+			name: "modified",
+			apiObj: &gitlab.MergeRequestDiff{
+				OldPath: "README.md", NewPath: "README.md", AMode: "100644", BMode: "100644",
+				Diff: "@@ -1,2 +1,2 @@\n # Flux\n-old\n+new\n",
+			},
+			want: gitprovider.PullRequestFile{
+				Path: "README.md", Status: gitprovider.PullRequestFileStatusModified, Additions: 1, Deletions: 1,
+				Patch: "@@ -1,2 +1,2 @@\n # Flux\n-old\n+new\n",
+			},
+			wantDiff: "diff --git a/README.md b/README.md\n--- a/README.md\n+++ b/README.md\n@@ -1,2 +1,2 @@\n # Flux\n-old\n+new\n",
+		},
+		{
+			name: "added",
+			apiObj: &gitlab.MergeRequestDiff{
+				OldPath: "new.txt", NewPath: "new.txt", AMode: "0", BMode: "100644", NewFile: true,
+				Diff: "@@ -0,0 +1 @@\n+new\n",
+			},
+			want: gitprovider.PullRequestFile{
+				Path: "new.txt", Status: gitprovider.PullRequestFileStatusAdded, Additions: 1,
+				Patch: "@@ -0,0 +1 @@\n+new\n",
+			},
+			wantDiff: "diff --git a/new.txt b/new.txt\nnew file mode 100644\n--- /dev/null\n+++ b/new.txt\n@@ -0,0 +1 @@\n+new\n",
+		},
+		{
+			name: "renamed without changes",
+			apiObj: &gitlab.MergeRequestDiff{
+				OldPath: "old.txt", NewPath: "new.txt", AMode: "100644", BMode: "100644", RenamedFile: true,
+			},
+			want: gitprovider.PullRequestFile{
+				Path: "new.txt", PreviousPath: "old.txt", Status: gitprovider.PullRequestFileStatusRenamed,
+			},
+			wantDiff: "diff --git a/old.txt b/new.txt\nrename from old.txt\nrename to new.txt\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, pullRequestFileFromAPI(tt.apiObj)); diff != "" {
+				t.Errorf("pullRequestFileFromAPI() mismatch (-want +got):\n%s", diff)
+			}
+			var b strings.Builder
+			writeFileDiff(&b, tt.apiObj)
+			if got := b.String(); got != tt.wantDiff {
+				t.Errorf("writeFileDiff() = %q, want %q", got, tt.wantDiff)
+			}
+		})
+	}
+}
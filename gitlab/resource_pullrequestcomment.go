@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newPullRequestComment(c *PullRequestCommentClient, apiObj *gitlab.Note) *pullRequestComment {
+	return &pullRequestComment{
+		n: *apiObj,
+		c: c,
+	}
+}
+
+var _ gitprovider.PullRequestComment = &pullRequestComment{}
+
+type pullRequestComment struct {
+	n gitlab.Note
+	c *PullRequestCommentClient
+}
+
+func (pc *pullRequestComment) Get() gitprovider.PullRequestCommentInfo {
+	return pullRequestCommentFromAPI(&pc.n)
+}
+
+func (pc *pullRequestComment) Set(info gitprovider.PullRequestCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	pc.n.Body = info.Body
+	return nil
+}
+
+func (pc *pullRequestComment) APIObject() interface{} {
+	return &pc.n
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (pc *pullRequestComment) Update(_ context.Context) error {
+	// PUT /projects/{project}/merge_requests/{merge_request_iid}/notes/{note_id}
+	apiObj, err := pc.c.c.UpdateMergeRequestNote(getRepoPath(pc.c.ref), pc.c.number, pc.n.ID, pc.n.Body)
+	if err != nil {
+		return err
+	}
+	pc.n = *apiObj
+	return nil
+}
+
+// Delete deletes the note.
+//
+// ErrNotFound is returned if the resource doesn't exist anymore.
+func (pc *pullRequestComment) Delete(_ context.Context) error {
+	// DELETE /projects/{project}/merge_requests/{merge_request_iid}/notes/{note_id}
+	return pc.c.c.DeleteMergeRequestNote(getRepoPath(pc.c.ref), pc.c.number, pc.n.ID)
+}
+
+// isConversationNote returns true for notes written by users in the conversation of a merge request or issue,
+// i.e. not for system notes or notes on the diff.
+func isConversationNote(apiObj *gitlab.Note) bool {
+	return !apiObj.System && apiObj.Position == nil
+}
+
+func pullRequestCommentFromAPI(apiObj *gitlab.Note) gitprovider.PullRequestCommentInfo {
+	info := gitprovider.PullRequestCommentInfo{
+		ID:     int64(apiObj.ID),
+		Body:   apiObj.Body,
+		Author: apiObj.Author.Username,
+	}
+	if apiObj.CreatedAt != nil {
+		info.CreatedAt = *apiObj.CreatedAt
+	}
+	if apiObj.UpdatedAt != nil {
+		info.UpdatedAt = *apiObj.UpdatedAt
+	}
+	return info
+}
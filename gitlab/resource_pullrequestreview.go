@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// newApprovalReview returns an approved review for the approver of a merge request.
+func newApprovalReview(apiObj *gitlab.MergeRequestApproverUser) *pullRequestReview {
+	return &pullRequestReview{
+		apiObj: apiObj,
+		info: gitprovider.PullRequestReviewInfo{
+			State:  gitprovider.PullRequestReviewStateApproved,
+			Author: apiObj.User.Username,
+		},
+	}
+}
+
+// newNoteReview returns a commented review for a note of a merge request.
+func newNoteReview(apiObj *gitlab.Note) *pullRequestReview {
+	return &pullRequestReview{
+		apiObj: apiObj,
+		info: gitprovider.PullRequestReviewInfo{
+			ID:          int64(apiObj.ID),
+			State:       gitprovider.PullRequestReviewStateCommented,
+			Body:        apiObj.Body,
+			Author:      apiObj.Author.Username,
+			SubmittedAt: apiObj.CreatedAt,
+		},
+	}
+}
+
+var _ gitprovider.PullRequestReview = &pullRequestReview{}
+
+// pullRequestReview is either an approval or a note, as GitLab doesn't have reviews.
+type pullRequestReview struct {
+	apiObj interface{}
+	info   gitprovider.PullRequestReviewInfo
+}
+
+func (r *pullRequestReview) Get() gitprovider.PullRequestReviewInfo {
+	return r.info
+}
+
+// APIObject returns the *gitlab.MergeRequestApproverUser of approvals, or the *gitlab.Note
+// of commented reviews.
+func (r *pullRequestReview) APIObject() interface{} {
+	return r.apiObj
+}
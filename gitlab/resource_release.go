@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newRelease(c *ReleaseClient, r *gitlab.Release) *release {
+	return &release{
+		r: *r,
+		c: c,
+	}
+}
+
+var _ gitprovider.Release = &release{}
+
+type release struct {
+	r gitlab.Release
+	c *ReleaseClient
+}
+
+func (r *release) Get() gitprovider.ReleaseInfo {
+	return releaseFromAPI(&r.r)
+}
+
+func (r *release) Set(info gitprovider.ReleaseInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.TagName != r.r.TagName {
+		return fmt.Errorf("cannot change the tag of release %q: %w", r.r.TagName, gitprovider.ErrInvalidArgument)
+	}
+	return releaseInfoToAPIObj(&info, &r.r)
+}
+
+func (r *release) APIObject() interface{} {
+	return &r.r
+}
+
+func (r *release) Repository() gitprovider.RepositoryRef {
+	return r.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (r *release) Update(_ context.Context) error {
+	// PUT /projects/{project}/releases/{tag}
+	apiObj, err := r.c.c.UpdateRelease(getRepoPath(r.c.ref), r.r.TagName, &gitlab.UpdateReleaseOptions{
+		Name:        &r.r.Name,
+		Description: &r.r.Description,
+	})
+	if err != nil {
+		return err
+	}
+	r.r = *apiObj
+	return nil
+}
+
+// Delete deletes the release from the repository irreversibly, the tag is kept.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource does not exist.
+func (r *release) Delete(_ context.Context) error {
+	// DELETE /projects/{project}/releases/{tag}
+	return r.c.c.DeleteRelease(getRepoPath(r.c.ref), r.r.TagName)
+}
+
+// UploadAsset uploads the content to the project and links it to the release as an asset
+// with the given name.
+func (r *release) UploadAsset(ctx context.Context, name string, content io.Reader) (gitprovider.ReleaseAssetInfo, error) {
+	// GET /projects/{project}
+	project, err := r.c.c.GetUserProject(ctx, getRepoPath(r.c.ref))
+	if err != nil {
+		return gitprovider.ReleaseAssetInfo{}, err
+	}
+	// POST /projects/{project}/uploads
+	file, err := r.c.c.UploadFile(getRepoPath(r.c.ref), name, content)
+	if err != nil {
+		return gitprovider.ReleaseAssetInfo{}, err
+	}
+	// The URL of uploads is relative to the web URL of the project
+	url := strings.TrimSuffix(project.WebURL, "/") + file.URL
+	// POST /projects/{project}/releases/{tag}/assets/links
+	link, err := r.c.c.CreateReleaseLink(getRepoPath(r.c.ref), r.r.TagName, &gitlab.CreateReleaseLinkOptions{
+		Name: &name,
+		URL:  &url,
+	})
+	if err != nil {
+		return gitprovider.ReleaseAssetInfo{}, err
+	}
+	r.r.Assets.Links = append(r.r.Assets.Links, link)
+	return releaseLinkFromAPI(link), nil
+}
+
+func validateReleaseAPI(apiObj *gitlab.Release) error {
+	return validateAPIObject("GitLab.Release", func(validator validation.Validator) {
+		if apiObj.TagName == "" {
+			validator.Required("TagName")
+		}
+	})
+}
+
+func releaseFromAPI(apiObj *gitlab.Release) gitprovider.ReleaseInfo {
+	info := gitprovider.ReleaseInfo{
+		TagName:     apiObj.TagName,
+		Name:        gitprovider.StringVar(apiObj.Name),
+		Description: gitprovider.StringVar(apiObj.Description),
+		// GitLab has no draft releases and prereleases
+		Draft:      gitprovider.BoolVar(false),
+		Prerelease: gitprovider.BoolVar(false),
+	}
+	for _, link := range apiObj.Assets.Links {
+		info.Assets = append(info.Assets, releaseLinkFromAPI(link))
+	}
+	return info
+}
+
+func releaseLinkFromAPI(apiObj *gitlab.ReleaseLink) gitprovider.ReleaseAssetInfo {
+	// GitLab doesn't report the size of linked assets
+	return gitprovider.ReleaseAssetInfo{
+		Name: apiObj.Name,
+		URL:  apiObj.URL,
+	}
+}
+
+func releaseInfoToAPIObj(info *gitprovider.ReleaseInfo, apiObj *gitlab.Release) error {
+	if (info.Draft != nil && *info.Draft) || (info.Prerelease != nil && *info.Prerelease) {
+		return fmt.Errorf("draft releases and prereleases: %w", gitprovider.ErrNoProviderSupport)
+	}
+	// Only set fields that are set in the info, i.e. PATCH behaviour
+	if info.Name != nil {
+		apiObj.Name = *info.Name
+	}
+	if info.Description != nil {
+		apiObj.Description = *info.Description
+	}
+	return nil
+}
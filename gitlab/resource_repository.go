@@ -31,6 +31,10 @@ func newUserProject(ctx *clientContext, apiObj *gogitlab.Project, ref gitprovide
 		clientContext: ctx,
 		p:             *apiObj,
 		ref:           ref,
+		collaborators: &CollaboratorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 		deployKeys: &DeployKeyClient{
 			clientContext: ctx,
 			ref:           ref,
@@ -51,6 +55,14 @@ func newUserProject(ctx *clientContext, apiObj *gogitlab.Project, ref gitprovide
 			clientContext: ctx,
 			ref:           ref,
 		},
+		issues: &IssueClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		labels: &LabelClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 		files: &FileClient{
 			clientContext: ctx,
 			ref:           ref,
@@ -59,6 +71,26 @@ func newUserProject(ctx *clientContext, apiObj *gogitlab.Project, ref gitprovide
 			clientContext: ctx,
 			ref:           ref,
 		},
+		webhooks: &WebhookClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		commitStatuses: &CommitStatusClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		branchProtections: &BranchProtectionClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		tags: &TagClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		releases: &ReleaseClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 	}
 }
 
@@ -70,13 +102,21 @@ type userProject struct {
 	p   gogitlab.Project
 	ref gitprovider.RepositoryRef
 
-	deployKeys   *DeployKeyClient
-	deployTokens *DeployTokenClient
-	commits      *CommitClient
-	branches     *BranchClient
-	pullRequests *PullRequestClient
-	files        *FileClient
-	trees        *TreeClient
+	collaborators     *CollaboratorClient
+	deployKeys        *DeployKeyClient
+	deployTokens      *DeployTokenClient
+	commits           *CommitClient
+	branches          *BranchClient
+	pullRequests      *PullRequestClient
+	issues            *IssueClient
+	labels            *LabelClient
+	files             *FileClient
+	trees             *TreeClient
+	webhooks          *WebhookClient
+	commitStatuses    *CommitStatusClient
+	branchProtections *BranchProtectionClient
+	tags              *TagClient
+	releases          *ReleaseClient
 }
 
 func (p *userProject) Get() gitprovider.RepositoryInfo {
@@ -87,6 +127,9 @@ func (p *userProject) Set(info gitprovider.RepositoryInfo) error {
 	if err := info.ValidateInfo(); err != nil {
 		return err
 	}
+	if err := validateRepositorySupport(info); err != nil {
+		return err
+	}
 	repositoryInfoToAPIObj(&info, &p.p)
 	return nil
 }
@@ -99,6 +142,10 @@ func (p *userProject) Repository() gitprovider.RepositoryRef {
 	return p.ref
 }
 
+func (p *userProject) Collaborators() gitprovider.CollaboratorClient {
+	return p.collaborators
+}
+
 func (p *userProject) DeployKeys() gitprovider.DeployKeyClient {
 	return p.deployKeys
 }
@@ -119,6 +166,14 @@ func (p *userProject) PullRequests() gitprovider.PullRequestClient {
 	return p.pullRequests
 }
 
+func (p *userProject) Issues() gitprovider.IssueClient {
+	return p.issues
+}
+
+func (p *userProject) Labels() gitprovider.LabelClient {
+	return p.labels
+}
+
 func (p *userProject) Files() gitprovider.FileClient {
 	return p.files
 }
@@ -127,6 +182,26 @@ func (p *userProject) Trees() gitprovider.TreeClient {
 	return p.trees
 }
 
+func (p *userProject) Webhooks() gitprovider.WebhookClient {
+	return p.webhooks
+}
+
+func (p *userProject) CommitStatuses() gitprovider.CommitStatusClient {
+	return p.commitStatuses
+}
+
+func (p *userProject) BranchProtections() gitprovider.BranchProtectionClient {
+	return p.branchProtections
+}
+
+func (p *userProject) Tags() gitprovider.TagClient {
+	return p.tags
+}
+
+func (p *userProject) Releases() gitprovider.ReleaseClient {
+	return p.releases
+}
+
 // The internal API object will be overridden with the received server data.
 func (p *userProject) Update(ctx context.Context) error {
 	// PATCH /repos/{owner}/{repo}
@@ -257,6 +332,11 @@ func repositoryFromAPI(apiObj *gogitlab.Project) gitprovider.RepositoryInfo {
 		DefaultBranch: &apiObj.DefaultBranch,
 	}
 	repo.Visibility = gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibility(apiObj.Visibility))
+	repo.Topics = apiObj.Topics
+	repo.Archived = &apiObj.Archived
+	repo.HasIssues = gitprovider.BoolVar(featureEnabled(apiObj.IssuesAccessLevel, apiObj.IssuesEnabled))
+	repo.HasWiki = gitprovider.BoolVar(featureEnabled(apiObj.WikiAccessLevel, apiObj.WikiEnabled))
+	repo.DeleteBranchOnMerge = &apiObj.RemoveSourceBranchAfterMerge
 	return repo
 }
 
@@ -278,6 +358,51 @@ func repositoryInfoToAPIObj(repo *gitprovider.RepositoryInfo, apiObj *gogitlab.P
 	if repo.Visibility != nil {
 		apiObj.Visibility = gitlabVisibilityMap[*repo.Visibility]
 	}
+	if repo.Topics != nil {
+		apiObj.Topics = repo.Topics
+	}
+	if repo.Archived != nil {
+		apiObj.Archived = *repo.Archived
+	}
+	if repo.HasIssues != nil {
+		apiObj.IssuesAccessLevel = featureAccessLevel(*repo.HasIssues, apiObj.IssuesAccessLevel)
+	}
+	if repo.HasWiki != nil {
+		apiObj.WikiAccessLevel = featureAccessLevel(*repo.HasWiki, apiObj.WikiAccessLevel)
+	}
+	if repo.DeleteBranchOnMerge != nil {
+		apiObj.RemoveSourceBranchAfterMerge = *repo.DeleteBranchOnMerge
+	}
+}
+
+// unsupportedRepositoryFields are the gitprovider.RepositoryInfo fields GitLab has no equivalent for.
+var unsupportedRepositoryFields = []string{"homepage", "hasProjects", "allowedMergeMethods", "isTemplate"}
+
+// validateRepositorySupport returns ErrNoProviderSupport if info sets fields GitLab has no
+// equivalent for.
+func validateRepositorySupport(info gitprovider.RepositoryInfo) error {
+	return gitprovider.ValidateUnsupportedFields(ProviderID, info, unsupportedRepositoryFields...)
+}
+
+// featureEnabled returns whether a project feature, like issues, is enabled by its access level,
+// or by the deprecated boolean if the server doesn't return the access level.
+func featureEnabled(level gogitlab.AccessControlValue, enabled bool) bool {
+	if level == "" {
+		return enabled
+	}
+	return level != gogitlab.DisabledAccessControl
+}
+
+// featureAccessLevel returns the access level enabling or disabling a project feature, keeping
+// the actual access level if it already enables the feature as desired.
+func featureAccessLevel(enabled bool, actual gogitlab.AccessControlValue) gogitlab.AccessControlValue {
+	if !enabled {
+		return gogitlab.DisabledAccessControl
+	}
+	if actual == "" || actual == gogitlab.DisabledAccessControl {
+		return gogitlab.EnabledAccessControl
+	}
+	return actual
 }
 
 // This function copies over the fields that are part of create/update requests of a project
@@ -292,7 +417,12 @@ func newGitlabProjectSpec(project *gogitlab.Project) *gitlabProjectSpec {
 			Visibility:  project.Visibility,
 
 			// Update-specific parameters
-			DefaultBranch: project.DefaultBranch,
+			DefaultBranch:                project.DefaultBranch,
+			Topics:                       project.Topics,
+			Archived:                     project.Archived,
+			IssuesAccessLevel:            project.IssuesAccessLevel,
+			WikiAccessLevel:              project.WikiAccessLevel,
+			RemoveSourceBranchAfterMerge: project.RemoveSourceBranchAfterMerge,
 		},
 	}
 }
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newTag(c *TagClient, tag *gitlab.Tag) *tagType {
+	return &tagType{
+		t: *tag,
+		c: c,
+	}
+}
+
+var _ gitprovider.Tag = &tagType{}
+
+type tagType struct {
+	t gitlab.Tag
+	c *TagClient
+}
+
+func (t *tagType) Get() gitprovider.TagInfo {
+	return tagFromAPI(&t.t)
+}
+
+func (t *tagType) APIObject() interface{} {
+	return &t.t
+}
+
+func (t *tagType) Repository() gitprovider.RepositoryRef {
+	return t.c.ref
+}
+
+func validateTagAPI(apiObj *gitlab.Tag) error {
+	return validateAPIObject("GitLab.Tag", func(validator validation.Validator) {
+		// Make sure the name and commit are populated as per
+		// https://docs.gitlab.com/ee/api/tags.html#get-a-single-repository-tag
+		if apiObj.Name == "" {
+			validator.Required("Name")
+		}
+		if apiObj.Commit == nil || apiObj.Commit.ID == "" {
+			validator.Required("Commit.ID")
+		}
+	})
+}
+
+func tagFromAPI(apiObj *gitlab.Tag) gitprovider.TagInfo {
+	info := gitprovider.TagInfo{
+		Name: apiObj.Name,
+		Sha:  apiObj.Commit.ID,
+	}
+	// Lightweight tags are returned with an empty message
+	if apiObj.Message != "" {
+		info.Message = gitprovider.StringVar(apiObj.Message)
+	}
+	return info
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newTeam(c *TeamsClient, users []*gitlab.GroupMember, info gitprovider.TeamInfo) *team {
+	return &team{
+		users: users,
+		info:  info,
+		c:     c,
+	}
+}
+
+var _ gitprovider.Team = &team{}
+
+type team struct {
+	users []*gitlab.GroupMember
+	info  gitprovider.TeamInfo
+	c     *TeamsClient
+}
+
+func (t *team) Get() gitprovider.TeamInfo {
+	return t.info
+}
+
+func (t *team) Set(info gitprovider.TeamInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.Name != t.info.Name {
+		return fmt.Errorf("cannot rename team %q: %w", t.info.Name, gitprovider.ErrInvalidArgument)
+	}
+	t.info = info
+	return nil
+}
+
+func (t *team) APIObject() interface{} {
+	return t.users
+}
+
+func (t *team) Organization() gitprovider.OrganizationRef {
+	return t.c.ref
+}
+
+// Members returns the client operating on the direct members of the subgroup.
+func (t *team) Members() gitprovider.TeamMemberClient {
+	return &TeamMemberClient{
+		clientContext: t.c.clientContext,
+		teams:         t.c,
+		team:          t.info.Name,
+	}
+}
+
+// Update will apply the desired description in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (t *team) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&t.info); err != nil {
+		return err
+	}
+	if err := validateTeamPrivacy(t.info); err != nil {
+		return err
+	}
+	// An empty description removes it
+	description := ""
+	if t.info.Description != nil {
+		description = *t.info.Description
+	}
+	// PUT /groups/{group}
+	apiObj, err := t.c.c.UpdateGroup(ctx, t.c.teamPath(t.info.Name), &gitlab.UpdateGroupOptions{
+		Description: &description,
+	})
+	if err != nil {
+		return err
+	}
+	t.info.Description = teamFromAPI(apiObj).Description
+	return nil
+}
+
+// Delete deletes the subgroup of the team, including all its projects.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource does not exist.
+func (t *team) Delete(ctx context.Context) error {
+	// DELETE /groups/{group}
+	return t.c.c.DeleteGroup(ctx, t.c.teamPath(t.info.Name))
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (t *team) Reconcile(ctx context.Context) (bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&t.info); err != nil {
+		return false, err
+	}
+
+	actual, err := t.c.Get(ctx, t.info.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := t.c.Create(ctx, t.info)
+			if err != nil {
+				return true, err
+			}
+			t.info = resp.Get()
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if t.info.Equals(actual.Get()) {
+		return false, nil
+	}
+	return true, t.Update(ctx)
+}
+
+func teamFromAPI(apiObj *gitlab.Group) gitprovider.TeamInfo {
+	info := gitprovider.TeamInfo{
+		Name: apiObj.Path,
+		// Subgroups are visible to all members of their parent group
+		Privacy: gitprovider.TeamPrivacyVar(gitprovider.TeamPrivacyClosed),
+	}
+	if apiObj.Description != "" {
+		info.Description = gitlab.String(apiObj.Description)
+	}
+	return info
+}
+
+// validateTeamPrivacy returns an error if info requests a privacy GitLab subgroups can't have.
+func validateTeamPrivacy(info gitprovider.TeamInfo) error {
+	if info.Privacy != nil && *info.Privacy != gitprovider.TeamPrivacyClosed {
+		return fmt.Errorf("subgroups are visible to all members of the group, %q privacy: %w",
+			*info.Privacy, gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newWebhook(c *WebhookClient, hook *gitlab.ProjectHook) *webhook {
+	return &webhook{
+		h: *hook,
+		c: c,
+	}
+}
+
+var _ gitprovider.Webhook = &webhook{}
+
+type webhook struct {
+	h gitlab.ProjectHook
+	c *WebhookClient
+	// token is the secret token sent along with every delivery. GitLab never returns it,
+	// hence it's only set if given through Set.
+	token *string
+}
+
+func (wh *webhook) Get() gitprovider.WebhookInfo {
+	return webhookFromAPI(&wh.h)
+}
+
+func (wh *webhook) Set(info gitprovider.WebhookInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	return webhookInfoToAPIObj(&info, wh)
+}
+
+func (wh *webhook) APIObject() interface{} {
+	return &wh.h
+}
+
+func (wh *webhook) Repository() gitprovider.RepositoryRef {
+	return wh.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (wh *webhook) Update(_ context.Context) error {
+	// PUT /projects/{project}/hooks/{hook_id}
+	apiObj, err := wh.c.c.EditHook(getRepoPath(wh.c.ref), wh.h.ID, &gitlab.EditProjectHookOptions{
+		URL:                   &wh.h.URL,
+		Token:                 wh.token,
+		PushEvents:            &wh.h.PushEvents,
+		TagPushEvents:         &wh.h.TagPushEvents,
+		MergeRequestsEvents:   &wh.h.MergeRequestsEvents,
+		IssuesEvents:          &wh.h.IssuesEvents,
+		NoteEvents:            &wh.h.NoteEvents,
+		ReleasesEvents:        &wh.h.ReleasesEvents,
+		EnableSSLVerification: &wh.h.EnableSSLVerification,
+	})
+	if err != nil {
+		return err
+	}
+	wh.h = *apiObj
+	return nil
+}
+
+// Delete deletes a webhook from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (wh *webhook) Delete(_ context.Context) error {
+	// DELETE /projects/{project}/hooks/{hook_id}
+	return wh.c.c.DeleteHook(getRepoPath(wh.c.ref), wh.h.ID)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (wh *webhook) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := wh.c.get(wh.h.URL)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return true, wh.createIntoSelf()
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// Use wrappers here to extract the "spec" part of the object for comparison
+	desiredSpec := newGitlabHookSpec(&wh.h)
+	actualSpec := newGitlabHookSpec(&actual.h)
+
+	// If the desired matches the actual state, do nothing
+	if desiredSpec.Equals(actualSpec) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	wh.h.ID = actual.h.ID
+	return true, wh.Update(ctx)
+}
+
+func (wh *webhook) createIntoSelf() error {
+	// POST /projects/{project}/hooks
+	apiObj, err := wh.c.c.CreateHook(getRepoPath(wh.c.ref), &gitlab.AddProjectHookOptions{
+		URL:                   &wh.h.URL,
+		Token:                 wh.token,
+		PushEvents:            &wh.h.PushEvents,
+		TagPushEvents:         &wh.h.TagPushEvents,
+		MergeRequestsEvents:   &wh.h.MergeRequestsEvents,
+		IssuesEvents:          &wh.h.IssuesEvents,
+		NoteEvents:            &wh.h.NoteEvents,
+		ReleasesEvents:        &wh.h.ReleasesEvents,
+		EnableSSLVerification: &wh.h.EnableSSLVerification,
+	})
+	if err != nil {
+		return err
+	}
+	wh.h = *apiObj
+	return nil
+}
+
+func validateWebhookAPI(apiObj *gitlab.ProjectHook) error {
+	return validateAPIObject("GitLab.ProjectHook", func(validator validation.Validator) {
+		// Make sure the URL is populated as per
+		// https://docs.gitlab.com/ee/api/projects.html#get-project-hook
+		if apiObj.URL == "" {
+			validator.Required("URL")
+		}
+	})
+}
+
+func webhookFromAPI(apiObj *gitlab.ProjectHook) gitprovider.WebhookInfo {
+	events := []gitprovider.WebhookEvent{}
+	for event, enabled := range hookEventFields(apiObj) {
+		if *enabled {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i] < events[j] })
+	return gitprovider.WebhookInfo{
+		URL:         apiObj.URL,
+		ContentType: gitprovider.WebhookContentTypeVar(gitprovider.WebhookContentTypeJSON),
+		Events:      events,
+		InsecureSSL: gitprovider.BoolVar(!apiObj.EnableSSLVerification),
+		// GitLab has no notion of inactive webhooks
+		Active: gitprovider.BoolVar(true),
+	}
+}
+
+func webhookInfoToAPIObj(info *gitprovider.WebhookInfo, wh *webhook) error {
+	// GitLab always delivers JSON, and can't disable a webhook
+	if info.ContentType != nil && *info.ContentType != gitprovider.WebhookContentTypeJSON {
+		return fmt.Errorf("webhook content type %q: %w", *info.ContentType, gitprovider.ErrNoProviderSupport)
+	}
+	if info.Active != nil && !*info.Active {
+		return fmt.Errorf("inactive webhooks: %w", gitprovider.ErrNoProviderSupport)
+	}
+	// Required fields, we assume info is validated, and hence these are set
+	wh.h.URL = info.URL
+	// optional fields
+	if info.Secret != nil {
+		wh.token = info.Secret
+	}
+	if info.InsecureSSL != nil {
+		wh.h.EnableSSLVerification = !*info.InsecureSSL
+	}
+	if info.Events != nil {
+		fields := hookEventFields(&wh.h)
+		for _, enabled := range fields {
+			*enabled = false
+		}
+		for _, event := range info.Events {
+			enabled, ok := fields[event]
+			if !ok {
+				return fmt.Errorf("webhook event %q: %w", event, gitprovider.ErrNoProviderSupport)
+			}
+			*enabled = true
+		}
+	}
+	return nil
+}
+
+// hookEventFields maps the generic webhook events to the fields of apiObj enabling them.
+func hookEventFields(apiObj *gitlab.ProjectHook) map[gitprovider.WebhookEvent]*bool {
+	return map[gitprovider.WebhookEvent]*bool{
+		gitprovider.WebhookEventPush:        &apiObj.PushEvents,
+		gitprovider.WebhookEventTagPush:     &apiObj.TagPushEvents,
+		gitprovider.WebhookEventPullRequest: &apiObj.MergeRequestsEvents,
+		gitprovider.WebhookEventIssues:      &apiObj.IssuesEvents,
+		gitprovider.WebhookEventComment:     &apiObj.NoteEvents,
+		gitprovider.WebhookEventRelease:     &apiObj.ReleasesEvents,
+	}
+}
+
+// This function copies over the fields that are part of create/update requests of a webhook
+// i.e. the desired spec of the webhook. This allows us to separate "spec" from "status" fields.
+func newGitlabHookSpec(hook *gitlab.ProjectHook) *gitlabHookSpec {
+	return &gitlabHookSpec{
+		&gitlab.ProjectHook{
+			// Create-specific parameters
+			// See: https://docs.gitlab.com/ee/api/projects.html#add-project-hook
+			URL:                   hook.URL,
+			PushEvents:            hook.PushEvents,
+			TagPushEvents:         hook.TagPushEvents,
+			MergeRequestsEvents:   hook.MergeRequestsEvents,
+			IssuesEvents:          hook.IssuesEvents,
+			NoteEvents:            hook.NoteEvents,
+			ReleasesEvents:        hook.ReleasesEvents,
+			EnableSSLVerification: hook.EnableSSLVerification,
+		},
+	}
+}
+
+type gitlabHookSpec struct {
+	*gitlab.ProjectHook
+}
+
+func (s *gitlabHookSpec) Equals(other *gitlabHookSpec) bool {
+	return reflect.DeepEqual(s, other)
+}
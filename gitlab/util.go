@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -15,6 +16,8 @@ const (
 	alreadyExistsMagicString = "name: [has already been taken]"
 	alreadySharedWithGroup   = "already shared with this group"
 	defaultBranchName        = "main"
+	labelAlreadyExists       = "Label already exists"
+	groupPathAlreadyTaken    = "path: [has already been taken]"
 )
 
 func getRepoPath(ref gitprovider.RepositoryRef) string {
@@ -68,7 +71,7 @@ func allGroupMemberPages(opts *gitlab.ListGroupMembersOptions, fn func() (*gitla
 	for {
 		resp, err := fn()
 		if err != nil {
-			return err
+			return handleHTTPError(err)
 		}
 		if resp.NextPage == 0 {
 			return nil
@@ -103,6 +106,19 @@ func allProjectUserPages(opts *gitlab.ListProjectUserOptions, fn func() (*gitlab
 	}
 }
 
+func allProjectMemberPages(opts *gitlab.ListProjectMembersOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return handleHTTPError(err)
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 func allDeployKeyPages(opts *gitlab.ListProjectDeployKeysOptions, fn func() (*gitlab.Response, error)) error {
 	for {
 		resp, err := fn()
@@ -116,6 +132,58 @@ func allDeployKeyPages(opts *gitlab.ListProjectDeployKeysOptions, fn func() (*gi
 	}
 }
 
+func allProjectHookPages(opts *gitlab.ListProjectHooksOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allCommitStatusPages(opts *gitlab.GetCommitStatusesOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allBranchPages(opts *gitlab.ListBranchesOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allProtectedBranchPages(opts *gitlab.ListProtectedBranchesOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 func allDeployTokenPages(opts *gitlab.ListProjectDeployTokensOptions, fn func() (*gitlab.Response, error)) error {
 	for {
 		resp, err := fn()
@@ -129,6 +197,148 @@ func allDeployTokenPages(opts *gitlab.ListProjectDeployTokensOptions, fn func()
 	}
 }
 
+func allTagPages(opts *gitlab.ListTagsOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allReleasePages(opts *gitlab.ListReleasesOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allMergeRequestPages(opts *gitlab.ListProjectMergeRequestsOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allMergeRequestDiffPages(opts *gitlab.ListMergeRequestDiffsOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allMergeRequestNotePages(opts *gitlab.ListMergeRequestNotesOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allIssuePages(opts *gitlab.ListProjectIssuesOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allMilestonePages(opts *gitlab.ListMilestonesOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allIssueNotePages(opts *gitlab.ListIssueNotesOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func allLabelPages(opts *gitlab.ListLabelsOptions, fn func() (*gitlab.Response, error)) error {
+	for {
+		resp, err := fn()
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// projectUserIDs maps the given logins to the IDs of the users, which must be members of the project.
+func projectUserIDs(ctx context.Context, c gitlabClient, ref gitprovider.RepositoryRef, logins []string) ([]int, error) {
+	if len(logins) == 0 {
+		return nil, nil
+	}
+	// GET /projects/{project}/users
+	users, err := c.ListProjectUsers(ctx, getRepoPath(ref))
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]int, len(users))
+	for _, user := range users {
+		ids[user.Username] = user.ID
+	}
+	result := make([]int, 0, len(logins))
+	for _, login := range logins {
+		id, ok := ids[login]
+		if !ok {
+			return nil, fmt.Errorf("user %q: %w", login, gitprovider.ErrNotFound)
+		}
+		result = append(result, id)
+	}
+	return result, nil
+}
+
 // validateUserRepositoryRef makes sure the UserRepositoryRef is valid for GitHub's usage.
 func validateUserRepositoryRef(ref gitprovider.UserRepositoryRef, expectedDomain string) error {
 	// Make sure the RepositoryRef fields are valid
@@ -218,6 +428,24 @@ func validateIdentityFields(ref gitprovider.IdentityRef, expectedDomain string)
 	return fmt.Errorf("invalid identity type: %v: %w", ref.GetType(), gitprovider.ErrInvalidArgument)
 }
 
+// handleMergeError wraps err like handleHTTPError, except for the 409 Conflict response returned when
+// accepting a merge request whose head isn't the expected commit, and the 406 Not Acceptable response
+// returned if it has conflicts, which are returned as a *gitprovider.ConflictError.
+func handleMergeError(err error) error {
+	glErrorResponse := &gitlab.ErrorResponse{}
+	if errors.As(err, &glErrorResponse) && (glErrorResponse.Response.StatusCode == http.StatusConflict ||
+		glErrorResponse.Response.StatusCode == http.StatusNotAcceptable) {
+		return validation.NewMultiError(err, &gitprovider.ConflictError{
+			HTTPError: gitprovider.HTTPError{
+				Response:     glErrorResponse.Response,
+				ErrorMessage: glErrorResponse.Error(),
+				Message:      glErrorResponse.Message,
+			},
+		})
+	}
+	return handleHTTPError(err)
+}
+
 // handleHTTPError checks the type of err, and returns typed variants of it
 // However, it _always_ keeps the original error too, and just wraps it in a MultiError
 // The consumer must use errors.Is and errors.As to check for equality and get data out of it.
@@ -245,7 +473,9 @@ func handleHTTPError(err error) error {
 			return validation.NewMultiError(err, gitprovider.ErrNotFound)
 		}
 		// Check for already exists errors
-		if strings.Contains(glErrorResponse.Message, alreadyExistsMagicString) {
+		if strings.Contains(glErrorResponse.Message, alreadyExistsMagicString) ||
+			strings.Contains(glErrorResponse.Message, labelAlreadyExists) ||
+			strings.Contains(glErrorResponse.Message, groupPathAlreadyTaken) {
 			return validation.NewMultiError(err, gitprovider.ErrAlreadyExists)
 		}
 		// Otherwise, return a generic *HTTPError
@@ -39,6 +39,10 @@ type Client interface {
 
 	// Raw returns the Go client used under the hood to access the Git provider.
 	Raw() interface{}
+
+	// DryRunPlan returns the Plan recording the write API calls skipped by this client, if it was
+	// created using WithDryRun(true). Otherwise, nil is returned.
+	DryRunPlan() *Plan
 }
 
 // ResourceClient allows access to resource-specific sub-clients.
@@ -78,7 +82,27 @@ type OrganizationsClient interface {
 	// Children returns all available organizations, using multiple paginated requests if needed.
 	Children(ctx context.Context, o OrganizationRef) ([]Organization, error)
 
-	// Possibly add Create/Update/Delete methods later
+	// Create creates the organization o with the given data. If o is a sub-organization,
+	// it's created within its parent organization, which must exist.
+	//
+	// ErrNoProviderSupport is returned if the provider doesn't allow creating organizations
+	// through its API, or doesn't support the requested visibility.
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, o OrganizationRef, req OrganizationInfo) (Organization, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, o OrganizationRef, req OrganizationInfo) (resp Organization, actionTaken bool, err error)
+
+	// Delete deletes the organization o irreversibly, including its repositories.
+	// This is a destructive call, only allowed if the client was created with destructive
+	// API calls enabled.
+	//
+	// ErrNotFound is returned if the resource doesn't exist.
+	Delete(ctx context.Context, o OrganizationRef) error
 }
 
 // OrgRepositoriesClient operates on repositories for organizations.
@@ -104,6 +128,12 @@ type OrgRepositoriesClient interface {
 	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 	// If req is already the actual state, this is a no-op (actionTaken == false).
 	Reconcile(ctx context.Context, r OrgRepositoryRef, req RepositoryInfo, opts ...RepositoryReconcileOption) (resp OrgRepository, actionTaken bool, err error)
+
+	// ReconcileWithChanges works like Reconcile, but returns the fields that were changed instead
+	// of actionTaken, with their actual (old) and desired (new) values. If req is created, all its
+	// set fields are listed without old values. If req is already the actual state, no changes are
+	// returned.
+	ReconcileWithChanges(ctx context.Context, r OrgRepositoryRef, req RepositoryInfo, opts ...RepositoryReconcileOption) (resp OrgRepository, changes []FieldChange, err error)
 }
 
 // UserRepositoriesClient operates on repositories for users.
@@ -132,13 +162,19 @@ type UserRepositoriesClient interface {
 	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 	// If req is already the actual state, this is a no-op (actionTaken == false).
 	Reconcile(ctx context.Context, r UserRepositoryRef, req RepositoryInfo, opts ...RepositoryReconcileOption) (resp UserRepository, actionTaken bool, err error)
+
+	// ReconcileWithChanges works like Reconcile, but returns the fields that were changed instead
+	// of actionTaken, with their actual (old) and desired (new) values. If req is created, all its
+	// set fields are listed without old values. If req is already the actual state, no changes are
+	// returned.
+	ReconcileWithChanges(ctx context.Context, r UserRepositoryRef, req RepositoryInfo, opts ...RepositoryReconcileOption) (resp UserRepository, changes []FieldChange, err error)
 }
 
 //
 //	Clients accessed through resource objects.
 //
 
-// TeamsClient allows reading teams for a specific organization.
+// TeamsClient operates on the teams of a specific organization.
 // This client can be accessed through Organization.Teams().
 type TeamsClient interface {
 	// Get a team within the specific organization.
@@ -154,7 +190,45 @@ type TeamsClient interface {
 	// List returns all available organizations, using multiple paginated requests if needed.
 	List(ctx context.Context) ([]Team, error)
 
-	// Possibly add Create/Update/Delete methods later
+	// Create creates a team with the given specifications. Members of req are ignored.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req TeamInfo) (Team, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req TeamInfo) (resp Team, actionTaken bool, err error)
+}
+
+// TeamMemberClient operates on the members of a specific team.
+// This client can be accessed through Team.Members().
+type TeamMemberClient interface {
+	// List all members of the team, with their roles.
+	//
+	// List returns all available members, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]TeamMemberInfo, error)
+
+	// Add adds the user to the team with the given role. If the user already is a member of
+	// the team, their role is changed to the given one.
+	//
+	// ErrNoProviderSupport is returned for roles the provider doesn't support.
+	Add(ctx context.Context, req TeamMemberInfo) error
+
+	// Remove removes the user with the given login from the team.
+	//
+	// ErrNotFound is returned if the user isn't a member of the team.
+	Remove(ctx context.Context, login string) error
+
+	// Reconcile makes sure the users in req are members of the team with the desired roles,
+	// adding them or changing their role as needed. With the Exclusive option, the members of
+	// the team that aren't in req are removed. All of req is validated and defaulted before
+	// any change is made, and logins must be unique.
+	//
+	// actionTaken is true if anything was changed.
+	Reconcile(ctx context.Context, req []TeamMemberInfo, opts ...TeamMemberReconcileOption) (actionTaken bool, err error)
 }
 
 // TeamAccessClient operates on the teams list for a specific repository.
@@ -181,6 +255,49 @@ type TeamAccessClient interface {
 	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 	// If req is already the actual state, this is a no-op (actionTaken == false).
 	Reconcile(ctx context.Context, req TeamAccessInfo) (resp TeamAccess, actionTaken bool, err error)
+
+	// ReconcileWithChanges works like Reconcile, but returns the fields that were changed instead
+	// of actionTaken, with their actual (old) and desired (new) values. If req is created, all its
+	// set fields are listed without old values. If req is already the actual state, no changes are
+	// returned.
+	ReconcileWithChanges(ctx context.Context, req TeamAccessInfo) (resp TeamAccess, changes []FieldChange, err error)
+
+	// ReconcileAll makes sure the team access control list of the repository becomes exactly the
+	// given desired set (req).
+	//
+	// Teams in req that don't have access are added, and the ones with another permission are
+	// updated. Teams that aren't in req are removed, which requires destructive API calls to be
+	// enabled; else ErrDestructiveCallDisallowed is returned before any change is made.
+	// With the PlanOnly option, the changes are reported without being applied.
+	ReconcileAll(ctx context.Context, req []TeamAccessInfo, opts ...ReconcileAllOption) (*ReconcileReport, error)
+}
+
+// CollaboratorClient operates on the users with direct access to a specific repository.
+// This client can be accessed through Repository.Collaborators().
+type CollaboratorClient interface {
+	// Get the permission level of the user with the given login, if they have direct access to
+	// the repository or have been invited to it.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, login string) (Collaborator, error)
+
+	// List the users with direct access to the repository, and the pending invitations.
+	//
+	// List returns all available collaborators, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Collaborator, error)
+
+	// Create gives the user direct access to the repository. On providers that require the
+	// user to accept an invitation first, the returned collaborator is pending.
+	//
+	// ErrAlreadyExists will be returned if the resource already exists.
+	Create(ctx context.Context, req CollaboratorInfo) (Collaborator, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req CollaboratorInfo) (resp Collaborator, actionTaken bool, err error)
 }
 
 // DeployKeyClient operates on the access credential list for a specific repository.
@@ -208,6 +325,21 @@ type DeployKeyClient interface {
 	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 	// If req is already the actual state, this is a no-op (actionTaken == false).
 	Reconcile(ctx context.Context, req DeployKeyInfo) (resp DeployKey, actionTaken bool, err error)
+
+	// ReconcileWithChanges works like Reconcile, but returns the fields that were changed instead
+	// of actionTaken, with their actual (old) and desired (new) values. If req is created, all its
+	// set fields are listed without old values. If req is already the actual state, no changes are
+	// returned.
+	ReconcileWithChanges(ctx context.Context, req DeployKeyInfo) (resp DeployKey, changes []FieldChange, err error)
+
+	// ReconcileAll makes sure the deploy keys of the repository become exactly the given desired
+	// set (req).
+	//
+	// Deploy keys in req that don't exist are created, and the ones that don't equal the actual
+	// state are updated. Deploy keys that aren't in req are deleted, which requires destructive API
+	// calls to be enabled; else ErrDestructiveCallDisallowed is returned before any change is made.
+	// With the PlanOnly option, the changes are reported without being applied.
+	ReconcileAll(ctx context.Context, req []DeployKeyInfo, opts ...ReconcileAllOption) (*ReconcileReport, error)
 }
 
 // DeployTokenClient operates on the deploy token list of a specific repository.
@@ -235,6 +367,83 @@ type DeployTokenClient interface {
 	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 	// If req is already the actual state, this is a no-op (actionTaken == false).
 	Reconcile(ctx context.Context, req DeployTokenInfo) (resp DeployToken, actionTaken bool, err error)
+
+	// ReconcileWithChanges works like Reconcile, but returns the fields that were changed instead
+	// of actionTaken, with their actual (old) and desired (new) values. As token values can't be
+	// read back from the server, an existing token is always regenerated, and the changes compare
+	// the old and the regenerated token. Token values are redacted.
+	ReconcileWithChanges(ctx context.Context, req DeployTokenInfo) (resp DeployToken, changes []FieldChange, err error)
+}
+
+// WebhookClient operates on the webhooks of a specific repository.
+// This client can be accessed through Repository.Webhooks().
+type WebhookClient interface {
+	// Get a Webhook by the URL it delivers events to.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, url string) (Webhook, error)
+
+	// List all webhooks for the given repository.
+	//
+	// List returns all available webhooks for the given repository,
+	// using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Webhook, error)
+
+	// Create a webhook with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if a webhook for the same URL already exists.
+	Create(ctx context.Context, req WebhookInfo) (Webhook, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req WebhookInfo) (resp Webhook, actionTaken bool, err error)
+}
+
+// BranchProtectionClient operates on the branch protections of a specific repository.
+// This client can be accessed through Repository.BranchProtections().
+type BranchProtectionClient interface {
+	// Get the protection of the given branch.
+	//
+	// ErrNotFound is returned if the branch is not protected.
+	Get(ctx context.Context, branch string) (BranchProtection, error)
+
+	// List all branch protections for the given repository.
+	//
+	// List returns all available branch protections for the given repository,
+	// using multiple paginated requests if needed.
+	List(ctx context.Context) ([]BranchProtection, error)
+
+	// Create protects a branch with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if the branch is already protected.
+	Create(ctx context.Context, req BranchProtectionInfo) (BranchProtection, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req BranchProtectionInfo) (resp BranchProtection, actionTaken bool, err error)
+}
+
+// CommitStatusClient operates on the statuses of commits in a specific repository.
+// This client can be accessed through Repository.CommitStatuses().
+type CommitStatusClient interface {
+	// List lists all statuses of the commit with the given sha, including the ones
+	// replaced by newer statuses with the same context.
+	//
+	// List returns all available statuses, using multiple paginated requests if needed.
+	List(ctx context.Context, sha string) ([]CommitStatus, error)
+
+	// Create creates a status for the commit with the given sha.
+	Create(ctx context.Context, sha string, req CommitStatusInfo) (CommitStatus, error)
+
+	// Combined returns the combined status of the commit with the given sha, made up of the
+	// latest status of every context.
+	Combined(ctx context.Context, sha string) (*CombinedCommitStatusInfo, error)
 }
 
 // CommitClient operates on the commits list for a specific repository.
@@ -250,30 +459,273 @@ type CommitClient interface {
 // BranchClient operates on the branches for a specific repository.
 // This client can be accessed through Repository.Branches().
 type BranchClient interface {
+	// Get returns the branch with the given name.
+	//
+	// ErrNotFound is returned if the branch does not exist.
+	Get(ctx context.Context, branch string) (Branch, error)
+
+	// List lists all branches of the repository.
+	//
+	// List returns all available branches for the given repository,
+	// using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Branch, error)
+
 	// Create creates a branch with the given specifications.
 	Create(ctx context.Context, branch, sha string) error
+
+	// Delete deletes the branch with the given name irreversibly.
+	//
+	// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+	// WithDestructiveAPICalls(true). ErrNotFound is returned if the branch does not exist.
+	Delete(ctx context.Context, branch string) error
+
+	// RenameDefault renames the default branch of the repository to the given name, and makes
+	// the open pull requests into it target the renamed branch.
+	//
+	// GitHub and Gitea rename the branch natively, which also moves its protection rules; the old
+	// name no longer exists afterwards. The other providers can't rename branches, so there a new
+	// branch is created at the head of the default branch, made the default branch, and the open
+	// pull requests are retargeted to it using RetargetPullRequests. The old branch and its
+	// protection rules are kept then, it can be deleted using Delete once it's no longer needed.
+	//
+	// ErrAlreadyExists is returned if a branch with the given name already exists.
+	RenameDefault(ctx context.Context, branch string) error
+}
+
+// TagClient operates on the tags of a specific repository.
+// This client can be accessed through Repository.Tags().
+type TagClient interface {
+	// Get returns the tag with the given name.
+	//
+	// ErrNotFound is returned if the tag does not exist.
+	Get(ctx context.Context, name string) (Tag, error)
+
+	// List lists all tags of the repository.
+	//
+	// List returns all available tags for the given repository,
+	// using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Tag, error)
+
+	// Create creates a tag with the given specifications. An annotated tag is created if
+	// req.Message is set, otherwise a lightweight tag.
+	//
+	// ErrAlreadyExists will be returned if the tag already exists.
+	Create(ctx context.Context, req TagInfo) (Tag, error)
+
+	// Delete deletes the tag with the given name irreversibly.
+	//
+	// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+	// WithDestructiveAPICalls(true). ErrNotFound is returned if the tag does not exist.
+	Delete(ctx context.Context, name string) error
+}
+
+// ReleaseClient operates on the releases of a specific repository.
+// This client can be accessed through Repository.Releases().
+type ReleaseClient interface {
+	// Get returns the release made from the tag with the given name.
+	//
+	// ErrNotFound is returned if the release does not exist.
+	Get(ctx context.Context, tagName string) (Release, error)
+
+	// List lists all releases of the repository.
+	//
+	// List returns all available releases for the given repository,
+	// using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Release, error)
+
+	// Create creates a release with the given specifications. If the tag doesn't exist yet,
+	// it's created from req.Target.
+	//
+	// ErrAlreadyExists will be returned if a release for the tag already exists.
+	Create(ctx context.Context, req ReleaseInfo) (Release, error)
 }
 
 // PullRequestClient operates on the pull requests for a specific repository.
 // This client can be accessed through Repository.PullRequests().
 type PullRequestClient interface {
-	// List lists all pull requests in the repository
-	List(ctx context.Context) ([]PullRequest, error)
+	// List lists the pull requests in the repository, by default only the open ones. Pass
+	// PullRequestListOptions{State: PullRequestStateVar(PullRequestStateAll)} to list them in any
+	// state, as List on GitLab did before the options were added.
+	// The pull requests can be filtered by state, source and target branch using
+	// PullRequestListOptions.
+	//
+	// List returns all available pull requests, using multiple paginated requests if needed.
+	List(ctx context.Context, opts ...PullRequestListOption) ([]PullRequest, error)
 	// Create creates a pull request with the given specifications.
 	Create(ctx context.Context, title, branch, baseBranch, description string) (PullRequest, error)
 	// Edit allows for changing an existing pull request using the given options. Please refer to "EditOptions" for details on which data can be
 	// edited.
+	//
+	// ErrNoProviderSupport is returned if one of the set options isn't supported by the provider, in which case
+	// the pull request isn't changed.
 	Edit(ctx context.Context, number int, opts EditOptions) (PullRequest, error)
 	// Get retrieves an existing pull request by number
 	Get(ctx context.Context, number int) (PullRequest, error)
-	// Merge merges a pull request with via either the "Squash" or "Merge" method
-	Merge(ctx context.Context, number int, mergeMethod MergeMethod, message string) error
+	// Merge merges a pull request via the "Merge", "Squash" or "Rebase" method, and returns the
+	// commit the base branch points to afterwards. The head of the pull request can be guarded,
+	// and the source branch deleted, using PullRequestMergeOptions.
+	//
+	// ErrNoProviderSupport is returned if the provider doesn't support the merge method, and a
+	// *ConflictError if the pull request can't be merged as it changed or conflicts with its base branch.
+	Merge(ctx context.Context, number int, mergeMethod MergeMethod, message string, opts ...PullRequestMergeOption) (*PullRequestMergeResult, error)
+	// EnableAutoMerge makes the provider merge the pull request via the given method as soon as
+	// its requirements are met, e.g. once the required status checks or pipelines succeeded.
+	//
+	// ErrNoProviderSupport is returned if the provider can't merge pull requests automatically,
+	// or not via the given method.
+	EnableAutoMerge(ctx context.Context, number int, mergeMethod MergeMethod) error
+	// DisableAutoMerge cancels merging the pull request automatically.
+	//
+	// ErrNoProviderSupport is returned if the provider can't merge pull requests automatically.
+	DisableAutoMerge(ctx context.Context, number int) error
 }
 
 // EditOptions is provided to a PullRequestClient's "Edit" method for updating an existing pull request.
 type EditOptions struct {
 	// Title is set to a non-nil value to request a pull request's title to be changed.
 	Title *string
+	// Description is set to a non-nil value to request a pull request's description to be changed.
+	Description *string
+	// TargetBranch is set to a non-nil value to request a pull request's target branch to be changed.
+	TargetBranch *string
+	// State is set to PullRequestStateClosed to close the pull request, or to PullRequestStateOpen
+	// to reopen it. Other states can't be set.
+	State *PullRequestState
+	// Draft is set to true to mark the pull request as a draft, or to false to mark it ready for review.
+	Draft *bool
+	// Assignees is set to a non-nil value to replace the assignees of the pull request with the given logins.
+	Assignees []string
+	// Reviewers is set to a non-nil value to request a review from the given logins, in addition
+	// to the already requested reviews.
+	Reviewers []string
+	// Labels is set to a non-nil value to replace the labels of the pull request with the given names.
+	Labels []string
+}
+
+// PullRequestCommentClient operates on the comments in the conversation of a specific pull request.
+// This client can be accessed through PullRequest.Comments().
+type PullRequestCommentClient interface {
+	// Get a comment by its ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id int64) (PullRequestComment, error)
+	// List all comments of the pull request, oldest first.
+	//
+	// List returns all available comments, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]PullRequestComment, error)
+	// Create a comment with the given body.
+	Create(ctx context.Context, req PullRequestCommentInfo) (PullRequestComment, error)
+}
+
+// PullRequestReviewClient operates on the reviews of a specific pull request.
+// This client can be accessed through PullRequest.Reviews().
+type PullRequestReviewClient interface {
+	// List all reviews of the pull request, oldest first. Providers that only track the
+	// latest review state of every reviewer return one review per reviewer.
+	//
+	// List returns all available reviews, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]PullRequestReview, error)
+	// Create submits a review with the given state as the authenticated user.
+	//
+	// ErrNoProviderSupport is returned if the provider can't submit reviews with the given state.
+	Create(ctx context.Context, req PullRequestReviewInfo) (PullRequestReview, error)
+}
+
+// IssueClient operates on the issues of a specific repository.
+// This client can be accessed through Repository.Issues().
+type IssueClient interface {
+	// Get returns the issue with the given number.
+	//
+	// ErrNotFound is returned if the issue does not exist.
+	Get(ctx context.Context, number int) (Issue, error)
+	// List lists the issues in the repository, by default only the open ones. Pull requests
+	// aren't included. The issues can be filtered by state, labels and assignee using
+	// IssueListOptions.
+	//
+	// List returns all available issues, using multiple paginated requests if needed.
+	List(ctx context.Context, opts ...IssueListOption) ([]Issue, error)
+	// Create opens an issue with the title, description, labels, assignees and milestone of req.
+	//
+	// ErrNotFound is returned if the milestone doesn't exist.
+	Create(ctx context.Context, req IssueInfo) (Issue, error)
+	// Edit changes an existing issue using the given options. Please refer to "IssueEditOptions"
+	// for details on which data can be edited.
+	//
+	// ErrNotFound is returned if the issue or the milestone doesn't exist.
+	Edit(ctx context.Context, number int, opts IssueEditOptions) (Issue, error)
+	// Close closes the issue, which is a no-op if it's closed already.
+	//
+	// ErrNotFound is returned if the issue does not exist.
+	Close(ctx context.Context, number int) (Issue, error)
+}
+
+// IssueEditOptions is provided to an IssueClient's "Edit" method for updating an existing issue.
+type IssueEditOptions struct {
+	// Title is set to a non-nil value to request an issue's title to be changed.
+	Title *string
+	// Description is set to a non-nil value to request an issue's description to be changed.
+	Description *string
+	// State is set to IssueStateClosed to close the issue, or to IssueStateOpen to reopen it.
+	State *IssueState
+	// Assignees is set to a non-nil value to replace the assignees of the issue with the given logins.
+	Assignees []string
+	// Labels is set to a non-nil value to replace the labels of the issue with the given names.
+	Labels []string
+	// Milestone is set to a non-nil value to move the issue to the milestone with the given title,
+	// or to the empty string to remove it from its milestone.
+	Milestone *string
+}
+
+// IssueCommentClient operates on the comments of a specific issue.
+// This client can be accessed through Issue.Comments().
+type IssueCommentClient interface {
+	// Get a comment by its ID.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, id int64) (IssueComment, error)
+	// List all comments of the issue, oldest first.
+	//
+	// List returns all available comments, using multiple paginated requests if needed.
+	List(ctx context.Context) ([]IssueComment, error)
+	// Create a comment with the given body.
+	Create(ctx context.Context, req IssueCommentInfo) (IssueComment, error)
+}
+
+// LabelClient operates on the labels of a specific repository.
+// This client can be accessed through Repository.Labels().
+type LabelClient interface {
+	// Get a label by its name.
+	//
+	// ErrNotFound is returned if the resource does not exist.
+	Get(ctx context.Context, name string) (Label, error)
+
+	// List all labels of the given repository.
+	//
+	// List returns all available labels for the given repository,
+	// using multiple paginated requests if needed.
+	List(ctx context.Context) ([]Label, error)
+
+	// Create a label with the given specifications.
+	//
+	// ErrAlreadyExists will be returned if a label with the same name already exists.
+	Create(ctx context.Context, req LabelInfo) (Label, error)
+
+	// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+	//
+	// If req doesn't exist under the hood, it is created (actionTaken == true).
+	// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+	// If req is already the actual state, this is a no-op (actionTaken == false).
+	Reconcile(ctx context.Context, req LabelInfo) (resp Label, actionTaken bool, err error)
+
+	// ReconcileAll makes sure the labels of the repository become exactly the given desired set (req),
+	// and reports the changes. All of req is validated before any change is made, and label names
+	// must be unique.
+	//
+	// Labels in req that don't exist are created, and the ones that don't equal the actual state are
+	// updated. Labels that aren't in req are deleted, which requires destructive API calls to be
+	// enabled; else ErrDestructiveCallDisallowed is returned before any change is made.
+	// With the PlanOnly option, the changes are reported without being applied.
+	ReconcileAll(ctx context.Context, req []LabelInfo, opts ...ReconcileAllOption) (*ReconcileReport, error)
 }
 
 // FileClient operates on the branches for a specific repository.
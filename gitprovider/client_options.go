@@ -45,6 +45,11 @@ type CommonClientOptions struct {
 	// deleting a repository) are allowed in the Client. Default: false
 	EnableDestructiveAPICalls *bool
 
+	// DryRun is a flag specifying whether the Client should only record the write API calls it
+	// would make in a Plan, instead of making them. Reads still go to the Git provider, so that the
+	// plan reflects the actual state. The plan is returned by Client.DryRunPlan. Default: false
+	DryRun *bool
+
 	// PreChainTransportHook is a function to get a custom RoundTripper that is given as the Transport
 	// to the *http.Client given to the provider-specific Client. It can be set for doing arbitrary
 	// modifications to HTTP requests. "in" might be nil, if so http.DefaultTransport is recommended.
@@ -89,6 +94,14 @@ func (opts *CommonClientOptions) ApplyToCommonClientOptions(target *CommonClient
 		target.EnableDestructiveAPICalls = opts.EnableDestructiveAPICalls
 	}
 
+	if opts.DryRun != nil {
+		// Make sure the user didn't specify the DryRun twice
+		if target.DryRun != nil {
+			return fmt.Errorf("option DryRun already configured: %w", ErrInvalidClientOptions)
+		}
+		target.DryRun = opts.DryRun
+	}
+
 	if opts.PreChainTransportHook != nil {
 		// Make sure the user didn't specify the PreChainTransportHook twice
 		if target.PreChainTransportHook != nil {
@@ -243,6 +256,12 @@ func WithDestructiveAPICalls(destructiveActions bool) ClientOption {
 	return buildCommonOption(CommonClientOptions{EnableDestructiveAPICalls: &destructiveActions})
 }
 
+// WithDryRun sets whether the Client only records the write API calls it would make, instead of
+// making them. The recorded operations are available through Client.DryRunPlan.
+func WithDryRun(dryRun bool) ClientOption {
+	return buildCommonOption(CommonClientOptions{DryRun: &dryRun})
+}
+
 // WithPreChainTransportHook registers a ChainableRoundTripperFunc "before" the cache and authentication
 // transports in the chain. For more information, see NewClient, and gitprovider.CommonClientOptions.PreChainTransportHook.
 func WithPreChainTransportHook(preRoundTripperFunc ChainableRoundTripperFunc) ClientOption {
@@ -242,6 +242,16 @@ func Test_makeCientOptions(t *testing.T) {
 			opts: []ClientOption{WithDestructiveAPICalls(true)},
 			want: buildCommonOption(CommonClientOptions{EnableDestructiveAPICalls: BoolVar(true)}),
 		},
+		{
+			name: "WithDryRun",
+			opts: []ClientOption{WithDryRun(true)},
+			want: buildCommonOption(CommonClientOptions{DryRun: BoolVar(true)}),
+		},
+		{
+			name:         "WithDryRun, duplicate",
+			opts:         []ClientOption{WithDryRun(true), WithDryRun(false)},
+			expectedErrs: []error{ErrInvalidClientOptions},
+		},
 		{
 			name: "WithPreChainTransportHook",
 			opts: []ClientOption{WithPreChainTransportHook(dummyRoundTripper1)},
@@ -0,0 +1,1087 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/testutils"
+)
+
+const (
+	testFilePath    = "conformance/file.txt"
+	testFileContent = "conformance\n"
+	testBranch      = "conformance"
+	testDefault     = "conformance-default"
+)
+
+// checks is the table of behavioural checks run against every client.
+var checks = []check{
+	{"Client/Identity", checkClientIdentity},
+	{"Organizations/Get", checkOrganizationsGet},
+	{"Organizations/GetNotFound", checkOrganizationsGetNotFound},
+	{"Organizations/Lifecycle", checkOrganizationsLifecycle},
+	{"OrgRepositories/Get", checkOrgRepositoriesGet},
+	{"OrgRepositories/GetNotFound", checkOrgRepositoriesGetNotFound},
+	{"OrgRepositories/CreateAlreadyExists", checkOrgRepositoriesCreateAlreadyExists},
+	{"OrgRepositories/List", checkOrgRepositoriesList},
+	{"OrgRepositories/ReconcileNoop", checkOrgRepositoriesReconcileNoop},
+	{"OrgRepositories/ReconcileUpdate", checkOrgRepositoriesReconcileUpdate},
+	{"OrgRepositories/DeleteDisallowed", checkOrgRepositoriesDeleteDisallowed},
+	{"UserRepositories/GetUserLogin", checkUserRepositoriesGetUserLogin},
+	{"DeployKeys/Lifecycle", checkDeployKeysLifecycle},
+	{"DeployKeys/ReconcileAllDryRun", checkDeployKeysReconcileAllDryRun},
+	{"DeployTokens/Lifecycle", checkDeployTokensLifecycle},
+	{"Teams/Lifecycle", checkTeamsLifecycle},
+	{"TeamMembers/ReconcileNoop", checkTeamMembersReconcileNoop},
+	{"TeamAccess/Lifecycle", checkTeamAccessLifecycle},
+	{"Collaborators/GetList", checkCollaboratorsGetList},
+	{"Webhooks/Lifecycle", checkWebhooksLifecycle},
+	{"Labels/Lifecycle", checkLabelsLifecycle},
+	{"Commits/Create", checkCommitsCreate},
+	{"Commits/ListPage", checkCommitsListPage},
+	{"Commits/DeleteFile", checkCommitsDeleteFile},
+	{"CommitStatuses/Lifecycle", checkCommitStatusesLifecycle},
+	{"Branches/Create", checkBranchesCreate},
+	{"Branches/GetList", checkBranchesGetList},
+	{"Branches/Delete", checkBranchesDelete},
+	{"Tags/Lifecycle", checkTagsLifecycle},
+	{"Releases/Lifecycle", checkReleasesLifecycle},
+	{"Files/Get", checkFilesGet},
+	{"Files/GetNotFound", checkFilesGetNotFound},
+	{"Trees/Get", checkTreesGet},
+	{"PullRequests/Lifecycle", checkPullRequestsLifecycle},
+	{"PullRequests/Comments", checkPullRequestsComments},
+	{"PullRequests/Reviews", checkPullRequestsReviews},
+	{"PullRequests/Files", checkPullRequestsFiles},
+	{"PullRequests/GetNotFound", checkPullRequestsGetNotFound},
+	{"PullRequests/Merge", checkPullRequestsMerge},
+	{"Issues/Lifecycle", checkIssuesLifecycle},
+	{"BranchProtections/Lifecycle", checkBranchProtectionsLifecycle},
+	{"Branches/RenameDefault", checkBranchesRenameDefault},
+}
+
+func checkClientIdentity(t *testing.T, s *suite) {
+	if s.client.ProviderID() == "" {
+		t.Error("ProviderID() must not be empty")
+	}
+	if got, want := s.client.SupportedDomain(), s.cfg.Organization.Domain; got != want {
+		t.Errorf("SupportedDomain() = %q, want the organization domain %q", got, want)
+	}
+}
+
+func checkOrganizationsGet(t *testing.T, s *suite) {
+	org, err := s.client.Organizations().Get(s.ctx, s.cfg.Organization)
+	must(t, "Organizations().Get()", err)
+	if got := org.Organization().GetIdentity(); got != s.cfg.Organization.GetIdentity() {
+		t.Errorf("Organizations().Get().Organization() = %q, want %q", got, s.cfg.Organization.GetIdentity())
+	}
+}
+
+func checkOrganizationsGetNotFound(t *testing.T, s *suite) {
+	ref := s.cfg.Organization
+	ref.Organization = "conformance-missing-" + s.repoRef.RepositoryName
+	ref.SubOrganizations = nil
+	_, err := s.client.Organizations().Get(s.ctx, ref)
+	expectErr(t, "Organizations().Get()", err, gitprovider.ErrNotFound)
+}
+
+func checkOrganizationsLifecycle(t *testing.T, s *suite) {
+	orgs := s.client.Organizations()
+	name := "conformance-" + s.repoRef.RepositoryName
+	ref := s.cfg.Organization
+	ref.SubOrganizations = append(append([]string{}, ref.SubOrganizations...), name)
+
+	req := gitprovider.OrganizationInfo{Name: gitprovider.StringVar(name)}
+	org, err := orgs.Create(s.ctx, ref, req)
+	if errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Skip("the provider can't create sub-organizations")
+	}
+	must(t, "Organizations().Create()", err)
+	if got := org.Get().Parent; got == nil || *got != s.cfg.Organization.GetIdentity() {
+		t.Errorf("Organizations().Create().Parent = %v, want %q", got, s.cfg.Organization.GetIdentity())
+	}
+	_, err = orgs.Create(s.ctx, ref, req)
+	expectErr(t, "Organizations().Create() of an existing organization", err, gitprovider.ErrAlreadyExists)
+
+	_, actionTaken, err := orgs.Reconcile(s.ctx, ref, req)
+	must(t, "Organizations().Reconcile()", err)
+	if actionTaken {
+		t.Error("Organizations().Reconcile() of the actual state must not take any action")
+	}
+	req.Description = gitprovider.StringVar("Conformance")
+	org, actionTaken, err = orgs.Reconcile(s.ctx, ref, req)
+	must(t, "Organizations().Reconcile()", err)
+	if !actionTaken {
+		t.Error("Organizations().Reconcile() of a changed description must take action")
+	}
+	if got := org.Get().Description; got == nil || *got != *req.Description {
+		t.Errorf("Organizations().Reconcile().Description = %v, want %q", got, *req.Description)
+	}
+
+	expectErr(t, "Organizations().Delete() without destructive calls", s.safeClient.Organizations().Delete(s.ctx, ref), gitprovider.ErrDestructiveCallDisallowed)
+	must(t, "Organizations().Delete()", orgs.Delete(s.ctx, ref))
+	_, err = orgs.Get(s.ctx, ref)
+	expectErr(t, "Organizations().Get() of a deleted organization", err, gitprovider.ErrNotFound)
+}
+
+func checkOrgRepositoriesGet(t *testing.T, s *suite) {
+	repo, err := s.client.OrgRepositories().Get(s.ctx, s.repoRef)
+	must(t, "OrgRepositories().Get()", err)
+	info := repo.Get()
+	if info.Description == nil || *info.Description != *s.repo.Get().Description {
+		t.Errorf("OrgRepositories().Get().Description = %v, want %q", info.Description, *s.repo.Get().Description)
+	}
+	if info.DefaultBranch == nil || *info.DefaultBranch != s.defaultBranch {
+		t.Errorf("OrgRepositories().Get().DefaultBranch = %v, want %q", info.DefaultBranch, s.defaultBranch)
+	}
+	if got := repo.Repository().GetRepository(); got != s.repoRef.RepositoryName {
+		t.Errorf("OrgRepositories().Get().Repository() = %q, want %q", got, s.repoRef.RepositoryName)
+	}
+}
+
+func checkOrgRepositoriesGetNotFound(t *testing.T, s *suite) {
+	ref := s.repoRef
+	ref.RepositoryName += "-missing"
+	_, err := s.client.OrgRepositories().Get(s.ctx, ref)
+	expectErr(t, "OrgRepositories().Get()", err, gitprovider.ErrNotFound)
+}
+
+func checkOrgRepositoriesCreateAlreadyExists(t *testing.T, s *suite) {
+	_, err := s.client.OrgRepositories().Create(s.ctx, s.repoRef, gitprovider.RepositoryInfo{})
+	expectErr(t, "OrgRepositories().Create()", err, gitprovider.ErrAlreadyExists)
+}
+
+func checkOrgRepositoriesList(t *testing.T, s *suite) {
+	repos, err := s.client.OrgRepositories().List(s.ctx, s.cfg.Organization)
+	must(t, "OrgRepositories().List()", err)
+	for _, repo := range repos {
+		if repo.Repository().GetRepository() == s.repoRef.RepositoryName {
+			return
+		}
+	}
+	t.Errorf("OrgRepositories().List() doesn't contain %q", s.repoRef.RepositoryName)
+}
+
+func checkOrgRepositoriesReconcileNoop(t *testing.T, s *suite) {
+	_, actionTaken, err := s.client.OrgRepositories().Reconcile(s.ctx, s.repoRef, s.repo.Get())
+	must(t, "OrgRepositories().Reconcile()", err)
+	if actionTaken {
+		t.Error("OrgRepositories().Reconcile() of the actual state must not take any action")
+	}
+}
+
+func checkOrgRepositoriesReconcileUpdate(t *testing.T, s *suite) {
+	req := s.repo.Get()
+	req.Description = gitprovider.StringVar("go-git-providers conformance test, updated")
+	_, actionTaken, err := s.client.OrgRepositories().Reconcile(s.ctx, s.repoRef, req)
+	must(t, "OrgRepositories().Reconcile()", err)
+	if !actionTaken {
+		t.Error("OrgRepositories().Reconcile() of a changed description must take action")
+	}
+
+	repo, err := s.client.OrgRepositories().Get(s.ctx, s.repoRef)
+	must(t, "OrgRepositories().Get()", err)
+	if got := repo.Get().Description; got == nil || *got != *req.Description {
+		t.Errorf("OrgRepositories().Get().Description = %v after Reconcile(), want %q", got, *req.Description)
+	}
+}
+
+func checkOrgRepositoriesDeleteDisallowed(t *testing.T, s *suite) {
+	repo, err := s.safeClient.OrgRepositories().Get(s.ctx, s.repoRef)
+	must(t, "OrgRepositories().Get()", err)
+	expectErr(t, "Delete() without destructive calls", repo.Delete(s.ctx), gitprovider.ErrDestructiveCallDisallowed)
+}
+
+func checkUserRepositoriesGetUserLogin(t *testing.T, s *suite) {
+	login, err := s.client.UserRepositories().GetUserLogin(s.ctx)
+	must(t, "UserRepositories().GetUserLogin()", err)
+	if login.GetIdentity() == "" {
+		t.Error("UserRepositories().GetUserLogin() returned an empty identity")
+	}
+	if got := login.GetDomain(); got != s.client.SupportedDomain() {
+		t.Errorf("UserRepositories().GetUserLogin().GetDomain() = %q, want %q", got, s.client.SupportedDomain())
+	}
+}
+
+func checkDeployKeysLifecycle(t *testing.T, s *suite) {
+	keyPair, err := testutils.NewEd25519Generator().Generate()
+	must(t, "generate key", err)
+	req := gitprovider.DeployKeyInfo{
+		Name: "conformance",
+		Key:  keyPair.PublicKey,
+	}
+
+	keys := s.repo.DeployKeys()
+	key, err := keys.Create(s.ctx, req)
+	must(t, "DeployKeys().Create()", err)
+	if got := key.Get().Name; got != req.Name {
+		t.Errorf("DeployKeys().Create().Name = %q, want %q", got, req.Name)
+	}
+	_, err = keys.Create(s.ctx, req)
+	expectErr(t, "DeployKeys().Create() of an existing key", err, gitprovider.ErrAlreadyExists)
+
+	_, err = keys.Get(s.ctx, req.Name)
+	must(t, "DeployKeys().Get()", err)
+	list, err := keys.List(s.ctx)
+	must(t, "DeployKeys().List()", err)
+	if len(list) != 1 {
+		t.Errorf("DeployKeys().List() returned %d keys, want 1", len(list))
+	}
+
+	_, actionTaken, err := keys.Reconcile(s.ctx, req)
+	must(t, "DeployKeys().Reconcile()", err)
+	if actionTaken {
+		t.Error("DeployKeys().Reconcile() of the actual state must not take any action")
+	}
+
+	must(t, "DeployKey.Delete()", key.Delete(s.ctx))
+	_, err = keys.Get(s.ctx, req.Name)
+	expectErr(t, "DeployKeys().Get() of a deleted key", err, gitprovider.ErrNotFound)
+}
+
+func checkDeployKeysReconcileAllDryRun(t *testing.T, s *suite) {
+	keys := s.repo.DeployKeys()
+	actual, err := keys.List(s.ctx)
+	must(t, "DeployKeys().List()", err)
+
+	// Removing all keys in a dry run must only report the deletions
+	report, err := keys.ReconcileAll(s.ctx, nil, &gitprovider.ReconcileAllOptions{PlanOnly: gitprovider.BoolVar(true)})
+	must(t, "DeployKeys().ReconcileAll()", err)
+	if !report.PlanOnly || len(report.Changes) != len(actual) {
+		t.Errorf("DeployKeys().ReconcileAll() = %+v, want a dry run deleting %d keys", report, len(actual))
+	}
+	after, err := keys.List(s.ctx)
+	must(t, "DeployKeys().List()", err)
+	if len(after) != len(actual) {
+		t.Errorf("DeployKeys().List() after a dry run returned %d keys, want %d", len(after), len(actual))
+	}
+}
+
+func checkDeployTokensLifecycle(t *testing.T, s *suite) {
+	tokens, err := s.repo.DeployTokens()
+	must(t, "DeployTokens()", err)
+
+	req := gitprovider.DeployTokenInfo{Name: "conformance"}
+	token, err := tokens.Create(s.ctx, req)
+	must(t, "DeployTokens().Create()", err)
+	if token.Get().Token == "" {
+		t.Error("DeployTokens().Create() must return the token value")
+	}
+	_, err = tokens.Get(s.ctx, req.Name)
+	must(t, "DeployTokens().Get()", err)
+
+	must(t, "DeployToken.Delete()", token.Delete(s.ctx))
+	_, err = tokens.Get(s.ctx, req.Name)
+	expectErr(t, "DeployTokens().Get() of a deleted token", err, gitprovider.ErrNotFound)
+}
+
+func checkTeamsLifecycle(t *testing.T, s *suite) {
+	org, err := s.client.Organizations().Get(s.ctx, s.cfg.Organization)
+	must(t, "Organizations().Get()", err)
+	teams := org.Teams()
+
+	req := gitprovider.TeamInfo{Name: "conformance-" + s.repoRef.RepositoryName}
+	_, err = teams.Create(s.ctx, req)
+	if errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Skip("the provider can't create teams")
+	}
+	must(t, "Teams().Create()", err)
+	_, err = teams.Create(s.ctx, req)
+	expectErr(t, "Teams().Create() of an existing team", err, gitprovider.ErrAlreadyExists)
+
+	_, actionTaken, err := teams.Reconcile(s.ctx, req)
+	must(t, "Teams().Reconcile()", err)
+	if actionTaken {
+		t.Error("Teams().Reconcile() of the actual state must not take any action")
+	}
+	req.Description = gitprovider.StringVar("Conformance")
+	_, actionTaken, err = teams.Reconcile(s.ctx, req)
+	if !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		must(t, "Teams().Reconcile()", err)
+		if !actionTaken {
+			t.Error("Teams().Reconcile() of a changed description must take action")
+		}
+	}
+
+	safeOrg, err := s.safeClient.Organizations().Get(s.ctx, s.cfg.Organization)
+	must(t, "Organizations().Get()", err)
+	team, err := safeOrg.Teams().Get(s.ctx, req.Name)
+	must(t, "Teams().Get()", err)
+	expectErr(t, "Team.Delete() without destructive calls", team.Delete(s.ctx), gitprovider.ErrDestructiveCallDisallowed)
+
+	team, err = teams.Get(s.ctx, req.Name)
+	must(t, "Teams().Get()", err)
+	must(t, "Team.Delete()", team.Delete(s.ctx))
+	_, err = teams.Get(s.ctx, req.Name)
+	expectErr(t, "Teams().Get() of a deleted team", err, gitprovider.ErrNotFound)
+}
+
+func checkTeamMembersReconcileNoop(t *testing.T, s *suite) {
+	if s.cfg.Team == "" {
+		t.Skip("Config.Team isn't set")
+	}
+	org, err := s.client.Organizations().Get(s.ctx, s.cfg.Organization)
+	must(t, "Organizations().Get()", err)
+	team, err := org.Teams().Get(s.ctx, s.cfg.Team)
+	must(t, "Teams().Get()", err)
+	members := team.Members()
+
+	actual, err := members.List(s.ctx)
+	must(t, "Members().List()", err)
+	actionTaken, err := members.Reconcile(s.ctx, actual, &gitprovider.TeamMemberReconcileOptions{Exclusive: gitprovider.BoolVar(true)})
+	must(t, "Members().Reconcile()", err)
+	if actionTaken {
+		t.Error("Members().Reconcile() of the actual members must not take any action")
+	}
+	expectErr(t, "Members().Remove() of a non-member", members.Remove(s.ctx, "conformance-"+s.repoRef.RepositoryName), gitprovider.ErrNotFound)
+}
+
+func checkTeamAccessLifecycle(t *testing.T, s *suite) {
+	if s.cfg.Team == "" {
+		t.Skip("Config.Team isn't set")
+	}
+	teamAccess := s.repo.TeamAccess()
+
+	req := gitprovider.TeamAccessInfo{
+		Name:       s.cfg.Team,
+		Permission: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush),
+	}
+	_, actionTaken, err := teamAccess.Reconcile(s.ctx, req)
+	must(t, "TeamAccess().Reconcile()", err)
+	if !actionTaken {
+		t.Error("TeamAccess().Reconcile() of a new team must take action")
+	}
+	_, actionTaken, err = teamAccess.Reconcile(s.ctx, req)
+	must(t, "TeamAccess().Reconcile()", err)
+	if actionTaken {
+		t.Error("TeamAccess().Reconcile() of the actual state must not take any action")
+	}
+
+	ta, err := teamAccess.Get(s.ctx, s.cfg.Team)
+	must(t, "TeamAccess().Get()", err)
+	if got := ta.Get().Permission; got == nil || *got != *req.Permission {
+		t.Errorf("TeamAccess().Get().Permission = %v, want %q", got, *req.Permission)
+	}
+
+	must(t, "TeamAccess.Delete()", ta.Delete(s.ctx))
+	_, err = teamAccess.Get(s.ctx, s.cfg.Team)
+	expectErr(t, "TeamAccess().Get() of a deleted team access", err, gitprovider.ErrNotFound)
+}
+
+func checkCollaboratorsGetList(t *testing.T, s *suite) {
+	collaborators := s.repo.Collaborators()
+
+	list, err := collaborators.List(s.ctx)
+	must(t, "Collaborators().List()", err)
+	for _, collaborator := range list {
+		info := collaborator.Get()
+		got, err := collaborators.Get(s.ctx, info.Login)
+		must(t, "Collaborators().Get()", err)
+		if !info.Equals(got.Get()) {
+			t.Errorf("Collaborators().Get(%q) = %+v, want %+v", info.Login, got.Get(), info)
+		}
+	}
+	_, err = collaborators.Get(s.ctx, "conformance-"+s.repoRef.RepositoryName)
+	expectErr(t, "Collaborators().Get() of a non-collaborator", err, gitprovider.ErrNotFound)
+}
+
+func checkWebhooksLifecycle(t *testing.T, s *suite) {
+	req := gitprovider.WebhookInfo{
+		URL:    "https://example.com/" + s.repoRef.RepositoryName,
+		Secret: gitprovider.StringVar("conformance"),
+		Events: []gitprovider.WebhookEvent{gitprovider.WebhookEventPush, gitprovider.WebhookEventPullRequest},
+	}
+
+	webhooks := s.repo.Webhooks()
+	hook, err := webhooks.Create(s.ctx, req)
+	must(t, "Webhooks().Create()", err)
+	if got := hook.Get().URL; got != req.URL {
+		t.Errorf("Webhooks().Create().URL = %q, want %q", got, req.URL)
+	}
+	if hook.Get().Secret != nil {
+		t.Error("Webhooks().Create() must not return the secret")
+	}
+	_, err = webhooks.Create(s.ctx, req)
+	expectErr(t, "Webhooks().Create() of an existing webhook", err, gitprovider.ErrAlreadyExists)
+
+	_, err = webhooks.Get(s.ctx, req.URL)
+	must(t, "Webhooks().Get()", err)
+	list, err := webhooks.List(s.ctx)
+	must(t, "Webhooks().List()", err)
+	if len(list) != 1 {
+		t.Errorf("Webhooks().List() returned %d webhooks, want 1", len(list))
+	}
+
+	_, actionTaken, err := webhooks.Reconcile(s.ctx, req)
+	must(t, "Webhooks().Reconcile()", err)
+	if actionTaken {
+		t.Error("Webhooks().Reconcile() of the actual state must not take any action")
+	}
+	req.Events = append(req.Events, gitprovider.WebhookEventComment)
+	_, actionTaken, err = webhooks.Reconcile(s.ctx, req)
+	must(t, "Webhooks().Reconcile()", err)
+	if !actionTaken {
+		t.Error("Webhooks().Reconcile() of changed events must take action")
+	}
+	hook, err = webhooks.Get(s.ctx, req.URL)
+	must(t, "Webhooks().Get()", err)
+	if got := hook.Get().Events; len(got) != 3 {
+		t.Errorf("Webhooks().Get().Events = %v, want 3 events", got)
+	}
+
+	must(t, "Webhook.Delete()", hook.Delete(s.ctx))
+	_, err = webhooks.Get(s.ctx, req.URL)
+	expectErr(t, "Webhooks().Get() of a deleted webhook", err, gitprovider.ErrNotFound)
+}
+
+func checkLabelsLifecycle(t *testing.T, s *suite) {
+	req := gitprovider.LabelInfo{Name: "conformance", Color: "#D73A4A"}
+
+	labels := s.repo.Labels()
+	label, err := labels.Create(s.ctx, req)
+	if errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Skip("the provider has no labels")
+	}
+	must(t, "Labels().Create()", err)
+	if got := label.Get(); got.Name != req.Name || got.Color != "d73a4a" {
+		t.Errorf("Labels().Create() = %+v, want the name and the normalized color", got)
+	}
+	_, err = labels.Create(s.ctx, req)
+	expectErr(t, "Labels().Create() of an existing label", err, gitprovider.ErrAlreadyExists)
+
+	_, actionTaken, err := labels.Reconcile(s.ctx, req)
+	must(t, "Labels().Reconcile()", err)
+	if actionTaken {
+		t.Error("Labels().Reconcile() of the actual state must not take any action")
+	}
+	req.Description = "conformance"
+	_, actionTaken, err = labels.Reconcile(s.ctx, req)
+	must(t, "Labels().Reconcile()", err)
+	if !actionTaken {
+		t.Error("Labels().Reconcile() of a changed description must take action")
+	}
+
+	desired := []gitprovider.LabelInfo{{Name: "conformance-set", Color: "0075ca"}}
+	repo, err := s.safeClient.OrgRepositories().Get(s.ctx, s.repoRef)
+	must(t, "OrgRepositories().Get()", err)
+	_, err = repo.Labels().ReconcileAll(s.ctx, desired)
+	expectErr(t, "Labels().ReconcileAll() removing labels without destructive calls", err, gitprovider.ErrDestructiveCallDisallowed)
+	if _, err := labels.Get(s.ctx, "conformance-set"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Labels().ReconcileAll() without destructive calls made changes, Get() error = %v", err)
+	}
+
+	report, err := labels.ReconcileAll(s.ctx, desired)
+	must(t, "Labels().ReconcileAll()", err)
+	if len(report.Changes) == 0 {
+		t.Error("Labels().ReconcileAll() of a changed set must report changes")
+	}
+	list, err := labels.List(s.ctx)
+	must(t, "Labels().List()", err)
+	if len(list) != 1 || list[0].Get().Name != "conformance-set" {
+		t.Errorf("Labels().List() after ReconcileAll() = %v, want only the desired label", list)
+	}
+	_, err = labels.Get(s.ctx, req.Name)
+	expectErr(t, "Labels().Get() of a label removed by ReconcileAll()", err, gitprovider.ErrNotFound)
+}
+
+func checkCommitsCreate(t *testing.T, s *suite) {
+	commit, err := s.repo.Commits().Create(s.ctx, s.defaultBranch, "Add conformance file", []gitprovider.CommitFile{{
+		Path:    gitprovider.StringVar(testFilePath),
+		Content: gitprovider.StringVar(testFileContent),
+	}})
+	must(t, "Commits().Create()", err)
+	if commit.Get().Sha == "" {
+		t.Error("Commits().Create().Sha must not be empty")
+	}
+}
+
+func checkCommitsListPage(t *testing.T, s *suite) {
+	commits, err := s.repo.Commits().ListPage(s.ctx, s.defaultBranch, 1, 0)
+	must(t, "Commits().ListPage()", err)
+	if len(commits) != 1 {
+		t.Fatalf("Commits().ListPage() with a page size of 1 returned %d commits", len(commits))
+	}
+	if got := commits[0].Get().Message; !strings.HasPrefix(got, "Add conformance file") {
+		t.Errorf("Commits().ListPage()[0].Message = %q, want the latest commit first", got)
+	}
+}
+
+// checkCommitsDeleteFile checks that a CommitFile with nil Content deletes the file.
+func checkCommitsDeleteFile(t *testing.T, s *suite) {
+	deletePath := "conformance/delete.txt"
+	_, err := s.repo.Commits().Create(s.ctx, s.defaultBranch, "Add file to delete", []gitprovider.CommitFile{{
+		Path:    gitprovider.StringVar(deletePath),
+		Content: gitprovider.StringVar("delete me\n"),
+	}})
+	must(t, "Commits().Create()", err)
+	_, err = s.repo.Commits().Create(s.ctx, s.defaultBranch, "Delete file", []gitprovider.CommitFile{{
+		Path: gitprovider.StringVar(deletePath),
+	}})
+	must(t, "Commits().Create() with nil Content", err)
+
+	files, err := s.repo.Files().Get(s.ctx, "conformance", s.defaultBranch)
+	must(t, "Files().Get()", err)
+	for _, f := range files {
+		if *f.Path == deletePath {
+			t.Errorf("%s still exists after committing it with nil Content", deletePath)
+		}
+	}
+}
+
+func checkCommitStatusesLifecycle(t *testing.T, s *suite) {
+	commits, err := s.repo.Commits().ListPage(s.ctx, s.defaultBranch, 1, 0)
+	must(t, "Commits().ListPage()", err)
+	if len(commits) == 0 {
+		t.Fatal("Commits().ListPage() returned no commits")
+	}
+	sha := commits[0].Get().Sha
+
+	statuses := s.repo.CommitStatuses()
+	for _, req := range []gitprovider.CommitStatusInfo{
+		{State: gitprovider.CommitStatusStatePending, Context: "conformance/build"},
+		{State: gitprovider.CommitStatusStateSuccess, Context: "conformance/build"},
+		{State: gitprovider.CommitStatusStateFailure, Context: "conformance/lint"},
+	} {
+		req.TargetURL = "https://example.com/" + req.Context
+		status, err := statuses.Create(s.ctx, sha, req)
+		must(t, "CommitStatuses().Create()", err)
+		if got := status.Get().State; got != req.State {
+			t.Errorf("CommitStatuses().Create().State = %q, want %q", got, req.State)
+		}
+	}
+
+	list, err := statuses.List(s.ctx, sha)
+	must(t, "CommitStatuses().List()", err)
+	// Some providers replace statuses of the same context, others keep them all
+	if len(list) < 2 {
+		t.Errorf("CommitStatuses().List() returned %d statuses, want at least 2", len(list))
+	}
+
+	combined, err := statuses.Combined(s.ctx, sha)
+	must(t, "CommitStatuses().Combined()", err)
+	if combined.State != gitprovider.CommitStatusStateFailure {
+		t.Errorf("CommitStatuses().Combined().State = %q, want %q", combined.State, gitprovider.CommitStatusStateFailure)
+	}
+	if len(combined.Statuses) != 2 {
+		t.Errorf("CommitStatuses().Combined() returned %d statuses, want 2", len(combined.Statuses))
+	}
+}
+
+func checkBranchesCreate(t *testing.T, s *suite) {
+	commits, err := s.repo.Commits().ListPage(s.ctx, s.defaultBranch, 1, 0)
+	must(t, "Commits().ListPage()", err)
+	if len(commits) == 0 {
+		t.Fatalf("no commits on %s", s.defaultBranch)
+	}
+	must(t, "Branches().Create()", s.repo.Branches().Create(s.ctx, testBranch, commits[0].Get().Sha))
+	s.branch = testBranch
+
+	_, err = s.repo.Commits().Create(s.ctx, s.branch, "Change conformance file", []gitprovider.CommitFile{{
+		Path:    gitprovider.StringVar(testFilePath),
+		Content: gitprovider.StringVar(testFileContent + "changed\n"),
+	}})
+	must(t, "Commits().Create() on the new branch", err)
+}
+
+func checkBranchesGetList(t *testing.T, s *suite) {
+	if s.branch == "" {
+		t.Skip("requires Branches/Create")
+	}
+	branch, err := s.repo.Branches().Get(s.ctx, s.branch)
+	must(t, "Branches().Get()", err)
+	if got := branch.Get(); got.Name != s.branch || got.Sha == "" {
+		t.Errorf("Branches().Get() = %+v, want name %q and a head commit", got, s.branch)
+	}
+	list, err := s.repo.Branches().List(s.ctx)
+	must(t, "Branches().List()", err)
+	found := map[string]bool{}
+	for _, b := range list {
+		found[b.Get().Name] = true
+	}
+	for _, name := range []string{s.defaultBranch, s.branch} {
+		if !found[name] {
+			t.Errorf("Branches().List() doesn't contain %q", name)
+		}
+	}
+	_, err = s.repo.Branches().Get(s.ctx, "conformance-missing")
+	expectErr(t, "Branches().Get() of a missing branch", err, gitprovider.ErrNotFound)
+}
+
+func checkBranchesDelete(t *testing.T, s *suite) {
+	const name = "conformance-delete"
+	head, err := s.repo.Branches().Get(s.ctx, s.defaultBranch)
+	must(t, "Branches().Get()", err)
+	must(t, "Branches().Create()", s.repo.Branches().Create(s.ctx, name, head.Get().Sha))
+
+	repo, err := s.safeClient.OrgRepositories().Get(s.ctx, s.repoRef)
+	must(t, "OrgRepositories().Get()", err)
+	expectErr(t, "Branches().Delete() without destructive calls", repo.Branches().Delete(s.ctx, name), gitprovider.ErrDestructiveCallDisallowed)
+
+	must(t, "Branches().Delete()", s.repo.Branches().Delete(s.ctx, name))
+	_, err = s.repo.Branches().Get(s.ctx, name)
+	expectErr(t, "Branches().Get() of a deleted branch", err, gitprovider.ErrNotFound)
+}
+
+func checkTagsLifecycle(t *testing.T, s *suite) {
+	head, err := s.repo.Branches().Get(s.ctx, s.defaultBranch)
+	must(t, "Branches().Get()", err)
+	sha := head.Get().Sha
+
+	tags := s.repo.Tags()
+	lightweight := gitprovider.TagInfo{Name: "conformance-lightweight", Sha: sha}
+	annotated := gitprovider.TagInfo{Name: "conformance-annotated", Sha: sha, Message: gitprovider.StringVar("Conformance")}
+	for _, req := range []gitprovider.TagInfo{lightweight, annotated} {
+		tag, err := tags.Create(s.ctx, req)
+		must(t, "Tags().Create()", err)
+		if got := tag.Get(); got.Name != req.Name || got.Sha != sha {
+			t.Errorf("Tags().Create() = %+v, want name %q at %s", got, req.Name, sha)
+		}
+	}
+	_, err = tags.Create(s.ctx, lightweight)
+	expectErr(t, "Tags().Create() of an existing tag", err, gitprovider.ErrAlreadyExists)
+
+	tag, err := tags.Get(s.ctx, annotated.Name)
+	must(t, "Tags().Get()", err)
+	// Some providers don't return the message of annotated tags
+	if got := tag.Get(); got.Sha != sha || (got.Message != nil && *got.Message != *annotated.Message) {
+		t.Errorf("Tags().Get() = %+v, want %+v", got, annotated)
+	}
+	tag, err = tags.Get(s.ctx, lightweight.Name)
+	must(t, "Tags().Get()", err)
+	if got := tag.Get(); got.Message != nil {
+		t.Errorf("Tags().Get() of a lightweight tag returned message %q", *got.Message)
+	}
+
+	list, err := tags.List(s.ctx)
+	must(t, "Tags().List()", err)
+	found := map[string]bool{}
+	for _, tag := range list {
+		found[tag.Get().Name] = true
+	}
+	repo, err := s.safeClient.OrgRepositories().Get(s.ctx, s.repoRef)
+	must(t, "OrgRepositories().Get()", err)
+	expectErr(t, "Tags().Delete() without destructive calls", repo.Tags().Delete(s.ctx, lightweight.Name), gitprovider.ErrDestructiveCallDisallowed)
+	for _, name := range []string{lightweight.Name, annotated.Name} {
+		if !found[name] {
+			t.Errorf("Tags().List() doesn't contain %q", name)
+		}
+		must(t, "Tags().Delete()", tags.Delete(s.ctx, name))
+	}
+	_, err = tags.Get(s.ctx, lightweight.Name)
+	expectErr(t, "Tags().Get() of a deleted tag", err, gitprovider.ErrNotFound)
+}
+
+func checkReleasesLifecycle(t *testing.T, s *suite) {
+	const tagName = "conformance-release"
+	releases := s.repo.Releases()
+	// Draft releases and prereleases aren't supported by all providers
+	release, err := releases.Create(s.ctx, gitprovider.ReleaseInfo{
+		TagName:     tagName,
+		Target:      gitprovider.StringVar(s.defaultBranch),
+		Description: gitprovider.StringVar("Conformance"),
+	})
+	must(t, "Releases().Create()", err)
+	if got := release.Get(); got.TagName != tagName || got.Name == nil || *got.Name != tagName {
+		t.Errorf("Releases().Create() = %+v, want tag and name %q", got, tagName)
+	}
+	_, err = releases.Create(s.ctx, gitprovider.ReleaseInfo{TagName: tagName})
+	expectErr(t, "Releases().Create() of an existing release", err, gitprovider.ErrAlreadyExists)
+	if _, err := s.repo.Tags().Get(s.ctx, tagName); err != nil {
+		t.Errorf("Tags().Get() of the tag created by Releases().Create(): %v", err)
+	}
+
+	info := release.Get()
+	info.Description = gitprovider.StringVar("Conformance, updated")
+	must(t, "Release.Set()", release.Set(info))
+	must(t, "Release.Update()", release.Update(s.ctx))
+	info.TagName = "conformance-other"
+	expectErr(t, "Release.Set() with another tag", release.Set(info), gitprovider.ErrInvalidArgument)
+
+	asset, err := release.UploadAsset(s.ctx, "conformance.txt", strings.NewReader(testFileContent))
+	must(t, "Release.UploadAsset()", err)
+	if asset.Name != "conformance.txt" || asset.URL == "" {
+		t.Errorf("Release.UploadAsset() = %+v, want name %q and a URL", asset, "conformance.txt")
+	}
+
+	release, err = releases.Get(s.ctx, tagName)
+	must(t, "Releases().Get()", err)
+	got := release.Get()
+	if got.Description == nil || *got.Description != "Conformance, updated" {
+		t.Errorf("Releases().Get().Description = %v, want %q", got.Description, "Conformance, updated")
+	}
+	if len(got.Assets) != 1 {
+		t.Errorf("Releases().Get() returned %d assets, want 1", len(got.Assets))
+	}
+
+	list, err := releases.List(s.ctx)
+	must(t, "Releases().List()", err)
+	found := false
+	for _, r := range list {
+		found = found || r.Get().TagName == tagName
+	}
+	if !found {
+		t.Errorf("Releases().List() doesn't contain %q", tagName)
+	}
+
+	repo, err := s.safeClient.OrgRepositories().Get(s.ctx, s.repoRef)
+	must(t, "OrgRepositories().Get()", err)
+	safeRelease, err := repo.Releases().Get(s.ctx, tagName)
+	must(t, "Releases().Get()", err)
+	expectErr(t, "Release.Delete() without destructive calls", safeRelease.Delete(s.ctx), gitprovider.ErrDestructiveCallDisallowed)
+
+	must(t, "Release.Delete()", release.Delete(s.ctx))
+	_, err = releases.Get(s.ctx, tagName)
+	expectErr(t, "Releases().Get() of a deleted release", err, gitprovider.ErrNotFound)
+	must(t, "Tags().Delete()", s.repo.Tags().Delete(s.ctx, tagName))
+}
+
+func checkFilesGet(t *testing.T, s *suite) {
+	files, err := s.repo.Files().Get(s.ctx, "conformance", s.defaultBranch)
+	must(t, "Files().Get()", err)
+	for _, f := range files {
+		if *f.Path == testFilePath {
+			if f.Content == nil || *f.Content != testFileContent {
+				t.Errorf("Files().Get() content of %s = %v, want %q", testFilePath, f.Content, testFileContent)
+			}
+			return
+		}
+	}
+	t.Errorf("Files().Get() doesn't contain %s", testFilePath)
+}
+
+func checkFilesGetNotFound(t *testing.T, s *suite) {
+	_, err := s.repo.Files().Get(s.ctx, "conformance-missing", s.defaultBranch)
+	expectErr(t, "Files().Get() of a missing directory", err, gitprovider.ErrNotFound)
+}
+
+func checkTreesGet(t *testing.T, s *suite) {
+	tree, err := s.repo.Trees().Get(s.ctx, s.defaultBranch, true)
+	must(t, "Trees().Get()", err)
+	for _, e := range tree.Tree {
+		if e.Path == testFilePath {
+			if e.Type != "blob" {
+				t.Errorf("Trees().Get() type of %s = %q, want blob", testFilePath, e.Type)
+			}
+			return
+		}
+	}
+	t.Errorf("Trees().Get() doesn't contain %s", testFilePath)
+}
+
+func checkPullRequestsLifecycle(t *testing.T, s *suite) {
+	if s.branch == "" {
+		t.Skip("requires Branches/Create")
+	}
+	prs := s.repo.PullRequests()
+
+	pr, err := prs.Create(s.ctx, "Conformance", s.branch, s.defaultBranch, "conformance test")
+	must(t, "PullRequests().Create()", err)
+	info := pr.Get()
+	if info.Number == 0 || info.WebURL == "" {
+		t.Errorf("PullRequests().Create() = %+v, want Number and WebURL to be set", info)
+	}
+	if info.Merged || info.State != gitprovider.PullRequestStateOpen {
+		t.Errorf("PullRequests().Create() = %+v, want an open pull request", info)
+	}
+	if info.TargetBranch != s.defaultBranch {
+		t.Errorf("PullRequests().Create().TargetBranch = %q, want %q", info.TargetBranch, s.defaultBranch)
+	}
+	s.prNumber = info.Number
+
+	got, err := prs.Get(s.ctx, s.prNumber)
+	must(t, "PullRequests().Get()", err)
+	if got.Get().Title != "Conformance" || got.Get().SourceBranch != s.branch {
+		t.Errorf("PullRequests().Get() = %+v", got.Get())
+	}
+
+	list, err := prs.List(s.ctx)
+	must(t, "PullRequests().List()", err)
+	found := false
+	for _, p := range list {
+		found = found || p.Get().Number == s.prNumber
+	}
+	if !found {
+		t.Errorf("PullRequests().List() doesn't contain #%d", s.prNumber)
+	}
+	list, err = prs.List(s.ctx, &gitprovider.PullRequestListOptions{TargetBranch: gitprovider.StringVar(s.branch)})
+	must(t, "PullRequests().List() with a target branch", err)
+	for _, p := range list {
+		if p.Get().Number == s.prNumber {
+			t.Errorf("PullRequests().List() into %q contains #%d", s.branch, s.prNumber)
+		}
+	}
+
+	edited, err := prs.Edit(s.ctx, s.prNumber, gitprovider.EditOptions{
+		Title:       gitprovider.StringVar("Conformance, edited"),
+		Description: gitprovider.StringVar("conformance test, edited"),
+	})
+	must(t, "PullRequests().Edit()", err)
+	if got := edited.Get(); got.Title != "Conformance, edited" || got.Description != "conformance test, edited" {
+		t.Errorf("PullRequests().Edit() = %+v", got)
+	}
+}
+
+func checkPullRequestsComments(t *testing.T, s *suite) {
+	if s.prNumber == 0 {
+		t.Skip("requires PullRequests/Lifecycle")
+	}
+	pr, err := s.repo.PullRequests().Get(s.ctx, s.prNumber)
+	must(t, "PullRequests().Get()", err)
+	comments := pr.Comments()
+
+	comment, err := comments.Create(s.ctx, gitprovider.PullRequestCommentInfo{Body: "conformance"})
+	must(t, "Comments().Create()", err)
+	if info := comment.Get(); info.ID == 0 || info.Body != "conformance" {
+		t.Errorf("Comments().Create() = %+v, want the ID and body to be set", info)
+	}
+	id := comment.Get().ID
+
+	must(t, "Set()", comment.Set(gitprovider.PullRequestCommentInfo{Body: "conformance, edited"}))
+	must(t, "Comments().Update()", comment.Update(s.ctx))
+	got, err := comments.Get(s.ctx, id)
+	must(t, "Comments().Get()", err)
+	if got.Get().Body != "conformance, edited" {
+		t.Errorf("Comments().Get().Body = %q after Update(), want %q", got.Get().Body, "conformance, edited")
+	}
+
+	list, err := comments.List(s.ctx)
+	must(t, "Comments().List()", err)
+	found := false
+	for _, c := range list {
+		found = found || c.Get().ID == id
+	}
+	if !found {
+		t.Errorf("Comments().List() doesn't contain comment %d", id)
+	}
+
+	must(t, "Comments().Delete()", got.Delete(s.ctx))
+	_, err = comments.Get(s.ctx, id)
+	expectErr(t, "Comments().Get() of a deleted comment", err, gitprovider.ErrNotFound)
+}
+
+func checkPullRequestsReviews(t *testing.T, s *suite) {
+	if s.prNumber == 0 {
+		t.Skip("requires PullRequests/Lifecycle")
+	}
+	pr, err := s.repo.PullRequests().Get(s.ctx, s.prNumber)
+	must(t, "PullRequests().Get()", err)
+
+	// Approving or requesting changes to one's own pull request isn't allowed by every provider,
+	// hence only comment
+	review, err := pr.Reviews().Create(s.ctx, gitprovider.PullRequestReviewInfo{
+		State: gitprovider.PullRequestReviewStateCommented,
+		Body:  "conformance review",
+	})
+	must(t, "Reviews().Create()", err)
+	if info := review.Get(); info.State != gitprovider.PullRequestReviewStateCommented || info.Body != "conformance review" {
+		t.Errorf("Reviews().Create() = %+v, want a commented review", info)
+	}
+	_, err = pr.Reviews().List(s.ctx)
+	must(t, "Reviews().List()", err)
+}
+
+// checkPullRequestsFiles checks the changes of the conformance file made in Branches/Create.
+func checkPullRequestsFiles(t *testing.T, s *suite) {
+	if s.prNumber == 0 {
+		t.Skip("requires PullRequests/Lifecycle")
+	}
+	pr, err := s.repo.PullRequests().Get(s.ctx, s.prNumber)
+	must(t, "PullRequests().Get()", err)
+
+	files, err := pr.Files(s.ctx)
+	must(t, "Files()", err)
+	if files.Truncated || len(files.Files) != 1 {
+		t.Fatalf("Files() = %+v, want only %s", files, testFilePath)
+	}
+	want := gitprovider.PullRequestFile{Path: testFilePath, Status: gitprovider.PullRequestFileStatusModified, Additions: 1}
+	if got := files.Files[0]; got.Path != want.Path || got.Status != want.Status || got.Additions != want.Additions || got.Deletions != want.Deletions {
+		t.Errorf("Files()[0] = %+v, want %+v", got, want)
+	}
+
+	diff, err := pr.Diff(s.ctx)
+	must(t, "Diff()", err)
+	if !strings.Contains(diff, testFilePath) || !strings.Contains(diff, "\n+changed\n") {
+		t.Errorf("Diff() = %q, want the change of %s", diff, testFilePath)
+	}
+}
+
+// checkPullRequestsGetNotFound checks that pull request errors are mapped to the gitprovider errors.
+func checkPullRequestsGetNotFound(t *testing.T, s *suite) {
+	_, err := s.repo.PullRequests().Get(s.ctx, 100000)
+	expectErr(t, "PullRequests().Get() of a missing pull request", err, gitprovider.ErrNotFound)
+}
+
+func checkPullRequestsMerge(t *testing.T, s *suite) {
+	if s.prNumber == 0 {
+		t.Skip("requires PullRequests/Lifecycle")
+	}
+	pr, err := s.repo.PullRequests().Get(s.ctx, s.prNumber)
+	must(t, "PullRequests().Get()", err)
+	head := pr.Get().HeadSha
+
+	// The merge is refused if the head isn't the expected commit
+	_, err = s.repo.PullRequests().Merge(s.ctx, s.prNumber, gitprovider.MergeMethodMerge, "Merge conformance",
+		&gitprovider.PullRequestMergeOptions{ExpectedHeadSha: gitprovider.StringVar(strings.Repeat("0", 40))})
+	var conflictErr *gitprovider.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Errorf("PullRequests().Merge() with an outdated head error = %v, want a *ConflictError", err)
+	}
+
+	opts := &gitprovider.PullRequestMergeOptions{ExpectedHeadSha: gitprovider.StringVar(head)}
+	result, err := s.repo.PullRequests().Merge(s.ctx, s.prNumber, gitprovider.MergeMethodMerge, "Merge conformance", opts)
+	if errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		// Fall back to squashing, for providers only supporting that
+		result, err = s.repo.PullRequests().Merge(s.ctx, s.prNumber, gitprovider.MergeMethodSquash, "Merge conformance", opts)
+	}
+	must(t, "PullRequests().Merge()", err)
+	if result.Sha == "" {
+		t.Errorf("PullRequests().Merge() = %+v, want the Sha to be set", result)
+	}
+
+	pr, err = s.repo.PullRequests().Get(s.ctx, s.prNumber)
+	must(t, "PullRequests().Get()", err)
+	if !pr.Get().Merged || pr.Get().State != gitprovider.PullRequestStateMerged {
+		t.Errorf("PullRequests().Get() = %+v after Merge(), want a merged pull request", pr.Get())
+	}
+
+	list, err := s.repo.PullRequests().List(s.ctx, &gitprovider.PullRequestListOptions{
+		State: gitprovider.PullRequestStateVar(gitprovider.PullRequestStateMerged),
+	})
+	must(t, "PullRequests().List() of merged pull requests", err)
+	found := false
+	for _, p := range list {
+		found = found || p.Get().Number == s.prNumber
+	}
+	if !found {
+		t.Errorf("PullRequests().List() of merged pull requests doesn't contain #%d", s.prNumber)
+	}
+}
+
+func checkIssuesLifecycle(t *testing.T, s *suite) {
+	issues := s.repo.Issues()
+	// Labels and milestones aren't set, as they must exist in the repository
+	issue, err := issues.Create(s.ctx, gitprovider.IssueInfo{Title: "conformance", Description: "conformance"})
+	if errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Skip("the provider has no issues")
+	}
+	must(t, "Issues().Create()", err)
+	number := issue.Get().Number
+	if info := issue.Get(); number == 0 || info.State != gitprovider.IssueStateOpen || info.Title != "conformance" {
+		t.Errorf("Issues().Create() = %+v, want an open issue with its number and title set", info)
+	}
+
+	comment, err := issue.Comments().Create(s.ctx, gitprovider.IssueCommentInfo{Body: "conformance"})
+	must(t, "Comments().Create()", err)
+	list, err := issue.Comments().List(s.ctx)
+	must(t, "Comments().List()", err)
+	if len(list) != 1 || list[0].Get().ID != comment.Get().ID {
+		t.Errorf("Comments().List() = %v, want only comment %d", list, comment.Get().ID)
+	}
+	must(t, "Comments().Delete()", comment.Delete(s.ctx))
+
+	issue, err = issues.Close(s.ctx, number)
+	must(t, "Issues().Close()", err)
+	if info := issue.Get(); info.State != gitprovider.IssueStateClosed || info.ClosedAt == nil {
+		t.Errorf("Issues().Close() = %+v, want a closed issue with its closing time set", info)
+	}
+	open, err := issues.List(s.ctx)
+	must(t, "Issues().List()", err)
+	for _, other := range open {
+		if other.Get().Number == number {
+			t.Errorf("Issues().List() contains closed issue %d, want only open issues", number)
+		}
+	}
+	_, err = issues.Get(s.ctx, number+1000)
+	expectErr(t, "Issues().Get() of a missing issue", err, gitprovider.ErrNotFound)
+}
+
+func checkBranchProtectionsLifecycle(t *testing.T, s *suite) {
+	// Some providers protect the default branch when creating the repository, hence reconcile
+	req := gitprovider.BranchProtectionInfo{Branch: s.defaultBranch}
+	protections := s.repo.BranchProtections()
+	_, _, err := protections.Reconcile(s.ctx, req)
+	must(t, "BranchProtections().Reconcile()", err)
+
+	protection, err := protections.Get(s.ctx, req.Branch)
+	must(t, "BranchProtections().Get()", err)
+	if got := protection.Get().Branch; got != req.Branch {
+		t.Errorf("BranchProtections().Get().Branch = %q, want %q", got, req.Branch)
+	}
+	list, err := protections.List(s.ctx)
+	must(t, "BranchProtections().List()", err)
+	found := false
+	for _, p := range list {
+		found = found || p.Get().Branch == req.Branch
+	}
+	if !found {
+		t.Errorf("BranchProtections().List() doesn't contain %q", req.Branch)
+	}
+	_, err = protections.Create(s.ctx, req)
+	expectErr(t, "BranchProtections().Create() of a protected branch", err, gitprovider.ErrAlreadyExists)
+
+	_, actionTaken, err := protections.Reconcile(s.ctx, req)
+	must(t, "BranchProtections().Reconcile()", err)
+	if actionTaken {
+		t.Error("BranchProtections().Reconcile() of the actual state must not take any action")
+	}
+	// Providers support different rules, change the first supported one
+	for _, change := range []func(*gitprovider.BranchProtectionInfo){
+		func(info *gitprovider.BranchProtectionInfo) {
+			info.RequiredApprovingReviewCount = gitprovider.IntVar(1)
+		},
+		func(info *gitprovider.BranchProtectionInfo) { info.AllowForcePushes = gitprovider.BoolVar(true) },
+	} {
+		changed := req
+		change(&changed)
+		changed.Default()
+		_, actionTaken, err = protections.Reconcile(s.ctx, changed)
+		if errors.Is(err, gitprovider.ErrNoProviderSupport) {
+			continue
+		}
+		must(t, "BranchProtections().Reconcile()", err)
+		if !actionTaken {
+			t.Error("BranchProtections().Reconcile() of changed rules must take action")
+		}
+		protection, err = protections.Get(s.ctx, req.Branch)
+		must(t, "BranchProtections().Get()", err)
+		if got := protection.Get(); !changed.Equals(got) {
+			t.Errorf("BranchProtections().Get() = %+v, want %+v", got, changed)
+		}
+		break
+	}
+
+	must(t, "BranchProtection.Delete()", protection.Delete(s.ctx))
+	_, err = protections.Get(s.ctx, req.Branch)
+	expectErr(t, "BranchProtections().Get() of an unprotected branch", err, gitprovider.ErrNotFound)
+}
+
+func checkBranchesRenameDefault(t *testing.T, s *suite) {
+	expectErr(t, "Branches().RenameDefault() to an existing branch", s.repo.Branches().RenameDefault(s.ctx, s.defaultBranch), gitprovider.ErrAlreadyExists)
+
+	must(t, "Branches().RenameDefault()", s.repo.Branches().RenameDefault(s.ctx, testDefault))
+	repo, err := s.client.OrgRepositories().Get(s.ctx, s.repoRef)
+	must(t, "OrgRepositories().Get()", err)
+	if info := repo.Get(); info.DefaultBranch == nil || *info.DefaultBranch != testDefault {
+		t.Errorf("DefaultBranch after RenameDefault() = %v, want %q", info.DefaultBranch, testDefault)
+	}
+	// Whether the previous default branch is kept depends on the provider
+	s.defaultBranch = testDefault
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// ClientFactory returns the client under test. destructive specifies whether the client
+// must be created with gitprovider.WithDestructiveAPICalls(true).
+type ClientFactory func(destructive bool) (gitprovider.Client, error)
+
+// Config configures a conformance run.
+type Config struct {
+	// NewClient creates the clients used by the checks.
+	// +required
+	NewClient ClientFactory
+
+	// Organization is an existing organization, in which the authenticated user
+	// is allowed to create and delete repositories.
+	// +required
+	Organization gitprovider.OrganizationRef
+
+	// Team is the name of an existing team in Organization, used by the team access checks.
+	// The team access checks are skipped if this is empty.
+	// +optional
+	Team string
+
+	// RepositoryPrefix is prepended to the random name of the repository created for the run.
+	// Default: "conformance-".
+	// +optional
+	RepositoryPrefix string
+
+	// Skip maps check names, e.g. "PullRequests/GetNotFound", to the reason for skipping them.
+	// This allows implementations to document known deviations from the contract.
+	// +optional
+	Skip map[string]string
+}
+
+// check is a single behavioural check. Checks run in the order they're declared in,
+// and may depend on the state left behind by the previous ones.
+type check struct {
+	name string
+	run  func(t *testing.T, s *suite)
+}
+
+// suite holds the state shared by all checks of a run.
+type suite struct {
+	ctx context.Context
+	cfg Config
+
+	// client is created with destructive calls enabled, safeClient without.
+	client     gitprovider.Client
+	safeClient gitprovider.Client
+
+	repoRef       gitprovider.OrgRepositoryRef
+	repo          gitprovider.OrgRepository
+	defaultBranch string
+
+	// branch is created by the Branches checks, and used for pull requests.
+	branch string
+	// prNumber is the pull request created by the PullRequests checks.
+	prNumber int
+}
+
+// Run runs the conformance checks against the clients returned by cfg.NewClient.
+//
+// A repository is created in cfg.Organization for the run, and deleted when it's done.
+// Every check is a subtest, named after the gitprovider client it checks, e.g.
+// "OrgRepositories/ReconcileNoop". Checks of capabilities the provider reports
+// as unsupported (using gitprovider.ErrNoProviderSupport) are skipped.
+func Run(t *testing.T, cfg Config) {
+	t.Helper()
+	if cfg.NewClient == nil {
+		t.Fatal("conformance: Config.NewClient is required")
+	}
+	if cfg.RepositoryPrefix == "" {
+		cfg.RepositoryPrefix = "conformance-"
+	}
+
+	s := &suite{
+		ctx: context.Background(),
+		cfg: cfg,
+	}
+	var err error
+	if s.client, err = cfg.NewClient(true); err != nil {
+		t.Fatalf("conformance: failed to create client: %v", err)
+	}
+	if s.safeClient, err = cfg.NewClient(false); err != nil {
+		t.Fatalf("conformance: failed to create client: %v", err)
+	}
+
+	s.repoRef = gitprovider.OrgRepositoryRef{
+		OrganizationRef: cfg.Organization,
+		RepositoryName:  fmt.Sprintf("%s%d", cfg.RepositoryPrefix, rand.New(rand.NewSource(time.Now().UnixNano())).Intn(1e6)),
+	}
+	s.repo, err = s.client.OrgRepositories().Create(s.ctx, s.repoRef, gitprovider.RepositoryInfo{
+		Description: gitprovider.StringVar("go-git-providers conformance test"),
+	}, &gitprovider.RepositoryCreateOptions{AutoInit: gitprovider.BoolVar(true)})
+	if err != nil {
+		t.Fatalf("conformance: failed to create repository %s: %v", s.repoRef, err)
+	}
+	s.defaultBranch = *s.repo.Get().DefaultBranch
+	t.Cleanup(func() {
+		if err := s.repo.Delete(s.ctx); err != nil {
+			t.Errorf("conformance: failed to delete repository %s: %v", s.repoRef, err)
+		}
+	})
+
+	for _, c := range checks {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			if reason, ok := cfg.Skip[c.name]; ok {
+				t.Skip(reason)
+			}
+			c.run(t, s)
+		})
+	}
+}
+
+// skipIfUnsupported skips the current check if err reports that the provider doesn't
+// support the capability being checked.
+func skipIfUnsupported(t *testing.T, err error) {
+	t.Helper()
+	if errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Skipf("not supported by the provider: %v", err)
+	}
+}
+
+// expectErr fails the check if err doesn't wrap target.
+func expectErr(t *testing.T, op string, err, target error) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Errorf("%s: got error %v, want %v", op, err, target)
+	}
+}
+
+// must fails the check immediately if err is non-nil.
+func must(t *testing.T, op string, err error) {
+	t.Helper()
+	skipIfUnsupported(t, err)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", op, err)
+	}
+}
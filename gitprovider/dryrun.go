@@ -0,0 +1,774 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// PlannedOperation is a write API call which a Client created using WithDryRun(true) skipped.
+type PlannedOperation struct {
+	// Operation is the kind of write, e.g. "Create", "Update", "Delete" or "Merge".
+	Operation string `json:"operation"`
+
+	// Resource is the kind of object written, e.g. "Repository" or "DeployKey".
+	Resource string `json:"resource"`
+
+	// Target is the organization or repository the object is in, or is, e.g. "https://github.com/my-org/my-repo".
+	Target string `json:"target"`
+
+	// Name identifies the object in the organization or repository, e.g. the name of a deploy key.
+	// It's empty if the object is the organization or repository itself.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Changes lists the fields the write would change, if known.
+	// +optional
+	Changes []FieldChange `json:"changes,omitempty"`
+}
+
+// Plan records the write API calls a Client created using WithDryRun(true) skipped, in order.
+// It's safe for concurrent use.
+type Plan struct {
+	mu         sync.Mutex
+	operations []PlannedOperation
+}
+
+// Operations returns a copy of the recorded operations, in the order they were recorded.
+func (p *Plan) Operations() []PlannedOperation {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PlannedOperation(nil), p.operations...)
+}
+
+func (p *Plan) add(op PlannedOperation) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.operations = append(p.operations, op)
+}
+
+// DryRunValidator checks, without making any changes, whether a provider would accept the writes
+// it restricts beyond the validation of the *Info structs. Providers pass it to NewDryRun, so that
+// a Plan only contains writes which would succeed.
+type DryRunValidator interface {
+	// ValidateCreateOrganization returns the error creating the organization would fail with, if any.
+	ValidateCreateOrganization(ref OrganizationRef, req OrganizationInfo) error
+
+	// ValidateUpdateOrganization returns the error updating the organization to req would fail with, if any.
+	ValidateUpdateOrganization(ref OrganizationRef, req OrganizationInfo) error
+
+	// ValidateEnableAutoMerge returns the error enabling auto-merge via the given method would fail with, if any.
+	ValidateEnableAutoMerge(mergeMethod MergeMethod) error
+
+	// ValidateDisableAutoMerge returns the error disabling auto-merge would fail with, if any.
+	ValidateDisableAutoMerge() error
+
+	// ValidateRepositoryInfo returns the error creating or updating a repository with info would
+	// fail with, if any.
+	ValidateRepositoryInfo(info RepositoryInfo) error
+}
+
+// nopDryRunValidator is the DryRunValidator of providers which don't restrict any writes.
+type nopDryRunValidator struct{}
+
+func (nopDryRunValidator) ValidateCreateOrganization(OrganizationRef, OrganizationInfo) error {
+	return nil
+}
+
+func (nopDryRunValidator) ValidateUpdateOrganization(OrganizationRef, OrganizationInfo) error {
+	return nil
+}
+
+func (nopDryRunValidator) ValidateEnableAutoMerge(MergeMethod) error {
+	return nil
+}
+
+func (nopDryRunValidator) ValidateDisableAutoMerge() error {
+	return nil
+}
+
+func (nopDryRunValidator) ValidateRepositoryInfo(RepositoryInfo) error {
+	return nil
+}
+
+// DryRun wraps the sub-clients of a provider's Client so that reads go to the provider, while writes
+// are validated like the provider would, and then recorded in a Plan instead of being made. It's
+// used by the providers to implement the WithDryRun option.
+//
+// Objects created in a dry run are only planned, they can't be read using Get or List afterwards.
+// Branches created or deleted in a dry run are tracked though, so that e.g. commits to a planned
+// branch are planned too, while commits to a deleted branch fail. Deleting organizations,
+// repositories, teams and branches requires destructive API calls to be enabled, just like without
+// a dry run.
+type DryRun struct {
+	plan               *Plan
+	destructiveActions bool
+	validator          DryRunValidator
+
+	// branches maps the branches created or deleted in the dry run to whether they exist.
+	mu       sync.Mutex
+	branches map[branchKey]bool
+}
+
+// branchKey identifies a branch of a repository.
+type branchKey struct {
+	repository string
+	branch     string
+}
+
+// NewDryRun creates a new DryRun with an empty Plan. destructiveActions should be set like the
+// EnableDestructiveAPICalls option of the Client. validator may be nil, if the provider doesn't
+// restrict any writes.
+func NewDryRun(destructiveActions bool, validator DryRunValidator) *DryRun {
+	if validator == nil {
+		validator = nopDryRunValidator{}
+	}
+	return &DryRun{
+		plan:               &Plan{},
+		destructiveActions: destructiveActions,
+		validator:          validator,
+		branches:           map[branchKey]bool{},
+	}
+}
+
+// Plan returns the Plan of the skipped writes. It's nil if d is nil, so providers can return
+// the result for clients without a dry run too.
+func (d *DryRun) Plan() *Plan {
+	if d == nil {
+		return nil
+	}
+	return d.plan
+}
+
+// Organizations wraps c for the dry run.
+func (d *DryRun) Organizations(c OrganizationsClient) OrganizationsClient {
+	return &dryRunOrganizationsClient{d: d, c: c}
+}
+
+// OrgRepositories wraps c for the dry run.
+func (d *DryRun) OrgRepositories(c OrgRepositoriesClient) OrgRepositoriesClient {
+	return &dryRunOrgRepositoriesClient{d: d, c: c}
+}
+
+// UserRepositories wraps c for the dry run.
+func (d *DryRun) UserRepositories(c UserRepositoriesClient) UserRepositoriesClient {
+	return &dryRunUserRepositoriesClient{d: d, c: c}
+}
+
+func (d *DryRun) record(operation, resource, target, name string, changes []FieldChange) {
+	d.plan.add(PlannedOperation{
+		Operation: operation,
+		Resource:  resource,
+		Target:    target,
+		Name:      name,
+		Changes:   changes,
+	})
+}
+
+// checkDelete returns ErrDestructiveCallDisallowed unless destructive API calls are enabled, like
+// the providers do when deleting organizations, repositories, teams and branches.
+func (d *DryRun) checkDelete(what string) error {
+	if !d.destructiveActions {
+		return fmt.Errorf("cannot delete %s: %w", what, ErrDestructiveCallDisallowed)
+	}
+	return nil
+}
+
+// planBranch records that the branch of the repository is created (exists == true), or deleted,
+// by the Plan.
+func (d *DryRun) planBranch(ref RepositoryRef, branch string, exists bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.branches[branchKey{repository: ref.String(), branch: branch}] = exists
+}
+
+// plannedBranch returns whether the branch of the repository exists after the Plan, if the Plan
+// creates or deletes it (planned == true).
+func (d *DryRun) plannedBranch(ref RepositoryRef, branch string) (exists, planned bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	exists, planned = d.branches[branchKey{repository: ref.String(), branch: branch}]
+	return exists, planned
+}
+
+// checkNotExists turns the error of getting an object before creating it into the error of
+// creating it: ErrAlreadyExists if the object was found, nil if it wasn't.
+func checkNotExists(err error) error {
+	switch {
+	case err == nil:
+		return ErrAlreadyExists
+	case errors.Is(err, ErrNotFound):
+		return nil
+	default:
+		return err
+	}
+}
+
+// notFound is returned when reading from an object which is only planned.
+func notFound(what string, name interface{}) error {
+	return fmt.Errorf("%s %v: %w", what, name, ErrNotFound)
+}
+
+// dryRunOrganizationsClient implements OrganizationsClient for a dry run.
+type dryRunOrganizationsClient struct {
+	d *DryRun
+	c OrganizationsClient
+}
+
+func (c *dryRunOrganizationsClient) Get(ctx context.Context, o OrganizationRef) (Organization, error) {
+	org, err := c.c.Get(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(org), nil
+}
+
+func (c *dryRunOrganizationsClient) List(ctx context.Context) ([]Organization, error) {
+	orgs, err := c.c.List(ctx)
+	return c.wrapAll(orgs), err
+}
+
+func (c *dryRunOrganizationsClient) Children(ctx context.Context, o OrganizationRef) ([]Organization, error) {
+	orgs, err := c.c.Children(ctx, o)
+	return c.wrapAll(orgs), err
+}
+
+func (c *dryRunOrganizationsClient) Create(ctx context.Context, o OrganizationRef, req OrganizationInfo) (Organization, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if err := c.d.validator.ValidateCreateOrganization(o, req); err != nil {
+		return nil, err
+	}
+	_, err := c.c.Get(ctx, o)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.d.record("Create", "Organization", o.String(), "", diffFields(req, nil))
+	return &dryRunOrganization{d: c.d, ref: o, info: req}, nil
+}
+
+func (c *dryRunOrganizationsClient) Reconcile(ctx context.Context, o OrganizationRef, req OrganizationInfo) (Organization, bool, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+	actual, err := c.c.Get(ctx, o)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, o, req)
+		return resp, true, err
+	} else if err != nil {
+		return nil, false, err
+	}
+	if req.Equals(actual.Get()) {
+		return c.wrap(actual), false, nil
+	}
+	if err := c.d.validator.ValidateUpdateOrganization(o, req); err != nil {
+		return nil, false, err
+	}
+	c.d.record("Update", "Organization", o.String(), "", diffFields(req, actual.Get()))
+	return &dryRunOrganization{d: c.d, inner: actual, ref: o, info: req}, true, nil
+}
+
+func (c *dryRunOrganizationsClient) Delete(ctx context.Context, o OrganizationRef) error {
+	if err := c.d.checkDelete("organization"); err != nil {
+		return err
+	}
+	if _, err := c.c.Get(ctx, o); err != nil {
+		return err
+	}
+	c.d.record("Delete", "Organization", o.String(), "", nil)
+	return nil
+}
+
+func (c *dryRunOrganizationsClient) wrap(org Organization) Organization {
+	return &dryRunOrganization{d: c.d, inner: org, ref: org.Organization(), info: org.Get()}
+}
+
+func (c *dryRunOrganizationsClient) wrapAll(orgs []Organization) []Organization {
+	if orgs == nil {
+		return nil
+	}
+	wrapped := make([]Organization, 0, len(orgs))
+	for _, org := range orgs {
+		wrapped = append(wrapped, c.wrap(org))
+	}
+	return wrapped
+}
+
+// dryRunOrganization implements Organization for a dry run. inner is nil if the organization is
+// only planned.
+type dryRunOrganization struct {
+	d     *DryRun
+	inner Organization
+	ref   OrganizationRef
+	info  OrganizationInfo
+}
+
+func (o *dryRunOrganization) APIObject() interface{} {
+	if o.inner != nil {
+		return o.inner.APIObject()
+	}
+	return &o.info
+}
+
+func (o *dryRunOrganization) Organization() OrganizationRef {
+	return o.ref
+}
+
+func (o *dryRunOrganization) Get() OrganizationInfo {
+	return o.info
+}
+
+func (o *dryRunOrganization) Teams() TeamsClient {
+	c := &dryRunTeamsClient{d: o.d, ref: o.ref}
+	if o.inner != nil {
+		c.c = o.inner.Teams()
+	}
+	return c
+}
+
+// dryRunTeamsClient implements TeamsClient for a dry run. c is nil if the organization is only
+// planned.
+type dryRunTeamsClient struct {
+	d   *DryRun
+	ref OrganizationRef
+	c   TeamsClient
+}
+
+func (c *dryRunTeamsClient) Get(ctx context.Context, name string) (Team, error) {
+	if c.c == nil {
+		return nil, notFound("team", name)
+	}
+	team, err := c.c.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(team), nil
+}
+
+func (c *dryRunTeamsClient) List(ctx context.Context) ([]Team, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	teams, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]Team, 0, len(teams))
+	for _, team := range teams {
+		wrapped = append(wrapped, c.wrap(team))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunTeamsClient) Create(ctx context.Context, req TeamInfo) (Team, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	_, err := c.Get(ctx, req.Name)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.d.record("Create", "Team", c.ref.String(), req.Name, diffFields(req, nil))
+	return &dryRunTeam{c: c, info: req, actual: req}, nil
+}
+
+func (c *dryRunTeamsClient) Reconcile(ctx context.Context, req TeamInfo) (Team, bool, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+	actual, err := c.Get(ctx, req.Name)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, req)
+		return resp, true, err
+	} else if err != nil {
+		return nil, false, err
+	}
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
+func (c *dryRunTeamsClient) wrap(team Team) Team {
+	return &dryRunTeam{c: c, inner: team, info: team.Get(), actual: team.Get()}
+}
+
+// dryRunTeam implements Team for a dry run. inner is nil if the team is only planned, actual is
+// the state of the team before the dry run.
+type dryRunTeam struct {
+	c      *dryRunTeamsClient
+	inner  Team
+	info   TeamInfo
+	actual TeamInfo
+}
+
+func (t *dryRunTeam) APIObject() interface{} {
+	if t.inner != nil {
+		return t.inner.APIObject()
+	}
+	return &t.info
+}
+
+func (t *dryRunTeam) Organization() OrganizationRef {
+	return t.c.ref
+}
+
+func (t *dryRunTeam) Get() TeamInfo {
+	return t.info
+}
+
+func (t *dryRunTeam) Set(info TeamInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	t.info = info
+	return nil
+}
+
+func (t *dryRunTeam) Update(ctx context.Context) error {
+	t.c.d.record("Update", "Team", t.c.ref.String(), t.actual.Name, diffFields(t.info, t.actual))
+	t.actual = t.info
+	return nil
+}
+
+func (t *dryRunTeam) Reconcile(ctx context.Context) (bool, error) {
+	if t.info.Equals(t.actual) {
+		return false, nil
+	}
+	return true, t.Update(ctx)
+}
+
+func (t *dryRunTeam) Delete(ctx context.Context) error {
+	if err := t.c.d.checkDelete("team"); err != nil {
+		return err
+	}
+	t.c.d.record("Delete", "Team", t.c.ref.String(), t.actual.Name, nil)
+	return nil
+}
+
+func (t *dryRunTeam) Members() TeamMemberClient {
+	c := &dryRunTeamMemberClient{team: t}
+	if t.inner != nil {
+		c.c = t.inner.Members()
+	}
+	return c
+}
+
+// dryRunTeamMemberClient implements TeamMemberClient for a dry run. c is nil if the team is only
+// planned.
+type dryRunTeamMemberClient struct {
+	team *dryRunTeam
+	c    TeamMemberClient
+}
+
+func (c *dryRunTeamMemberClient) List(ctx context.Context) ([]TeamMemberInfo, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	return c.c.List(ctx)
+}
+
+func (c *dryRunTeamMemberClient) Add(ctx context.Context, req TeamMemberInfo) error {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+	c.record("Add", req.Login, diffFields(req, nil))
+	return nil
+}
+
+func (c *dryRunTeamMemberClient) Remove(ctx context.Context, login string) error {
+	if err := c.team.c.d.checkDelete("team member"); err != nil {
+		return err
+	}
+	members, err := c.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		if member.Login == login {
+			c.record("Remove", login, nil)
+			return nil
+		}
+	}
+	return notFound("team member", login)
+}
+
+func (c *dryRunTeamMemberClient) Reconcile(ctx context.Context, req []TeamMemberInfo, opts ...TeamMemberReconcileOption) (bool, error) {
+	return ReconcileTeamMembers(ctx, c, req, opts...)
+}
+
+// record records an operation on a team member, named "<team>/<login>".
+func (c *dryRunTeamMemberClient) record(operation, login string, changes []FieldChange) {
+	c.team.c.d.record(operation, "TeamMember", c.team.c.ref.String(), c.team.actual.Name+"/"+login, changes)
+}
+
+// dryRunOrgRepositoriesClient implements OrgRepositoriesClient for a dry run.
+type dryRunOrgRepositoriesClient struct {
+	d *DryRun
+	c OrgRepositoriesClient
+}
+
+func (c *dryRunOrgRepositoriesClient) Get(ctx context.Context, r OrgRepositoryRef) (OrgRepository, error) {
+	repo, err := c.c.Get(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return newDryRunRepository(c.d, repo), nil
+}
+
+func (c *dryRunOrgRepositoriesClient) List(ctx context.Context, o OrganizationRef) ([]OrgRepository, error) {
+	repos, err := c.c.List(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]OrgRepository, 0, len(repos))
+	for _, repo := range repos {
+		wrapped = append(wrapped, newDryRunRepository(c.d, repo))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunOrgRepositoriesClient) Create(ctx context.Context, r OrgRepositoryRef, req RepositoryInfo, opts ...RepositoryCreateOption) (OrgRepository, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if err := c.d.validator.ValidateRepositoryInfo(req); err != nil {
+		return nil, err
+	}
+	o, err := MakeRepositoryCreateOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.c.Get(ctx, r)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	return planDryRunRepository(c.d, r, req, o), nil
+}
+
+func (c *dryRunOrgRepositoriesClient) Reconcile(ctx context.Context, r OrgRepositoryRef, req RepositoryInfo, opts ...RepositoryReconcileOption) (OrgRepository, bool, error) {
+	repo, changes, err := c.ReconcileWithChanges(ctx, r, req, opts...)
+	return repo, len(changes) != 0, err
+}
+
+func (c *dryRunOrgRepositoriesClient) ReconcileWithChanges(ctx context.Context, r OrgRepositoryRef, req RepositoryInfo, opts ...RepositoryReconcileOption) (OrgRepository, []FieldChange, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+	actual, err := c.Get(ctx, r)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, r, req, toCreateOptions(opts)...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp, req.Diff(RepositoryInfo{}), nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	changes, err := reconcileDryRunRepository(ctx, actual, req)
+	return actual, changes, err
+}
+
+// dryRunUserRepositoriesClient implements UserRepositoriesClient for a dry run.
+type dryRunUserRepositoriesClient struct {
+	d *DryRun
+	c UserRepositoriesClient
+}
+
+func (c *dryRunUserRepositoriesClient) Get(ctx context.Context, r UserRepositoryRef) (UserRepository, error) {
+	repo, err := c.c.Get(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return newDryRunRepository(c.d, repo), nil
+}
+
+func (c *dryRunUserRepositoriesClient) List(ctx context.Context, o UserRef) ([]UserRepository, error) {
+	repos, err := c.c.List(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]UserRepository, 0, len(repos))
+	for _, repo := range repos {
+		wrapped = append(wrapped, newDryRunRepository(c.d, repo))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunUserRepositoriesClient) Create(ctx context.Context, r UserRepositoryRef, req RepositoryInfo, opts ...RepositoryCreateOption) (UserRepository, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if err := c.d.validator.ValidateRepositoryInfo(req); err != nil {
+		return nil, err
+	}
+	o, err := MakeRepositoryCreateOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.c.Get(ctx, r)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	return planDryRunRepository(c.d, r, req, o), nil
+}
+
+func (c *dryRunUserRepositoriesClient) GetUserLogin(ctx context.Context) (IdentityRef, error) {
+	return c.c.GetUserLogin(ctx)
+}
+
+func (c *dryRunUserRepositoriesClient) Reconcile(ctx context.Context, r UserRepositoryRef, req RepositoryInfo, opts ...RepositoryReconcileOption) (UserRepository, bool, error) {
+	repo, changes, err := c.ReconcileWithChanges(ctx, r, req, opts...)
+	return repo, len(changes) != 0, err
+}
+
+func (c *dryRunUserRepositoriesClient) ReconcileWithChanges(ctx context.Context, r UserRepositoryRef, req RepositoryInfo, opts ...RepositoryReconcileOption) (UserRepository, []FieldChange, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+	actual, err := c.Get(ctx, r)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, r, req, toCreateOptions(opts)...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp, req.Diff(RepositoryInfo{}), nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	changes, err := reconcileDryRunRepository(ctx, actual, req)
+	return actual, changes, err
+}
+
+// toCreateOptions converts the reconcile options to create options.
+func toCreateOptions(opts []RepositoryReconcileOption) []RepositoryCreateOption {
+	createOpts := make([]RepositoryCreateOption, 0, len(opts))
+	for _, opt := range opts {
+		createOpts = append(createOpts, opt)
+	}
+	return createOpts
+}
+
+// reconcileDryRunRepository updates actual to req, if they differ, and returns the changes.
+func reconcileDryRunRepository(ctx context.Context, actual UserRepository, req RepositoryInfo) ([]FieldChange, error) {
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	if err := actual.Set(req); err != nil {
+		return nil, err
+	}
+	return changes, actual.Update(ctx)
+}
+
+// dryRunRepository implements OrgRepository and UserRepository for a dry run. inner is nil if the
+// repository is only planned, actual is the state of the repository before the dry run.
+type dryRunRepository struct {
+	d      *DryRun
+	inner  UserRepository
+	ref    RepositoryRef
+	info   RepositoryInfo
+	actual RepositoryInfo
+}
+
+func newDryRunRepository(d *DryRun, repo UserRepository) *dryRunRepository {
+	return &dryRunRepository{d: d, inner: repo, ref: repo.Repository(), info: repo.Get(), actual: repo.Get()}
+}
+
+// planDryRunRepository records the creation of the repository, and returns the planned repository.
+// The default branch of the repository only exists if it's initialized.
+func planDryRunRepository(d *DryRun, ref RepositoryRef, req RepositoryInfo, o RepositoryCreateOptions) *dryRunRepository {
+	d.record("Create", "Repository", ref.String(), "", req.Diff(RepositoryInfo{}))
+	if o.AutoInit != nil && *o.AutoInit {
+		d.planBranch(ref, *req.DefaultBranch, true)
+	}
+	return &dryRunRepository{d: d, ref: ref, info: req, actual: req}
+}
+
+// record records an operation on an object in the repository.
+func (r *dryRunRepository) record(operation, resource, name string, changes []FieldChange) {
+	r.d.record(operation, resource, r.ref.String(), name, changes)
+}
+
+// checkBranch returns ErrNotFound if the branch doesn't exist after the Plan.
+func (r *dryRunRepository) checkBranch(ctx context.Context, branch string) error {
+	if exists, planned := r.d.plannedBranch(r.ref, branch); planned {
+		if !exists {
+			return notFound("branch", branch)
+		}
+		return nil
+	}
+	_, err := r.Branches().Get(ctx, branch)
+	return err
+}
+
+func (r *dryRunRepository) APIObject() interface{} {
+	if r.inner != nil {
+		return r.inner.APIObject()
+	}
+	return &r.info
+}
+
+func (r *dryRunRepository) Repository() RepositoryRef {
+	return r.ref
+}
+
+func (r *dryRunRepository) Get() RepositoryInfo {
+	return r.info
+}
+
+func (r *dryRunRepository) Set(info RepositoryInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if err := r.d.validator.ValidateRepositoryInfo(info); err != nil {
+		return err
+	}
+	r.info = info
+	return nil
+}
+
+func (r *dryRunRepository) Update(ctx context.Context) error {
+	r.record("Update", "Repository", "", r.info.Diff(r.actual))
+	r.actual = r.info
+	return nil
+}
+
+func (r *dryRunRepository) Reconcile(ctx context.Context) (bool, error) {
+	if r.info.Equals(r.actual) {
+		return false, nil
+	}
+	return true, r.Update(ctx)
+}
+
+func (r *dryRunRepository) Delete(ctx context.Context) error {
+	if err := r.d.checkDelete("repository"); err != nil {
+		return err
+	}
+	r.record("Delete", "Repository", "", nil)
+	return nil
+}
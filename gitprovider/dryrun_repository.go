@@ -0,0 +1,1905 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+func (r *dryRunRepository) TeamAccess() TeamAccessClient {
+	c := &dryRunTeamAccessClient{r: r}
+	if repo, ok := r.inner.(OrgRepository); ok {
+		c.c = repo.TeamAccess()
+	}
+	return c
+}
+
+func (r *dryRunRepository) Collaborators() CollaboratorClient {
+	c := &dryRunCollaboratorClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.Collaborators()
+	}
+	return c
+}
+
+func (r *dryRunRepository) DeployKeys() DeployKeyClient {
+	c := &dryRunDeployKeyClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.DeployKeys()
+	}
+	return c
+}
+
+func (r *dryRunRepository) DeployTokens() (DeployTokenClient, error) {
+	c := &dryRunDeployTokenClient{r: r}
+	if r.inner != nil {
+		inner, err := r.inner.DeployTokens()
+		if err != nil {
+			return nil, err
+		}
+		c.c = inner
+	}
+	return c, nil
+}
+
+func (r *dryRunRepository) Commits() CommitClient {
+	c := &dryRunCommitClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.Commits()
+	}
+	return c
+}
+
+func (r *dryRunRepository) Branches() BranchClient {
+	c := &dryRunBranchClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.Branches()
+	}
+	return c
+}
+
+func (r *dryRunRepository) PullRequests() PullRequestClient {
+	c := &dryRunPullRequestClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.PullRequests()
+	}
+	return c
+}
+
+func (r *dryRunRepository) Issues() IssueClient {
+	c := &dryRunIssueClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.Issues()
+	}
+	return c
+}
+
+func (r *dryRunRepository) Labels() LabelClient {
+	c := &dryRunLabelClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.Labels()
+	}
+	return c
+}
+
+func (r *dryRunRepository) Files() FileClient {
+	c := &dryRunFileClient{}
+	if r.inner != nil {
+		c.c = r.inner.Files()
+	}
+	return c
+}
+
+func (r *dryRunRepository) Trees() TreeClient {
+	c := &dryRunTreeClient{}
+	if r.inner != nil {
+		c.c = r.inner.Trees()
+	}
+	return c
+}
+
+func (r *dryRunRepository) Webhooks() WebhookClient {
+	c := &dryRunWebhookClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.Webhooks()
+	}
+	return c
+}
+
+func (r *dryRunRepository) CommitStatuses() CommitStatusClient {
+	c := &dryRunCommitStatusClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.CommitStatuses()
+	}
+	return c
+}
+
+func (r *dryRunRepository) BranchProtections() BranchProtectionClient {
+	c := &dryRunBranchProtectionClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.BranchProtections()
+	}
+	return c
+}
+
+func (r *dryRunRepository) Tags() TagClient {
+	c := &dryRunTagClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.Tags()
+	}
+	return c
+}
+
+func (r *dryRunRepository) Releases() ReleaseClient {
+	c := &dryRunReleaseClient{r: r}
+	if r.inner != nil {
+		c.c = r.inner.Releases()
+	}
+	return c
+}
+
+// dryRunTeamAccessClient implements TeamAccessClient for a dry run. c is nil if the repository is only planned.
+type dryRunTeamAccessClient struct {
+	r *dryRunRepository
+	c TeamAccessClient
+}
+
+func (c *dryRunTeamAccessClient) Get(ctx context.Context, name string) (TeamAccess, error) {
+	if c.c == nil {
+		return nil, notFound("team access", name)
+	}
+	obj, err := c.c.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(obj), nil
+}
+
+func (c *dryRunTeamAccessClient) List(ctx context.Context) ([]TeamAccess, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	objs, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]TeamAccess, 0, len(objs))
+	for _, obj := range objs {
+		wrapped = append(wrapped, c.wrap(obj))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunTeamAccessClient) Create(ctx context.Context, req TeamAccessInfo) (TeamAccess, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	_, err := c.Get(ctx, req.Name)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "TeamAccess", req.Name, req.Diff(TeamAccessInfo{}))
+	return &dryRunTeamAccess{c: c, info: req, actual: req}, nil
+}
+
+func (c *dryRunTeamAccessClient) Reconcile(ctx context.Context, req TeamAccessInfo) (TeamAccess, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, req)
+	return resp, len(changes) != 0, err
+}
+
+func (c *dryRunTeamAccessClient) ReconcileWithChanges(ctx context.Context, req TeamAccessInfo) (TeamAccess, []FieldChange, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+	actual, err := c.Get(ctx, req.Name)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, req)
+		return resp, req.Diff(TeamAccessInfo{}), err
+	} else if err != nil {
+		return nil, nil, err
+	}
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return actual, nil, nil
+	}
+	if err := actual.Set(req); err != nil {
+		return actual, nil, err
+	}
+	return actual, changes, actual.Update(ctx)
+}
+
+func (c *dryRunTeamAccessClient) ReconcileAll(ctx context.Context, req []TeamAccessInfo, opts ...ReconcileAllOption) (*ReconcileReport, error) {
+	return ReconcileAllTeamAccess(ctx, c, c.r.d.destructiveActions, req, opts...)
+}
+
+func (c *dryRunTeamAccessClient) wrap(obj TeamAccess) TeamAccess {
+	return &dryRunTeamAccess{c: c, inner: obj, info: obj.Get(), actual: obj.Get()}
+}
+
+// dryRunTeamAccess implements TeamAccess for a dry run. inner is nil if the team access is only planned, actual
+// is the state of the team access before the dry run.
+type dryRunTeamAccess struct {
+	c      *dryRunTeamAccessClient
+	inner  TeamAccess
+	info   TeamAccessInfo
+	actual TeamAccessInfo
+}
+
+func (o *dryRunTeamAccess) APIObject() interface{} {
+	if o.inner != nil {
+		return o.inner.APIObject()
+	}
+	return &o.info
+}
+
+func (o *dryRunTeamAccess) Repository() RepositoryRef {
+	return o.c.r.ref
+}
+
+func (o *dryRunTeamAccess) Get() TeamAccessInfo {
+	return o.info
+}
+
+func (o *dryRunTeamAccess) Set(info TeamAccessInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	o.info = info
+	return nil
+}
+
+func (o *dryRunTeamAccess) Update(ctx context.Context) error {
+	o.c.r.record("Update", "TeamAccess", o.actual.Name, o.info.Diff(o.actual))
+	o.actual = o.info
+	return nil
+}
+
+func (o *dryRunTeamAccess) Reconcile(ctx context.Context) (bool, error) {
+	if o.info.Equals(o.actual) {
+		return false, nil
+	}
+	return true, o.Update(ctx)
+}
+
+func (o *dryRunTeamAccess) Delete(ctx context.Context) error {
+	o.c.r.record("Delete", "TeamAccess", o.actual.Name, nil)
+	return nil
+}
+
+// dryRunCollaboratorClient implements CollaboratorClient for a dry run. c is nil if the repository is only planned.
+type dryRunCollaboratorClient struct {
+	r *dryRunRepository
+	c CollaboratorClient
+}
+
+func (c *dryRunCollaboratorClient) Get(ctx context.Context, login string) (Collaborator, error) {
+	if c.c == nil {
+		return nil, notFound("collaborator", login)
+	}
+	obj, err := c.c.Get(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(obj), nil
+}
+
+func (c *dryRunCollaboratorClient) List(ctx context.Context) ([]Collaborator, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	objs, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]Collaborator, 0, len(objs))
+	for _, obj := range objs {
+		wrapped = append(wrapped, c.wrap(obj))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunCollaboratorClient) Create(ctx context.Context, req CollaboratorInfo) (Collaborator, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	_, err := c.Get(ctx, req.Login)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "Collaborator", req.Login, diffFields(req, nil))
+	return &dryRunCollaborator{c: c, info: req, actual: req}, nil
+}
+
+func (c *dryRunCollaboratorClient) Reconcile(ctx context.Context, req CollaboratorInfo) (Collaborator, bool, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+	actual, err := c.Get(ctx, req.Login)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, req)
+		return resp, true, err
+	} else if err != nil {
+		return nil, false, err
+	}
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
+func (c *dryRunCollaboratorClient) wrap(obj Collaborator) Collaborator {
+	return &dryRunCollaborator{c: c, inner: obj, info: obj.Get(), actual: obj.Get()}
+}
+
+// dryRunCollaborator implements Collaborator for a dry run. inner is nil if the collaborator is only planned, actual
+// is the state of the collaborator before the dry run.
+type dryRunCollaborator struct {
+	c      *dryRunCollaboratorClient
+	inner  Collaborator
+	info   CollaboratorInfo
+	actual CollaboratorInfo
+}
+
+func (o *dryRunCollaborator) APIObject() interface{} {
+	if o.inner != nil {
+		return o.inner.APIObject()
+	}
+	return &o.info
+}
+
+func (o *dryRunCollaborator) Repository() RepositoryRef {
+	return o.c.r.ref
+}
+
+func (o *dryRunCollaborator) Get() CollaboratorInfo {
+	return o.info
+}
+
+func (o *dryRunCollaborator) Set(info CollaboratorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	o.info = info
+	return nil
+}
+
+func (o *dryRunCollaborator) Update(ctx context.Context) error {
+	o.c.r.record("Update", "Collaborator", o.actual.Login, diffFields(o.info, o.actual))
+	o.actual = o.info
+	return nil
+}
+
+func (o *dryRunCollaborator) Reconcile(ctx context.Context) (bool, error) {
+	if o.info.Equals(o.actual) {
+		return false, nil
+	}
+	return true, o.Update(ctx)
+}
+
+func (o *dryRunCollaborator) Delete(ctx context.Context) error {
+	o.c.r.record("Delete", "Collaborator", o.actual.Login, nil)
+	return nil
+}
+
+// dryRunDeployKeyClient implements DeployKeyClient for a dry run. c is nil if the repository is only planned.
+type dryRunDeployKeyClient struct {
+	r *dryRunRepository
+	c DeployKeyClient
+}
+
+func (c *dryRunDeployKeyClient) Get(ctx context.Context, name string) (DeployKey, error) {
+	if c.c == nil {
+		return nil, notFound("deploy key", name)
+	}
+	obj, err := c.c.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(obj), nil
+}
+
+func (c *dryRunDeployKeyClient) List(ctx context.Context) ([]DeployKey, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	objs, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]DeployKey, 0, len(objs))
+	for _, obj := range objs {
+		wrapped = append(wrapped, c.wrap(obj))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunDeployKeyClient) Create(ctx context.Context, req DeployKeyInfo) (DeployKey, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	_, err := c.Get(ctx, req.Name)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "DeployKey", req.Name, req.Diff(DeployKeyInfo{}))
+	return &dryRunDeployKey{c: c, info: req, actual: req}, nil
+}
+
+func (c *dryRunDeployKeyClient) Reconcile(ctx context.Context, req DeployKeyInfo) (DeployKey, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, req)
+	return resp, len(changes) != 0, err
+}
+
+func (c *dryRunDeployKeyClient) ReconcileWithChanges(ctx context.Context, req DeployKeyInfo) (DeployKey, []FieldChange, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+	actual, err := c.Get(ctx, req.Name)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, req)
+		return resp, req.Diff(DeployKeyInfo{}), err
+	} else if err != nil {
+		return nil, nil, err
+	}
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return actual, nil, nil
+	}
+	if err := actual.Set(req); err != nil {
+		return actual, nil, err
+	}
+	return actual, changes, actual.Update(ctx)
+}
+
+func (c *dryRunDeployKeyClient) ReconcileAll(ctx context.Context, req []DeployKeyInfo, opts ...ReconcileAllOption) (*ReconcileReport, error) {
+	return ReconcileAllDeployKeys(ctx, c, c.r.d.destructiveActions, req, opts...)
+}
+
+func (c *dryRunDeployKeyClient) wrap(obj DeployKey) DeployKey {
+	return &dryRunDeployKey{c: c, inner: obj, info: obj.Get(), actual: obj.Get()}
+}
+
+// dryRunDeployKey implements DeployKey for a dry run. inner is nil if the deploy key is only planned, actual
+// is the state of the deploy key before the dry run.
+type dryRunDeployKey struct {
+	c      *dryRunDeployKeyClient
+	inner  DeployKey
+	info   DeployKeyInfo
+	actual DeployKeyInfo
+}
+
+func (o *dryRunDeployKey) APIObject() interface{} {
+	if o.inner != nil {
+		return o.inner.APIObject()
+	}
+	return &o.info
+}
+
+func (o *dryRunDeployKey) Repository() RepositoryRef {
+	return o.c.r.ref
+}
+
+func (o *dryRunDeployKey) Get() DeployKeyInfo {
+	return o.info
+}
+
+func (o *dryRunDeployKey) Set(info DeployKeyInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	o.info = info
+	return nil
+}
+
+func (o *dryRunDeployKey) Update(ctx context.Context) error {
+	o.c.r.record("Update", "DeployKey", o.actual.Name, o.info.Diff(o.actual))
+	o.actual = o.info
+	return nil
+}
+
+func (o *dryRunDeployKey) Reconcile(ctx context.Context) (bool, error) {
+	if o.info.Equals(o.actual) {
+		return false, nil
+	}
+	return true, o.Update(ctx)
+}
+
+func (o *dryRunDeployKey) Delete(ctx context.Context) error {
+	o.c.r.record("Delete", "DeployKey", o.actual.Name, nil)
+	return nil
+}
+
+// dryRunDeployTokenClient implements DeployTokenClient for a dry run. c is nil if the repository is only planned.
+type dryRunDeployTokenClient struct {
+	r *dryRunRepository
+	c DeployTokenClient
+}
+
+func (c *dryRunDeployTokenClient) Get(ctx context.Context, name string) (DeployToken, error) {
+	if c.c == nil {
+		return nil, notFound("deploy token", name)
+	}
+	obj, err := c.c.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(obj), nil
+}
+
+func (c *dryRunDeployTokenClient) List(ctx context.Context) ([]DeployToken, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	objs, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]DeployToken, 0, len(objs))
+	for _, obj := range objs {
+		wrapped = append(wrapped, c.wrap(obj))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunDeployTokenClient) Create(ctx context.Context, req DeployTokenInfo) (DeployToken, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	_, err := c.Get(ctx, req.Name)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "DeployToken", req.Name, req.Diff(DeployTokenInfo{}))
+	return &dryRunDeployToken{c: c, info: req, actual: req}, nil
+}
+
+func (c *dryRunDeployTokenClient) Reconcile(ctx context.Context, req DeployTokenInfo) (DeployToken, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, req)
+	return resp, len(changes) != 0, err
+}
+
+func (c *dryRunDeployTokenClient) ReconcileWithChanges(ctx context.Context, req DeployTokenInfo) (DeployToken, []FieldChange, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+	actual, err := c.Get(ctx, req.Name)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, req)
+		return resp, req.Diff(DeployTokenInfo{}), err
+	} else if err != nil {
+		return nil, nil, err
+	}
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return actual, nil, nil
+	}
+	if err := actual.Set(req); err != nil {
+		return actual, nil, err
+	}
+	return actual, changes, actual.Update(ctx)
+}
+
+func (c *dryRunDeployTokenClient) wrap(obj DeployToken) DeployToken {
+	return &dryRunDeployToken{c: c, inner: obj, info: obj.Get(), actual: obj.Get()}
+}
+
+// dryRunDeployToken implements DeployToken for a dry run. inner is nil if the deploy token is only planned, actual
+// is the state of the deploy token before the dry run.
+type dryRunDeployToken struct {
+	c      *dryRunDeployTokenClient
+	inner  DeployToken
+	info   DeployTokenInfo
+	actual DeployTokenInfo
+}
+
+func (o *dryRunDeployToken) APIObject() interface{} {
+	if o.inner != nil {
+		return o.inner.APIObject()
+	}
+	return &o.info
+}
+
+func (o *dryRunDeployToken) Repository() RepositoryRef {
+	return o.c.r.ref
+}
+
+func (o *dryRunDeployToken) Get() DeployTokenInfo {
+	return o.info
+}
+
+func (o *dryRunDeployToken) Set(info DeployTokenInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	o.info = info
+	return nil
+}
+
+func (o *dryRunDeployToken) Update(ctx context.Context) error {
+	o.c.r.record("Update", "DeployToken", o.actual.Name, o.info.Diff(o.actual))
+	o.actual = o.info
+	return nil
+}
+
+func (o *dryRunDeployToken) Reconcile(ctx context.Context) (bool, error) {
+	if o.info.Equals(o.actual) {
+		return false, nil
+	}
+	return true, o.Update(ctx)
+}
+
+func (o *dryRunDeployToken) Delete(ctx context.Context) error {
+	o.c.r.record("Delete", "DeployToken", o.actual.Name, nil)
+	return nil
+}
+
+// dryRunWebhookClient implements WebhookClient for a dry run. c is nil if the repository is only planned.
+type dryRunWebhookClient struct {
+	r *dryRunRepository
+	c WebhookClient
+}
+
+func (c *dryRunWebhookClient) Get(ctx context.Context, url string) (Webhook, error) {
+	if c.c == nil {
+		return nil, notFound("webhook", url)
+	}
+	obj, err := c.c.Get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(obj), nil
+}
+
+func (c *dryRunWebhookClient) List(ctx context.Context) ([]Webhook, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	objs, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]Webhook, 0, len(objs))
+	for _, obj := range objs {
+		wrapped = append(wrapped, c.wrap(obj))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunWebhookClient) Create(ctx context.Context, req WebhookInfo) (Webhook, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	_, err := c.Get(ctx, req.URL)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "Webhook", req.URL, diffFields(req, nil))
+	return &dryRunWebhook{c: c, info: req, actual: req}, nil
+}
+
+func (c *dryRunWebhookClient) Reconcile(ctx context.Context, req WebhookInfo) (Webhook, bool, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+	actual, err := c.Get(ctx, req.URL)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, req)
+		return resp, true, err
+	} else if err != nil {
+		return nil, false, err
+	}
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
+func (c *dryRunWebhookClient) wrap(obj Webhook) Webhook {
+	return &dryRunWebhook{c: c, inner: obj, info: obj.Get(), actual: obj.Get()}
+}
+
+// dryRunWebhook implements Webhook for a dry run. inner is nil if the webhook is only planned, actual
+// is the state of the webhook before the dry run.
+type dryRunWebhook struct {
+	c      *dryRunWebhookClient
+	inner  Webhook
+	info   WebhookInfo
+	actual WebhookInfo
+}
+
+func (o *dryRunWebhook) APIObject() interface{} {
+	if o.inner != nil {
+		return o.inner.APIObject()
+	}
+	return &o.info
+}
+
+func (o *dryRunWebhook) Repository() RepositoryRef {
+	return o.c.r.ref
+}
+
+func (o *dryRunWebhook) Get() WebhookInfo {
+	return o.info
+}
+
+func (o *dryRunWebhook) Set(info WebhookInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	o.info = info
+	return nil
+}
+
+func (o *dryRunWebhook) Update(ctx context.Context) error {
+	o.c.r.record("Update", "Webhook", o.actual.URL, diffFields(o.info, o.actual))
+	o.actual = o.info
+	return nil
+}
+
+func (o *dryRunWebhook) Reconcile(ctx context.Context) (bool, error) {
+	if o.info.Equals(o.actual) {
+		return false, nil
+	}
+	return true, o.Update(ctx)
+}
+
+func (o *dryRunWebhook) Delete(ctx context.Context) error {
+	o.c.r.record("Delete", "Webhook", o.actual.URL, nil)
+	return nil
+}
+
+// dryRunBranchProtectionClient implements BranchProtectionClient for a dry run. c is nil if the repository is only planned.
+type dryRunBranchProtectionClient struct {
+	r *dryRunRepository
+	c BranchProtectionClient
+}
+
+func (c *dryRunBranchProtectionClient) Get(ctx context.Context, branch string) (BranchProtection, error) {
+	if c.c == nil {
+		return nil, notFound("branch protection", branch)
+	}
+	obj, err := c.c.Get(ctx, branch)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(obj), nil
+}
+
+func (c *dryRunBranchProtectionClient) List(ctx context.Context) ([]BranchProtection, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	objs, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]BranchProtection, 0, len(objs))
+	for _, obj := range objs {
+		wrapped = append(wrapped, c.wrap(obj))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunBranchProtectionClient) Create(ctx context.Context, req BranchProtectionInfo) (BranchProtection, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	_, err := c.Get(ctx, req.Branch)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "BranchProtection", req.Branch, diffFields(req, nil))
+	return &dryRunBranchProtection{c: c, info: req, actual: req}, nil
+}
+
+func (c *dryRunBranchProtectionClient) Reconcile(ctx context.Context, req BranchProtectionInfo) (BranchProtection, bool, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+	actual, err := c.Get(ctx, req.Branch)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, req)
+		return resp, true, err
+	} else if err != nil {
+		return nil, false, err
+	}
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
+func (c *dryRunBranchProtectionClient) wrap(obj BranchProtection) BranchProtection {
+	return &dryRunBranchProtection{c: c, inner: obj, info: obj.Get(), actual: obj.Get()}
+}
+
+// dryRunBranchProtection implements BranchProtection for a dry run. inner is nil if the branch protection is only planned, actual
+// is the state of the branch protection before the dry run.
+type dryRunBranchProtection struct {
+	c      *dryRunBranchProtectionClient
+	inner  BranchProtection
+	info   BranchProtectionInfo
+	actual BranchProtectionInfo
+}
+
+func (o *dryRunBranchProtection) APIObject() interface{} {
+	if o.inner != nil {
+		return o.inner.APIObject()
+	}
+	return &o.info
+}
+
+func (o *dryRunBranchProtection) Repository() RepositoryRef {
+	return o.c.r.ref
+}
+
+func (o *dryRunBranchProtection) Get() BranchProtectionInfo {
+	return o.info
+}
+
+func (o *dryRunBranchProtection) Set(info BranchProtectionInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	o.info = info
+	return nil
+}
+
+func (o *dryRunBranchProtection) Update(ctx context.Context) error {
+	o.c.r.record("Update", "BranchProtection", o.actual.Branch, diffFields(o.info, o.actual))
+	o.actual = o.info
+	return nil
+}
+
+func (o *dryRunBranchProtection) Reconcile(ctx context.Context) (bool, error) {
+	if o.info.Equals(o.actual) {
+		return false, nil
+	}
+	return true, o.Update(ctx)
+}
+
+func (o *dryRunBranchProtection) Delete(ctx context.Context) error {
+	o.c.r.record("Delete", "BranchProtection", o.actual.Branch, nil)
+	return nil
+}
+
+// dryRunLabelClient implements LabelClient for a dry run. c is nil if the repository is only planned.
+type dryRunLabelClient struct {
+	r *dryRunRepository
+	c LabelClient
+}
+
+func (c *dryRunLabelClient) Get(ctx context.Context, name string) (Label, error) {
+	if c.c == nil {
+		return nil, notFound("label", name)
+	}
+	obj, err := c.c.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(obj), nil
+}
+
+func (c *dryRunLabelClient) List(ctx context.Context) ([]Label, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	objs, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]Label, 0, len(objs))
+	for _, obj := range objs {
+		wrapped = append(wrapped, c.wrap(obj))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunLabelClient) Create(ctx context.Context, req LabelInfo) (Label, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	_, err := c.Get(ctx, req.Name)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "Label", req.Name, diffFields(req, nil))
+	return &dryRunLabel{c: c, info: req, actual: req}, nil
+}
+
+func (c *dryRunLabelClient) Reconcile(ctx context.Context, req LabelInfo) (Label, bool, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+	actual, err := c.Get(ctx, req.Name)
+	if errors.Is(err, ErrNotFound) {
+		resp, err := c.Create(ctx, req)
+		return resp, true, err
+	} else if err != nil {
+		return nil, false, err
+	}
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
+func (c *dryRunLabelClient) ReconcileAll(ctx context.Context, req []LabelInfo, opts ...ReconcileAllOption) (*ReconcileReport, error) {
+	return ReconcileAllLabels(ctx, c, c.r.d.destructiveActions, req, opts...)
+}
+
+func (c *dryRunLabelClient) wrap(obj Label) Label {
+	return &dryRunLabel{c: c, inner: obj, info: obj.Get(), actual: obj.Get()}
+}
+
+// dryRunLabel implements Label for a dry run. inner is nil if the label is only planned, actual
+// is the state of the label before the dry run.
+type dryRunLabel struct {
+	c      *dryRunLabelClient
+	inner  Label
+	info   LabelInfo
+	actual LabelInfo
+}
+
+func (o *dryRunLabel) APIObject() interface{} {
+	if o.inner != nil {
+		return o.inner.APIObject()
+	}
+	return &o.info
+}
+
+func (o *dryRunLabel) Repository() RepositoryRef {
+	return o.c.r.ref
+}
+
+func (o *dryRunLabel) Get() LabelInfo {
+	return o.info
+}
+
+func (o *dryRunLabel) Set(info LabelInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	o.info = info
+	return nil
+}
+
+func (o *dryRunLabel) Update(ctx context.Context) error {
+	o.c.r.record("Update", "Label", o.actual.Name, diffFields(o.info, o.actual))
+	o.actual = o.info
+	return nil
+}
+
+func (o *dryRunLabel) Reconcile(ctx context.Context) (bool, error) {
+	if o.info.Equals(o.actual) {
+		return false, nil
+	}
+	return true, o.Update(ctx)
+}
+
+func (o *dryRunLabel) Delete(ctx context.Context) error {
+	if err := o.c.r.d.checkDelete("label"); err != nil {
+		return err
+	}
+	o.c.r.record("Delete", "Label", o.actual.Name, nil)
+	return nil
+}
+
+// dryRunCommitStatusClient implements CommitStatusClient for a dry run. c is nil if the repository
+// is only planned.
+type dryRunCommitStatusClient struct {
+	r *dryRunRepository
+	c CommitStatusClient
+}
+
+func (c *dryRunCommitStatusClient) List(ctx context.Context, sha string) ([]CommitStatus, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	return c.c.List(ctx, sha)
+}
+
+func (c *dryRunCommitStatusClient) Create(ctx context.Context, sha string, req CommitStatusInfo) (CommitStatus, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "CommitStatus", sha, diffFields(req, nil))
+	return &dryRunCommitStatus{r: c.r, info: req}, nil
+}
+
+func (c *dryRunCommitStatusClient) Combined(ctx context.Context, sha string) (*CombinedCommitStatusInfo, error) {
+	if c.c == nil {
+		return nil, notFound("commit", sha)
+	}
+	return c.c.Combined(ctx, sha)
+}
+
+// dryRunCommitStatus implements CommitStatus for a planned commit status.
+type dryRunCommitStatus struct {
+	r    *dryRunRepository
+	info CommitStatusInfo
+}
+
+func (s *dryRunCommitStatus) APIObject() interface{} {
+	return &s.info
+}
+
+func (s *dryRunCommitStatus) Repository() RepositoryRef {
+	return s.r.ref
+}
+
+func (s *dryRunCommitStatus) Get() CommitStatusInfo {
+	return s.info
+}
+
+// dryRunCommitClient implements CommitClient for a dry run. c is nil if the repository is only
+// planned.
+type dryRunCommitClient struct {
+	r *dryRunRepository
+	c CommitClient
+}
+
+func (c *dryRunCommitClient) ListPage(ctx context.Context, branch string, perPage int, page int) ([]Commit, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	return c.c.ListPage(ctx, branch, perPage, page)
+}
+
+// Create records the commit with a change per file, from the file's path to its content, which is
+// nil for files that would be deleted.
+func (c *dryRunCommitClient) Create(ctx context.Context, branch string, message string, files []CommitFile) (Commit, error) {
+	if err := c.r.checkBranch(ctx, branch); err != nil {
+		return nil, err
+	}
+	changes := make([]FieldChange, 0, len(files))
+	for _, file := range files {
+		if file.Path == nil {
+			return nil, fmt.Errorf("commit file has no path: %w", ErrInvalidArgument)
+		}
+		change := FieldChange{Path: *file.Path}
+		if file.Content != nil {
+			change.New = *file.Content
+		}
+		changes = append(changes, change)
+	}
+	c.r.record("Create", "Commit", branch, changes)
+	return &dryRunCommit{info: CommitInfo{Message: message}}, nil
+}
+
+// dryRunCommit implements Commit for a planned commit.
+type dryRunCommit struct {
+	info CommitInfo
+}
+
+func (c *dryRunCommit) APIObject() interface{} {
+	return &c.info
+}
+
+func (c *dryRunCommit) Get() CommitInfo {
+	return c.info
+}
+
+// dryRunBranchClient implements BranchClient for a dry run. c is nil if the repository is only
+// planned.
+type dryRunBranchClient struct {
+	r *dryRunRepository
+	c BranchClient
+}
+
+func (c *dryRunBranchClient) Get(ctx context.Context, branch string) (Branch, error) {
+	if c.c == nil {
+		return nil, notFound("branch", branch)
+	}
+	return c.c.Get(ctx, branch)
+}
+
+func (c *dryRunBranchClient) List(ctx context.Context) ([]Branch, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	return c.c.List(ctx)
+}
+
+func (c *dryRunBranchClient) Create(ctx context.Context, branch, sha string) error {
+	if err := checkNotExists(c.r.checkBranch(ctx, branch)); err != nil {
+		return err
+	}
+	c.r.record("Create", "Branch", branch, []FieldChange{{Path: "sha", New: sha}})
+	c.r.d.planBranch(c.r.ref, branch, true)
+	return nil
+}
+
+func (c *dryRunBranchClient) Delete(ctx context.Context, branch string) error {
+	if err := c.r.d.checkDelete("branch"); err != nil {
+		return err
+	}
+	if err := c.r.checkBranch(ctx, branch); err != nil {
+		return err
+	}
+	c.r.record("Delete", "Branch", branch, nil)
+	c.r.d.planBranch(c.r.ref, branch, false)
+	return nil
+}
+
+// RenameDefault plans the new default branch, and the retargeting of the open pull requests into
+// the current one. Whether the current default branch is kept depends on the provider, hence it's
+// not planned.
+func (c *dryRunBranchClient) RenameDefault(ctx context.Context, branch string) error {
+	if err := checkNotExists(c.r.checkBranch(ctx, branch)); err != nil {
+		return err
+	}
+	previous := c.r.actual.DefaultBranch
+	c.r.record("RenameDefault", "Branch", branch, []FieldChange{{Path: "defaultBranch", Old: previous, New: branch}})
+	c.r.d.planBranch(c.r.ref, branch, true)
+	c.r.info.DefaultBranch = StringVar(branch)
+	c.r.actual.DefaultBranch = StringVar(branch)
+	if previous == nil {
+		return nil
+	}
+	return RetargetPullRequests(ctx, c.r.PullRequests(), *previous, branch)
+}
+
+// dryRunTagClient implements TagClient for a dry run. c is nil if the repository is only planned.
+type dryRunTagClient struct {
+	r *dryRunRepository
+	c TagClient
+}
+
+func (c *dryRunTagClient) Get(ctx context.Context, name string) (Tag, error) {
+	if c.c == nil {
+		return nil, notFound("tag", name)
+	}
+	return c.c.Get(ctx, name)
+}
+
+func (c *dryRunTagClient) List(ctx context.Context) ([]Tag, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	return c.c.List(ctx)
+}
+
+func (c *dryRunTagClient) Create(ctx context.Context, req TagInfo) (Tag, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	_, err := c.Get(ctx, req.Name)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "Tag", req.Name, diffFields(req, nil))
+	return &dryRunTag{r: c.r, info: req}, nil
+}
+
+func (c *dryRunTagClient) Delete(ctx context.Context, name string) error {
+	if err := c.r.d.checkDelete("tag"); err != nil {
+		return err
+	}
+	c.r.record("Delete", "Tag", name, nil)
+	return nil
+}
+
+// dryRunTag implements Tag for a planned tag.
+type dryRunTag struct {
+	r    *dryRunRepository
+	info TagInfo
+}
+
+func (t *dryRunTag) APIObject() interface{} {
+	return &t.info
+}
+
+func (t *dryRunTag) Repository() RepositoryRef {
+	return t.r.ref
+}
+
+func (t *dryRunTag) Get() TagInfo {
+	return t.info
+}
+
+// dryRunReleaseClient implements ReleaseClient for a dry run. c is nil if the repository is only
+// planned.
+type dryRunReleaseClient struct {
+	r *dryRunRepository
+	c ReleaseClient
+}
+
+func (c *dryRunReleaseClient) Get(ctx context.Context, tagName string) (Release, error) {
+	if c.c == nil {
+		return nil, notFound("release", tagName)
+	}
+	release, err := c.c.Get(ctx, tagName)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(release), nil
+}
+
+func (c *dryRunReleaseClient) List(ctx context.Context) ([]Release, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	releases, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]Release, 0, len(releases))
+	for _, release := range releases {
+		wrapped = append(wrapped, c.wrap(release))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunReleaseClient) Create(ctx context.Context, req ReleaseInfo) (Release, error) {
+	if err := ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	_, err := c.Get(ctx, req.TagName)
+	if err := checkNotExists(err); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "Release", req.TagName, diffFields(req, nil))
+	return &dryRunRelease{c: c, info: req, actual: req}, nil
+}
+
+func (c *dryRunReleaseClient) wrap(release Release) Release {
+	return &dryRunRelease{c: c, inner: release, info: release.Get(), actual: release.Get()}
+}
+
+// dryRunRelease implements Release for a dry run. inner is nil if the release is only planned,
+// actual is the state of the release before the dry run.
+type dryRunRelease struct {
+	c      *dryRunReleaseClient
+	inner  Release
+	info   ReleaseInfo
+	actual ReleaseInfo
+}
+
+func (r *dryRunRelease) APIObject() interface{} {
+	if r.inner != nil {
+		return r.inner.APIObject()
+	}
+	return &r.info
+}
+
+func (r *dryRunRelease) Repository() RepositoryRef {
+	return r.c.r.ref
+}
+
+func (r *dryRunRelease) Get() ReleaseInfo {
+	return r.info
+}
+
+func (r *dryRunRelease) Set(info ReleaseInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	r.info = info
+	return nil
+}
+
+func (r *dryRunRelease) Update(ctx context.Context) error {
+	r.c.r.record("Update", "Release", r.actual.TagName, diffFields(r.info, r.actual))
+	r.actual = r.info
+	return nil
+}
+
+func (r *dryRunRelease) Delete(ctx context.Context) error {
+	if err := r.c.r.d.checkDelete("release"); err != nil {
+		return err
+	}
+	r.c.r.record("Delete", "Release", r.actual.TagName, nil)
+	return nil
+}
+
+// UploadAsset records the upload without reading content.
+func (r *dryRunRelease) UploadAsset(ctx context.Context, name string, content io.Reader) (ReleaseAssetInfo, error) {
+	if name == "" {
+		return ReleaseAssetInfo{}, fmt.Errorf("asset name cannot be empty: %w", ErrInvalidArgument)
+	}
+	r.c.r.record("UploadAsset", "Release", r.actual.TagName, []FieldChange{{Path: "assets", New: name}})
+	return ReleaseAssetInfo{Name: name}, nil
+}
+
+// dryRunPullRequestClient implements PullRequestClient for a dry run. c is nil if the repository
+// is only planned.
+type dryRunPullRequestClient struct {
+	r *dryRunRepository
+	c PullRequestClient
+}
+
+func (c *dryRunPullRequestClient) List(ctx context.Context, opts ...PullRequestListOption) ([]PullRequest, error) {
+	if _, err := MakePullRequestListOptions(opts...); err != nil {
+		return nil, err
+	}
+	if c.c == nil {
+		return nil, nil
+	}
+	prs, err := c.c.List(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		wrapped = append(wrapped, c.wrap(pr))
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunPullRequestClient) Get(ctx context.Context, number int) (PullRequest, error) {
+	if c.c == nil {
+		return nil, notFound("pull request", number)
+	}
+	pr, err := c.c.Get(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(pr), nil
+}
+
+func (c *dryRunPullRequestClient) Create(ctx context.Context, title, branch, baseBranch, description string) (PullRequest, error) {
+	if err := c.r.checkBranch(ctx, branch); err != nil {
+		return nil, err
+	}
+	if err := c.r.checkBranch(ctx, baseBranch); err != nil {
+		return nil, err
+	}
+	info := PullRequestInfo{
+		Title:        title,
+		Description:  description,
+		SourceBranch: branch,
+		TargetBranch: baseBranch,
+		State:        PullRequestStateOpen,
+	}
+	c.r.record("Create", "PullRequest", branch, diffFields(info, nil))
+	return &dryRunPullRequest{c: c, info: info}, nil
+}
+
+func (c *dryRunPullRequestClient) Edit(ctx context.Context, number int, opts EditOptions) (PullRequest, error) {
+	pr, err := c.Get(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	info := pr.Get()
+	if opts.Title != nil {
+		info.Title = *opts.Title
+	}
+	if opts.Description != nil {
+		info.Description = *opts.Description
+	}
+	if opts.TargetBranch != nil {
+		info.TargetBranch = *opts.TargetBranch
+	}
+	if opts.State != nil {
+		info.State = *opts.State
+	}
+	if opts.Draft != nil {
+		info.Draft = *opts.Draft
+	}
+	if opts.Assignees != nil {
+		info.Assignees = opts.Assignees
+	}
+	if opts.Reviewers != nil {
+		info.Reviewers = opts.Reviewers
+	}
+	if opts.Labels != nil {
+		info.Labels = opts.Labels
+	}
+	c.r.record("Edit", "PullRequest", pullRequestName(number), diffFields(info, pr.Get()))
+	return &dryRunPullRequest{c: c, inner: pr.(*dryRunPullRequest).inner, info: info}, nil
+}
+
+// Merge records the merge, if the pull request is open, has no conflicts and is at the expected
+// head. The commit the merge would create isn't known, hence the head of the pull request is
+// returned instead.
+func (c *dryRunPullRequestClient) Merge(ctx context.Context, number int, mergeMethod MergeMethod, message string, opts ...PullRequestMergeOption) (*PullRequestMergeResult, error) {
+	if err := ValidateMergeMethod(mergeMethod); err != nil {
+		return nil, err
+	}
+	o, err := MakePullRequestMergeOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	info, err := c.getOpen(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mergeable != nil && !*info.Mergeable {
+		return nil, newConflictError(fmt.Sprintf("pull request %d conflicts with its base branch", number))
+	}
+	if o.ExpectedHeadSha != nil && *o.ExpectedHeadSha != info.HeadSha {
+		return nil, NewHeadChangedError(number, *o.ExpectedHeadSha, info.HeadSha)
+	}
+	c.r.record("Merge", "PullRequest", pullRequestName(number), []FieldChange{
+		{Path: "merged", Old: false, New: true},
+		{Path: "state", Old: info.State, New: PullRequestStateMerged},
+		{Path: "merge_method", New: mergeMethod},
+	})
+	if o.DeleteSourceBranch != nil && *o.DeleteSourceBranch {
+		c.r.record("Delete", "Branch", info.SourceBranch, nil)
+		c.r.d.planBranch(c.r.ref, info.SourceBranch, false)
+	}
+	return &PullRequestMergeResult{Sha: info.HeadSha}, nil
+}
+
+func (c *dryRunPullRequestClient) EnableAutoMerge(ctx context.Context, number int, mergeMethod MergeMethod) error {
+	if err := ValidateMergeMethod(mergeMethod); err != nil {
+		return err
+	}
+	if err := c.r.d.validator.ValidateEnableAutoMerge(mergeMethod); err != nil {
+		return err
+	}
+	if _, err := c.getOpen(ctx, number); err != nil {
+		return err
+	}
+	c.r.record("EnableAutoMerge", "PullRequest", pullRequestName(number), []FieldChange{{Path: "auto_merge", Old: false, New: true}})
+	return nil
+}
+
+func (c *dryRunPullRequestClient) DisableAutoMerge(ctx context.Context, number int) error {
+	if err := c.r.d.validator.ValidateDisableAutoMerge(); err != nil {
+		return err
+	}
+	if _, err := c.Get(ctx, number); err != nil {
+		return err
+	}
+	c.r.record("DisableAutoMerge", "PullRequest", pullRequestName(number), []FieldChange{{Path: "auto_merge", Old: true, New: false}})
+	return nil
+}
+
+// getOpen returns the pull request with the number, or a *ConflictError if it isn't open.
+func (c *dryRunPullRequestClient) getOpen(ctx context.Context, number int) (PullRequestInfo, error) {
+	pr, err := c.Get(ctx, number)
+	if err != nil {
+		return PullRequestInfo{}, err
+	}
+	info := pr.Get()
+	if info.Merged || info.State != PullRequestStateOpen {
+		return info, newConflictError(fmt.Sprintf("pull request %d is %s", number, info.State))
+	}
+	return info, nil
+}
+
+// newConflictError returns a *ConflictError for a conflict detected by the dry run.
+func newConflictError(msg string) *ConflictError {
+	return &ConflictError{HTTPError: HTTPError{ErrorMessage: msg, Message: msg}}
+}
+
+func (c *dryRunPullRequestClient) wrap(pr PullRequest) PullRequest {
+	return &dryRunPullRequest{c: c, inner: pr, info: pr.Get()}
+}
+
+// pullRequestName returns the name of the pull request or issue with the number in a
+// PlannedOperation, e.g. "#1".
+func pullRequestName(number int) string {
+	return fmt.Sprintf("#%d", number)
+}
+
+// dryRunPullRequest implements PullRequest for a dry run. inner is nil if the pull request is only
+// planned.
+type dryRunPullRequest struct {
+	c     *dryRunPullRequestClient
+	inner PullRequest
+	info  PullRequestInfo
+}
+
+func (pr *dryRunPullRequest) APIObject() interface{} {
+	if pr.inner != nil {
+		return pr.inner.APIObject()
+	}
+	return &pr.info
+}
+
+func (pr *dryRunPullRequest) Get() PullRequestInfo {
+	return pr.info
+}
+
+func (pr *dryRunPullRequest) Comments() PullRequestCommentClient {
+	c := &dryRunPullRequestCommentClient{pr: pr}
+	if pr.inner != nil {
+		c.c = pr.inner.Comments()
+	}
+	return c
+}
+
+func (pr *dryRunPullRequest) Reviews() PullRequestReviewClient {
+	c := &dryRunPullRequestReviewClient{pr: pr}
+	if pr.inner != nil {
+		c.c = pr.inner.Reviews()
+	}
+	return c
+}
+
+func (pr *dryRunPullRequest) Files(ctx context.Context) (*PullRequestFiles, error) {
+	if pr.inner == nil {
+		return nil, notFound("pull request", pr.info.SourceBranch)
+	}
+	return pr.inner.Files(ctx)
+}
+
+func (pr *dryRunPullRequest) Diff(ctx context.Context) (string, error) {
+	if pr.inner == nil {
+		return "", notFound("pull request", pr.info.SourceBranch)
+	}
+	return pr.inner.Diff(ctx)
+}
+
+// record records an operation on an object of the pull request.
+func (pr *dryRunPullRequest) record(operation, resource string, changes []FieldChange) {
+	name := pr.info.SourceBranch
+	if pr.inner != nil {
+		name = pullRequestName(pr.info.Number)
+	}
+	pr.c.r.record(operation, resource, name, changes)
+}
+
+// dryRunPullRequestCommentClient implements PullRequestCommentClient for a dry run. c is nil if
+// the pull request is only planned.
+type dryRunPullRequestCommentClient struct {
+	pr *dryRunPullRequest
+	c  PullRequestCommentClient
+}
+
+func (c *dryRunPullRequestCommentClient) Get(ctx context.Context, id int64) (PullRequestComment, error) {
+	if c.c == nil {
+		return nil, notFound("pull request comment", id)
+	}
+	comment, err := c.c.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &dryRunPullRequestComment{pr: c.pr, inner: comment, info: comment.Get(), actual: comment.Get()}, nil
+}
+
+func (c *dryRunPullRequestCommentClient) List(ctx context.Context) ([]PullRequestComment, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	comments, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]PullRequestComment, 0, len(comments))
+	for _, comment := range comments {
+		wrapped = append(wrapped, &dryRunPullRequestComment{pr: c.pr, inner: comment, info: comment.Get(), actual: comment.Get()})
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunPullRequestCommentClient) Create(ctx context.Context, req PullRequestCommentInfo) (PullRequestComment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	c.pr.record("Create", "PullRequestComment", diffFields(req, nil))
+	return &dryRunPullRequestComment{pr: c.pr, info: req, actual: req}, nil
+}
+
+// dryRunPullRequestComment implements PullRequestComment for a dry run. inner is nil if the
+// comment is only planned, actual is the state of the comment before the dry run.
+type dryRunPullRequestComment struct {
+	pr     *dryRunPullRequest
+	inner  PullRequestComment
+	info   PullRequestCommentInfo
+	actual PullRequestCommentInfo
+}
+
+func (pc *dryRunPullRequestComment) APIObject() interface{} {
+	if pc.inner != nil {
+		return pc.inner.APIObject()
+	}
+	return &pc.info
+}
+
+func (pc *dryRunPullRequestComment) Get() PullRequestCommentInfo {
+	return pc.info
+}
+
+func (pc *dryRunPullRequestComment) Set(info PullRequestCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	pc.info = info
+	return nil
+}
+
+func (pc *dryRunPullRequestComment) Update(ctx context.Context) error {
+	pc.pr.record("Update", "PullRequestComment", diffFields(pc.info, pc.actual))
+	pc.actual = pc.info
+	return nil
+}
+
+func (pc *dryRunPullRequestComment) Delete(ctx context.Context) error {
+	pc.pr.record("Delete", "PullRequestComment", []FieldChange{{Path: "id", Old: pc.actual.ID}})
+	return nil
+}
+
+// dryRunPullRequestReviewClient implements PullRequestReviewClient for a dry run. c is nil if the
+// pull request is only planned.
+type dryRunPullRequestReviewClient struct {
+	pr *dryRunPullRequest
+	c  PullRequestReviewClient
+}
+
+func (c *dryRunPullRequestReviewClient) List(ctx context.Context) ([]PullRequestReview, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	return c.c.List(ctx)
+}
+
+func (c *dryRunPullRequestReviewClient) Create(ctx context.Context, req PullRequestReviewInfo) (PullRequestReview, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	c.pr.record("Create", "PullRequestReview", diffFields(req, nil))
+	return &dryRunPullRequestReview{info: req}, nil
+}
+
+// dryRunPullRequestReview implements PullRequestReview for a planned review.
+type dryRunPullRequestReview struct {
+	info PullRequestReviewInfo
+}
+
+func (r *dryRunPullRequestReview) APIObject() interface{} {
+	return &r.info
+}
+
+func (r *dryRunPullRequestReview) Get() PullRequestReviewInfo {
+	return r.info
+}
+
+// dryRunIssueClient implements IssueClient for a dry run. c is nil if the repository is only
+// planned.
+type dryRunIssueClient struct {
+	r *dryRunRepository
+	c IssueClient
+}
+
+func (c *dryRunIssueClient) Get(ctx context.Context, number int) (Issue, error) {
+	if c.c == nil {
+		return nil, notFound("issue", number)
+	}
+	issue, err := c.c.Get(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return &dryRunIssue{c: c, inner: issue, info: issue.Get()}, nil
+}
+
+func (c *dryRunIssueClient) List(ctx context.Context, opts ...IssueListOption) ([]Issue, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	issues, err := c.c.List(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]Issue, 0, len(issues))
+	for _, issue := range issues {
+		wrapped = append(wrapped, &dryRunIssue{c: c, inner: issue, info: issue.Get()})
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunIssueClient) Create(ctx context.Context, req IssueInfo) (Issue, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	c.r.record("Create", "Issue", req.Title, diffFields(req, nil))
+	return &dryRunIssue{c: c, info: req}, nil
+}
+
+func (c *dryRunIssueClient) Edit(ctx context.Context, number int, opts IssueEditOptions) (Issue, error) {
+	issue, err := c.Get(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	info := issue.Get()
+	if opts.Title != nil {
+		info.Title = *opts.Title
+	}
+	if opts.Description != nil {
+		info.Description = *opts.Description
+	}
+	if opts.State != nil {
+		info.State = *opts.State
+	}
+	if opts.Assignees != nil {
+		info.Assignees = opts.Assignees
+	}
+	if opts.Labels != nil {
+		info.Labels = opts.Labels
+	}
+	if opts.Milestone != nil {
+		info.Milestone = *opts.Milestone
+	}
+	c.r.record("Edit", "Issue", pullRequestName(number), diffFields(info, issue.Get()))
+	return &dryRunIssue{c: c, inner: issue.(*dryRunIssue).inner, info: info}, nil
+}
+
+func (c *dryRunIssueClient) Close(ctx context.Context, number int) (Issue, error) {
+	issue, err := c.Get(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	info := issue.Get()
+	info.State = IssueStateClosed
+	c.r.record("Close", "Issue", pullRequestName(number), diffFields(info, issue.Get()))
+	return &dryRunIssue{c: c, inner: issue.(*dryRunIssue).inner, info: info}, nil
+}
+
+// dryRunIssue implements Issue for a dry run. inner is nil if the issue is only planned.
+type dryRunIssue struct {
+	c     *dryRunIssueClient
+	inner Issue
+	info  IssueInfo
+}
+
+func (i *dryRunIssue) APIObject() interface{} {
+	if i.inner != nil {
+		return i.inner.APIObject()
+	}
+	return &i.info
+}
+
+func (i *dryRunIssue) Get() IssueInfo {
+	return i.info
+}
+
+func (i *dryRunIssue) Comments() IssueCommentClient {
+	c := &dryRunIssueCommentClient{issue: i}
+	if i.inner != nil {
+		c.c = i.inner.Comments()
+	}
+	return c
+}
+
+// record records an operation on an object of the issue.
+func (i *dryRunIssue) record(operation, resource string, changes []FieldChange) {
+	name := i.info.Title
+	if i.inner != nil {
+		name = pullRequestName(i.info.Number)
+	}
+	i.c.r.record(operation, resource, name, changes)
+}
+
+// dryRunIssueCommentClient implements IssueCommentClient for a dry run. c is nil if the issue is
+// only planned.
+type dryRunIssueCommentClient struct {
+	issue *dryRunIssue
+	c     IssueCommentClient
+}
+
+func (c *dryRunIssueCommentClient) Get(ctx context.Context, id int64) (IssueComment, error) {
+	if c.c == nil {
+		return nil, notFound("issue comment", id)
+	}
+	comment, err := c.c.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &dryRunIssueComment{issue: c.issue, inner: comment, info: comment.Get(), actual: comment.Get()}, nil
+}
+
+func (c *dryRunIssueCommentClient) List(ctx context.Context) ([]IssueComment, error) {
+	if c.c == nil {
+		return nil, nil
+	}
+	comments, err := c.c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make([]IssueComment, 0, len(comments))
+	for _, comment := range comments {
+		wrapped = append(wrapped, &dryRunIssueComment{issue: c.issue, inner: comment, info: comment.Get(), actual: comment.Get()})
+	}
+	return wrapped, nil
+}
+
+func (c *dryRunIssueCommentClient) Create(ctx context.Context, req IssueCommentInfo) (IssueComment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	c.issue.record("Create", "IssueComment", diffFields(req, nil))
+	return &dryRunIssueComment{issue: c.issue, info: req, actual: req}, nil
+}
+
+// dryRunIssueComment implements IssueComment for a dry run. inner is nil if the comment is only
+// planned, actual is the state of the comment before the dry run.
+type dryRunIssueComment struct {
+	issue  *dryRunIssue
+	inner  IssueComment
+	info   IssueCommentInfo
+	actual IssueCommentInfo
+}
+
+func (ic *dryRunIssueComment) APIObject() interface{} {
+	if ic.inner != nil {
+		return ic.inner.APIObject()
+	}
+	return &ic.info
+}
+
+func (ic *dryRunIssueComment) Get() IssueCommentInfo {
+	return ic.info
+}
+
+func (ic *dryRunIssueComment) Set(info IssueCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	ic.info = info
+	return nil
+}
+
+func (ic *dryRunIssueComment) Update(ctx context.Context) error {
+	ic.issue.record("Update", "IssueComment", diffFields(ic.info, ic.actual))
+	ic.actual = ic.info
+	return nil
+}
+
+func (ic *dryRunIssueComment) Delete(ctx context.Context) error {
+	ic.issue.record("Delete", "IssueComment", []FieldChange{{Path: "id", Old: ic.actual.ID}})
+	return nil
+}
+
+// dryRunFileClient implements FileClient for a dry run. c is nil if the repository is only planned.
+type dryRunFileClient struct {
+	c FileClient
+}
+
+func (c *dryRunFileClient) Get(ctx context.Context, path, branch string, optFns ...FilesGetOption) ([]*CommitFile, error) {
+	if c.c == nil {
+		return nil, notFound("path", path)
+	}
+	return c.c.Get(ctx, path, branch, optFns...)
+}
+
+// dryRunTreeClient implements TreeClient for a dry run. c is nil if the repository is only planned.
+type dryRunTreeClient struct {
+	c TreeClient
+}
+
+func (c *dryRunTreeClient) Get(ctx context.Context, sha string, recursive bool) (*TreeInfo, error) {
+	if c.c == nil {
+		return nil, notFound("tree", sha)
+	}
+	return c.c.Get(ctx, sha, recursive)
+}
+
+func (c *dryRunTreeClient) List(ctx context.Context, sha string, path string, recursive bool) ([]*TreeEntry, error) {
+	if c.c == nil {
+		return nil, notFound("tree", sha)
+	}
+	return c.c.List(ctx, sha, path, recursive)
+}
@@ -124,6 +124,110 @@ func RepositoryPermissionVar(p RepositoryPermission) *RepositoryPermission {
 	return &p
 }
 
+// OrganizationVisibility is an enum specifying the visibility of an organization.
+type OrganizationVisibility string
+
+const (
+	// OrganizationVisibilityPublic specifies that the organization is visible to everyone.
+	OrganizationVisibilityPublic = OrganizationVisibility("public")
+	// OrganizationVisibilityInternal specifies that the organization is visible to all
+	// users signed in to the Git provider.
+	OrganizationVisibilityInternal = OrganizationVisibility("internal")
+	// OrganizationVisibilityPrivate specifies that the organization is only visible to its members.
+	OrganizationVisibilityPrivate = OrganizationVisibility("private")
+)
+
+// knownOrganizationVisibilityValues is a map of known OrganizationVisibility values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownOrganizationVisibilityValues = map[OrganizationVisibility]struct{}{
+	OrganizationVisibilityPublic:   {},
+	OrganizationVisibilityInternal: {},
+	OrganizationVisibilityPrivate:  {},
+}
+
+// ValidateOrganizationVisibility validates a given OrganizationVisibility.
+// Use as errs.Append(ValidateOrganizationVisibility(visibility), visibility, "FieldName").
+func ValidateOrganizationVisibility(v OrganizationVisibility) error {
+	_, ok := knownOrganizationVisibilityValues[v]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// OrganizationVisibilityVar returns a pointer to an OrganizationVisibility.
+func OrganizationVisibilityVar(v OrganizationVisibility) *OrganizationVisibility {
+	return &v
+}
+
+// TeamPrivacy is an enum specifying who can see a team within its organization.
+type TeamPrivacy string
+
+const (
+	// TeamPrivacyClosed specifies that the team is visible to all members of the organization.
+	TeamPrivacyClosed = TeamPrivacy("closed")
+	// TeamPrivacySecret specifies that the team is only visible to its members and the
+	// owners of the organization.
+	TeamPrivacySecret = TeamPrivacy("secret")
+)
+
+// knownTeamPrivacyValues is a map of known TeamPrivacy values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownTeamPrivacyValues = map[TeamPrivacy]struct{}{
+	TeamPrivacyClosed: {},
+	TeamPrivacySecret: {},
+}
+
+// ValidateTeamPrivacy validates a given TeamPrivacy.
+// Use as errs.Append(ValidateTeamPrivacy(privacy), privacy, "FieldName").
+func ValidateTeamPrivacy(p TeamPrivacy) error {
+	_, ok := knownTeamPrivacyValues[p]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// TeamPrivacyVar returns a pointer to a TeamPrivacy.
+func TeamPrivacyVar(p TeamPrivacy) *TeamPrivacy {
+	return &p
+}
+
+// TeamMemberRole is an enum specifying the role of a member within a team.
+type TeamMemberRole string
+
+const (
+	// TeamMemberRoleMember specifies a regular member of the team.
+	TeamMemberRoleMember = TeamMemberRole("member")
+	// TeamMemberRoleMaintainer specifies a member who can also manage the team and its members.
+	TeamMemberRoleMaintainer = TeamMemberRole("maintainer")
+)
+
+// knownTeamMemberRoleValues is a map of known TeamMemberRole values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownTeamMemberRoleValues = map[TeamMemberRole]struct{}{
+	TeamMemberRoleMember:     {},
+	TeamMemberRoleMaintainer: {},
+}
+
+// ValidateTeamMemberRole validates a given TeamMemberRole.
+// Use as errs.Append(ValidateTeamMemberRole(role), role, "FieldName").
+func ValidateTeamMemberRole(r TeamMemberRole) error {
+	_, ok := knownTeamMemberRoleValues[r]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// TeamMemberRoleVar returns a pointer to a TeamMemberRole.
+func TeamMemberRoleVar(r TeamMemberRole) *TeamMemberRole {
+	return &r
+}
+
 // LicenseTemplate is an enum specifying a license template that can be used when creating a
 // repository. Examples of available licenses are here:
 // https://docs.github.com/en/github/creating-cloning-and-archiving-repositories/licensing-a-repository#searching-github-by-license-type
@@ -182,4 +286,331 @@ const (
 
 	// MergeMethodSquash causes a pull request merge to first squash commits
 	MergeMethodSquash = MergeMethod("squash")
+
+	// MergeMethodRebase causes a pull request merge to rebase its commits onto the base branch
+	MergeMethodRebase = MergeMethod("rebase")
+)
+
+// knownMergeMethodValues is a map of known MergeMethod values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownMergeMethodValues = map[MergeMethod]struct{}{
+	MergeMethodMerge:  {},
+	MergeMethodSquash: {},
+	MergeMethodRebase: {},
+}
+
+// ValidateMergeMethod validates a given MergeMethod.
+// Use as errs.Append(ValidateMergeMethod(method), method, "FieldName").
+func ValidateMergeMethod(m MergeMethod) error {
+	_, ok := knownMergeMethodValues[m]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// WebhookContentType is an enum specifying the payload encoding a webhook uses when
+// delivering events.
+type WebhookContentType string
+
+const (
+	// WebhookContentTypeJSON delivers the payload as the body of an application/json request.
+	WebhookContentTypeJSON = WebhookContentType("json")
+
+	// WebhookContentTypeForm delivers the payload as the "payload" parameter of an
+	// application/x-www-form-urlencoded request.
+	// Not all providers support this, in which case ErrNoProviderSupport is returned.
+	WebhookContentTypeForm = WebhookContentType("form")
+)
+
+// knownWebhookContentTypeValues is a map of known WebhookContentType values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownWebhookContentTypeValues = map[WebhookContentType]struct{}{
+	WebhookContentTypeJSON: {},
+	WebhookContentTypeForm: {},
+}
+
+// ValidateWebhookContentType validates a given WebhookContentType.
+// Use as errs.Append(ValidateWebhookContentType(contentType), contentType, "FieldName").
+func ValidateWebhookContentType(t WebhookContentType) error {
+	_, ok := knownWebhookContentTypeValues[t]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// WebhookContentTypeVar returns a pointer to a WebhookContentType.
+func WebhookContentTypeVar(t WebhookContentType) *WebhookContentType {
+	return &t
+}
+
+// WebhookEvent is an enum specifying a provider-independent class of events a webhook
+// can be subscribed to. Each provider maps these onto its own event names; if a provider
+// has no equivalent for an event, ErrNoProviderSupport is returned.
+type WebhookEvent string
+
+const (
+	// WebhookEventPush is sent when commits are pushed to a branch.
+	WebhookEventPush = WebhookEvent("push")
+
+	// WebhookEventTagPush is sent when a tag is created or deleted.
+	WebhookEventTagPush = WebhookEvent("tag_push")
+
+	// WebhookEventPullRequest is sent when a pull request is opened, updated, merged or closed.
+	WebhookEventPullRequest = WebhookEvent("pull_request")
+
+	// WebhookEventIssues is sent when an issue is opened, edited or closed.
+	WebhookEventIssues = WebhookEvent("issues")
+
+	// WebhookEventComment is sent when a comment is made on an issue or pull request.
+	WebhookEventComment = WebhookEvent("comment")
+
+	// WebhookEventRelease is sent when a release is published.
+	WebhookEventRelease = WebhookEvent("release")
+)
+
+// knownWebhookEventValues is a map of known WebhookEvent values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownWebhookEventValues = map[WebhookEvent]struct{}{
+	WebhookEventPush:        {},
+	WebhookEventTagPush:     {},
+	WebhookEventPullRequest: {},
+	WebhookEventIssues:      {},
+	WebhookEventComment:     {},
+	WebhookEventRelease:     {},
+}
+
+// ValidateWebhookEvent validates a given WebhookEvent.
+// Use as errs.Append(ValidateWebhookEvent(event), event, "FieldName").
+func ValidateWebhookEvent(e WebhookEvent) error {
+	_, ok := knownWebhookEventValues[e]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// CommitStatusState is an enum specifying the state of a commit status.
+type CommitStatusState string
+
+const (
+	// CommitStatusStatePending means that e.g. the build is queued or running.
+	CommitStatusStatePending = CommitStatusState("pending")
+
+	// CommitStatusStateSuccess means that e.g. the build succeeded.
+	CommitStatusStateSuccess = CommitStatusState("success")
+
+	// CommitStatusStateFailure means that e.g. the build failed.
+	CommitStatusStateFailure = CommitStatusState("failure")
+
+	// CommitStatusStateError means that e.g. the build couldn't run.
+	CommitStatusStateError = CommitStatusState("error")
+)
+
+// knownCommitStatusStateValues is a map of known CommitStatusState values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownCommitStatusStateValues = map[CommitStatusState]struct{}{
+	CommitStatusStatePending: {},
+	CommitStatusStateSuccess: {},
+	CommitStatusStateFailure: {},
+	CommitStatusStateError:   {},
+}
+
+// ValidateCommitStatusState validates a given CommitStatusState.
+// Use as errs.Append(ValidateCommitStatusState(state), state, "FieldName").
+func ValidateCommitStatusState(s CommitStatusState) error {
+	_, ok := knownCommitStatusStateValues[s]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// CommitStatusStateVar returns a pointer to a CommitStatusState.
+func CommitStatusStateVar(s CommitStatusState) *CommitStatusState {
+	return &s
+}
+
+// PullRequestState is an enum specifying the state of a pull request.
+type PullRequestState string
+
+const (
+	// PullRequestStateOpen means that the pull request can still be merged.
+	PullRequestStateOpen = PullRequestState("open")
+
+	// PullRequestStateClosed means that the pull request was closed without being merged.
+	PullRequestStateClosed = PullRequestState("closed")
+
+	// PullRequestStateMerged means that the pull request was merged.
+	PullRequestStateMerged = PullRequestState("merged")
+
+	// PullRequestStateAll is only used when listing pull requests, to list pull requests
+	// in any state.
+	PullRequestStateAll = PullRequestState("all")
+)
+
+// knownPullRequestStateValues is a map of known PullRequestState values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownPullRequestStateValues = map[PullRequestState]struct{}{
+	PullRequestStateOpen:   {},
+	PullRequestStateClosed: {},
+	PullRequestStateMerged: {},
+	PullRequestStateAll:    {},
+}
+
+// ValidatePullRequestState validates a given PullRequestState.
+// Use as errs.Append(ValidatePullRequestState(state), state, "FieldName").
+func ValidatePullRequestState(s PullRequestState) error {
+	_, ok := knownPullRequestStateValues[s]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// PullRequestStateVar returns a pointer to a PullRequestState.
+func PullRequestStateVar(s PullRequestState) *PullRequestState {
+	return &s
+}
+
+// PullRequestReviewState is an enum specifying the state of a pull request review.
+type PullRequestReviewState string
+
+const (
+	// PullRequestReviewStateApproved means that the reviewer approved the changes.
+	PullRequestReviewStateApproved = PullRequestReviewState("approved")
+
+	// PullRequestReviewStateChangesRequested means that the reviewer requested changes
+	// before the pull request can be merged.
+	PullRequestReviewStateChangesRequested = PullRequestReviewState("changes_requested")
+
+	// PullRequestReviewStateCommented means that the reviewer commented without approving
+	// or requesting changes.
+	PullRequestReviewStateCommented = PullRequestReviewState("commented")
+
+	// PullRequestReviewStateDismissed means that the review was dismissed, e.g. by a maintainer.
+	// It is only returned by the server, and can't be submitted.
+	PullRequestReviewStateDismissed = PullRequestReviewState("dismissed")
+)
+
+// knownPullRequestReviewStateValues is a map of known PullRequestReviewState values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownPullRequestReviewStateValues = map[PullRequestReviewState]struct{}{
+	PullRequestReviewStateApproved:         {},
+	PullRequestReviewStateChangesRequested: {},
+	PullRequestReviewStateCommented:        {},
+	PullRequestReviewStateDismissed:        {},
+}
+
+// ValidatePullRequestReviewState validates a given PullRequestReviewState.
+// Use as errs.Append(ValidatePullRequestReviewState(state), state, "FieldName").
+func ValidatePullRequestReviewState(s PullRequestReviewState) error {
+	_, ok := knownPullRequestReviewStateValues[s]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// PullRequestReviewStateVar returns a pointer to a PullRequestReviewState.
+func PullRequestReviewStateVar(s PullRequestReviewState) *PullRequestReviewState {
+	return &s
+}
+
+// PullRequestFileStatus is an enum specifying how a file was changed by a pull request.
+type PullRequestFileStatus string
+
+const (
+	// PullRequestFileStatusAdded means that the file was created by the pull request.
+	PullRequestFileStatusAdded = PullRequestFileStatus("added")
+
+	// PullRequestFileStatusModified means that the content of the file was changed.
+	PullRequestFileStatusModified = PullRequestFileStatus("modified")
+
+	// PullRequestFileStatusRemoved means that the file was deleted by the pull request.
+	PullRequestFileStatusRemoved = PullRequestFileStatus("removed")
+
+	// PullRequestFileStatusRenamed means that the file was moved from its previous path,
+	// its content may have been changed as well.
+	PullRequestFileStatusRenamed = PullRequestFileStatus("renamed")
+)
+
+// knownPullRequestFileStatusValues is a map of known PullRequestFileStatus values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownPullRequestFileStatusValues = map[PullRequestFileStatus]struct{}{
+	PullRequestFileStatusAdded:    {},
+	PullRequestFileStatusModified: {},
+	PullRequestFileStatusRemoved:  {},
+	PullRequestFileStatusRenamed:  {},
+}
+
+// ValidatePullRequestFileStatus validates a given PullRequestFileStatus.
+// Use as errs.Append(ValidatePullRequestFileStatus(status), status, "FieldName").
+func ValidatePullRequestFileStatus(s PullRequestFileStatus) error {
+	_, ok := knownPullRequestFileStatusValues[s]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// IssueState is an enum specifying the state of an issue.
+type IssueState string
+
+const (
+	// IssueStateOpen means that the issue is still being worked on.
+	IssueStateOpen = IssueState("open")
+
+	// IssueStateClosed means that the issue was closed, e.g. because it's resolved.
+	IssueStateClosed = IssueState("closed")
+
+	// IssueStateAll is only used when listing issues, to list issues in any state.
+	IssueStateAll = IssueState("all")
+)
+
+// knownIssueStateValues is a map of known IssueState values, used for validation.
+//
+//nolint:gochecknoglobals
+var knownIssueStateValues = map[IssueState]struct{}{
+	IssueStateOpen:   {},
+	IssueStateClosed: {},
+	IssueStateAll:    {},
+}
+
+// ValidateIssueState validates a given IssueState.
+// Use as errs.Append(ValidateIssueState(state), state, "FieldName").
+func ValidateIssueState(s IssueState) error {
+	_, ok := knownIssueStateValues[s]
+	if !ok {
+		return validation.ErrFieldEnumInvalid
+	}
+	return nil
+}
+
+// IssueStateVar returns a pointer to an IssueState.
+func IssueStateVar(s IssueState) *IssueState {
+	return &s
+}
+
+// ReconcileAction is an enum specifying the kind of change made by a set-level reconcile, such as
+// TeamAccessClient.ReconcileAll.
+type ReconcileAction string
+
+const (
+	// ReconcileActionCreate means that the object didn't exist, and is created.
+	ReconcileActionCreate = ReconcileAction("create")
+
+	// ReconcileActionUpdate means that the object didn't equal the desired state, and is updated.
+	ReconcileActionUpdate = ReconcileAction("update")
+
+	// ReconcileActionDelete means that the object isn't in the desired set, and is deleted.
+	ReconcileActionDelete = ReconcileAction("delete")
 )
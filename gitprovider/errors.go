@@ -133,6 +133,22 @@ type InvalidCredentialsError struct {
 	HTTPError `json:",inline"`
 }
 
+// ConflictError describes that a request conflicts with the current state of the resource, e.g.
+// that a pull request can't be merged as its head isn't the expected commit anymore, or as its
+// changes conflict with its base branch.
+type ConflictError struct {
+	// ConflictError extends HTTPError. The Response is nil if the conflict was detected by the client,
+	// or if the underlying API client doesn't expose it.
+	HTTPError `json:",inline"`
+}
+
+// NewHeadChangedError returns a *ConflictError describing that the head of the pull request with the
+// given number is the commit actual, instead of expected.
+func NewHeadChangedError(number int, expected, actual string) *ConflictError {
+	msg := fmt.Sprintf("head of pull request %d is %q, expected %q", number, actual, expected)
+	return &ConflictError{HTTPError: HTTPError{ErrorMessage: msg, Message: msg}}
+}
+
 // ErrIncorrectUser describes that the user provided was incorrect
 //
 // It is returned by `UserRepositories().Create` when an incorrect UserLogin is passed in
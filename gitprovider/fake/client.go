@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// DefaultDomain specifies the default domain used as the backend.
+	DefaultDomain = "fake.example.com"
+	// ProviderID is the provider ID for the in-memory fake.
+	ProviderID = gitprovider.ProviderID("fake")
+)
+
+// NewClient creates a new gitprovider.Client instance keeping all of its state in memory,
+// for use in unit tests of code built on top of gitprovider.
+//
+// login is the user the client is authenticated as, returned by GetUserLogin. Organizations
+// and their teams don't exist until they have been created through the client, or added with
+// AddOrganization and AddTeam.
+// The domain can be customized using WithDomain, and destructive calls are blocked unless
+// WithDestructiveAPICalls(true) is given, just like for the real providers.
+func NewClient(login string, optFns ...gitprovider.ClientOption) (*Client, error) {
+	// Complete the options struct
+	opts, err := gitprovider.MakeClientOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+	if login == "" {
+		return nil, fmt.Errorf("login must not be empty: %w", gitprovider.ErrInvalidArgument)
+	}
+
+	domain := DefaultDomain
+	if opts.Domain != nil {
+		domain = *opts.Domain
+	}
+	// By default, turn destructive actions off. But allow overrides.
+	destructiveActions := false
+	if opts.EnableDestructiveAPICalls != nil {
+		destructiveActions = *opts.EnableDestructiveAPICalls
+	}
+
+	c := newClient(newStore(), domain, login, destructiveActions)
+	if opts.DryRun != nil && *opts.DryRun {
+		c.dryRun = gitprovider.NewDryRun(destructiveActions, nil)
+	}
+	return c, nil
+}
+
+func newClient(s *store, domain, login string, destructiveActions bool) *Client {
+	ctx := &clientContext{s, domain, login, destructiveActions}
+	return &Client{
+		clientContext: ctx,
+		orgs: &OrganizationsClient{
+			clientContext: ctx,
+		},
+		orgRepos: &OrgRepositoriesClient{
+			clientContext: ctx,
+		},
+		userRepos: &UserRepositoriesClient{
+			clientContext: ctx,
+		},
+	}
+}
+
+type clientContext struct {
+	s                  *store
+	domain             string
+	login              string
+	destructiveActions bool
+}
+
+// Client implements the gitprovider.Client interface.
+var _ gitprovider.Client = &Client{}
+
+// Client is an in-memory implementation of gitprovider.Client.
+// It is safe for concurrent use.
+type Client struct {
+	*clientContext
+
+	orgs      *OrganizationsClient
+	orgRepos  *OrgRepositoriesClient
+	userRepos *UserRepositoriesClient
+
+	// dryRun is set if the client was created using gitprovider.WithDryRun(true).
+	dryRun *gitprovider.DryRun
+}
+
+// SupportedDomain returns the domain endpoint for this client, e.g. "fake.example.com".
+// This field is set at client creation time, and can't be changed.
+func (c *Client) SupportedDomain() string {
+	return c.domain
+}
+
+// ProviderID returns the provider ID "fake".
+// This field is set at client creation time, and can't be changed.
+func (c *Client) ProviderID() gitprovider.ProviderID {
+	return ProviderID
+}
+
+// Raw returns the *Client itself, as there's no other Go client used under the hood.
+func (c *Client) Raw() interface{} {
+	return c
+}
+
+// Organizations returns the OrganizationsClient handling sets of organizations.
+func (c *Client) Organizations() gitprovider.OrganizationsClient {
+	if c.dryRun != nil {
+		return c.dryRun.Organizations(c.orgs)
+	}
+	return c.orgs
+}
+
+// OrgRepositories returns the OrgRepositoriesClient handling sets of repositories in an organization.
+func (c *Client) OrgRepositories() gitprovider.OrgRepositoriesClient {
+	if c.dryRun != nil {
+		return c.dryRun.OrgRepositories(c.orgRepos)
+	}
+	return c.orgRepos
+}
+
+// UserRepositories returns the UserRepositoriesClient handling sets of repositories for a user.
+func (c *Client) UserRepositories() gitprovider.UserRepositoriesClient {
+	if c.dryRun != nil {
+		return c.dryRun.UserRepositories(c.userRepos)
+	}
+	return c.userRepos
+}
+
+// DryRunPlan returns the Plan recording the write API calls skipped by this client, if it was
+// created using gitprovider.WithDryRun(true). Otherwise, nil is returned.
+func (c *Client) DryRunPlan() *gitprovider.Plan {
+	return c.dryRun.Plan()
+}
+
+// HasTokenPermission returns true for all permissions, as the fake client isn't restricted.
+func (c *Client) HasTokenPermission(ctx context.Context, permission gitprovider.TokenPermission) (bool, error) {
+	return true, nil
+}
+
+// WithDestructiveAPICalls returns a client for the same in-memory backend as c, with
+// destructive calls allowed or not. This allows testing both modes against the same state.
+// If c is a dry run client, the returned client records the skipped writes in a new Plan.
+func (c *Client) WithDestructiveAPICalls(destructiveActions bool) *Client {
+	client := newClient(c.s, c.domain, c.login, destructiveActions)
+	if c.dryRun != nil {
+		client.dryRun = gitprovider.NewDryRun(destructiveActions, nil)
+	}
+	return client
+}
+
+// WithDryRun returns a client for the same in-memory backend as c, which records the writes in a
+// new Plan instead of making them if dryRun is true. This allows testing dry runs against state
+// seeded using c.
+func (c *Client) WithDryRun(dryRun bool) *Client {
+	client := newClient(c.s, c.domain, c.login, c.destructiveActions)
+	if dryRun {
+		client.dryRun = gitprovider.NewDryRun(c.destructiveActions, nil)
+	}
+	return client
+}
+
+// AddOrganization adds an organization, which may also be a sub-organization, to the fake backend.
+// Unlike OrganizationsClient.Create, info is stored as given and the parent organization doesn't
+// need to exist, which allows seeding the backend with organizations in any state.
+//
+// ErrAlreadyExists is returned if the organization has already been added.
+func (c *Client) AddOrganization(ref gitprovider.OrganizationRef, info gitprovider.OrganizationInfo) error {
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if _, ok := c.s.orgs[orgKey(ref)]; ok {
+		return fmt.Errorf("organization %q: %w", orgKey(ref), gitprovider.ErrAlreadyExists)
+	}
+	c.s.orgs[orgKey(ref)] = &orgRecord{
+		ref:         ref,
+		info:        copyOrganizationInfo(info),
+		teams:       map[string]*gitprovider.TeamInfo{},
+		maintainers: map[string]map[string]struct{}{},
+	}
+	return nil
+}
+
+// AddTeam adds a team to an organization previously added with AddOrganization.
+//
+// ErrNotFound is returned if the organization doesn't exist, and ErrAlreadyExists if the team does.
+func (c *Client) AddTeam(ref gitprovider.OrganizationRef, info gitprovider.TeamInfo) error {
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return err
+	}
+	if info.Name == "" {
+		return fmt.Errorf("team name must not be empty: %w", gitprovider.ErrInvalidArgument)
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	org, ok := c.s.orgs[orgKey(ref)]
+	if !ok {
+		return fmt.Errorf("organization %q: %w", orgKey(ref), gitprovider.ErrNotFound)
+	}
+	if _, ok := org.teams[info.Name]; ok {
+		return fmt.Errorf("team %q: %w", info.Name, gitprovider.ErrAlreadyExists)
+	}
+	info = copyTeamInfo(info)
+	org.teams[info.Name] = &info
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TeamMemberClient implements the gitprovider.TeamMemberClient interface.
+var _ gitprovider.TeamMemberClient = &TeamMemberClient{}
+
+// TeamMemberClient operates on the members of a specific team.
+type TeamMemberClient struct {
+	*clientContext
+	ref  gitprovider.OrganizationRef
+	team string
+}
+
+// List all members of the team, with their roles, in the order they were added.
+func (c *TeamMemberClient) List(ctx context.Context) ([]gitprovider.TeamMemberInfo, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	org, team, err := c.getTeam()
+	if err != nil {
+		return nil, err
+	}
+	members := make([]gitprovider.TeamMemberInfo, 0, len(team.Members))
+	for _, login := range team.Members {
+		role := gitprovider.TeamMemberRoleMember
+		if _, ok := org.maintainers[c.team][login]; ok {
+			role = gitprovider.TeamMemberRoleMaintainer
+		}
+		members = append(members, gitprovider.TeamMemberInfo{Login: login, Role: gitprovider.TeamMemberRoleVar(role)})
+	}
+	return members, nil
+}
+
+// Add adds the user to the team with the given role. If the user already is a member of
+// the team, their role is changed to the given one.
+func (c *TeamMemberClient) Add(ctx context.Context, req gitprovider.TeamMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	org, team, err := c.getTeam()
+	if err != nil {
+		return err
+	}
+	if !containsString(team.Members, req.Login) {
+		team.Members = append(team.Members, req.Login)
+	}
+	if *req.Role == gitprovider.TeamMemberRoleMaintainer {
+		if org.maintainers[c.team] == nil {
+			org.maintainers[c.team] = map[string]struct{}{}
+		}
+		org.maintainers[c.team][req.Login] = struct{}{}
+	} else {
+		delete(org.maintainers[c.team], req.Login)
+	}
+	return nil
+}
+
+// Remove removes the user with the given login from the team.
+//
+// ErrNotFound is returned if the user isn't a member of the team.
+func (c *TeamMemberClient) Remove(ctx context.Context, login string) error {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	org, team, err := c.getTeam()
+	if err != nil {
+		return err
+	}
+	for i, member := range team.Members {
+		if member == login {
+			team.Members = append(team.Members[:i], team.Members[i+1:]...)
+			delete(org.maintainers[c.team], login)
+			return nil
+		}
+	}
+	return fmt.Errorf("member %q of team %q: %w", login, c.team, gitprovider.ErrNotFound)
+}
+
+// Reconcile makes sure the users in req are members of the team with the desired roles,
+// adding them or changing their role as needed. With the Exclusive option, the members of
+// the team that aren't in req are removed.
+func (c *TeamMemberClient) Reconcile(ctx context.Context, req []gitprovider.TeamMemberInfo, opts ...gitprovider.TeamMemberReconcileOption) (bool, error) {
+	return gitprovider.ReconcileTeamMembers(ctx, c, req, opts...)
+}
+
+// getTeam returns the record of the organization and the stored team this client is bound to.
+// The caller must hold the store lock.
+func (c *TeamMemberClient) getTeam() (*orgRecord, *gitprovider.TeamInfo, error) {
+	org, ok := c.s.orgs[orgKey(c.ref)]
+	if !ok {
+		return nil, nil, fmt.Errorf("organization %q: %w", orgKey(c.ref), gitprovider.ErrNotFound)
+	}
+	team, ok := org.teams[c.team]
+	if !ok {
+		return nil, nil, fmt.Errorf("team %q: %w", c.team, gitprovider.ErrNotFound)
+	}
+	return org, team, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TeamsClient implements the gitprovider.TeamsClient interface.
+var _ gitprovider.TeamsClient = &TeamsClient{}
+
+// TeamsClient handles teams organization-wide.
+type TeamsClient struct {
+	*clientContext
+	ref gitprovider.OrganizationRef
+}
+
+// Get a team within the specific organization.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Team, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	org, err := c.getOrganization()
+	if err != nil {
+		return nil, err
+	}
+	team, ok := org.teams[teamName]
+	if !ok {
+		return nil, fmt.Errorf("team %q: %w", teamName, gitprovider.ErrNotFound)
+	}
+	return newTeam(c, *team), nil
+}
+
+// List all teams within the specific organization.
+//
+// List returns all available teams, sorted by name.
+func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	org, err := c.getOrganization()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(org.teams))
+	for name := range org.teams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	teams := make([]gitprovider.Team, 0, len(names))
+	for _, name := range names {
+		teams = append(teams, newTeam(c, *org.teams[name]))
+	}
+	return teams, nil
+}
+
+// Create creates a team with the given specifications. Members of req are ignored.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamsClient) Create(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	org, err := c.getOrganization()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := org.teams[req.Name]; ok {
+		return nil, fmt.Errorf("team %q: %w", req.Name, gitprovider.ErrAlreadyExists)
+	}
+	req.Members = nil
+	info := copyTeamInfo(req)
+	org.teams[req.Name] = &info
+	return newTeam(c, info), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamsClient) Reconcile(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// getOrganization returns the record of the organization this client is bound to.
+// The caller must hold the store lock.
+func (c *TeamsClient) getOrganization() (*orgRecord, error) {
+	org, ok := c.s.orgs[orgKey(c.ref)]
+	if !ok {
+		return nil, fmt.Errorf("organization %q: %w", orgKey(c.ref), gitprovider.ErrNotFound)
+	}
+	return org, nil
+}
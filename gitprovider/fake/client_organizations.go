@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// OrganizationsClient implements the gitprovider.OrganizationsClient interface.
+var _ gitprovider.OrganizationsClient = &OrganizationsClient{}
+
+// OrganizationsClient operates on organizations the user has access to.
+type OrganizationsClient struct {
+	*clientContext
+}
+
+// Get a specific organization the user has access to.
+// This might also refer to a sub-organization.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *OrganizationsClient) Get(ctx context.Context, ref gitprovider.OrganizationRef) (gitprovider.Organization, error) {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	org, ok := c.s.orgs[orgKey(ref)]
+	if !ok {
+		return nil, fmt.Errorf("organization %q: %w", orgKey(ref), gitprovider.ErrNotFound)
+	}
+	return newOrganization(c.clientContext, copyOrganizationInfo(org.info), ref), nil
+}
+
+// List all top-level organizations the specific user has access to.
+//
+// List returns all available organizations.
+func (c *OrganizationsClient) List(ctx context.Context) ([]gitprovider.Organization, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	orgs := []gitprovider.Organization{}
+	for _, key := range sortedOrgKeys(c.s.orgs) {
+		org := c.s.orgs[key]
+		if len(org.ref.SubOrganizations) == 0 {
+			orgs = append(orgs, newOrganization(c.clientContext, copyOrganizationInfo(org.info), org.ref))
+		}
+	}
+	return orgs, nil
+}
+
+// Children returns the immediate child-organizations for the specific OrganizationRef o.
+// The OrganizationRef may point to any existing sub-organization.
+//
+// ErrNotFound is returned if the organization does not exist.
+func (c *OrganizationsClient) Children(ctx context.Context, ref gitprovider.OrganizationRef) ([]gitprovider.Organization, error) {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if _, ok := c.s.orgs[orgKey(ref)]; !ok {
+		return nil, fmt.Errorf("organization %q: %w", orgKey(ref), gitprovider.ErrNotFound)
+	}
+
+	prefix := orgKey(ref) + "/"
+	children := []gitprovider.Organization{}
+	for _, key := range sortedOrgKeys(c.s.orgs) {
+		if strings.HasPrefix(key, prefix) && !strings.Contains(strings.TrimPrefix(key, prefix), "/") {
+			org := c.s.orgs[key]
+			children = append(children, newOrganization(c.clientContext, copyOrganizationInfo(org.info), org.ref))
+		}
+	}
+	return children, nil
+}
+
+// Create creates the organization o with the given data. If o is a sub-organization, its
+// parent organization must exist. The visibility defaults to private.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrganizationsClient) Create(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, error) {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if _, ok := c.s.orgs[orgKey(ref)]; ok {
+		return nil, fmt.Errorf("organization %q: %w", orgKey(ref), gitprovider.ErrAlreadyExists)
+	}
+	req.Parent = nil
+	if n := len(ref.SubOrganizations); n > 0 {
+		parent := ref
+		parent.SubOrganizations = ref.SubOrganizations[:n-1]
+		if _, ok := c.s.orgs[orgKey(parent)]; !ok {
+			return nil, fmt.Errorf("parent organization %q: %w", orgKey(parent), gitprovider.ErrNotFound)
+		}
+		req.Parent = gitprovider.StringVar(orgKey(parent))
+	}
+	if req.Visibility == nil {
+		req.Visibility = gitprovider.OrganizationVisibilityVar(gitprovider.OrganizationVisibilityPrivate)
+	}
+	c.s.orgs[orgKey(ref)] = &orgRecord{
+		ref:         ref,
+		info:        copyOrganizationInfo(req),
+		teams:       map[string]*gitprovider.TeamInfo{},
+		maintainers: map[string]map[string]struct{}{},
+	}
+	return newOrganization(c.clientContext, copyOrganizationInfo(req), ref), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrganizationsClient) Reconcile(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, bool, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, ref)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, ref, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	org, ok := c.s.orgs[orgKey(ref)]
+	if !ok {
+		return nil, false, fmt.Errorf("organization %q: %w", orgKey(ref), gitprovider.ErrNotFound)
+	}
+	// Keep the parent, and the visibility if it's not set
+	req.Parent = org.info.Parent
+	if req.Visibility == nil {
+		req.Visibility = org.info.Visibility
+	}
+	org.info = copyOrganizationInfo(req)
+	return newOrganization(c.clientContext, copyOrganizationInfo(org.info), ref), true, nil
+}
+
+// Delete deletes the organization o, including its sub-organizations and repositories.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource doesn't exist.
+func (c *OrganizationsClient) Delete(ctx context.Context, ref gitprovider.OrganizationRef) error {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return err
+	}
+	// Don't allow deleting organizations if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete organization: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if _, ok := c.s.orgs[orgKey(ref)]; !ok {
+		return fmt.Errorf("organization %q: %w", orgKey(ref), gitprovider.ErrNotFound)
+	}
+	delete(c.s.orgs, orgKey(ref))
+	prefix := orgKey(ref) + "/"
+	for key := range c.s.orgs {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.s.orgs, key)
+		}
+	}
+	for key := range c.s.repos {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.s.repos, key)
+		}
+	}
+	return nil
+}
+
+func sortedOrgKeys(orgs map[string]*orgRecord) []string {
+	keys := make([]string, 0, len(orgs))
+	for key := range orgs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
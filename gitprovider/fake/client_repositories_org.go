@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// OrgRepositoriesClient implements the gitprovider.OrgRepositoriesClient interface.
+var _ gitprovider.OrgRepositoriesClient = &OrgRepositoriesClient{}
+
+// OrgRepositoriesClient operates on repositories the user has access to.
+type OrgRepositoriesClient struct {
+	*clientContext
+}
+
+// Get returns the repository for the given reference.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *OrgRepositoriesClient) Get(ctx context.Context, ref gitprovider.OrgRepositoryRef) (gitprovider.OrgRepository, error) {
+	// Make sure the OrgRepositoryRef is valid
+	if err := validateOrgRepositoryRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(ref)
+	if err != nil {
+		return nil, err
+	}
+	return newOrgRepository(c.clientContext, copyRepositoryInfo(repo.info), ref), nil
+}
+
+// List all repositories in the given organization.
+//
+// List returns all available repositories, sorted by name.
+func (c *OrgRepositoriesClient) List(ctx context.Context, ref gitprovider.OrganizationRef) ([]gitprovider.OrgRepository, error) {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if _, ok := c.s.orgs[orgKey(ref)]; !ok {
+		return nil, fmt.Errorf("organization %q: %w", orgKey(ref), gitprovider.ErrNotFound)
+	}
+
+	repos := []gitprovider.OrgRepository{}
+	for _, repo := range listRepos(c.s, ref) {
+		repoRef := gitprovider.OrgRepositoryRef{
+			OrganizationRef: ref,
+			RepositoryName:  repo.ref.GetRepository(),
+		}
+		repos = append(repos, newOrgRepository(c.clientContext, copyRepositoryInfo(repo.info), repoRef))
+	}
+	return repos, nil
+}
+
+// Create creates a repository for the given organization, with the data and options
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrgRepositoriesClient) Create(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryCreateOption) (gitprovider.OrgRepository, error) {
+	// Make sure the RepositoryRef is valid
+	if err := validateOrgRepositoryRef(ref, c.domain); err != nil {
+		return nil, err
+	}
+
+	info, err := createRepository(c.clientContext, ref, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newOrgRepository(c.clientContext, info, ref), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, ref, req, opts...)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.OrgRepositoriesClient.ReconcileWithChanges.
+func (c *OrgRepositoriesClient) ReconcileWithChanges(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, []gitprovider.FieldChange, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+
+	actual, err := c.Get(ctx, ref)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
+			return resp, req.Diff(gitprovider.RepositoryInfo{}), err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, nil, err
+	}
+
+	// Run generic reconciliation
+	changes, err := reconcileRepository(ctx, actual, req)
+	return actual, changes, err
+}
+
+// createRepository stores a new repository, and returns its (defaulted) info.
+// If the repository is owned by an organization, the organization must exist.
+func createRepository(c *clientContext, ref gitprovider.RepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryCreateOption) (gitprovider.RepositoryInfo, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return req, err
+	}
+	// Assemble the options struct based on the given options
+	o, err := gitprovider.MakeRepositoryCreateOptions(opts...)
+	if err != nil {
+		return req, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if orgRef, ok := ref.(gitprovider.OrgRepositoryRef); ok {
+		if _, ok := c.s.orgs[orgKey(orgRef.OrganizationRef)]; !ok {
+			return req, fmt.Errorf("organization %q: %w", orgKey(orgRef.OrganizationRef), gitprovider.ErrNotFound)
+		}
+	}
+	if _, ok := c.s.repos[repoKey(ref)]; ok {
+		return req, fmt.Errorf("repository %q: %w", repoKey(ref), gitprovider.ErrAlreadyExists)
+	}
+
+	repo := newRepoRecord(ref, copyRepositoryInfo(req))
+	c.s.repos[repoKey(ref)] = repo
+
+	// Like the real providers, add an initial commit to the default branch if the repository
+	// is to be auto-initialized, or if a license was requested.
+	files := map[string]string{}
+	if o.AutoInit != nil && *o.AutoInit {
+		files["README.md"] = fmt.Sprintf("# %s\n", ref.GetRepository())
+	}
+	if o.LicenseTemplate != nil {
+		files["LICENSE"] = fmt.Sprintf("%s license\n", *o.LicenseTemplate)
+	}
+	if len(files) > 0 {
+		c.s.commit(repo, *req.DefaultBranch, c.login, "Initial commit", nil, files)
+	}
+	return copyRepositoryInfo(repo.info), nil
+}
+
+// listRepos returns the repositories owned by the given identity, sorted by name.
+// The caller must hold the store lock.
+func listRepos(s *store, ref gitprovider.IdentityRef) []*repoRecord {
+	repos := []*repoRecord{}
+	for _, repo := range s.repos {
+		if repo.ref.GetIdentity() == ref.GetIdentity() {
+			repos = append(repos, repo)
+		}
+	}
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].ref.GetRepository() < repos[j].ref.GetRepository()
+	})
+	return repos
+}
+
+func reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) ([]gitprovider.FieldChange, error) {
+	// If the desired matches the actual state, just return the actual state
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return nil, err
+	}
+	// Apply the desired state by running Update
+	return changes, actual.Update(ctx)
+}
+
+func toCreateOpts(opts ...gitprovider.RepositoryReconcileOption) []gitprovider.RepositoryCreateOption {
+	// Convert RepositoryReconcileOption => RepositoryCreateOption
+	createOpts := make([]gitprovider.RepositoryCreateOption, 0, len(opts))
+	for _, opt := range opts {
+		createOpts = append(createOpts, opt)
+	}
+	return createOpts
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// BranchClient implements the gitprovider.BranchClient interface.
+var _ gitprovider.BranchClient = &BranchClient{}
+
+// BranchClient operates on the branches for a specific repository.
+type BranchClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the branch with the given name.
+//
+// ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Get(_ context.Context, branch string) (gitprovider.Branch, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := repo.branches[branch]; !ok {
+		return nil, fmt.Errorf("branch %q: %w", branch, gitprovider.ErrNotFound)
+	}
+	return newBranch(c, branchInfo(repo, branch)), nil
+}
+
+// List lists all branches of the repository, sorted by name.
+func (c *BranchClient) List(_ context.Context) ([]gitprovider.Branch, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(repo.branches))
+	for name := range repo.branches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	branches := make([]gitprovider.Branch, 0, len(names))
+	for _, name := range names {
+		branches = append(branches, newBranch(c, branchInfo(repo, name)))
+	}
+	return branches, nil
+}
+
+// Create creates a branch pointing to the commit sha.
+//
+// ErrNotFound is returned if the commit doesn't exist, and ErrAlreadyExists if the branch does.
+func (c *BranchClient) Create(ctx context.Context, branch, sha string) error {
+	if branch == "" {
+		return fmt.Errorf("branch name must not be empty: %w", gitprovider.ErrInvalidArgument)
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.commits[sha]; !ok {
+		return fmt.Errorf("commit %q: %w", sha, gitprovider.ErrNotFound)
+	}
+	if _, ok := repo.branches[branch]; ok {
+		return fmt.Errorf("branch %q: %w", branch, gitprovider.ErrAlreadyExists)
+	}
+	repo.branches[branch] = sha
+	return nil
+}
+
+// Delete deletes the branch with the given name, along with its protection.
+//
+// ErrDestructiveCallDisallowed is returned unless destructive calls are allowed, ErrNotFound if
+// the branch doesn't exist, and ErrInvalidArgument if it's the default branch.
+func (c *BranchClient) Delete(_ context.Context, branch string) error {
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete branch: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.branches[branch]; !ok {
+		return fmt.Errorf("branch %q: %w", branch, gitprovider.ErrNotFound)
+	}
+	if repo.info.DefaultBranch != nil && *repo.info.DefaultBranch == branch {
+		return fmt.Errorf("cannot delete the default branch %q: %w", branch, gitprovider.ErrInvalidArgument)
+	}
+	delete(repo.branches, branch)
+	delete(repo.branchProtections, branch)
+	return nil
+}
+
+// RenameDefault makes a new branch with the given name, pointing to the head of the current
+// default branch, the default branch of the repository, and retargets the open pull requests into
+// the current default branch to it. Like on providers which can't rename branches, the current
+// default branch is kept.
+//
+// ErrAlreadyExists is returned if the branch already exists.
+func (c *BranchClient) RenameDefault(ctx context.Context, branch string) error {
+	previous, err := c.switchDefault(branch)
+	if err != nil || previous == "" {
+		return err
+	}
+	return gitprovider.RetargetPullRequests(ctx, &PullRequestClient{clientContext: c.clientContext, ref: c.ref}, previous, branch)
+}
+
+// switchDefault makes a new branch with the given name, pointing to the head of the current
+// default branch, the default branch of the repository, and returns the previous default branch.
+func (c *BranchClient) switchDefault(branch string) (string, error) {
+	if branch == "" {
+		return "", fmt.Errorf("branch name must not be empty: %w", gitprovider.ErrInvalidArgument)
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := repo.branches[branch]; ok {
+		return "", fmt.Errorf("branch %q: %w", branch, gitprovider.ErrAlreadyExists)
+	}
+	previous := ""
+	if repo.info.DefaultBranch != nil {
+		previous = *repo.info.DefaultBranch
+		if sha, ok := repo.branches[previous]; ok {
+			repo.branches[branch] = sha
+		}
+	}
+	repo.info.DefaultBranch = gitprovider.StringVar(branch)
+	return previous, nil
+}
+
+// branchInfo returns the information of the given existing branch. The caller must hold the lock.
+func branchInfo(repo *repoRecord, branch string) gitprovider.BranchInfo {
+	_, protected := repo.branchProtections[branch]
+	return gitprovider.BranchInfo{
+		Name:      branch,
+		Sha:       repo.branches[branch],
+		Protected: protected,
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// BranchProtectionClient implements the gitprovider.BranchProtectionClient interface.
+var _ gitprovider.BranchProtectionClient = &BranchProtectionClient{}
+
+// BranchProtectionClient operates on the branch protections of a specific repository.
+type BranchProtectionClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the protection of the given branch.
+//
+// ErrNotFound is returned if the branch is not protected.
+func (c *BranchProtectionClient) Get(ctx context.Context, branch string) (gitprovider.BranchProtection, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	protection, ok := repo.branchProtections[branch]
+	if !ok {
+		return nil, fmt.Errorf("branch protection %q: %w", branch, gitprovider.ErrNotFound)
+	}
+	return newBranchProtection(c, *protection), nil
+}
+
+// List lists all branch protections of the repository, sorted by branch.
+func (c *BranchProtectionClient) List(ctx context.Context) ([]gitprovider.BranchProtection, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	branches := make([]string, 0, len(repo.branchProtections))
+	for branch := range repo.branchProtections {
+		branches = append(branches, branch)
+	}
+	sort.Strings(branches)
+
+	protections := make([]gitprovider.BranchProtection, 0, len(branches))
+	for _, branch := range branches {
+		protections = append(protections, newBranchProtection(c, *repo.branchProtections[branch]))
+	}
+	return protections, nil
+}
+
+// Create protects a branch with the given specifications.
+//
+// ErrNotFound is returned if the branch does not exist.
+// ErrAlreadyExists will be returned if the branch is already protected.
+func (c *BranchProtectionClient) Create(ctx context.Context, req gitprovider.BranchProtectionInfo) (gitprovider.BranchProtection, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if err := c.set("", req); err != nil {
+		return nil, err
+	}
+	return newBranchProtection(c, req), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *BranchProtectionClient) Reconcile(ctx context.Context, req gitprovider.BranchProtectionInfo) (gitprovider.BranchProtection, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the protection of the desired branch
+	actual, err := c.Get(ctx, req.Branch)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// set stores info as a branch protection, replacing the protection of oldBranch if that is non-empty.
+// Like the real providers, only existing branches can be protected.
+// The caller must hold the store lock.
+func (c *BranchProtectionClient) set(oldBranch string, info gitprovider.BranchProtectionInfo) error {
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return err
+	}
+	if oldBranch != "" {
+		if _, ok := repo.branchProtections[oldBranch]; !ok {
+			return fmt.Errorf("branch protection %q: %w", oldBranch, gitprovider.ErrNotFound)
+		}
+	}
+	if _, ok := repo.branches[info.Branch]; !ok {
+		return fmt.Errorf("branch %q: %w", info.Branch, gitprovider.ErrNotFound)
+	}
+	if _, ok := repo.branchProtections[info.Branch]; ok && info.Branch != oldBranch {
+		return fmt.Errorf("branch protection %q: %w", info.Branch, gitprovider.ErrAlreadyExists)
+	}
+	delete(repo.branchProtections, oldBranch)
+	info = copyBranchProtectionInfo(info)
+	repo.branchProtections[info.Branch] = &info
+	return nil
+}
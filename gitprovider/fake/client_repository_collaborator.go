@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CollaboratorClient implements the gitprovider.CollaboratorClient interface.
+var _ gitprovider.CollaboratorClient = &CollaboratorClient{}
+
+// CollaboratorClient operates on the users with direct access to a specific repository.
+type CollaboratorClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get the permission level of the user with the given login.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *CollaboratorClient) Get(ctx context.Context, login string) (gitprovider.Collaborator, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	collaborator, ok := repo.collaborators[login]
+	if !ok {
+		return nil, fmt.Errorf("collaborator %q: %w", login, gitprovider.ErrNotFound)
+	}
+	return newCollaborator(c, *collaborator), nil
+}
+
+// List lists the users with direct access to the repository, sorted by login.
+func (c *CollaboratorClient) List(ctx context.Context) ([]gitprovider.Collaborator, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	logins := make([]string, 0, len(repo.collaborators))
+	for login := range repo.collaborators {
+		logins = append(logins, login)
+	}
+	sort.Strings(logins)
+
+	collaborators := make([]gitprovider.Collaborator, 0, len(logins))
+	for _, login := range logins {
+		collaborators = append(collaborators, newCollaborator(c, *repo.collaborators[login]))
+	}
+	return collaborators, nil
+}
+
+// Create gives the user direct access to the repository.
+// The fake doesn't know about users, hence any login is accepted and never pending.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *CollaboratorClient) Create(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := repo.collaborators[req.Login]; ok {
+		return nil, fmt.Errorf("collaborator %q: %w", req.Login, gitprovider.ErrAlreadyExists)
+	}
+	req.Pending = false
+	info := copyCollaboratorInfo(req)
+	repo.collaborators[req.Login] = &info
+	return newCollaborator(c, req), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *CollaboratorClient) Reconcile(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, req.Login)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CommitClient implements the gitprovider.CommitClient interface.
+var _ gitprovider.CommitClient = &CommitClient{}
+
+// CommitClient operates on the commits for a specific repository.
+type CommitClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// ListPage lists the commits reachable from the head of branch, newest first,
+// following first parents only. page starts at 1, 0 is treated as 1.
+func (c *CommitClient) ListPage(ctx context.Context, branch string, perPage, page int) ([]gitprovider.Commit, error) {
+	if perPage <= 0 {
+		return nil, fmt.Errorf("perPage must be positive: %w", gitprovider.ErrInvalidArgument)
+	}
+	if page < 1 {
+		page = 1
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	sha, ok := repo.branches[branch]
+	if !ok {
+		return nil, fmt.Errorf("branch %q: %w", branch, gitprovider.ErrNotFound)
+	}
+
+	commits := []gitprovider.Commit{}
+	skip := (page - 1) * perPage
+	for cur, ok := repo.commits[sha]; ok && len(commits) < perPage; {
+		if skip > 0 {
+			skip--
+		} else {
+			commits = append(commits, newCommit(c, cur.info))
+		}
+		if len(cur.parents) == 0 {
+			break
+		}
+		cur, ok = repo.commits[cur.parents[0]]
+	}
+	return commits, nil
+}
+
+// Create creates a commit on branch with the given specifications, on top of the branch head.
+// A file with nil Content is deleted. If the repository is empty, the branch is created.
+//
+// ErrNotFound is returned if the branch doesn't exist in a non-empty repository.
+func (c *CommitClient) Create(ctx context.Context, branch string, message string, files []gitprovider.CommitFile) (gitprovider.Commit, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files added: %w", gitprovider.ErrInvalidArgument)
+	}
+	for _, file := range files {
+		if file.Path == nil || strings.Trim(*file.Path, "/") == "" {
+			return nil, fmt.Errorf("file path must be set: %w", gitprovider.ErrInvalidArgument)
+		}
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var parents []string
+	snapshot := map[string]string{}
+	if sha, ok := repo.branches[branch]; ok {
+		parents = []string{sha}
+		snapshot = copyFiles(repo.commits[sha].files)
+	} else if len(repo.branches) > 0 {
+		return nil, fmt.Errorf("branch %q: %w", branch, gitprovider.ErrNotFound)
+	}
+
+	for _, file := range files {
+		path := strings.Trim(*file.Path, "/")
+		if file.Content == nil {
+			delete(snapshot, path)
+			continue
+		}
+		snapshot[path] = *file.Content
+	}
+
+	commit := c.s.commit(repo, branch, c.login, message, parents, snapshot)
+	return newCommit(c, commit.info), nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CommitStatusClient implements the gitprovider.CommitStatusClient interface.
+var _ gitprovider.CommitStatusClient = &CommitStatusClient{}
+
+// CommitStatusClient operates on the commit statuses for a specific repository.
+type CommitStatusClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists all statuses of the commit with the given sha, newest first.
+//
+// ErrNotFound is returned if the commit does not exist.
+func (c *CommitStatusClient) List(ctx context.Context, sha string) ([]gitprovider.CommitStatus, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.getCommitRepo(sha)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]gitprovider.CommitStatus, 0, len(repo.commitStatuses[sha]))
+	for _, info := range repo.commitStatuses[sha] {
+		statuses = append(statuses, newCommitStatus(c, info))
+	}
+	return statuses, nil
+}
+
+// Create creates a status for the commit with the given sha.
+//
+// ErrNotFound is returned if the commit does not exist.
+func (c *CommitStatusClient) Create(ctx context.Context, sha string, req gitprovider.CommitStatusInfo) (gitprovider.CommitStatus, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.getCommitRepo(sha)
+	if err != nil {
+		return nil, err
+	}
+	req.CreatedAt = time.Now().UTC()
+	repo.commitStatuses[sha] = append([]gitprovider.CommitStatusInfo{req}, repo.commitStatuses[sha]...)
+	return newCommitStatus(c, req), nil
+}
+
+// Combined returns the combined status of the commit with the given sha.
+//
+// ErrNotFound is returned if the commit does not exist.
+func (c *CommitStatusClient) Combined(ctx context.Context, sha string) (*gitprovider.CombinedCommitStatusInfo, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.getCommitRepo(sha)
+	if err != nil {
+		return nil, err
+	}
+	combined := gitprovider.CombineCommitStatuses(sha, repo.commitStatuses[sha])
+	return &combined, nil
+}
+
+// getCommitRepo returns the repository record, making sure it contains the commit with the given sha.
+// The caller must hold the store lock.
+func (c *CommitStatusClient) getCommitRepo(sha string) (*repoRecord, error) {
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := repo.commits[sha]; !ok {
+		return nil, fmt.Errorf("commit %q: %w", sha, gitprovider.ErrNotFound)
+	}
+	return repo, nil
+}
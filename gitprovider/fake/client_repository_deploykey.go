@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// DeployKeyClient implements the gitprovider.DeployKeyClient interface.
+var _ gitprovider.DeployKeyClient = &DeployKeyClient{}
+
+// DeployKeyClient operates on the access deploy key list for a specific repository.
+type DeployKeyClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the deploy key with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *DeployKeyClient) Get(ctx context.Context, deployKeyName string) (gitprovider.DeployKey, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := repo.deployKeys[deployKeyName]
+	if !ok {
+		return nil, fmt.Errorf("deploy key %q: %w", deployKeyName, gitprovider.ErrNotFound)
+	}
+	return newDeployKey(c, *key), nil
+}
+
+// List lists all repository deploy keys, sorted by name.
+func (c *DeployKeyClient) List(ctx context.Context) ([]gitprovider.DeployKey, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(repo.deployKeys))
+	for name := range repo.deployKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := make([]gitprovider.DeployKey, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, newDeployKey(c, *repo.deployKeys[name]))
+	}
+	return keys, nil
+}
+
+// Create creates a deploy key with the given specifications.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *DeployKeyClient) Create(ctx context.Context, req gitprovider.DeployKeyInfo) (gitprovider.DeployKey, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if err := c.set("", req); err != nil {
+		return nil, err
+	}
+	return newDeployKey(c, req), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *DeployKeyClient) Reconcile(ctx context.Context, req gitprovider.DeployKeyInfo) (gitprovider.DeployKey, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, req)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.DeployKeyClient.ReconcileWithChanges.
+func (c *DeployKeyClient) ReconcileWithChanges(ctx context.Context, req gitprovider.DeployKeyInfo) (gitprovider.DeployKey, []gitprovider.FieldChange, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+
+	// Get the key with the desired name
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, req.Diff(gitprovider.DeployKeyInfo{}), err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, nil, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return actual, nil, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, nil, err
+	}
+	// Apply the desired state by running Update
+	return actual, changes, actual.Update(ctx)
+}
+
+// ReconcileAll implements gitprovider.DeployKeyClient.ReconcileAll.
+func (c *DeployKeyClient) ReconcileAll(ctx context.Context,
+	req []gitprovider.DeployKeyInfo, opts ...gitprovider.ReconcileAllOption,
+) (*gitprovider.ReconcileReport, error) {
+	return gitprovider.ReconcileAllDeployKeys(ctx, c, c.destructiveActions, req, opts...)
+}
+
+// set stores info as a deploy key, replacing the key called oldName if that is non-empty.
+// The caller must hold the store lock.
+func (c *DeployKeyClient) set(oldName string, info gitprovider.DeployKeyInfo) error {
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return err
+	}
+	if oldName != "" {
+		if _, ok := repo.deployKeys[oldName]; !ok {
+			return fmt.Errorf("deploy key %q: %w", oldName, gitprovider.ErrNotFound)
+		}
+	}
+	if _, ok := repo.deployKeys[info.Name]; ok && info.Name != oldName {
+		return fmt.Errorf("deploy key %q: %w", info.Name, gitprovider.ErrAlreadyExists)
+	}
+	delete(repo.deployKeys, oldName)
+	info = copyDeployKeyInfo(info)
+	repo.deployKeys[info.Name] = &info
+	return nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// DeployTokenClient implements the gitprovider.DeployTokenClient interface.
+var _ gitprovider.DeployTokenClient = &DeployTokenClient{}
+
+// DeployTokenClient operates on the access deploy token list for a specific repository.
+type DeployTokenClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the deploy token with the given name.
+// Like on GitLab, the secret token value is only returned when the token is created.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *DeployTokenClient) Get(ctx context.Context, deployTokenName string) (gitprovider.DeployToken, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	token, ok := repo.deployTokens[deployTokenName]
+	if !ok {
+		return nil, fmt.Errorf("deploy token %q: %w", deployTokenName, gitprovider.ErrNotFound)
+	}
+	return newDeployToken(c, withoutSecret(*token)), nil
+}
+
+// List lists all repository deploy tokens, sorted by name.
+func (c *DeployTokenClient) List(ctx context.Context) ([]gitprovider.DeployToken, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(repo.deployTokens))
+	for name := range repo.deployTokens {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tokens := make([]gitprovider.DeployToken, 0, len(names))
+	for _, name := range names {
+		tokens = append(tokens, newDeployToken(c, withoutSecret(*repo.deployTokens[name])))
+	}
+	return tokens, nil
+}
+
+// Create creates a deploy token with the given specifications.
+// If no Username is given, one is generated. The returned object holds the generated token value.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *DeployTokenClient) Create(ctx context.Context, req gitprovider.DeployTokenInfo) (gitprovider.DeployToken, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	info, err := c.create(req)
+	if err != nil {
+		return nil, err
+	}
+	return newDeployToken(c, info), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// As the token value can't be read back, an existing token is always deleted and recreated (actionTaken == true).
+func (c *DeployTokenClient) Reconcile(ctx context.Context, req gitprovider.DeployTokenInfo) (gitprovider.DeployToken, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, req)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.DeployTokenClient.ReconcileWithChanges.
+func (c *DeployTokenClient) ReconcileWithChanges(ctx context.Context, req gitprovider.DeployTokenInfo) (gitprovider.DeployToken, []gitprovider.FieldChange, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+
+	// Get the token with the desired name
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, req.Diff(gitprovider.DeployTokenInfo{}), err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, nil, err
+	}
+
+	old := actual.Get()
+	if err := actual.Set(req); err != nil {
+		return nil, nil, err
+	}
+	if _, err := actual.Reconcile(ctx); err != nil {
+		return nil, nil, err
+	}
+	return actual, actual.Get().Diff(old), nil
+}
+
+// create stores a new deploy token, generating its username and token value.
+// The caller must hold the store lock.
+func (c *DeployTokenClient) create(info gitprovider.DeployTokenInfo) (gitprovider.DeployTokenInfo, error) {
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return info, err
+	}
+	if _, ok := repo.deployTokens[info.Name]; ok {
+		return info, fmt.Errorf("deploy token %q: %w", info.Name, gitprovider.ErrAlreadyExists)
+	}
+	token := c.s.newSHA(repoKey(c.ref), info.Name)
+	if info.Username == "" {
+		info.Username = fmt.Sprintf("%s+deploy-token-%d", ProviderID, c.s.seq)
+	}
+	info.Token = token
+	repo.deployTokens[info.Name] = &info
+	return info, nil
+}
+
+func withoutSecret(info gitprovider.DeployTokenInfo) gitprovider.DeployTokenInfo {
+	info.Token = ""
+	return info
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// FileClient implements the gitprovider.FileClient interface.
+var _ gitprovider.FileClient = &FileClient{}
+
+// FileClient operates on the files for a specific repository.
+type FileClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the file at path, or the files in the directory at path, on the given branch.
+// Files in sub-directories are only returned if the Recursive option is set.
+//
+// ErrNotFound is returned if the branch doesn't exist, or there are no files at path.
+func (c *FileClient) Get(ctx context.Context, path, branch string, optFns ...gitprovider.FilesGetOption) ([]*gitprovider.CommitFile, error) {
+	fileOpts := gitprovider.FilesGetOptions{}
+	for _, opt := range optFns {
+		opt.ApplyFilesGetOptions(&fileOpts)
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	commit, err := repo.resolve(branch)
+	if err != nil {
+		return nil, err
+	}
+
+	path = strings.Trim(path, "/")
+	if content, ok := commit.files[path]; ok {
+		return []*gitprovider.CommitFile{newCommitFile(path, content)}, nil
+	}
+
+	prefix := ""
+	if path != "" {
+		prefix = path + "/"
+	}
+	files := []*gitprovider.CommitFile{}
+	for _, p := range sortedPaths(commit.files) {
+		rel := strings.TrimPrefix(p, prefix)
+		if !strings.HasPrefix(p, prefix) || (!fileOpts.Recursive && strings.Contains(rel, "/")) {
+			continue
+		}
+		files = append(files, newCommitFile(p, commit.files[p]))
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files found on path %q: %w", path, gitprovider.ErrNotFound)
+	}
+	return files, nil
+}
+
+func newCommitFile(path, content string) *gitprovider.CommitFile {
+	return &gitprovider.CommitFile{
+		Path:    gitprovider.StringVar(path),
+		Content: gitprovider.StringVar(content),
+	}
+}
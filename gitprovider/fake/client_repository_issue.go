@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IssueClient implements the gitprovider.IssueClient interface.
+var _ gitprovider.IssueClient = &IssueClient{}
+
+// IssueClient operates on the issues for a specific repository.
+type IssueClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the issue with the given number.
+//
+// ErrNotFound is returned if the issue doesn't exist.
+func (c *IssueClient) Get(ctx context.Context, number int) (gitprovider.Issue, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	issue, err := c.get(number)
+	if err != nil {
+		return nil, err
+	}
+	return newIssue(c, copyIssueInfo(issue.info)), nil
+}
+
+// List lists the issues in the repository, by default only the open ones.
+func (c *IssueClient) List(ctx context.Context, optFns ...gitprovider.IssueListOption) ([]gitprovider.Issue, error) {
+	opts, err := gitprovider.MakeIssueListOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	issues := []gitprovider.Issue{}
+	for _, issue := range repo.issues {
+		if opts.Matches(issue.info) {
+			issues = append(issues, newIssue(c, copyIssueInfo(issue.info)))
+		}
+	}
+	return issues, nil
+}
+
+// Create opens an issue with the title, description, labels, assignees and milestone of req.
+// The fake has no labels or milestones of its own, hence any name is accepted.
+func (c *IssueClient) Create(ctx context.Context, req gitprovider.IssueInfo) (gitprovider.Issue, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+
+	number := len(repo.issues) + 1
+	now := time.Now().UTC()
+	issue := &issueRecord{
+		info: copyIssueInfo(gitprovider.IssueInfo{
+			Number:      number,
+			Title:       req.Title,
+			Description: req.Description,
+			State:       gitprovider.IssueStateOpen,
+			Labels:      req.Labels,
+			Assignees:   req.Assignees,
+			Milestone:   req.Milestone,
+			Author:      c.login,
+			WebURL:      fmt.Sprintf("%s/issues/%d", c.ref.String(), number),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}),
+	}
+	repo.issues = append(repo.issues, issue)
+	return newIssue(c, copyIssueInfo(issue.info)), nil
+}
+
+// Edit changes the fields of the issue that are set in opts. All options are supported.
+//
+// ErrNotFound is returned if the issue doesn't exist.
+func (c *IssueClient) Edit(ctx context.Context, number int, opts gitprovider.IssueEditOptions) (gitprovider.Issue, error) {
+	if err := opts.ValidateOptions(); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	issue, err := c.get(number)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if opts.Title != nil {
+		issue.info.Title = *opts.Title
+	}
+	if opts.Description != nil {
+		issue.info.Description = *opts.Description
+	}
+	if opts.State != nil && *opts.State != issue.info.State {
+		issue.info.State = *opts.State
+		issue.info.ClosedAt = nil
+		if issue.info.State == gitprovider.IssueStateClosed {
+			issue.info.ClosedAt = &now
+		}
+	}
+	if opts.Assignees != nil {
+		issue.info.Assignees = append([]string{}, opts.Assignees...)
+	}
+	if opts.Labels != nil {
+		issue.info.Labels = append([]string{}, opts.Labels...)
+	}
+	if opts.Milestone != nil {
+		issue.info.Milestone = *opts.Milestone
+	}
+	issue.info.UpdatedAt = now
+	return newIssue(c, copyIssueInfo(issue.info)), nil
+}
+
+// Close closes the issue, which is a no-op if it's closed already.
+//
+// ErrNotFound is returned if the issue doesn't exist.
+func (c *IssueClient) Close(ctx context.Context, number int) (gitprovider.Issue, error) {
+	return c.Edit(ctx, number, gitprovider.IssueEditOptions{
+		State: gitprovider.IssueStateVar(gitprovider.IssueStateClosed),
+	})
+}
+
+// get returns the issue with the given number. The caller must hold the store lock.
+func (c *IssueClient) get(number int) (*issueRecord, error) {
+	return getIssueRecord(c.s, c.ref, number)
+}
+
+// getIssueRecord returns the issue with the given number in the repository ref.
+// The caller must hold the store lock.
+func getIssueRecord(s *store, ref gitprovider.RepositoryRef, number int) (*issueRecord, error) {
+	repo, err := s.getRepo(ref)
+	if err != nil {
+		return nil, err
+	}
+	if number < 1 || number > len(repo.issues) {
+		return nil, fmt.Errorf("issue %d: %w", number, gitprovider.ErrNotFound)
+	}
+	return repo.issues[number-1], nil
+}
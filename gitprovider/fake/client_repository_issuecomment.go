@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// IssueCommentClient implements the gitprovider.IssueCommentClient interface.
+var _ gitprovider.IssueCommentClient = &IssueCommentClient{}
+
+// IssueCommentClient operates on the comments of a specific issue.
+type IssueCommentClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int
+}
+
+// Get returns the comment with the given ID.
+//
+// ErrNotFound is returned if the issue or the comment doesn't exist.
+func (c *IssueCommentClient) Get(ctx context.Context, id int64) (gitprovider.IssueComment, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	_, comment, err := c.get(id)
+	if err != nil {
+		return nil, err
+	}
+	return newIssueComment(c, *comment), nil
+}
+
+// List lists all comments of the issue, oldest first.
+//
+// ErrNotFound is returned if the issue doesn't exist.
+func (c *IssueCommentClient) List(ctx context.Context) ([]gitprovider.IssueComment, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	issue, err := c.getIssue()
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]gitprovider.IssueComment, 0, len(issue.comments))
+	for _, comment := range issue.comments {
+		comments = append(comments, newIssueComment(c, *comment))
+	}
+	return comments, nil
+}
+
+// Create adds a comment with the given body to the issue, written by the authenticated user.
+//
+// ErrNotFound is returned if the issue doesn't exist.
+func (c *IssueCommentClient) Create(ctx context.Context, req gitprovider.IssueCommentInfo) (gitprovider.IssueComment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	issue, err := c.getIssue()
+	if err != nil {
+		return nil, err
+	}
+	c.s.seq++
+	now := time.Now().UTC()
+	comment := &gitprovider.IssueCommentInfo{
+		ID:        int64(c.s.seq),
+		Body:      req.Body,
+		Author:    c.login,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	issue.comments = append(issue.comments, comment)
+	return newIssueComment(c, *comment), nil
+}
+
+// set applies the body of info to the stored comment with the same ID, and returns the result.
+// The caller must hold the store lock.
+func (c *IssueCommentClient) set(info gitprovider.IssueCommentInfo) (gitprovider.IssueCommentInfo, error) {
+	_, comment, err := c.get(info.ID)
+	if err != nil {
+		return gitprovider.IssueCommentInfo{}, err
+	}
+	comment.Body = info.Body
+	comment.UpdatedAt = time.Now().UTC()
+	return *comment, nil
+}
+
+// delete removes the comment with the given ID. The caller must hold the store lock.
+func (c *IssueCommentClient) delete(id int64) error {
+	issue, comment, err := c.get(id)
+	if err != nil {
+		return err
+	}
+	comments := make([]*gitprovider.IssueCommentInfo, 0, len(issue.comments)-1)
+	for _, other := range issue.comments {
+		if other != comment {
+			comments = append(comments, other)
+		}
+	}
+	issue.comments = comments
+	return nil
+}
+
+// get returns the issue and its comment with the given ID. The caller must hold the store lock.
+func (c *IssueCommentClient) get(id int64) (*issueRecord, *gitprovider.IssueCommentInfo, error) {
+	issue, err := c.getIssue()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, comment := range issue.comments {
+		if comment.ID == id {
+			return issue, comment, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("comment %d on issue %d: %w", id, c.number, gitprovider.ErrNotFound)
+}
+
+// getIssue returns the issue the comments belong to. The caller must hold the store lock.
+func (c *IssueCommentClient) getIssue() (*issueRecord, error) {
+	return getIssueRecord(c.s, c.ref, c.number)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// LabelClient implements the gitprovider.LabelClient interface.
+var _ gitprovider.LabelClient = &LabelClient{}
+
+// LabelClient operates on the labels of a specific repository.
+type LabelClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the label with the given name.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *LabelClient) Get(ctx context.Context, name string) (gitprovider.Label, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	l, ok := repo.labels[name]
+	if !ok {
+		return nil, fmt.Errorf("label %q: %w", name, gitprovider.ErrNotFound)
+	}
+	return newLabel(c, *l), nil
+}
+
+// List lists all repository labels, sorted by name.
+func (c *LabelClient) List(ctx context.Context) ([]gitprovider.Label, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(repo.labels))
+	for name := range repo.labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	labels := make([]gitprovider.Label, 0, len(names))
+	for _, name := range names {
+		labels = append(labels, newLabel(c, *repo.labels[name]))
+	}
+	return labels, nil
+}
+
+// Create creates a label with the given specifications.
+//
+// ErrAlreadyExists will be returned if a label with the same name already exists.
+func (c *LabelClient) Create(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if err := c.set("", req); err != nil {
+		return nil, err
+	}
+	return newLabel(c, req), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *LabelClient) Reconcile(ctx context.Context, req gitprovider.LabelInfo) (gitprovider.Label, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the label with the desired name
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// ReconcileAll implements gitprovider.LabelClient.ReconcileAll.
+func (c *LabelClient) ReconcileAll(ctx context.Context, req []gitprovider.LabelInfo, opts ...gitprovider.ReconcileAllOption) (*gitprovider.ReconcileReport, error) {
+	return gitprovider.ReconcileAllLabels(ctx, c, c.destructiveActions, req, opts...)
+}
+
+// set stores info as a label, replacing the label named oldName if that is non-empty.
+// The caller must hold the store lock.
+func (c *LabelClient) set(oldName string, info gitprovider.LabelInfo) error {
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.labels[oldName]; oldName != "" && !ok {
+		return fmt.Errorf("label %q: %w", oldName, gitprovider.ErrNotFound)
+	}
+	if _, ok := repo.labels[info.Name]; ok && info.Name != oldName {
+		return fmt.Errorf("label %q: %w", info.Name, gitprovider.ErrAlreadyExists)
+	}
+	delete(repo.labels, oldName)
+	repo.labels[info.Name] = &info
+	return nil
+}
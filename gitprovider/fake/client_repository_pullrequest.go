@@ -0,0 +1,381 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestClient implements the gitprovider.PullRequestClient interface.
+var _ gitprovider.PullRequestClient = &PullRequestClient{}
+
+// PullRequestClient operates on the pull requests for a specific repository.
+type PullRequestClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists the pull requests in the repository, by default only the open ones.
+func (c *PullRequestClient) List(ctx context.Context, optFns ...gitprovider.PullRequestListOption) ([]gitprovider.PullRequest, error) {
+	opts, err := gitprovider.MakePullRequestListOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	prs := []gitprovider.PullRequest{}
+	for _, pr := range repo.pullRequests {
+		if info := pr.get(repo); opts.Matches(info) {
+			prs = append(prs, newPullRequest(c, info))
+		}
+	}
+	return prs, nil
+}
+
+// Create creates a pull request from branch into baseBranch.
+//
+// ErrNotFound is returned if either branch doesn't exist, and ErrAlreadyExists
+// if there's already an open pull request between the two branches.
+func (c *PullRequestClient) Create(ctx context.Context, title, branch, baseBranch, description string) (gitprovider.PullRequest, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range []string{branch, baseBranch} {
+		if _, ok := repo.branches[b]; !ok {
+			return nil, fmt.Errorf("branch %q: %w", b, gitprovider.ErrNotFound)
+		}
+	}
+	for _, pr := range repo.pullRequests {
+		if pr.info.State == gitprovider.PullRequestStateOpen && pr.info.SourceBranch == branch && pr.info.TargetBranch == baseBranch {
+			return nil, fmt.Errorf("pull request from %q to %q: %w", branch, baseBranch, gitprovider.ErrAlreadyExists)
+		}
+	}
+
+	number := len(repo.pullRequests) + 1
+	now := time.Now().UTC()
+	pr := &pullRequestRecord{
+		info: gitprovider.PullRequestInfo{
+			Title:        title,
+			Description:  description,
+			Number:       number,
+			WebURL:       fmt.Sprintf("%s/pull/%d", c.ref.String(), number),
+			SourceBranch: branch,
+			TargetBranch: baseBranch,
+			State:        gitprovider.PullRequestStateOpen,
+			Author:       c.login,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		},
+	}
+	repo.pullRequests = append(repo.pullRequests, pr)
+	return newPullRequest(c, pr.get(repo)), nil
+}
+
+// Edit changes the fields of the pull request that are set in opts. All options are supported.
+//
+// ErrNotFound is returned if the pull request or the target branch doesn't exist, and
+// ErrInvalidArgument if the state of a merged pull request is changed.
+func (c *PullRequestClient) Edit(ctx context.Context, number int, opts gitprovider.EditOptions) (gitprovider.PullRequest, error) {
+	if err := opts.ValidateOptions(); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	pr, err := c.get(number)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	if opts.TargetBranch != nil {
+		if _, ok := repo.branches[*opts.TargetBranch]; !ok {
+			return nil, fmt.Errorf("branch %q: %w", *opts.TargetBranch, gitprovider.ErrNotFound)
+		}
+	}
+	if opts.State != nil && pr.info.State == gitprovider.PullRequestStateMerged {
+		return nil, fmt.Errorf("pull request %d is already merged: %w", number, gitprovider.ErrInvalidArgument)
+	}
+
+	if opts.Title != nil {
+		pr.info.Title = *opts.Title
+	}
+	if opts.Description != nil {
+		pr.info.Description = *opts.Description
+	}
+	if opts.TargetBranch != nil {
+		pr.info.TargetBranch = *opts.TargetBranch
+	}
+	if opts.State != nil {
+		// Keep the heads of the branches when closing, as with merging
+		info := pr.get(repo)
+		pr.info.HeadSha, pr.info.BaseSha = info.HeadSha, info.BaseSha
+		pr.info.State = *opts.State
+		if pr.info.State != gitprovider.PullRequestStateOpen {
+			pr.info.AutoMerge = false
+		}
+	}
+	if opts.Draft != nil {
+		pr.info.Draft = *opts.Draft
+	}
+	if opts.Assignees != nil {
+		pr.info.Assignees = append([]string{}, opts.Assignees...)
+	}
+	for _, login := range opts.Reviewers {
+		if !containsString(pr.info.Reviewers, login) {
+			pr.info.Reviewers = append(pr.info.Reviewers, login)
+		}
+	}
+	if opts.Labels != nil {
+		pr.info.Labels = append([]string{}, opts.Labels...)
+	}
+	pr.info.UpdatedAt = time.Now().UTC()
+	return newPullRequest(c, pr.get(repo)), nil
+}
+
+// Get retrieves an existing pull request by number.
+//
+// ErrNotFound is returned if the pull request doesn't exist.
+func (c *PullRequestClient) Get(ctx context.Context, number int) (gitprovider.PullRequest, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	pr, err := c.get(number)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	return newPullRequest(c, pr.get(repo)), nil
+}
+
+// Merge merges the pull request into its base branch, using any of the merge methods. Changes made
+// on the source branch win over the ones on the base branch, there are no conflicts. The source
+// branch is kept unless DeleteSourceBranch is set, which is allowed even if destructive calls aren't.
+//
+// ErrNotFound is returned if the pull request or one of its branches doesn't exist, and a
+// *ConflictError if its head isn't the expected commit.
+func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod, message string, optFns ...gitprovider.PullRequestMergeOption) (*gitprovider.PullRequestMergeResult, error) {
+	opts, err := gitprovider.MakePullRequestMergeOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	pr, err := c.get(number)
+	if err != nil {
+		return nil, err
+	}
+	if pr.info.Merged {
+		return nil, fmt.Errorf("pull request %d is already merged: %w", number, gitprovider.ErrInvalidArgument)
+	}
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	base, ok := repo.branches[pr.info.TargetBranch]
+	if !ok {
+		return nil, fmt.Errorf("branch %q: %w", pr.info.TargetBranch, gitprovider.ErrNotFound)
+	}
+	head, ok := repo.branches[pr.info.SourceBranch]
+	if !ok {
+		return nil, fmt.Errorf("branch %q: %w", pr.info.SourceBranch, gitprovider.ErrNotFound)
+	}
+	if opts.ExpectedHeadSha != nil && *opts.ExpectedHeadSha != head {
+		return nil, gitprovider.NewHeadChangedError(number, *opts.ExpectedHeadSha, head)
+	}
+
+	switch mergeMethod {
+	case gitprovider.MergeMethodMerge:
+		if message == "" {
+			message = fmt.Sprintf("Merge pull request #%d from %s", number, pr.info.SourceBranch)
+		}
+		c.s.commit(repo, pr.info.TargetBranch, c.login, message, []string{base, head}, mergeFiles(repo, base, head))
+	case gitprovider.MergeMethodSquash:
+		if message == "" {
+			message = fmt.Sprintf("%s (#%d)", pr.info.Title, number)
+		}
+		c.s.commit(repo, pr.info.TargetBranch, c.login, message, []string{base}, mergeFiles(repo, base, head))
+	case gitprovider.MergeMethodRebase:
+		// Replay the commits of the source branch onto the base branch, keeping their messages
+		onto := base
+		for _, commit := range repo.unmergedCommits(base, head) {
+			files := applyChanges(repo.commits[onto].files, repo.commits[commit.parents[0]].files, commit.files)
+			onto = c.s.commit(repo, pr.info.TargetBranch, commit.info.Author, commit.info.Message, []string{onto}, files).info.Sha
+		}
+	default:
+		return nil, fmt.Errorf("unsupported merge method %q: %w", mergeMethod, gitprovider.ErrInvalidArgument)
+	}
+
+	if opts.DeleteSourceBranch != nil && *opts.DeleteSourceBranch {
+		delete(repo.branches, pr.info.SourceBranch)
+		delete(repo.branchProtections, pr.info.SourceBranch)
+	}
+	now := time.Now().UTC()
+	pr.info.HeadSha = head
+	pr.info.BaseSha = base
+	pr.info.State = gitprovider.PullRequestStateMerged
+	pr.info.Merged = true
+	pr.info.AutoMerge = false
+	pr.info.MergedAt = &now
+	pr.info.UpdatedAt = now
+	return &gitprovider.PullRequestMergeResult{Sha: repo.branches[pr.info.TargetBranch]}, nil
+}
+
+// EnableAutoMerge marks the pull request to be merged automatically, all merge methods are supported.
+// The fake has no status checks that could succeed, hence it never merges the pull request itself,
+// use Merge for that.
+//
+// ErrNotFound is returned if the pull request doesn't exist, and ErrInvalidArgument if it isn't open.
+func (c *PullRequestClient) EnableAutoMerge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod) error {
+	if err := gitprovider.ValidateMergeMethod(mergeMethod); err != nil {
+		return fmt.Errorf("unsupported merge method %q: %w", mergeMethod, gitprovider.ErrInvalidArgument)
+	}
+	return c.setAutoMerge(number, true)
+}
+
+// DisableAutoMerge cancels merging the pull request automatically.
+//
+// ErrNotFound is returned if the pull request doesn't exist, and ErrInvalidArgument if it isn't open.
+func (c *PullRequestClient) DisableAutoMerge(ctx context.Context, number int) error {
+	return c.setAutoMerge(number, false)
+}
+
+func (c *PullRequestClient) setAutoMerge(number int, autoMerge bool) error {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	pr, err := c.get(number)
+	if err != nil {
+		return err
+	}
+	if pr.info.State != gitprovider.PullRequestStateOpen {
+		return fmt.Errorf("pull request %d isn't open: %w", number, gitprovider.ErrInvalidArgument)
+	}
+	pr.info.AutoMerge = autoMerge
+	pr.info.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// changedFiles returns the files changed on the source branch of the pull request since its
+// merge base with the target branch.
+func (c *PullRequestClient) changedFiles(number int) ([]gitprovider.PullRequestFile, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	pr, err := c.get(number)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	info := pr.get(repo)
+	head, ok := repo.commits[info.HeadSha]
+	if !ok {
+		return nil, fmt.Errorf("branch %q: %w", info.SourceBranch, gitprovider.ErrNotFound)
+	}
+	mergeBaseFiles := map[string]string{}
+	if mb := repo.mergeBase(info.BaseSha, info.HeadSha); mb != nil {
+		mergeBaseFiles = mb.files
+	}
+	return diffFiles(mergeBaseFiles, head.files), nil
+}
+
+// get returns the pull request with the given number. The caller must hold the store lock.
+func (c *PullRequestClient) get(number int) (*pullRequestRecord, error) {
+	return getPullRequestRecord(c.s, c.ref, number)
+}
+
+// getPullRequestRecord returns the pull request with the given number in the repository ref.
+// The caller must hold the store lock.
+func getPullRequestRecord(s *store, ref gitprovider.RepositoryRef, number int) (*pullRequestRecord, error) {
+	repo, err := s.getRepo(ref)
+	if err != nil {
+		return nil, err
+	}
+	if number < 1 || number > len(repo.pullRequests) {
+		return nil, fmt.Errorf("pull request %d: %w", number, gitprovider.ErrNotFound)
+	}
+	return repo.pullRequests[number-1], nil
+}
+
+// get returns the information of the pull request, with the heads of its branches filled in
+// while it's open. The caller must hold the store lock.
+func (pr *pullRequestRecord) get(repo *repoRecord) gitprovider.PullRequestInfo {
+	info := copyPullRequestInfo(pr.info)
+	if info.State == gitprovider.PullRequestStateOpen {
+		info.HeadSha = repo.branches[info.SourceBranch]
+		info.BaseSha = repo.branches[info.TargetBranch]
+		// There are no conflicts in the fake, see Merge
+		info.Mergeable = gitprovider.BoolVar(true)
+	}
+	return info
+}
+
+// mergeFiles applies the changes made on head since its merge base with base, to the files of base.
+func mergeFiles(repo *repoRecord, base, head string) map[string]string {
+	mergeBaseFiles := map[string]string{}
+	if mb := repo.mergeBase(base, head); mb != nil {
+		mergeBaseFiles = mb.files
+	}
+	return applyChanges(repo.commits[base].files, mergeBaseFiles, repo.commits[head].files)
+}
+
+// applyChanges applies the changes made between the file snapshots from and to, to a copy of files.
+func applyChanges(files, from, to map[string]string) map[string]string {
+	out := copyFiles(files)
+	changed := copyFiles(to)
+	for p := range from {
+		changed[p] = ""
+	}
+	for p := range changed {
+		content, inTo := to[p]
+		old, inFrom := from[p]
+		if inTo == inFrom && content == old {
+			continue
+		}
+		if inTo {
+			out[p] = content
+		} else {
+			delete(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestCommentClient implements the gitprovider.PullRequestCommentClient interface.
+var _ gitprovider.PullRequestCommentClient = &PullRequestCommentClient{}
+
+// PullRequestCommentClient operates on the comments in the conversation of a specific pull request.
+type PullRequestCommentClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int
+}
+
+// Get returns the comment with the given ID.
+//
+// ErrNotFound is returned if the pull request or the comment doesn't exist.
+func (c *PullRequestCommentClient) Get(ctx context.Context, id int64) (gitprovider.PullRequestComment, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	_, comment, err := c.get(id)
+	if err != nil {
+		return nil, err
+	}
+	return newPullRequestComment(c, *comment), nil
+}
+
+// List lists all comments of the pull request, oldest first.
+//
+// ErrNotFound is returned if the pull request doesn't exist.
+func (c *PullRequestCommentClient) List(ctx context.Context) ([]gitprovider.PullRequestComment, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	pr, err := c.getPullRequest()
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]gitprovider.PullRequestComment, 0, len(pr.comments))
+	for _, comment := range pr.comments {
+		comments = append(comments, newPullRequestComment(c, *comment))
+	}
+	return comments, nil
+}
+
+// Create adds a comment with the given body to the pull request, written by the authenticated user.
+//
+// ErrNotFound is returned if the pull request doesn't exist.
+func (c *PullRequestCommentClient) Create(ctx context.Context, req gitprovider.PullRequestCommentInfo) (gitprovider.PullRequestComment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	pr, err := c.getPullRequest()
+	if err != nil {
+		return nil, err
+	}
+	c.s.seq++
+	now := time.Now().UTC()
+	comment := &gitprovider.PullRequestCommentInfo{
+		ID:        int64(c.s.seq),
+		Body:      req.Body,
+		Author:    c.login,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	pr.comments = append(pr.comments, comment)
+	return newPullRequestComment(c, *comment), nil
+}
+
+// set applies the body of info to the stored comment with the same ID, and returns the result.
+// The caller must hold the store lock.
+func (c *PullRequestCommentClient) set(info gitprovider.PullRequestCommentInfo) (gitprovider.PullRequestCommentInfo, error) {
+	_, comment, err := c.get(info.ID)
+	if err != nil {
+		return gitprovider.PullRequestCommentInfo{}, err
+	}
+	comment.Body = info.Body
+	comment.UpdatedAt = time.Now().UTC()
+	return *comment, nil
+}
+
+// delete removes the comment with the given ID. The caller must hold the store lock.
+func (c *PullRequestCommentClient) delete(id int64) error {
+	pr, comment, err := c.get(id)
+	if err != nil {
+		return err
+	}
+	comments := make([]*gitprovider.PullRequestCommentInfo, 0, len(pr.comments)-1)
+	for _, other := range pr.comments {
+		if other != comment {
+			comments = append(comments, other)
+		}
+	}
+	pr.comments = comments
+	return nil
+}
+
+// get returns the pull request and its comment with the given ID. The caller must hold the store lock.
+func (c *PullRequestCommentClient) get(id int64) (*pullRequestRecord, *gitprovider.PullRequestCommentInfo, error) {
+	pr, err := c.getPullRequest()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, comment := range pr.comments {
+		if comment.ID == id {
+			return pr, comment, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("comment %d on pull request %d: %w", id, c.number, gitprovider.ErrNotFound)
+}
+
+// getPullRequest returns the pull request the comments belong to. The caller must hold the store lock.
+func (c *PullRequestCommentClient) getPullRequest() (*pullRequestRecord, error) {
+	return getPullRequestRecord(c.s, c.ref, c.number)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestReviewClient implements the gitprovider.PullRequestReviewClient interface.
+var _ gitprovider.PullRequestReviewClient = &PullRequestReviewClient{}
+
+// PullRequestReviewClient operates on the reviews of a specific pull request.
+type PullRequestReviewClient struct {
+	*clientContext
+	ref    gitprovider.RepositoryRef
+	number int
+}
+
+// List lists all reviews of the pull request, oldest first.
+//
+// ErrNotFound is returned if the pull request doesn't exist.
+func (c *PullRequestReviewClient) List(ctx context.Context) ([]gitprovider.PullRequestReview, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	pr, err := getPullRequestRecord(c.s, c.ref, c.number)
+	if err != nil {
+		return nil, err
+	}
+	reviews := make([]gitprovider.PullRequestReview, 0, len(pr.reviews))
+	for _, info := range pr.reviews {
+		reviews = append(reviews, newPullRequestReview(info))
+	}
+	return reviews, nil
+}
+
+// Create submits a review as the authenticated user, of the current head of the pull request.
+// All review states except dismissed are supported.
+//
+// ErrNotFound is returned if the pull request doesn't exist.
+func (c *PullRequestReviewClient) Create(ctx context.Context, req gitprovider.PullRequestReviewInfo) (gitprovider.PullRequestReview, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := getPullRequestRecord(c.s, c.ref, c.number)
+	if err != nil {
+		return nil, err
+	}
+	c.s.seq++
+	now := time.Now().UTC()
+	info := gitprovider.PullRequestReviewInfo{
+		ID:          int64(c.s.seq),
+		State:       req.State,
+		Body:        req.Body,
+		Author:      c.login,
+		CommitSha:   pr.get(repo).HeadSha,
+		SubmittedAt: &now,
+	}
+	pr.reviews = append(pr.reviews, info)
+	return newPullRequestReview(info), nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// ReleaseClient implements the gitprovider.ReleaseClient interface.
+var _ gitprovider.ReleaseClient = &ReleaseClient{}
+
+// ReleaseClient operates on the releases of a specific repository.
+type ReleaseClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the release made from the given tag.
+//
+// ErrNotFound is returned if the release does not exist.
+func (c *ReleaseClient) Get(_ context.Context, tagName string) (gitprovider.Release, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	release, ok := repo.releases[tagName]
+	if !ok {
+		return nil, fmt.Errorf("release %q: %w", tagName, gitprovider.ErrNotFound)
+	}
+	return newRelease(c, *release), nil
+}
+
+// List lists all releases of the repository, sorted by tag name.
+func (c *ReleaseClient) List(_ context.Context) ([]gitprovider.Release, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(repo.releases))
+	for name := range repo.releases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	releases := make([]gitprovider.Release, 0, len(names))
+	for _, name := range names {
+		releases = append(releases, newRelease(c, *repo.releases[name]))
+	}
+	return releases, nil
+}
+
+// Create creates a release with the given specifications. If the tag doesn't exist, a
+// lightweight tag is created from req.Target, or the default branch if unset.
+//
+// ErrAlreadyExists will be returned if a release for the tag already exists, and ErrNotFound
+// if the tag needs to be created but the target doesn't exist.
+func (c *ReleaseClient) Create(_ context.Context, req gitprovider.ReleaseInfo) (gitprovider.Release, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := repo.releases[req.TagName]; ok {
+		return nil, fmt.Errorf("release %q: %w", req.TagName, gitprovider.ErrAlreadyExists)
+	}
+	if _, ok := repo.tags[req.TagName]; !ok {
+		target := ""
+		if repo.info.DefaultBranch != nil {
+			target = *repo.info.DefaultBranch
+		}
+		if req.Target != nil {
+			target = *req.Target
+		}
+		commit, err := repo.resolve(target)
+		if err != nil {
+			return nil, err
+		}
+		if err := createTag(repo, gitprovider.TagInfo{Name: req.TagName, Sha: commit.info.Sha}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Like the real providers, the target isn't stored
+	info := copyReleaseInfo(req)
+	info.Target = nil
+	info.Assets = nil
+	repo.releases[req.TagName] = &info
+	return newRelease(c, info), nil
+}
+
+// set replaces the stored release with the given one, keeping its assets. The caller must hold the lock.
+func (c *ReleaseClient) set(info gitprovider.ReleaseInfo) (gitprovider.ReleaseInfo, error) {
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return info, err
+	}
+	actual, ok := repo.releases[info.TagName]
+	if !ok {
+		return info, fmt.Errorf("release %q: %w", info.TagName, gitprovider.ErrNotFound)
+	}
+	info = copyReleaseInfo(info)
+	info.Target = nil
+	info.Assets = actual.Assets
+	repo.releases[info.TagName] = &info
+	return copyReleaseInfo(info), nil
+}
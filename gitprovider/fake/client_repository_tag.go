@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TagClient implements the gitprovider.TagClient interface.
+var _ gitprovider.TagClient = &TagClient{}
+
+// TagClient operates on the tags of a specific repository.
+type TagClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the tag with the given name.
+//
+// ErrNotFound is returned if the tag does not exist.
+func (c *TagClient) Get(_ context.Context, name string) (gitprovider.Tag, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	tag, ok := repo.tags[name]
+	if !ok {
+		return nil, fmt.Errorf("tag %q: %w", name, gitprovider.ErrNotFound)
+	}
+	return newTag(c, copyTagInfo(*tag)), nil
+}
+
+// List lists all tags of the repository, sorted by name.
+func (c *TagClient) List(_ context.Context) ([]gitprovider.Tag, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(repo.tags))
+	for name := range repo.tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tags := make([]gitprovider.Tag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, newTag(c, copyTagInfo(*repo.tags[name])))
+	}
+	return tags, nil
+}
+
+// Create creates a tag pointing to the commit req.Sha.
+//
+// ErrNotFound is returned if the commit doesn't exist, and ErrAlreadyExists if the tag does.
+func (c *TagClient) Create(_ context.Context, req gitprovider.TagInfo) (gitprovider.Tag, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	if err := createTag(repo, req); err != nil {
+		return nil, err
+	}
+	return newTag(c, copyTagInfo(req)), nil
+}
+
+// Delete deletes the tag with the given name irreversibly. Releases made from the tag are kept.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn\'t created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the tag does not exist.
+func (c *TagClient) Delete(_ context.Context, name string) error {
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete tag: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.tags[name]; !ok {
+		return fmt.Errorf("tag %q: %w", name, gitprovider.ErrNotFound)
+	}
+	delete(repo.tags, name)
+	return nil
+}
+
+// createTag stores the given tag. The caller must hold the lock.
+func createTag(repo *repoRecord, req gitprovider.TagInfo) error {
+	if _, ok := repo.commits[req.Sha]; !ok {
+		return fmt.Errorf("commit %q: %w", req.Sha, gitprovider.ErrNotFound)
+	}
+	if _, ok := repo.tags[req.Name]; ok {
+		return fmt.Errorf("tag %q: %w", req.Name, gitprovider.ErrAlreadyExists)
+	}
+	info := copyTagInfo(req)
+	repo.tags[req.Name] = &info
+	return nil
+}
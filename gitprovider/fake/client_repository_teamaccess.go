@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TeamAccessClient implements the gitprovider.TeamAccessClient interface.
+var _ gitprovider.TeamAccessClient = &TeamAccessClient{}
+
+// TeamAccessClient operates on the teams list for a specific repository.
+type TeamAccessClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get a team's permission level of this given repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *TeamAccessClient) Get(ctx context.Context, name string) (gitprovider.TeamAccess, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	ta, ok := repo.teamAccess[name]
+	if !ok {
+		return nil, fmt.Errorf("team access %q: %w", name, gitprovider.ErrNotFound)
+	}
+	return newTeamAccess(c, *ta), nil
+}
+
+// List lists the team access control list for this repository, sorted by team name.
+func (c *TeamAccessClient) List(ctx context.Context) ([]gitprovider.TeamAccess, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(repo.teamAccess))
+	for name := range repo.teamAccess {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	teamAccess := make([]gitprovider.TeamAccess, 0, len(names))
+	for _, name := range names {
+		teamAccess = append(teamAccess, newTeamAccess(c, *repo.teamAccess[name]))
+	}
+	return teamAccess, nil
+}
+
+// Create adds a given team to the repo's team access control list.
+// The team must exist in the organization owning the repository.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamAccessClient) Create(ctx context.Context, req gitprovider.TeamAccessInfo) (gitprovider.TeamAccess, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := repo.teamAccess[req.Name]; ok {
+		return nil, fmt.Errorf("team access %q: %w", req.Name, gitprovider.ErrAlreadyExists)
+	}
+	if err := c.set(req); err != nil {
+		return nil, err
+	}
+	return newTeamAccess(c, req), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamAccessClient) Reconcile(ctx context.Context, req gitprovider.TeamAccessInfo) (gitprovider.TeamAccess, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, req)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.TeamAccessClient.ReconcileWithChanges.
+func (c *TeamAccessClient) ReconcileWithChanges(ctx context.Context, req gitprovider.TeamAccessInfo) (gitprovider.TeamAccess, []gitprovider.FieldChange, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, nil, err
+	}
+
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, req.Diff(gitprovider.TeamAccessInfo{}), err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, nil, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return actual, nil, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, nil, err
+	}
+	// Apply the desired state by running Update
+	return actual, changes, actual.Update(ctx)
+}
+
+// ReconcileAll implements gitprovider.TeamAccessClient.ReconcileAll.
+func (c *TeamAccessClient) ReconcileAll(ctx context.Context,
+	req []gitprovider.TeamAccessInfo, opts ...gitprovider.ReconcileAllOption,
+) (*gitprovider.ReconcileReport, error) {
+	return gitprovider.ReconcileAllTeamAccess(ctx, c, c.destructiveActions, req, opts...)
+}
+
+// set grants the team access to the repository, after making sure the team exists.
+// The caller must hold the store lock.
+func (c *TeamAccessClient) set(info gitprovider.TeamAccessInfo) error {
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return err
+	}
+	orgRef, ok := c.ref.(gitprovider.OrgRepositoryRef)
+	if !ok {
+		return fmt.Errorf("team access requires an organization repository: %w", gitprovider.ErrInvalidArgument)
+	}
+	org, ok := c.s.orgs[orgKey(orgRef.OrganizationRef)]
+	if !ok {
+		return fmt.Errorf("organization %q: %w", orgKey(orgRef.OrganizationRef), gitprovider.ErrNotFound)
+	}
+	if _, ok := org.teams[info.Name]; !ok {
+		return fmt.Errorf("team %q: %w", info.Name, gitprovider.ErrNotFound)
+	}
+	info = copyTeamAccessInfo(info)
+	repo.teamAccess[info.Name] = &info
+	return nil
+}
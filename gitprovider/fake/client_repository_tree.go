@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TreeClient implements the gitprovider.TreeClient interface.
+var _ gitprovider.TreeClient = &TreeClient{}
+
+// TreeClient operates on the trees in a specific repository.
+type TreeClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the tree for the given tree SHA, commit SHA or branch name.
+// If recursive is false, only the top-level entries of the tree are returned.
+//
+// ErrNotFound is returned if sha can't be resolved to a tree.
+func (c *TreeClient) Get(ctx context.Context, sha string, recursive bool) (*gitprovider.TreeInfo, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	treeID, files, err := repo.resolveTree(sha)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*gitprovider.TreeEntry{}
+	for dir, subFiles := range subTrees(files) {
+		if recursive || !strings.Contains(dir, "/") {
+			entries = append(entries, &gitprovider.TreeEntry{
+				Path: dir,
+				Mode: treeMode,
+				Type: "tree",
+				SHA:  treeSHA(subFiles),
+			})
+		}
+	}
+	for p, content := range files {
+		if recursive || !strings.Contains(p, "/") {
+			entries = append(entries, &gitprovider.TreeEntry{
+				Path: p,
+				Mode: blobMode,
+				Type: "blob",
+				Size: len(content),
+				SHA:  blobSHA(content),
+			})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+
+	return &gitprovider.TreeInfo{
+		SHA:  treeID,
+		Tree: entries,
+	}, nil
+}
+
+// List returns the files (blobs) in the given tree, whose path starts with path.
+func (c *TreeClient) List(ctx context.Context, sha string, path string, recursive bool) ([]*gitprovider.TreeEntry, error) {
+	treeInfo, err := c.Get(ctx, sha, recursive)
+	if err != nil {
+		return nil, err
+	}
+	treeEntries := make([]*gitprovider.TreeEntry, 0)
+	for _, treeEntry := range treeInfo.Tree {
+		if treeEntry.Type == "blob" && strings.HasPrefix(treeEntry.Path, path) {
+			treeEntries = append(treeEntries, treeEntry)
+		}
+	}
+	return treeEntries, nil
+}
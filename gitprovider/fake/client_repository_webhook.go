@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// WebhookClient implements the gitprovider.WebhookClient interface.
+var _ gitprovider.WebhookClient = &WebhookClient{}
+
+// WebhookClient operates on the webhooks of a specific repository.
+type WebhookClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the webhook delivering events to the given URL.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *WebhookClient) Get(ctx context.Context, url string) (gitprovider.Webhook, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	hook, ok := repo.webhooks[url]
+	if !ok {
+		return nil, fmt.Errorf("webhook %q: %w", url, gitprovider.ErrNotFound)
+	}
+	return newWebhook(c, *hook), nil
+}
+
+// List lists all repository webhooks, sorted by URL.
+func (c *WebhookClient) List(ctx context.Context) ([]gitprovider.Webhook, error) {
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return nil, err
+	}
+	urls := make([]string, 0, len(repo.webhooks))
+	for url := range repo.webhooks {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	hooks := make([]gitprovider.Webhook, 0, len(urls))
+	for _, url := range urls {
+		hooks = append(hooks, newWebhook(c, *repo.webhooks[url]))
+	}
+	return hooks, nil
+}
+
+// Create creates a webhook with the given specifications.
+//
+// ErrAlreadyExists will be returned if a webhook for the same URL already exists.
+func (c *WebhookClient) Create(ctx context.Context, req gitprovider.WebhookInfo) (gitprovider.Webhook, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	c.s.mu.Lock()
+	defer c.s.mu.Unlock()
+
+	if err := c.set("", req); err != nil {
+		return nil, err
+	}
+	return newWebhook(c, req), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *WebhookClient) Reconcile(ctx context.Context, req gitprovider.WebhookInfo) (gitprovider.Webhook, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the webhook with the desired URL
+	actual, err := c.Get(ctx, req.URL)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	return actual, true, actual.Update(ctx)
+}
+
+// set stores info as a webhook, replacing the webhook for oldURL if that is non-empty.
+// Like the real providers, the stored secret is kept if info doesn't specify one.
+// The caller must hold the store lock.
+func (c *WebhookClient) set(oldURL string, info gitprovider.WebhookInfo) error {
+	repo, err := c.s.getRepo(c.ref)
+	if err != nil {
+		return err
+	}
+	if oldURL != "" {
+		old, ok := repo.webhooks[oldURL]
+		if !ok {
+			return fmt.Errorf("webhook %q: %w", oldURL, gitprovider.ErrNotFound)
+		}
+		if info.Secret == nil {
+			info.Secret = old.Secret
+		}
+	}
+	if _, ok := repo.webhooks[info.URL]; ok && info.URL != oldURL {
+		return fmt.Errorf("webhook %q: %w", info.URL, gitprovider.ErrAlreadyExists)
+	}
+	delete(repo.webhooks, oldURL)
+	info = copyWebhookInfo(info)
+	repo.webhooks[info.URL] = &info
+	return nil
+}
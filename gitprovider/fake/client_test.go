@@ -0,0 +1,1729 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+var (
+	orgRef    = gitprovider.OrganizationRef{Domain: DefaultDomain, Organization: "fluxcd"}
+	subOrgRef = gitprovider.OrganizationRef{Domain: DefaultDomain, Organization: "fluxcd", SubOrganizations: []string{"engineering"}}
+	repoRef   = gitprovider.OrgRepositoryRef{OrganizationRef: orgRef, RepositoryName: "flux2"}
+)
+
+func newTestClient(t *testing.T, optFns ...gitprovider.ClientOption) *Client {
+	t.Helper()
+	c, err := NewClient("fluxbot", optFns...)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if err := c.AddOrganization(orgRef, gitprovider.OrganizationInfo{Name: gitprovider.StringVar("Flux")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AddOrganization(subOrgRef, gitprovider.OrganizationInfo{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AddTeam(orgRef, gitprovider.TeamInfo{Name: "maintainers", Members: []string{"fluxbot"}}); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func newTestRepository(t *testing.T, c *Client) gitprovider.OrgRepository {
+	t.Helper()
+	repo, err := c.OrgRepositories().Create(context.Background(), repoRef, gitprovider.RepositoryInfo{},
+		&gitprovider.RepositoryCreateOptions{AutoInit: gitprovider.BoolVar(true)})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	return repo
+}
+
+func TestOrganizations(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	if err := c.AddOrganization(orgRef, gitprovider.OrganizationInfo{}); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("AddOrganization() error = %v, want ErrAlreadyExists", err)
+	}
+	if err := c.AddTeam(gitprovider.OrganizationRef{Domain: DefaultDomain, Organization: "other"}, gitprovider.TeamInfo{Name: "a"}); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("AddTeam() error = %v, want ErrNotFound", err)
+	}
+
+	org, err := c.Organizations().Get(ctx, orgRef)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if name := *org.Get().Name; name != "Flux" {
+		t.Errorf("Get().Name = %q, want Flux", name)
+	}
+	if _, err := c.Organizations().Get(ctx, gitprovider.OrganizationRef{Domain: "github.com", Organization: "fluxcd"}); !errors.Is(err, gitprovider.ErrDomainUnsupported) {
+		t.Errorf("Get() error = %v, want ErrDomainUnsupported", err)
+	}
+
+	orgs, err := c.Organizations().List(ctx)
+	if err != nil || len(orgs) != 1 {
+		t.Errorf("List() = %v, %v, want only the top-level organization", orgs, err)
+	}
+	children, err := c.Organizations().Children(ctx, orgRef)
+	if err != nil || len(children) != 1 || children[0].Organization().GetIdentity() != "fluxcd/engineering" {
+		t.Errorf("Children() = %v, %v", children, err)
+	}
+
+	team, err := org.Teams().Get(ctx, "maintainers")
+	if err != nil {
+		t.Fatalf("Teams().Get() error = %v", err)
+	}
+	if diff := cmp.Diff(gitprovider.TeamInfo{Name: "maintainers", Members: []string{"fluxbot"}}, team.Get()); diff != "" {
+		t.Errorf("Teams().Get() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := org.Teams().Get(ctx, "missing"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Teams().Get() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestOrganizationsLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	newTestRepository(t, c)
+	orgs := c.WithDestructiveAPICalls(true).Organizations()
+
+	ref := gitprovider.OrganizationRef{Domain: DefaultDomain, Organization: "fluxcd", SubOrganizations: []string{"engineering", "frontend"}}
+	req := gitprovider.OrganizationInfo{Name: gitprovider.StringVar("Frontend"), Parent: gitprovider.StringVar("ignored")}
+	org, err := orgs.Create(ctx, ref, req)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	want := gitprovider.OrganizationInfo{
+		Name:       gitprovider.StringVar("Frontend"),
+		Visibility: gitprovider.OrganizationVisibilityVar(gitprovider.OrganizationVisibilityPrivate),
+		Parent:     gitprovider.StringVar("fluxcd/engineering"),
+	}
+	if diff := cmp.Diff(want, org.Get()); diff != "" {
+		t.Errorf("Create() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := orgs.Create(ctx, ref, req); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Create() error = %v, want ErrAlreadyExists", err)
+	}
+	orphan := gitprovider.OrganizationRef{Domain: DefaultDomain, Organization: "fluxcd", SubOrganizations: []string{"missing", "x"}}
+	if _, err := orgs.Create(ctx, orphan, req); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Create() without parent error = %v, want ErrNotFound", err)
+	}
+	if _, err := orgs.Create(ctx, orphan, gitprovider.OrganizationInfo{}); !errors.Is(err, validation.ErrFieldRequired) {
+		t.Errorf("Create() without name error = %v, want ErrFieldRequired", err)
+	}
+
+	// An unset visibility and description equal the actual ones
+	if _, actionTaken, err := orgs.Reconcile(ctx, ref, gitprovider.OrganizationInfo{Name: req.Name, Description: gitprovider.StringVar("")}); err != nil || actionTaken {
+		t.Errorf("Reconcile() of the actual state = %v, %v, want no action", actionTaken, err)
+	}
+	req.Visibility = gitprovider.OrganizationVisibilityVar(gitprovider.OrganizationVisibilityInternal)
+	org, actionTaken, err := orgs.Reconcile(ctx, ref, req)
+	if err != nil || !actionTaken {
+		t.Fatalf("Reconcile() of a changed visibility = %v, %v, want action", actionTaken, err)
+	}
+	if got := org.Get(); *got.Visibility != gitprovider.OrganizationVisibilityInternal || *got.Parent != "fluxcd/engineering" {
+		t.Errorf("Reconcile() = %+v, want internal visibility and the same parent", got)
+	}
+	created, actionTaken, err := orgs.Reconcile(ctx, gitprovider.OrganizationRef{Domain: DefaultDomain, Organization: "weaveworks"}, gitprovider.OrganizationInfo{Name: gitprovider.StringVar("Weaveworks")})
+	if err != nil || !actionTaken || created.Get().Parent != nil {
+		t.Errorf("Reconcile() of a new organization = %v, %v, want action without parent", actionTaken, err)
+	}
+
+	if err := c.Organizations().Delete(ctx, orgRef); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("Delete() without destructive calls error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+	// Deleting an organization deletes its sub-organizations and repositories
+	if err := orgs.Delete(ctx, orgRef); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	for _, ref := range []gitprovider.OrganizationRef{orgRef, subOrgRef, ref} {
+		if _, err := orgs.Get(ctx, ref); !errors.Is(err, gitprovider.ErrNotFound) {
+			t.Errorf("Get(%q) of a deleted organization error = %v, want ErrNotFound", ref.GetIdentity(), err)
+		}
+	}
+	if _, err := c.OrgRepositories().Get(ctx, repoRef); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() of a repository of a deleted organization error = %v, want ErrNotFound", err)
+	}
+	if err := orgs.Delete(ctx, orgRef); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Delete() of a deleted organization error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestTeams(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	org, err := c.WithDestructiveAPICalls(true).Organizations().Get(ctx, orgRef)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	teams := org.Teams()
+
+	req := gitprovider.TeamInfo{Name: "reviewers", Description: gitprovider.StringVar("Reviewers"), Members: []string{"ignored"}}
+	team, err := teams.Create(ctx, req)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	want := gitprovider.TeamInfo{
+		Name:        "reviewers",
+		Description: gitprovider.StringVar("Reviewers"),
+		Privacy:     gitprovider.TeamPrivacyVar(gitprovider.TeamPrivacyClosed),
+	}
+	if diff := cmp.Diff(want, team.Get()); diff != "" {
+		t.Errorf("Create() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := teams.Create(ctx, req); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Create() error = %v, want ErrAlreadyExists", err)
+	}
+	if _, err := teams.Create(ctx, gitprovider.TeamInfo{Name: "x", Privacy: gitprovider.TeamPrivacyVar("public")}); !errors.Is(err, validation.ErrFieldEnumInvalid) {
+		t.Errorf("Create() error = %v, want ErrFieldEnumInvalid", err)
+	}
+
+	if _, actionTaken, err := teams.Reconcile(ctx, req); err != nil || actionTaken {
+		t.Errorf("Reconcile() of the actual state = %v, %v, want no action", actionTaken, err)
+	}
+	req.Privacy = gitprovider.TeamPrivacyVar(gitprovider.TeamPrivacySecret)
+	if _, actionTaken, err := teams.Reconcile(ctx, req); err != nil || !actionTaken {
+		t.Errorf("Reconcile() of a changed privacy = %v, %v, want action", actionTaken, err)
+	}
+	// The members of the seeded team are kept when it's updated
+	maintainers, actionTaken, err := teams.Reconcile(ctx, gitprovider.TeamInfo{Name: "maintainers"})
+	if err != nil || !actionTaken {
+		t.Fatalf("Reconcile() = %v, %v, want action", actionTaken, err)
+	}
+	if got := maintainers.Get().Members; len(got) != 1 || got[0] != "fluxbot" {
+		t.Errorf("Reconcile().Members = %v, want the existing members", got)
+	}
+	if err := maintainers.Set(gitprovider.TeamInfo{Name: "renamed"}); !errors.Is(err, gitprovider.ErrInvalidArgument) {
+		t.Errorf("Set() of another name error = %v, want ErrInvalidArgument", err)
+	}
+
+	team, err = teams.Get(ctx, "reviewers")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got := *team.Get().Privacy; got != gitprovider.TeamPrivacySecret {
+		t.Errorf("Get().Privacy = %q, want secret", got)
+	}
+	org, err = c.Organizations().Get(ctx, orgRef)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	guarded, err := org.Teams().Get(ctx, "reviewers")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if err := guarded.Delete(ctx); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("Delete() without destructive calls error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+	if err := team.Delete(ctx); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := teams.Get(ctx, "reviewers"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() of a deleted team error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestTeamMembers(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	org, err := c.Organizations().Get(ctx, orgRef)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	team, err := org.Teams().Get(ctx, "maintainers")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	members := team.Members()
+
+	member := gitprovider.TeamMemberRoleVar(gitprovider.TeamMemberRoleMember)
+	maintainer := gitprovider.TeamMemberRoleVar(gitprovider.TeamMemberRoleMaintainer)
+	if err := members.Add(ctx, gitprovider.TeamMemberInfo{Login: "alice", Role: maintainer}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	// Adding an existing member changes their role
+	if err := members.Add(ctx, gitprovider.TeamMemberInfo{Login: "fluxbot", Role: maintainer}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := members.Add(ctx, gitprovider.TeamMemberInfo{Login: "bob"}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	want := []gitprovider.TeamMemberInfo{
+		{Login: "fluxbot", Role: maintainer},
+		{Login: "alice", Role: maintainer},
+		{Login: "bob", Role: member},
+	}
+	got, err := members.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("List() mismatch (-want +got):\n%s", diff)
+	}
+	team, err = org.Teams().Get(ctx, "maintainers")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"fluxbot", "alice", "bob"}, team.Get().Members); diff != "" {
+		t.Errorf("Get().Members mismatch (-want +got):\n%s", diff)
+	}
+
+	req := []gitprovider.TeamMemberInfo{{Login: "alice"}, {Login: "carol", Role: maintainer}}
+	if actionTaken, err := members.Reconcile(ctx, req); err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want action", actionTaken, err)
+	}
+	if actionTaken, err := members.Reconcile(ctx, req); err != nil || actionTaken {
+		t.Errorf("Reconcile() of the actual state = %v, %v, want no action", actionTaken, err)
+	}
+	// Only an exclusive reconcile removes the members that aren't desired
+	if actionTaken, err := members.Reconcile(ctx, req, &gitprovider.TeamMemberReconcileOptions{Exclusive: gitprovider.BoolVar(true)}); err != nil || !actionTaken {
+		t.Errorf("Reconcile() exclusive = %v, %v, want action", actionTaken, err)
+	}
+	want = []gitprovider.TeamMemberInfo{
+		{Login: "alice", Role: member},
+		{Login: "carol", Role: maintainer},
+	}
+	got, err = members.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("List() after exclusive Reconcile() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := members.Reconcile(ctx, []gitprovider.TeamMemberInfo{{Login: "alice"}, {Login: "alice", Role: maintainer}}); !errors.Is(err, validation.ErrFieldInvalid) {
+		t.Errorf("Reconcile() of duplicate logins error = %v, want ErrFieldInvalid", err)
+	}
+
+	if err := members.Remove(ctx, "alice"); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if err := members.Remove(ctx, "alice"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Remove() of a non-member error = %v, want ErrNotFound", err)
+	}
+	if err := members.Add(ctx, gitprovider.TeamMemberInfo{Login: "dave", Role: gitprovider.TeamMemberRoleVar("owner")}); !errors.Is(err, validation.ErrFieldEnumInvalid) {
+		t.Errorf("Add() of an invalid role error = %v, want ErrFieldEnumInvalid", err)
+	}
+}
+
+func TestRepositories(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	want := gitprovider.RepositoryInfo{
+		DefaultBranch: gitprovider.StringVar("main"),
+		Visibility:    gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate),
+	}
+	if diff := cmp.Diff(want, repo.Get()); diff != "" {
+		t.Errorf("Create() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := c.OrgRepositories().Create(ctx, repoRef, gitprovider.RepositoryInfo{}); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Create() error = %v, want ErrAlreadyExists", err)
+	}
+	missingOrgRepo := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: DefaultDomain, Organization: "other"},
+		RepositoryName:  "flux2",
+	}
+	if _, err := c.OrgRepositories().Create(ctx, missingOrgRepo, gitprovider.RepositoryInfo{}); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Create() error = %v, want ErrNotFound", err)
+	}
+
+	tests := []struct {
+		name            string
+		req             gitprovider.RepositoryInfo
+		wantActionTaken bool
+		wantErr         error
+	}{
+		{
+			name: "no changes",
+			req:  gitprovider.RepositoryInfo{},
+		},
+		{
+			name:            "update description",
+			req:             gitprovider.RepositoryInfo{Description: gitprovider.StringVar("GitOps")},
+			wantActionTaken: true,
+		},
+		{
+			name: "description is already updated",
+			req:  gitprovider.RepositoryInfo{Description: gitprovider.StringVar("GitOps")},
+		},
+		{
+			name: "update settings",
+			req: gitprovider.RepositoryInfo{
+				Description:         gitprovider.StringVar("GitOps"),
+				Topics:              []string{"gitops", "flux"},
+				HasWiki:             gitprovider.BoolVar(false),
+				AllowedMergeMethods: []gitprovider.MergeMethod{gitprovider.MergeMethodSquash},
+			},
+			wantActionTaken: true,
+		},
+		{
+			name: "settings are already updated",
+			req: gitprovider.RepositoryInfo{
+				Description: gitprovider.StringVar("GitOps"),
+				Topics:      []string{"flux", "gitops"},
+				HasWiki:     gitprovider.BoolVar(false),
+			},
+		},
+		{
+			name: "unset settings are kept",
+			req:  gitprovider.RepositoryInfo{Description: gitprovider.StringVar("GitOps")},
+		},
+		{
+			name:    "unknown default branch",
+			req:     gitprovider.RepositoryInfo{Description: gitprovider.StringVar("GitOps"), DefaultBranch: gitprovider.StringVar("missing")},
+			wantErr: gitprovider.ErrInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, actionTaken, err := c.OrgRepositories().Reconcile(ctx, repoRef, tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Reconcile() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && actionTaken != tt.wantActionTaken {
+				t.Errorf("Reconcile() actionTaken = %v, want %v", actionTaken, tt.wantActionTaken)
+			}
+		})
+	}
+
+	_, changes, err := c.OrgRepositories().ReconcileWithChanges(ctx, repoRef, gitprovider.RepositoryInfo{
+		Description: gitprovider.StringVar("GitOps"),
+		Visibility:  gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPublic),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantChanges := []gitprovider.FieldChange{
+		{Path: "visibility", Old: gitprovider.RepositoryVisibilityPrivate, New: gitprovider.RepositoryVisibilityPublic},
+	}
+	if diff := cmp.Diff(wantChanges, changes); diff != "" {
+		t.Errorf("ReconcileWithChanges() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Mutating a returned object mustn't change the stored state
+	*repo.Get().DefaultBranch = "changed"
+	got, err := c.OrgRepositories().Get(ctx, repoRef)
+	if err != nil || *got.Get().DefaultBranch != "main" || *got.Get().Description != "GitOps" {
+		t.Errorf("Get() = %v, %v", got, err)
+	}
+	wantSettings := gitprovider.RepositoryInfo{
+		Description:         gitprovider.StringVar("GitOps"),
+		DefaultBranch:       gitprovider.StringVar("main"),
+		Visibility:          gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPublic),
+		Topics:              []string{"gitops", "flux"},
+		HasWiki:             gitprovider.BoolVar(false),
+		AllowedMergeMethods: []gitprovider.MergeMethod{gitprovider.MergeMethodSquash},
+	}
+	if !wantSettings.Equals(got.Get()) {
+		t.Errorf("Get() settings = %v, want %v", got.Get(), wantSettings)
+	}
+
+	repos, err := c.OrgRepositories().List(ctx, orgRef)
+	if err != nil || len(repos) != 1 {
+		t.Errorf("List() = %v, %v", repos, err)
+	}
+
+	if err := repo.Delete(ctx); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("Delete() error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+	destructive := c.WithDestructiveAPICalls(true)
+	repo, err = destructive.OrgRepositories().Get(ctx, repoRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Delete(ctx); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+	if _, err := c.OrgRepositories().Get(ctx, repoRef); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestUserRepositories(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	login, err := c.UserRepositories().GetUserLogin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := gitprovider.UserRepositoryRef{UserRef: login.(gitprovider.UserRef), RepositoryName: "dotfiles"}
+	_, actionTaken, err := c.UserRepositories().Reconcile(ctx, ref, gitprovider.RepositoryInfo{})
+	if err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want creation", actionTaken, err)
+	}
+
+	other := ref
+	other.UserLogin = "someone-else"
+	var incorrectUser *gitprovider.ErrIncorrectUser
+	if _, err := c.UserRepositories().Create(ctx, other, gitprovider.RepositoryInfo{}); !errors.As(err, &incorrectUser) {
+		t.Errorf("Create() error = %v, want ErrIncorrectUser", err)
+	}
+
+	repos, err := c.UserRepositories().List(ctx, login.(gitprovider.UserRef))
+	if err != nil || len(repos) != 1 || repos[0].Repository().GetRepository() != "dotfiles" {
+		t.Errorf("List() = %v, %v", repos, err)
+	}
+}
+
+func TestDeployKeysAndTokens(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	req := gitprovider.DeployKeyInfo{Name: "flux", Key: []byte("ssh-ed25519 AAAA")}
+	if _, actionTaken, err := repo.DeployKeys().Reconcile(ctx, req); err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want creation", actionTaken, err)
+	}
+	if _, actionTaken, err := repo.DeployKeys().Reconcile(ctx, req); err != nil || actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want no action", actionTaken, err)
+	}
+	if _, err := repo.DeployKeys().Create(ctx, req); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Create() error = %v, want ErrAlreadyExists", err)
+	}
+	req.ReadOnly = gitprovider.BoolVar(false)
+	key, actionTaken, err := repo.DeployKeys().Reconcile(ctx, req)
+	if err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want update", actionTaken, err)
+	}
+	if err := key.Delete(ctx); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+	if keys, err := repo.DeployKeys().List(ctx); err != nil || len(keys) != 0 {
+		t.Errorf("List() = %v, %v, want no keys", keys, err)
+	}
+
+	tokens, err := repo.DeployTokens()
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := tokens.Create(ctx, gitprovider.DeployTokenInfo{Name: "ci"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if info := token.Get(); info.Username == "" || info.Token == "" {
+		t.Errorf("Create() = %+v, want a generated username and token", info)
+	}
+	got, err := tokens.Get(ctx, "ci")
+	if err != nil || got.Get().Token != "" {
+		t.Errorf("Get() = %+v, %v, want the token value to be hidden", got, err)
+	}
+	recreated, actionTaken, err := tokens.Reconcile(ctx, gitprovider.DeployTokenInfo{Name: "ci"})
+	if err != nil || !actionTaken || recreated.Get().Token == token.Get().Token {
+		t.Errorf("Reconcile() = %+v, %v, %v, want a new token", recreated, actionTaken, err)
+	}
+}
+
+func TestTeamAccess(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	if _, err := repo.TeamAccess().Create(ctx, gitprovider.TeamAccessInfo{Name: "missing"}); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Create() error = %v, want ErrNotFound", err)
+	}
+	if _, actionTaken, err := repo.TeamAccess().Reconcile(ctx, gitprovider.TeamAccessInfo{Name: "maintainers"}); err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want creation", actionTaken, err)
+	}
+	ta, actionTaken, err := repo.TeamAccess().Reconcile(ctx, gitprovider.TeamAccessInfo{
+		Name:       "maintainers",
+		Permission: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionAdmin),
+	})
+	if err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want update", actionTaken, err)
+	}
+	_, changes, err := repo.TeamAccess().ReconcileWithChanges(ctx, gitprovider.TeamAccessInfo{
+		Name:       "maintainers",
+		Permission: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionPush),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantChanges := []gitprovider.FieldChange{
+		{Path: "permission", Old: gitprovider.RepositoryPermissionAdmin, New: gitprovider.RepositoryPermissionPush},
+	}
+	if diff := cmp.Diff(wantChanges, changes); diff != "" {
+		t.Errorf("ReconcileWithChanges() mismatch (-want +got):\n%s", diff)
+	}
+	got, err := repo.TeamAccess().Get(ctx, "maintainers")
+	if err != nil || *got.Get().Permission != gitprovider.RepositoryPermissionPush {
+		t.Errorf("Get() = %v, %v", got, err)
+	}
+	if err := ta.Delete(ctx); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+	if err := ta.Delete(ctx); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Delete() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestDeployKeysReconcileAll(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	for _, req := range []gitprovider.DeployKeyInfo{
+		{Name: "flux", Key: []byte("ssh-ed25519 AAAA")},
+		{Name: "old", Key: []byte("ssh-ed25519 BBBB")},
+	} {
+		if _, err := repo.DeployKeys().Create(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	desired := []gitprovider.DeployKeyInfo{
+		{Name: "flux", Key: []byte("ssh-ed25519 AAAA"), ReadOnly: gitprovider.BoolVar(false)},
+		{Name: "new", Key: []byte("ssh-ed25519 CCCC")},
+	}
+	if _, err := repo.DeployKeys().ReconcileAll(ctx, []gitprovider.DeployKeyInfo{desired[1], desired[1]}); !errors.Is(err, validation.ErrFieldInvalid) {
+		t.Errorf("ReconcileAll() with duplicate names error = %v, want ErrFieldInvalid", err)
+	}
+
+	want := []gitprovider.ReconcileChange{
+		{Action: gitprovider.ReconcileActionUpdate, Name: "flux", Fields: []gitprovider.FieldChange{{Path: "readOnly", Old: true, New: false}}},
+		{Action: gitprovider.ReconcileActionCreate, Name: "new"},
+		{Action: gitprovider.ReconcileActionDelete, Name: "old"},
+	}
+	report, err := repo.DeployKeys().ReconcileAll(ctx, desired, &gitprovider.ReconcileAllOptions{PlanOnly: gitprovider.BoolVar(true)})
+	if err != nil || !report.PlanOnly {
+		t.Fatalf("ReconcileAll() = %+v, %v, want a dry run", report, err)
+	}
+	if diff := cmp.Diff(want, report.Changes); diff != "" {
+		t.Errorf("ReconcileAll() dry run changes mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := repo.DeployKeys().ReconcileAll(ctx, desired); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("ReconcileAll() error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+	if keys, err := repo.DeployKeys().List(ctx); err != nil || len(keys) != 2 {
+		t.Fatalf("List() = %v, %v, want the keys to be unchanged", keys, err)
+	}
+
+	repo, err = c.WithDestructiveAPICalls(true).OrgRepositories().Get(ctx, repoRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err = repo.DeployKeys().ReconcileAll(ctx, desired)
+	if err != nil || report.PlanOnly {
+		t.Fatalf("ReconcileAll() = %+v, %v", report, err)
+	}
+	if diff := cmp.Diff(want, report.Changes); diff != "" {
+		t.Errorf("ReconcileAll() changes mismatch (-want +got):\n%s", diff)
+	}
+	if report, err := repo.DeployKeys().ReconcileAll(ctx, desired); err != nil || report.ActionTaken() {
+		t.Errorf("ReconcileAll() = %+v, %v, want no action", report, err)
+	}
+}
+
+func TestTeamAccessReconcileAll(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t).WithDestructiveAPICalls(true)
+	if err := c.AddTeam(orgRef, gitprovider.TeamInfo{Name: "reviewers"}); err != nil {
+		t.Fatal(err)
+	}
+	repo := newTestRepository(t, c)
+
+	if _, err := repo.TeamAccess().Create(ctx, gitprovider.TeamAccessInfo{Name: "maintainers"}); err != nil {
+		t.Fatal(err)
+	}
+	report, err := repo.TeamAccess().ReconcileAll(ctx, []gitprovider.TeamAccessInfo{
+		{Name: "reviewers", Permission: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionTriage)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []gitprovider.ReconcileChange{
+		{Action: gitprovider.ReconcileActionCreate, Name: "reviewers"},
+		{Action: gitprovider.ReconcileActionDelete, Name: "maintainers"},
+	}
+	if diff := cmp.Diff(want, report.Changes); diff != "" {
+		t.Errorf("ReconcileAll() changes mismatch (-want +got):\n%s", diff)
+	}
+	list, err := repo.TeamAccess().List(ctx)
+	if err != nil || len(list) != 1 || list[0].Get().Name != "reviewers" {
+		t.Errorf("List() = %v, %v, want only reviewers", list, err)
+	}
+}
+
+func TestCollaborators(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	if _, actionTaken, err := repo.Collaborators().Reconcile(ctx, gitprovider.CollaboratorInfo{Login: "alice"}); err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want creation", actionTaken, err)
+	}
+	if _, err := repo.Collaborators().Create(ctx, gitprovider.CollaboratorInfo{Login: "alice"}); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Create() error = %v, want ErrAlreadyExists", err)
+	}
+	collaborator, actionTaken, err := repo.Collaborators().Reconcile(ctx, gitprovider.CollaboratorInfo{
+		Login:      "alice",
+		Permission: gitprovider.RepositoryPermissionVar(gitprovider.RepositoryPermissionMaintain),
+	})
+	if err != nil || !actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want update", actionTaken, err)
+	}
+	got, err := repo.Collaborators().Get(ctx, "alice")
+	if err != nil || *got.Get().Permission != gitprovider.RepositoryPermissionMaintain || got.Get().Pending {
+		t.Errorf("Get() = %v, %v", got, err)
+	}
+	list, err := repo.Collaborators().List(ctx)
+	if err != nil || len(list) != 1 {
+		t.Errorf("List() = %v, %v, want one collaborator", list, err)
+	}
+	if err := collaborator.Delete(ctx); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+	if _, err := repo.Collaborators().Get(ctx, "alice"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestCommitsBranchesAndPullRequests(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	commits, err := repo.Commits().ListPage(ctx, "main", 10, 1)
+	if err != nil || len(commits) != 1 {
+		t.Fatalf("ListPage() = %v, %v, want the initial commit", commits, err)
+	}
+	initial := commits[0].Get().Sha
+
+	if err := repo.Branches().Create(ctx, "feature", "0000"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Branches().Create() error = %v, want ErrNotFound", err)
+	}
+	if err := repo.Branches().Create(ctx, "feature", initial); err != nil {
+		t.Fatalf("Branches().Create() error = %v", err)
+	}
+	if err := repo.Branches().Create(ctx, "feature", initial); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Branches().Create() error = %v, want ErrAlreadyExists", err)
+	}
+
+	if _, err := repo.Commits().Create(ctx, "feature", "add app", []gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("apps/podinfo.yaml"), Content: gitprovider.StringVar("kind: HelmRelease")},
+		{Path: gitprovider.StringVar("README.md")},
+	}); err != nil {
+		t.Fatalf("Commits().Create() error = %v", err)
+	}
+	if _, err := repo.Commits().Create(ctx, "main", "add license", []gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("LICENSE"), Content: gitprovider.StringVar("Apache-2.0")},
+	}); err != nil {
+		t.Fatalf("Commits().Create() error = %v", err)
+	}
+	if _, err := repo.Commits().Create(ctx, "missing", "msg", []gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("a"), Content: gitprovider.StringVar("b")},
+	}); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Commits().Create() error = %v, want ErrNotFound", err)
+	}
+
+	pr, err := repo.PullRequests().Create(ctx, "Add podinfo", "feature", "main", "")
+	if err != nil {
+		t.Fatalf("PullRequests().Create() error = %v", err)
+	}
+	if _, err := repo.PullRequests().Create(ctx, "Again", "feature", "main", ""); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("PullRequests().Create() error = %v, want ErrAlreadyExists", err)
+	}
+	number := pr.Get().Number
+	if info := pr.Get(); info.State != gitprovider.PullRequestStateOpen || info.TargetBranch != "main" || info.HeadSha == "" ||
+		info.Mergeable == nil || !*info.Mergeable || info.MergedAt != nil {
+		t.Errorf("PullRequests().Create() = %+v, want an open and mergeable pull request", info)
+	}
+	if _, err := repo.PullRequests().Edit(ctx, number, gitprovider.EditOptions{Title: gitprovider.StringVar("Add podinfo app")}); err != nil {
+		t.Errorf("Edit() error = %v", err)
+	}
+	edited, err := repo.PullRequests().Edit(ctx, number, gitprovider.EditOptions{
+		Description: gitprovider.StringVar("Deploys podinfo"),
+		State:       gitprovider.PullRequestStateVar(gitprovider.PullRequestStateClosed),
+		Draft:       gitprovider.BoolVar(true),
+		Assignees:   []string{"alice"},
+		Reviewers:   []string{"bob"},
+		Labels:      []string{"apps"},
+	})
+	if err != nil {
+		t.Fatalf("Edit() error = %v", err)
+	}
+	wantEdited := pr.Get()
+	wantEdited.Title = "Add podinfo app"
+	wantEdited.Description = "Deploys podinfo"
+	wantEdited.State = gitprovider.PullRequestStateClosed
+	wantEdited.Draft = true
+	wantEdited.Assignees = []string{"alice"}
+	wantEdited.Reviewers = []string{"bob"}
+	wantEdited.Labels = []string{"apps"}
+	wantEdited.Mergeable = nil
+	if diff := cmp.Diff(wantEdited, edited.Get(), cmpopts.IgnoreFields(gitprovider.PullRequestInfo{}, "UpdatedAt")); diff != "" {
+		t.Errorf("Edit() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := repo.PullRequests().Edit(ctx, number, gitprovider.EditOptions{
+		State: gitprovider.PullRequestStateVar(gitprovider.PullRequestStateMerged),
+	}); !errors.Is(err, validation.ErrFieldInvalid) {
+		t.Errorf("Edit() error = %v, want ErrFieldInvalid", err)
+	}
+	if _, err := repo.PullRequests().Edit(ctx, number, gitprovider.EditOptions{
+		State: gitprovider.PullRequestStateVar(gitprovider.PullRequestStateOpen),
+		Draft: gitprovider.BoolVar(false),
+	}); err != nil {
+		t.Errorf("Edit() error = %v", err)
+	}
+	var conflictErr *gitprovider.ConflictError
+	if _, err := repo.PullRequests().Merge(ctx, number, gitprovider.MergeMethodMerge, "", &gitprovider.PullRequestMergeOptions{
+		ExpectedHeadSha: gitprovider.StringVar(initial),
+	}); !errors.As(err, &conflictErr) {
+		t.Errorf("Merge() error = %v, want a *ConflictError", err)
+	}
+	result, err := repo.PullRequests().Merge(ctx, number, gitprovider.MergeMethodMerge, "", &gitprovider.PullRequestMergeOptions{
+		ExpectedHeadSha: gitprovider.StringVar(pr.Get().HeadSha),
+	})
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if _, err := repo.PullRequests().Merge(ctx, number, gitprovider.MergeMethodMerge, ""); !errors.Is(err, gitprovider.ErrInvalidArgument) {
+		t.Errorf("Merge() error = %v, want ErrInvalidArgument", err)
+	}
+	if head, err := repo.Branches().Get(ctx, "main"); err != nil || head.Get().Sha != result.Sha {
+		t.Errorf("Merge() = %+v, want the head of main %v, %v", result, head, err)
+	}
+	merged, err := repo.PullRequests().Get(ctx, number)
+	if err != nil || !merged.Get().Merged || merged.Get().Title != "Add podinfo app" {
+		t.Errorf("Get() = %+v, %v", merged, err)
+	}
+	if info := merged.Get(); info.State != gitprovider.PullRequestStateMerged || info.MergedAt == nil || info.Mergeable != nil {
+		t.Errorf("Get() = %+v, want a merged pull request", info)
+	}
+	if open, err := repo.PullRequests().List(ctx); err != nil || len(open) != 0 {
+		t.Errorf("List() = %v, %v, want no open pull requests", open, err)
+	}
+	listed, err := repo.PullRequests().List(ctx, &gitprovider.PullRequestListOptions{
+		State:        gitprovider.PullRequestStateVar(gitprovider.PullRequestStateAll),
+		TargetBranch: gitprovider.StringVar("main"),
+	})
+	if err != nil || len(listed) != 1 || listed[0].Get().Number != number {
+		t.Errorf("List() = %v, %v, want the merged pull request", listed, err)
+	}
+	if _, err := repo.PullRequests().List(ctx, &gitprovider.PullRequestListOptions{
+		State: gitprovider.PullRequestStateVar("draft"),
+	}); !errors.Is(err, validation.ErrFieldEnumInvalid) {
+		t.Errorf("List() error = %v, want ErrFieldEnumInvalid", err)
+	}
+
+	// The merge keeps the change made on main, and applies the ones made on the feature branch
+	files, err := repo.Files().Get(ctx, "", "main", &gitprovider.FilesGetOptions{Recursive: true})
+	if err != nil {
+		t.Fatalf("Files().Get() error = %v", err)
+	}
+	want := []*gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("LICENSE"), Content: gitprovider.StringVar("Apache-2.0")},
+		{Path: gitprovider.StringVar("apps/podinfo.yaml"), Content: gitprovider.StringVar("kind: HelmRelease")},
+	}
+	if diff := cmp.Diff(want, files); diff != "" {
+		t.Errorf("Files().Get() mismatch (-want +got):\n%s", diff)
+	}
+	commits, err = repo.Commits().ListPage(ctx, "main", 2, 2)
+	if err != nil || len(commits) != 1 || commits[0].Get().Sha != initial {
+		t.Errorf("ListPage() = %v, %v, want the initial commit on the second page", commits, err)
+	}
+}
+
+func TestPullRequestsRebase(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	main, err := repo.Branches().Get(ctx, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Branches().Create(ctx, "feature", main.Get().Sha); err != nil {
+		t.Fatal(err)
+	}
+	for _, msg := range []string{"add app", "configure app"} {
+		if _, err := repo.Commits().Create(ctx, "feature", msg, []gitprovider.CommitFile{
+			{Path: gitprovider.StringVar("apps/podinfo.yaml"), Content: gitprovider.StringVar(msg)},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := repo.Commits().Create(ctx, "main", "add license", []gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("LICENSE"), Content: gitprovider.StringVar("Apache-2.0")},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	pr, err := repo.PullRequests().Create(ctx, "Add podinfo", "feature", "main", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := repo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodRebase, "", &gitprovider.PullRequestMergeOptions{
+		DeleteSourceBranch: gitprovider.BoolVar(true),
+	})
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	commits, err := repo.Commits().ListPage(ctx, "main", 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var messages []string
+	for _, commit := range commits {
+		messages = append(messages, commit.Get().Message)
+	}
+	if want := []string{"configure app", "add app", "add license"}; len(messages) < 3 || !cmp.Equal(want, messages[:3]) {
+		t.Errorf("ListPage() = %v, want the rebased commits on top of %v", messages, want[2:])
+	}
+	if commits[0].Get().Sha != result.Sha {
+		t.Errorf("Merge() = %+v, want the head of main %q", result, commits[0].Get().Sha)
+	}
+	files, err := repo.Files().Get(ctx, "", "main", &gitprovider.FilesGetOptions{Recursive: true})
+	if err != nil || len(files) != 3 {
+		t.Errorf("Files().Get() = %v, %v, want the license, the app and the README", files, err)
+	}
+	if _, err := repo.Branches().Get(ctx, "feature"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Branches().Get() of the source branch error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestBranchesRenameDefault(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+	main, err := repo.Branches().Get(ctx, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Branches().Create(ctx, "feature", main.Get().Sha); err != nil {
+		t.Fatal(err)
+	}
+	files := []gitprovider.CommitFile{{Path: gitprovider.StringVar("README.md"), Content: gitprovider.StringVar("# Flux")}}
+	if _, err := repo.Commits().Create(ctx, "feature", "Update README", files); err != nil {
+		t.Fatal(err)
+	}
+	pr, err := repo.PullRequests().Create(ctx, "Update README", "feature", "main", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// In a dry run, the retargeting of the pull request is planned
+	d := c.WithDryRun(true)
+	dryRepo, err := d.OrgRepositories().Get(ctx, repoRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dryRepo.Branches().RenameDefault(ctx, "feature"); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("RenameDefault() error = %v, want ErrAlreadyExists", err)
+	}
+	if err := dryRepo.Branches().RenameDefault(ctx, "trunk"); err != nil {
+		t.Fatalf("RenameDefault() error = %v", err)
+	}
+	var planned []string
+	for _, op := range d.DryRunPlan().Operations() {
+		planned = append(planned, op.Operation+" "+op.Resource+" "+op.Name)
+	}
+	want := []string{"RenameDefault Branch trunk", "Edit PullRequest #" + strconv.Itoa(pr.Get().Number)}
+	if diff := cmp.Diff(want, planned); diff != "" {
+		t.Errorf("DryRunPlan() operations mismatch (-want +got):\n%s", diff)
+	}
+	if got, err := repo.PullRequests().Get(ctx, pr.Get().Number); err != nil || got.Get().TargetBranch != "main" {
+		t.Errorf("PullRequests().Get() = %v, %v, want the target branch to be kept in a dry run", got, err)
+	}
+
+	if err := repo.Branches().RenameDefault(ctx, "trunk"); err != nil {
+		t.Fatalf("RenameDefault() error = %v", err)
+	}
+	got, err := repo.PullRequests().Get(ctx, pr.Get().Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target := got.Get().TargetBranch; target != "trunk" {
+		t.Errorf("TargetBranch after RenameDefault() = %q, want trunk", target)
+	}
+	// Like on providers which can't rename branches, the previous default branch is kept
+	if _, err := repo.Branches().Get(ctx, "main"); err != nil {
+		t.Errorf("Branches().Get() of the previous default branch error = %v", err)
+	}
+}
+
+func TestPullRequestFiles(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	main, err := repo.Branches().Get(ctx, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Branches().Create(ctx, "feature", main.Get().Sha); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Commits().Create(ctx, "feature", "replace readme", []gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("README.md")},
+		{Path: gitprovider.StringVar("docs/index.md"), Content: gitprovider.StringVar("# Flux\n")},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// Changes on the base branch aren't part of the pull request
+	if _, err := repo.Commits().Create(ctx, "main", "add license", []gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("LICENSE"), Content: gitprovider.StringVar("Apache-2.0\n")},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	pr, err := repo.PullRequests().Create(ctx, "Move readme", "feature", "main", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := pr.Files(ctx)
+	if err != nil {
+		t.Fatalf("Files() error = %v", err)
+	}
+	want := &gitprovider.PullRequestFiles{Files: []gitprovider.PullRequestFile{
+		{Path: "README.md", Status: gitprovider.PullRequestFileStatusRemoved, Deletions: 1, Patch: "@@ -1,1 +0,0 @@\n-# flux2\n"},
+		{Path: "docs/index.md", Status: gitprovider.PullRequestFileStatusAdded, Additions: 1, Patch: "@@ -0,0 +1,1 @@\n+# Flux\n"},
+	}}
+	if diff := cmp.Diff(want, files); diff != "" {
+		t.Errorf("Files() mismatch (-want +got):\n%s", diff)
+	}
+	diff, err := pr.Diff(ctx)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	wantDiff := "diff --git a/README.md b/README.md\ndeleted file mode 100644\n--- a/README.md\n+++ /dev/null\n@@ -1,1 +0,0 @@\n-# flux2\n" +
+		"diff --git a/docs/index.md b/docs/index.md\nnew file mode 100644\n--- /dev/null\n+++ b/docs/index.md\n@@ -0,0 +1,1 @@\n+# Flux\n"
+	if diff != wantDiff {
+		t.Errorf("Diff() = %q, want %q", diff, wantDiff)
+	}
+}
+
+func TestPullRequestAutoMerge(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	main, err := repo.Branches().Get(ctx, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Branches().Create(ctx, "feature", main.Get().Sha); err != nil {
+		t.Fatal(err)
+	}
+	pr, err := repo.PullRequests().Create(ctx, "Feature", "feature", "main", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	number := pr.Get().Number
+
+	err = repo.PullRequests().EnableAutoMerge(ctx, number, gitprovider.MergeMethod("octopus"))
+	if !errors.Is(err, gitprovider.ErrInvalidArgument) {
+		t.Errorf("EnableAutoMerge() with an unknown merge method error = %v, want ErrInvalidArgument", err)
+	}
+	if err := repo.PullRequests().EnableAutoMerge(ctx, number, gitprovider.MergeMethodSquash); err != nil {
+		t.Fatalf("EnableAutoMerge() error = %v", err)
+	}
+	if pr, err = repo.PullRequests().Get(ctx, number); err != nil || !pr.Get().AutoMerge || pr.Get().Merged {
+		t.Fatalf("Get() = %+v, %v, want an open pull request with auto-merge", pr.Get(), err)
+	}
+	if err := repo.PullRequests().DisableAutoMerge(ctx, number); err != nil {
+		t.Fatalf("DisableAutoMerge() error = %v", err)
+	}
+	if pr, err = repo.PullRequests().Get(ctx, number); err != nil || pr.Get().AutoMerge {
+		t.Fatalf("Get() = %+v, %v, want auto-merge disabled", pr.Get(), err)
+	}
+
+	// Merging clears auto-merge, which can't be enabled anymore
+	if err := repo.PullRequests().EnableAutoMerge(ctx, number, gitprovider.MergeMethodMerge); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.PullRequests().Merge(ctx, number, gitprovider.MergeMethodMerge, ""); err != nil {
+		t.Fatal(err)
+	}
+	if pr, err = repo.PullRequests().Get(ctx, number); err != nil || pr.Get().AutoMerge {
+		t.Fatalf("Get() = %+v, %v, want auto-merge cleared", pr.Get(), err)
+	}
+	err = repo.PullRequests().EnableAutoMerge(ctx, number, gitprovider.MergeMethodMerge)
+	if !errors.Is(err, gitprovider.ErrInvalidArgument) {
+		t.Errorf("EnableAutoMerge() of a merged pull request error = %v, want ErrInvalidArgument", err)
+	}
+	err = repo.PullRequests().DisableAutoMerge(ctx, 100)
+	if !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("DisableAutoMerge() of a missing pull request error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPullRequestCommentsAndReviews(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	branch, err := repo.Branches().Get(ctx, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Branches().Create(ctx, "feature", branch.Get().Sha); err != nil {
+		t.Fatal(err)
+	}
+	pr, err := repo.PullRequests().Create(ctx, "Add podinfo", "feature", "main", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := pr.Comments().Create(ctx, gitprovider.PullRequestCommentInfo{}); !errors.Is(err, validation.ErrFieldRequired) {
+		t.Errorf("Comments().Create() error = %v, want ErrFieldRequired", err)
+	}
+	first, err := pr.Comments().Create(ctx, gitprovider.PullRequestCommentInfo{Body: "first"})
+	if err != nil {
+		t.Fatalf("Comments().Create() error = %v", err)
+	}
+	second, err := pr.Comments().Create(ctx, gitprovider.PullRequestCommentInfo{Body: "second"})
+	if err != nil {
+		t.Fatalf("Comments().Create() error = %v", err)
+	}
+	if info := first.Get(); info.ID == 0 || info.Author != "fluxbot" || info.CreatedAt.IsZero() {
+		t.Errorf("Comments().Create() = %+v, want the ID, author and creation time set", info)
+	}
+	if err := second.Set(gitprovider.PullRequestCommentInfo{Body: "edited"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.Update(ctx); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	got, err := pr.Comments().Get(ctx, second.Get().ID)
+	if err != nil || got.Get().Body != "edited" {
+		t.Errorf("Comments().Get() = %v, %v, want the edited comment", got, err)
+	}
+	if err := first.Delete(ctx); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := first.Delete(ctx); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Delete() error = %v, want ErrNotFound", err)
+	}
+	comments, err := pr.Comments().List(ctx)
+	if err != nil || len(comments) != 1 || comments[0].Get().Body != "edited" {
+		t.Errorf("Comments().List() = %v, %v, want only the edited comment", comments, err)
+	}
+
+	if _, err := pr.Reviews().Create(ctx, gitprovider.PullRequestReviewInfo{
+		State: gitprovider.PullRequestReviewStateChangesRequested,
+	}); !errors.Is(err, validation.ErrFieldRequired) {
+		t.Errorf("Reviews().Create() error = %v, want ErrFieldRequired", err)
+	}
+	if _, err := pr.Reviews().Create(ctx, gitprovider.PullRequestReviewInfo{
+		State: gitprovider.PullRequestReviewStateApproved,
+	}); err != nil {
+		t.Fatalf("Reviews().Create() error = %v", err)
+	}
+	reviews, err := pr.Reviews().List(ctx)
+	if err != nil || len(reviews) != 1 {
+		t.Fatalf("Reviews().List() = %v, %v, want one review", reviews, err)
+	}
+	want := gitprovider.PullRequestReviewInfo{
+		State:     gitprovider.PullRequestReviewStateApproved,
+		Author:    "fluxbot",
+		CommitSha: pr.Get().HeadSha,
+	}
+	if diff := cmp.Diff(want, reviews[0].Get(), cmpopts.IgnoreFields(gitprovider.PullRequestReviewInfo{}, "ID", "SubmittedAt")); diff != "" {
+		t.Errorf("Reviews().List() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIssues(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	if _, err := repo.Issues().Create(ctx, gitprovider.IssueInfo{}); !errors.Is(err, validation.ErrFieldRequired) {
+		t.Errorf("Create() error = %v, want ErrFieldRequired", err)
+	}
+	bug, err := repo.Issues().Create(ctx, gitprovider.IssueInfo{
+		Title:     "Crash on startup",
+		Labels:    []string{"bug", "help wanted"},
+		Assignees: []string{"fluxbot"},
+		Milestone: "v1.0",
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if info := bug.Get(); info.Number != 1 || info.State != gitprovider.IssueStateOpen || info.Author != "fluxbot" {
+		t.Errorf("Create() = %+v, want open issue 1 by fluxbot", info)
+	}
+	if _, err := repo.Issues().Create(ctx, gitprovider.IssueInfo{Title: "Add docs", Labels: []string{"docs"}}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	issues, err := repo.Issues().List(ctx, &gitprovider.IssueListOptions{Labels: []string{"bug"}})
+	if err != nil || len(issues) != 1 || issues[0].Get().Number != 1 {
+		t.Errorf("List(bug) = %v, %v, want issue 1", issues, err)
+	}
+	issues, err = repo.Issues().List(ctx, &gitprovider.IssueListOptions{Assignee: gitprovider.StringVar("fluxbot")})
+	if err != nil || len(issues) != 1 || issues[0].Get().Number != 1 {
+		t.Errorf("List(fluxbot) = %v, %v, want issue 1", issues, err)
+	}
+
+	closed, err := repo.Issues().Close(ctx, 1)
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if info := closed.Get(); info.State != gitprovider.IssueStateClosed || info.ClosedAt == nil {
+		t.Errorf("Close() = %+v, want closed issue with closing time", info)
+	}
+	issues, err = repo.Issues().List(ctx)
+	if err != nil || len(issues) != 1 || issues[0].Get().Number != 2 {
+		t.Errorf("List() = %v, %v, want only the open issue 2", issues, err)
+	}
+	issues, err = repo.Issues().List(ctx, &gitprovider.IssueListOptions{State: gitprovider.IssueStateVar(gitprovider.IssueStateAll)})
+	if err != nil || len(issues) != 2 {
+		t.Errorf("List(all) = %v, %v, want both issues", issues, err)
+	}
+
+	edited, err := repo.Issues().Edit(ctx, 1, gitprovider.IssueEditOptions{
+		State:     gitprovider.IssueStateVar(gitprovider.IssueStateOpen),
+		Labels:    []string{},
+		Milestone: gitprovider.StringVar(""),
+	})
+	if err != nil {
+		t.Fatalf("Edit() error = %v", err)
+	}
+	if info := edited.Get(); info.State != gitprovider.IssueStateOpen || info.ClosedAt != nil || len(info.Labels) != 0 || info.Milestone != "" {
+		t.Errorf("Edit() = %+v, want reopened issue without labels and milestone", info)
+	}
+	if _, err := repo.Issues().Edit(ctx, 1, gitprovider.IssueEditOptions{Title: gitprovider.StringVar("")}); !errors.Is(err, validation.ErrFieldInvalid) {
+		t.Errorf("Edit() error = %v, want ErrFieldInvalid", err)
+	}
+	if _, err := repo.Issues().Get(ctx, 3); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() error = %v, want ErrNotFound", err)
+	}
+
+	comment, err := edited.Comments().Create(ctx, gitprovider.IssueCommentInfo{Body: "Fixed in main"})
+	if err != nil {
+		t.Fatalf("Comments().Create() error = %v", err)
+	}
+	if err := comment.Set(gitprovider.IssueCommentInfo{Body: "Fixed in v1.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := comment.Update(ctx); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	comments, err := edited.Comments().List(ctx)
+	if err != nil || len(comments) != 1 || comments[0].Get().Body != "Fixed in v1.0.1" {
+		t.Errorf("Comments().List() = %v, %v, want the edited comment", comments, err)
+	}
+	if err := comment.Delete(ctx); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := edited.Comments().Get(ctx, comment.Get().ID); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Comments().Get() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestLabels(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	bug, err := repo.Labels().Create(ctx, gitprovider.LabelInfo{Name: "bug", Color: "#D73A4A"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if got := bug.Get().Color; got != "d73a4a" {
+		t.Errorf("Create().Color = %q, want the normalized color", got)
+	}
+	if _, err := repo.Labels().Create(ctx, gitprovider.LabelInfo{Name: "bug", Color: "d73a4a"}); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Create() error = %v, want ErrAlreadyExists", err)
+	}
+	if _, actionTaken, err := repo.Labels().Reconcile(ctx, gitprovider.LabelInfo{Name: "bug", Color: "d73a4a"}); err != nil || actionTaken {
+		t.Errorf("Reconcile() = %v, %v, want no action", actionTaken, err)
+	}
+
+	// Rename the label
+	if err := bug.Set(gitprovider.LabelInfo{Name: "kind/bug", Color: "d73a4a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bug.Update(ctx); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if _, err := repo.Labels().Get(ctx, "bug"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() of the old name error = %v, want ErrNotFound", err)
+	}
+	if _, err := repo.Labels().Create(ctx, gitprovider.LabelInfo{Name: "wontfix", Color: "ffffff"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if _, err := repo.Labels().ReconcileAll(ctx, []gitprovider.LabelInfo{
+		{Name: "docs", Color: "0075ca"},
+		{Name: "docs", Color: "0075ca"},
+	}); !errors.Is(err, validation.ErrFieldInvalid) {
+		t.Errorf("ReconcileAll() with duplicate names error = %v, want ErrFieldInvalid", err)
+	}
+	desired := []gitprovider.LabelInfo{
+		{Name: "docs", Color: "0075ca", Description: "Improvements or additions to documentation"},
+		{Name: "kind/bug", Color: "b60205"},
+	}
+	want := []gitprovider.ReconcileChange{
+		{Action: gitprovider.ReconcileActionCreate, Name: "docs"},
+		{Action: gitprovider.ReconcileActionUpdate, Name: "kind/bug", Fields: []gitprovider.FieldChange{{Path: "color", Old: "d73a4a", New: "b60205"}}},
+		{Action: gitprovider.ReconcileActionDelete, Name: "wontfix"},
+	}
+	report, err := repo.Labels().ReconcileAll(ctx, desired, &gitprovider.ReconcileAllOptions{PlanOnly: gitprovider.BoolVar(true)})
+	if err != nil || !report.PlanOnly {
+		t.Fatalf("ReconcileAll() = %+v, %v, want a dry run", report, err)
+	}
+	if diff := cmp.Diff(want, report.Changes); diff != "" {
+		t.Errorf("ReconcileAll() dry run changes mismatch (-want +got):\n%s", diff)
+	}
+	// Deleting labels requires destructive API calls, also outside of ReconcileAll
+	if _, err := repo.Labels().ReconcileAll(ctx, desired); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("ReconcileAll() error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+	if err := bug.Delete(ctx); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("Delete() error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+	if labels, err := repo.Labels().List(ctx); err != nil || len(labels) != 2 {
+		t.Fatalf("List() = %v, %v, want the labels to be unchanged", labels, err)
+	}
+
+	repo, err = c.WithDestructiveAPICalls(true).OrgRepositories().Get(ctx, repoRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err = repo.Labels().ReconcileAll(ctx, desired)
+	if err != nil || report.PlanOnly {
+		t.Fatalf("ReconcileAll() = %+v, %v", report, err)
+	}
+	if diff := cmp.Diff(want, report.Changes); diff != "" {
+		t.Errorf("ReconcileAll() changes mismatch (-want +got):\n%s", diff)
+	}
+	labels, err := repo.Labels().List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	got := make([]gitprovider.LabelInfo, 0, len(labels))
+	for _, l := range labels {
+		got = append(got, l.Get())
+	}
+	if diff := cmp.Diff(desired, got); diff != "" {
+		t.Errorf("List() after ReconcileAll() mismatch (-want +got):\n%s", diff)
+	}
+	if report, err := repo.Labels().ReconcileAll(ctx, desired); err != nil || report.ActionTaken() {
+		t.Errorf("ReconcileAll() = %+v, %v, want no action", report, err)
+	}
+}
+
+func TestFilesAndTrees(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	commit, err := repo.Commits().Create(ctx, "main", "add apps", []gitprovider.CommitFile{
+		{Path: gitprovider.StringVar("apps/base/podinfo.yaml"), Content: gitprovider.StringVar("base")},
+		{Path: gitprovider.StringVar("apps/kustomization.yaml"), Content: gitprovider.StringVar("kustomize")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := repo.Files().Get(ctx, "apps", "main")
+	if err != nil || len(files) != 1 || *files[0].Path != "apps/kustomization.yaml" {
+		t.Errorf("Files().Get() = %v, %v, want only the top-level file", files, err)
+	}
+	if _, err := repo.Files().Get(ctx, "missing", "main"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Files().Get() error = %v, want ErrNotFound", err)
+	}
+
+	tree, err := repo.Trees().Get(ctx, "main", false)
+	if err != nil {
+		t.Fatalf("Trees().Get() error = %v", err)
+	}
+	if tree.SHA != commit.Get().TreeSha {
+		t.Errorf("Trees().Get().SHA = %q, want %q", tree.SHA, commit.Get().TreeSha)
+	}
+	var paths []string
+	for _, e := range tree.Tree {
+		paths = append(paths, e.Type+":"+e.Path)
+	}
+	if diff := cmp.Diff([]string{"blob:README.md", "tree:apps"}, paths); diff != "" {
+		t.Errorf("Trees().Get() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Sub-trees can be retrieved by their SHA, with paths relative to them
+	subTree, err := repo.Trees().Get(ctx, tree.Tree[1].SHA, true)
+	if err != nil || len(subTree.Tree) != 3 {
+		t.Fatalf("Trees().Get() = %v, %v", subTree, err)
+	}
+	blobs, err := repo.Trees().List(ctx, commit.Get().Sha, "apps/", true)
+	if err != nil || len(blobs) != 2 || blobs[0].Path != "apps/base/podinfo.yaml" {
+		t.Errorf("Trees().List() = %v, %v", blobs, err)
+	}
+}
+
+func TestTagsAndReleases(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+
+	head, err := repo.Branches().Get(ctx, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sha := head.Get().Sha
+
+	annotated := gitprovider.TagInfo{Name: "v1.0.0", Sha: sha, Message: gitprovider.StringVar("First release")}
+	if _, err := repo.Tags().Create(ctx, annotated); err != nil {
+		t.Fatalf("Tags().Create() error = %v", err)
+	}
+	if _, err := repo.Tags().Create(ctx, annotated); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Tags().Create() error = %v, want ErrAlreadyExists", err)
+	}
+	if _, err := repo.Tags().Create(ctx, gitprovider.TagInfo{Name: "v0.1.0", Sha: "0000"}); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Tags().Create() error = %v, want ErrNotFound", err)
+	}
+	tag, err := repo.Tags().Get(ctx, "v1.0.0")
+	if err != nil {
+		t.Fatalf("Tags().Get() error = %v", err)
+	}
+	if diff := cmp.Diff(annotated, tag.Get()); diff != "" {
+		t.Errorf("Tags().Get() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Creating a release for a missing tag creates a lightweight tag
+	release, err := repo.Releases().Create(ctx, gitprovider.ReleaseInfo{TagName: "v1.1.0", Target: gitprovider.StringVar("main")})
+	if err != nil {
+		t.Fatalf("Releases().Create() error = %v", err)
+	}
+	tags, err := repo.Tags().List(ctx)
+	if err != nil {
+		t.Fatalf("Tags().List() error = %v", err)
+	}
+	wantTags := []gitprovider.TagInfo{annotated, {Name: "v1.1.0", Sha: sha}}
+	if len(tags) != len(wantTags) {
+		t.Fatalf("Tags().List() = %v, want %d tags", tags, len(wantTags))
+	}
+	for i, tag := range tags {
+		if diff := cmp.Diff(wantTags[i], tag.Get()); diff != "" {
+			t.Errorf("Tags().List()[%d] mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+	if _, err := repo.Releases().Create(ctx, gitprovider.ReleaseInfo{TagName: "v1.1.0"}); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Releases().Create() error = %v, want ErrAlreadyExists", err)
+	}
+
+	info := release.Get()
+	info.Description = gitprovider.StringVar("Changelog")
+	if err := release.Set(info); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if err := release.Update(ctx); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	info.TagName = "v2.0.0"
+	if err := release.Set(info); !errors.Is(err, gitprovider.ErrInvalidArgument) {
+		t.Errorf("Set() error = %v, want ErrInvalidArgument", err)
+	}
+	asset, err := release.UploadAsset(ctx, "checksums.txt", strings.NewReader("abc"))
+	if err != nil {
+		t.Fatalf("UploadAsset() error = %v", err)
+	}
+	if asset.Size != 3 {
+		t.Errorf("UploadAsset().Size = %d, want 3", asset.Size)
+	}
+
+	release, err = repo.Releases().Get(ctx, "v1.1.0")
+	if err != nil {
+		t.Fatalf("Releases().Get() error = %v", err)
+	}
+	want := gitprovider.ReleaseInfo{
+		TagName:     "v1.1.0",
+		Name:        gitprovider.StringVar("v1.1.0"),
+		Description: gitprovider.StringVar("Changelog"),
+		Draft:       gitprovider.BoolVar(false),
+		Prerelease:  gitprovider.BoolVar(false),
+		Assets:      []gitprovider.ReleaseAssetInfo{asset},
+	}
+	if diff := cmp.Diff(want, release.Get()); diff != "" {
+		t.Errorf("Releases().Get() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Deleting tags and releases requires destructive API calls
+	if err := release.Delete(ctx); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("Delete() error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+	if err := repo.Tags().Delete(ctx, "v1.1.0"); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("Tags().Delete() error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+	repo, err = c.WithDestructiveAPICalls(true).OrgRepositories().Get(ctx, repoRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	release, err = repo.Releases().Get(ctx, "v1.1.0")
+	if err != nil {
+		t.Fatalf("Releases().Get() error = %v", err)
+	}
+
+	// Deleting the release keeps the tag
+	if err := release.Delete(ctx); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if releases, err := repo.Releases().List(ctx); err != nil || len(releases) != 0 {
+		t.Errorf("Releases().List() = %v, %v, want no releases", releases, err)
+	}
+	if err := repo.Tags().Delete(ctx, "v1.1.0"); err != nil {
+		t.Errorf("Tags().Delete() error = %v", err)
+	}
+	if _, err := repo.Tags().Get(ctx, "v1.1.0"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Tags().Get() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+	if _, err := repo.DeployKeys().Create(ctx, gitprovider.DeployKeyInfo{Name: "flux", Key: []byte("ssh-ed25519 AAAA")}); err != nil {
+		t.Fatal(err)
+	}
+	if plan := c.DryRunPlan(); plan != nil {
+		t.Errorf("DryRunPlan() = %v, want nil without a dry run", plan)
+	}
+
+	d := c.WithDryRun(true)
+	if _, _, err := d.OrgRepositories().Reconcile(ctx, repoRef, gitprovider.RepositoryInfo{Description: gitprovider.StringVar("Flux")}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if _, err := d.OrgRepositories().Create(ctx, repoRef, gitprovider.RepositoryInfo{}); !errors.Is(err, gitprovider.ErrAlreadyExists) {
+		t.Errorf("Create() error = %v, want ErrAlreadyExists", err)
+	}
+
+	plannedRef := gitprovider.OrgRepositoryRef{OrganizationRef: orgRef, RepositoryName: "planned"}
+	planned, err := d.OrgRepositories().Create(ctx, plannedRef, gitprovider.RepositoryInfo{})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	key := gitprovider.DeployKeyInfo{Name: "flux", Key: []byte("ssh-ed25519 BBBB")}
+	if _, err := planned.DeployKeys().Create(ctx, key); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if keys, err := planned.DeployKeys().List(ctx); err != nil || len(keys) != 0 {
+		t.Errorf("List() = %v, %v, want no deploy keys in a planned repository", keys, err)
+	}
+
+	repo, err = d.OrgRepositories().Get(ctx, repoRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Delete(ctx); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("Delete() error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+	actualKey, err := repo.DeployKeys().Get(ctx, "flux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := actualKey.Delete(ctx); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	files := []gitprovider.CommitFile{{Path: gitprovider.StringVar("README.md"), Content: gitprovider.StringVar("# Flux")}}
+	if _, err := repo.Commits().Create(ctx, "main", "Update README", files); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	plannedInfo := gitprovider.RepositoryInfo{}
+	plannedInfo.Default()
+	key.Default()
+	want := []gitprovider.PlannedOperation{
+		{Operation: "Update", Resource: "Repository", Target: repoRef.String(), Changes: []gitprovider.FieldChange{{Path: "description", New: "Flux"}}},
+		{Operation: "Create", Resource: "Repository", Target: plannedRef.String(), Changes: plannedInfo.Diff(gitprovider.RepositoryInfo{})},
+		{Operation: "Create", Resource: "DeployKey", Target: plannedRef.String(), Name: "flux", Changes: key.Diff(gitprovider.DeployKeyInfo{})},
+		{Operation: "Delete", Resource: "DeployKey", Target: repoRef.String(), Name: "flux"},
+		{Operation: "Create", Resource: "Commit", Target: repoRef.String(), Name: "main", Changes: []gitprovider.FieldChange{{Path: "README.md", New: "# Flux"}}},
+	}
+	if diff := cmp.Diff(want, d.DryRunPlan().Operations()); diff != "" {
+		t.Errorf("DryRunPlan() operations mismatch (-want +got):\n%s", diff)
+	}
+
+	// Nothing was written to the backend
+	repo, err = c.OrgRepositories().Get(ctx, repoRef)
+	if err != nil || repo.Get().Description != nil {
+		t.Errorf("Get() = %v, %v, want the repository to be unchanged", repo, err)
+	}
+	if keys, err := repo.DeployKeys().List(ctx); err != nil || len(keys) != 1 {
+		t.Errorf("List() = %v, %v, want the deploy keys to be unchanged", keys, err)
+	}
+	if commits, err := repo.Commits().ListPage(ctx, "main", 10, 0); err != nil || len(commits) != 1 {
+		t.Errorf("ListPage() = %v, %v, want the commits to be unchanged", commits, err)
+	}
+	if _, err := c.OrgRepositories().Get(ctx, plannedRef); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Get() error = %v, want ErrNotFound for the planned repository", err)
+	}
+}
+
+// rejectingValidator is a gitprovider.DryRunValidator of a provider which restricts all writes.
+type rejectingValidator struct{}
+
+func (rejectingValidator) ValidateCreateOrganization(gitprovider.OrganizationRef, gitprovider.OrganizationInfo) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func (rejectingValidator) ValidateUpdateOrganization(gitprovider.OrganizationRef, gitprovider.OrganizationInfo) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func (rejectingValidator) ValidateEnableAutoMerge(gitprovider.MergeMethod) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func (rejectingValidator) ValidateDisableAutoMerge() error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func (rejectingValidator) ValidateRepositoryInfo(gitprovider.RepositoryInfo) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+func TestDryRunRejectsFailingWrites(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	repo := newTestRepository(t, c)
+	main, err := repo.Branches().Get(ctx, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Branches().Create(ctx, "feature", main.Get().Sha); err != nil {
+		t.Fatal(err)
+	}
+	files := []gitprovider.CommitFile{{Path: gitprovider.StringVar("README.md"), Content: gitprovider.StringVar("# Flux")}}
+	head, err := repo.Commits().Create(ctx, "feature", "Update README", files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr, err := repo.PullRequests().Create(ctx, "Update README", "feature", "main", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Branches().Create(ctx, "closed", head.Get().Sha); err != nil {
+		t.Fatal(err)
+	}
+	closed, err := repo.PullRequests().Create(ctx, "Closed", "closed", "main", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.PullRequests().Edit(ctx, closed.Get().Number, gitprovider.EditOptions{State: gitprovider.PullRequestStateVar(gitprovider.PullRequestStateClosed)}); err != nil {
+		t.Fatal(err)
+	}
+
+	d := c.WithDryRun(true)
+	repo, err = d.OrgRepositories().Get(ctx, repoRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Commits().Create(ctx, "missing", "Update README", files); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Commits().Create() error = %v, want ErrNotFound for a missing branch", err)
+	}
+	if _, err := repo.PullRequests().Create(ctx, "Missing", "missing", "main", ""); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("PullRequests().Create() error = %v, want ErrNotFound for a missing branch", err)
+	}
+	// Branches created in the dry run can be committed to
+	if err := repo.Branches().Create(ctx, "planned", main.Get().Sha); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Commits().Create(ctx, "planned", "Update README", files); err != nil {
+		t.Errorf("Commits().Create() error = %v", err)
+	}
+	if _, err := repo.PullRequests().Create(ctx, "Planned", "planned", "main", ""); err != nil {
+		t.Errorf("PullRequests().Create() error = %v", err)
+	}
+
+	var conflict *gitprovider.ConflictError
+	_, err = repo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodSquash, "", &gitprovider.PullRequestMergeOptions{
+		ExpectedHeadSha: gitprovider.StringVar(main.Get().Sha),
+	})
+	if !errors.As(err, &conflict) {
+		t.Errorf("Merge() error = %v, want *ConflictError for a changed head", err)
+	}
+	if _, err := repo.PullRequests().Merge(ctx, closed.Get().Number, gitprovider.MergeMethodSquash, ""); !errors.As(err, &conflict) {
+		t.Errorf("Merge() error = %v, want *ConflictError for a closed pull request", err)
+	}
+	result, err := repo.PullRequests().Merge(ctx, pr.Get().Number, gitprovider.MergeMethodSquash, "", &gitprovider.PullRequestMergeOptions{
+		ExpectedHeadSha:    gitprovider.StringVar(head.Get().Sha),
+		DeleteSourceBranch: gitprovider.BoolVar(true),
+	})
+	if err != nil || result.Sha != head.Get().Sha {
+		t.Errorf("Merge() = %v, %v, want the head of the pull request", result, err)
+	}
+	// The source branch is deleted by the merge
+	if _, err := repo.Commits().Create(ctx, "feature", "Update README", files); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Commits().Create() error = %v, want ErrNotFound for a deleted branch", err)
+	}
+
+	// Removing team members requires destructive API calls, and the user to be a member
+	org, err := d.Organizations().Get(ctx, orgRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	team, err := org.Teams().Get(ctx, "maintainers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := team.Members().Remove(ctx, "fluxbot"); !errors.Is(err, gitprovider.ErrDestructiveCallDisallowed) {
+		t.Errorf("Members().Remove() error = %v, want ErrDestructiveCallDisallowed", err)
+	}
+	dd := c.WithDestructiveAPICalls(true).WithDryRun(true)
+	if org, err = dd.Organizations().Get(ctx, orgRef); err != nil {
+		t.Fatal(err)
+	}
+	if team, err = org.Teams().Get(ctx, "maintainers"); err != nil {
+		t.Fatal(err)
+	}
+	if err := team.Members().Remove(ctx, "alice"); !errors.Is(err, gitprovider.ErrNotFound) {
+		t.Errorf("Members().Remove() error = %v, want ErrNotFound for a user who isn't a member", err)
+	}
+	if err := team.Members().Remove(ctx, "fluxbot"); err != nil {
+		t.Errorf("Members().Remove() error = %v", err)
+	}
+	if ops := dd.DryRunPlan().Operations(); len(ops) != 1 || ops[0].Operation != "Remove" || ops[0].Name != "maintainers/fluxbot" {
+		t.Errorf("DryRunPlan() operations = %v, want only the removal of fluxbot", ops)
+	}
+
+	// Writes the provider would reject aren't planned
+	r := gitprovider.NewDryRun(false, rejectingValidator{})
+	newOrgRef := gitprovider.OrganizationRef{Domain: DefaultDomain, Organization: "new"}
+	if _, err := r.Organizations(c.Organizations()).Create(ctx, newOrgRef, gitprovider.OrganizationInfo{Name: gitprovider.StringVar("new")}); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Organizations().Create() error = %v, want ErrNoProviderSupport", err)
+	}
+	repo, err = r.OrgRepositories(c.OrgRepositories()).Get(ctx, repoRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.PullRequests().EnableAutoMerge(ctx, pr.Get().Number, gitprovider.MergeMethodSquash); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("EnableAutoMerge() error = %v, want ErrNoProviderSupport", err)
+	}
+	info := repo.Get()
+	info.Topics = []string{"flux"}
+	if err := repo.Set(info); !errors.Is(err, gitprovider.ErrNoProviderSupport) {
+		t.Errorf("Set() error = %v, want ErrNoProviderSupport", err)
+	}
+	if ops := r.Plan().Operations(); len(ops) != 0 {
+		t.Errorf("Plan() operations = %v, want none", ops)
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// diffContextLines is the number of unchanged lines shown around the changes in a hunk.
+const diffContextLines = 3
+
+// diffFiles returns the files changed between the file snapshots from and to, ordered by path.
+// Renames aren't detected, they show up as a removed and an added file.
+func diffFiles(from, to map[string]string) []gitprovider.PullRequestFile {
+	all := copyFiles(to)
+	for p := range from {
+		all[p] = ""
+	}
+	files := []gitprovider.PullRequestFile{}
+	for _, p := range sortedPaths(all) {
+		old, inFrom := from[p]
+		content, inTo := to[p]
+		if inFrom == inTo && old == content {
+			continue
+		}
+		file := gitprovider.PullRequestFile{Path: p, Status: gitprovider.PullRequestFileStatusModified}
+		switch {
+		case !inFrom:
+			file.Status = gitprovider.PullRequestFileStatusAdded
+		case !inTo:
+			file.Status = gitprovider.PullRequestFileStatusRemoved
+		}
+		file.Patch, file.Additions, file.Deletions = diffLines(old, content)
+		files = append(files, file)
+	}
+	return files
+}
+
+// unifiedDiff returns the changes of the given files in the format of "git diff".
+func unifiedDiff(files []gitprovider.PullRequestFile) string {
+	var b strings.Builder
+	for _, file := range files {
+		fmt.Fprintf(&b, "diff --git a/%s b/%s\n", file.Path, file.Path)
+		oldPath, newPath := "a/"+file.Path, "b/"+file.Path
+		switch file.Status {
+		case gitprovider.PullRequestFileStatusAdded:
+			b.WriteString("new file mode 100644\n")
+			oldPath = "/dev/null"
+		case gitprovider.PullRequestFileStatusRemoved:
+			b.WriteString("deleted file mode 100644\n")
+			newPath = "/dev/null"
+		}
+		// Added or removed empty files don't have any hunks
+		if file.Patch == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldPath, newPath)
+		b.WriteString(file.Patch)
+	}
+	return b.String()
+}
+
+// diffLines returns the hunks of the line based diff between a and b, and the number of
+// added and removed lines.
+func diffLines(a, b string) (patch string, additions, deletions int) {
+	aLines, bLines := splitLines(a), splitLines(b)
+
+	// lcs[i][j] is the length of the longest common subsequence of aLines[i:] and bLines[j:]
+	lcs := make([][]int, len(aLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bLines)+1)
+	}
+	for i := len(aLines) - 1; i >= 0; i-- {
+		for j := len(bLines) - 1; j >= 0; j-- {
+			switch {
+			case aLines[i] == bLines[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	// Walk the table to get the edit script, removing lines before adding them
+	type op struct {
+		kind byte
+		line string
+	}
+	ops := []op{}
+	for i, j := 0, 0; i < len(aLines) || j < len(bLines); {
+		switch {
+		case i < len(aLines) && j < len(bLines) && aLines[i] == bLines[j]:
+			ops = append(ops, op{' ', aLines[i]})
+			i++
+			j++
+		case i < len(aLines) && (j == len(bLines) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, op{'-', aLines[i]})
+			deletions++
+			i++
+		default:
+			ops = append(ops, op{'+', bLines[j]})
+			additions++
+			j++
+		}
+	}
+
+	// Group the changes into hunks, merging them if they are close to each other
+	var out strings.Builder
+	aLine, bLine := 0, 0
+	for k := 0; k < len(ops); {
+		first := k
+		for first < len(ops) && ops[first].kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+		end := first
+		for n := first; n < len(ops) && n-end <= 2*diffContextLines; n++ {
+			if ops[n].kind != ' ' {
+				end = n + 1
+			}
+		}
+		start := first - diffContextLines
+		if start < k {
+			start = k
+		}
+		stop := end + diffContextLines
+		if stop > len(ops) {
+			stop = len(ops)
+		}
+
+		// The lines skipped before the hunk are unchanged
+		aLine += start - k
+		bLine += start - k
+		aLen, bLen := 0, 0
+		for _, o := range ops[start:stop] {
+			if o.kind != '+' {
+				aLen++
+			}
+			if o.kind != '-' {
+				bLen++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(aLine, aLen), hunkRange(bLine, bLen))
+		for _, o := range ops[start:stop] {
+			out.WriteByte(o.kind)
+			out.WriteString(o.line)
+			if !strings.HasSuffix(o.line, "\n") {
+				out.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		aLine += aLen
+		bLine += bLen
+		k = stop
+	}
+	return out.String(), additions, deletions
+}
+
+// hunkRange formats the range of a hunk, given the number of lines before it and its length.
+func hunkRange(before, length int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", before)
+	}
+	return fmt.Sprintf("%d,%d", before+1, length)
+}
+
+// splitLines splits s into lines, keeping their line feeds. Only the last line may lack one.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"testing"
+)
+
+func Test_diffLines(t *testing.T) {
+	tests := []struct {
+		name          string
+		a, b          string
+		wantPatch     string
+		wantAdditions int
+		wantDeletions int
+	}{
+		{
+			name:          "added file",
+			a:             "",
+			b:             "a\nb\n",
+			wantPatch:     "@@ -0,0 +1,2 @@\n+a\n+b\n",
+			wantAdditions: 2,
+		},
+		{
+			name:          "removed file",
+			a:             "a\n",
+			b:             "",
+			wantPatch:     "@@ -1,1 +0,0 @@\n-a\n",
+			wantDeletions: 1,
+		},
+		{
+			name:          "missing line feed",
+			a:             "a",
+			b:             "b",
+			wantPatch:     "@@ -1,1 +1,1 @@\n-a\n\\ No newline at end of file\n+b\n\\ No newline at end of file\n",
+			wantAdditions: 1,
+			wantDeletions: 1,
+		},
+		{
+			name:          "nearby changes are in one hunk",
+			a:             "1\n2\n3\n4\n5\n6\n7\n8\n",
+			b:             "one\n2\n3\n4\n5\n6\n7\neight\n",
+			wantPatch:     "@@ -1,8 +1,8 @@\n-1\n+one\n 2\n 3\n 4\n 5\n 6\n 7\n-8\n+eight\n",
+			wantAdditions: 2,
+			wantDeletions: 2,
+		},
+		{
+			name:          "distant changes are in separate hunks",
+			a:             "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			b:             "1\ntwo\n3\n4\n5\n6\n7\n8\n9\n10\n12\n",
+			wantPatch:     "@@ -1,5 +1,5 @@\n 1\n-2\n+two\n 3\n 4\n 5\n@@ -8,5 +8,4 @@\n 8\n 9\n 10\n-11\n 12\n",
+			wantAdditions: 1,
+			wantDeletions: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, additions, deletions := diffLines(tt.a, tt.b)
+			if patch != tt.wantPatch {
+				t.Errorf("diffLines() patch = %q, want %q", patch, tt.wantPatch)
+			}
+			if additions != tt.wantAdditions || deletions != tt.wantDeletions {
+				t.Errorf("diffLines() = +%d -%d, want +%d -%d", additions, deletions, tt.wantAdditions, tt.wantDeletions)
+			}
+		})
+	}
+}
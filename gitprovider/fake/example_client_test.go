@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/fake"
+)
+
+// checkErr is used for examples in this repository.
+func checkErr(err error) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func ExampleNewClient() {
+	ctx := context.Background()
+
+	// Create a fake client, and seed it with an organization
+	c, err := fake.NewClient("fluxbot")
+	checkErr(err)
+	orgRef := gitprovider.OrganizationRef{Domain: fake.DefaultDomain, Organization: "fluxcd"}
+	checkErr(c.AddOrganization(orgRef, gitprovider.OrganizationInfo{Name: gitprovider.StringVar("Flux")}))
+
+	// Code under test only sees a gitprovider.Client
+	var client gitprovider.Client = c
+	repoRef := gitprovider.OrgRepositoryRef{OrganizationRef: orgRef, RepositoryName: "flux2"}
+	repo, actionTaken, err := client.OrgRepositories().Reconcile(ctx, repoRef, gitprovider.RepositoryInfo{
+		Description: gitprovider.StringVar("Flux v2"),
+	}, &gitprovider.RepositoryCreateOptions{AutoInit: gitprovider.BoolVar(true)})
+	checkErr(err)
+
+	files, err := repo.Files().Get(ctx, "README.md", *repo.Get().DefaultBranch)
+	checkErr(err)
+
+	fmt.Printf("Created: %t. README: %q", actionTaken, *files[0].Content)
+	// Output: Created: true. README: "# flux2\n"
+}
@@ -1,5 +1,5 @@
 /*
-Copyright 2020 The Flux CD contributors.
+Copyright 2026 The Flux CD contributors.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -14,9 +14,32 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package bitbucket
+package fake
 
 import (
-	// TODO: Dummy import until we have the implementation ready.
-	_ "github.com/ktrysmt/go-bitbucket"
+	"github.com/fluxcd/go-git-providers/gitprovider"
 )
+
+func newBranch(c *BranchClient, info gitprovider.BranchInfo) *branch {
+	return &branch{
+		b: info,
+		c: c,
+	}
+}
+
+var _ gitprovider.Branch = &branch{}
+
+type branch struct {
+	b gitprovider.BranchInfo
+	c *BranchClient
+}
+
+// Get returns the branch information.
+func (b *branch) Get() gitprovider.BranchInfo {
+	return b.b
+}
+
+// APIObject returns the stored *gitprovider.BranchInfo.
+func (b *branch) APIObject() interface{} {
+	return &b.b
+}
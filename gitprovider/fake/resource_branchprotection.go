@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newBranchProtection(c *BranchProtectionClient, info gitprovider.BranchProtectionInfo) *branchProtection {
+	return &branchProtection{
+		p:      copyBranchProtectionInfo(info),
+		branch: info.Branch,
+		c:      c,
+	}
+}
+
+var _ gitprovider.BranchProtection = &branchProtection{}
+
+type branchProtection struct {
+	p gitprovider.BranchProtectionInfo
+	// branch is the branch the protection is currently stored under, which differs from p.Branch
+	// if the branch has been changed using Set, but not yet updated.
+	branch string
+	c      *BranchProtectionClient
+}
+
+// Get returns the branch protection information.
+func (bp *branchProtection) Get() gitprovider.BranchProtectionInfo {
+	return copyBranchProtectionInfo(bp.p)
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+func (bp *branchProtection) Set(info gitprovider.BranchProtectionInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	bp.p = copyBranchProtectionInfo(info)
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.BranchProtectionInfo.
+func (bp *branchProtection) APIObject() interface{} {
+	return &bp.p
+}
+
+// Repository returns the repository reference.
+func (bp *branchProtection) Repository() gitprovider.RepositoryRef {
+	return bp.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (bp *branchProtection) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&bp.p); err != nil {
+		return err
+	}
+
+	bp.c.s.mu.Lock()
+	defer bp.c.s.mu.Unlock()
+
+	if err := bp.c.set(bp.branch, bp.p); err != nil {
+		return err
+	}
+	bp.branch = bp.p.Branch
+	return nil
+}
+
+// Delete unprotects the branch.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (bp *branchProtection) Delete(ctx context.Context) error {
+	bp.c.s.mu.Lock()
+	defer bp.c.s.mu.Unlock()
+
+	repo, err := bp.c.s.getRepo(bp.c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.branchProtections[bp.branch]; !ok {
+		return fmt.Errorf("branch protection %q: %w", bp.branch, gitprovider.ErrNotFound)
+	}
+	delete(repo.branchProtections, bp.branch)
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (bp *branchProtection) Reconcile(ctx context.Context) (bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&bp.p); err != nil {
+		return false, err
+	}
+
+	actual, err := bp.c.Get(ctx, bp.p.Branch)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			if _, err := bp.c.Create(ctx, bp.p); err != nil {
+				return true, err
+			}
+			bp.branch = bp.p.Branch
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	// If desired state already is the actual state, do nothing
+	if bp.p.Equals(actual.Get()) {
+		return false, nil
+	}
+	// Otherwise, make the desired state the actual state
+	bp.branch = bp.p.Branch
+	return true, bp.Update(ctx)
+}
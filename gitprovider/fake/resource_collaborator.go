@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newCollaborator(c *CollaboratorClient, info gitprovider.CollaboratorInfo) *collaborator {
+	return &collaborator{
+		info: copyCollaboratorInfo(info),
+		c:    c,
+	}
+}
+
+var _ gitprovider.Collaborator = &collaborator{}
+
+type collaborator struct {
+	info gitprovider.CollaboratorInfo
+	c    *CollaboratorClient
+}
+
+// Get returns the collaborator information.
+func (c *collaborator) Get() gitprovider.CollaboratorInfo {
+	return copyCollaboratorInfo(c.info)
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+func (c *collaborator) Set(info gitprovider.CollaboratorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	c.info = copyCollaboratorInfo(info)
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.CollaboratorInfo.
+func (c *collaborator) APIObject() interface{} {
+	return &c.info
+}
+
+// Repository returns the repository reference.
+func (c *collaborator) Repository() gitprovider.RepositoryRef {
+	return c.c.ref
+}
+
+// Delete removes the user's direct access to the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Delete(ctx context.Context) error {
+	c.c.s.mu.Lock()
+	defer c.c.s.mu.Unlock()
+
+	repo, err := c.c.s.getRepo(c.c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.collaborators[c.info.Login]; !ok {
+		return fmt.Errorf("collaborator %q: %w", c.info.Login, gitprovider.ErrNotFound)
+	}
+	delete(repo.collaborators, c.info.Login)
+	return nil
+}
+
+// Update will apply the desired state in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&c.info); err != nil {
+		return err
+	}
+
+	c.c.s.mu.Lock()
+	defer c.c.s.mu.Unlock()
+
+	repo, err := c.c.s.getRepo(c.c.ref)
+	if err != nil {
+		return err
+	}
+	actual, ok := repo.collaborators[c.info.Login]
+	if !ok {
+		return fmt.Errorf("collaborator %q: %w", c.info.Login, gitprovider.ErrNotFound)
+	}
+	actual.Permission = gitprovider.RepositoryPermissionVar(*c.info.Permission)
+	c.info.Pending = actual.Pending
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *collaborator) Reconcile(ctx context.Context) (bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&c.info); err != nil {
+		return false, err
+	}
+
+	actual, err := c.c.Get(ctx, c.info.Login)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.c.Create(ctx, c.info)
+			if err != nil {
+				return true, err
+			}
+			c.info = resp.Get()
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	// If desired state already is the actual state, do nothing
+	if c.info.Equals(actual.Get()) {
+		return false, nil
+	}
+	// Otherwise, make the desired state the actual state
+	return true, c.Update(ctx)
+}
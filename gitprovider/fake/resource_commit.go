@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newCommit(c *CommitClient, info gitprovider.CommitInfo) *commit {
+	return &commit{
+		k: info,
+		c: c,
+	}
+}
+
+var _ gitprovider.Commit = &commit{}
+
+type commit struct {
+	k gitprovider.CommitInfo
+	c *CommitClient
+}
+
+// Get returns the commit information.
+func (c *commit) Get() gitprovider.CommitInfo {
+	return c.k
+}
+
+// APIObject returns the stored *gitprovider.CommitInfo.
+func (c *commit) APIObject() interface{} {
+	return &c.k
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newCommitStatus(c *CommitStatusClient, info gitprovider.CommitStatusInfo) *commitStatus {
+	return &commitStatus{
+		s: info,
+		c: c,
+	}
+}
+
+var _ gitprovider.CommitStatus = &commitStatus{}
+
+type commitStatus struct {
+	s gitprovider.CommitStatusInfo
+	c *CommitStatusClient
+}
+
+// Get returns the commit status information.
+func (s *commitStatus) Get() gitprovider.CommitStatusInfo {
+	return s.s
+}
+
+// APIObject returns the stored *gitprovider.CommitStatusInfo.
+func (s *commitStatus) APIObject() interface{} {
+	return &s.s
+}
+
+// Repository returns the repository reference.
+func (s *commitStatus) Repository() gitprovider.RepositoryRef {
+	return s.c.ref
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newDeployKey(c *DeployKeyClient, info gitprovider.DeployKeyInfo) *deployKey {
+	return &deployKey{
+		k:    copyDeployKeyInfo(info),
+		name: info.Name,
+		c:    c,
+	}
+}
+
+var _ gitprovider.DeployKey = &deployKey{}
+
+type deployKey struct {
+	k gitprovider.DeployKeyInfo
+	// name is the name the key is currently stored under, which differs from k.Name
+	// if the key has been renamed using Set, but not yet updated.
+	name string
+	c    *DeployKeyClient
+}
+
+// Get returns the deploy key information.
+func (dk *deployKey) Get() gitprovider.DeployKeyInfo {
+	return copyDeployKeyInfo(dk.k)
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+func (dk *deployKey) Set(info gitprovider.DeployKeyInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	dk.k = copyDeployKeyInfo(info)
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.DeployKeyInfo.
+func (dk *deployKey) APIObject() interface{} {
+	return &dk.k
+}
+
+// Repository returns the repository reference.
+func (dk *deployKey) Repository() gitprovider.RepositoryRef {
+	return dk.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (dk *deployKey) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&dk.k); err != nil {
+		return err
+	}
+
+	dk.c.s.mu.Lock()
+	defer dk.c.s.mu.Unlock()
+
+	if err := dk.c.set(dk.name, dk.k); err != nil {
+		return err
+	}
+	dk.name = dk.k.Name
+	return nil
+}
+
+// Delete deletes a deploy key from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (dk *deployKey) Delete(ctx context.Context) error {
+	dk.c.s.mu.Lock()
+	defer dk.c.s.mu.Unlock()
+
+	repo, err := dk.c.s.getRepo(dk.c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.deployKeys[dk.name]; !ok {
+		return fmt.Errorf("deploy key %q: %w", dk.name, gitprovider.ErrNotFound)
+	}
+	delete(repo.deployKeys, dk.name)
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (dk *deployKey) Reconcile(ctx context.Context) (bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&dk.k); err != nil {
+		return false, err
+	}
+
+	actual, err := dk.c.Get(ctx, dk.k.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			if _, err := dk.c.Create(ctx, dk.k); err != nil {
+				return true, err
+			}
+			dk.name = dk.k.Name
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	// If desired state already is the actual state, do nothing
+	if dk.k.Equals(actual.Get()) {
+		return false, nil
+	}
+	// Otherwise, make the desired state the actual state
+	dk.name = dk.k.Name
+	return true, dk.Update(ctx)
+}
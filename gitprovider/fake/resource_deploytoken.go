@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newDeployToken(c *DeployTokenClient, info gitprovider.DeployTokenInfo) *deployToken {
+	return &deployToken{
+		k:    info,
+		name: info.Name,
+		c:    c,
+	}
+}
+
+var _ gitprovider.DeployToken = &deployToken{}
+
+type deployToken struct {
+	k gitprovider.DeployTokenInfo
+	// name is the name the token is currently stored under.
+	name string
+	c    *DeployTokenClient
+}
+
+// Get returns the deploy token information.
+func (dk *deployToken) Get() gitprovider.DeployTokenInfo {
+	return dk.k
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+func (dk *deployToken) Set(info gitprovider.DeployTokenInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	dk.k = info
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.DeployTokenInfo.
+func (dk *deployToken) APIObject() interface{} {
+	return &dk.k
+}
+
+// Repository returns the repository reference.
+func (dk *deployToken) Repository() gitprovider.RepositoryRef {
+	return dk.c.ref
+}
+
+// Update deletes the deploy token and creates it again with the desired state,
+// which generates a new token value.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (dk *deployToken) Update(ctx context.Context) error {
+	dk.c.s.mu.Lock()
+	defer dk.c.s.mu.Unlock()
+
+	if err := dk.delete(); err != nil {
+		return err
+	}
+	return dk.createIntoSelf()
+}
+
+// Delete deletes a deploy token from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (dk *deployToken) Delete(ctx context.Context) error {
+	dk.c.s.mu.Lock()
+	defer dk.c.s.mu.Unlock()
+
+	return dk.delete()
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// The deploy token value cannot be read back, consequently the deploy token is
+// (re-)created in every reconcile call.
+func (dk *deployToken) Reconcile(ctx context.Context) (bool, error) {
+	dk.c.s.mu.Lock()
+	defer dk.c.s.mu.Unlock()
+
+	// Ignore a missing token, it'll be created below
+	if err := dk.delete(); err != nil && !errors.Is(err, gitprovider.ErrNotFound) {
+		return false, err
+	}
+	return true, dk.createIntoSelf()
+}
+
+// delete removes the stored token. The caller must hold the store lock.
+func (dk *deployToken) delete() error {
+	repo, err := dk.c.s.getRepo(dk.c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.deployTokens[dk.name]; !ok {
+		return fmt.Errorf("deploy token %q: %w", dk.name, gitprovider.ErrNotFound)
+	}
+	delete(repo.deployTokens, dk.name)
+	return nil
+}
+
+// createIntoSelf stores the desired state as a new token. The caller must hold the store lock.
+func (dk *deployToken) createIntoSelf() error {
+	info, err := dk.c.create(dk.k)
+	if err != nil {
+		return err
+	}
+	dk.k = info
+	dk.name = info.Name
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssue(c *IssueClient, info gitprovider.IssueInfo) *issue {
+	return &issue{
+		i: info,
+		comments: &IssueCommentClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        info.Number,
+		},
+	}
+}
+
+var _ gitprovider.Issue = &issue{}
+
+type issue struct {
+	i gitprovider.IssueInfo
+
+	comments *IssueCommentClient
+}
+
+// Get returns the issue information.
+func (i *issue) Get() gitprovider.IssueInfo {
+	return i.i
+}
+
+// APIObject returns the stored *gitprovider.IssueInfo.
+func (i *issue) APIObject() interface{} {
+	return &i.i
+}
+
+// Comments gives access to the comments of this issue.
+func (i *issue) Comments() gitprovider.IssueCommentClient {
+	return i.comments
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newIssueComment(c *IssueCommentClient, info gitprovider.IssueCommentInfo) *issueComment {
+	return &issueComment{
+		ic: info,
+		c:  c,
+	}
+}
+
+var _ gitprovider.IssueComment = &issueComment{}
+
+type issueComment struct {
+	ic gitprovider.IssueCommentInfo
+	c  *IssueCommentClient
+}
+
+// Get returns the comment information.
+func (ic *issueComment) Get() gitprovider.IssueCommentInfo {
+	return ic.ic
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+//
+// ErrInvalidArgument is returned if the ID is changed, as it identifies the comment.
+func (ic *issueComment) Set(info gitprovider.IssueCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.ID != 0 && info.ID != ic.ic.ID {
+		return fmt.Errorf("cannot change the ID of comment %d: %w", ic.ic.ID, gitprovider.ErrInvalidArgument)
+	}
+	ic.ic.Body = info.Body
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.IssueCommentInfo.
+func (ic *issueComment) APIObject() interface{} {
+	return &ic.ic
+}
+
+// Update will apply the desired state in this object to the server.
+// Only the body of a comment can be changed.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (ic *issueComment) Update(_ context.Context) error {
+	if err := ic.ic.ValidateInfo(); err != nil {
+		return err
+	}
+
+	ic.c.s.mu.Lock()
+	defer ic.c.s.mu.Unlock()
+
+	info, err := ic.c.set(ic.ic)
+	if err != nil {
+		return err
+	}
+	ic.ic = info
+	return nil
+}
+
+// Delete deletes the comment from the issue.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (ic *issueComment) Delete(_ context.Context) error {
+	ic.c.s.mu.Lock()
+	defer ic.c.s.mu.Unlock()
+
+	return ic.c.delete(ic.ic.ID)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newLabel(c *LabelClient, info gitprovider.LabelInfo) *label {
+	return &label{
+		l:    info,
+		name: info.Name,
+		c:    c,
+	}
+}
+
+var _ gitprovider.Label = &label{}
+
+type label struct {
+	l gitprovider.LabelInfo
+	// name is the name the label is currently stored under, which differs from l.Name
+	// if the label has been renamed using Set, but not yet updated.
+	name string
+	c    *LabelClient
+}
+
+// Get returns the label information.
+func (l *label) Get() gitprovider.LabelInfo {
+	return l.l
+}
+
+// Set sets the desired state of this object. Changing the name renames the label.
+// User have to call Update() to apply the changes to the server.
+func (l *label) Set(info gitprovider.LabelInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	l.l = info
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.LabelInfo.
+func (l *label) APIObject() interface{} {
+	return &l.l
+}
+
+// Repository returns the repository reference.
+func (l *label) Repository() gitprovider.RepositoryRef {
+	return l.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist, and ErrAlreadyExists if it's renamed
+// to the name of another label.
+func (l *label) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&l.l); err != nil {
+		return err
+	}
+
+	l.c.s.mu.Lock()
+	defer l.c.s.mu.Unlock()
+
+	if err := l.c.set(l.name, l.l); err != nil {
+		return err
+	}
+	l.name = l.l.Name
+	return nil
+}
+
+// Delete deletes the label from the repository.
+//
+// ErrDestructiveCallDisallowed is returned unless destructive API calls are enabled.
+// ErrNotFound is returned if the resource does not exist.
+func (l *label) Delete(ctx context.Context) error {
+	if !l.c.destructiveActions {
+		return fmt.Errorf("cannot delete label: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+
+	l.c.s.mu.Lock()
+	defer l.c.s.mu.Unlock()
+
+	repo, err := l.c.s.getRepo(l.c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.labels[l.name]; !ok {
+		return fmt.Errorf("label %q: %w", l.name, gitprovider.ErrNotFound)
+	}
+	delete(repo.labels, l.name)
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (l *label) Reconcile(ctx context.Context) (bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&l.l); err != nil {
+		return false, err
+	}
+
+	actual, err := l.c.Get(ctx, l.l.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			if _, err := l.c.Create(ctx, l.l); err != nil {
+				return true, err
+			}
+			l.name = l.l.Name
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	// If desired state already is the actual state, do nothing
+	if l.l.Equals(actual.Get()) {
+		return false, nil
+	}
+	// Otherwise, make the desired state the actual state
+	l.name = l.l.Name
+	return true, l.Update(ctx)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newOrganization(ctx *clientContext, info gitprovider.OrganizationInfo, ref gitprovider.OrganizationRef) *organization {
+	return &organization{
+		clientContext: ctx,
+		o:             info,
+		ref:           ref,
+		teams: &TeamsClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+	}
+}
+
+var _ gitprovider.Organization = &organization{}
+
+type organization struct {
+	*clientContext
+
+	o   gitprovider.OrganizationInfo
+	ref gitprovider.OrganizationRef
+
+	teams *TeamsClient
+}
+
+// Get returns the organization information.
+func (o *organization) Get() gitprovider.OrganizationInfo {
+	return o.o
+}
+
+// APIObject returns the stored *gitprovider.OrganizationInfo.
+func (o *organization) APIObject() interface{} {
+	return &o.o
+}
+
+// Organization returns the organization reference.
+func (o *organization) Organization() gitprovider.OrganizationRef {
+	return o.ref
+}
+
+// Teams gives access to the TeamsClient for this specific organization.
+func (o *organization) Teams() gitprovider.TeamsClient {
+	return o.teams
+}
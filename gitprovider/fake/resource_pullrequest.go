@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newPullRequest(c *PullRequestClient, info gitprovider.PullRequestInfo) *pullrequest {
+	return &pullrequest{
+		pr: info,
+		c:  c,
+		comments: &PullRequestCommentClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        info.Number,
+		},
+		reviews: &PullRequestReviewClient{
+			clientContext: c.clientContext,
+			ref:           c.ref,
+			number:        info.Number,
+		},
+	}
+}
+
+var _ gitprovider.PullRequest = &pullrequest{}
+
+type pullrequest struct {
+	pr gitprovider.PullRequestInfo
+	c  *PullRequestClient
+
+	comments *PullRequestCommentClient
+	reviews  *PullRequestReviewClient
+}
+
+// Get returns the pull request information.
+func (pr *pullrequest) Get() gitprovider.PullRequestInfo {
+	return pr.pr
+}
+
+// APIObject returns the stored *gitprovider.PullRequestInfo.
+func (pr *pullrequest) APIObject() interface{} {
+	return &pr.pr
+}
+
+// Comments gives access to the comments in the conversation of this pull request.
+func (pr *pullrequest) Comments() gitprovider.PullRequestCommentClient {
+	return pr.comments
+}
+
+// Reviews gives access to the reviews of this pull request.
+func (pr *pullrequest) Reviews() gitprovider.PullRequestReviewClient {
+	return pr.reviews
+}
+
+// Files returns the files changed on the source branch since its merge base with the target
+// branch, ordered by path. The result is never truncated, and renames aren't detected.
+//
+// ErrNotFound is returned if the source branch of the open pull request doesn't exist anymore.
+func (pr *pullrequest) Files(_ context.Context) (*gitprovider.PullRequestFiles, error) {
+	files, err := pr.c.changedFiles(pr.pr.Number)
+	if err != nil {
+		return nil, err
+	}
+	return &gitprovider.PullRequestFiles{Files: files}, nil
+}
+
+// Diff returns the changes of Files as a raw unified diff, in the format of "git diff".
+func (pr *pullrequest) Diff(_ context.Context) (string, error) {
+	files, err := pr.c.changedFiles(pr.pr.Number)
+	if err != nil {
+		return "", err
+	}
+	return unifiedDiff(files), nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newPullRequestComment(c *PullRequestCommentClient, info gitprovider.PullRequestCommentInfo) *pullRequestComment {
+	return &pullRequestComment{
+		pc: info,
+		c:  c,
+	}
+}
+
+var _ gitprovider.PullRequestComment = &pullRequestComment{}
+
+type pullRequestComment struct {
+	pc gitprovider.PullRequestCommentInfo
+	c  *PullRequestCommentClient
+}
+
+// Get returns the comment information.
+func (pc *pullRequestComment) Get() gitprovider.PullRequestCommentInfo {
+	return pc.pc
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+//
+// ErrInvalidArgument is returned if the ID is changed, as it identifies the comment.
+func (pc *pullRequestComment) Set(info gitprovider.PullRequestCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.ID != 0 && info.ID != pc.pc.ID {
+		return fmt.Errorf("cannot change the ID of comment %d: %w", pc.pc.ID, gitprovider.ErrInvalidArgument)
+	}
+	pc.pc.Body = info.Body
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.PullRequestCommentInfo.
+func (pc *pullRequestComment) APIObject() interface{} {
+	return &pc.pc
+}
+
+// Update will apply the desired state in this object to the server.
+// Only the body of a comment can be changed.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (pc *pullRequestComment) Update(_ context.Context) error {
+	if err := pc.pc.ValidateInfo(); err != nil {
+		return err
+	}
+
+	pc.c.s.mu.Lock()
+	defer pc.c.s.mu.Unlock()
+
+	info, err := pc.c.set(pc.pc)
+	if err != nil {
+		return err
+	}
+	pc.pc = info
+	return nil
+}
+
+// Delete deletes the comment from the pull request.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (pc *pullRequestComment) Delete(_ context.Context) error {
+	pc.c.s.mu.Lock()
+	defer pc.c.s.mu.Unlock()
+
+	return pc.c.delete(pc.pc.ID)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newPullRequestReview(info gitprovider.PullRequestReviewInfo) *pullRequestReview {
+	return &pullRequestReview{
+		pr: copyPullRequestReviewInfo(info),
+	}
+}
+
+var _ gitprovider.PullRequestReview = &pullRequestReview{}
+
+type pullRequestReview struct {
+	pr gitprovider.PullRequestReviewInfo
+}
+
+// Get returns the review information.
+func (pr *pullRequestReview) Get() gitprovider.PullRequestReviewInfo {
+	return copyPullRequestReviewInfo(pr.pr)
+}
+
+// APIObject returns the stored *gitprovider.PullRequestReviewInfo.
+func (pr *pullRequestReview) APIObject() interface{} {
+	return &pr.pr
+}
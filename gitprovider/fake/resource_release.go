@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newRelease(c *ReleaseClient, info gitprovider.ReleaseInfo) *release {
+	return &release{
+		r: copyReleaseInfo(info),
+		c: c,
+	}
+}
+
+var _ gitprovider.Release = &release{}
+
+type release struct {
+	r gitprovider.ReleaseInfo
+	c *ReleaseClient
+}
+
+// Get returns the release information.
+func (r *release) Get() gitprovider.ReleaseInfo {
+	return copyReleaseInfo(r.r)
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+//
+// ErrInvalidArgument is returned if the tag name is changed, as it identifies the release.
+func (r *release) Set(info gitprovider.ReleaseInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.TagName != r.r.TagName {
+		return fmt.Errorf("cannot change the tag of release %q: %w", r.r.TagName, gitprovider.ErrInvalidArgument)
+	}
+	r.r = copyReleaseInfo(info)
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.ReleaseInfo.
+func (r *release) APIObject() interface{} {
+	return &r.r
+}
+
+// Repository returns the repository reference.
+func (r *release) Repository() gitprovider.RepositoryRef {
+	return r.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (r *release) Update(_ context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&r.r); err != nil {
+		return err
+	}
+
+	r.c.s.mu.Lock()
+	defer r.c.s.mu.Unlock()
+
+	info, err := r.c.set(r.r)
+	if err != nil {
+		return err
+	}
+	r.r = info
+	return nil
+}
+
+// Delete deletes the release from the repository irreversibly, the tag is kept.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource does not exist.
+func (r *release) Delete(_ context.Context) error {
+	if !r.c.destructiveActions {
+		return fmt.Errorf("cannot delete release: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+
+	r.c.s.mu.Lock()
+	defer r.c.s.mu.Unlock()
+
+	repo, err := r.c.s.getRepo(r.c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.releases[r.r.TagName]; !ok {
+		return fmt.Errorf("release %q: %w", r.r.TagName, gitprovider.ErrNotFound)
+	}
+	delete(repo.releases, r.r.TagName)
+	return nil
+}
+
+// UploadAsset attaches the content to the release as a file with the given name.
+//
+// ErrAlreadyExists is returned if the release already has an asset with the given name.
+func (r *release) UploadAsset(_ context.Context, name string, content io.Reader) (gitprovider.ReleaseAssetInfo, error) {
+	if name == "" {
+		return gitprovider.ReleaseAssetInfo{}, fmt.Errorf("asset name must not be empty: %w", gitprovider.ErrInvalidArgument)
+	}
+	b, err := io.ReadAll(content)
+	if err != nil {
+		return gitprovider.ReleaseAssetInfo{}, err
+	}
+
+	r.c.s.mu.Lock()
+	defer r.c.s.mu.Unlock()
+
+	repo, err := r.c.s.getRepo(r.c.ref)
+	if err != nil {
+		return gitprovider.ReleaseAssetInfo{}, err
+	}
+	actual, ok := repo.releases[r.r.TagName]
+	if !ok {
+		return gitprovider.ReleaseAssetInfo{}, fmt.Errorf("release %q: %w", r.r.TagName, gitprovider.ErrNotFound)
+	}
+	for _, asset := range actual.Assets {
+		if asset.Name == name {
+			return gitprovider.ReleaseAssetInfo{}, fmt.Errorf("asset %q: %w", name, gitprovider.ErrAlreadyExists)
+		}
+	}
+	asset := gitprovider.ReleaseAssetInfo{
+		Name: name,
+		URL:  fmt.Sprintf("%s/releases/download/%s/%s", r.c.ref.String(), r.r.TagName, name),
+		Size: int64(len(b)),
+	}
+	actual.Assets = append(actual.Assets, asset)
+	r.r.Assets = append(r.r.Assets, asset)
+	return asset, nil
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newUserRepository(ctx *clientContext, info gitprovider.RepositoryInfo, ref gitprovider.RepositoryRef) *userRepository {
+	return &userRepository{
+		clientContext: ctx,
+		r:             info,
+		ref:           ref,
+		collaborators: &CollaboratorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		deployKeys: &DeployKeyClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		deployTokens: &DeployTokenClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		commits: &CommitClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		branches: &BranchClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		pullRequests: &PullRequestClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		issues: &IssueClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		labels: &LabelClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		files: &FileClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		trees: &TreeClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		webhooks: &WebhookClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		commitStatuses: &CommitStatusClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		branchProtections: &BranchProtectionClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		tags: &TagClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		releases: &ReleaseClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+	}
+}
+
+var _ gitprovider.UserRepository = &userRepository{}
+
+type userRepository struct {
+	*clientContext
+
+	r   gitprovider.RepositoryInfo
+	ref gitprovider.RepositoryRef
+
+	collaborators     *CollaboratorClient
+	deployKeys        *DeployKeyClient
+	deployTokens      *DeployTokenClient
+	commits           *CommitClient
+	branches          *BranchClient
+	pullRequests      *PullRequestClient
+	issues            *IssueClient
+	labels            *LabelClient
+	files             *FileClient
+	trees             *TreeClient
+	webhooks          *WebhookClient
+	commitStatuses    *CommitStatusClient
+	branchProtections *BranchProtectionClient
+	tags              *TagClient
+	releases          *ReleaseClient
+}
+
+// Get returns the repository information.
+func (r *userRepository) Get() gitprovider.RepositoryInfo {
+	return copyRepositoryInfo(r.r)
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+func (r *userRepository) Set(info gitprovider.RepositoryInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	r.r = copyRepositoryInfo(mergeRepositorySettings(info, r.r))
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.RepositoryInfo.
+func (r *userRepository) APIObject() interface{} {
+	return &r.r
+}
+
+// Repository returns the repository reference.
+func (r *userRepository) Repository() gitprovider.RepositoryRef {
+	return r.ref
+}
+
+// Collaborators gives access to manipulating the users with direct access to this specific repository.
+func (r *userRepository) Collaborators() gitprovider.CollaboratorClient {
+	return r.collaborators
+}
+
+// DeployKeys gives access to manipulating deploy keys to access this specific repository.
+func (r *userRepository) DeployKeys() gitprovider.DeployKeyClient {
+	return r.deployKeys
+}
+
+// DeployTokens gives access to manipulating deploy tokens to access this specific repository.
+func (r *userRepository) DeployTokens() (gitprovider.DeployTokenClient, error) {
+	return r.deployTokens, nil
+}
+
+// Commits gives access to this specific repository commits.
+func (r *userRepository) Commits() gitprovider.CommitClient {
+	return r.commits
+}
+
+// Branches gives access to this specific repository branches.
+func (r *userRepository) Branches() gitprovider.BranchClient {
+	return r.branches
+}
+
+// PullRequests gives access to this specific repository pull requests.
+func (r *userRepository) PullRequests() gitprovider.PullRequestClient {
+	return r.pullRequests
+}
+
+// Issues gives access to this specific repository issues.
+func (r *userRepository) Issues() gitprovider.IssueClient {
+	return r.issues
+}
+
+// Labels gives access to this specific repository labels.
+func (r *userRepository) Labels() gitprovider.LabelClient {
+	return r.labels
+}
+
+// Files gives access to this specific repository files.
+func (r *userRepository) Files() gitprovider.FileClient {
+	return r.files
+}
+
+// Trees gives access to this specific repository trees.
+func (r *userRepository) Trees() gitprovider.TreeClient {
+	return r.trees
+}
+
+// Webhooks gives access to this specific repository webhooks.
+func (r *userRepository) Webhooks() gitprovider.WebhookClient {
+	return r.webhooks
+}
+
+// CommitStatuses gives access to this specific repository commit statuses.
+func (r *userRepository) CommitStatuses() gitprovider.CommitStatusClient {
+	return r.commitStatuses
+}
+
+// BranchProtections gives access to this specific repository branch protections.
+func (r *userRepository) BranchProtections() gitprovider.BranchProtectionClient {
+	return r.branchProtections
+}
+
+// Tags gives access to this specific repository tags.
+func (r *userRepository) Tags() gitprovider.TagClient {
+	return r.tags
+}
+
+// Releases gives access to this specific repository releases.
+func (r *userRepository) Releases() gitprovider.ReleaseClient {
+	return r.releases
+}
+
+// Update will apply the desired state in this object to the server.
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a *gitprovider.RepositoryInfo and set fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the stored data.
+func (r *userRepository) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&r.r); err != nil {
+		return err
+	}
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	repo, err := r.s.getRepo(r.ref)
+	if err != nil {
+		return err
+	}
+	// Like the real providers, only allow switching to an existing branch once there are any
+	if _, ok := repo.branches[*r.r.DefaultBranch]; !ok && len(repo.branches) > 0 {
+		return fmt.Errorf("default branch %q doesn't exist: %w", *r.r.DefaultBranch, gitprovider.ErrInvalidArgument)
+	}
+	repo.info = copyRepositoryInfo(r.r)
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the stored data if actionTaken == true.
+func (r *userRepository) Reconcile(ctx context.Context) (bool, error) {
+	r.s.mu.Lock()
+	repo, err := r.s.getRepo(r.ref)
+	var actual gitprovider.RepositoryInfo
+	if err == nil {
+		actual = copyRepositoryInfo(repo.info)
+	}
+	r.s.mu.Unlock()
+
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			info, err := createRepository(r.clientContext, r.ref, r.r)
+			if err != nil {
+				return true, err
+			}
+			r.r = info
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	// If desired state already is the actual state, do nothing
+	if r.r.Equals(actual) {
+		return false, nil
+	}
+	// Otherwise, make the desired state the actual state
+	return true, r.Update(ctx)
+}
+
+// Delete deletes the current resource irreversibly.
+//
+// ErrNotFound is returned if the resource doesn't exist anymore.
+func (r *userRepository) Delete(ctx context.Context) error {
+	if !r.destructiveActions {
+		return fmt.Errorf("cannot delete repository: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if _, err := r.s.getRepo(r.ref); err != nil {
+		return err
+	}
+	delete(r.s.repos, repoKey(r.ref))
+	return nil
+}
+
+func newOrgRepository(ctx *clientContext, info gitprovider.RepositoryInfo, ref gitprovider.RepositoryRef) *orgRepository {
+	return &orgRepository{
+		userRepository: *newUserRepository(ctx, info, ref),
+		teamAccess: &TeamAccessClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+	}
+}
+
+var _ gitprovider.OrgRepository = &orgRepository{}
+
+type orgRepository struct {
+	userRepository
+
+	teamAccess *TeamAccessClient
+}
+
+// TeamAccess returns a TeamsAccessClient for operating on teams' access to this specific repository.
+func (r *orgRepository) TeamAccess() gitprovider.TeamAccessClient {
+	return r.teamAccess
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newTag(c *TagClient, info gitprovider.TagInfo) *tag {
+	return &tag{
+		t: info,
+		c: c,
+	}
+}
+
+var _ gitprovider.Tag = &tag{}
+
+type tag struct {
+	t gitprovider.TagInfo
+	c *TagClient
+}
+
+// Get returns the tag information.
+func (t *tag) Get() gitprovider.TagInfo {
+	return t.t
+}
+
+// APIObject returns the stored *gitprovider.TagInfo.
+func (t *tag) APIObject() interface{} {
+	return &t.t
+}
+
+// Repository returns the repository reference.
+func (t *tag) Repository() gitprovider.RepositoryRef {
+	return t.c.ref
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newTeam(c *TeamsClient, info gitprovider.TeamInfo) *team {
+	return &team{
+		t: copyTeamInfo(info),
+		c: c,
+	}
+}
+
+var _ gitprovider.Team = &team{}
+
+type team struct {
+	t gitprovider.TeamInfo
+	c *TeamsClient
+}
+
+// Get returns the team information.
+func (t *team) Get() gitprovider.TeamInfo {
+	return t.t
+}
+
+// Set sets the desired state of this object. The name of the team can't be changed.
+// User have to call Update() to apply the changes to the server.
+func (t *team) Set(info gitprovider.TeamInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.Name != t.t.Name {
+		return fmt.Errorf("cannot rename team %q: %w", t.t.Name, gitprovider.ErrInvalidArgument)
+	}
+	t.t = info
+	return nil
+}
+
+// Members gives access to the members of this specific team.
+func (t *team) Members() gitprovider.TeamMemberClient {
+	return &TeamMemberClient{
+		clientContext: t.c.clientContext,
+		ref:           t.c.ref,
+		team:          t.t.Name,
+	}
+}
+
+// APIObject returns the stored *gitprovider.TeamInfo.
+func (t *team) APIObject() interface{} {
+	return &t.t
+}
+
+// Organization returns the reference of the organization the team belongs to.
+func (t *team) Organization() gitprovider.OrganizationRef {
+	return t.c.ref
+}
+
+// Update will apply the desired description and privacy in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (t *team) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&t.t); err != nil {
+		return err
+	}
+
+	t.c.s.mu.Lock()
+	defer t.c.s.mu.Unlock()
+
+	org, err := t.c.getOrganization()
+	if err != nil {
+		return err
+	}
+	stored, ok := org.teams[t.t.Name]
+	if !ok {
+		return fmt.Errorf("team %q: %w", t.t.Name, gitprovider.ErrNotFound)
+	}
+	stored.Description = copyStringPtr(t.t.Description)
+	stored.Privacy = gitprovider.TeamPrivacyVar(*t.t.Privacy)
+	t.t = copyTeamInfo(*stored)
+	return nil
+}
+
+// Delete deletes the team from the organization.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource does not exist.
+func (t *team) Delete(ctx context.Context) error {
+	if !t.c.destructiveActions {
+		return fmt.Errorf("cannot delete team: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+
+	t.c.s.mu.Lock()
+	defer t.c.s.mu.Unlock()
+
+	org, err := t.c.getOrganization()
+	if err != nil {
+		return err
+	}
+	if _, ok := org.teams[t.t.Name]; !ok {
+		return fmt.Errorf("team %q: %w", t.t.Name, gitprovider.ErrNotFound)
+	}
+	delete(org.teams, t.t.Name)
+	delete(org.maintainers, t.t.Name)
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (t *team) Reconcile(ctx context.Context) (bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&t.t); err != nil {
+		return false, err
+	}
+
+	actual, err := t.c.Get(ctx, t.t.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := t.c.Create(ctx, t.t)
+			if err != nil {
+				return true, err
+			}
+			t.t = resp.Get()
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	// If desired state already is the actual state, do nothing
+	if t.t.Equals(actual.Get()) {
+		return false, nil
+	}
+	// Otherwise, make the desired state the actual state
+	return true, t.Update(ctx)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newTeamAccess(c *TeamAccessClient, ta gitprovider.TeamAccessInfo) *teamAccess {
+	return &teamAccess{
+		ta: copyTeamAccessInfo(ta),
+		c:  c,
+	}
+}
+
+var _ gitprovider.TeamAccess = &teamAccess{}
+
+type teamAccess struct {
+	ta gitprovider.TeamAccessInfo
+	c  *TeamAccessClient
+}
+
+// Get returns the team access information.
+func (ta *teamAccess) Get() gitprovider.TeamAccessInfo {
+	return copyTeamAccessInfo(ta.ta)
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+func (ta *teamAccess) Set(info gitprovider.TeamAccessInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	ta.ta = copyTeamAccessInfo(info)
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.TeamAccessInfo.
+func (ta *teamAccess) APIObject() interface{} {
+	return &ta.ta
+}
+
+// Repository returns the repository reference.
+func (ta *teamAccess) Repository() gitprovider.RepositoryRef {
+	return ta.c.ref
+}
+
+// Delete removes the team from the repository's team access control list.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (ta *teamAccess) Delete(ctx context.Context) error {
+	ta.c.s.mu.Lock()
+	defer ta.c.s.mu.Unlock()
+
+	repo, err := ta.c.s.getRepo(ta.c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.teamAccess[ta.ta.Name]; !ok {
+		return fmt.Errorf("team access %q: %w", ta.ta.Name, gitprovider.ErrNotFound)
+	}
+	delete(repo.teamAccess, ta.ta.Name)
+	return nil
+}
+
+// Update will apply the desired state in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (ta *teamAccess) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&ta.ta); err != nil {
+		return err
+	}
+
+	ta.c.s.mu.Lock()
+	defer ta.c.s.mu.Unlock()
+
+	repo, err := ta.c.s.getRepo(ta.c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.teamAccess[ta.ta.Name]; !ok {
+		return fmt.Errorf("team access %q: %w", ta.ta.Name, gitprovider.ErrNotFound)
+	}
+	return ta.c.set(ta.ta)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (ta *teamAccess) Reconcile(ctx context.Context) (bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&ta.ta); err != nil {
+		return false, err
+	}
+
+	actual, err := ta.c.Get(ctx, ta.ta.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			_, err := ta.c.Create(ctx, ta.ta)
+			return true, err
+		}
+
+		return false, err
+	}
+
+	// If desired state already is the actual state, do nothing
+	if ta.ta.Equals(actual.Get()) {
+		return false, nil
+	}
+	// Otherwise, make the desired state the actual state
+	return true, ta.Update(ctx)
+}
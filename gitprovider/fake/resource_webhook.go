@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newWebhook(c *WebhookClient, info gitprovider.WebhookInfo) *webhook {
+	// Like the real providers, never hand out the secret
+	info.Secret = nil
+	return &webhook{
+		h:   copyWebhookInfo(info),
+		url: info.URL,
+		c:   c,
+	}
+}
+
+var _ gitprovider.Webhook = &webhook{}
+
+type webhook struct {
+	h gitprovider.WebhookInfo
+	// url is the URL the webhook is currently stored under, which differs from h.URL
+	// if the URL has been changed using Set, but not yet updated.
+	url string
+	c   *WebhookClient
+}
+
+// Get returns the webhook information. The secret is never returned.
+func (wh *webhook) Get() gitprovider.WebhookInfo {
+	info := copyWebhookInfo(wh.h)
+	info.Secret = nil
+	return info
+}
+
+// Set sets the desired state of this object.
+// User have to call Update() to apply the changes to the server.
+func (wh *webhook) Set(info gitprovider.WebhookInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	wh.h = copyWebhookInfo(info)
+	return nil
+}
+
+// APIObject returns the stored *gitprovider.WebhookInfo.
+func (wh *webhook) APIObject() interface{} {
+	return &wh.h
+}
+
+// Repository returns the repository reference.
+func (wh *webhook) Repository() gitprovider.RepositoryRef {
+	return wh.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (wh *webhook) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&wh.h); err != nil {
+		return err
+	}
+
+	wh.c.s.mu.Lock()
+	defer wh.c.s.mu.Unlock()
+
+	if err := wh.c.set(wh.url, wh.h); err != nil {
+		return err
+	}
+	wh.url = wh.h.URL
+	wh.h.Secret = nil
+	return nil
+}
+
+// Delete deletes a webhook from the repository.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (wh *webhook) Delete(ctx context.Context) error {
+	wh.c.s.mu.Lock()
+	defer wh.c.s.mu.Unlock()
+
+	repo, err := wh.c.s.getRepo(wh.c.ref)
+	if err != nil {
+		return err
+	}
+	if _, ok := repo.webhooks[wh.url]; !ok {
+		return fmt.Errorf("webhook %q: %w", wh.url, gitprovider.ErrNotFound)
+	}
+	delete(repo.webhooks, wh.url)
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (wh *webhook) Reconcile(ctx context.Context) (bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&wh.h); err != nil {
+		return false, err
+	}
+
+	actual, err := wh.c.Get(ctx, wh.h.URL)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			if _, err := wh.c.Create(ctx, wh.h); err != nil {
+				return true, err
+			}
+			wh.url = wh.h.URL
+			wh.h.Secret = nil
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	// If desired state already is the actual state, do nothing
+	if wh.h.Equals(actual.Get()) {
+		return false, nil
+	}
+	// Otherwise, make the desired state the actual state
+	wh.url = wh.h.URL
+	return true, wh.Update(ctx)
+}
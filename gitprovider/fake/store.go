@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// blobMode is the Git mode of regular files.
+	blobMode = "100644"
+	// treeMode is the Git mode of directories.
+	treeMode = "040000"
+)
+
+// store is the in-memory state shared by every sub-client of a Client.
+// All access to it must happen while holding mu.
+type store struct {
+	mu sync.Mutex
+
+	// orgs is keyed by the organization identity, e.g. "fluxcd/engineering".
+	orgs map[string]*orgRecord
+	// repos is keyed by the repository identity and name, e.g. "fluxcd/flux2".
+	repos map[string]*repoRecord
+	// seq makes generated SHAs, IDs and tokens unique.
+	seq int
+}
+
+func newStore() *store {
+	return &store{
+		orgs:  map[string]*orgRecord{},
+		repos: map[string]*repoRecord{},
+	}
+}
+
+type orgRecord struct {
+	ref   gitprovider.OrganizationRef
+	info  gitprovider.OrganizationInfo
+	teams map[string]*gitprovider.TeamInfo
+	// maintainers is keyed by team name, and holds the logins of the members of
+	// the team that are maintainers.
+	maintainers map[string]map[string]struct{}
+}
+
+type repoRecord struct {
+	ref  gitprovider.RepositoryRef
+	info gitprovider.RepositoryInfo
+
+	deployKeys   map[string]*gitprovider.DeployKeyInfo
+	deployTokens map[string]*gitprovider.DeployTokenInfo
+	teamAccess   map[string]*gitprovider.TeamAccessInfo
+	// collaborators is keyed by login.
+	collaborators map[string]*gitprovider.CollaboratorInfo
+	// webhooks is keyed by URL.
+	webhooks map[string]*gitprovider.WebhookInfo
+	// labels is keyed by name.
+	labels map[string]*gitprovider.LabelInfo
+	// branchProtections is keyed by branch name.
+	branchProtections map[string]*gitprovider.BranchProtectionInfo
+	// commitStatuses is keyed by commit SHA, and ordered newest first.
+	commitStatuses map[string][]gitprovider.CommitStatusInfo
+
+	// branches maps a branch name to the SHA of its head commit.
+	branches map[string]string
+	// tags is keyed by tag name.
+	tags map[string]*gitprovider.TagInfo
+	// releases is keyed by the name of the tag they are made from.
+	releases map[string]*gitprovider.ReleaseInfo
+	// commits is keyed by commit SHA.
+	commits map[string]*commitRecord
+	// pullRequests is indexed by pull request number - 1.
+	pullRequests []*pullRequestRecord
+	// issues is indexed by issue number - 1, issues are numbered separately from pull requests.
+	issues []*issueRecord
+}
+
+type commitRecord struct {
+	info    gitprovider.CommitInfo
+	parents []string
+	// files is a snapshot of the full repository content at this commit, keyed by path.
+	files map[string]string
+}
+
+type pullRequestRecord struct {
+	// info holds the last known branch heads of the pull request, they are only
+	// kept up-to-date with the branches once it's closed or merged.
+	info gitprovider.PullRequestInfo
+	// comments is ordered oldest first.
+	comments []*gitprovider.PullRequestCommentInfo
+	// reviews is ordered oldest first.
+	reviews []gitprovider.PullRequestReviewInfo
+}
+
+type issueRecord struct {
+	info gitprovider.IssueInfo
+	// comments is ordered oldest first.
+	comments []*gitprovider.IssueCommentInfo
+}
+
+func newRepoRecord(ref gitprovider.RepositoryRef, info gitprovider.RepositoryInfo) *repoRecord {
+	return &repoRecord{
+		ref:               ref,
+		info:              info,
+		deployKeys:        map[string]*gitprovider.DeployKeyInfo{},
+		deployTokens:      map[string]*gitprovider.DeployTokenInfo{},
+		teamAccess:        map[string]*gitprovider.TeamAccessInfo{},
+		collaborators:     map[string]*gitprovider.CollaboratorInfo{},
+		webhooks:          map[string]*gitprovider.WebhookInfo{},
+		labels:            map[string]*gitprovider.LabelInfo{},
+		commitStatuses:    map[string][]gitprovider.CommitStatusInfo{},
+		branchProtections: map[string]*gitprovider.BranchProtectionInfo{},
+		branches:          map[string]string{},
+		tags:              map[string]*gitprovider.TagInfo{},
+		releases:          map[string]*gitprovider.ReleaseInfo{},
+		commits:           map[string]*commitRecord{},
+	}
+}
+
+func orgKey(ref gitprovider.OrganizationRef) string {
+	return ref.GetIdentity()
+}
+
+func repoKey(ref gitprovider.RepositoryRef) string {
+	return ref.GetIdentity() + "/" + ref.GetRepository()
+}
+
+// getRepo returns the record for ref, or ErrNotFound.
+func (s *store) getRepo(ref gitprovider.RepositoryRef) (*repoRecord, error) {
+	r, ok := s.repos[repoKey(ref)]
+	if !ok {
+		return nil, fmt.Errorf("repository %q: %w", repoKey(ref), gitprovider.ErrNotFound)
+	}
+	return r, nil
+}
+
+// newSHA returns a unique, 40 character hexadecimal SHA derived from parts.
+func (s *store) newSHA(parts ...string) string {
+	s.seq++
+	return hashOf(fmt.Sprintf("%d\x00%s", s.seq, strings.Join(parts, "\x00")))
+}
+
+func hashOf(s string) string {
+	sum := sha1.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// blobSHA returns the Git object ID of a blob with the given content.
+func blobSHA(content string) string {
+	return hashOf(fmt.Sprintf("blob %d\x00%s", len(content), content))
+}
+
+// treeSHA returns a stable ID for the tree containing files, whose paths are relative to the tree.
+func treeSHA(files map[string]string) string {
+	paths := sortedPaths(files)
+	var b strings.Builder
+	for _, p := range paths {
+		fmt.Fprintf(&b, "%s %s\n", p, blobSHA(files[p]))
+	}
+	return hashOf("tree\x00" + b.String())
+}
+
+func sortedPaths(files map[string]string) []string {
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// commit records a new commit with the given parents and file snapshot, and moves branch to it.
+func (s *store) commit(r *repoRecord, branch, author, message string, parents []string, files map[string]string) *commitRecord {
+	sha := s.newSHA(append([]string{repoKey(r.ref), message}, parents...)...)
+	c := &commitRecord{
+		info: gitprovider.CommitInfo{
+			Sha:       sha,
+			TreeSha:   treeSHA(files),
+			Author:    author,
+			Message:   message,
+			CreatedAt: time.Now().UTC(),
+			URL:       fmt.Sprintf("%s/commit/%s", r.ref.String(), sha),
+		},
+		parents: parents,
+		files:   files,
+	}
+	r.commits[sha] = c
+	r.branches[branch] = sha
+	return c
+}
+
+// resolve returns the commit a branch name or commit SHA points to.
+func (r *repoRecord) resolve(rev string) (*commitRecord, error) {
+	if sha, ok := r.branches[rev]; ok {
+		return r.commits[sha], nil
+	}
+	if c, ok := r.commits[rev]; ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("revision %q: %w", rev, gitprovider.ErrNotFound)
+}
+
+// resolveTree returns the files reachable from a branch name, commit SHA or tree SHA.
+// Paths of the returned files are relative to the tree.
+func (r *repoRecord) resolveTree(rev string) (string, map[string]string, error) {
+	if c, err := r.resolve(rev); err == nil {
+		return c.info.TreeSha, c.files, nil
+	}
+	for _, c := range r.commits {
+		if c.info.TreeSha == rev {
+			return rev, c.files, nil
+		}
+		for _, files := range subTrees(c.files) {
+			if treeSHA(files) == rev {
+				return rev, files, nil
+			}
+		}
+	}
+	return "", nil, fmt.Errorf("tree %q: %w", rev, gitprovider.ErrNotFound)
+}
+
+// subTrees returns the files of every directory in files, keyed by directory path.
+// Paths in the returned file sets are relative to the directory.
+func subTrees(files map[string]string) map[string]map[string]string {
+	trees := map[string]map[string]string{}
+	for p, content := range files {
+		parts := strings.Split(p, "/")
+		for i := 1; i < len(parts); i++ {
+			dir := strings.Join(parts[:i], "/")
+			if trees[dir] == nil {
+				trees[dir] = map[string]string{}
+			}
+			trees[dir][strings.Join(parts[i:], "/")] = content
+		}
+	}
+	return trees
+}
+
+// ancestors returns the SHAs of all commits reachable from sha, including sha itself.
+func (r *repoRecord) ancestors(sha string) map[string]bool {
+	seen := map[string]bool{}
+	queue := []string{sha}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if seen[cur] {
+			continue
+		}
+		seen[cur] = true
+		if c, ok := r.commits[cur]; ok {
+			queue = append(queue, c.parents...)
+		}
+	}
+	return seen
+}
+
+// mergeBase returns the closest common ancestor of the commits a and b, or nil if there is none.
+func (r *repoRecord) mergeBase(a, b string) *commitRecord {
+	fromA := r.ancestors(a)
+	queue := []string{b}
+	seen := map[string]bool{}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if seen[cur] {
+			continue
+		}
+		seen[cur] = true
+		if fromA[cur] {
+			return r.commits[cur]
+		}
+		if c, ok := r.commits[cur]; ok {
+			queue = append(queue, c.parents...)
+		}
+	}
+	return nil
+}
+
+// unmergedCommits returns the commits on the first-parent history of head that aren't reachable
+// from base, oldest first.
+func (r *repoRecord) unmergedCommits(base, head string) []*commitRecord {
+	merged := r.ancestors(base)
+	var commits []*commitRecord
+	for sha := head; !merged[sha]; {
+		c, ok := r.commits[sha]
+		if !ok || len(c.parents) == 0 {
+			break
+		}
+		commits = append([]*commitRecord{c}, commits...)
+		sha = c.parents[0]
+	}
+	return commits
+}
+
+func copyFiles(files map[string]string) map[string]string {
+	out := make(map[string]string, len(files))
+	for p, content := range files {
+		out[p] = content
+	}
+	return out
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// validateUserRepositoryRef makes sure the UserRepositoryRef is valid for this client.
+func validateUserRepositoryRef(ref gitprovider.UserRepositoryRef, expectedDomain string) error {
+	// Make sure the RepositoryRef fields are valid
+	if err := validation.ValidateTargets("UserRepositoryRef", ref); err != nil {
+		return err
+	}
+	// Make sure the type is valid, and domain is expected
+	return validateIdentityFields(ref, expectedDomain)
+}
+
+// validateOrgRepositoryRef makes sure the OrgRepositoryRef is valid for this client.
+func validateOrgRepositoryRef(ref gitprovider.OrgRepositoryRef, expectedDomain string) error {
+	// Make sure the RepositoryRef fields are valid
+	if err := validation.ValidateTargets("OrgRepositoryRef", ref); err != nil {
+		return err
+	}
+	// Make sure the type is valid, and domain is expected
+	return validateIdentityFields(ref, expectedDomain)
+}
+
+// validateOrganizationRef makes sure the OrganizationRef is valid for this client.
+func validateOrganizationRef(ref gitprovider.OrganizationRef, expectedDomain string) error {
+	// Make sure the OrganizationRef fields are valid
+	if err := validation.ValidateTargets("OrganizationRef", ref); err != nil {
+		return err
+	}
+	// Make sure the type is valid, and domain is expected
+	return validateIdentityFields(ref, expectedDomain)
+}
+
+// validateUserRef makes sure the UserRef is valid for this client.
+func validateUserRef(ref gitprovider.UserRef, expectedDomain string) error {
+	// Make sure the UserRef fields are valid
+	if err := validation.ValidateTargets("UserRef", ref); err != nil {
+		return err
+	}
+	// Make sure the type is valid, and domain is expected
+	return validateIdentityFields(ref, expectedDomain)
+}
+
+// validateIdentityFields makes sure the type of the IdentityRef is supported, and the domain is as expected.
+func validateIdentityFields(ref gitprovider.IdentityRef, expectedDomain string) error {
+	// Make sure the expected domain is used
+	if ref.GetDomain() != expectedDomain {
+		return fmt.Errorf("domain %q not supported by this client: %w", ref.GetDomain(), gitprovider.ErrDomainUnsupported)
+	}
+	// Make sure the right type of identityref is used
+	switch ref.GetType() {
+	case gitprovider.IdentityTypeOrganization, gitprovider.IdentityTypeSuborganization, gitprovider.IdentityTypeUser:
+		return nil
+	}
+	return fmt.Errorf("invalid identity type: %v: %w", ref.GetType(), gitprovider.ErrInvalidArgument)
+}
+
+// The copy functions below make sure that no pointers are shared between the info structs
+// held by callers, and the ones kept in the store.
+
+func copyStringPtr(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	return gitprovider.StringVar(*s)
+}
+
+func copyBoolPtr(b *bool) *bool {
+	if b == nil {
+		return nil
+	}
+	return gitprovider.BoolVar(*b)
+}
+
+func copyOrganizationInfo(info gitprovider.OrganizationInfo) gitprovider.OrganizationInfo {
+	info.Name = copyStringPtr(info.Name)
+	info.Description = copyStringPtr(info.Description)
+	info.Parent = copyStringPtr(info.Parent)
+	if info.Visibility != nil {
+		info.Visibility = gitprovider.OrganizationVisibilityVar(*info.Visibility)
+	}
+	return info
+}
+
+func copyRepositoryInfo(info gitprovider.RepositoryInfo) gitprovider.RepositoryInfo {
+	info.Description = copyStringPtr(info.Description)
+	info.DefaultBranch = copyStringPtr(info.DefaultBranch)
+	if info.Visibility != nil {
+		info.Visibility = gitprovider.RepositoryVisibilityVar(*info.Visibility)
+	}
+	if info.Topics != nil {
+		info.Topics = append([]string{}, info.Topics...)
+	}
+	info.Homepage = copyStringPtr(info.Homepage)
+	info.Archived = copyBoolPtr(info.Archived)
+	info.HasIssues = copyBoolPtr(info.HasIssues)
+	info.HasWiki = copyBoolPtr(info.HasWiki)
+	info.HasProjects = copyBoolPtr(info.HasProjects)
+	if info.AllowedMergeMethods != nil {
+		info.AllowedMergeMethods = append([]gitprovider.MergeMethod{}, info.AllowedMergeMethods...)
+	}
+	info.DeleteBranchOnMerge = copyBoolPtr(info.DeleteBranchOnMerge)
+	info.IsTemplate = copyBoolPtr(info.IsTemplate)
+	return info
+}
+
+// mergeRepositorySettings returns info with the settings it leaves unset, like Topics, taken
+// from actual, as the real providers leave them as they are.
+func mergeRepositorySettings(info, actual gitprovider.RepositoryInfo) gitprovider.RepositoryInfo {
+	if info.Topics == nil {
+		info.Topics = actual.Topics
+	}
+	if info.Homepage == nil {
+		info.Homepage = actual.Homepage
+	}
+	if info.Archived == nil {
+		info.Archived = actual.Archived
+	}
+	if info.HasIssues == nil {
+		info.HasIssues = actual.HasIssues
+	}
+	if info.HasWiki == nil {
+		info.HasWiki = actual.HasWiki
+	}
+	if info.HasProjects == nil {
+		info.HasProjects = actual.HasProjects
+	}
+	if info.AllowedMergeMethods == nil {
+		info.AllowedMergeMethods = actual.AllowedMergeMethods
+	}
+	if info.DeleteBranchOnMerge == nil {
+		info.DeleteBranchOnMerge = actual.DeleteBranchOnMerge
+	}
+	if info.IsTemplate == nil {
+		info.IsTemplate = actual.IsTemplate
+	}
+	return info
+}
+
+func copyDeployKeyInfo(info gitprovider.DeployKeyInfo) gitprovider.DeployKeyInfo {
+	info.Key = append([]byte(nil), info.Key...)
+	info.ReadOnly = copyBoolPtr(info.ReadOnly)
+	return info
+}
+
+func copyWebhookInfo(info gitprovider.WebhookInfo) gitprovider.WebhookInfo {
+	info.Secret = copyStringPtr(info.Secret)
+	if info.ContentType != nil {
+		info.ContentType = gitprovider.WebhookContentTypeVar(*info.ContentType)
+	}
+	if info.Events != nil {
+		info.Events = append([]gitprovider.WebhookEvent{}, info.Events...)
+	}
+	info.InsecureSSL = copyBoolPtr(info.InsecureSSL)
+	info.Active = copyBoolPtr(info.Active)
+	return info
+}
+
+func copyIntPtr(i *int) *int {
+	if i == nil {
+		return nil
+	}
+	return gitprovider.IntVar(*i)
+}
+
+func copyBranchProtectionInfo(info gitprovider.BranchProtectionInfo) gitprovider.BranchProtectionInfo {
+	info.RequiredApprovingReviewCount = copyIntPtr(info.RequiredApprovingReviewCount)
+	if info.RequiredStatusChecks != nil {
+		info.RequiredStatusChecks = append([]string{}, info.RequiredStatusChecks...)
+	}
+	info.RestrictPushes = copyBoolPtr(info.RestrictPushes)
+	if info.PushAllowances != nil {
+		info.PushAllowances = append([]string{}, info.PushAllowances...)
+	}
+	info.AllowForcePushes = copyBoolPtr(info.AllowForcePushes)
+	info.AllowDeletions = copyBoolPtr(info.AllowDeletions)
+	info.IncludeAdmins = copyBoolPtr(info.IncludeAdmins)
+	return info
+}
+
+func copyTeamAccessInfo(info gitprovider.TeamAccessInfo) gitprovider.TeamAccessInfo {
+	if info.Permission != nil {
+		info.Permission = gitprovider.RepositoryPermissionVar(*info.Permission)
+	}
+	return info
+}
+
+func copyCollaboratorInfo(info gitprovider.CollaboratorInfo) gitprovider.CollaboratorInfo {
+	if info.Permission != nil {
+		info.Permission = gitprovider.RepositoryPermissionVar(*info.Permission)
+	}
+	return info
+}
+
+func copyTeamInfo(info gitprovider.TeamInfo) gitprovider.TeamInfo {
+	info.Description = copyStringPtr(info.Description)
+	if info.Privacy != nil {
+		info.Privacy = gitprovider.TeamPrivacyVar(*info.Privacy)
+	}
+	if info.Members != nil {
+		info.Members = append([]string{}, info.Members...)
+	}
+	return info
+}
+
+func copyTagInfo(info gitprovider.TagInfo) gitprovider.TagInfo {
+	info.Message = copyStringPtr(info.Message)
+	return info
+}
+
+func copyReleaseInfo(info gitprovider.ReleaseInfo) gitprovider.ReleaseInfo {
+	info.Target = copyStringPtr(info.Target)
+	info.Name = copyStringPtr(info.Name)
+	info.Description = copyStringPtr(info.Description)
+	info.Draft = copyBoolPtr(info.Draft)
+	info.Prerelease = copyBoolPtr(info.Prerelease)
+	if info.Assets != nil {
+		info.Assets = append([]gitprovider.ReleaseAssetInfo{}, info.Assets...)
+	}
+	return info
+}
+
+func copyPullRequestInfo(info gitprovider.PullRequestInfo) gitprovider.PullRequestInfo {
+	for _, s := range []*[]string{&info.Labels, &info.Assignees, &info.Reviewers} {
+		if *s != nil {
+			*s = append([]string{}, *s...)
+		}
+	}
+	if info.MergedAt != nil {
+		mergedAt := *info.MergedAt
+		info.MergedAt = &mergedAt
+	}
+	info.Mergeable = copyBoolPtr(info.Mergeable)
+	return info
+}
+
+func copyIssueInfo(info gitprovider.IssueInfo) gitprovider.IssueInfo {
+	for _, s := range []*[]string{&info.Labels, &info.Assignees} {
+		if *s != nil {
+			*s = append([]string{}, *s...)
+		}
+	}
+	if info.ClosedAt != nil {
+		closedAt := *info.ClosedAt
+		info.ClosedAt = &closedAt
+	}
+	return info
+}
+
+func copyPullRequestReviewInfo(info gitprovider.PullRequestReviewInfo) gitprovider.PullRequestReviewInfo {
+	if info.SubmittedAt != nil {
+		submittedAt := *info.SubmittedAt
+		info.SubmittedAt = &submittedAt
+	}
+	return info
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -16,7 +16,12 @@ limitations under the License.
 
 package gitprovider
 
-import "context"
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/validation"
+)
 
 // ProviderID is a typed string for a given Git provider
 // The provider constants are defined in their respective packages.
@@ -48,6 +53,18 @@ type DefaultedInfoRequest interface {
 	Default()
 }
 
+// DiffableInfoRequest is a superset of InfoRequest, also including a Diff() function that lists
+// the fields in which the desired state differs from the actual one. Diff(actual) is empty exactly
+// when Equals(actual) is true.
+type DiffableInfoRequest interface {
+	// DiffableInfoRequest is a superset of InfoRequest
+	InfoRequest
+
+	// Diff lists the fields of this *Info request (the desired state) that don't match the actual
+	// passed in as the argument, with their actual (old) and desired (new) values.
+	Diff(actual InfoRequest) []FieldChange
+}
+
 // Updatable is an interface which all objects that can be updated
 // using the Client implement.
 type Updatable interface {
@@ -120,3 +137,250 @@ func ValidateAndDefaultInfo(info DefaultedInfoRequest) error {
 	info.Default()
 	return nil
 }
+
+// ReconcileTeamMembers implements TeamMemberClient.Reconcile on top of the other methods of c, for
+// all providers: the users of req become members of the team with the desired roles, and with the
+// Exclusive option, the other members are removed. All of req is validated and defaulted before
+// any change is made, and logins must be unique.
+//
+// actionTaken is true if anything was changed, also if an error is returned part way.
+func ReconcileTeamMembers(ctx context.Context, c TeamMemberClient, req []TeamMemberInfo, opts ...TeamMemberReconcileOption) (actionTaken bool, err error) {
+	o := MakeTeamMemberReconcileOptions(opts...)
+	desired, err := validateAndDefaultTeamMembers(req)
+	if err != nil {
+		return false, err
+	}
+	actual, err := c.List(ctx)
+	if err != nil {
+		return false, err
+	}
+	// undesired is keyed by login, and holds the actual members that aren't in req
+	undesired := make(map[string]TeamMemberInfo, len(actual))
+	for _, member := range actual {
+		undesired[member.Login] = member
+	}
+
+	for _, info := range desired {
+		member, ok := undesired[info.Login]
+		delete(undesired, info.Login)
+		if ok && info.Equals(member) {
+			continue
+		}
+		if err := c.Add(ctx, info); err != nil {
+			return actionTaken, err
+		}
+		actionTaken = true
+	}
+	if o.Exclusive == nil || !*o.Exclusive {
+		return actionTaken, nil
+	}
+	// Remove in the order of the actual members, to be deterministic
+	for _, member := range actual {
+		if _, ok := undesired[member.Login]; !ok {
+			continue
+		}
+		if err := c.Remove(ctx, member.Login); err != nil {
+			return actionTaken, err
+		}
+		actionTaken = true
+	}
+	return actionTaken, nil
+}
+
+// validateAndDefaultTeamMembers validates and defaults the members of req, and returns the
+// defaulted copy. Logins must be unique.
+func validateAndDefaultTeamMembers(req []TeamMemberInfo) ([]TeamMemberInfo, error) {
+	members := make([]TeamMemberInfo, 0, len(req))
+	logins := make(map[string]struct{}, len(req))
+	validator := validation.New("TeamMembers")
+	for _, info := range req {
+		if err := ValidateAndDefaultInfo(&info); err != nil {
+			return nil, err
+		}
+		if _, ok := logins[info.Login]; ok {
+			validator.Invalid(info.Login, "Login")
+		}
+		logins[info.Login] = struct{}{}
+		members = append(members, info)
+	}
+	if err := validator.Error(); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// RetargetPullRequests changes the target branch of the open pull requests into the branch from to
+// the branch to, on top of the other methods of c, for all providers. It's used to rename the default
+// branch on providers which can't rename branches natively, see BranchClient.RenameDefault.
+func RetargetPullRequests(ctx context.Context, c PullRequestClient, from, to string) error {
+	prs, err := c.List(ctx, &PullRequestListOptions{
+		State:        PullRequestStateVar(PullRequestStateOpen),
+		TargetBranch: &from,
+	})
+	if err != nil {
+		return err
+	}
+	for _, pr := range prs {
+		if _, err := c.Edit(ctx, pr.Get().Number, EditOptions{TargetBranch: &to}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReconcileAllTeamAccess implements TeamAccessClient.ReconcileAll on top of the other methods of c,
+// for all providers. Team names must be unique.
+func ReconcileAllTeamAccess(ctx context.Context, c TeamAccessClient, destructive bool, req []TeamAccessInfo, opts ...ReconcileAllOption) (*ReconcileReport, error) {
+	return reconcileAll[TeamAccessInfo](ctx, "TeamAccess", req, c.List, c.Create, destructive, opts, func(info TeamAccessInfo) string {
+		return info.Name
+	})
+}
+
+// ReconcileAllLabels implements LabelClient.ReconcileAll on top of the other methods of c, for all
+// providers. Label names must be unique.
+func ReconcileAllLabels(ctx context.Context, c LabelClient, destructive bool, req []LabelInfo, opts ...ReconcileAllOption) (*ReconcileReport, error) {
+	return reconcileAll[LabelInfo](ctx, "Labels", req, c.List, c.Create, destructive, opts, func(info LabelInfo) string {
+		return info.Name
+	})
+}
+
+// ReconcileAllDeployKeys implements DeployKeyClient.ReconcileAll on top of the other methods of c,
+// for all providers. Deploy key names must be unique.
+func ReconcileAllDeployKeys(ctx context.Context, c DeployKeyClient, destructive bool, req []DeployKeyInfo, opts ...ReconcileAllOption) (*ReconcileReport, error) {
+	return reconcileAll[DeployKeyInfo](ctx, "DeployKeys", req, c.List, c.Create, destructive, opts, func(info DeployKeyInfo) string {
+		return info.Name
+	})
+}
+
+// setObject is an object in a set reconciled by reconcileAll, e.g. a TeamAccess.
+type setObject[I any] interface {
+	Get() I
+	Set(info I) error
+	Update(ctx context.Context) error
+	Delete(ctx context.Context) error
+}
+
+// defaultedInfo is a pointer to I which can be defaulted, e.g. a *TeamAccessInfo.
+type defaultedInfo[I any] interface {
+	*I
+	DefaultedInfoRequest
+}
+
+// reconcileAll makes the objects listed by list exactly the desired set (req), where objects are
+// identified by the given name function. All of req is validated and defaulted, and all changes
+// are computed, before any change is made; names must be unique.
+//
+// Removing objects requires destructive to be true, else ErrDestructiveCallDisallowed is returned
+// before any change is made. With the PlanOnly option, the changes are only computed.
+func reconcileAll[I DiffableInfoRequest, PI defaultedInfo[I], O setObject[I], C any](
+	ctx context.Context,
+	kind string,
+	req []I,
+	list func(ctx context.Context) ([]O, error),
+	create func(ctx context.Context, info I) (C, error),
+	destructive bool,
+	opts []ReconcileAllOption,
+	name func(info I) string,
+) (*ReconcileReport, error) {
+	desired, err := validateAndDefaultSet[I, PI](kind, req, name)
+	if err != nil {
+		return nil, err
+	}
+	actual, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// undesired is keyed by name, and holds the actual objects that aren't in req
+	undesired := make(map[string]O, len(actual))
+	for _, obj := range actual {
+		undesired[name(obj.Get())] = obj
+	}
+
+	steps := make([]reconcileStep, 0, len(desired)+len(actual))
+	for _, info := range desired {
+		info := info
+		obj, ok := undesired[name(info)]
+		delete(undesired, name(info))
+		switch {
+		case !ok:
+			steps = append(steps, reconcileStep{ReconcileActionCreate, name(info), nil, func(ctx context.Context) error {
+				_, err := create(ctx, info)
+				return err
+			}})
+		case !info.Equals(obj.Get()):
+			steps = append(steps, reconcileStep{ReconcileActionUpdate, name(info), info.Diff(obj.Get()), func(ctx context.Context) error {
+				if err := obj.Set(info); err != nil {
+					return err
+				}
+				return obj.Update(ctx)
+			}})
+		}
+	}
+	// Delete in the order of the actual objects, to be deterministic
+	for _, obj := range actual {
+		if _, ok := undesired[name(obj.Get())]; ok {
+			steps = append(steps, reconcileStep{ReconcileActionDelete, name(obj.Get()), nil, obj.Delete})
+		}
+	}
+	return applyReconcileSteps(ctx, steps, destructive, MakeReconcileAllOptions(opts...))
+}
+
+// validateAndDefaultSet validates and defaults the objects of req, and returns the defaulted copy.
+// The names of the objects must be unique.
+func validateAndDefaultSet[I any, PI defaultedInfo[I]](kind string, req []I, name func(info I) string) ([]I, error) {
+	set := make([]I, 0, len(req))
+	names := make(map[string]struct{}, len(req))
+	validator := validation.New(kind)
+	for _, info := range req {
+		if err := ValidateAndDefaultInfo(PI(&info)); err != nil {
+			return nil, err
+		}
+		if _, ok := names[name(info)]; ok {
+			validator.Invalid(name(info), "Name")
+		}
+		names[name(info)] = struct{}{}
+		set = append(set, info)
+	}
+	if err := validator.Error(); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// reconcileStep is a change computed by a set-level reconcile, which is applied by calling apply.
+type reconcileStep struct {
+	action ReconcileAction
+	name   string
+	fields []FieldChange
+	apply  func(ctx context.Context) error
+}
+
+// applyReconcileSteps applies the steps in order, and reports the applied changes. With PlanOnly,
+// all steps are reported without applying them. Unless destructive is true, steps deleting objects
+// make it return ErrDestructiveCallDisallowed before applying anything.
+func applyReconcileSteps(ctx context.Context, steps []reconcileStep, destructive bool, o ReconcileAllOptions) (*ReconcileReport, error) {
+	report := &ReconcileReport{
+		PlanOnly: o.PlanOnly != nil && *o.PlanOnly,
+		Changes:  make([]ReconcileChange, 0, len(steps)),
+	}
+	if report.PlanOnly {
+		for _, step := range steps {
+			report.Changes = append(report.Changes, ReconcileChange{Action: step.action, Name: step.name, Fields: step.fields})
+		}
+		return report, nil
+	}
+	if !destructive {
+		for _, step := range steps {
+			if step.action == ReconcileActionDelete {
+				return report, fmt.Errorf("cannot delete %q: %w", step.name, ErrDestructiveCallDisallowed)
+			}
+		}
+	}
+	for _, step := range steps {
+		if err := step.apply(ctx); err != nil {
+			return report, err
+		}
+		report.Changes = append(report.Changes, ReconcileChange{Action: step.action, Name: step.name, Fields: step.fields})
+	}
+	return report, nil
+}
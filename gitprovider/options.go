@@ -94,3 +94,292 @@ func (opts *FilesGetOptions) ApplyFilesGetOptions(target *FilesGetOptions) {
 	target.Recursive = opts.Recursive
 
 }
+
+// MakePullRequestListOptions returns a PullRequestListOptions based off the mutator functions
+// given to PullRequestClient.List(), with State defaulted to open.
+// validation.ErrFieldEnumInvalid is returned if the state doesn't match known values.
+func MakePullRequestListOptions(opts ...PullRequestListOption) (PullRequestListOptions, error) {
+	o := &PullRequestListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToPullRequestListOptions(o)
+	}
+	if o.State == nil {
+		o.State = PullRequestStateVar(PullRequestStateOpen)
+	}
+	return *o, o.ValidateOptions()
+}
+
+// PullRequestListOptions specifies optional options when listing pull requests.
+type PullRequestListOptions struct {
+	// State filters the pull requests by state. Use PullRequestStateAll to list pull requests
+	// in any state.
+	// Default: open.
+	// +optional
+	State *PullRequestState
+
+	// SourceBranch only lists pull requests from the given branch.
+	// +optional
+	SourceBranch *string
+
+	// TargetBranch only lists pull requests into the given branch.
+	// +optional
+	TargetBranch *string
+}
+
+// PullRequestListOption is an interface for applying options when listing pull requests.
+type PullRequestListOption interface {
+	ApplyToPullRequestListOptions(target *PullRequestListOptions)
+}
+
+// ApplyToPullRequestListOptions applies the options defined in the options struct to the
+// target struct that is being completed.
+func (opts *PullRequestListOptions) ApplyToPullRequestListOptions(target *PullRequestListOptions) {
+	// Go through each field in opts, and apply it to target if set
+	if opts.State != nil {
+		target.State = opts.State
+	}
+	if opts.SourceBranch != nil {
+		target.SourceBranch = opts.SourceBranch
+	}
+	if opts.TargetBranch != nil {
+		target.TargetBranch = opts.TargetBranch
+	}
+}
+
+// ValidateOptions validates that the options are valid.
+func (opts *PullRequestListOptions) ValidateOptions() error {
+	errs := validation.New("PullRequestListOptions")
+	if opts.State != nil {
+		errs.Append(ValidatePullRequestState(*opts.State), *opts.State, "State")
+	}
+	return errs.Error()
+}
+
+// Matches returns true if the pull request passes all filters of the options.
+// It's used by providers that can't filter server-side.
+func (opts *PullRequestListOptions) Matches(info PullRequestInfo) bool {
+	if opts.State != nil && *opts.State != PullRequestStateAll && *opts.State != info.State {
+		return false
+	}
+	if opts.SourceBranch != nil && *opts.SourceBranch != info.SourceBranch {
+		return false
+	}
+	if opts.TargetBranch != nil && *opts.TargetBranch != info.TargetBranch {
+		return false
+	}
+	return true
+}
+
+// MakePullRequestMergeOptions returns a PullRequestMergeOptions based off the mutator functions
+// given to PullRequestClient.Merge().
+// validation.ErrFieldInvalid is returned if the expected head SHA is empty.
+func MakePullRequestMergeOptions(opts ...PullRequestMergeOption) (PullRequestMergeOptions, error) {
+	o := &PullRequestMergeOptions{}
+	for _, opt := range opts {
+		opt.ApplyToPullRequestMergeOptions(o)
+	}
+	return *o, o.ValidateOptions()
+}
+
+// PullRequestMergeOptions specifies optional options when merging pull requests.
+type PullRequestMergeOptions struct {
+	// ExpectedHeadSha makes the merge fail with a *ConflictError if the head of the pull request
+	// isn't the given commit, e.g. because new commits were pushed after it was reviewed.
+	// +optional
+	ExpectedHeadSha *string
+
+	// DeleteSourceBranch can be set to true in order to delete the source branch of the pull
+	// request once it's merged. Unlike BranchClient.Delete, this doesn't require destructive API
+	// calls to be enabled, as the changes of the branch are part of the base branch then.
+	// Default: nil (which means "false, keep the branch")
+	// +optional
+	DeleteSourceBranch *bool
+}
+
+// PullRequestMergeOption is an interface for applying options when merging pull requests.
+type PullRequestMergeOption interface {
+	ApplyToPullRequestMergeOptions(target *PullRequestMergeOptions)
+}
+
+// ApplyToPullRequestMergeOptions applies the options defined in the options struct to the
+// target struct that is being completed.
+func (opts *PullRequestMergeOptions) ApplyToPullRequestMergeOptions(target *PullRequestMergeOptions) {
+	// Go through each field in opts, and apply it to target if set
+	if opts.ExpectedHeadSha != nil {
+		target.ExpectedHeadSha = opts.ExpectedHeadSha
+	}
+	if opts.DeleteSourceBranch != nil {
+		target.DeleteSourceBranch = opts.DeleteSourceBranch
+	}
+}
+
+// ValidateOptions validates that the options are valid.
+func (opts *PullRequestMergeOptions) ValidateOptions() error {
+	errs := validation.New("PullRequestMergeOptions")
+	if opts.ExpectedHeadSha != nil && *opts.ExpectedHeadSha == "" {
+		errs.Invalid(*opts.ExpectedHeadSha, "ExpectedHeadSha")
+	}
+	return errs.Error()
+}
+
+// ValidateOptions validates that the options are valid.
+func (opts *EditOptions) ValidateOptions() error {
+	errs := validation.New("EditOptions")
+	if opts.State != nil && *opts.State != PullRequestStateOpen && *opts.State != PullRequestStateClosed {
+		errs.Invalid(*opts.State, "State")
+	}
+	return errs.Error()
+}
+
+// MakeTeamMemberReconcileOptions returns a TeamMemberReconcileOptions based off the mutator functions
+// given to TeamMemberClient.Reconcile().
+func MakeTeamMemberReconcileOptions(opts ...TeamMemberReconcileOption) TeamMemberReconcileOptions {
+	o := &TeamMemberReconcileOptions{}
+	for _, opt := range opts {
+		opt.ApplyToTeamMemberReconcileOptions(o)
+	}
+	return *o
+}
+
+// TeamMemberReconcileOptions specifies optional options when reconciling the members of a team.
+type TeamMemberReconcileOptions struct {
+	// Exclusive can be set to true in order to remove the members of the team that aren't
+	// in the desired list, making the desired list the exact membership of the team.
+	// Default: nil (which means "false, keep other members")
+	// +optional
+	Exclusive *bool
+}
+
+// TeamMemberReconcileOption is an interface for applying options when reconciling team members.
+type TeamMemberReconcileOption interface {
+	ApplyToTeamMemberReconcileOptions(target *TeamMemberReconcileOptions)
+}
+
+// ApplyToTeamMemberReconcileOptions applies the options defined in the options struct to the
+// target struct that is being completed.
+func (opts *TeamMemberReconcileOptions) ApplyToTeamMemberReconcileOptions(target *TeamMemberReconcileOptions) {
+	// Go through each field in opts, and apply it to target if set
+	if opts.Exclusive != nil {
+		target.Exclusive = opts.Exclusive
+	}
+}
+
+// MakeReconcileAllOptions returns a ReconcileAllOptions based off the mutator functions
+// given to set-level reconciles such as TeamAccessClient.ReconcileAll().
+func MakeReconcileAllOptions(opts ...ReconcileAllOption) ReconcileAllOptions {
+	o := &ReconcileAllOptions{}
+	for _, opt := range opts {
+		opt.ApplyToReconcileAllOptions(o)
+	}
+	return *o
+}
+
+// ReconcileAllOptions specifies optional options for set-level reconciles.
+type ReconcileAllOptions struct {
+	// PlanOnly can be set to true in order to only compute the changes, without applying them.
+	// Default: nil (which means "false, apply the changes")
+	// +optional
+	PlanOnly *bool
+}
+
+// ReconcileAllOption is an interface for applying options for set-level reconciles.
+type ReconcileAllOption interface {
+	ApplyToReconcileAllOptions(target *ReconcileAllOptions)
+}
+
+// ApplyToReconcileAllOptions applies the options defined in the options struct to the
+// target struct that is being completed.
+func (opts *ReconcileAllOptions) ApplyToReconcileAllOptions(target *ReconcileAllOptions) {
+	// Go through each field in opts, and apply it to target if set
+	if opts.PlanOnly != nil {
+		target.PlanOnly = opts.PlanOnly
+	}
+}
+
+// MakeIssueListOptions returns an IssueListOptions based off the mutator functions
+// given to IssueClient.List(), with State defaulted to open.
+// validation.ErrFieldEnumInvalid is returned if the state doesn't match known values.
+func MakeIssueListOptions(opts ...IssueListOption) (IssueListOptions, error) {
+	o := &IssueListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToIssueListOptions(o)
+	}
+	if o.State == nil {
+		o.State = IssueStateVar(IssueStateOpen)
+	}
+	return *o, o.ValidateOptions()
+}
+
+// IssueListOptions specifies optional options when listing issues.
+type IssueListOptions struct {
+	// State filters the issues by state. Use IssueStateAll to list issues in any state.
+	// Default: open.
+	// +optional
+	State *IssueState
+
+	// Labels only lists issues that have all of the given labels.
+	// +optional
+	Labels []string
+
+	// Assignee only lists issues assigned to the user with the given login.
+	// +optional
+	Assignee *string
+}
+
+// IssueListOption is an interface for applying options when listing issues.
+type IssueListOption interface {
+	ApplyToIssueListOptions(target *IssueListOptions)
+}
+
+// ApplyToIssueListOptions applies the options defined in the options struct to the
+// target struct that is being completed.
+func (opts *IssueListOptions) ApplyToIssueListOptions(target *IssueListOptions) {
+	// Go through each field in opts, and apply it to target if set
+	if opts.State != nil {
+		target.State = opts.State
+	}
+	if opts.Labels != nil {
+		target.Labels = opts.Labels
+	}
+	if opts.Assignee != nil {
+		target.Assignee = opts.Assignee
+	}
+}
+
+// ValidateOptions validates that the options are valid.
+func (opts *IssueListOptions) ValidateOptions() error {
+	errs := validation.New("IssueListOptions")
+	if opts.State != nil {
+		errs.Append(ValidateIssueState(*opts.State), *opts.State, "State")
+	}
+	return errs.Error()
+}
+
+// Matches returns true if the issue passes all filters of the options.
+// It's used by providers that can't filter server-side.
+func (opts *IssueListOptions) Matches(info IssueInfo) bool {
+	if opts.State != nil && *opts.State != IssueStateAll && *opts.State != info.State {
+		return false
+	}
+	for _, label := range opts.Labels {
+		if !containsString(info.Labels, label) {
+			return false
+		}
+	}
+	if opts.Assignee != nil && !containsString(info.Assignees, *opts.Assignee) {
+		return false
+	}
+	return true
+}
+
+// ValidateOptions validates that the options are valid.
+func (opts *IssueEditOptions) ValidateOptions() error {
+	errs := validation.New("IssueEditOptions")
+	if opts.Title != nil && *opts.Title == "" {
+		errs.Invalid(*opts.Title, "Title")
+	}
+	if opts.State != nil && *opts.State != IssueStateOpen && *opts.State != IssueStateClosed {
+		errs.Invalid(*opts.State, "State")
+	}
+	return errs.Error()
+}
@@ -93,3 +93,139 @@ func TestMakeRepositoryCreateOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestMakePullRequestMergeOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []PullRequestMergeOption
+		want        PullRequestMergeOptions
+		expectedErr error
+	}{
+		{
+			name: "default nil pointers",
+			want: PullRequestMergeOptions{},
+		},
+		{
+			name: "latter overrides former",
+			opts: []PullRequestMergeOption{
+				&PullRequestMergeOptions{ExpectedHeadSha: StringVar("abc"), DeleteSourceBranch: BoolVar(true)},
+				&PullRequestMergeOptions{ExpectedHeadSha: StringVar("def")},
+			},
+			want: PullRequestMergeOptions{ExpectedHeadSha: StringVar("def"), DeleteSourceBranch: BoolVar(true)},
+		},
+		{
+			name:        "empty expected head",
+			opts:        []PullRequestMergeOption{&PullRequestMergeOptions{ExpectedHeadSha: StringVar("")}},
+			want:        PullRequestMergeOptions{ExpectedHeadSha: StringVar("")},
+			expectedErr: validation.ErrFieldInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MakePullRequestMergeOptions(tt.opts...)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("MakePullRequestMergeOptions() error = %v, wanted %v", err, tt.expectedErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MakePullRequestMergeOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPullRequestListOptions_Matches(t *testing.T) {
+	info := PullRequestInfo{SourceBranch: "feature", TargetBranch: "main", State: PullRequestStateMerged}
+	tests := []struct {
+		name string
+		opts []PullRequestListOption
+		want bool
+	}{
+		{
+			name: "open by default",
+			want: false,
+		},
+		{
+			name: "same state",
+			opts: []PullRequestListOption{&PullRequestListOptions{State: PullRequestStateVar(PullRequestStateMerged)}},
+			want: true,
+		},
+		{
+			name: "all states",
+			opts: []PullRequestListOption{&PullRequestListOptions{State: PullRequestStateVar(PullRequestStateAll)}},
+			want: true,
+		},
+		{
+			name: "other target branch",
+			opts: []PullRequestListOption{&PullRequestListOptions{
+				State:        PullRequestStateVar(PullRequestStateAll),
+				TargetBranch: StringVar("dev"),
+			}},
+			want: false,
+		},
+		{
+			name: "same branches",
+			opts: []PullRequestListOption{&PullRequestListOptions{
+				State:        PullRequestStateVar(PullRequestStateAll),
+				SourceBranch: StringVar("feature"),
+				TargetBranch: StringVar("main"),
+			}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := MakePullRequestListOptions(tt.opts...)
+			if err != nil {
+				t.Fatalf("MakePullRequestListOptions() error = %v", err)
+			}
+			if got := opts.Matches(info); got != tt.want {
+				t.Errorf("PullRequestListOptions.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueListOptions_Matches(t *testing.T) {
+	info := IssueInfo{State: IssueStateOpen, Labels: []string{"bug", "help wanted"}, Assignees: []string{"fluxbot"}}
+	tests := []struct {
+		name string
+		opts []IssueListOption
+		want bool
+	}{
+		{
+			name: "open by default",
+			want: true,
+		},
+		{
+			name: "other state",
+			opts: []IssueListOption{&IssueListOptions{State: IssueStateVar(IssueStateClosed)}},
+			want: false,
+		},
+		{
+			name: "all labels",
+			opts: []IssueListOption{&IssueListOptions{Labels: []string{"help wanted", "bug"}}},
+			want: true,
+		},
+		{
+			name: "missing label",
+			opts: []IssueListOption{&IssueListOptions{Labels: []string{"bug", "docs"}}},
+			want: false,
+		},
+		{
+			name: "other assignee",
+			opts: []IssueListOption{&IssueListOptions{Assignee: StringVar("octocat")}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := MakeIssueListOptions(tt.opts...)
+			if err != nil {
+				t.Fatalf("MakeIssueListOptions() error = %v", err)
+			}
+			if got := opts.Matches(info); got != tt.want {
+				t.Errorf("IssueListOptions.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
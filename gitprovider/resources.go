@@ -16,6 +16,11 @@ limitations under the License.
 
 package gitprovider
 
+import (
+	"context"
+	"io"
+)
+
 // Organization represents an organization in a Git provider.
 // For now, the organization is read-only, i.e. there aren't set/update methods.
 type Organization interface {
@@ -33,16 +38,28 @@ type Organization interface {
 }
 
 // Team represents a team in an organization in a Git provider.
-// For now, the team is read-only, i.e. there aren't set/update methods.
 type Team interface {
 	// Team implements the Object interface,
 	// allowing access to the underlying object returned from the API.
 	Object
+	// The team can be updated.
+	Updatable
+	// The team can be reconciled.
+	Reconcilable
+	// The team can be deleted. This is a destructive call, only allowed if the client
+	// was created with destructive API calls enabled.
+	Deletable
 	// OrganizationBound returns organization reference details.
 	OrganizationBound
 
 	// Get returns high-level information about this team.
 	Get() TeamInfo
+	// Set sets high-level desired state for this team. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile(). The name of the team can't be changed.
+	Set(TeamInfo) error
+
+	// Members gives access to the TeamMemberClient for this specific team.
+	Members() TeamMemberClient
 }
 
 // UserRepository describes a repository owned by an user.
@@ -65,6 +82,9 @@ type UserRepository interface {
 	// the Git provider, run .Update() or .Reconcile().
 	Set(RepositoryInfo) error
 
+	// Collaborators gives access to manipulating the users with direct access to this specific repository.
+	Collaborators() CollaboratorClient
+
 	// DeployKeys gives access to manipulating deploy keys to access this specific repository.
 	DeployKeys() DeployKeyClient
 
@@ -81,11 +101,35 @@ type UserRepository interface {
 	// PullRequests gives access to this specific repository pull requests
 	PullRequests() PullRequestClient
 
+	// Issues gives access to the issues of this specific repository.
+	// The client returns "ErrNoProviderSupport" if the provider doesn't support issues.
+	Issues() IssueClient
+
+	// Labels gives access to manipulating the labels of this specific repository.
+	// The client returns "ErrNoProviderSupport" if the provider doesn't support labels.
+	Labels() LabelClient
+
 	// Files gives access to this specific repository files
 	Files() FileClient
 
 	// Trees gives access to this specific repository trees.
 	Trees() TreeClient
+
+	// Webhooks gives access to manipulating the webhooks of this specific repository.
+	Webhooks() WebhookClient
+
+	// CommitStatuses gives access to the statuses of this specific repository's commits.
+	CommitStatuses() CommitStatusClient
+
+	// BranchProtections gives access to manipulating the branch protections of this specific repository.
+	BranchProtections() BranchProtectionClient
+
+	// Tags gives access to manipulating the tags of this specific repository.
+	Tags() TagClient
+
+	// Releases gives access to manipulating the releases of this specific repository.
+	// The client returns "ErrNoProviderSupport" if the provider doesn't support releases.
+	Releases() ReleaseClient
 }
 
 // OrgRepository describes a repository owned by an organization.
@@ -144,6 +188,49 @@ type DeployToken interface {
 	Set(DeployTokenInfo) error
 }
 
+// Webhook represents a subscription of an HTTP endpoint to events in a repository.
+type Webhook interface {
+	// Webhook implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The webhook can be updated.
+	Updatable
+	// The webhook can be reconciled.
+	Reconcilable
+	// The webhook can be deleted.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this webhook.
+	Get() WebhookInfo
+	// Set sets high-level desired state for this webhook. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile().
+	Set(WebhookInfo) error
+}
+
+// BranchProtection represents the rules protecting a branch of a repository.
+// Deleting it unprotects the branch.
+type BranchProtection interface {
+	// BranchProtection implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The branch protection can be updated.
+	Updatable
+	// The branch protection can be reconciled.
+	Reconcilable
+	// The branch protection can be deleted.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this branch protection.
+	Get() BranchProtectionInfo
+	// Set sets high-level desired state for this branch protection. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile().
+	Set(BranchProtectionInfo) error
+}
+
 // TeamAccess describes a binding between a repository and a team.
 type TeamAccess interface {
 	// TeamAccess implements the Object interface,
@@ -165,6 +252,28 @@ type TeamAccess interface {
 	Set(TeamAccessInfo) error
 }
 
+// Collaborator describes a binding between a repository and a user with direct access to it.
+// Deleting it removes the user's access, or revokes the invitation if it is pending.
+type Collaborator interface {
+	// Collaborator implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The collaborator can be updated.
+	Updatable
+	// The collaborator can be reconciled.
+	Reconcilable
+	// The collaborator can be deleted.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this user's access to the repository.
+	Get() CollaboratorInfo
+	// Set sets high-level desired state for this collaborator. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile().
+	Set(CollaboratorInfo) error
+}
+
 // Commit represents a git commit.
 type Commit interface {
 	// Object implements the Object interface,
@@ -175,6 +284,65 @@ type Commit interface {
 	Get() CommitInfo
 }
 
+// Branch represents a branch of a repository.
+type Branch interface {
+	// Object implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+
+	// Get returns high-level information about this branch.
+	Get() BranchInfo
+}
+
+// Tag represents a tag of a repository.
+type Tag interface {
+	// Object implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this tag.
+	Get() TagInfo
+}
+
+// Release represents a release of a repository, made from a tag.
+// Deleting it keeps the tag.
+type Release interface {
+	// Release implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The release can be updated.
+	Updatable
+	// The release can be deleted irreversibly, which requires destructive API calls to be enabled;
+	// else ErrDestructiveCallDisallowed is returned.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this release.
+	Get() ReleaseInfo
+	// Set sets high-level desired state for this release. In order to apply these changes in
+	// the Git provider, run .Update().
+	Set(ReleaseInfo) error
+
+	// UploadAsset reads the content until EOF and attaches it to the release as a file with the
+	// given name. The uploaded asset is added to the assets returned by Get.
+	UploadAsset(ctx context.Context, name string, content io.Reader) (ReleaseAssetInfo, error)
+}
+
+// CommitStatus represents the status of a commit, e.g. reported by CI.
+type CommitStatus interface {
+	// Object implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this commit status.
+	Get() CommitStatusInfo
+}
+
 // PullRequest represents a pull request.
 type PullRequest interface {
 	// Object implements the Object interface,
@@ -183,6 +351,96 @@ type PullRequest interface {
 
 	// Get returns high-level information about this pull request.
 	Get() PullRequestInfo
+
+	// Comments gives access to the comments in the conversation of this pull request.
+	Comments() PullRequestCommentClient
+	// Reviews gives access to the reviews of this pull request.
+	Reviews() PullRequestReviewClient
+
+	// Files returns the files changed by this pull request, handling pagination.
+	// The result is marked as truncated if the provider doesn't return all files.
+	Files(ctx context.Context) (*PullRequestFiles, error)
+	// Diff returns the changes of this pull request as a raw unified diff.
+	Diff(ctx context.Context) (string, error)
+}
+
+// PullRequestComment represents a comment in the conversation of a pull request.
+type PullRequestComment interface {
+	// PullRequestComment implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The comment can be updated.
+	Updatable
+	// The comment can be deleted.
+	Deletable
+
+	// Get returns high-level information about this comment.
+	Get() PullRequestCommentInfo
+	// Set sets high-level desired state for this comment. In order to apply these changes in
+	// the Git provider, run .Update().
+	Set(PullRequestCommentInfo) error
+}
+
+// PullRequestReview represents a review of a pull request.
+type PullRequestReview interface {
+	// PullRequestReview implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+
+	// Get returns high-level information about this review.
+	Get() PullRequestReviewInfo
+}
+
+// Issue represents an issue.
+type Issue interface {
+	// Object implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+
+	// Get returns high-level information about this issue.
+	Get() IssueInfo
+
+	// Comments gives access to the comments of this issue.
+	Comments() IssueCommentClient
+}
+
+// IssueComment represents a comment on an issue.
+type IssueComment interface {
+	// IssueComment implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The comment can be updated.
+	Updatable
+	// The comment can be deleted.
+	Deletable
+
+	// Get returns high-level information about this comment.
+	Get() IssueCommentInfo
+	// Set sets high-level desired state for this comment. In order to apply these changes in
+	// the Git provider, run .Update().
+	Set(IssueCommentInfo) error
+}
+
+// Label represents a label of a repository, used to categorize issues and pull requests.
+type Label interface {
+	// Label implements the Object interface,
+	// allowing access to the underlying object returned from the API.
+	Object
+	// The label can be updated.
+	Updatable
+	// The label can be reconciled.
+	Reconcilable
+	// The label can be deleted, which requires destructive API calls to be enabled; else
+	// ErrDestructiveCallDisallowed is returned.
+	Deletable
+	// RepositoryBound returns repository reference details.
+	RepositoryBound
+
+	// Get returns high-level information about this label.
+	Get() LabelInfo
+	// Set sets high-level desired state for this label. In order to apply these changes in
+	// the Git provider, run .Update() or .Reconcile(). Changing the name renames the label.
+	Set(LabelInfo) error
 }
 
 // Tree represents a git tree which is the hierarchical structure of your git data.
@@ -117,6 +117,92 @@ func TestDefaulting(t *testing.T) {
 				Permission: RepositoryPermissionVar(RepositoryPermissionPush),
 			},
 		},
+		{
+			name:       "Webhook: empty",
+			structName: "Webhook",
+			object:     &WebhookInfo{},
+			expected: &WebhookInfo{
+				ContentType: WebhookContentTypeVar(WebhookContentTypeJSON),
+				Events:      []WebhookEvent{WebhookEventPush},
+				InsecureSSL: BoolVar(false),
+				Active:      BoolVar(true),
+			},
+		},
+		{
+			name:       "Webhook: sort and deduplicate events",
+			structName: "Webhook",
+			object: &WebhookInfo{
+				ContentType: WebhookContentTypeVar(WebhookContentTypeForm),
+				Events:      []WebhookEvent{WebhookEventPullRequest, WebhookEventPush, WebhookEventPullRequest},
+				InsecureSSL: BoolVar(true),
+				Active:      BoolVar(false),
+			},
+			expected: &WebhookInfo{
+				ContentType: WebhookContentTypeVar(WebhookContentTypeForm),
+				Events:      []WebhookEvent{WebhookEventPullRequest, WebhookEventPush},
+				InsecureSSL: BoolVar(true),
+				Active:      BoolVar(false),
+			},
+		},
+		{
+			name:       "CommitStatus: empty",
+			structName: "CommitStatus",
+			object:     &CommitStatusInfo{},
+			expected: &CommitStatusInfo{
+				Context: "default",
+			},
+		},
+		{
+			name:       "CommitStatus: set context",
+			structName: "CommitStatus",
+			object: &CommitStatusInfo{
+				Context: "ci/build",
+			},
+			expected: &CommitStatusInfo{
+				Context: "ci/build",
+			},
+		},
+		{
+			name:       "BranchProtection: empty",
+			structName: "BranchProtection",
+			object:     &BranchProtectionInfo{},
+			expected: &BranchProtectionInfo{
+				RequiredApprovingReviewCount: IntVar(0),
+				RestrictPushes:               BoolVar(false),
+				AllowForcePushes:             BoolVar(false),
+				AllowDeletions:               BoolVar(false),
+				IncludeAdmins:                BoolVar(false),
+			},
+		},
+		{
+			name:       "BranchProtection: normalize lists",
+			structName: "BranchProtection",
+			object: &BranchProtectionInfo{
+				RequiredStatusChecks: []string{"ci/lint", "ci/build", "ci/lint"},
+				RestrictPushes:       BoolVar(true),
+				PushAllowances:       []string{},
+			},
+			expected: &BranchProtectionInfo{
+				RequiredApprovingReviewCount: IntVar(0),
+				RequiredStatusChecks:         []string{"ci/build", "ci/lint"},
+				RestrictPushes:               BoolVar(true),
+				AllowForcePushes:             BoolVar(false),
+				AllowDeletions:               BoolVar(false),
+				IncludeAdmins:                BoolVar(false),
+			},
+		},
+		{
+			name:       "Label: normalize color",
+			structName: "Label",
+			object: &LabelInfo{
+				Name:  "bug",
+				Color: "#D73A4A",
+			},
+			expected: &LabelInfo{
+				Name:  "bug",
+				Color: "d73a4a",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
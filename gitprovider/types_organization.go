@@ -16,20 +16,178 @@ limitations under the License.
 
 package gitprovider
 
+import (
+	"reflect"
+
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+const (
+	// the default team privacy is closed, i.e. visible to the whole organization.
+	defaultTeamPrivacy = TeamPrivacyClosed
+	// the default role of team members is a regular member.
+	defaultTeamMemberRole = TeamMemberRoleMember
+)
+
+// OrganizationInfo implements InfoRequest.
+var _ InfoRequest = OrganizationInfo{}
+
 // OrganizationInfo represents an (top-level- or sub-) organization.
 type OrganizationInfo struct {
 	// Name is the human-friendly name of this organization, e.g. "Flux" or "Kubernetes SIGs".
+	// Name is required when creating or reconciling an organization.
 	Name *string `json:"name"`
 
 	// Description returns a description for the organization.
 	Description *string `json:"description"`
+
+	// Visibility specifies who can see the organization.
+	// If nil, the provider's default is used when creating the organization, and the
+	// visibility isn't changed when reconciling it.
+	// Available options: See the OrganizationVisibility enum.
+	// +optional
+	Visibility *OrganizationVisibility `json:"visibility,omitempty"`
+
+	// Parent is the full path of the parent organization of a sub-organization, e.g. "fluxcd"
+	// for "fluxcd/engineering", and nil for top-level organizations.
+	// Parent is read-only, the parent of a new organization is given by its OrganizationRef.
+	Parent *string `json:"parent,omitempty"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (o OrganizationInfo) ValidateInfo() error {
+	validator := validation.New("Organization")
+	// Make sure we've set the name of the organization
+	if o.Name == nil || len(*o.Name) == 0 {
+		validator.Required("Name")
+	}
+	// Validate the Visibility enum
+	if o.Visibility != nil {
+		validator.Append(ValidateOrganizationVisibility(*o.Visibility), *o.Visibility, "Visibility")
+	}
+	return validator.Error()
 }
 
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The parent isn't compared as it's read-only, a nil description
+// equals an empty one, and the visibility is only compared if it's set in the desired state.
+func (o OrganizationInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(OrganizationInfo)
+	if !ok {
+		return false
+	}
+	o.Parent, a.Parent = nil, nil
+	if o.Visibility == nil {
+		a.Visibility = nil
+	}
+	if o.Description == nil {
+		o.Description = StringVar("")
+	}
+	if a.Description == nil {
+		a.Description = StringVar("")
+	}
+	return reflect.DeepEqual(o, a)
+}
+
+// TeamInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = TeamInfo{}
+var _ DefaultedInfoRequest = &TeamInfo{}
+
 // TeamInfo is a representation for a team of users inside of an organization.
 type TeamInfo struct {
 	// Name describes the name of the team. The team name may contain slashes.
+	// In GitHub, this is the slug of the team.
+	// +required
 	Name string `json:"name"`
 
+	// Description describes the team.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Privacy specifies who can see the team within the organization.
+	// Default: closed.
+	// Available options: See the TeamPrivacy enum.
+	// +optional
+	Privacy *TeamPrivacy `json:"privacy,omitempty"`
+
 	// Members points to a set of user names (logins) of the members of this team.
+	// Members are read-only, they are ignored when creating or updating a team.
+	// Use Team.Members() to manage the members and their roles.
 	Members []string `json:"members"`
 }
+
+// Default defaults the Team fields.
+func (t *TeamInfo) Default() {
+	if t.Privacy == nil {
+		t.Privacy = TeamPrivacyVar(defaultTeamPrivacy)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (t TeamInfo) ValidateInfo() error {
+	validator := validation.New("Team")
+	// Make sure we've set the name of the team
+	if len(t.Name) == 0 {
+		validator.Required("Name")
+	}
+	// Validate the Privacy enum
+	if t.Privacy != nil {
+		validator.Append(ValidateTeamPrivacy(*t.Privacy), *t.Privacy, "Privacy")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. Members are not compared, as they aren't part of the desired state.
+func (t TeamInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(TeamInfo)
+	if !ok {
+		return false
+	}
+	t.Members, a.Members = nil, nil
+	return reflect.DeepEqual(t, a)
+}
+
+// TeamMemberInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = TeamMemberInfo{}
+var _ DefaultedInfoRequest = &TeamMemberInfo{}
+
+// TeamMemberInfo contains high-level information about a member of a team.
+type TeamMemberInfo struct {
+	// Login is the user name of the member.
+	// +required
+	Login string `json:"login"`
+
+	// Role describes the role of the member within the team.
+	// Default: member.
+	// Available options: See the TeamMemberRole enum.
+	// +optional
+	Role *TeamMemberRole `json:"role,omitempty"`
+}
+
+// Default defaults the TeamMember fields.
+func (tm *TeamMemberInfo) Default() {
+	if tm.Role == nil {
+		tm.Role = TeamMemberRoleVar(defaultTeamMemberRole)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (tm TeamMemberInfo) ValidateInfo() error {
+	validator := validation.New("TeamMember")
+	// Make sure we've set the login of the member
+	if len(tm.Login) == 0 {
+		validator.Required("Login")
+	}
+	// Validate the Role enum
+	if tm.Role != nil {
+		validator.Append(ValidateTeamMemberRole(*tm.Role), *tm.Role, "Role")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (tm TeamMemberInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(tm, actual)
+}
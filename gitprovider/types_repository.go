@@ -18,6 +18,9 @@ package gitprovider
 
 import (
 	"reflect"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/fluxcd/go-git-providers/validation"
@@ -34,11 +37,18 @@ const (
 	defaultBranchName = "main"
 	// by default, deploy keys are read-only.
 	defaultDeployKeyReadOnly = true
+	// by default, webhooks deliver JSON payloads.
+	defaultWebhookContentType = WebhookContentTypeJSON
+	// by default, webhooks are only subscribed to push events.
+	defaultWebhookEvent = WebhookEventPush
+	// the default commit status context, as used by GitHub.
+	defaultCommitStatusContext = "default"
 )
 
-// RepositoryInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+// RepositoryInfo implements InfoRequest, DiffableInfoRequest and DefaultedInfoRequest (with a pointer receiver).
 var _ InfoRequest = RepositoryInfo{}
 var _ DefaultedInfoRequest = &RepositoryInfo{}
+var _ DiffableInfoRequest = RepositoryInfo{}
 
 // RepositoryInfo represents a Git repository provided by a Git provider.
 type RepositoryInfo struct {
@@ -58,6 +68,59 @@ type RepositoryInfo struct {
 	// Default value at POST-time: RepositoryVisibilityPrivate.
 	// +optional
 	Visibility *RepositoryVisibility `json:"visibility"`
+
+	// The settings below are left as they are in the Git provider when unset, and aren't
+	// compared by Equals and Diff then. Providers return ErrNoProviderSupport for the settings
+	// they can't map to their API.
+
+	// Topics are the topics (also called tags) used to find the repository. The order doesn't
+	// matter, and an empty list removes all topics.
+	// No default value at POST-time.
+	// +optional
+	Topics []string `json:"topics,omitempty"`
+
+	// Homepage is the URL of the website of the repository.
+	// No default value at POST-time.
+	// +optional
+	Homepage *string `json:"homepage,omitempty"`
+
+	// Archived describes whether the repository is archived, i.e. read-only.
+	// No default value at POST-time.
+	// +optional
+	Archived *bool `json:"archived,omitempty"`
+
+	// HasIssues describes whether the issue tracker of the repository is enabled.
+	// No default value at POST-time.
+	// +optional
+	HasIssues *bool `json:"hasIssues,omitempty"`
+
+	// HasWiki describes whether the wiki of the repository is enabled.
+	// No default value at POST-time.
+	// +optional
+	HasWiki *bool `json:"hasWiki,omitempty"`
+
+	// HasProjects describes whether the project boards of the repository are enabled.
+	// No default value at POST-time.
+	// +optional
+	HasProjects *bool `json:"hasProjects,omitempty"`
+
+	// AllowedMergeMethods lists the methods pull requests of the repository can be merged with.
+	// The order doesn't matter, but at least one method is required if set.
+	// No default value at POST-time.
+	// Available options: See the MergeMethod enum.
+	// +optional
+	AllowedMergeMethods []MergeMethod `json:"allowedMergeMethods,omitempty"`
+
+	// DeleteBranchOnMerge describes whether the source branch of pull requests is deleted
+	// when they are merged.
+	// No default value at POST-time.
+	// +optional
+	DeleteBranchOnMerge *bool `json:"deleteBranchOnMerge,omitempty"`
+
+	// IsTemplate describes whether the repository can be used as a template for new repositories.
+	// No default value at POST-time.
+	// +optional
+	IsTemplate *bool `json:"isTemplate,omitempty"`
 }
 
 // Default defaults the Repository, implementing the InfoRequest interface.
@@ -77,18 +140,74 @@ func (r RepositoryInfo) ValidateInfo() error {
 	if r.Visibility != nil {
 		validator.Append(ValidateRepositoryVisibility(*r.Visibility), *r.Visibility, "Visibility")
 	}
+	for _, topic := range r.Topics {
+		if topic == "" {
+			validator.Invalid(topic, "Topics")
+		}
+	}
+	// Validate the MergeMethod enums, of which at least one is required
+	if r.AllowedMergeMethods != nil && len(r.AllowedMergeMethods) == 0 {
+		validator.Required("AllowedMergeMethods")
+	}
+	for _, method := range r.AllowedMergeMethods {
+		validator.Append(ValidateMergeMethod(method), method, "AllowedMergeMethods")
+	}
 	return validator.Error()
 }
 
 // Equals can be used to check if this *Info request (the desired state) matches the actual
-// passed in as the argument.
+// passed in as the argument. Unset settings, like Topics, aren't compared.
 func (r RepositoryInfo) Equals(actual InfoRequest) bool {
-	return reflect.DeepEqual(r, actual)
+	return len(r.Diff(actual)) == 0
+}
+
+// Diff lists the fields in which this *Info request (the desired state) differs from the actual
+// passed in as the argument. Unset settings, like Topics, aren't compared.
+func (r RepositoryInfo) Diff(actual InfoRequest) []FieldChange {
+	if a, ok := actual.(RepositoryInfo); ok {
+		actual = r.withUnsetSettings(a)
+	}
+	return diffFields(r, actual)
+}
+
+// withUnsetSettings returns a copy of actual with the settings unset in r unset too, so that they
+// aren't compared. Lists which are equal to those of r, ignoring the order, are set to the ones
+// of r.
+func (r RepositoryInfo) withUnsetSettings(actual RepositoryInfo) RepositoryInfo {
+	if r.Topics == nil || sameStrings(r.Topics, actual.Topics) {
+		actual.Topics = r.Topics
+	}
+	if r.Homepage == nil {
+		actual.Homepage = nil
+	}
+	if r.Archived == nil {
+		actual.Archived = nil
+	}
+	if r.HasIssues == nil {
+		actual.HasIssues = nil
+	}
+	if r.HasWiki == nil {
+		actual.HasWiki = nil
+	}
+	if r.HasProjects == nil {
+		actual.HasProjects = nil
+	}
+	if r.AllowedMergeMethods == nil || sameMergeMethods(r.AllowedMergeMethods, actual.AllowedMergeMethods) {
+		actual.AllowedMergeMethods = r.AllowedMergeMethods
+	}
+	if r.DeleteBranchOnMerge == nil {
+		actual.DeleteBranchOnMerge = nil
+	}
+	if r.IsTemplate == nil {
+		actual.IsTemplate = nil
+	}
+	return actual
 }
 
-// TeamAccessInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+// TeamAccessInfo implements InfoRequest, DiffableInfoRequest and DefaultedInfoRequest (with a pointer receiver).
 var _ InfoRequest = TeamAccessInfo{}
 var _ DefaultedInfoRequest = &TeamAccessInfo{}
+var _ DiffableInfoRequest = TeamAccessInfo{}
 
 // TeamAccessInfo contains high-level information about a team's access to a repository.
 type TeamAccessInfo struct {
@@ -130,9 +249,69 @@ func (ta TeamAccessInfo) Equals(actual InfoRequest) bool {
 	return reflect.DeepEqual(ta, actual)
 }
 
-// DeployKeyInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+// Diff lists the fields in which this *Info request (the desired state) differs from the actual
+// passed in as the argument.
+func (ta TeamAccessInfo) Diff(actual InfoRequest) []FieldChange {
+	return diffFields(ta, actual)
+}
+
+// CollaboratorInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = CollaboratorInfo{}
+var _ DefaultedInfoRequest = &CollaboratorInfo{}
+
+// CollaboratorInfo contains high-level information about a user's direct access to a repository.
+type CollaboratorInfo struct {
+	// Login is the user name of the collaborator.
+	// +required
+	Login string `json:"login"`
+
+	// Permission describes the permission level for which the user is allowed to operate.
+	// Default: pull.
+	// Available options: See the RepositoryPermission enum.
+	// +optional
+	Permission *RepositoryPermission `json:"permission,omitempty"`
+
+	// Pending is true if the user has been invited to the repository, but hasn't accepted
+	// the invitation yet. Pending is read-only, and ignored when comparing the desired state.
+	Pending bool `json:"pending,omitempty"`
+}
+
+// Default defaults the Collaborator fields.
+func (c *CollaboratorInfo) Default() {
+	if c.Permission == nil {
+		c.Permission = RepositoryPermissionVar(defaultRepoPermission)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (c CollaboratorInfo) ValidateInfo() error {
+	validator := validation.New("Collaborator")
+	// Make sure we've set the login of the user
+	if len(c.Login) == 0 {
+		validator.Required("Login")
+	}
+	// Validate the Permission enum
+	if c.Permission != nil {
+		validator.Append(ValidateRepositoryPermission(*c.Permission), *c.Permission, "Permission")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. Pending is not compared, as it isn't part of the desired state.
+func (c CollaboratorInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(CollaboratorInfo)
+	if !ok {
+		return false
+	}
+	c.Pending, a.Pending = false, false
+	return reflect.DeepEqual(c, a)
+}
+
+// DeployKeyInfo implements InfoRequest, DiffableInfoRequest and DefaultedInfoRequest (with a pointer receiver).
 var _ InfoRequest = DeployKeyInfo{}
 var _ DefaultedInfoRequest = &DeployKeyInfo{}
+var _ DiffableInfoRequest = DeployKeyInfo{}
 
 // DeployKeyInfo contains high-level information about a deploy key.
 type DeployKeyInfo struct {
@@ -179,9 +358,16 @@ func (dk DeployKeyInfo) Equals(actual InfoRequest) bool {
 	return reflect.DeepEqual(dk, actual)
 }
 
-// DeployTokenInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+// Diff lists the fields in which this *Info request (the desired state) differs from the actual
+// passed in as the argument.
+func (dk DeployKeyInfo) Diff(actual InfoRequest) []FieldChange {
+	return diffFields(dk, actual)
+}
+
+// DeployTokenInfo implements InfoRequest, DiffableInfoRequest and DefaultedInfoRequest (with a pointer receiver).
 var _ InfoRequest = DeployTokenInfo{}
 var _ DefaultedInfoRequest = &DeployTokenInfo{}
+var _ DiffableInfoRequest = DeployTokenInfo{}
 
 // DeployTokenInfo contains high-level information about a deploy token.
 type DeployTokenInfo struct {
@@ -220,6 +406,368 @@ func (dk DeployTokenInfo) Equals(actual InfoRequest) bool {
 	return reflect.DeepEqual(dk, actual)
 }
 
+// Diff lists the fields in which this *Info request (the desired state) differs from the actual
+// passed in as the argument. Token values are redacted, as they are secret.
+func (dk DeployTokenInfo) Diff(actual InfoRequest) []FieldChange {
+	changes := diffFields(dk, actual)
+	for i := range changes {
+		if changes[i].Path == "token" {
+			changes[i].Old, changes[i].New = redactToken(changes[i].Old), redactToken(changes[i].New)
+		}
+	}
+	return changes
+}
+
+// redactToken replaces a set token value of a FieldChange with a placeholder.
+func redactToken(v interface{}) interface{} {
+	if v == "" {
+		return v
+	}
+	return "<redacted>"
+}
+
+// WebhookInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = WebhookInfo{}
+var _ DefaultedInfoRequest = &WebhookInfo{}
+
+// WebhookInfo contains high-level information about a repository webhook.
+type WebhookInfo struct {
+	// URL is the endpoint the Git provider delivers events to. It identifies the webhook
+	// within the repository.
+	// +required
+	URL string `json:"url"`
+
+	// Secret is used to sign (or, in GitLab's case, is sent along with) every delivery, so that
+	// the receiver can verify the sender. Git providers never return the secret, hence it is
+	// always nil when returned from the server, and not taken into account by Equals.
+	// +optional
+	Secret *string `json:"secret,omitempty"`
+
+	// ContentType specifies how the payload is encoded.
+	// Default value at POST-time: WebhookContentTypeJSON.
+	// Available options: See the WebhookContentType enum.
+	// +optional
+	ContentType *WebhookContentType `json:"contentType,omitempty"`
+
+	// Events is the set of events the webhook is subscribed to.
+	// Default value at POST-time: [WebhookEventPush].
+	// Available options: See the WebhookEvent enum.
+	// +optional
+	Events []WebhookEvent `json:"events,omitempty"`
+
+	// InsecureSSL disables TLS certificate verification when delivering events.
+	// Default value at POST-time: false.
+	// +optional
+	InsecureSSL *bool `json:"insecureSSL,omitempty"`
+
+	// Active specifies whether events are delivered at all.
+	// Default value at POST-time: true.
+	// +optional
+	Active *bool `json:"active,omitempty"`
+}
+
+// Default defaults the Webhook fields.
+func (wh *WebhookInfo) Default() {
+	if wh.ContentType == nil {
+		wh.ContentType = WebhookContentTypeVar(defaultWebhookContentType)
+	}
+	if len(wh.Events) == 0 {
+		wh.Events = []WebhookEvent{defaultWebhookEvent}
+	}
+	wh.Events = normalizeWebhookEvents(wh.Events)
+	if wh.InsecureSSL == nil {
+		wh.InsecureSSL = BoolVar(false)
+	}
+	if wh.Active == nil {
+		wh.Active = BoolVar(true)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (wh WebhookInfo) ValidateInfo() error {
+	validator := validation.New("Webhook")
+	// Make sure we've set the URL of the webhook
+	if len(wh.URL) == 0 {
+		validator.Required("URL")
+	}
+	// Validate the ContentType enum
+	if wh.ContentType != nil {
+		validator.Append(ValidateWebhookContentType(*wh.ContentType), *wh.ContentType, "ContentType")
+	}
+	// Validate the Events enum
+	for _, event := range wh.Events {
+		validator.Append(ValidateWebhookEvent(event), event, "Events")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The order of Events is not significant, and Secret is ignored,
+// as it can't be read back from the server.
+func (wh WebhookInfo) Equals(actual InfoRequest) bool {
+	other, ok := actual.(WebhookInfo)
+	if !ok {
+		return false
+	}
+	wh.Secret, other.Secret = nil, nil
+	wh.Events = normalizeWebhookEvents(wh.Events)
+	other.Events = normalizeWebhookEvents(other.Events)
+	return reflect.DeepEqual(wh, other)
+}
+
+// normalizeWebhookEvents returns a sorted copy of events without duplicates.
+func normalizeWebhookEvents(events []WebhookEvent) []WebhookEvent {
+	if events == nil {
+		return nil
+	}
+	seen := make(map[WebhookEvent]struct{}, len(events))
+	out := make([]WebhookEvent, 0, len(events))
+	for _, event := range events {
+		if _, ok := seen[event]; ok {
+			continue
+		}
+		seen[event] = struct{}{}
+		out = append(out, event)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// CommitStatusInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = CommitStatusInfo{}
+var _ DefaultedInfoRequest = &CommitStatusInfo{}
+
+// CommitStatusInfo contains high-level information about a commit status, e.g. reported by CI.
+type CommitStatusInfo struct {
+	// State is the state of the status.
+	// Available options: See the CommitStatusState enum.
+	// +required
+	State CommitStatusState `json:"state"`
+
+	// Context differentiates this status from the statuses reported by other systems,
+	// e.g. "ci/build". Newer statuses replace older ones with the same context in the
+	// combined status.
+	// Default: "default".
+	// +optional
+	Context string `json:"context"`
+
+	// Description is a short, human-readable description of the status.
+	// +optional
+	Description string `json:"description"`
+
+	// TargetURL links to the details of the status, e.g. the CI build log.
+	// +optional
+	TargetURL string `json:"targetURL"`
+
+	// CreatedAt is the time the status was created, set by the server.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Default defaults the CommitStatus fields.
+func (cs *CommitStatusInfo) Default() {
+	if cs.Context == "" {
+		cs.Context = defaultCommitStatusContext
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (cs CommitStatusInfo) ValidateInfo() error {
+	validator := validation.New("CommitStatus")
+	// Make sure we've set a valid state
+	if len(cs.State) == 0 {
+		validator.Required("State")
+	} else {
+		validator.Append(ValidateCommitStatusState(cs.State), cs.State, "State")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (cs CommitStatusInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(cs, actual)
+}
+
+// CombinedCommitStatusInfo contains the combined status of a commit, made up of the
+// latest status of every context.
+type CombinedCommitStatusInfo struct {
+	// Sha is the git sha of the commit.
+	Sha string `json:"sha"`
+
+	// State is failure if any context reports error or failure, pending if there are no
+	// statuses or any context is pending, and success otherwise.
+	State CommitStatusState `json:"state"`
+
+	// Statuses holds the latest status of every context, sorted by context.
+	Statuses []CommitStatusInfo `json:"statuses"`
+}
+
+// CombineCommitStatuses computes the combined status of the commit with the given sha,
+// from all of its statuses. For statuses with the same context and creation time, the
+// first one wins, so statuses should be ordered newest first.
+// This is used for providers without an API for the combined status.
+func CombineCommitStatuses(sha string, statuses []CommitStatusInfo) CombinedCommitStatusInfo {
+	latest := map[string]CommitStatusInfo{}
+	for _, status := range statuses {
+		if prev, ok := latest[status.Context]; ok && !status.CreatedAt.After(prev.CreatedAt) {
+			continue
+		}
+		latest[status.Context] = status
+	}
+
+	combined := CombinedCommitStatusInfo{
+		Sha:      sha,
+		Statuses: make([]CommitStatusInfo, 0, len(latest)),
+	}
+	for _, status := range latest {
+		combined.Statuses = append(combined.Statuses, status)
+	}
+	sort.Slice(combined.Statuses, func(i, j int) bool {
+		return combined.Statuses[i].Context < combined.Statuses[j].Context
+	})
+	combined.State = combinedCommitStatusState(combined.Statuses)
+	return combined
+}
+
+// combinedCommitStatusState returns the combined state of the latest statuses of a commit.
+func combinedCommitStatusState(statuses []CommitStatusInfo) CommitStatusState {
+	if len(statuses) == 0 {
+		return CommitStatusStatePending
+	}
+	state := CommitStatusStateSuccess
+	for _, status := range statuses {
+		switch status.State {
+		case CommitStatusStateFailure, CommitStatusStateError:
+			return CommitStatusStateFailure
+		case CommitStatusStatePending:
+			state = CommitStatusStatePending
+		}
+	}
+	return state
+}
+
+// BranchProtectionInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = BranchProtectionInfo{}
+var _ DefaultedInfoRequest = &BranchProtectionInfo{}
+
+// BranchProtectionInfo contains high-level information about the protection of a branch.
+// Git providers support different subsets of these rules; setting a rule the provider can't
+// enforce results in ErrNoProviderSupport.
+type BranchProtectionInfo struct {
+	// Branch is the name of the protected branch. It identifies the branch protection
+	// within the repository.
+	// +required
+	Branch string `json:"branch"`
+
+	// RequiredApprovingReviewCount is the number of approving reviews a pull request
+	// needs before it can be merged into the branch. Zero means reviews aren't required.
+	// Default value at POST-time: 0.
+	// +optional
+	RequiredApprovingReviewCount *int `json:"requiredApprovingReviewCount,omitempty"`
+
+	// RequiredStatusChecks is the set of commit status contexts which must succeed
+	// before a pull request can be merged into the branch.
+	// +optional
+	RequiredStatusChecks []string `json:"requiredStatusChecks,omitempty"`
+
+	// RestrictPushes specifies whether pushing to the branch is restricted to the users
+	// in PushAllowances. If unset, everyone with write access may push.
+	// Default value at POST-time: false.
+	// +optional
+	RestrictPushes *bool `json:"restrictPushes,omitempty"`
+
+	// PushAllowances is the set of logins of the users allowed to push to the branch
+	// if RestrictPushes is set.
+	// +optional
+	PushAllowances []string `json:"pushAllowances,omitempty"`
+
+	// AllowForcePushes specifies whether force-pushes to the branch are allowed.
+	// Default value at POST-time: false.
+	// +optional
+	AllowForcePushes *bool `json:"allowForcePushes,omitempty"`
+
+	// AllowDeletions specifies whether the branch may be deleted.
+	// Default value at POST-time: false.
+	// +optional
+	AllowDeletions *bool `json:"allowDeletions,omitempty"`
+
+	// IncludeAdmins specifies whether the rules above are enforced for administrators too.
+	// Default value at POST-time: false.
+	// +optional
+	IncludeAdmins *bool `json:"includeAdmins,omitempty"`
+}
+
+// Default defaults the BranchProtection fields.
+func (bp *BranchProtectionInfo) Default() {
+	if bp.RequiredApprovingReviewCount == nil {
+		bp.RequiredApprovingReviewCount = IntVar(0)
+	}
+	bp.RequiredStatusChecks = normalizeStrings(bp.RequiredStatusChecks)
+	if bp.RestrictPushes == nil {
+		bp.RestrictPushes = BoolVar(false)
+	}
+	bp.PushAllowances = normalizeStrings(bp.PushAllowances)
+	if bp.AllowForcePushes == nil {
+		bp.AllowForcePushes = BoolVar(false)
+	}
+	if bp.AllowDeletions == nil {
+		bp.AllowDeletions = BoolVar(false)
+	}
+	if bp.IncludeAdmins == nil {
+		bp.IncludeAdmins = BoolVar(false)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (bp BranchProtectionInfo) ValidateInfo() error {
+	validator := validation.New("BranchProtection")
+	// Make sure we've set the branch name
+	if len(bp.Branch) == 0 {
+		validator.Required("Branch")
+	}
+	// A negative amount of reviews doesn't make sense
+	if bp.RequiredApprovingReviewCount != nil && *bp.RequiredApprovingReviewCount < 0 {
+		validator.Invalid(*bp.RequiredApprovingReviewCount, "RequiredApprovingReviewCount")
+	}
+	// Push allowances only have an effect if pushes are restricted
+	if len(bp.PushAllowances) != 0 && (bp.RestrictPushes == nil || !*bp.RestrictPushes) {
+		validator.Invalid(bp.PushAllowances, "PushAllowances")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument. The order of RequiredStatusChecks and PushAllowances is not significant.
+func (bp BranchProtectionInfo) Equals(actual InfoRequest) bool {
+	other, ok := actual.(BranchProtectionInfo)
+	if !ok {
+		return false
+	}
+	bp.RequiredStatusChecks = normalizeStrings(bp.RequiredStatusChecks)
+	other.RequiredStatusChecks = normalizeStrings(other.RequiredStatusChecks)
+	bp.PushAllowances = normalizeStrings(bp.PushAllowances)
+	other.PushAllowances = normalizeStrings(other.PushAllowances)
+	return reflect.DeepEqual(bp, other)
+}
+
+// normalizeStrings returns a sorted copy of values without duplicates, or nil if values is empty.
+func normalizeStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(values))
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		out = append(out, value)
+	}
+	sort.Strings(out)
+	return out
+}
+
 // CommitInfo contains high-level information about a deploy key.
 type CommitInfo struct {
 	// Sha is the git sha for this commit.
@@ -243,6 +791,148 @@ type CommitInfo struct {
 	URL string `json:"url"`
 }
 
+// BranchInfo contains high-level information about a branch.
+type BranchInfo struct {
+	// Name is the name of the branch, e.g. "main".
+	Name string `json:"name"`
+
+	// Sha is the git sha of the commit at the head of the branch.
+	Sha string `json:"sha"`
+
+	// Protected specifies whether the branch is protected, see BranchProtectionClient.
+	Protected bool `json:"protected"`
+}
+
+// TagInfo implements InfoRequest.
+var _ InfoRequest = TagInfo{}
+
+// TagInfo contains high-level information about a tag.
+type TagInfo struct {
+	// Name is the name of the tag, e.g. "v1.0.0".
+	// +required
+	Name string `json:"name"`
+
+	// Sha is the git sha of the commit the tag points to.
+	// +required
+	Sha string `json:"sha"`
+
+	// Message is the message of an annotated tag. Tags without a message are lightweight tags,
+	// i.e. plain references to the commit.
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// ValidateInfo validates the object at POST-time.
+func (t TagInfo) ValidateInfo() error {
+	validator := validation.New("Tag")
+	if len(t.Name) == 0 {
+		validator.Required("Name")
+	}
+	if len(t.Sha) == 0 {
+		validator.Required("Sha")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (t TagInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(t, actual)
+}
+
+// ReleaseInfo implements InfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = ReleaseInfo{}
+var _ DefaultedInfoRequest = &ReleaseInfo{}
+
+// ReleaseInfo contains high-level information about a release.
+type ReleaseInfo struct {
+	// TagName is the name of the tag the release is made from. It identifies the release
+	// within the repository.
+	// +required
+	TagName string `json:"tagName"`
+
+	// Target is the branch or commit sha the tag is created from, if it doesn't exist yet.
+	// It is only used when creating the release, hence it is always nil when returned from
+	// the server, and not taken into account by Equals.
+	// Default value at POST-time: the default branch of the repository.
+	// +optional
+	Target *string `json:"target,omitempty"`
+
+	// Name is the title of the release.
+	// Default value at POST-time: TagName.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Description is the release notes of the release.
+	// Default value at POST-time: "".
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Draft specifies whether the release is unpublished.
+	// Default value at POST-time: false.
+	// +optional
+	Draft *bool `json:"draft,omitempty"`
+
+	// Prerelease specifies whether the release is marked as not ready for production.
+	// Default value at POST-time: false.
+	// +optional
+	Prerelease *bool `json:"prerelease,omitempty"`
+
+	// Assets are the files attached to the release, set by the server. Files are attached using
+	// Release.UploadAsset. Assets are ignored when updating the release, and not taken into
+	// account by Equals.
+	Assets []ReleaseAssetInfo `json:"assets,omitempty"`
+}
+
+// Default defaults the Release fields.
+func (r *ReleaseInfo) Default() {
+	if r.Name == nil {
+		r.Name = StringVar(r.TagName)
+	}
+	if r.Description == nil {
+		r.Description = StringVar("")
+	}
+	if r.Draft == nil {
+		r.Draft = BoolVar(false)
+	}
+	if r.Prerelease == nil {
+		r.Prerelease = BoolVar(false)
+	}
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (r ReleaseInfo) ValidateInfo() error {
+	validator := validation.New("Release")
+	if len(r.TagName) == 0 {
+		validator.Required("TagName")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (r ReleaseInfo) Equals(actual InfoRequest) bool {
+	a, ok := actual.(ReleaseInfo)
+	if !ok {
+		return false
+	}
+	r.Target, a.Target = nil, nil
+	r.Assets, a.Assets = nil, nil
+	return reflect.DeepEqual(r, a)
+}
+
+// ReleaseAssetInfo contains high-level information about a file attached to a release.
+type ReleaseAssetInfo struct {
+	// Name is the file name of the asset.
+	Name string `json:"name"`
+
+	// URL is the link to download the asset.
+	URL string `json:"url"`
+
+	// Size is the size of the asset in bytes. It is zero if the provider doesn't report it.
+	Size int64 `json:"size"`
+}
+
 // CommitFile contains high-level information about a file added to a commit.
 type CommitFile struct {
 	// Path is path where this file is located.
@@ -274,6 +964,346 @@ type PullRequestInfo struct {
 
 	// SourceBranch is the branch from which the pull request has been created.
 	SourceBranch string `json:"source_branch"`
+
+	// TargetBranch is the branch the pull request is merged into.
+	TargetBranch string `json:"target_branch"`
+
+	// HeadSha is the git sha of the latest commit on the source branch.
+	HeadSha string `json:"head_sha"`
+
+	// BaseSha is the git sha of the commit on the target branch the changes are compared to.
+	// Providers differ in whether this is the merge base or the head of the target branch.
+	BaseSha string `json:"base_sha"`
+
+	// State is the state of the pull request, i.e. open, closed or merged.
+	State PullRequestState `json:"state"`
+
+	// Draft specifies whether the pull request is marked as a draft (or work in progress).
+	Draft bool `json:"draft"`
+
+	// Author is the login of the user that created the pull request.
+	Author string `json:"author"`
+
+	// Labels are the names of the labels attached to the pull request.
+	Labels []string `json:"labels,omitempty"`
+
+	// Assignees are the logins of the users assigned to the pull request.
+	Assignees []string `json:"assignees,omitempty"`
+
+	// Reviewers are the logins of the users a review of the pull request is requested from.
+	// Not all providers return them, e.g. Gitea doesn't.
+	Reviewers []string `json:"reviewers,omitempty"`
+
+	// CreatedAt is the time the pull request was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time the pull request was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// MergedAt is the time the pull request was merged, nil if it isn't merged.
+	MergedAt *time.Time `json:"merged_at,omitempty"`
+
+	// Mergeable specifies whether the pull request can be merged without conflicts.
+	// It is nil if the provider hasn't computed it (yet), or if the pull request isn't open.
+	Mergeable *bool `json:"mergeable,omitempty"`
+
+	// AutoMerge specifies whether the pull request is merged automatically once its requirements
+	// are met, see PullRequestClient.EnableAutoMerge. Not all providers return it, e.g. Gitea doesn't.
+	AutoMerge bool `json:"auto_merge"`
+}
+
+// PullRequestMergeResult contains the outcome of merging a pull request.
+type PullRequestMergeResult struct {
+	// Sha is the git sha of the commit the base branch points to after the merge, i.e. the
+	// merge commit, the squashed commit or the last rebased commit.
+	// In a dry run, it's the head of the pull request, as the commit the merge would create
+	// isn't known.
+	Sha string `json:"sha"`
+}
+
+// PullRequestFile contains high-level information about a file changed by a pull request.
+type PullRequestFile struct {
+	// Path is the path of the file in the source branch, or the removed path if the file
+	// was removed.
+	Path string `json:"path"`
+
+	// PreviousPath is the path of the file in the target branch if it was renamed.
+	PreviousPath string `json:"previous_path,omitempty"`
+
+	// Status describes how the file was changed.
+	Status PullRequestFileStatus `json:"status"`
+
+	// Additions is the number of added lines.
+	Additions int `json:"additions"`
+
+	// Deletions is the number of removed lines.
+	Deletions int `json:"deletions"`
+
+	// Patch contains the unified diff hunks of the file. It is empty for binary files, if
+	// the diff is too large to be returned, or if the provider doesn't return it, e.g. Gitea
+	// and Bitbucket Cloud don't.
+	Patch string `json:"patch,omitempty"`
+}
+
+// PullRequestFiles contains the files changed by a pull request.
+type PullRequestFiles struct {
+	// Files are the changed files, in the order returned by the provider.
+	Files []PullRequestFile `json:"files"`
+
+	// Truncated specifies whether the provider capped the result, e.g. GitHub returns up to
+	// 3000 files, so that Files doesn't contain all changes of the pull request.
+	Truncated bool `json:"truncated"`
+}
+
+// PullRequestCommentInfo implements InfoRequest.
+var _ InfoRequest = PullRequestCommentInfo{}
+
+// PullRequestCommentInfo contains high-level information about a comment in the conversation
+// of a pull request. Comments on specific lines of the diff aren't included.
+type PullRequestCommentInfo struct {
+	// ID is the identifier of the comment, set by the server.
+	ID int64 `json:"id"`
+
+	// Body is the text of the comment.
+	// +required
+	Body string `json:"body"`
+
+	// Author is the login of the user that wrote the comment, set by the server.
+	Author string `json:"author"`
+
+	// CreatedAt is the time the comment was created, set by the server.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is the time the comment was last edited, set by the server.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (pc PullRequestCommentInfo) ValidateInfo() error {
+	validator := validation.New("PullRequestComment")
+	if len(pc.Body) == 0 {
+		validator.Required("Body")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (pc PullRequestCommentInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(pc, actual)
+}
+
+// PullRequestReviewInfo implements InfoRequest.
+var _ InfoRequest = PullRequestReviewInfo{}
+
+// PullRequestReviewInfo contains high-level information about a review of a pull request.
+type PullRequestReviewInfo struct {
+	// ID is the identifier of the review, set by the server. Providers that don't store
+	// reviews as separate objects leave it empty.
+	ID int64 `json:"id"`
+
+	// State is the outcome of the review. Dismissed reviews can't be submitted.
+	// Available options: See the PullRequestReviewState enum.
+	// +required
+	State PullRequestReviewState `json:"state"`
+
+	// Body is the text of the review. It is required when requesting changes or commenting.
+	// +optional
+	Body string `json:"body"`
+
+	// Author is the login of the reviewer, set by the server.
+	Author string `json:"author"`
+
+	// CommitSha is the git sha of the commit that was reviewed, set by the server if the
+	// provider returns it.
+	CommitSha string `json:"commitSha"`
+
+	// SubmittedAt is the time the review was submitted, set by the server if the provider returns it.
+	SubmittedAt *time.Time `json:"submittedAt,omitempty"`
+}
+
+// ValidateInfo validates the object at POST-time.
+func (pr PullRequestReviewInfo) ValidateInfo() error {
+	validator := validation.New("PullRequestReview")
+	if len(pr.State) == 0 {
+		validator.Required("State")
+	} else if pr.State == PullRequestReviewStateDismissed {
+		validator.Invalid(pr.State, "State")
+	} else {
+		validator.Append(ValidatePullRequestReviewState(pr.State), pr.State, "State")
+	}
+	if len(pr.Body) == 0 && (pr.State == PullRequestReviewStateChangesRequested || pr.State == PullRequestReviewStateCommented) {
+		validator.Required("Body")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (pr PullRequestReviewInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(pr, actual)
+}
+
+// IssueInfo implements InfoRequest.
+var _ InfoRequest = IssueInfo{}
+
+// IssueInfo contains high-level information about an issue.
+type IssueInfo struct {
+	// Number is the number of the issue, set by the server.
+	Number int `json:"number"`
+
+	// Title is the title of the issue.
+	// +required
+	Title string `json:"title"`
+
+	// Description is the description of the issue.
+	// +optional
+	Description string `json:"description"`
+
+	// State is the state of the issue, set by the server. Issues are created open, and can be
+	// closed and reopened using IssueClient.
+	State IssueState `json:"state"`
+
+	// Labels are the names of the labels of the issue. The labels must exist in the repository.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// Assignees are the logins of the users assigned to the issue.
+	// +optional
+	Assignees []string `json:"assignees,omitempty"`
+
+	// Milestone is the title of the milestone of the issue, if any. The milestone must exist
+	// in the repository.
+	// +optional
+	Milestone string `json:"milestone,omitempty"`
+
+	// Author is the login of the user that opened the issue, set by the server.
+	Author string `json:"author"`
+
+	// WebURL is the URL of the issue in the web UI of the provider, set by the server.
+	WebURL string `json:"web_url"`
+
+	// CreatedAt is the time the issue was opened, set by the server.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is the time the issue was last changed, set by the server.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// ClosedAt is the time the issue was closed, set by the server while it's closed.
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+}
+
+// ValidateInfo validates the object at POST-time.
+func (ii IssueInfo) ValidateInfo() error {
+	validator := validation.New("Issue")
+	if len(ii.Title) == 0 {
+		validator.Required("Title")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (ii IssueInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(ii, actual)
+}
+
+// IssueCommentInfo implements InfoRequest.
+var _ InfoRequest = IssueCommentInfo{}
+
+// IssueCommentInfo contains high-level information about a comment on an issue.
+type IssueCommentInfo struct {
+	// ID is the identifier of the comment, set by the server.
+	ID int64 `json:"id"`
+
+	// Body is the text of the comment.
+	// +required
+	Body string `json:"body"`
+
+	// Author is the login of the user that wrote the comment, set by the server.
+	Author string `json:"author"`
+
+	// CreatedAt is the time the comment was created, set by the server.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is the time the comment was last edited, set by the server.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (ic IssueCommentInfo) ValidateInfo() error {
+	validator := validation.New("IssueComment")
+	if len(ic.Body) == 0 {
+		validator.Required("Body")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (ic IssueCommentInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(ic, actual)
+}
+
+// labelColorRegexp matches normalized label colors, see LabelInfo.Color.
+var labelColorRegexp = regexp.MustCompile("^[0-9a-f]{6}$")
+
+// LabelInfo implements InfoRequest, DiffableInfoRequest and DefaultedInfoRequest (with a pointer receiver).
+var _ InfoRequest = LabelInfo{}
+var _ DefaultedInfoRequest = &LabelInfo{}
+var _ DiffableInfoRequest = LabelInfo{}
+
+// LabelInfo contains high-level information about a label of a repository.
+type LabelInfo struct {
+	// Name is the name of the label. It identifies the label within the repository.
+	// +required
+	Name string `json:"name"`
+
+	// Color is the color of the label as six hexadecimal digits, e.g. "d73a4a". A leading "#"
+	// and upper-case digits are allowed, they're normalized away by Default.
+	// +required
+	Color string `json:"color"`
+
+	// Description is a short description of the label.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// Default defaults the Label fields.
+func (l *LabelInfo) Default() {
+	l.Color = normalizeLabelColor(l.Color)
+}
+
+// ValidateInfo validates the object at {Object}.Set() and POST-time.
+func (l LabelInfo) ValidateInfo() error {
+	validator := validation.New("Label")
+	// Make sure we've set the name of the label
+	if len(l.Name) == 0 {
+		validator.Required("Name")
+	}
+	if len(l.Color) == 0 {
+		validator.Required("Color")
+	} else if !labelColorRegexp.MatchString(normalizeLabelColor(l.Color)) {
+		validator.Invalid(l.Color, "Color")
+	}
+	return validator.Error()
+}
+
+// Equals can be used to check if this *Info request (the desired state) matches the actual
+// passed in as the argument.
+func (l LabelInfo) Equals(actual InfoRequest) bool {
+	return reflect.DeepEqual(l, actual)
+}
+
+// Diff lists the fields in which this *Info request (the desired state) differs from the actual
+// passed in as the argument.
+func (l LabelInfo) Diff(actual InfoRequest) []FieldChange {
+	return diffFields(l, actual)
+}
+
+// normalizeLabelColor removes the leading "#" of color, and makes its digits lower-case.
+func normalizeLabelColor(color string) string {
+	return strings.ToLower(strings.TrimPrefix(color, "#"))
 }
 
 // TreeEntry contains info about each tree object's structure in TreeInfo whether it is a file or tree
@@ -307,3 +1337,44 @@ type TreeInfo struct {
 	// If truncated is true in the response when fetching a tree, then the number of items in the tree array exceeded the maximum limit
 	Truncated bool `json:"truncated"`
 }
+
+// ReconcileChange describes a change made, or with PlanOnly planned, by a set-level reconcile.
+type ReconcileChange struct {
+	// Action specifies whether the object is created, updated or deleted.
+	Action ReconcileAction `json:"action"`
+
+	// Name identifies the object within the set, e.g. the name of a team or a deploy key.
+	Name string `json:"name"`
+
+	// Fields lists the changed fields of updated objects.
+	// +optional
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// ReconcileReport describes the result of a set-level reconcile, such as TeamAccessClient.ReconcileAll.
+type ReconcileReport struct {
+	// PlanOnly is true if the changes were only computed, and not applied.
+	PlanOnly bool `json:"planOnly"`
+
+	// Changes lists the changes in the order they are applied. If the reconcile failed part way,
+	// only the changes applied before the error are listed.
+	Changes []ReconcileChange `json:"changes"`
+}
+
+// ActionTaken returns true if anything was changed, or with PlanOnly would be changed.
+func (r ReconcileReport) ActionTaken() bool {
+	return len(r.Changes) != 0
+}
+
+// FieldChange describes a field in which the actual state differs from the desired state, as
+// returned by DiffableInfoRequest.Diff and the ReconcileWithChanges methods of the clients.
+type FieldChange struct {
+	// Path is the JSON name of the field, e.g. "visibility".
+	Path string `json:"path"`
+
+	// Old is the actual value of the field, or nil if it isn't set.
+	Old interface{} `json:"old"`
+
+	// New is the desired value of the field, or nil if it isn't set.
+	New interface{} `json:"new"`
+}
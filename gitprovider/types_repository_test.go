@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitprovider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCombineCommitStatuses(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		statuses []CommitStatusInfo
+		expected CombinedCommitStatusInfo
+	}{
+		{
+			name:     "no statuses",
+			expected: CombinedCommitStatusInfo{Sha: "abc", State: CommitStatusStatePending, Statuses: []CommitStatusInfo{}},
+		},
+		{
+			name: "latest status of a context wins",
+			statuses: []CommitStatusInfo{
+				{State: CommitStatusStatePending, Context: "build", CreatedAt: now.Add(-time.Minute)},
+				{State: CommitStatusStateSuccess, Context: "build", CreatedAt: now},
+			},
+			expected: CombinedCommitStatusInfo{
+				Sha:   "abc",
+				State: CommitStatusStateSuccess,
+				Statuses: []CommitStatusInfo{
+					{State: CommitStatusStateSuccess, Context: "build", CreatedAt: now},
+				},
+			},
+		},
+		{
+			name: "first status wins on ties",
+			statuses: []CommitStatusInfo{
+				{State: CommitStatusStateError, Context: "build", CreatedAt: now},
+				{State: CommitStatusStateSuccess, Context: "build", CreatedAt: now},
+			},
+			expected: CombinedCommitStatusInfo{
+				Sha:   "abc",
+				State: CommitStatusStateFailure,
+				Statuses: []CommitStatusInfo{
+					{State: CommitStatusStateError, Context: "build", CreatedAt: now},
+				},
+			},
+		},
+		{
+			name: "pending if any context is pending",
+			statuses: []CommitStatusInfo{
+				{State: CommitStatusStateSuccess, Context: "lint", CreatedAt: now},
+				{State: CommitStatusStatePending, Context: "build", CreatedAt: now},
+			},
+			expected: CombinedCommitStatusInfo{
+				Sha:   "abc",
+				State: CommitStatusStatePending,
+				Statuses: []CommitStatusInfo{
+					{State: CommitStatusStatePending, Context: "build", CreatedAt: now},
+					{State: CommitStatusStateSuccess, Context: "lint", CreatedAt: now},
+				},
+			},
+		},
+		{
+			name: "failure takes precedence over pending",
+			statuses: []CommitStatusInfo{
+				{State: CommitStatusStatePending, Context: "build", CreatedAt: now},
+				{State: CommitStatusStateFailure, Context: "lint", CreatedAt: now},
+			},
+			expected: CombinedCommitStatusInfo{
+				Sha:   "abc",
+				State: CommitStatusStateFailure,
+				Statuses: []CommitStatusInfo{
+					{State: CommitStatusStatePending, Context: "build", CreatedAt: now},
+					{State: CommitStatusStateFailure, Context: "lint", CreatedAt: now},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CombineCommitStatuses("abc", tt.statuses)
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("CombineCommitStatuses() returned diff (want -> got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInfoDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  DiffableInfoRequest
+		actual   InfoRequest
+		expected []FieldChange
+	}{
+		{
+			name:    "equal repositories",
+			desired: RepositoryInfo{Visibility: RepositoryVisibilityVar(RepositoryVisibilityPrivate)},
+			actual:  RepositoryInfo{Visibility: RepositoryVisibilityVar(RepositoryVisibilityPrivate)},
+		},
+		{
+			name: "changed repository fields",
+			desired: RepositoryInfo{
+				Description: StringVar("podinfo"),
+				Visibility:  RepositoryVisibilityVar(RepositoryVisibilityPrivate),
+			},
+			actual: RepositoryInfo{Visibility: RepositoryVisibilityVar(RepositoryVisibilityPublic)},
+			expected: []FieldChange{
+				{Path: "description", Old: nil, New: "podinfo"},
+				{Path: "visibility", Old: RepositoryVisibilityPublic, New: RepositoryVisibilityPrivate},
+			},
+		},
+		{
+			name:    "unset repository settings",
+			desired: RepositoryInfo{Visibility: RepositoryVisibilityVar(RepositoryVisibilityPrivate)},
+			actual: RepositoryInfo{
+				Visibility: RepositoryVisibilityVar(RepositoryVisibilityPrivate),
+				Topics:     []string{"gitops"},
+				Archived:   BoolVar(true),
+				HasWiki:    BoolVar(true),
+			},
+		},
+		{
+			name: "reordered repository settings",
+			desired: RepositoryInfo{
+				Topics:              []string{"gitops", "flux"},
+				AllowedMergeMethods: []MergeMethod{MergeMethodRebase, MergeMethodMerge},
+			},
+			actual: RepositoryInfo{
+				Topics:              []string{"flux", "gitops"},
+				AllowedMergeMethods: []MergeMethod{MergeMethodMerge, MergeMethodRebase},
+			},
+		},
+		{
+			name: "changed repository settings",
+			desired: RepositoryInfo{
+				Topics:   []string{"gitops", "flux"},
+				Homepage: StringVar("https://fluxcd.io"),
+				HasWiki:  BoolVar(false),
+			},
+			actual: RepositoryInfo{
+				Topics:   []string{"gitops"},
+				Homepage: StringVar("https://fluxcd.io"),
+				HasWiki:  BoolVar(true),
+				Archived: BoolVar(false),
+			},
+			expected: []FieldChange{
+				{Path: "topics", Old: []string{"gitops"}, New: []string{"gitops", "flux"}},
+				{Path: "hasWiki", Old: true, New: false},
+			},
+		},
+		{
+			name:    "changed team access permission",
+			desired: TeamAccessInfo{Name: "maintainers", Permission: RepositoryPermissionVar(RepositoryPermissionAdmin)},
+			actual:  TeamAccessInfo{Name: "maintainers", Permission: RepositoryPermissionVar(RepositoryPermissionPush)},
+			expected: []FieldChange{
+				{Path: "permission", Old: RepositoryPermissionPush, New: RepositoryPermissionAdmin},
+			},
+		},
+		{
+			name:    "changed deploy key",
+			desired: DeployKeyInfo{Name: "flux", Key: []byte("ssh-ed25519 BBBB")},
+			actual:  DeployKeyInfo{Name: "flux", Key: []byte("ssh-ed25519 AAAA")},
+			expected: []FieldChange{
+				{Path: "key", Old: "ssh-ed25519 AAAA", New: "ssh-ed25519 BBBB"},
+			},
+		},
+		{
+			name:    "redacted deploy token",
+			desired: DeployTokenInfo{Name: "ci", Username: "ci-2", Token: "secret"},
+			actual:  DeployTokenInfo{Name: "ci", Username: "ci-1"},
+			expected: []FieldChange{
+				{Path: "username", Old: "ci-1", New: "ci-2"},
+				{Path: "token", Old: "", New: "<redacted>"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.desired.Diff(tt.actual)
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("Diff() mismatch (-want +got):\n%s", diff)
+			}
+			if equals := tt.desired.Equals(tt.actual); equals != (len(got) == 0) {
+				t.Errorf("Equals() = %v, but Diff() returned %d changes", equals, len(got))
+			}
+		})
+	}
+}
@@ -125,6 +125,35 @@ func TestRepository_Validate(t *testing.T) {
 			},
 			expectedErrs: []error{validation.ErrFieldEnumInvalid},
 		},
+		{
+			name: "valid create and update, with settings",
+			repo: RepositoryInfo{
+				Topics:              []string{"gitops"},
+				Archived:            BoolVar(false),
+				AllowedMergeMethods: []MergeMethod{MergeMethodSquash, MergeMethodRebase},
+			},
+		},
+		{
+			name: "invalid create and update, empty topic",
+			repo: RepositoryInfo{
+				Topics: []string{"gitops", ""},
+			},
+			expectedErrs: []error{validation.ErrFieldInvalid},
+		},
+		{
+			name: "invalid create and update, no merge methods",
+			repo: RepositoryInfo{
+				AllowedMergeMethods: []MergeMethod{},
+			},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "invalid create and update, invalid merge method",
+			repo: RepositoryInfo{
+				AllowedMergeMethods: []MergeMethod{MergeMethod("fast-forward")},
+			},
+			expectedErrs: []error{validation.ErrFieldEnumInvalid},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -179,3 +208,391 @@ func TestTeamAccess_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestCollaborator_Validate(t *testing.T) {
+	invalidPermission := RepositoryPermission("unknown")
+	tests := []struct {
+		name         string
+		c            CollaboratorInfo
+		expectedErrs []error
+	}{
+		{
+			name: "valid create, required field set",
+			c: CollaboratorInfo{
+				Login: "alice",
+			},
+		},
+		{
+			name:         "invalid create, required login",
+			c:            CollaboratorInfo{},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "valid create, with valid enum",
+			c: CollaboratorInfo{
+				Login:      "alice",
+				Permission: RepositoryPermissionVar(RepositoryPermissionTriage),
+			},
+		},
+		{
+			name: "invalid create, invalid enum",
+			c: CollaboratorInfo{
+				Login:      "alice",
+				Permission: &invalidPermission,
+			},
+			expectedErrs: []error{validation.ErrFieldEnumInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertValidation(t, "Collaborator", tt.c.ValidateInfo, tt.expectedErrs)
+		})
+	}
+}
+
+func TestOrganization_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		org          OrganizationInfo
+		expectedErrs []error
+	}{
+		{
+			name: "valid create, required fields set",
+			org: OrganizationInfo{
+				Name: StringVar("Flux"),
+			},
+		},
+		{
+			name: "valid create, all fields set",
+			org: OrganizationInfo{
+				Name:        StringVar("Flux"),
+				Description: StringVar("The Flux project"),
+				Visibility:  OrganizationVisibilityVar(OrganizationVisibilityInternal),
+			},
+		},
+		{
+			name:         "invalid create, required fields",
+			org:          OrganizationInfo{Name: StringVar("")},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "invalid create, invalid visibility",
+			org: OrganizationInfo{
+				Name:       StringVar("Flux"),
+				Visibility: OrganizationVisibilityVar("hidden"),
+			},
+			expectedErrs: []error{validation.ErrFieldEnumInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertValidation(t, "Organization", tt.org.ValidateInfo, tt.expectedErrs)
+		})
+	}
+}
+
+func TestTeam_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		team         TeamInfo
+		expectedErrs []error
+	}{
+		{
+			name: "valid create, required fields set",
+			team: TeamInfo{
+				Name: "maintainers",
+			},
+		},
+		{
+			name: "valid create, all fields set",
+			team: TeamInfo{
+				Name:        "maintainers",
+				Description: StringVar("The maintainers"),
+				Privacy:     TeamPrivacyVar(TeamPrivacySecret),
+			},
+		},
+		{
+			name:         "invalid create, required fields",
+			team:         TeamInfo{},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "invalid create, invalid privacy",
+			team: TeamInfo{
+				Name:    "maintainers",
+				Privacy: TeamPrivacyVar("public"),
+			},
+			expectedErrs: []error{validation.ErrFieldEnumInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertValidation(t, "Team", tt.team.ValidateInfo, tt.expectedErrs)
+		})
+	}
+}
+
+func TestTeamMember_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		member       TeamMemberInfo
+		expectedErrs []error
+	}{
+		{
+			name: "valid create, required fields set",
+			member: TeamMemberInfo{
+				Login: "fluxbot",
+			},
+		},
+		{
+			name: "valid create, all fields set",
+			member: TeamMemberInfo{
+				Login: "fluxbot",
+				Role:  TeamMemberRoleVar(TeamMemberRoleMaintainer),
+			},
+		},
+		{
+			name:         "invalid create, required fields",
+			member:       TeamMemberInfo{},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "invalid create, invalid role",
+			member: TeamMemberInfo{
+				Login: "fluxbot",
+				Role:  TeamMemberRoleVar("owner"),
+			},
+			expectedErrs: []error{validation.ErrFieldEnumInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertValidation(t, "TeamMember", tt.member.ValidateInfo, tt.expectedErrs)
+		})
+	}
+}
+
+func TestWebhook_Validate(t *testing.T) {
+	invalidContentType := WebhookContentType("xml")
+	tests := []struct {
+		name         string
+		hook         WebhookInfo
+		expectedErrs []error
+	}{
+		{
+			name: "valid create, required field set",
+			hook: WebhookInfo{
+				URL: "https://example.com/hook",
+			},
+		},
+		{
+			name:         "invalid create, required url",
+			hook:         WebhookInfo{},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "valid create, with valid enums",
+			hook: WebhookInfo{
+				URL:         "https://example.com/hook",
+				ContentType: WebhookContentTypeVar(WebhookContentTypeForm),
+				Events:      []WebhookEvent{WebhookEventPush, WebhookEventRelease},
+			},
+		},
+		{
+			name: "invalid create, invalid content type",
+			hook: WebhookInfo{
+				URL:         "https://example.com/hook",
+				ContentType: &invalidContentType,
+			},
+			expectedErrs: []error{validation.ErrFieldEnumInvalid},
+		},
+		{
+			name: "invalid create, invalid event",
+			hook: WebhookInfo{
+				URL:    "https://example.com/hook",
+				Events: []WebhookEvent{WebhookEventPush, "deployment"},
+			},
+			expectedErrs: []error{validation.ErrFieldEnumInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertValidation(t, "Webhook", tt.hook.ValidateInfo, tt.expectedErrs)
+		})
+	}
+}
+
+func TestLabel_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		label        LabelInfo
+		expectedErrs []error
+	}{
+		{
+			name: "valid create, required fields set",
+			label: LabelInfo{
+				Name:  "bug",
+				Color: "d73a4a",
+			},
+		},
+		{
+			name: "valid create, color with leading # and upper-case digits",
+			label: LabelInfo{
+				Name:  "bug",
+				Color: "#D73A4A",
+			},
+		},
+		{
+			name:         "invalid create, required fields",
+			label:        LabelInfo{},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "invalid create, invalid color",
+			label: LabelInfo{
+				Name:  "bug",
+				Color: "red",
+			},
+			expectedErrs: []error{validation.ErrFieldInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertValidation(t, "Label", tt.label.ValidateInfo, tt.expectedErrs)
+		})
+	}
+}
+
+func TestCommitStatus_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       CommitStatusInfo
+		expectedErrs []error
+	}{
+		{
+			name: "valid create, required field set",
+			status: CommitStatusInfo{
+				State: CommitStatusStateSuccess,
+			},
+		},
+		{
+			name:         "invalid create, required state",
+			status:       CommitStatusInfo{},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "invalid create, invalid state",
+			status: CommitStatusInfo{
+				State: CommitStatusState("cancelled"),
+			},
+			expectedErrs: []error{validation.ErrFieldEnumInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertValidation(t, "CommitStatus", tt.status.ValidateInfo, tt.expectedErrs)
+		})
+	}
+}
+
+func TestBranchProtection_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		protection   BranchProtectionInfo
+		expectedErrs []error
+	}{
+		{
+			name: "valid create, required field set",
+			protection: BranchProtectionInfo{
+				Branch: "main",
+			},
+		},
+		{
+			name: "valid create, push allowances",
+			protection: BranchProtectionInfo{
+				Branch:         "main",
+				RestrictPushes: BoolVar(true),
+				PushAllowances: []string{"alice"},
+			},
+		},
+		{
+			name:         "invalid create, required branch",
+			protection:   BranchProtectionInfo{},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "invalid create, negative review count",
+			protection: BranchProtectionInfo{
+				Branch:                       "main",
+				RequiredApprovingReviewCount: IntVar(-1),
+			},
+			expectedErrs: []error{validation.ErrFieldInvalid},
+		},
+		{
+			name: "invalid create, push allowances without restricted pushes",
+			protection: BranchProtectionInfo{
+				Branch:         "main",
+				PushAllowances: []string{"alice"},
+			},
+			expectedErrs: []error{validation.ErrFieldInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertValidation(t, "BranchProtection", tt.protection.ValidateInfo, tt.expectedErrs)
+		})
+	}
+}
+
+func TestPullRequestReview_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		review       PullRequestReviewInfo
+		expectedErrs []error
+	}{
+		{
+			name: "valid create, approval without body",
+			review: PullRequestReviewInfo{
+				State: PullRequestReviewStateApproved,
+			},
+		},
+		{
+			name: "valid create, changes requested",
+			review: PullRequestReviewInfo{
+				State: PullRequestReviewStateChangesRequested,
+				Body:  "please add tests",
+			},
+		},
+		{
+			name:         "invalid create, required state",
+			review:       PullRequestReviewInfo{},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "invalid create, comment without body",
+			review: PullRequestReviewInfo{
+				State: PullRequestReviewStateCommented,
+			},
+			expectedErrs: []error{validation.ErrFieldRequired},
+		},
+		{
+			name: "invalid create, dismissed",
+			review: PullRequestReviewInfo{
+				State: PullRequestReviewStateDismissed,
+			},
+			expectedErrs: []error{validation.ErrFieldInvalid},
+		},
+		{
+			name: "invalid create, invalid state",
+			review: PullRequestReviewInfo{
+				State: PullRequestReviewState("pending"),
+			},
+			expectedErrs: []error{validation.ErrFieldEnumInvalid},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertValidation(t, "PullRequestReview", tt.review.ValidateInfo, tt.expectedErrs)
+		})
+	}
+}
@@ -19,6 +19,8 @@ package gitprovider
 import (
 	"fmt"
 	"net/url"
+	"reflect"
+	"strings"
 )
 
 // BoolVar returns a pointer to the given bool.
@@ -26,6 +28,11 @@ func BoolVar(b bool) *bool {
 	return &b
 }
 
+// IntVar returns a pointer to the given int.
+func IntVar(i int) *int {
+	return &i
+}
+
 // StringVar returns a pointer to the given string.
 func StringVar(s string) *string {
 	return &s
@@ -39,3 +46,125 @@ func GetDomainURL(d string) string {
 	}
 	return d
 }
+
+// containsString returns true if the slice contains the given string.
+func containsString(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// diffFields lists the exported fields in which the structs desired and actual differ, by their
+// JSON name. Pointers are dereferenced and byte slices converted to strings, so that the values are
+// readable when logged. If actual isn't of the same type as desired, all set fields of desired
+// are listed.
+func diffFields(desired, actual interface{}) []FieldChange {
+	dv, av := reflect.ValueOf(desired), reflect.ValueOf(actual)
+	if !av.IsValid() || av.Type() != dv.Type() {
+		av = reflect.Zero(dv.Type())
+	}
+	var changes []FieldChange
+	t := dv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if reflect.DeepEqual(dv.Field(i).Interface(), av.Field(i).Interface()) {
+			continue
+		}
+		changes = append(changes, FieldChange{
+			Path: fieldPath(field),
+			Old:  fieldValue(av.Field(i)),
+			New:  fieldValue(dv.Field(i)),
+		})
+	}
+	return changes
+}
+
+// fieldPath returns the JSON name of the struct field, or its Go name if it doesn't have one.
+func fieldPath(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
+
+// fieldValue returns the value of v for a FieldChange, which is nil for nil pointers and slices.
+func fieldValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return v.Elem().Interface()
+	case reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+	}
+	if b, ok := v.Interface().([]byte); ok {
+		return string(b)
+	}
+	return v.Interface()
+}
+
+// sameStrings returns true if a and b contain the same strings, ignoring the order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := make(map[string]int, len(a))
+	for _, s := range a {
+		count[s]++
+	}
+	for _, s := range b {
+		if count[s] == 0 {
+			return false
+		}
+		count[s]--
+	}
+	return true
+}
+
+// sameMergeMethods returns true if a and b contain the same merge methods, ignoring the order.
+func sameMergeMethods(a, b []MergeMethod) bool {
+	as := make([]string, 0, len(a))
+	for _, m := range a {
+		as = append(as, string(m))
+	}
+	bs := make([]string, 0, len(b))
+	for _, m := range b {
+		bs = append(bs, string(m))
+	}
+	return sameStrings(as, bs)
+}
+
+// ValidateUnsupportedFields returns an error wrapping ErrNoProviderSupport if any of the fields
+// of the info struct, given by their JSON name (e.g. "topics"), is set. Providers use it to
+// reject requests which they can't map to their API, instead of silently ignoring fields.
+func ValidateUnsupportedFields(provider ProviderID, info interface{}, fields ...string) error {
+	v := reflect.ValueOf(info)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	unsupported := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		unsupported[field] = struct{}{}
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		path := fieldPath(t.Field(i))
+		if _, ok := unsupported[path]; !ok {
+			continue
+		}
+		if fieldValue(v.Field(i)) != nil {
+			return fmt.Errorf("%s doesn't support setting %q of %s: %w", provider, path, t.Name(), ErrNoProviderSupport)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	bitbucketServerEventHeader     = "X-Event-Key"
+	bitbucketServerDeliveryHeader  = "X-Request-Id"
+	bitbucketServerSignatureHeader = "X-Hub-Signature"
+)
+
+type bitbucketServerLinks struct {
+	Self []struct {
+		Href string `json:"href"`
+	} `json:"self"`
+}
+
+type bitbucketServerRepository struct {
+	Slug    string `json:"slug"`
+	Name    string `json:"name"`
+	Project struct {
+		Key   string `json:"key"`
+		Name  string `json:"name"`
+		Type  string `json:"type"`
+		Owner struct {
+			Name string `json:"name"`
+		} `json:"owner"`
+	} `json:"project"`
+	Links bitbucketServerLinks `json:"links"`
+}
+
+type bitbucketServerUser struct {
+	Name string `json:"name"`
+}
+
+type bitbucketServerRefsChangedPayload struct {
+	Actor      bitbucketServerUser       `json:"actor"`
+	Repository bitbucketServerRepository `json:"repository"`
+	Changes    []struct {
+		RefID    string `json:"refId"`
+		FromHash string `json:"fromHash"`
+		ToHash   string `json:"toHash"`
+	} `json:"changes"`
+}
+
+type bitbucketServerPullRequestPayload struct {
+	Actor       bitbucketServerUser `json:"actor"`
+	PullRequest struct {
+		ID          int    `json:"id"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		State       string `json:"state"`
+		Author      struct {
+			User bitbucketServerUser `json:"user"`
+		} `json:"author"`
+		FromRef struct {
+			DisplayID    string `json:"displayId"`
+			LatestCommit string `json:"latestCommit"`
+		} `json:"fromRef"`
+		ToRef struct {
+			DisplayID    string                    `json:"displayId"`
+			LatestCommit string                    `json:"latestCommit"`
+			Repository   bitbucketServerRepository `json:"repository"`
+		} `json:"toRef"`
+		Links bitbucketServerLinks `json:"links"`
+	} `json:"pullRequest"`
+}
+
+func decodeBitbucketServer(header http.Header, body []byte) ([]Event, error) {
+	event := header.Get(bitbucketServerEventHeader)
+	switch event {
+	case "diagnostics:ping":
+		return nil, nil
+	case "repo:refs_changed":
+		var p bitbucketServerRefsChangedPayload
+		if err := unmarshal(body, event, &p); err != nil {
+			return nil, err
+		}
+		meta, err := bitbucketServerMetadata(header, p.Repository, p.Actor)
+		if err != nil {
+			return nil, err
+		}
+		// Bitbucket Server sends all refs changed by a push in one delivery, without the commits
+		events := make([]Event, 0, len(p.Changes))
+		for _, c := range p.Changes {
+			e, err := newRefEvent(meta, c.RefID, c.FromHash, c.ToHash, nil)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, e)
+		}
+		return events, nil
+	case "pr:opened", "pr:from_ref_updated", "pr:modified", "pr:declined", "pr:deleted", "pr:reopened", "pr:merged":
+		var p bitbucketServerPullRequestPayload
+		if err := unmarshal(body, event, &p); err != nil {
+			return nil, err
+		}
+		meta, err := bitbucketServerMetadata(header, p.PullRequest.ToRef.Repository, p.Actor)
+		if err != nil {
+			return nil, err
+		}
+		pr := p.PullRequest
+		webURL := ""
+		if len(pr.Links.Self) > 0 {
+			webURL = pr.Links.Self[0].Href
+		}
+		return []Event{&PullRequestEvent{
+			Metadata: meta,
+			Action:   bitbucketServerPullRequestActions[event],
+			PullRequest: gitprovider.PullRequestInfo{
+				Title:        pr.Title,
+				Description:  pr.Description,
+				Merged:       pr.State == "MERGED",
+				Number:       pr.ID,
+				WebURL:       webURL,
+				SourceBranch: pr.FromRef.DisplayID,
+				TargetBranch: pr.ToRef.DisplayID,
+				HeadSha:      pr.FromRef.LatestCommit,
+				BaseSha:      pr.ToRef.LatestCommit,
+				State:        pullRequestState(pr.State == "DECLINED", pr.State == "MERGED"),
+				Author:       pr.Author.User.Name,
+			},
+		}}, nil
+	}
+	return nil, fmt.Errorf("%w: Bitbucket Server %q event", ErrUnsupportedEvent, event)
+}
+
+// bitbucketServerPullRequestActions maps the pull request event keys to actions.
+//
+//nolint:gochecknoglobals
+var bitbucketServerPullRequestActions = map[string]PullRequestAction{
+	"pr:opened":           PullRequestActionOpened,
+	"pr:from_ref_updated": PullRequestActionUpdated,
+	"pr:modified":         PullRequestActionEdited,
+	"pr:declined":         PullRequestActionClosed,
+	"pr:deleted":          PullRequestActionClosed,
+	"pr:reopened":         PullRequestActionReopened,
+	"pr:merged":           PullRequestActionMerged,
+}
+
+// bitbucketServerMetadata returns the metadata of a delivery. Repositories in personal
+// projects are returned as gitprovider.UserRepositoryRef. Older Bitbucket Server versions
+// don't send any repository links, leaving the domain of the reference empty.
+func bitbucketServerMetadata(header http.Header, repo bitbucketServerRepository, actor bitbucketServerUser) (Metadata, error) {
+	webURL := ""
+	if len(repo.Links.Self) > 0 {
+		webURL = repo.Links.Self[0].Href
+	}
+
+	var ref gitprovider.RepositoryRef
+	var err error
+	if repo.Project.Type == "PERSONAL" {
+		owner := repo.Project.Owner.Name
+		if owner == "" {
+			owner = strings.TrimPrefix(repo.Project.Key, "~")
+		}
+		ref, err = newRepositoryRef(webURL, []string{owner}, repo.Name, true)
+		if err != nil {
+			return Metadata{}, err
+		}
+		userRef := ref.(gitprovider.UserRepositoryRef)
+		userRef.SetSlug(repo.Slug)
+		ref = userRef
+	} else {
+		ref, err = newRepositoryRef(webURL, []string{repo.Project.Name}, repo.Name, false)
+		if err != nil {
+			return Metadata{}, err
+		}
+		orgRef := ref.(gitprovider.OrgRepositoryRef)
+		orgRef.SetKey(repo.Project.Key)
+		orgRef.SetSlug(repo.Slug)
+		ref = orgRef
+	}
+
+	return Metadata{
+		Provider:   ProviderBitbucketServer,
+		DeliveryID: header.Get(bitbucketServerDeliveryHeader),
+		Repository: ref,
+		Sender:     actor.Name,
+	}, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	giteaEventHeader     = "X-Gitea-Event"
+	giteaDeliveryHeader  = "X-Gitea-Delivery"
+	giteaSignatureHeader = "X-Gitea-Signature"
+)
+
+// giteaRepository is the repository object of Gitea payloads. Gitea doesn't say whether
+// the owner is a user or an organization.
+type giteaRepository struct {
+	Name    string    `json:"name"`
+	HTMLURL string    `json:"html_url"`
+	Owner   giteaUser `json:"owner"`
+}
+
+type giteaUser struct {
+	Login string `json:"login"`
+}
+
+type giteaCommit struct {
+	ID        string    `json:"id"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+	URL       string    `json:"url"`
+	Author    struct {
+		Name string `json:"name"`
+	} `json:"author"`
+}
+
+type giteaPushPayload struct {
+	Ref        string          `json:"ref"`
+	Before     string          `json:"before"`
+	After      string          `json:"after"`
+	Commits    []giteaCommit   `json:"commits"`
+	Repository giteaRepository `json:"repository"`
+	Sender     giteaUser       `json:"sender"`
+}
+
+type giteaPullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title   string `json:"title"`
+		Body    string `json:"body"`
+		State   string `json:"state"`
+		HTMLURL string `json:"html_url"`
+		Merged  bool   `json:"merged"`
+		Head    struct {
+			Ref string `json:"ref"`
+			Sha string `json:"sha"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository giteaRepository `json:"repository"`
+	Sender     giteaUser       `json:"sender"`
+}
+
+func decodeGitea(header http.Header, body []byte) ([]Event, error) {
+	event := header.Get(giteaEventHeader)
+	switch event {
+	case "push":
+		var p giteaPushPayload
+		if err := unmarshal(body, event, &p); err != nil {
+			return nil, err
+		}
+		meta, err := giteaMetadata(header, p.Repository, p.Sender)
+		if err != nil {
+			return nil, err
+		}
+		commits := make([]gitprovider.CommitInfo, 0, len(p.Commits))
+		for _, c := range p.Commits {
+			commits = append(commits, gitprovider.CommitInfo{
+				Sha:       c.ID,
+				Author:    c.Author.Name,
+				Message:   c.Message,
+				CreatedAt: c.Timestamp,
+				URL:       c.URL,
+			})
+		}
+		e, err := newRefEvent(meta, p.Ref, p.Before, p.After, commits)
+		if err != nil {
+			return nil, err
+		}
+		return []Event{e}, nil
+	case "pull_request":
+		var p giteaPullRequestPayload
+		if err := unmarshal(body, event, &p); err != nil {
+			return nil, err
+		}
+		meta, err := giteaMetadata(header, p.Repository, p.Sender)
+		if err != nil {
+			return nil, err
+		}
+		// Gitea uses the same actions as GitHub, except for "synchronized"
+		action, err := githubPullRequestAction(p.Action, p.PullRequest.Merged)
+		if err != nil {
+			return nil, err
+		}
+		return []Event{&PullRequestEvent{
+			Metadata: meta,
+			Action:   action,
+			PullRequest: gitprovider.PullRequestInfo{
+				Title:        p.PullRequest.Title,
+				Description:  p.PullRequest.Body,
+				Merged:       p.PullRequest.Merged,
+				Number:       p.Number,
+				WebURL:       p.PullRequest.HTMLURL,
+				SourceBranch: p.PullRequest.Head.Ref,
+				TargetBranch: p.PullRequest.Base.Ref,
+				HeadSha:      p.PullRequest.Head.Sha,
+				State:        pullRequestState(p.PullRequest.State == "closed", p.PullRequest.Merged),
+				Author:       p.PullRequest.User.Login,
+			},
+		}}, nil
+	}
+	return nil, fmt.Errorf("%w: Gitea %q event", ErrUnsupportedEvent, event)
+}
+
+func giteaMetadata(header http.Header, repo giteaRepository, sender giteaUser) (Metadata, error) {
+	ref, err := newRepositoryRef(repo.HTMLURL, []string{repo.Owner.Login}, repo.Name, false)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return Metadata{
+		Provider:   ProviderGitea,
+		DeliveryID: header.Get(giteaDeliveryHeader),
+		Repository: ref,
+		Sender:     sender.Login,
+	}, nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	githubEventHeader     = "X-GitHub-Event"
+	githubDeliveryHeader  = "X-GitHub-Delivery"
+	githubSignatureHeader = "X-Hub-Signature-256"
+)
+
+// githubRepository is the repository object of GitHub payloads.
+type githubRepository struct {
+	Name    string `json:"name"`
+	HTMLURL string `json:"html_url"`
+	Owner   struct {
+		Login string `json:"login"`
+		Type  string `json:"type"`
+	} `json:"owner"`
+}
+
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+type githubCommit struct {
+	ID        string    `json:"id"`
+	TreeID    string    `json:"tree_id"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+	URL       string    `json:"url"`
+	Author    struct {
+		Name string `json:"name"`
+	} `json:"author"`
+}
+
+type githubPushPayload struct {
+	Ref        string           `json:"ref"`
+	Before     string           `json:"before"`
+	After      string           `json:"after"`
+	Commits    []githubCommit   `json:"commits"`
+	Repository githubRepository `json:"repository"`
+	Sender     githubUser       `json:"sender"`
+}
+
+type githubPullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title   string `json:"title"`
+		Body    string `json:"body"`
+		State   string `json:"state"`
+		HTMLURL string `json:"html_url"`
+		Merged  bool   `json:"merged"`
+		Head    struct {
+			Ref string `json:"ref"`
+			Sha string `json:"sha"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository githubRepository `json:"repository"`
+	Sender     githubUser       `json:"sender"`
+}
+
+func decodeGitHub(header http.Header, body []byte) ([]Event, error) {
+	event := header.Get(githubEventHeader)
+	switch event {
+	case "ping":
+		return nil, nil
+	case "push":
+		var p githubPushPayload
+		if err := unmarshal(body, event, &p); err != nil {
+			return nil, err
+		}
+		meta, err := githubMetadata(header, p.Repository, p.Sender)
+		if err != nil {
+			return nil, err
+		}
+		commits := make([]gitprovider.CommitInfo, 0, len(p.Commits))
+		for _, c := range p.Commits {
+			commits = append(commits, gitprovider.CommitInfo{
+				Sha:       c.ID,
+				TreeSha:   c.TreeID,
+				Author:    c.Author.Name,
+				Message:   c.Message,
+				CreatedAt: c.Timestamp,
+				URL:       c.URL,
+			})
+		}
+		e, err := newRefEvent(meta, p.Ref, p.Before, p.After, commits)
+		if err != nil {
+			return nil, err
+		}
+		return []Event{e}, nil
+	case "pull_request":
+		var p githubPullRequestPayload
+		if err := unmarshal(body, event, &p); err != nil {
+			return nil, err
+		}
+		meta, err := githubMetadata(header, p.Repository, p.Sender)
+		if err != nil {
+			return nil, err
+		}
+		action, err := githubPullRequestAction(p.Action, p.PullRequest.Merged)
+		if err != nil {
+			return nil, err
+		}
+		return []Event{&PullRequestEvent{
+			Metadata: meta,
+			Action:   action,
+			PullRequest: gitprovider.PullRequestInfo{
+				Title:        p.PullRequest.Title,
+				Description:  p.PullRequest.Body,
+				Merged:       p.PullRequest.Merged,
+				Number:       p.Number,
+				WebURL:       p.PullRequest.HTMLURL,
+				SourceBranch: p.PullRequest.Head.Ref,
+				TargetBranch: p.PullRequest.Base.Ref,
+				HeadSha:      p.PullRequest.Head.Sha,
+				State:        pullRequestState(p.PullRequest.State == "closed", p.PullRequest.Merged),
+				Author:       p.PullRequest.User.Login,
+			},
+		}}, nil
+	}
+	return nil, fmt.Errorf("%w: GitHub %q event", ErrUnsupportedEvent, event)
+}
+
+func githubMetadata(header http.Header, repo githubRepository, sender githubUser) (Metadata, error) {
+	ref, err := newRepositoryRef(repo.HTMLURL, []string{repo.Owner.Login}, repo.Name, repo.Owner.Type == "User")
+	if err != nil {
+		return Metadata{}, err
+	}
+	return Metadata{
+		Provider:   ProviderGitHub,
+		DeliveryID: header.Get(githubDeliveryHeader),
+		Repository: ref,
+		Sender:     sender.Login,
+	}, nil
+}
+
+// githubPullRequestAction maps the actions of GitHub (and Gitea) pull request events.
+func githubPullRequestAction(action string, merged bool) (PullRequestAction, error) {
+	switch action {
+	case "opened":
+		return PullRequestActionOpened, nil
+	case "synchronize", "synchronized":
+		return PullRequestActionUpdated, nil
+	case "edited":
+		return PullRequestActionEdited, nil
+	case "closed":
+		if merged {
+			return PullRequestActionMerged, nil
+		}
+		return PullRequestActionClosed, nil
+	case "reopened":
+		return PullRequestActionReopened, nil
+	}
+	return "", fmt.Errorf("%w: pull request %q action", ErrUnsupportedEvent, action)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	gitlabEventHeader    = "X-Gitlab-Event"
+	gitlabDeliveryHeader = "X-Gitlab-Event-UUID"
+	gitlabTokenHeader    = "X-Gitlab-Token"
+)
+
+// gitlabProject is the project object of GitLab payloads. GitLab doesn't say whether
+// the namespace is a user or a group.
+type gitlabProject struct {
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+}
+
+type gitlabCommit struct {
+	ID        string    `json:"id"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+	URL       string    `json:"url"`
+	Author    struct {
+		Name string `json:"name"`
+	} `json:"author"`
+}
+
+type gitlabPushPayload struct {
+	Ref          string         `json:"ref"`
+	Before       string         `json:"before"`
+	After        string         `json:"after"`
+	UserUsername string         `json:"user_username"`
+	Commits      []gitlabCommit `json:"commits"`
+	Project      gitlabProject  `json:"project"`
+}
+
+type gitlabMergeRequestPayload struct {
+	User struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project          gitlabProject `json:"project"`
+	ObjectAttributes struct {
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		Description  string `json:"description"`
+		URL          string `json:"url"`
+		State        string `json:"state"`
+		Action       string `json:"action"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+		LastCommit   struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+		// OldRev is only set for updates that pushed new commits.
+		OldRev string `json:"oldrev"`
+	} `json:"object_attributes"`
+}
+
+func decodeGitLab(header http.Header, body []byte) ([]Event, error) {
+	event := header.Get(gitlabEventHeader)
+	switch event {
+	case "Push Hook", "Tag Push Hook":
+		var p gitlabPushPayload
+		if err := unmarshal(body, event, &p); err != nil {
+			return nil, err
+		}
+		meta, err := gitlabMetadata(header, p.Project, p.UserUsername)
+		if err != nil {
+			return nil, err
+		}
+		commits := make([]gitprovider.CommitInfo, 0, len(p.Commits))
+		for _, c := range p.Commits {
+			commits = append(commits, gitprovider.CommitInfo{
+				Sha:       c.ID,
+				Author:    c.Author.Name,
+				Message:   c.Message,
+				CreatedAt: c.Timestamp,
+				URL:       c.URL,
+			})
+		}
+		e, err := newRefEvent(meta, p.Ref, p.Before, p.After, commits)
+		if err != nil {
+			return nil, err
+		}
+		return []Event{e}, nil
+	case "Merge Request Hook":
+		var p gitlabMergeRequestPayload
+		if err := unmarshal(body, event, &p); err != nil {
+			return nil, err
+		}
+		meta, err := gitlabMetadata(header, p.Project, p.User.Username)
+		if err != nil {
+			return nil, err
+		}
+		mr := p.ObjectAttributes
+		action, err := gitlabMergeRequestAction(mr.Action, mr.OldRev)
+		if err != nil {
+			return nil, err
+		}
+		return []Event{&PullRequestEvent{
+			Metadata: meta,
+			Action:   action,
+			PullRequest: gitprovider.PullRequestInfo{
+				Title:        mr.Title,
+				Description:  mr.Description,
+				Merged:       mr.State == "merged",
+				Number:       mr.IID,
+				WebURL:       mr.URL,
+				SourceBranch: mr.SourceBranch,
+				TargetBranch: mr.TargetBranch,
+				HeadSha:      mr.LastCommit.ID,
+				State:        pullRequestState(mr.State == "closed", mr.State == "merged"),
+			},
+		}}, nil
+	}
+	return nil, fmt.Errorf("%w: GitLab %q event", ErrUnsupportedEvent, event)
+}
+
+func gitlabMetadata(header http.Header, project gitlabProject, sender string) (Metadata, error) {
+	path := strings.Split(project.PathWithNamespace, "/")
+	ref, err := newRepositoryRef(project.WebURL, path[:len(path)-1], path[len(path)-1], false)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return Metadata{
+		Provider:   ProviderGitLab,
+		DeliveryID: header.Get(gitlabDeliveryHeader),
+		Repository: ref,
+		Sender:     sender,
+	}, nil
+}
+
+func gitlabMergeRequestAction(action, oldRev string) (PullRequestAction, error) {
+	switch action {
+	case "open":
+		return PullRequestActionOpened, nil
+	case "update":
+		if oldRev != "" {
+			return PullRequestActionUpdated, nil
+		}
+		return PullRequestActionEdited, nil
+	case "close":
+		return PullRequestActionClosed, nil
+	case "reopen":
+		return PullRequestActionReopened, nil
+	case "merge":
+		return PullRequestActionMerged, nil
+	}
+	return "", fmt.Errorf("%w: merge request %q action", ErrUnsupportedEvent, action)
+}
@@ -0,0 +1,495 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const (
+	// ProviderGitHub is the provider ID of deliveries sent by GitHub.
+	ProviderGitHub = gitprovider.ProviderID("github")
+	// ProviderGitLab is the provider ID of deliveries sent by GitLab.
+	ProviderGitLab = gitprovider.ProviderID("gitlab")
+	// ProviderGitea is the provider ID of deliveries sent by Gitea.
+	ProviderGitea = gitprovider.ProviderID("gitea")
+	// ProviderBitbucketServer is the provider ID of deliveries sent by Bitbucket Server.
+	ProviderBitbucketServer = gitprovider.ProviderID("stash")
+
+	// MaxPayloadSize is the largest delivery body that is read, matching the GitHub limit of 25MB.
+	MaxPayloadSize = 25 << 20
+)
+
+var (
+	// ErrUnknownProvider is returned if the provider can't be detected from the request headers.
+	ErrUnknownProvider = errors.New("unable to detect the webhook provider from the request headers")
+	// ErrMissingSecret is returned if a delivery is verified against an empty secret.
+	ErrMissingSecret = errors.New("webhook secret is empty")
+	// ErrMissingSignature is returned if a secret is configured, but the delivery isn't signed.
+	ErrMissingSignature = errors.New("webhook delivery is not signed")
+	// ErrInvalidSignature is returned if the delivery signature doesn't match the secret.
+	ErrInvalidSignature = errors.New("webhook delivery signature is invalid")
+	// ErrUnsupportedEvent is returned for deliveries that don't map to any of the normalized events.
+	ErrUnsupportedEvent = errors.New("unsupported webhook event")
+	// ErrPayloadTooLarge is returned if the delivery body is larger than MaxPayloadSize.
+	ErrPayloadTooLarge = errors.New("webhook payload is too large")
+)
+
+// EventType is the kind of a normalized event.
+type EventType string
+
+const (
+	// EventTypePush is the type of PushEvent.
+	EventTypePush = EventType("push")
+	// EventTypeTag is the type of TagEvent.
+	EventTypeTag = EventType("tag")
+	// EventTypePullRequest is the type of PullRequestEvent.
+	EventTypePullRequest = EventType("pull_request")
+)
+
+// PullRequestAction describes what happened to the pull request of a PullRequestEvent.
+type PullRequestAction string
+
+const (
+	// PullRequestActionOpened means that the pull request was opened.
+	PullRequestActionOpened = PullRequestAction("opened")
+	// PullRequestActionUpdated means that new commits were pushed to the source branch.
+	PullRequestActionUpdated = PullRequestAction("updated")
+	// PullRequestActionEdited means that e.g. the title or description changed.
+	PullRequestActionEdited = PullRequestAction("edited")
+	// PullRequestActionClosed means that the pull request was closed without being merged.
+	PullRequestActionClosed = PullRequestAction("closed")
+	// PullRequestActionReopened means that a closed pull request was reopened.
+	PullRequestActionReopened = PullRequestAction("reopened")
+	// PullRequestActionMerged means that the pull request was merged.
+	PullRequestActionMerged = PullRequestAction("merged")
+)
+
+// Event is a webhook delivery decoded into a provider-neutral form. It's one of
+// *PushEvent, *TagEvent or *PullRequestEvent.
+type Event interface {
+	// Type returns the kind of the event.
+	Type() EventType
+	// GetMetadata returns the information common to all events.
+	GetMetadata() Metadata
+}
+
+// Metadata contains the information common to all events.
+type Metadata struct {
+	// Provider is the ID of the provider that sent the delivery, e.g. ProviderGitHub.
+	Provider gitprovider.ProviderID `json:"provider"`
+
+	// DeliveryID is the unique ID of the delivery, if the provider sends one.
+	DeliveryID string `json:"deliveryID"`
+
+	// Repository is the repository the event happened in. It's a gitprovider.OrgRepositoryRef,
+	// unless the payload says that the repository is owned by a user.
+	Repository gitprovider.RepositoryRef `json:"repository"`
+
+	// Sender is the login of the user that triggered the event.
+	Sender string `json:"sender"`
+}
+
+// GetMetadata returns the information common to all events.
+func (m Metadata) GetMetadata() Metadata {
+	return m
+}
+
+// PushEvent is sent when commits are pushed to a branch, or a branch is created or deleted.
+type PushEvent struct {
+	Metadata `json:",inline"`
+
+	// Ref is the full name of the pushed ref, e.g. "refs/heads/main".
+	Ref string `json:"ref"`
+
+	// Branch is the name of the pushed branch, e.g. "main".
+	Branch string `json:"branch"`
+
+	// Before is the SHA the branch pointed to before the push. It's empty if the branch was created.
+	Before string `json:"before"`
+
+	// After is the SHA the branch points to after the push. It's empty if the branch was deleted.
+	After string `json:"after"`
+
+	// Commits are the pushed commits, if the provider includes them in the payload.
+	Commits []gitprovider.CommitInfo `json:"commits"`
+}
+
+// Type returns EventTypePush.
+func (e *PushEvent) Type() EventType {
+	return EventTypePush
+}
+
+// Created returns true if the push created the branch.
+func (e *PushEvent) Created() bool {
+	return e.Before == ""
+}
+
+// Deleted returns true if the push deleted the branch.
+func (e *PushEvent) Deleted() bool {
+	return e.After == ""
+}
+
+// TagEvent is sent when a tag is pushed or deleted.
+type TagEvent struct {
+	Metadata `json:",inline"`
+
+	// Ref is the full name of the tag, e.g. "refs/tags/v1.0.0".
+	Ref string `json:"ref"`
+
+	// Tag is the name of the tag, e.g. "v1.0.0".
+	Tag string `json:"tag"`
+
+	// Sha is the SHA the tag points to. It's empty if the tag was deleted.
+	Sha string `json:"sha"`
+}
+
+// Type returns EventTypeTag.
+func (e *TagEvent) Type() EventType {
+	return EventTypeTag
+}
+
+// Deleted returns true if the tag was deleted.
+func (e *TagEvent) Deleted() bool {
+	return e.Sha == ""
+}
+
+// PullRequestEvent is sent when a pull request is opened, updated, closed or merged.
+type PullRequestEvent struct {
+	Metadata `json:",inline"`
+
+	// Action describes what happened to the pull request.
+	Action PullRequestAction `json:"action"`
+
+	// PullRequest is the state of the pull request after the event.
+	PullRequest gitprovider.PullRequestInfo `json:"pullRequest"`
+}
+
+// Type returns EventTypePullRequest.
+func (e *PullRequestEvent) Type() EventType {
+	return EventTypePullRequest
+}
+
+// DetectProvider returns the ID of the provider that sent a delivery with the given headers.
+//
+// ErrUnknownProvider is returned if the headers don't match any of the supported providers.
+func DetectProvider(header http.Header) (gitprovider.ProviderID, error) {
+	switch {
+	// Gitea also sends the GitHub headers for compatibility, so it has to be checked first
+	case header.Get(giteaEventHeader) != "":
+		return ProviderGitea, nil
+	case header.Get(githubEventHeader) != "":
+		return ProviderGitHub, nil
+	case header.Get(gitlabEventHeader) != "":
+		return ProviderGitLab, nil
+	// Bitbucket Cloud sends X-Event-Key too, but identifies itself with X-Hook-UUID
+	case header.Get(bitbucketServerEventHeader) != "" && header.Get("X-Hook-UUID") == "":
+		return ProviderBitbucketServer, nil
+	}
+	return "", ErrUnknownProvider
+}
+
+// Verify checks the signature of a delivery from the given provider against the shared secret
+// the webhook was created with, i.e. gitprovider.WebhookInfo.Secret.
+//
+// GitHub and Bitbucket Server deliveries are checked against their HMAC-SHA256 signature headers,
+// Gitea deliveries against X-Gitea-Signature, and GitLab deliveries against the X-Gitlab-Token header.
+//
+// ErrMissingSecret is returned if secret is empty, as an unsigned delivery can't be told apart
+// from a forged one. ErrMissingSignature or ErrInvalidSignature are returned if the delivery
+// can't be trusted.
+func Verify(provider gitprovider.ProviderID, header http.Header, body []byte, secret string) error {
+	if secret == "" {
+		return ErrMissingSecret
+	}
+	switch provider {
+	case ProviderGitHub:
+		return verifyHMAC(header.Get(githubSignatureHeader), "sha256=", body, secret)
+	case ProviderGitLab:
+		return verifyToken(header.Get(gitlabTokenHeader), secret)
+	case ProviderGitea:
+		return verifyHMAC(header.Get(giteaSignatureHeader), "", body, secret)
+	case ProviderBitbucketServer:
+		return verifyHMAC(header.Get(bitbucketServerSignatureHeader), "sha256=", body, secret)
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownProvider, provider)
+}
+
+// Decode decodes a delivery from the given provider into normalized events.
+//
+// Most deliveries hold a single event, but Bitbucket Server sends every ref changed by a push
+// in the same delivery. No events and no error are returned for pings.
+//
+// Deliveries sent with gitprovider.WebhookContentTypeForm are decoded from their "payload"
+// form field.
+//
+// ErrUnsupportedEvent is returned for deliveries that don't map to any of the normalized events,
+// e.g. issue events or pull request label changes.
+func Decode(provider gitprovider.ProviderID, header http.Header, body []byte) ([]Event, error) {
+	body, err := payload(header, body)
+	if err != nil {
+		return nil, err
+	}
+	switch provider {
+	case ProviderGitHub:
+		return decodeGitHub(header, body)
+	case ProviderGitLab:
+		return decodeGitLab(header, body)
+	case ProviderGitea:
+		return decodeGitea(header, body)
+	case ProviderBitbucketServer:
+		return decodeBitbucketServer(header, body)
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownProvider, provider)
+}
+
+// Parse detects the provider that sent the request, verifies its signature using secret, and
+// decodes the body into normalized events. See Verify and Decode for details.
+//
+// The request body is consumed.
+func Parse(r *http.Request, secret string) ([]Event, error) {
+	return parse(r, true, secret)
+}
+
+// ParseUnverified is like Parse, but doesn't verify the signature of the delivery. Only use it
+// if the deliveries are authenticated by other means, e.g. a trusted network.
+func ParseUnverified(r *http.Request) ([]Event, error) {
+	return parse(r, false, "")
+}
+
+func parse(r *http.Request, verify bool, secret string) ([]Event, error) {
+	provider, err := DetectProvider(r.Header)
+	if err != nil {
+		return nil, err
+	}
+	body, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	if verify {
+		if err := Verify(provider, r.Header, body, secret); err != nil {
+			return nil, err
+		}
+	}
+	return Decode(provider, r.Header, body)
+}
+
+// EventFunc is called by the Handler for every received event.
+type EventFunc func(ctx context.Context, event Event) error
+
+// NewHandler returns a http.Handler receiving webhook deliveries from any of the supported
+// providers. Deliveries are verified using secret and decoded using Parse, and fn is called
+// for every event.
+//
+// The handler responds with 401 Unauthorized if the signature can't be verified, 202 Accepted
+// for deliveries that aren't supported, and 500 Internal Server Error if fn fails.
+//
+// NewHandler panics if secret is empty, use NewUnverifiedHandler to accept unsigned deliveries.
+func NewHandler(secret string, fn EventFunc) http.Handler {
+	if secret == "" {
+		panic("webhook: NewHandler called with an empty secret")
+	}
+	return &handler{verify: true, secret: secret, fn: fn}
+}
+
+// NewUnverifiedHandler is like NewHandler, but doesn't verify the signature of the deliveries.
+// Only use it if the deliveries are authenticated by other means, e.g. a trusted network.
+func NewUnverifiedHandler(fn EventFunc) http.Handler {
+	return &handler{fn: fn}
+}
+
+type handler struct {
+	verify bool
+	secret string
+	fn     EventFunc
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	events, err := parse(r, h.verify, h.secret)
+	switch {
+	case errors.Is(err, ErrUnsupportedEvent):
+		w.WriteHeader(http.StatusAccepted)
+		return
+	case errors.Is(err, ErrMissingSignature), errors.Is(err, ErrInvalidSignature):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	case errors.Is(err, ErrPayloadTooLarge):
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, event := range events {
+		if err := h.fn(r.Context(), event); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxPayloadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook payload: %w", err)
+	}
+	if len(body) > MaxPayloadSize {
+		return nil, ErrPayloadTooLarge
+	}
+	return body, nil
+}
+
+// payload returns the JSON payload of a delivery, which is the "payload" field of the body if
+// it was sent as a form.
+func payload(header http.Header, body []byte) ([]byte, error) {
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		return body, nil
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "application/x-www-form-urlencoded" {
+		return body, nil
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode webhook form: %w", err)
+	}
+	if !form.Has("payload") {
+		return nil, errors.New("webhook form has no payload field")
+	}
+	return []byte(form.Get("payload")), nil
+}
+
+// isZeroSha returns true for the all-zero SHA providers use for missing refs.
+func isZeroSha(sha string) bool {
+	return strings.Trim(sha, "0") == ""
+}
+
+// nonZeroSha returns sha, or an empty string if it's the all-zero SHA.
+func nonZeroSha(sha string) string {
+	if isZeroSha(sha) {
+		return ""
+	}
+	return sha
+}
+
+// pullRequestState returns the state of a pull request that is either open, closed, or merged.
+func pullRequestState(closed, merged bool) gitprovider.PullRequestState {
+	switch {
+	case merged:
+		return gitprovider.PullRequestStateMerged
+	case closed:
+		return gitprovider.PullRequestStateClosed
+	}
+	return gitprovider.PullRequestStateOpen
+}
+
+// newRepositoryRef creates a reference to the repository name, owned by the given path of
+// organizations (or a single user login if user is true), on the domain of webURL.
+// The domain is left empty if webURL is.
+func newRepositoryRef(webURL string, owner []string, name string, user bool) (gitprovider.RepositoryRef, error) {
+	if len(owner) == 0 || owner[0] == "" || name == "" {
+		return nil, fmt.Errorf("%w: repository owner and name are required", gitprovider.ErrInvalidServerData)
+	}
+	domain := ""
+	if webURL != "" {
+		u, err := url.Parse(webURL)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("%w: invalid repository URL %q", gitprovider.ErrInvalidServerData, webURL)
+		}
+		domain = u.Host
+		if u.Scheme == "http" {
+			domain = "http://" + u.Host
+		}
+	}
+
+	if user {
+		return gitprovider.UserRepositoryRef{
+			UserRef: gitprovider.UserRef{
+				Domain:    domain,
+				UserLogin: owner[0],
+			},
+			RepositoryName: name,
+		}, nil
+	}
+	ref := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{
+			Domain:       domain,
+			Organization: owner[0],
+		},
+		RepositoryName: name,
+	}
+	if len(owner) > 1 {
+		ref.SubOrganizations = owner[1:]
+	}
+	return ref, nil
+}
+
+// newRefEvent returns a PushEvent or TagEvent for a change of ref from before to after.
+func newRefEvent(meta Metadata, ref, before, after string, commits []gitprovider.CommitInfo) (Event, error) {
+	if len(commits) == 0 {
+		commits = nil
+	}
+	if tag := strings.TrimPrefix(ref, "refs/tags/"); tag != ref {
+		return &TagEvent{
+			Metadata: meta,
+			Ref:      ref,
+			Tag:      tag,
+			Sha:      nonZeroSha(after),
+		}, nil
+	}
+	if branch := strings.TrimPrefix(ref, "refs/heads/"); branch != ref {
+		return &PushEvent{
+			Metadata: meta,
+			Ref:      ref,
+			Branch:   branch,
+			Before:   nonZeroSha(before),
+			After:    nonZeroSha(after),
+			Commits:  commits,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: push to ref %q", ErrUnsupportedEvent, ref)
+}
+
+// unmarshal decodes a payload, wrapping errors with the provider-specific event name.
+func unmarshal(body []byte, event string, v interface{}) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to decode %q webhook payload: %w", event, err)
+	}
+	return nil
+}
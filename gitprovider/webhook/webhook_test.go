@@ -0,0 +1,548 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func TestDecode(t *testing.T) {
+	podinfo := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: "github.com", Organization: "fluxcd"},
+		RepositoryName:  "podinfo",
+	}
+	gitlabPodinfo := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{
+			Domain:           "gitlab.example.com",
+			Organization:     "flux",
+			SubOrganizations: []string{"apps"},
+		},
+		RepositoryName: "podinfo",
+	}
+	giteaPodinfo := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: "http://gitea.example.com:3000", Organization: "flux"},
+		RepositoryName:  "podinfo",
+	}
+	stashPodinfo := gitprovider.OrgRepositoryRef{
+		OrganizationRef: gitprovider.OrganizationRef{Domain: "stash.example.com", Organization: "Flux"},
+		RepositoryName:  "Podinfo",
+	}
+	stashPodinfo.SetKey("FLUX")
+	stashPodinfo.SetSlug("podinfo")
+	stashDotfiles := gitprovider.UserRepositoryRef{
+		UserRef:        gitprovider.UserRef{UserLogin: "jdoe"},
+		RepositoryName: "dotfiles",
+	}
+	stashDotfiles.SetSlug("dotfiles")
+
+	tests := []struct {
+		name     string
+		provider gitprovider.ProviderID
+		header   http.Header
+		file     string
+		want     []Event
+		wantErr  error
+	}{
+		{
+			name:     "GitHub push",
+			provider: ProviderGitHub,
+			header:   newHeader(githubEventHeader, "push", githubDeliveryHeader, "72d3162e"),
+			file:     "github_push.json",
+			want: []Event{&PushEvent{
+				Metadata: Metadata{Provider: ProviderGitHub, DeliveryID: "72d3162e", Repository: podinfo, Sender: "jdoe"},
+				Ref:      "refs/heads/main",
+				Branch:   "main",
+				Before:   "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+				After:    "0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c",
+				Commits: []gitprovider.CommitInfo{{
+					Sha:       "0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c",
+					TreeSha:   "f9d2a07e9488b91af2641b26b9407fe22a451433",
+					Author:    "Jane Doe",
+					Message:   "Update README.md",
+					CreatedAt: time.Date(2026, 5, 15, 15, 20, 30, 0, time.FixedZone("", -7*3600)),
+					URL:       "https://github.com/fluxcd/podinfo/commit/0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c",
+				}},
+			}},
+		},
+		{
+			name:     "GitHub tag deletion in a user repository",
+			provider: ProviderGitHub,
+			header:   newHeader(githubEventHeader, "push"),
+			file:     "github_tag_delete.json",
+			want: []Event{&TagEvent{
+				Metadata: Metadata{
+					Provider: ProviderGitHub,
+					Repository: gitprovider.UserRepositoryRef{
+						UserRef:        gitprovider.UserRef{Domain: "github.com", UserLogin: "jdoe"},
+						RepositoryName: "dotfiles",
+					},
+					Sender: "jdoe",
+				},
+				Ref: "refs/tags/v1.0.0",
+				Tag: "v1.0.0",
+			}},
+		},
+		{
+			name:     "GitHub merged pull request",
+			provider: ProviderGitHub,
+			header:   newHeader(githubEventHeader, "pull_request"),
+			file:     "github_pull_request.json",
+			want: []Event{&PullRequestEvent{
+				Metadata: Metadata{Provider: ProviderGitHub, Repository: podinfo, Sender: "jdoe"},
+				Action:   PullRequestActionMerged,
+				PullRequest: gitprovider.PullRequestInfo{
+					Title:        "Add webhook support",
+					Description:  "Receives webhooks.",
+					Merged:       true,
+					Number:       42,
+					WebURL:       "https://github.com/fluxcd/podinfo/pull/42",
+					SourceBranch: "webhooks",
+					TargetBranch: "main",
+					HeadSha:      "0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c",
+					State:        gitprovider.PullRequestStateMerged,
+					Author:       "jdoe",
+				},
+			}},
+		},
+		{
+			name:     "GitHub ping",
+			provider: ProviderGitHub,
+			header:   newHeader(githubEventHeader, "ping"),
+			file:     "github_push.json",
+		},
+		{
+			name:     "GitHub issues",
+			provider: ProviderGitHub,
+			header:   newHeader(githubEventHeader, "issues"),
+			file:     "github_push.json",
+			wantErr:  ErrUnsupportedEvent,
+		},
+		{
+			name:     "GitLab branch creation in a subgroup",
+			provider: ProviderGitLab,
+			header:   newHeader(gitlabEventHeader, "Push Hook"),
+			file:     "gitlab_push.json",
+			want: []Event{&PushEvent{
+				Metadata: Metadata{Provider: ProviderGitLab, Repository: gitlabPodinfo, Sender: "jdoe"},
+				Ref:      "refs/heads/feature",
+				Branch:   "feature",
+				After:    "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+				Commits: []gitprovider.CommitInfo{{
+					Sha:       "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+					Author:    "Jane Doe",
+					Message:   "Add feature",
+					CreatedAt: time.Date(2026, 5, 15, 10, 0, 0, 0, time.FixedZone("", 2*3600)),
+					URL:       "https://gitlab.example.com/flux/apps/podinfo/-/commit/da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+				}},
+			}},
+		},
+		{
+			name:     "GitLab merge request update with new commits",
+			provider: ProviderGitLab,
+			header:   newHeader(gitlabEventHeader, "Merge Request Hook"),
+			file:     "gitlab_merge_request.json",
+			want: []Event{&PullRequestEvent{
+				Metadata: Metadata{Provider: ProviderGitLab, Repository: gitlabPodinfo, Sender: "jdoe"},
+				Action:   PullRequestActionUpdated,
+				PullRequest: gitprovider.PullRequestInfo{
+					Title:        "Add feature",
+					Description:  "Adds the feature.",
+					Number:       7,
+					WebURL:       "https://gitlab.example.com/flux/apps/podinfo/-/merge_requests/7",
+					SourceBranch: "feature",
+					TargetBranch: "main",
+					HeadSha:      "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+					State:        gitprovider.PullRequestStateOpen,
+				},
+			}},
+		},
+		{
+			name:     "Gitea tag creation",
+			provider: ProviderGitea,
+			header:   newHeader(giteaEventHeader, "push"),
+			file:     "gitea_push.json",
+			want: []Event{&TagEvent{
+				Metadata: Metadata{Provider: ProviderGitea, Repository: giteaPodinfo, Sender: "jdoe"},
+				Ref:      "refs/tags/v0.1.0",
+				Tag:      "v0.1.0",
+				Sha:      "2b0d5d3c4a4f6a8b6d8c3a8f3d4f5e6a7b8c9d0e",
+			}},
+		},
+		{
+			name:     "Gitea synchronized pull request",
+			provider: ProviderGitea,
+			header:   newHeader(giteaEventHeader, "pull_request"),
+			file:     "gitea_pull_request.json",
+			want: []Event{&PullRequestEvent{
+				Metadata: Metadata{Provider: ProviderGitea, Repository: giteaPodinfo, Sender: "jdoe"},
+				Action:   PullRequestActionUpdated,
+				PullRequest: gitprovider.PullRequestInfo{
+					Title:        "Bump version",
+					Number:       3,
+					WebURL:       "http://gitea.example.com:3000/flux/podinfo/pulls/3",
+					SourceBranch: "bump",
+					TargetBranch: "main",
+					HeadSha:      "2b0d5d3c4a4f6a8b6d8c3a8f3d4f5e6a7b8c9d0e",
+					State:        gitprovider.PullRequestStateOpen,
+					Author:       "jdoe",
+				},
+			}},
+		},
+		{
+			name:     "Bitbucket Server refs changed",
+			provider: ProviderBitbucketServer,
+			header:   newHeader(bitbucketServerEventHeader, "repo:refs_changed", bitbucketServerDeliveryHeader, "f6a1"),
+			file:     "bitbucketserver_refs_changed.json",
+			want: []Event{
+				&PushEvent{
+					Metadata: Metadata{Provider: ProviderBitbucketServer, DeliveryID: "f6a1", Repository: stashPodinfo, Sender: "jdoe"},
+					Ref:      "refs/heads/main",
+					Branch:   "main",
+					Before:   "ecddabb624f6f5ba43816f5926e580a5f680a932",
+					After:    "178864a7d521b6f5e720b386b2c2b0ef8563e0dc",
+				},
+				&TagEvent{
+					Metadata: Metadata{Provider: ProviderBitbucketServer, DeliveryID: "f6a1", Repository: stashPodinfo, Sender: "jdoe"},
+					Ref:      "refs/tags/v1.0.0",
+					Tag:      "v1.0.0",
+					Sha:      "178864a7d521b6f5e720b386b2c2b0ef8563e0dc",
+				},
+			},
+		},
+		{
+			name:     "Bitbucket Server merged pull request in a personal project",
+			provider: ProviderBitbucketServer,
+			header:   newHeader(bitbucketServerEventHeader, "pr:merged"),
+			file:     "bitbucketserver_pr_merged.json",
+			want: []Event{&PullRequestEvent{
+				Metadata: Metadata{Provider: ProviderBitbucketServer, Repository: stashDotfiles, Sender: "jdoe"},
+				Action:   PullRequestActionMerged,
+				PullRequest: gitprovider.PullRequestInfo{
+					Title:        "Add feature",
+					Description:  "Adds the feature.",
+					Merged:       true,
+					Number:       9,
+					WebURL:       "https://stash.example.com/users/jdoe/repos/dotfiles/pull-requests/9",
+					SourceBranch: "feature",
+					TargetBranch: "main",
+					HeadSha:      "178864a7d521b6f5e720b386b2c2b0ef8563e0dc",
+					BaseSha:      "ecddabb624f6f5ba43816f5926e580a5f680a932",
+					State:        gitprovider.PullRequestStateMerged,
+					Author:       "jdoe",
+				},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := DetectProvider(tt.header)
+			if err != nil {
+				t.Fatalf("DetectProvider() error = %v", err)
+			}
+			if provider != tt.provider {
+				t.Errorf("DetectProvider() = %q, want %q", provider, tt.provider)
+			}
+
+			got, err := Decode(provider, tt.header, readTestdata(t, tt.file))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Decode() error = %v, want %v", err, tt.wantErr)
+			}
+			opts := cmp.AllowUnexported(gitprovider.OrganizationRef{}, gitprovider.OrgRepositoryRef{}, gitprovider.UserRepositoryRef{})
+			if diff := cmp.Diff(tt.want, got, opts); diff != "" {
+				t.Errorf("Decode() returned diff (want -> got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDecodeForm(t *testing.T) {
+	body := readTestdata(t, "github_push.json")
+	want, err := Decode(ProviderGitHub, newHeader(githubEventHeader, "push"), body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	form := []byte(url.Values{"payload": {string(body)}}.Encode())
+	header := newHeader(githubEventHeader, "push", "Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	got, err := Decode(ProviderGitHub, header, form)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	opts := cmp.AllowUnexported(gitprovider.OrganizationRef{}, gitprovider.OrgRepositoryRef{})
+	if diff := cmp.Diff(want, got, opts); diff != "" {
+		t.Errorf("Decode() returned diff (want -> got):\n%s", diff)
+	}
+
+	if _, err := Decode(ProviderGitHub, header, []byte("ref=refs%2Fheads%2Fmain")); err == nil {
+		t.Error("Decode() of a form without payload field succeeded")
+	}
+}
+
+func TestDetectProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  http.Header
+		want    gitprovider.ProviderID
+		wantErr error
+	}{
+		{
+			name:   "Gitea sends GitHub headers too",
+			header: newHeader(githubEventHeader, "push", giteaEventHeader, "push"),
+			want:   ProviderGitea,
+		},
+		{
+			name:    "Bitbucket Cloud",
+			header:  newHeader(bitbucketServerEventHeader, "repo:push", "X-Hook-Uuid", "6c9f"),
+			wantErr: ErrUnknownProvider,
+		},
+		{
+			name:    "no headers",
+			header:  newHeader(),
+			wantErr: ErrUnknownProvider,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectProvider(tt.header)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DetectProvider() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DetectProvider() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"ref":"refs/heads/main"}`)
+	secret := "s3cr3t"
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	sig := hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name     string
+		provider gitprovider.ProviderID
+		header   http.Header
+		secret   string
+		wantErr  error
+	}{
+		{
+			name:     "GitHub valid",
+			provider: ProviderGitHub,
+			header:   newHeader(githubSignatureHeader, "sha256="+sig),
+			secret:   secret,
+		},
+		{
+			name:     "GitHub invalid",
+			provider: ProviderGitHub,
+			header:   newHeader(githubSignatureHeader, "sha256="+sig),
+			secret:   "other",
+			wantErr:  ErrInvalidSignature,
+		},
+		{
+			name:     "GitHub missing",
+			provider: ProviderGitHub,
+			header:   newHeader(),
+			secret:   secret,
+			wantErr:  ErrMissingSignature,
+		},
+		{
+			name:     "GitLab valid",
+			provider: ProviderGitLab,
+			header:   newHeader(gitlabTokenHeader, secret),
+			secret:   secret,
+		},
+		{
+			name:     "GitLab invalid",
+			provider: ProviderGitLab,
+			header:   newHeader(gitlabTokenHeader, "other"),
+			secret:   secret,
+			wantErr:  ErrInvalidSignature,
+		},
+		{
+			name:     "Gitea valid",
+			provider: ProviderGitea,
+			header:   newHeader(giteaSignatureHeader, sig),
+			secret:   secret,
+		},
+		{
+			name:     "Gitea with prefix",
+			provider: ProviderGitea,
+			header:   newHeader(giteaSignatureHeader, "sha256="+sig),
+			secret:   secret,
+			wantErr:  ErrInvalidSignature,
+		},
+		{
+			name:     "Bitbucket Server valid",
+			provider: ProviderBitbucketServer,
+			header:   newHeader(bitbucketServerSignatureHeader, "sha256="+sig),
+			secret:   secret,
+		},
+		{
+			name:     "no secret",
+			provider: ProviderBitbucketServer,
+			header:   newHeader(bitbucketServerSignatureHeader, "sha256="+sig),
+			wantErr:  ErrMissingSecret,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Verify(tt.provider, tt.header, body, tt.secret); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	body := readTestdata(t, "gitlab_push.json")
+	tests := []struct {
+		name       string
+		method     string
+		header     http.Header
+		fnErr      error
+		wantStatus int
+		wantEvents int
+	}{
+		{
+			name:       "delivered",
+			method:     http.MethodPost,
+			header:     newHeader(gitlabEventHeader, "Push Hook", gitlabTokenHeader, "s3cr3t"),
+			wantStatus: http.StatusNoContent,
+			wantEvents: 1,
+		},
+		{
+			name:       "invalid token",
+			method:     http.MethodPost,
+			header:     newHeader(gitlabEventHeader, "Push Hook", gitlabTokenHeader, "other"),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "unsupported event",
+			method:     http.MethodPost,
+			header:     newHeader(gitlabEventHeader, "Issue Hook", gitlabTokenHeader, "s3cr3t"),
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "unknown provider",
+			method:     http.MethodPost,
+			header:     newHeader(),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "event func failure",
+			method:     http.MethodPost,
+			header:     newHeader(gitlabEventHeader, "Push Hook", gitlabTokenHeader, "s3cr3t"),
+			fnErr:      errors.New("failed"),
+			wantStatus: http.StatusInternalServerError,
+			wantEvents: 1,
+		},
+		{
+			name:       "wrong method",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []Event
+			h := NewHandler("s3cr3t", func(_ context.Context, e Event) error {
+				events = append(events, e)
+				return tt.fnErr
+			})
+
+			req := httptest.NewRequest(tt.method, "/hook", bytes.NewReader(body))
+			for k, v := range tt.header {
+				req.Header[k] = v
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if len(events) != tt.wantEvents {
+				t.Errorf("got %d events, want %d", len(events), tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestNewHandlerEmptySecret(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewHandler() with an empty secret didn't panic")
+		}
+	}()
+	NewHandler("", func(context.Context, Event) error { return nil })
+}
+
+func TestUnverifiedHandler(t *testing.T) {
+	var events []Event
+	h := NewUnverifiedHandler(func(_ context.Context, e Event) error {
+		events = append(events, e)
+		return nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/hook", bytes.NewReader(readTestdata(t, "gitlab_push.json")))
+	req.Header.Set(gitlabEventHeader, "Push Hook")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if len(events) != 1 {
+		t.Errorf("got %d events, want 1", len(events))
+	}
+}
+
+// newHeader returns the canonicalized header of the given key and value pairs.
+func newHeader(kv ...string) http.Header {
+	h := http.Header{}
+	for i := 0; i+1 < len(kv); i += 2 {
+		h.Set(kv[i], kv[i+1])
+	}
+	return h
+}
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
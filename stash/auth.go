@@ -76,5 +76,9 @@ func NewStashClient(username, token string, optFns ...gitprovider.ClientOption)
 		destructiveActions = *opts.EnableDestructiveAPICalls
 	}
 
-	return newClient(stashClient, host, token, destructiveActions, logger), nil
+	p := newClient(stashClient, host, token, destructiveActions, logger)
+	if opts.DryRun != nil && *opts.DryRun {
+		p.dryRun = gitprovider.NewDryRun(destructiveActions, dryRunValidator{})
+	}
+	return p, nil
 }
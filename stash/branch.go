@@ -22,21 +22,25 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 const (
-	branchesURI      = "branches"
-	defaultBranchURI = "default"
+	branchesURI          = "branches"
+	defaultBranchURI     = "default"
+	branchUtilsURIprefix = "/rest/branch-utils/1.0"
 )
 
 // Branches interface defines the methods that can be used to
 // retrieve branches of a repository.
 type Branches interface {
 	List(ctx context.Context, projectKey, repositorySlug string, opts *PagingOptions) (*BranchList, error)
+	All(ctx context.Context, projectKey, repositorySlug string) ([]*Branch, error)
 	Get(ctx context.Context, projectKey, repositorySlug, branchID string) (*Branch, error)
 	Create(ctx context.Context, projectKey, repositorySlug, branchID, startPoint string) (*Branch, error)
 	Default(ctx context.Context, projectKey, repositorySlug string) (*Branch, error)
 	SetDefault(ctx context.Context, projectKey, repositorySlug, branchID string) error
+	Delete(ctx context.Context, projectKey, repositorySlug, branchID string) error
 }
 
 // BranchesService is a client for communicating with stash branches endpoint
@@ -107,6 +111,26 @@ func (s *BranchesService) List(ctx context.Context, projectKey, repositorySlug s
 	return b, nil
 }
 
+// All retrieves all branches of a repository.
+// This function handles pagination, HTTP error wrapping, and validates the server result.
+func (s *BranchesService) All(ctx context.Context, projectKey, repositorySlug string) ([]*Branch, error) {
+	b := []*Branch{}
+	opts := &PagingOptions{Limit: perPageLimit}
+	err := allPages(opts, func() (*Paging, error) {
+		list, err := s.List(ctx, projectKey, repositorySlug, opts)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, list.GetBranches()...)
+		return &list.Paging, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
 // Get retrieves a stash branch given it's ID i.e a git reference.
 // Get uses the endpoint
 // "GET /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/branches?base&details&filterText&orderBy".
@@ -236,3 +260,40 @@ func (s *BranchesService) Create(ctx context.Context, projectKey, repositorySlug
 	b.Session.set(resp)
 	return b, nil
 }
+
+// Delete deletes a branch of a repository given it's ID i.e a git reference.
+// Delete uses the endpoint "DELETE /rest/branch-utils/1.0/projects/{projectKey}/repos/{repositorySlug}/branches".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-branch-rest.html
+func (s *BranchesService) Delete(ctx context.Context, projectKey, repositorySlug, branchID string) error {
+	branch := struct {
+		Name   string `json:"name"`
+		DryRun bool   `json:"dryRun"`
+	}{
+		Name:   branchID,
+		DryRun: false,
+	}
+	body, err := marshallBody(branch)
+	header := http.Header{"Content-Type": []string{"application/json"}}
+
+	if err != nil {
+		return fmt.Errorf("failed to marshall branch: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodDelete, newBranchUtilsURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, branchesURI), WithBody(body), WithHeader(header))
+	if err != nil {
+		return fmt.Errorf("delete branch request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("delete branch failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func newBranchUtilsURI(elements ...string) string {
+	return strings.Join(append([]string{branchUtilsURIprefix}, elements...), "/")
+}
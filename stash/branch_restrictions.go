@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	branchRestrictionsURIprefix = "/rest/branch-permissions/2.0"
+	branchRestrictionsURI       = "restrictions"
+
+	// BranchRestrictionTypeReadOnly prevents all changes to the branch, except by the exempted users and groups.
+	BranchRestrictionTypeReadOnly = "read-only"
+	// BranchRestrictionTypeNoDeletes prevents the deletion of the branch.
+	BranchRestrictionTypeNoDeletes = "no-deletes"
+	// BranchRestrictionTypeFastForwardOnly prevents rewriting the history of the branch.
+	BranchRestrictionTypeFastForwardOnly = "fast-forward-only"
+	// BranchRestrictionTypePullRequestOnly prevents changes to the branch without a pull request.
+	BranchRestrictionTypePullRequestOnly = "pull-request-only"
+
+	// BranchRestrictionMatcherTypeBranch matches a single branch by its ref name.
+	BranchRestrictionMatcherTypeBranch = "BRANCH"
+)
+
+// BranchRestrictions interface defines the methods that can be used to
+// manage the branch restrictions of a repository.
+type BranchRestrictions interface {
+	List(ctx context.Context, projectKey, repositorySlug, branch string, opts *PagingOptions) (*BranchRestrictionList, error)
+	All(ctx context.Context, projectKey, repositorySlug, branch string) ([]*BranchRestriction, error)
+	Create(ctx context.Context, projectKey, repositorySlug string, restriction *BranchRestrictionRequest) (*BranchRestriction, error)
+	Delete(ctx context.Context, projectKey, repositorySlug string, restrictionID int) error
+}
+
+// BranchRestrictionsService is a client for communicating with stash branch permissions endpoint
+// bitbucket-server API docs: https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-ref-restriction-rest.html
+type BranchRestrictionsService service
+
+// BranchRestriction restricts the changes that can be made to the branches matched by its matcher.
+type BranchRestriction struct {
+	// Session is the session object
+	Session `json:"sessionInfo,omitempty"`
+	// ID is the restriction id
+	ID int `json:"id,omitempty"`
+	// Type is the restriction type, e.g. read-only or no-deletes
+	Type string `json:"type"`
+	// Matcher selects the branches the restriction applies to
+	Matcher BranchRestrictionMatcher `json:"matcher"`
+	// Users are the users exempted from the restriction
+	Users []User `json:"users,omitempty"`
+	// Groups are the names of the groups exempted from the restriction
+	Groups []string `json:"groups,omitempty"`
+}
+
+// BranchRestrictionMatcher selects the branches a restriction applies to.
+type BranchRestrictionMatcher struct {
+	// ID is the matched value, e.g. refs/heads/main for a branch matcher
+	ID string `json:"id"`
+	// DisplayID is the human-readable matched value, e.g. main
+	DisplayID string `json:"displayId,omitempty"`
+	// Type is the matcher type
+	Type BranchRestrictionMatcherType `json:"type"`
+	// Active specifies whether the matcher is enabled
+	Active bool `json:"active"`
+}
+
+// BranchRestrictionMatcherType is the type of a branch restriction matcher.
+type BranchRestrictionMatcherType struct {
+	// ID is the type id, e.g. BRANCH
+	ID string `json:"id"`
+	// Name is the type name
+	Name string `json:"name,omitempty"`
+}
+
+// BranchRestrictionRequest is the request for creating a branch restriction.
+// Contrary to BranchRestriction, the exempted users are given by their names.
+type BranchRestrictionRequest struct {
+	// Type is the restriction type, e.g. read-only or no-deletes
+	Type string `json:"type"`
+	// Matcher selects the branches the restriction applies to
+	Matcher BranchRestrictionMatcher `json:"matcher"`
+	// Users are the names of the users exempted from the restriction
+	Users []string `json:"users,omitempty"`
+	// Groups are the names of the groups exempted from the restriction
+	Groups []string `json:"groups,omitempty"`
+}
+
+// BranchRestrictionList is a list of branch restrictions
+type BranchRestrictionList struct {
+	Paging
+	BranchRestrictions []*BranchRestriction `json:"values,omitempty"`
+}
+
+// GetBranchRestrictions returns the list of branch restrictions
+func (b *BranchRestrictionList) GetBranchRestrictions() []*BranchRestriction {
+	return b.BranchRestrictions
+}
+
+// NewBranchMatcher returns a matcher selecting the given branch.
+func NewBranchMatcher(branch string) BranchRestrictionMatcher {
+	return BranchRestrictionMatcher{
+		ID:        fmt.Sprintf("refs/heads/%s", branch),
+		DisplayID: branch,
+		Type:      BranchRestrictionMatcherType{ID: BranchRestrictionMatcherTypeBranch, Name: "Branch"},
+		Active:    true,
+	}
+}
+
+func newBranchRestrictionsURI(elements ...string) string {
+	return strings.Join(append([]string{branchRestrictionsURIprefix}, elements...), "/")
+}
+
+// List returns the list of branch restrictions for the repository. If branch is set,
+// only the restrictions applying to the given branch are returned.
+// Paging is optional and is enabled by providing a PagingOptions struct.
+// A pointer to a BranchRestrictionList struct is returned to retrieve the next page of results.
+// List uses the endpoint "GET /rest/branch-permissions/2.0/projects/{projectKey}/repos/{repositorySlug}/restrictions".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-ref-restriction-rest.html
+func (s *BranchRestrictionsService) List(ctx context.Context, projectKey, repositorySlug, branch string, opts *PagingOptions) (*BranchRestrictionList, error) {
+	query := addPaging(url.Values{}, opts)
+	if branch != "" {
+		query.Add("matcherType", BranchRestrictionMatcherTypeBranch)
+		query.Add("matcherId", fmt.Sprintf("refs/heads/%s", branch))
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodGet, newBranchRestrictionsURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, branchRestrictionsURI), WithQuery(query))
+	if err != nil {
+		return nil, fmt.Errorf("list branch restrictions for repository request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("list branch restrictions for repository requests failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	restrictions := &BranchRestrictionList{}
+	if err := json.Unmarshal(res, restrictions); err != nil {
+		return nil, fmt.Errorf("list branch restrictions for repository failed, unable to unmarshall json: %w", err)
+	}
+
+	for _, r := range restrictions.GetBranchRestrictions() {
+		r.Session.set(resp)
+	}
+
+	return restrictions, nil
+}
+
+// All retrieves all branch restrictions of the repository, or of the given branch if set.
+// This function handles pagination, HTTP error wrapping, and validates the server result.
+func (s *BranchRestrictionsService) All(ctx context.Context, projectKey, repositorySlug, branch string) ([]*BranchRestriction, error) {
+	r := []*BranchRestriction{}
+	opts := &PagingOptions{Limit: perPageLimit}
+	err := allPages(opts, func() (*Paging, error) {
+		list, err := s.List(ctx, projectKey, repositorySlug, branch, opts)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, list.GetBranchRestrictions()...)
+		return &list.Paging, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Create creates a branch restriction.
+// Create uses the endpoint "POST /rest/branch-permissions/2.0/projects/{projectKey}/repos/{repositorySlug}/restrictions".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-ref-restriction-rest.html
+func (s *BranchRestrictionsService) Create(ctx context.Context, projectKey, repositorySlug string, restriction *BranchRestrictionRequest) (*BranchRestriction, error) {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(restriction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall branch restriction: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newBranchRestrictionsURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, branchRestrictionsURI), WithBody(body), WithHeader(header))
+	if err != nil {
+		return nil, fmt.Errorf("create branch restriction for repository request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("create branch restriction for repository requests failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusBadRequest {
+		return nil, fmt.Errorf("create branch restriction for repository failed: %s", resp.Status)
+	}
+
+	r := &BranchRestriction{}
+	if err := json.Unmarshal(res, r); err != nil {
+		return nil, fmt.Errorf("create branch restriction for repository failed, unable to unmarshall json: %w", err)
+	}
+
+	r.Session.set(resp)
+
+	return r, nil
+}
+
+// Delete deletes the branch restriction with the given ID.
+// Delete uses the endpoint "DELETE /rest/branch-permissions/2.0/projects/{projectKey}/repos/{repositorySlug}/restrictions/{id}".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-ref-restriction-rest.html
+func (s *BranchRestrictionsService) Delete(ctx context.Context, projectKey, repositorySlug string, restrictionID int) error {
+	req, err := s.Client.NewRequest(ctx, http.MethodDelete, newBranchRestrictionsURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, branchRestrictionsURI, strconv.Itoa(restrictionID)))
+	if err != nil {
+		return fmt.Errorf("delete branch restriction for repository request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("delete branch restriction for repository requests failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
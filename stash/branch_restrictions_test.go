@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListBranchRestrictions(t *testing.T) {
+	restrictions := []*BranchRestriction{
+		{ID: 1, Type: BranchRestrictionTypeNoDeletes, Matcher: NewBranchMatcher("main")},
+		{ID: 2, Type: BranchRestrictionTypeReadOnly, Matcher: NewBranchMatcher("main"), Users: []User{{Name: "alice"}}},
+	}
+
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s", branchRestrictionsURIprefix, projectsURI, RepositoriesURI, branchRestrictionsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("matcherId"); got != "refs/heads/main" {
+			http.Error(w, fmt.Sprintf("unexpected matcherId %q", got), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		l := struct {
+			Values     []*BranchRestriction `json:"values"`
+			IsLastPage bool                 `json:"isLastPage"`
+		}{restrictions, true}
+		json.NewEncoder(w).Encode(l)
+	})
+	ctx := context.Background()
+	list, err := client.BranchRestrictions.All(ctx, "prj1", "repo1", "main")
+	if err != nil {
+		t.Fatalf("BranchRestrictions.All returned error: %v", err)
+	}
+
+	if diff := cmp.Diff(restrictions, list); diff != "" {
+		t.Errorf("BranchRestrictions.All returned diff (want -> got):\n%s", diff)
+	}
+}
+
+func TestCreateBranchRestriction(t *testing.T) {
+	req := &BranchRestrictionRequest{
+		Type:    BranchRestrictionTypeReadOnly,
+		Matcher: NewBranchMatcher("main"),
+		Users:   []string{"alice"},
+	}
+
+	mux, client := setup(t)
+
+	var got *BranchRestrictionRequest
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s", branchRestrictionsURIprefix, projectsURI, RepositoriesURI, branchRestrictionsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+			return
+		}
+		got = &BranchRestrictionRequest{}
+		json.NewDecoder(r.Body).Decode(got)
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&BranchRestriction{
+			ID:      1,
+			Type:    got.Type,
+			Matcher: got.Matcher,
+			Users:   []User{{Name: "alice"}},
+		})
+	})
+
+	ctx := context.Background()
+	restriction, err := client.BranchRestrictions.Create(ctx, "prj1", "repo1", req)
+	if err != nil {
+		t.Fatalf("BranchRestrictions.Create returned error: %v", err)
+	}
+
+	if diff := cmp.Diff(req, got); diff != "" {
+		t.Errorf("BranchRestrictions.Create sent diff (want -> got):\n%s", diff)
+	}
+	if restriction.ID != 1 {
+		t.Errorf("BranchRestrictions.Create returned ID %d, want 1", restriction.ID)
+	}
+}
+
+func TestDeleteBranchRestriction(t *testing.T) {
+	mux, client := setup(t)
+
+	deleted := false
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s/1", branchRestrictionsURIprefix, projectsURI, RepositoriesURI, branchRestrictionsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+			return
+		}
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if err := client.BranchRestrictions.Delete(ctx, "prj1", "repo1", 1); err != nil {
+		t.Fatalf("BranchRestrictions.Delete returned error: %v", err)
+	}
+	if !deleted {
+		t.Error("BranchRestrictions.Delete didn't send the request")
+	}
+}
@@ -153,3 +153,69 @@ func TestDefaultBranch(t *testing.T) {
 		t.Errorf("Branches.Default returned branch:\n%s, want:\n %s", b.ID, d.ID)
 	}
 }
+
+func TestAllBranches(t *testing.T) {
+	bIDs := []*Branch{
+		{ID: "refs/heads/main"}, {ID: "refs/heads/release"}, {ID: "refs/heads/feature"}}
+
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s", stashURIprefix, projectsURI, RepositoriesURI, branchesURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("start") == "" {
+			b := &BranchList{
+				Paging:   Paging{IsLastPage: false, NextPageStart: 2},
+				Branches: bIDs[:2],
+			}
+			json.NewEncoder(w).Encode(b)
+			return
+		}
+		b := &BranchList{
+			Paging:   Paging{IsLastPage: true},
+			Branches: bIDs[2:],
+		}
+		json.NewEncoder(w).Encode(b)
+	})
+
+	ctx := context.Background()
+	list, err := client.Branches.All(ctx, "prj1", "repo1")
+	if err != nil {
+		t.Fatalf("Branches.All returned error: %v", err)
+	}
+
+	if diff := cmp.Diff(bIDs, list); diff != "" {
+		t.Errorf("Branches.All returned diff (want -> got):\n%s", diff)
+	}
+}
+
+func TestDeleteBranch(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s", branchUtilsURIprefix, projectsURI, RepositoriesURI, branchesURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Branches.Delete used method %s, want %s", r.Method, http.MethodDelete)
+		}
+		b := struct {
+			Name   string `json:"name"`
+			DryRun bool   `json:"dryRun"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if b.Name != "refs/heads/feature" {
+			http.Error(w, "The specified branch does not exist", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if err := client.Branches.Delete(ctx, "prj1", "repo1", "refs/heads/feature"); err != nil {
+		t.Fatalf("Branches.Delete returned error: %v", err)
+	}
+	if err := client.Branches.Delete(ctx, "prj1", "repo1", "refs/heads/missing"); err != ErrNotFound {
+		t.Fatalf("Branches.Delete returned error: %v, want %v", err, ErrNotFound)
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	buildStatusURIprefix  = "/rest/build-status/1.0"
+	buildStatusCommitsURI = "commits"
+
+	// BuildStatusStateSuccessful is the state of a successful build.
+	BuildStatusStateSuccessful = "SUCCESSFUL"
+	// BuildStatusStateFailed is the state of a failed build.
+	BuildStatusStateFailed = "FAILED"
+	// BuildStatusStateInProgress is the state of a build which is in progress.
+	BuildStatusStateInProgress = "INPROGRESS"
+)
+
+// BuildStatuses interface defines the methods that can be used to
+// manage the build statuses of a commit.
+type BuildStatuses interface {
+	List(ctx context.Context, commitID string, opts *PagingOptions) (*BuildStatusList, error)
+	All(ctx context.Context, commitID string) ([]*BuildStatus, error)
+	Create(ctx context.Context, commitID string, status *BuildStatus) error
+}
+
+// BuildStatusesService is a client for communicating with stash build status endpoint
+// bitbucket-server API docs: https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-build-rest.html
+type BuildStatusesService service
+
+// BuildStatus is the result of a build for a commit.
+// Build statuses are keyed by commit only, hence are shared by all repositories containing the commit.
+type BuildStatus struct {
+	// Session is the session object
+	Session `json:"sessionInfo,omitempty"`
+	// State is the build state, one of SUCCESSFUL, FAILED or INPROGRESS
+	State string `json:"state"`
+	// Key is the build key, identifying the build among the ones of the commit
+	Key string `json:"key"`
+	// Name is the build name
+	Name string `json:"name,omitempty"`
+	// URL is the link to the build result
+	URL string `json:"url"`
+	// Description is the build description
+	Description string `json:"description,omitempty"`
+	// DateAdded is the time the status was added in milliseconds since epoch
+	DateAdded int64 `json:"dateAdded,omitempty"`
+}
+
+// BuildStatusList is a list of build statuses
+type BuildStatusList struct {
+	Paging
+	BuildStatuses []*BuildStatus `json:"values,omitempty"`
+}
+
+// GetBuildStatuses returns the list of build statuses
+func (b *BuildStatusList) GetBuildStatuses() []*BuildStatus {
+	return b.BuildStatuses
+}
+
+func newBuildStatusURI(commitID string) string {
+	return strings.Join([]string{buildStatusURIprefix, buildStatusCommitsURI, url.PathEscape(commitID)}, "/")
+}
+
+// List returns the list of build statuses for the commit.
+// Paging is optional and is enabled by providing a PagingOptions struct.
+// A pointer to a BuildStatusList struct is returned to retrieve the next page of results.
+// List uses the endpoint "GET /rest/build-status/1.0/commits/{commitId}".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-build-rest.html
+func (s *BuildStatusesService) List(ctx context.Context, commitID string, opts *PagingOptions) (*BuildStatusList, error) {
+	query := addPaging(url.Values{}, opts)
+	req, err := s.Client.NewRequest(ctx, http.MethodGet, newBuildStatusURI(commitID), WithQuery(query))
+	if err != nil {
+		return nil, fmt.Errorf("list build statuses for commit request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("list build statuses for commit requests failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	statuses := &BuildStatusList{}
+	if err := json.Unmarshal(res, statuses); err != nil {
+		return nil, fmt.Errorf("list build statuses for commit failed, unable to unmarshall json: %w", err)
+	}
+
+	for _, status := range statuses.GetBuildStatuses() {
+		status.Session.set(resp)
+	}
+
+	return statuses, nil
+}
+
+// All retrieves all build statuses of the commit.
+// This function handles pagination, HTTP error wrapping, and validates the server result.
+func (s *BuildStatusesService) All(ctx context.Context, commitID string) ([]*BuildStatus, error) {
+	b := []*BuildStatus{}
+	opts := &PagingOptions{Limit: perPageLimit}
+	err := allPages(opts, func() (*Paging, error) {
+		list, err := s.List(ctx, commitID, opts)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, list.GetBuildStatuses()...)
+		return &list.Paging, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Create adds a build status to the commit. A status with the same key replaces the existing one.
+// The server doesn't return the created status.
+// Create uses the endpoint "POST /rest/build-status/1.0/commits/{commitId}".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-build-rest.html
+func (s *BuildStatusesService) Create(ctx context.Context, commitID string, status *BuildStatus) error {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(status)
+	if err != nil {
+		return fmt.Errorf("failed to marshall build status: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newBuildStatusURI(commitID), WithBody(body), WithHeader(header))
+	if err != nil {
+		return fmt.Errorf("create build status for commit request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("create build status for commit requests failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusBadRequest {
+		return fmt.Errorf("create build status for commit failed: %s", resp.Status)
+	}
+
+	return nil
+}
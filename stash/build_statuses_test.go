@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListBuildStatuses(t *testing.T) {
+	statuses := []*BuildStatus{
+		{State: BuildStatusStateSuccessful, Key: "ci/build", URL: "https://ci.example.com/1", DateAdded: 1700000000000},
+		{State: BuildStatusStateFailed, Key: "ci/lint", URL: "https://ci.example.com/2", DateAdded: 1700000001000},
+	}
+
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/abc123", buildStatusURIprefix, buildStatusCommitsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		l := struct {
+			Values     []*BuildStatus `json:"values"`
+			IsLastPage bool           `json:"isLastPage"`
+		}{statuses, true}
+		json.NewEncoder(w).Encode(l)
+	})
+	ctx := context.Background()
+	list, err := client.BuildStatuses.All(ctx, "abc123")
+	if err != nil {
+		t.Fatalf("BuildStatuses.All returned error: %v", err)
+	}
+
+	if diff := cmp.Diff(statuses, list); diff != "" {
+		t.Errorf("BuildStatuses.All returned diff (want -> got):\n%s", diff)
+	}
+}
+
+func TestCreateBuildStatus(t *testing.T) {
+	req := &BuildStatus{
+		State:       BuildStatusStateInProgress,
+		Key:         "ci/build",
+		Name:        "ci/build",
+		URL:         "https://ci.example.com/1",
+		Description: "Build started",
+	}
+
+	mux, client := setup(t)
+
+	var got *BuildStatus
+	path := fmt.Sprintf("%s/%s/abc123", buildStatusURIprefix, buildStatusCommitsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+			return
+		}
+		got = &BuildStatus{}
+		json.NewDecoder(r.Body).Decode(got)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if err := client.BuildStatuses.Create(ctx, "abc123", req); err != nil {
+		t.Fatalf("BuildStatuses.Create returned error: %v", err)
+	}
+
+	if diff := cmp.Diff(req, got); diff != "" {
+		t.Errorf("BuildStatuses.Create sent diff (want -> got):\n%s", diff)
+	}
+}
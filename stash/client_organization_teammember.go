@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TeamMemberClient implements the gitprovider.TeamMemberClient interface.
+var _ gitprovider.TeamMemberClient = &TeamMemberClient{}
+
+// TeamMemberClient operates on the members of a specific team (stash group).
+// Stash groups don't have maintainers, hence only the member role is supported.
+type TeamMemberClient struct {
+	*clientContext
+	team string
+}
+
+// List all members of the group, all of them having the member role.
+//
+// List returns all available members, using multiple paginated requests if needed.
+func (c *TeamMemberClient) List(ctx context.Context) ([]gitprovider.TeamMemberInfo, error) {
+	users, err := c.client.Groups.AllGroupMembers(ctx, c.team)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to list members of group %s: %w", c.team, err)
+	}
+
+	members := make([]gitprovider.TeamMemberInfo, 0, len(users))
+	// We rely on slugs here as it is used for login
+	for _, slug := range getGroupMemberSlugs(users) {
+		members = append(members, gitprovider.TeamMemberInfo{
+			Login: slug,
+			Role:  gitprovider.TeamMemberRoleVar(gitprovider.TeamMemberRoleMember),
+		})
+	}
+	return members, nil
+}
+
+// Add adds the user to the group. Adding a user that already is a member is a no-op.
+//
+// ErrNoProviderSupport is returned for the maintainer role.
+func (c *TeamMemberClient) Add(ctx context.Context, req gitprovider.TeamMemberInfo) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return err
+	}
+	if err := validateTeamMemberRole(req); err != nil {
+		return err
+	}
+	if err := c.client.Groups.AddUsers(ctx, c.team, req.Login); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to add user %s to group %s: %w", req.Login, c.team, err)
+	}
+	return nil
+}
+
+// Remove removes the user with the given login from the group.
+//
+// ErrNotFound is returned if the user isn't a member of the group.
+func (c *TeamMemberClient) Remove(ctx context.Context, login string) error {
+	// Removing a user that isn't a member succeeds, hence check the membership first
+	members, err := c.List(ctx)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, member := range members {
+		if member.Login == login {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("user %q isn't a member of group %q: %w", login, c.team, gitprovider.ErrNotFound)
+	}
+	if err := c.client.Groups.RemoveUser(ctx, c.team, login); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to remove user %s from group %s: %w", login, c.team, err)
+	}
+	return nil
+}
+
+// Reconcile makes sure the users in req are members of the group, adding them as needed.
+// With the Exclusive option, the members of the group that aren't in req are removed.
+//
+// ErrNoProviderSupport is returned if any user in req has the maintainer role.
+func (c *TeamMemberClient) Reconcile(ctx context.Context, req []gitprovider.TeamMemberInfo, opts ...gitprovider.TeamMemberReconcileOption) (bool, error) {
+	for _, member := range req {
+		if err := validateTeamMemberRole(member); err != nil {
+			return false, err
+		}
+	}
+	return gitprovider.ReconcileTeamMembers(ctx, c, req, opts...)
+}
+
+// validateTeamMemberRole returns an error if info requests a role stash groups don't have.
+func validateTeamMemberRole(info gitprovider.TeamMemberInfo) error {
+	if info.Role != nil && *info.Role != gitprovider.TeamMemberRoleMember {
+		return fmt.Errorf("groups don't have maintainers, %q role: %w", *info.Role, gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ package stash
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -49,6 +50,9 @@ func getGroupMemberSlugs(users []*User) []string {
 func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Team, error) {
 	users, err := c.client.Groups.AllGroupMembers(ctx, teamName)
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
 		return nil, err
 	}
 
@@ -67,13 +71,12 @@ func (c *TeamsClient) Get(ctx context.Context, teamName string) (gitprovider.Tea
 	team := &Team{
 		ref:   c.ref,
 		users: users,
+		c:     c,
 	}
 
-	team.info = gitprovider.TeamInfo{
-		Name: teamName,
-		// We rely on slugs here as it is used for login
-		Members: getGroupMemberSlugs(team.users),
-	}
+	team.info = groupInfo(teamName)
+	// We rely on slugs here as it is used for login
+	team.info.Members = getGroupMemberSlugs(team.users)
 
 	return team, nil
 }
@@ -119,6 +122,74 @@ func (c *TeamsClient) List(ctx context.Context) ([]gitprovider.Team, error) {
 	return teams, nil
 }
 
+// Create creates a team (stash group) with the given name. Members of req are ignored.
+//
+// Stash groups have neither a description nor a privacy, hence only the closed privacy is
+// supported. Groups are created for the whole server, they are only listed for the project
+// once they are granted access to it.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *TeamsClient) Create(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if err := validateGroupInfo(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.client.Groups.Create(ctx, req.Name); err != nil {
+		if errors.Is(err, ErrAlreadyExists) {
+			return nil, gitprovider.ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create group %s: %w", req.Name, err)
+	}
+
+	return &Team{
+		ref:   c.ref,
+		users: []*User{},
+		info:  groupInfo(req.Name),
+		c:     c,
+	}, nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *TeamsClient) Reconcile(ctx context.Context, req gitprovider.TeamInfo) (gitprovider.Team, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, req.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
+
 func validateProjectGroupPermissionAPI(apiObj *ProjectGroupPermission) error {
 	return validateAPIObject("Stash.ProjectGroupPermission", func(validator validation.Validator) {
 		if apiObj.Group.Name == "" {
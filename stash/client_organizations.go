@@ -18,7 +18,9 @@ package stash
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/fluxcd/go-git-providers/validation"
@@ -97,6 +99,142 @@ func (c *OrganizationsClient) Children(_ context.Context, _ gitprovider.Organiza
 	return nil, gitprovider.ErrNoProviderSupport
 }
 
+// Create creates a project with the given description and visibility. The internal visibility
+// isn't supported, as projects are either public or private.
+//
+// The name of the project is the organization of the OrganizationRef, hence the name in req
+// must equal it. The key of the OrganizationRef is used as key of the project if it's set,
+// otherwise the key is made of the letters, digits and underscores of the name, in upper case.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *OrganizationsClient) Create(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, error) {
+	// Make sure the OrganizationRef is valid
+	if err := validateOrganizationRef(ref, c.host); err != nil {
+		return nil, err
+	}
+	if err := validateOrganizationInfo(ref, req); err != nil {
+		return nil, err
+	}
+
+	key := ref.Key()
+	if key == "" {
+		key = projectKey(ref.Organization)
+	}
+	project := &Project{
+		Key:  key,
+		Name: ref.Organization,
+	}
+	organizationInfoToAPI(req, project)
+	apiObj, err := c.client.Projects.Create(ctx, project)
+	if err != nil {
+		if errors.Is(err, ErrAlreadyExists) {
+			return nil, gitprovider.ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create organization %q: %w", ref.Organization, err)
+	}
+	if err := validateProjectAPI(apiObj); err != nil {
+		return nil, err
+	}
+
+	ref.SetKey(apiObj.Key)
+	return newOrganization(c.clientContext, apiObj, ref), nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *OrganizationsClient) Reconcile(ctx context.Context, ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) (gitprovider.Organization, bool, error) {
+	if err := validateOrganizationInfo(ref, req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.Get(ctx, ref)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, ref, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	project := *actual.APIObject().(*Project)
+	organizationInfoToAPI(req, &project)
+	apiObj, err := c.client.Projects.Update(ctx, project.Key, &project)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return actual, true, gitprovider.ErrNotFound
+		}
+		return actual, true, fmt.Errorf("failed to update organization %q: %w", ref.Organization, err)
+	}
+	if err := validateProjectAPI(apiObj); err != nil {
+		return actual, true, err
+	}
+
+	ref.SetKey(apiObj.Key)
+	return newOrganization(c.clientContext, apiObj, ref), true, nil
+}
+
+// Delete deletes the project. Projects that still contain repositories can't be deleted.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource doesn't exist.
+func (c *OrganizationsClient) Delete(ctx context.Context, ref gitprovider.OrganizationRef) error {
+	// Don't allow deleting projects if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete organization: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	org, err := c.Get(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if err := c.client.Projects.Delete(ctx, org.Organization().Key()); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete organization %q: %w", ref.Organization, err)
+	}
+	return nil
+}
+
+// validateOrganizationInfo validates req, and returns an error if its name isn't the name
+// of the project ref points to, or it requests the internal visibility.
+func validateOrganizationInfo(ref gitprovider.OrganizationRef, req gitprovider.OrganizationInfo) error {
+	if err := req.ValidateInfo(); err != nil {
+		return err
+	}
+	if *req.Name != ref.Organization {
+		return fmt.Errorf("the name of project %q is its organization, %q: %w", ref.Organization, *req.Name, gitprovider.ErrInvalidArgument)
+	}
+	if req.Visibility != nil && *req.Visibility == gitprovider.OrganizationVisibilityInternal {
+		return fmt.Errorf("projects are either public or private, %q visibility: %w", *req.Visibility, gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
+
+// projectKey returns the key of a new project called name, made of its letters,
+// digits and underscores in upper case.
+func projectKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		}
+		return -1
+	}, name)
+}
+
 // validateOrganizationRef makes sure the OrganizationRef is valid for stash usage.
 func validateOrganizationRef(ref gitprovider.OrganizationRef, expectedDomain string) error {
 	// Make sure the OrganizationRef fields are valid
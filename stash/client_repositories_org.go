@@ -143,21 +143,27 @@ func (c *OrgRepositoriesClient) Create(ctx context.Context,
 // If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 // If req is already the actual state, this is a no-op (actionTaken == false).
 func (c *OrgRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, ref, req, opts...)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.OrgRepositoriesClient.ReconcileWithChanges.
+func (c *OrgRepositoriesClient) ReconcileWithChanges(ctx context.Context, ref gitprovider.OrgRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.OrgRepository, []gitprovider.FieldChange, error) {
 	actual, err := c.Get(ctx, ref)
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
-			return resp, true, err
+			return resp, req.Diff(gitprovider.RepositoryInfo{}), err
 		}
 
 		// Unexpected path, Get should succeed or return NotFound
-		return nil, false, fmt.Errorf("unexpected error when reconciling repository: %w", err)
+		return nil, nil, fmt.Errorf("unexpected error when reconciling repository: %w", err)
 	}
 
-	actionTaken, err := c.reconcileRepository(ctx, actual, req)
+	changes, err := c.reconcileRepository(ctx, actual, req)
 
-	return actual, actionTaken, err
+	return actual, changes, err
 }
 
 // update will apply the desired state in this object to the server.
@@ -197,6 +203,9 @@ func createRepository(ctx context.Context, c *Client, orgKey string, ref gitprov
 	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
 		return nil, err
 	}
+	if err := validateRepositorySupport(req); err != nil {
+		return nil, err
+	}
 
 	// Assemble the options struct based on the given options
 	opt, err := gitprovider.MakeRepositoryCreateOptions(opts...)
@@ -343,18 +352,16 @@ func getRepoHTTPref(clones []Clone) string {
 	return "no http ref found"
 }
 
-func (c *OrgRepositoriesClient) reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
-	actionTaken := false
-
+func (c *OrgRepositoriesClient) reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) ([]gitprovider.FieldChange, error) {
 	// If the desired matches the actual state, just return the actual state
-	new := actual.Get()
-	if req.Equals(new) {
-		return actionTaken, nil
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return nil, nil
 	}
 	// Populate the desired state to the current-actual object
 	err := actual.Set(req)
 	if err != nil {
-		return actionTaken, err
+		return nil, err
 	}
 
 	projectKey, repoSlug := getStashRefs(actual.Repository())
@@ -367,11 +374,10 @@ func (c *OrgRepositoriesClient) reconcileRepository(ctx context.Context, actual
 	}
 
 	if err != nil {
-		return actionTaken, err
+		return nil, err
 	}
 
-	actionTaken = true
-	return actionTaken, nil
+	return changes, nil
 }
 
 func toCreateOpts(opts ...gitprovider.RepositoryReconcileOption) []gitprovider.RepositoryCreateOption {
@@ -157,35 +157,39 @@ func (c *UserRepositoriesClient) Create(ctx context.Context,
 // If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
 // If req is already the actual state, this is a no-op (actionTaken == false).
 func (c *UserRepositoriesClient) Reconcile(ctx context.Context, ref gitprovider.UserRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.UserRepository, bool, error) {
+	resp, changes, err := c.ReconcileWithChanges(ctx, ref, req, opts...)
+	return resp, len(changes) != 0, err
+}
+
+// ReconcileWithChanges implements gitprovider.UserRepositoriesClient.ReconcileWithChanges.
+func (c *UserRepositoriesClient) ReconcileWithChanges(ctx context.Context, ref gitprovider.UserRepositoryRef, req gitprovider.RepositoryInfo, opts ...gitprovider.RepositoryReconcileOption) (gitprovider.UserRepository, []gitprovider.FieldChange, error) {
 	actual, err := c.Get(ctx, ref)
 	if err != nil {
 		// Create if not found
 		if errors.Is(err, gitprovider.ErrNotFound) {
 			resp, err := c.Create(ctx, ref, req, toCreateOpts(opts...)...)
-			return resp, true, err
+			return resp, req.Diff(gitprovider.RepositoryInfo{}), err
 		}
 
 		// Unexpected path, Get should succeed or return NotFound
-		return nil, false, fmt.Errorf("failed to reconcile repository %s/%s: %w", addTilde(ref.UserLogin), ref.RepositoryName, err)
+		return nil, nil, fmt.Errorf("failed to reconcile repository %s/%s: %w", addTilde(ref.UserLogin), ref.RepositoryName, err)
 	}
 
-	actionTaken, err := c.reconcileRepository(ctx, actual, req)
+	changes, err := c.reconcileRepository(ctx, actual, req)
 
-	return actual, actionTaken, err
+	return actual, changes, err
 }
 
-func (c *UserRepositoriesClient) reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) (bool, error) {
-	actionTaken := false
-
+func (c *UserRepositoriesClient) reconcileRepository(ctx context.Context, actual gitprovider.UserRepository, req gitprovider.RepositoryInfo) ([]gitprovider.FieldChange, error) {
 	// If the desired matches the actual state, just return the actual state
-	new := actual.Get()
-	if req.Equals(new) {
-		return actionTaken, nil
+	changes := req.Diff(actual.Get())
+	if len(changes) == 0 {
+		return nil, nil
 	}
 	// Populate the desired state to the current-actual object
 	err := actual.Set(req)
 	if err != nil {
-		return actionTaken, err
+		return nil, err
 	}
 
 	repo := actual.APIObject().(*Repository)
@@ -198,12 +202,10 @@ func (c *UserRepositoriesClient) reconcileRepository(ctx context.Context, actual
 	}
 
 	if err != nil {
-		return actionTaken, err
+		return nil, err
 	}
 
-	actionTaken = true
-
-	return actionTaken, nil
+	return changes, nil
 }
 
 func validateUserAPI(apiObj *User) error {
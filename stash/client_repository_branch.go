@@ -18,7 +18,9 @@ package stash
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
@@ -32,6 +34,90 @@ type BranchClient struct {
 	ref gitprovider.RepositoryRef
 }
 
+// Get returns the branch with the given name.
+// ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Get(ctx context.Context, branch string) (gitprovider.Branch, error) {
+	apiObj, err := c.get(ctx, branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch %q: %w", branch, err)
+	}
+	protected, err := c.protectedBranches(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch %q: %w", branch, err)
+	}
+	return newBranch(c, apiObj, protected[apiObj.DisplayID]), nil
+}
+
+func (c *BranchClient) get(ctx context.Context, branch string) (*Branch, error) {
+	branches, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Loop through the branches until we find one with the right name
+	for _, b := range branches {
+		if b.DisplayID == branch {
+			return b, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all branches of the repository.
+// List returns all available branches for the given repository,
+// using multiple paginated requests if needed.
+func (c *BranchClient) List(ctx context.Context) ([]gitprovider.Branch, error) {
+	apiObjs, err := c.list(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+	protected, err := c.protectedBranches(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+	// Cast to the generic []gitprovider.Branch
+	branches := make([]gitprovider.Branch, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		branches = append(branches, newBranch(c, apiObj, protected[apiObj.DisplayID]))
+	}
+	return branches, nil
+}
+
+func (c *BranchClient) list(ctx context.Context) ([]*Branch, error) {
+	projectKey, repoSlug := c.repositoryKeys()
+	apiObjs, err := c.client.Branches.All(ctx, projectKey, repoSlug)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
+		return nil, err
+	}
+
+	for _, apiObj := range apiObjs {
+		if err := validateBranchAPI(apiObj); err != nil {
+			return nil, err
+		}
+	}
+	return apiObjs, nil
+}
+
+// protectedBranches returns the names of the branches to which at least one
+// branch restriction applies.
+func (c *BranchClient) protectedBranches(ctx context.Context) (map[string]bool, error) {
+	projectKey, repoSlug := c.repositoryKeys()
+	restrictions, err := c.client.BranchRestrictions.All(ctx, projectKey, repoSlug, "")
+	if err != nil {
+		return nil, err
+	}
+	protected := map[string]bool{}
+	for _, restriction := range restrictions {
+		if restriction.Matcher.Type.ID != BranchRestrictionMatcherTypeBranch {
+			continue
+		}
+		protected[strings.TrimPrefix(restriction.Matcher.ID, "refs/heads/")] = true
+	}
+	return protected, nil
+}
+
 // Create creates a branch with the given specifications.
 func (c *BranchClient) Create(ctx context.Context, branch, sha string) error {
 	projectKey, repoSlug := getStashRefs(c.ref)
@@ -91,13 +177,7 @@ func (c *BranchClient) Create(ctx context.Context, branch, sha string) error {
 }
 
 func (c *BranchClient) getDefault(ctx context.Context) (string, error) {
-	projectKey, repoSlug := getStashRefs(c.ref)
-
-	// check if it is a user repository
-	// if yes, we need to add a tilde to the user login and use it as the project key
-	if r, ok := c.ref.(gitprovider.UserRepositoryRef); ok {
-		projectKey = addTilde(r.UserLogin)
-	}
+	projectKey, repoSlug := c.repositoryKeys()
 
 	b, err := c.client.Branches.Default(ctx, projectKey, repoSlug)
 	if err != nil {
@@ -107,3 +187,58 @@ func (c *BranchClient) getDefault(ctx context.Context) (string, error) {
 	return b.DisplayID, nil
 
 }
+
+// Delete deletes the branch with the given name irreversibly.
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the branch does not exist.
+func (c *BranchClient) Delete(ctx context.Context, branch string) error {
+	// Don't allow deleting branches if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete branch: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	projectKey, repoSlug := c.repositoryKeys()
+	if err := c.client.Branches.Delete(ctx, projectKey, repoSlug, fmt.Sprintf("refs/heads/%s", branch)); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete branch %q: %w", branch, err)
+	}
+	return nil
+}
+
+// RenameDefault makes a new branch with the given name, pointing to the head of the current
+// default branch, the default branch of the repository, and retargets the open pull requests into
+// the current default branch to it. Bitbucket Server can't rename branches, so the current default branch
+// is kept.
+// ErrAlreadyExists is returned if a branch with the given name already exists.
+func (c *BranchClient) RenameDefault(ctx context.Context, branch string) error {
+	if _, err := c.get(ctx, branch); err == nil {
+		return fmt.Errorf("branch %q: %w", branch, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return err
+	}
+
+	projectKey, repoSlug := c.repositoryKeys()
+	current, err := c.client.Branches.Default(ctx, projectKey, repoSlug)
+	if err != nil {
+		return fmt.Errorf("failed to get default branch: %w", err)
+	}
+	b, err := c.client.Branches.Create(ctx, projectKey, repoSlug, branch, current.LatestCommit)
+	if err != nil {
+		return fmt.Errorf("failed to create branch %q: %w", branch, err)
+	}
+	if err := c.client.Branches.SetDefault(ctx, projectKey, repoSlug, b.ID); err != nil {
+		return fmt.Errorf("failed to set default branch %q: %w", branch, err)
+	}
+	return gitprovider.RetargetPullRequests(ctx, &PullRequestClient{clientContext: c.clientContext, ref: c.ref}, current.DisplayID, branch)
+}
+
+// repositoryKeys returns the project key and repository slug, using the
+// user's personal project for user repositories.
+func (c *BranchClient) repositoryKeys() (string, string) {
+	projectKey, repoSlug := getStashRefs(c.ref)
+	if r, ok := c.ref.(gitprovider.UserRepositoryRef); ok {
+		projectKey = addTilde(r.UserLogin)
+	}
+	return projectKey, repoSlug
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/hashicorp/go-multierror"
+)
+
+// BranchProtectionClient implements the gitprovider.BranchProtectionClient interface.
+var _ gitprovider.BranchProtectionClient = &BranchProtectionClient{}
+
+// BranchProtectionClient operates on the branch protections of a specific repository.
+// Bitbucket Server protects branches through branch restrictions, hence a branch is
+// protected if at least one restriction applies to it.
+type BranchProtectionClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the protection of the given branch.
+// ErrNotFound is returned if the branch is not protected.
+func (c *BranchProtectionClient) Get(ctx context.Context, branch string) (gitprovider.BranchProtection, error) {
+	apiObj, err := c.get(ctx, branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch protection %q: %w", branch, err)
+	}
+	return newBranchProtection(c, apiObj), nil
+}
+
+func (c *BranchProtectionClient) get(ctx context.Context, branch string) (*BranchProtection, error) {
+	protections, err := c.list(ctx, branch)
+	if err != nil {
+		return nil, err
+	}
+	for _, protection := range protections {
+		if protection.Branch == branch {
+			return protection, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all branch protections of the repository.
+// List returns all available branch protections for the given repository,
+// using multiple paginated requests if needed.
+func (c *BranchProtectionClient) List(ctx context.Context) ([]gitprovider.BranchProtection, error) {
+	apiObjs, err := c.list(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branch protections: %w", err)
+	}
+	// Cast to the generic []gitprovider.BranchProtection
+	protections := make([]gitprovider.BranchProtection, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		protections = append(protections, newBranchProtection(c, apiObj))
+	}
+	return protections, nil
+}
+
+// list returns the restrictions of the repository grouped by branch, and sorted by branch name.
+// Restrictions which don't match a single branch, e.g. the ones using patterns, are skipped.
+// If branch is set, only the restrictions of that branch are requested.
+func (c *BranchProtectionClient) list(ctx context.Context, branch string) ([]*BranchProtection, error) {
+	projectKey, repoSlug := c.repositoryKeys()
+	apiObjs, err := c.client.BranchRestrictions.All(ctx, projectKey, repoSlug, branch)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
+		return nil, err
+	}
+
+	var errs error
+	protections := map[string]*BranchProtection{}
+	for _, apiObj := range apiObjs {
+		if err := validateBranchRestrictionAPI(apiObj); err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		if apiObj.Matcher.Type.ID != BranchRestrictionMatcherTypeBranch {
+			continue
+		}
+		name := strings.TrimPrefix(apiObj.Matcher.ID, "refs/heads/")
+		if _, ok := protections[name]; !ok {
+			protections[name] = &BranchProtection{Branch: name}
+		}
+		protections[name].Restrictions = append(protections[name].Restrictions, apiObj)
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	result := make([]*BranchProtection, 0, len(protections))
+	for _, protection := range protections {
+		result = append(result, protection)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Branch < result[j].Branch })
+	return result, nil
+}
+
+// Create protects a branch with the given specifications.
+//
+// ErrAlreadyExists will be returned if the branch is already protected.
+func (c *BranchProtectionClient) Create(ctx context.Context, req gitprovider.BranchProtectionInfo) (gitprovider.BranchProtection, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if _, err := c.get(ctx, req.Branch); err == nil {
+		return nil, fmt.Errorf("branch protection for %q: %w", req.Branch, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, fmt.Errorf("failed to create branch protection: %w", err)
+	}
+
+	bp := newBranchProtection(c, &BranchProtection{Branch: req.Branch})
+	if err := bp.Set(req); err != nil {
+		return nil, err
+	}
+	if len(bp.p.Restrictions) == 0 {
+		return nil, fmt.Errorf("branch protection for %q enforces no rules: %w", req.Branch, gitprovider.ErrInvalidArgument)
+	}
+	if err := c.update(ctx, &bp.p); err != nil {
+		return nil, fmt.Errorf("failed to create branch protection: %w", err)
+	}
+	return bp, nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *BranchProtectionClient) Reconcile(ctx context.Context, req gitprovider.BranchProtectionInfo) (gitprovider.BranchProtection, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the protection of the desired branch
+	actual, err := c.Get(ctx, req.Branch)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, fmt.Errorf("failed to reconcile branch protection %q: %w", req.Branch, err)
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	if err := actual.Update(ctx); err != nil {
+		return actual, false, fmt.Errorf("failed to update branch protection %q: %w", req.Branch, err)
+	}
+	return actual, true, nil
+}
+
+// update makes the restrictions of the branch on the server match the given ones. Restrictions
+// can't be edited, hence changed restrictions are deleted and created again.
+// The given protection is overridden with the received server data.
+func (c *BranchProtectionClient) update(ctx context.Context, protection *BranchProtection) error {
+	projectKey, repoSlug := c.repositoryKeys()
+	actual, err := c.get(ctx, protection.Branch)
+	if err != nil && !errors.Is(err, gitprovider.ErrNotFound) {
+		return err
+	}
+	if actual == nil {
+		actual = &BranchProtection{Branch: protection.Branch}
+	}
+
+	desired := map[string]bool{}
+	for _, r := range protection.Restrictions {
+		desired[restrictionKey(r)] = true
+	}
+	existing := map[string]bool{}
+	for _, r := range actual.Restrictions {
+		key := restrictionKey(r)
+		if desired[key] {
+			existing[key] = true
+			continue
+		}
+		if err := c.client.BranchRestrictions.Delete(ctx, projectKey, repoSlug, r.ID); err != nil {
+			return fmt.Errorf("failed to delete branch restriction %d: %w", r.ID, err)
+		}
+	}
+	for _, r := range protection.Restrictions {
+		if existing[restrictionKey(r)] {
+			continue
+		}
+		users := make([]string, 0, len(r.Users))
+		for _, user := range r.Users {
+			users = append(users, user.Name)
+		}
+		_, err := c.client.BranchRestrictions.Create(ctx, projectKey, repoSlug, &BranchRestrictionRequest{
+			Type:    r.Type,
+			Matcher: NewBranchMatcher(protection.Branch),
+			Users:   users,
+			Groups:  r.Groups,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create branch restriction %q: %w", r.Type, err)
+		}
+	}
+
+	apiObj, err := c.get(ctx, protection.Branch)
+	if err != nil {
+		return err
+	}
+	*protection = *apiObj
+	return nil
+}
+
+// delete removes all restrictions of the given branch from the server.
+// ErrNotFound is returned if the branch isn't protected.
+func (c *BranchProtectionClient) delete(ctx context.Context, branch string) error {
+	protection, err := c.get(ctx, branch)
+	if err != nil {
+		return err
+	}
+	projectKey, repoSlug := c.repositoryKeys()
+	for _, r := range protection.Restrictions {
+		if err := c.client.BranchRestrictions.Delete(ctx, projectKey, repoSlug, r.ID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return fmt.Errorf("failed to delete branch protection %q: %w", branch, err)
+		}
+	}
+	return nil
+}
+
+// repositoryKeys returns the project key and repository slug, using the
+// user's personal project for user repositories.
+func (c *BranchProtectionClient) repositoryKeys() (string, string) {
+	projectKey, repoSlug := getStashRefs(c.ref)
+	if r, ok := c.ref.(gitprovider.UserRepositoryRef); ok {
+		projectKey = addTilde(r.UserLogin)
+	}
+	return projectKey, repoSlug
+}
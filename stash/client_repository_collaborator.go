@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// CollaboratorClient implements the gitprovider.CollaboratorClient interface.
+var _ gitprovider.CollaboratorClient = &CollaboratorClient{}
+
+// CollaboratorClient operates on the users granted a permission on a specific repository.
+type CollaboratorClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get the repository permission of the user with the given login.
+// The permissions users inherit from the project aren't taken into account.
+// Stash grants permissions right away, hence collaborators are never pending.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *CollaboratorClient) Get(ctx context.Context, login string) (gitprovider.Collaborator, error) {
+	return c.get(ctx, login)
+}
+
+func (c *CollaboratorClient) get(ctx context.Context, login string) (*collaborator, error) {
+	collaborators, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, collaborator := range collaborators {
+		if collaborator.u.User.Name == login {
+			return collaborator, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists the users that have been granted a permission on the repository.
+//
+// List returns all available collaborators, using multiple paginated requests if needed.
+func (c *CollaboratorClient) List(ctx context.Context) ([]gitprovider.Collaborator, error) {
+	collaborators, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]gitprovider.Collaborator, 0, len(collaborators))
+	for _, collaborator := range collaborators {
+		result = append(result, collaborator)
+	}
+	return result, nil
+}
+
+func (c *CollaboratorClient) list(ctx context.Context) ([]*collaborator, error) {
+	projectKey, repoSlug := getStashRefs(c.ref)
+	apiObjs, err := c.client.Repositories.AllUsersPermission(ctx, projectKey, repoSlug)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to list repository collaborators: %w", err)
+	}
+
+	collaborators := make([]*collaborator, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		collaborator, err := newCollaborator(c, apiObj)
+		if err != nil {
+			return nil, err
+		}
+		collaborators = append(collaborators, collaborator)
+	}
+	return collaborators, nil
+}
+
+// Create grants the user a permission on the repository.
+// Stash only supports the pull, push and admin permissions.
+//
+// ErrAlreadyExists will be returned if the resource already exists.
+func (c *CollaboratorClient) Create(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.get(ctx, req.Login); err == nil {
+		return nil, fmt.Errorf("collaborator %q: %w", req.Login, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+	if err := c.update(ctx, req); err != nil {
+		return nil, err
+	}
+	return c.get(ctx, req.Login)
+}
+
+// update grants the permission of req to the user, replacing the permission they had.
+func (c *CollaboratorClient) update(ctx context.Context, req gitprovider.CollaboratorInfo) error {
+	projectKey, repoSlug := getStashRefs(c.ref)
+	permission, err := getStashPermission(*req.Permission)
+	if err != nil {
+		return err
+	}
+	err = c.client.Repositories.UpdateRepositoryUserPermission(ctx, projectKey, repoSlug, req.Login, permission)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to update repository collaborator: %w", err)
+	}
+	return nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *CollaboratorClient) Reconcile(ctx context.Context, req gitprovider.CollaboratorInfo) (gitprovider.Collaborator, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	actual, err := c.get(ctx, req.Login)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, err
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	return actual, true, actual.Update(ctx)
+}
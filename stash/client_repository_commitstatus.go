@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/hashicorp/go-multierror"
+)
+
+// CommitStatusClient implements the gitprovider.CommitStatusClient interface.
+var _ gitprovider.CommitStatusClient = &CommitStatusClient{}
+
+// CommitStatusClient operates on the commit statuses for a specific repository.
+// Bitbucket Server stores build statuses per commit, hence they're shared by all
+// repositories containing the commit.
+type CommitStatusClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// List lists all statuses of the commit with the given sha.
+//
+// List returns all available statuses, using multiple paginated requests if needed.
+func (c *CommitStatusClient) List(ctx context.Context, sha string) ([]gitprovider.CommitStatus, error) {
+	apiObjs, err := c.list(ctx, sha)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commit statuses: %w", err)
+	}
+	// Cast to the generic []gitprovider.CommitStatus
+	statuses := make([]gitprovider.CommitStatus, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		statuses = append(statuses, newCommitStatus(c, apiObj))
+	}
+	return statuses, nil
+}
+
+func (c *CommitStatusClient) list(ctx context.Context, sha string) ([]*BuildStatus, error) {
+	apiObjs, err := c.client.BuildStatuses.All(ctx, sha)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
+		return nil, err
+	}
+
+	var errs error
+	for _, apiObj := range apiObjs {
+		if err := validateCommitStatusAPI(apiObj); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+	return apiObjs, nil
+}
+
+// Create creates a status for the commit with the given sha.
+//
+// Bitbucket Server requires a TargetURL, ErrInvalidArgument is returned if it is empty.
+func (c *CommitStatusClient) Create(ctx context.Context, sha string, req gitprovider.CommitStatusInfo) (gitprovider.CommitStatus, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+	if req.TargetURL == "" {
+		return nil, fmt.Errorf("commit status target URL is required by Bitbucket Server: %w", gitprovider.ErrInvalidArgument)
+	}
+
+	apiObj := commitStatusToAPI(&req)
+	if err := c.client.BuildStatuses.Create(ctx, sha, apiObj); err != nil {
+		return nil, fmt.Errorf("failed to create commit status: %w", err)
+	}
+	// The server doesn't return the created status
+	apiObj.DateAdded = time.Now().UnixMilli()
+	return newCommitStatus(c, apiObj), nil
+}
+
+// Combined returns the combined status of the commit with the given sha.
+func (c *CommitStatusClient) Combined(ctx context.Context, sha string) (*gitprovider.CombinedCommitStatusInfo, error) {
+	apiObjs, err := c.list(ctx, sha)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get combined commit status: %w", err)
+	}
+
+	statuses := make([]gitprovider.CommitStatusInfo, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		statuses = append(statuses, commitStatusFromAPI(apiObj))
+	}
+	combined := gitprovider.CombineCommitStatuses(sha, statuses)
+	return &combined, nil
+}
@@ -18,6 +18,7 @@ package stash
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
@@ -47,12 +48,26 @@ func (c *PullRequestClient) Get(ctx context.Context, number int) (gitprovider.Pu
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pull request: %w", err)
 	}
-	return newPullRequest(pr), nil
+	return newPullRequest(c, pr), nil
 
 }
 
-// List returns all pull requests for the given repository.
-func (c *PullRequestClient) List(ctx context.Context) ([]gitprovider.PullRequest, error) {
+// List returns the pull requests for the given repository, by default only the open ones.
+func (c *PullRequestClient) List(ctx context.Context, optFns ...gitprovider.PullRequestListOption) ([]gitprovider.PullRequest, error) {
+	opts, err := gitprovider.MakePullRequestListOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+	state := PullRequestStateAll
+	switch *opts.State {
+	case gitprovider.PullRequestStateOpen:
+		state = PullRequestStateOpen
+	case gitprovider.PullRequestStateClosed:
+		state = PullRequestStateDeclined
+	case gitprovider.PullRequestStateMerged:
+		state = PullRequestStateMerged
+	}
+
 	projectKey, repoSlug := getStashRefs(c.ref)
 
 	// check if it is a user repository
@@ -61,24 +76,46 @@ func (c *PullRequestClient) List(ctx context.Context) ([]gitprovider.PullRequest
 		projectKey = addTilde(r.UserLogin)
 	}
 
-	apiObjs, err := c.client.PullRequests.All(ctx, projectKey, repoSlug)
+	apiObjs, err := c.client.PullRequests.All(ctx, projectKey, repoSlug, state)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pull requests: %w", err)
 	}
 
-	// Traverse the list, and return a list of OrgRepository objects
+	// Traverse the list, and return a list of PullRequest objects
 	prs := make([]gitprovider.PullRequest, 0, len(apiObjs))
 	for _, apiObj := range apiObjs {
-		prs = append(prs, newPullRequest(apiObj))
+		if opts.Matches(pullrequestFromAPI(apiObj)) {
+			prs = append(prs, newPullRequest(c, apiObj))
+		}
 	}
 
 	return prs, nil
 
 }
 
-// Merge merges the pull request.
-// Stash does not support message and merge strategy options for pull requests automatic merges.
-func (c *PullRequestClient) Merge(ctx context.Context, number int, _ gitprovider.MergeMethod, _ string) error {
+// mergeStrategies maps the merge methods to the Bitbucket Server merge strategies, which have to be
+// enabled for the repository.
+//
+//nolint:gochecknoglobals
+var mergeStrategies = map[gitprovider.MergeMethod]string{
+	gitprovider.MergeMethodMerge:  MergeStrategyNoFastForward,
+	gitprovider.MergeMethodSquash: MergeStrategySquash,
+	gitprovider.MergeMethodRebase: MergeStrategyRebaseNoFastForward,
+}
+
+// Merge merges the pull request with the given specifications. All merge methods and options are supported.
+// The pull request is merged at the version it's fetched at, hence a *gitprovider.ConflictError is returned
+// if it changes before it's merged.
+func (c *PullRequestClient) Merge(ctx context.Context, number int, mergeMethod gitprovider.MergeMethod, message string, optFns ...gitprovider.PullRequestMergeOption) (*gitprovider.PullRequestMergeResult, error) {
+	opts, err := gitprovider.MakePullRequestMergeOptions(optFns...)
+	if err != nil {
+		return nil, err
+	}
+	strategy, ok := mergeStrategies[mergeMethod]
+	if !ok {
+		return nil, fmt.Errorf("unknown merge method %q: %w", mergeMethod, gitprovider.ErrInvalidArgument)
+	}
+
 	projectKey, repoSlug := getStashRefs(c.ref)
 
 	// check if it is a user repository
@@ -90,17 +127,55 @@ func (c *PullRequestClient) Merge(ctx context.Context, number int, _ gitprovider
 	// Get the pull request first
 	pr, err := c.client.PullRequests.Get(ctx, projectKey, repoSlug, number)
 	if err != nil {
-		return fmt.Errorf("failed to get pull request: %w", err)
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+	if opts.ExpectedHeadSha != nil && pr.FromRef.LatestCommit != *opts.ExpectedHeadSha {
+		return nil, gitprovider.NewHeadChangedError(number, *opts.ExpectedHeadSha, pr.FromRef.LatestCommit)
 	}
 
-	// Merge the pull request
-	_, err = c.client.PullRequests.Merge(ctx, projectKey, repoSlug, pr.ID, pr.Version)
+	// Merge the pull request, the server refuses if its version changed meanwhile
+	merged, err := c.client.PullRequests.Merge(ctx, projectKey, repoSlug, pr.ID, pr.Version, &PullRequestMerge{
+		Message:    message,
+		StrategyID: strategy,
+	})
 	if err != nil {
-		return err
+		if errors.Is(err, ErrConflict) {
+			return nil, &gitprovider.ConflictError{HTTPError: gitprovider.HTTPError{ErrorMessage: err.Error(), Message: err.Error()}}
+		}
+		return nil, err
 	}
 
-	return nil
+	if opts.DeleteSourceBranch != nil && *opts.DeleteSourceBranch {
+		if err := c.deleteSourceBranch(ctx, projectKey, repoSlug, merged); err != nil {
+			return nil, err
+		}
+	}
 
+	if merged.Properties.MergeCommit != nil {
+		return &gitprovider.PullRequestMergeResult{Sha: merged.Properties.MergeCommit.ID}, nil
+	}
+	// Older Bitbucket Server versions don't return the merge commit, the target branch points to it
+	commits, err := c.client.Commits.ListPage(ctx, projectKey, repoSlug, merged.ToRef.ID, 1, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the head of branch %q: %w", merged.ToRef.DisplayID, err)
+	}
+	if len(commits) == 0 {
+		return nil, fmt.Errorf("branch %q has no commits: %w", merged.ToRef.DisplayID, gitprovider.ErrInvalidServerData)
+	}
+	return &gitprovider.PullRequestMergeResult{Sha: commits[0].ID}, nil
+}
+
+// deleteSourceBranch deletes the source branch of the given merged pull request. As its changes are
+// part of the target branch, this is allowed even if destructive API calls are disabled. Nothing is
+// deleted if the pull request isn't merged, or its source branch is in a fork.
+func (c *PullRequestClient) deleteSourceBranch(ctx context.Context, projectKey, repoSlug string, pr *PullRequest) error {
+	if pr.State != PullRequestStateMerged || pr.FromRef.Repository.ID != pr.ToRef.Repository.ID {
+		return nil
+	}
+	if err := c.client.Branches.Delete(ctx, projectKey, repoSlug, pr.FromRef.ID); err != nil {
+		return fmt.Errorf("failed to delete branch %q: %w", pr.FromRef.DisplayID, err)
+	}
+	return nil
 }
 
 // Create creates a pull request with the given specifications.
@@ -140,11 +215,18 @@ func (c *PullRequestClient) Create(ctx context.Context, title, branch, baseBranc
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pull request: %w", err)
 	}
-	return newPullRequest(created), nil
+	return newPullRequest(c, created), nil
 }
 
 // Edit modifies an existing PR. Please refer to "EditOptions" for details on which data can be edited.
+// Stash has no assignees nor labels, drafts are supported since Bitbucket Server 8.18.
 func (c *PullRequestClient) Edit(ctx context.Context, number int, opts gitprovider.EditOptions) (gitprovider.PullRequest, error) {
+	if err := opts.ValidateOptions(); err != nil {
+		return nil, err
+	}
+	if opts.Assignees != nil || opts.Labels != nil {
+		return nil, fmt.Errorf("pull request assignees and labels: %w", gitprovider.ErrNoProviderSupport)
+	}
 	projectKey, repoSlug := getStashRefs(c.ref)
 
 	// check if it is a user repository
@@ -163,18 +245,61 @@ func (c *PullRequestClient) Edit(ctx context.Context, number int, opts gitprovid
 		return nil, fmt.Errorf("API object is of unexpected type") // this should never happen!
 	}
 
+	// Only open pull requests can be updated, hence reopen them first, and decline them last
+	if opts.State != nil && *opts.State == gitprovider.PullRequestStateOpen && apiObject.State == PullRequestStateDeclined {
+		apiObject, err = c.client.PullRequests.Reopen(ctx, projectKey, repoSlug, number, apiObject.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reopen pull request: %w", err)
+		}
+	}
+
 	if opts.Title != nil {
 		apiObject.Title = *opts.Title
 	}
-	// the REST API doesn't accept the following fields to be set for update requests
-	apiObject.Author = nil
-	apiObject.Participants = nil
-	edited, err := c.client.PullRequests.Update(ctx, projectKey, repoSlug, apiObject)
-	if err != nil {
-		return nil, fmt.Errorf("failed to edit pull request: %w", err)
+	if opts.Description != nil {
+		apiObject.Description = *opts.Description
+	}
+	if opts.TargetBranch != nil {
+		apiObject.ToRef.ID = fmt.Sprintf("refs/heads/%s", *opts.TargetBranch)
+		apiObject.ToRef.DisplayID = *opts.TargetBranch
+	}
+	if opts.Draft != nil {
+		apiObject.Draft = opts.Draft
 	}
+	for _, login := range opts.Reviewers {
+		if !hasReviewer(apiObject, login) {
+			apiObject.Reviewers = append(apiObject.Reviewers, Participant{User: User{Name: login}})
+		}
+	}
+	edited := apiObject
+	if opts.Title != nil || opts.Description != nil || opts.TargetBranch != nil || opts.Draft != nil || len(opts.Reviewers) != 0 {
+		// the REST API doesn't accept the following fields to be set for update requests
+		apiObject.Author = nil
+		apiObject.Participants = nil
+		edited, err = c.client.PullRequests.Update(ctx, projectKey, repoSlug, apiObject)
+		if err != nil {
+			return nil, fmt.Errorf("failed to edit pull request: %w", err)
+		}
+	}
+
+	if opts.State != nil && *opts.State == gitprovider.PullRequestStateClosed && edited.State == PullRequestStateOpen {
+		edited, err = c.client.PullRequests.Decline(ctx, projectKey, repoSlug, number, edited.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decline pull request: %w", err)
+		}
+	}
+
+	return newPullRequest(c, edited), nil
+}
 
-	return newPullRequest(edited), nil
+// hasReviewer returns true if a review of the pull request is requested from the given login.
+func hasReviewer(pr *PullRequest, login string) bool {
+	for _, reviewer := range pr.Reviewers {
+		if reviewer.User.Name == login {
+			return true
+		}
+	}
+	return false
 }
 
 func validatePullRequestsAPI(apiObj *PullRequest) error {
@@ -185,3 +310,13 @@ func validatePullRequestsAPI(apiObj *PullRequest) error {
 		}
 	})
 }
+
+// EnableAutoMerge returns ErrNoProviderSupport, as Bitbucket Server can't merge pull requests automatically.
+func (c *PullRequestClient) EnableAutoMerge(_ context.Context, _ int, _ gitprovider.MergeMethod) error {
+	return gitprovider.ErrNoProviderSupport
+}
+
+// DisableAutoMerge returns ErrNoProviderSupport, as Bitbucket Server can't merge pull requests automatically.
+func (c *PullRequestClient) DisableAutoMerge(_ context.Context, _ int) error {
+	return gitprovider.ErrNoProviderSupport
+}
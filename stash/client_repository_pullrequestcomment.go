@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestCommentClient implements the gitprovider.PullRequestCommentClient interface.
+var _ gitprovider.PullRequestCommentClient = &PullRequestCommentClient{}
+
+// PullRequestCommentClient operates on the general comments of a specific pull request.
+type PullRequestCommentClient struct {
+	*clientContext
+	projectKey string
+	repoSlug   string
+	number     int
+}
+
+// Get returns the comment with the given ID.
+//
+// ErrNotFound is returned if the comment does not exist.
+func (c *PullRequestCommentClient) Get(ctx context.Context, id int64) (gitprovider.PullRequestComment, error) {
+	apiObj, err := c.client.PullRequestComments.Get(ctx, c.projectKey, c.repoSlug, c.number, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request comment: %w", err)
+	}
+	return newPullRequestComment(c, apiObj), nil
+}
+
+// List returns the general comments of the pull request, oldest first.
+// Comments on the diff and replies to comments aren't included.
+func (c *PullRequestCommentClient) List(ctx context.Context) ([]gitprovider.PullRequestComment, error) {
+	apiObjs, err := c.client.PullRequestComments.All(ctx, c.projectKey, c.repoSlug, c.number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request comments: %w", err)
+	}
+
+	comments := make([]gitprovider.PullRequestComment, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		comments = append(comments, newPullRequestComment(c, apiObj))
+	}
+	return comments, nil
+}
+
+// Create creates a general comment with the given body.
+func (c *PullRequestCommentClient) Create(ctx context.Context, req gitprovider.PullRequestCommentInfo) (gitprovider.PullRequestComment, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	apiObj, err := c.client.PullRequestComments.Create(ctx, c.projectKey, c.repoSlug, c.number, req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request comment: %w", err)
+	}
+	return newPullRequestComment(c, apiObj), nil
+}
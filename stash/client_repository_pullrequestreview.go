@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// PullRequestReviewClient implements the gitprovider.PullRequestReviewClient interface.
+var _ gitprovider.PullRequestReviewClient = &PullRequestReviewClient{}
+
+// PullRequestReviewClient operates on the reviews of a specific pull request.
+// Bitbucket Server only keeps the review status of every participant, hence approving and
+// requesting changes sets the status of the authenticated user, and commenting adds a comment.
+type PullRequestReviewClient struct {
+	*clientContext
+	projectKey string
+	repoSlug   string
+	number     int
+}
+
+// List returns one review for every participant that approved the pull request or
+// marked it as needing work.
+func (c *PullRequestReviewClient) List(ctx context.Context) ([]gitprovider.PullRequestReview, error) {
+	pr, err := c.client.PullRequests.Get(ctx, c.projectKey, c.repoSlug, c.number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	reviews := []gitprovider.PullRequestReview{}
+	for _, participants := range [][]Participant{pr.Reviewers, pr.Participants} {
+		for i := range participants {
+			if _, ok := reviewStates[participants[i].Status]; ok {
+				reviews = append(reviews, newParticipantReview(&participants[i]))
+			}
+		}
+	}
+	return reviews, nil
+}
+
+// Create sets the review status of the authenticated user, or adds a comment for a commented review.
+// The body of an approval or changes request is added as a comment.
+func (c *PullRequestReviewClient) Create(ctx context.Context, req gitprovider.PullRequestReviewInfo) (gitprovider.PullRequestReview, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if req.State == gitprovider.PullRequestReviewStateCommented {
+		comment, err := c.client.PullRequestComments.Create(ctx, c.projectKey, c.repoSlug, c.number, req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create pull request comment: %w", err)
+		}
+		return newCommentReview(comment), nil
+	}
+
+	// The participant is identified by the slug of the user
+	user, err := c.client.Users.Get(ctx, c.client.username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %q: %w", c.client.username, err)
+	}
+	status := ParticipantStatusApproved
+	if req.State == gitprovider.PullRequestReviewStateChangesRequested {
+		status = ParticipantStatusNeedsWork
+	}
+	participant, err := c.client.PullRequests.SetParticipantStatus(ctx, c.projectKey, c.repoSlug, c.number, user.Slug, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set participant status: %w", err)
+	}
+	if req.Body != "" {
+		if _, err := c.client.PullRequestComments.Create(ctx, c.projectKey, c.repoSlug, c.number, req.Body); err != nil {
+			return nil, fmt.Errorf("failed to create pull request comment: %w", err)
+		}
+	}
+	review := newParticipantReview(participant)
+	review.info.Body = req.Body
+	return review, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// TagClient implements the gitprovider.TagClient interface.
+var _ gitprovider.TagClient = &TagClient{}
+
+// TagClient operates on the tags of a specific repository.
+// Bitbucket Server doesn't return the message of annotated tags, hence it's never set.
+type TagClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the tag with the given name.
+// ErrNotFound is returned if the tag does not exist.
+func (c *TagClient) Get(ctx context.Context, name string) (gitprovider.Tag, error) {
+	apiObj, err := c.get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag %q: %w", name, err)
+	}
+	return newTag(c, apiObj), nil
+}
+
+func (c *TagClient) get(ctx context.Context, name string) (*Tag, error) {
+	projectKey, repoSlug := c.repositoryKeys()
+	apiObj, err := c.client.Tags.Get(ctx, projectKey, repoSlug, name)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
+		return nil, err
+	}
+	if err := validateTagAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// List lists all tags of the repository.
+// List returns all available tags for the given repository,
+// using multiple paginated requests if needed.
+func (c *TagClient) List(ctx context.Context) ([]gitprovider.Tag, error) {
+	projectKey, repoSlug := c.repositoryKeys()
+	apiObjs, err := c.client.Tags.All(ctx, projectKey, repoSlug)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	// Cast to the generic []gitprovider.Tag
+	tags := make([]gitprovider.Tag, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		if err := validateTagAPI(apiObj); err != nil {
+			return nil, err
+		}
+		tags = append(tags, newTag(c, apiObj))
+	}
+	return tags, nil
+}
+
+// Create creates a tag with the given specifications. An annotated tag is created if
+// req.Message is set, otherwise a lightweight tag.
+// ErrAlreadyExists will be returned if the tag already exists.
+func (c *TagClient) Create(ctx context.Context, req gitprovider.TagInfo) (gitprovider.Tag, error) {
+	if err := req.ValidateInfo(); err != nil {
+		return nil, err
+	}
+	if _, err := c.get(ctx, req.Name); err == nil {
+		return nil, fmt.Errorf("tag %q: %w", req.Name, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, err
+	}
+
+	tag := &CreateTagRequest{
+		Name:       req.Name,
+		StartPoint: req.Sha,
+	}
+	if req.Message != nil {
+		tag.Message = *req.Message
+	}
+	projectKey, repoSlug := c.repositoryKeys()
+	apiObj, err := c.client.Tags.Create(ctx, projectKey, repoSlug, tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag %q: %w", req.Name, err)
+	}
+	if err := validateTagAPI(apiObj); err != nil {
+		return nil, err
+	}
+	return newTag(c, apiObj), nil
+}
+
+// Delete deletes the tag with the given name irreversibly.
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the tag does not exist.
+func (c *TagClient) Delete(ctx context.Context, name string) error {
+	// Don't allow deleting tags if the user didn't explicitly allow dangerous API calls.
+	if !c.destructiveActions {
+		return fmt.Errorf("cannot delete tag: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	projectKey, repoSlug := c.repositoryKeys()
+	if err := c.client.Tags.Delete(ctx, projectKey, repoSlug, name); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete tag %q: %w", name, err)
+	}
+	return nil
+}
+
+func (c *TagClient) repositoryKeys() (string, string) {
+	projectKey, repoSlug := getStashRefs(c.ref)
+	if r, ok := c.ref.(gitprovider.UserRepositoryRef); ok {
+		projectKey = addTilde(r.UserLogin)
+	}
+	return projectKey, repoSlug
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/hashicorp/go-multierror"
+)
+
+// WebhookClient implements the gitprovider.WebhookClient interface.
+var _ gitprovider.WebhookClient = &WebhookClient{}
+
+// WebhookClient operates on the webhooks of a specific repository.
+type WebhookClient struct {
+	*clientContext
+	ref gitprovider.RepositoryRef
+}
+
+// Get returns the webhook delivering events to the given URL.
+// ErrNotFound is returned if the resource does not exist.
+func (c *WebhookClient) Get(ctx context.Context, url string) (gitprovider.Webhook, error) {
+	hook, err := c.get(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook %q: %w", url, err)
+	}
+	return newWebhook(c, hook), nil
+}
+
+func (c *WebhookClient) get(ctx context.Context, url string) (*Webhook, error) {
+	hooks, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Loop through the webhooks until we find one with the right URL
+	for _, hook := range hooks {
+		if hook.URL == url {
+			return hook, nil
+		}
+	}
+	return nil, gitprovider.ErrNotFound
+}
+
+// List lists all repository webhooks.
+// List returns all available repository webhooks,
+// using multiple paginated requests if needed.
+func (c *WebhookClient) List(ctx context.Context) ([]gitprovider.Webhook, error) {
+	apiObjs, err := c.list(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	// Cast to the generic []gitprovider.Webhook
+	hooks := make([]gitprovider.Webhook, 0, len(apiObjs))
+	for _, apiObj := range apiObjs {
+		hooks = append(hooks, newWebhook(c, apiObj))
+	}
+	return hooks, nil
+}
+
+func (c *WebhookClient) list(ctx context.Context) ([]*Webhook, error) {
+	projectKey, repoSlug := c.repositoryKeys()
+	apiObjs, err := c.client.Webhooks.All(ctx, projectKey, repoSlug)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
+		return nil, err
+	}
+
+	var errs error
+	for _, apiObj := range apiObjs {
+		if err := validateWebhookAPI(apiObj); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+	return apiObjs, nil
+}
+
+// Create creates a webhook with the given specifications.
+//
+// ErrAlreadyExists will be returned if a webhook for the same URL already exists.
+func (c *WebhookClient) Create(ctx context.Context, req gitprovider.WebhookInfo) (gitprovider.Webhook, error) {
+	// Webhooks are identified by their URL, hence don't allow duplicates
+	if _, err := c.get(ctx, req.URL); err == nil {
+		return nil, fmt.Errorf("webhook for %q: %w", req.URL, gitprovider.ErrAlreadyExists)
+	} else if !errors.Is(err, gitprovider.ErrNotFound) {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	apiObj, err := createWebhook(ctx, c, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return newWebhook(c, apiObj), nil
+}
+
+func createWebhook(ctx context.Context, c *WebhookClient, req gitprovider.WebhookInfo) (*Webhook, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, err
+	}
+
+	apiObj := &Webhook{}
+	if err := webhookInfoToAPIObj(&req, apiObj); err != nil {
+		return nil, err
+	}
+
+	projectKey, repoSlug := c.repositoryKeys()
+	return c.client.Webhooks.Create(ctx, projectKey, repoSlug, apiObj)
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *WebhookClient) Reconcile(ctx context.Context, req gitprovider.WebhookInfo) (gitprovider.Webhook, bool, error) {
+	// First thing, validate and default the request to ensure a valid and fully-populated object
+	// (to minimize any possible diffs between desired and actual state)
+	if err := gitprovider.ValidateAndDefaultInfo(&req); err != nil {
+		return nil, false, err
+	}
+
+	// Get the webhook with the desired URL
+	actual, err := c.Get(ctx, req.URL)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			resp, err := c.Create(ctx, req)
+			return resp, true, err
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return nil, false, fmt.Errorf("failed to reconcile webhook %q: %w", req.URL, err)
+	}
+
+	// If the desired matches the actual state, just return the actual state
+	if req.Equals(actual.Get()) {
+		return actual, false, nil
+	}
+
+	// Populate the desired state to the current-actual object
+	if err := actual.Set(req); err != nil {
+		return actual, false, err
+	}
+	// Apply the desired state by running Update
+	if err := actual.Update(ctx); err != nil {
+		return actual, false, fmt.Errorf("failed to update webhook %q: %w", req.URL, err)
+	}
+	return actual, true, nil
+}
+
+// update applies the given webhook to the server.
+// ErrNotFound is returned if the resource does not exist.
+func (c *WebhookClient) update(ctx context.Context, hook *Webhook) (*Webhook, error) {
+	projectKey, repoSlug := c.repositoryKeys()
+	apiObj, err := c.client.Webhooks.Update(ctx, projectKey, repoSlug, hook.ID, hook)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, gitprovider.ErrNotFound
+		}
+		return nil, err
+	}
+	return apiObj, nil
+}
+
+// delete removes the given webhook from the server.
+// ErrNotFound is returned if the resource does not exist.
+func (c *WebhookClient) delete(ctx context.Context, hook *Webhook) error {
+	projectKey, repoSlug := c.repositoryKeys()
+	if err := c.client.Webhooks.Delete(ctx, projectKey, repoSlug, hook.ID); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete webhook %q: %w", hook.URL, err)
+	}
+	return nil
+}
+
+// repositoryKeys returns the project key and repository slug, using the
+// user's personal project for user repositories.
+func (c *WebhookClient) repositoryKeys() (string, string) {
+	projectKey, repoSlug := getStashRefs(c.ref)
+	if r, ok := c.ref.(gitprovider.UserRepositoryRef); ok {
+		projectKey = addTilde(r.UserLogin)
+	}
+	return projectKey, repoSlug
+}
@@ -25,8 +25,10 @@ import (
 )
 
 const (
-	groupsURI       = "admin/groups"
-	groupMembersURI = "admin/groups/more-members"
+	groupsURI          = "admin/groups"
+	groupMembersURI    = "admin/groups/more-members"
+	groupAddUsersURI   = "admin/groups/add-users"
+	groupRemoveUserURI = "admin/groups/remove-user"
 )
 
 // Groups interface defines the methods that can be used to
@@ -36,6 +38,10 @@ type Groups interface {
 	Get(ctx context.Context, groupName string) (*Group, error)
 	ListGroupMembers(ctx context.Context, groupName string, opts *PagingOptions) (*GroupMembers, error)
 	AllGroupMembers(ctx context.Context, groupName string) ([]*User, error)
+	Create(ctx context.Context, groupName string) (*Group, error)
+	Delete(ctx context.Context, groupName string) error
+	AddUsers(ctx context.Context, groupName string, users ...string) error
+	RemoveUser(ctx context.Context, groupName, user string) error
 }
 
 // GroupsService is a client for communicating with stash groups endpoint
@@ -208,3 +214,129 @@ func (s *GroupsService) AllGroupMembers(ctx context.Context, groupName string) (
 
 	return p, nil
 }
+
+// Create creates a stash group with the given name.
+// Create uses the endpoint "POST /rest/api/1.0/admin/groups?name".
+// The authenticated user must have the ADMIN permission to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *GroupsService) Create(ctx context.Context, groupName string) (*Group, error) {
+	query := url.Values{
+		"name": []string{groupName},
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(groupsURI), WithQuery(query))
+	if err != nil {
+		return nil, fmt.Errorf("create group request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusConflict {
+			return nil, ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("create group failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusBadRequest {
+		return nil, fmt.Errorf("create group failed: %s", resp.Status)
+	}
+
+	g := &Group{}
+	if err := json.Unmarshal(res, g); err != nil {
+		return nil, fmt.Errorf("create group failed, unable to unmarshal group json: %w", err)
+	}
+
+	g.Session.set(resp)
+	return g, nil
+}
+
+// Delete deletes the stash group with the given name.
+// Delete uses the endpoint "DELETE /rest/api/1.0/admin/groups?name".
+// The authenticated user must have the ADMIN permission to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *GroupsService) Delete(ctx context.Context, groupName string) error {
+	query := url.Values{
+		"name": []string{groupName},
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodDelete, newURI(groupsURI), WithQuery(query))
+	if err != nil {
+		return fmt.Errorf("delete group request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("delete group failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// AddUsers adds the users with the given slugs to the stash group with the given name.
+// Adding a user that already is a member of the group is a no-op.
+// AddUsers uses the endpoint "POST /rest/api/1.0/admin/groups/add-users".
+// The authenticated user must have the ADMIN permission to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *GroupsService) AddUsers(ctx context.Context, groupName string, users ...string) error {
+	members := struct {
+		Group string   `json:"group"`
+		Users []string `json:"users"`
+	}{
+		Group: groupName,
+		Users: users,
+	}
+	body, err := marshallBody(members)
+	header := http.Header{"Content-Type": []string{"application/json"}}
+
+	if err != nil {
+		return fmt.Errorf("failed to marshall group members: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(groupAddUsersURI), WithBody(body), WithHeader(header))
+	if err != nil {
+		return fmt.Errorf("add group members request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("add group members failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// RemoveUser removes the user with the given slug from the stash group with the given name.
+// RemoveUser uses the endpoint "POST /rest/api/1.0/admin/groups/remove-user".
+// The authenticated user must have the ADMIN permission to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *GroupsService) RemoveUser(ctx context.Context, groupName, user string) error {
+	member := struct {
+		Context  string `json:"context"`
+		ItemName string `json:"itemName"`
+	}{
+		Context:  groupName,
+		ItemName: user,
+	}
+	body, err := marshallBody(member)
+	header := http.Header{"Content-Type": []string{"application/json"}}
+
+	if err != nil {
+		return fmt.Errorf("failed to marshall group member: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(groupRemoveUserURI), WithBody(body), WithHeader(header))
+	if err != nil {
+		return fmt.Errorf("remove group member request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("remove group member failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -153,3 +153,122 @@ func TestListGroupMembers(t *testing.T) {
 		t.Errorf("Groups.ListGroupMembers returned diff (want -> got):\n%s", diff)
 	}
 }
+
+func TestCreateGroup(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s", stashURIprefix, groupsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Groups.Create used method %s, want %s", r.Method, http.MethodPost)
+		}
+		if r.URL.Query().Get("name") == "avengers" {
+			http.Error(w, "A group with this name already exists", http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&Group{Name: r.URL.Query().Get("name"), Deleteable: true})
+	})
+
+	ctx := context.Background()
+	group, err := client.Groups.Create(ctx, "x-men")
+	if err != nil {
+		t.Fatalf("Groups.Create returned error: %v", err)
+	}
+	if group.Name != "x-men" {
+		t.Errorf("Groups.Create returned group %s, want %s", group.Name, "x-men")
+	}
+	if _, err := client.Groups.Create(ctx, "avengers"); err != ErrAlreadyExists {
+		t.Fatalf("Groups.Create returned error: %v, want %v", err, ErrAlreadyExists)
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s", stashURIprefix, groupsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Groups.Delete used method %s, want %s", r.Method, http.MethodDelete)
+		}
+		if r.URL.Query().Get("name") != "x-men" {
+			http.Error(w, "The specified group does not exist", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&Group{Name: "x-men"})
+	})
+
+	ctx := context.Background()
+	if err := client.Groups.Delete(ctx, "x-men"); err != nil {
+		t.Fatalf("Groups.Delete returned error: %v", err)
+	}
+	if err := client.Groups.Delete(ctx, "avengers"); err != ErrNotFound {
+		t.Fatalf("Groups.Delete returned error: %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestAddGroupUsers(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s", stashURIprefix, groupAddUsersURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Groups.AddUsers used method %s, want %s", r.Method, http.MethodPost)
+		}
+		members := struct {
+			Group string   `json:"group"`
+			Users []string `json:"users"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&members); err != nil {
+			t.Fatalf("Groups.AddUsers sent invalid body: %v", err)
+		}
+		if members.Group != "x-men" {
+			http.Error(w, "The specified group does not exist", http.StatusNotFound)
+			return
+		}
+		if diff := cmp.Diff([]string{"wolverine", "storm"}, members.Users); diff != "" {
+			t.Errorf("Groups.AddUsers sent users mismatch (-want +got):\n%s", diff)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if err := client.Groups.AddUsers(ctx, "x-men", "wolverine", "storm"); err != nil {
+		t.Fatalf("Groups.AddUsers returned error: %v", err)
+	}
+	if err := client.Groups.AddUsers(ctx, "avengers", "wolverine"); err != ErrNotFound {
+		t.Fatalf("Groups.AddUsers returned error: %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestRemoveGroupUser(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s", stashURIprefix, groupRemoveUserURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Groups.RemoveUser used method %s, want %s", r.Method, http.MethodPost)
+		}
+		member := struct {
+			Context  string `json:"context"`
+			ItemName string `json:"itemName"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&member); err != nil {
+			t.Fatalf("Groups.RemoveUser sent invalid body: %v", err)
+		}
+		if member.Context != "x-men" || member.ItemName != "wolverine" {
+			http.Error(w, "The specified group or user does not exist", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := context.Background()
+	if err := client.Groups.RemoveUser(ctx, "x-men", "wolverine"); err != nil {
+		t.Fatalf("Groups.RemoveUser returned error: %v", err)
+	}
+	if err := client.Groups.RemoveUser(ctx, "x-men", "magneto"); err != ErrNotFound {
+		t.Fatalf("Groups.RemoveUser returned error: %v, want %v", err, ErrNotFound)
+	}
+}
@@ -0,0 +1,51 @@
+//go:build e2e
+
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"os"
+	"testing"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/gitprovider/conformance"
+)
+
+func TestConformance(t *testing.T) {
+	user := os.Getenv("STASH_USER")
+	token := os.Getenv("STASH_TOKEN")
+	domain := os.Getenv("STASH_DOMAIN")
+	org := os.Getenv("GIT_PROVIDER_ORGANIZATION")
+	if user == "" || token == "" || domain == "" || org == "" {
+		t.Skip("STASH_USER, STASH_TOKEN, STASH_DOMAIN and GIT_PROVIDER_ORGANIZATION must be set")
+	}
+
+	conformance.Run(t, conformance.Config{
+		NewClient: func(destructive bool) (gitprovider.Client, error) {
+			return NewStashClient(user, token,
+				gitprovider.WithDomain(domain),
+				gitprovider.WithDestructiveAPICalls(destructive),
+			)
+		},
+		Organization: gitprovider.OrganizationRef{Domain: domain, Organization: org},
+		Team:         os.Getenv("STASH_TEST_TEAM_NAME"),
+		Skip: map[string]string{
+			"Commits/DeleteFile": "a nil CommitFile.Content isn't treated as a delete",
+		},
+	})
+}
@@ -266,7 +266,7 @@ var _ = Describe("Stash Provider", func() {
 
 		// Merge PR
 		id := pr.APIObject().(*PullRequest).ID
-		err = userRepo.PullRequests().Merge(ctx, id, "merge", "merged")
+		_, err = userRepo.PullRequests().Merge(ctx, id, "merge", "merged")
 		Expect(err).ToNot(HaveOccurred())
 	})
 })
@@ -40,6 +40,9 @@ type Projects interface {
 	ListProjectGroupsPermission(ctx context.Context, projectKey string, opts *PagingOptions) (*ProjectGroups, error)
 	AllGroupsPermission(ctx context.Context, projectKey string) ([]*ProjectGroupPermission, error)
 	ListProjectUsersPermission(ctx context.Context, projectKey string, opts *PagingOptions) (*ProjectUsers, error)
+	Create(ctx context.Context, project *Project) (*Project, error)
+	Update(ctx context.Context, projectKey string, project *Project) (*Project, error)
+	Delete(ctx context.Context, projectKey string) error
 }
 
 // ProjectsService is a client for communicating with stash projects endpoint
@@ -373,3 +376,110 @@ func (s *ProjectsService) ListProjectUsersPermission(ctx context.Context, projec
 
 	return up, nil
 }
+
+// projectRequest is the body of requests creating or updating a project.
+// Unlike Project, it always contains the public flag, allowing to make a project private.
+type projectRequest struct {
+	Key         string `json:"key,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description"`
+	Public      bool   `json:"public"`
+}
+
+func newProjectRequest(project *Project) projectRequest {
+	return projectRequest{
+		Key:         project.Key,
+		Name:        project.Name,
+		Description: project.Description,
+		Public:      project.Public,
+	}
+}
+
+// Create creates a project with the key, name, description and public flag of the given project.
+// Create uses the endpoint "POST /rest/api/1.0/projects".
+// The authenticated user must have PROJECT_CREATE permission to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *ProjectsService) Create(ctx context.Context, project *Project) (*Project, error) {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(newProjectRequest(project))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall project: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(projectsURI), WithBody(body), WithHeader(header))
+	if err != nil {
+		return nil, fmt.Errorf("create project request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusConflict {
+			return nil, ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("create project failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("create project failed: %s", resp.Status)
+	}
+
+	p := &Project{}
+	if err := json.Unmarshal(res, p); err != nil {
+		return nil, fmt.Errorf("create project failed, unable to unmarshall project json: %w", err)
+	}
+
+	p.Session.set(resp)
+	return p, nil
+}
+
+// Update updates the name, description and public flag of the project with the given key.
+// Update uses the endpoint "PUT /rest/api/1.0/projects/{projectKey}".
+// The authenticated user must have PROJECT_ADMIN permission for the specified project to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *ProjectsService) Update(ctx context.Context, projectKey string, project *Project) (*Project, error) {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(newProjectRequest(project))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall project: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPut, newURI(projectsURI, projectKey), WithBody(body), WithHeader(header))
+	if err != nil {
+		return nil, fmt.Errorf("update project request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("update project failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	p := &Project{}
+	if err := json.Unmarshal(res, p); err != nil {
+		return nil, fmt.Errorf("update project failed, unable to unmarshall project json: %w", err)
+	}
+
+	p.Session.set(resp)
+	return p, nil
+}
+
+// Delete deletes the project with the given key. Projects that still contain repositories
+// can't be deleted.
+// Delete uses the endpoint "DELETE /rest/api/1.0/projects/{projectKey}".
+// The authenticated user must have PROJECT_ADMIN permission for the specified project to call this resource.
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *ProjectsService) Delete(ctx context.Context, projectKey string) error {
+	req, err := s.Client.NewRequest(ctx, http.MethodDelete, newURI(projectsURI, projectKey))
+	if err != nil {
+		return fmt.Errorf("delete project request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("delete project failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -227,3 +227,91 @@ func TestListProjectUsersPermission(t *testing.T) {
 	}
 
 }
+
+func TestCreateProject(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s", stashURIprefix, projectsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Projects.Create used method %s, want %s", r.Method, http.MethodPost)
+		}
+		p := &Project{}
+		if err := json.NewDecoder(r.Body).Decode(p); err != nil {
+			t.Fatalf("Projects.Create sent invalid body: %v", err)
+		}
+		if p.Key == "PRJ" {
+			http.Error(w, "Project key is already in use", http.StatusConflict)
+			return
+		}
+		p.ID = 1
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(p)
+	})
+
+	ctx := context.Background()
+	want := &Project{Key: "FLUX", Name: "flux", Description: "Flux", Public: true}
+	p, err := client.Projects.Create(ctx, want)
+	if err != nil {
+		t.Fatalf("Projects.Create returned error: %v", err)
+	}
+	if p.ID != 1 || p.Key != want.Key || p.Name != want.Name || p.Description != want.Description || !p.Public {
+		t.Errorf("Projects.Create returned project %+v, want %+v", p, want)
+	}
+	if _, err := client.Projects.Create(ctx, &Project{Key: "PRJ", Name: "prj"}); err != ErrAlreadyExists {
+		t.Fatalf("Projects.Create returned error: %v, want %v", err, ErrAlreadyExists)
+	}
+}
+
+func TestUpdateProject(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/FLUX", stashURIprefix, projectsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Projects.Update used method %s, want %s", r.Method, http.MethodPut)
+		}
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Projects.Update sent invalid body: %v", err)
+		}
+		// The public flag must be sent, even if false
+		if public, ok := body["public"]; !ok || public != false {
+			t.Errorf("Projects.Update sent public %v, want false", public)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&Project{Key: "FLUX", Name: body["name"].(string)})
+	})
+
+	ctx := context.Background()
+	p, err := client.Projects.Update(ctx, "FLUX", &Project{Name: "flux"})
+	if err != nil {
+		t.Fatalf("Projects.Update returned error: %v", err)
+	}
+	if p.Name != "flux" || p.Public {
+		t.Errorf("Projects.Update returned project %+v", p)
+	}
+	if _, err := client.Projects.Update(ctx, "MISSING", &Project{Name: "missing"}); err != ErrNotFound {
+		t.Fatalf("Projects.Update returned error: %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestDeleteProject(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/FLUX", stashURIprefix, projectsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Projects.Delete used method %s, want %s", r.Method, http.MethodDelete)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if err := client.Projects.Delete(ctx, "FLUX"); err != nil {
+		t.Fatalf("Projects.Delete returned error: %v", err)
+	}
+	if err := client.Projects.Delete(ctx, "MISSING"); err != ErrNotFound {
+		t.Fatalf("Projects.Delete returned error: %v, want %v", err, ErrNotFound)
+	}
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	commentsURI   = "comments"
+	activitiesURI = "activities"
+
+	// activityActionCommented is the action of the activities of pull request comments
+	activityActionCommented = "COMMENTED"
+	// commentActionAdded is the comment action of the activities of new comments
+	commentActionAdded = "ADDED"
+)
+
+// PullRequestComments interface defines the methods that can be used to
+// manage the comments of a pull request.
+type PullRequestComments interface {
+	ListActivities(ctx context.Context, projectKey, repositorySlug string, prID int, opts *PagingOptions) (*ActivityList, error)
+	All(ctx context.Context, projectKey, repositorySlug string, prID int) ([]*Comment, error)
+	Get(ctx context.Context, projectKey, repositorySlug string, prID int, commentID int64) (*Comment, error)
+	Create(ctx context.Context, projectKey, repositorySlug string, prID int, text string) (*Comment, error)
+	Update(ctx context.Context, projectKey, repositorySlug string, prID int, comment *Comment) (*Comment, error)
+	Delete(ctx context.Context, projectKey, repositorySlug string, prID int, commentID int64, version int) error
+}
+
+// PullRequestCommentsService is a client for communicating with stash pull request comments endpoint
+// bitbucket-server API docs: https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+type PullRequestCommentsService service
+
+// Comment is a comment of a pull request
+type Comment struct {
+	// Session is the session of the comment
+	Session `json:"sessionInfo,omitempty"`
+	// ID is the id of the comment
+	ID int64 `json:"id,omitempty"`
+	// Version is the version of the comment, required to update or delete it
+	Version int `json:"version"`
+	// Text is the text of the comment
+	Text string `json:"text"`
+	// Author is the author of the comment
+	Author *User `json:"author,omitempty"`
+	// CreatedDate is the creation date of the comment
+	CreatedDate int64 `json:"createdDate,omitempty"`
+	// UpdatedDate is the update date of the comment
+	UpdatedDate int64 `json:"updatedDate,omitempty"`
+}
+
+// Activity is an event in the history of a pull request, e.g. a comment or an approval
+type Activity struct {
+	// ID is the id of the activity
+	ID int64 `json:"id,omitempty"`
+	// CreatedDate is the creation date of the activity
+	CreatedDate int64 `json:"createdDate,omitempty"`
+	// User is the user that caused the activity
+	User *User `json:"user,omitempty"`
+	// Action is the kind of the activity, e.g. COMMENTED, APPROVED or REVIEWED
+	Action string `json:"action,omitempty"`
+	// CommentAction is the kind of COMMENTED activities, e.g. ADDED or EDITED
+	CommentAction string `json:"commentAction,omitempty"`
+	// Comment is the comment of COMMENTED activities
+	Comment *Comment `json:"comment,omitempty"`
+	// CommentAnchor is the position in the diff of comments on the diff, nil for general comments
+	CommentAnchor *json.RawMessage `json:"commentAnchor,omitempty"`
+}
+
+// ActivityList is a list of pull request activities
+type ActivityList struct {
+	// Paging is the paging information
+	Paging
+	// Activities are the activities, newest first
+	Activities []*Activity `json:"values,omitempty"`
+}
+
+// GetActivities returns a list of activities
+func (a *ActivityList) GetActivities() []*Activity {
+	return a.Activities
+}
+
+func newPullRequestURI(projectKey, repositorySlug string, prID int, elements ...string) string {
+	return newURI(append([]string{projectsURI, projectKey, RepositoriesURI, repositorySlug, pullRequestsURI, strconv.Itoa(prID)}, elements...)...)
+}
+
+// ListActivities returns the list of activities of the pull request, newest first.
+// Paging is optional and is enabled by providing a PagingOptions struct.
+// A pointer to a ActivityList struct is returned to retrieve the next page of results.
+// ListActivities uses the endpoint "GET /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}/activities".
+// https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
+func (s *PullRequestCommentsService) ListActivities(ctx context.Context, projectKey, repositorySlug string, prID int, opts *PagingOptions) (*ActivityList, error) {
+	query := addPaging(url.Values{}, opts)
+	req, err := s.Client.NewRequest(ctx, http.MethodGet, newPullRequestURI(projectKey, repositorySlug, prID, activitiesURI), WithQuery(query))
+	if err != nil {
+		return nil, fmt.Errorf("list pull request activities request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("list pull request activities failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	a := &ActivityList{}
+	if err := json.Unmarshal(res, a); err != nil {
+		return nil, fmt.Errorf("list pull request activities failed, unable to unmarshal activity list json: %w", err)
+	}
+
+	for _, activity := range a.GetActivities() {
+		if activity.Comment != nil {
+			activity.Comment.Session.set(resp)
+		}
+	}
+
+	return a, nil
+}
+
+// All retrieves the general comments of the pull request, oldest first.
+// Comments on the diff and replies to comments aren't included.
+// This function handles pagination, HTTP error wrapping, and validates the server result.
+func (s *PullRequestCommentsService) All(ctx context.Context, projectKey, repositorySlug string, prID int) ([]*Comment, error) {
+	comments := []*Comment{}
+	opts := &PagingOptions{Limit: perPageLimit}
+	err := allPages(opts, func() (*Paging, error) {
+		list, err := s.ListActivities(ctx, projectKey, repositorySlug, prID, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, activity := range list.GetActivities() {
+			if activity.Action == activityActionCommented && activity.CommentAction == commentActionAdded &&
+				activity.CommentAnchor == nil && activity.Comment != nil {
+				comments = append(comments, activity.Comment)
+			}
+		}
+		return &list.Paging, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Activities are returned newest first
+	for i, j := 0, len(comments)-1; i < j; i, j = i+1, j-1 {
+		comments[i], comments[j] = comments[j], comments[i]
+	}
+	return comments, nil
+}
+
+// Get retrieves a comment of the pull request given it's ID.
+// Get uses the endpoint "GET /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}/comments/{commentId}".
+func (s *PullRequestCommentsService) Get(ctx context.Context, projectKey, repositorySlug string, prID int, commentID int64) (*Comment, error) {
+	req, err := s.Client.NewRequest(ctx, http.MethodGet, newPullRequestURI(projectKey, repositorySlug, prID, commentsURI, strconv.FormatInt(commentID, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("get pull request comment request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get pull request comment failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	c := &Comment{}
+	if err := json.Unmarshal(res, c); err != nil {
+		return nil, fmt.Errorf("get pull request comment failed, unable to unmarshal comment json: %w", err)
+	}
+
+	c.Session.set(resp)
+
+	return c, nil
+}
+
+// Create adds a general comment with the given text to the pull request.
+// Create uses the endpoint "POST /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}/comments".
+func (s *PullRequestCommentsService) Create(ctx context.Context, projectKey, repositorySlug string, prID int, text string) (*Comment, error) {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(&Comment{Text: text})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall comment: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newPullRequestURI(projectKey, repositorySlug, prID, commentsURI), WithBody(body), WithHeader(header))
+	if err != nil {
+		return nil, fmt.Errorf("create pull request comment request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("create pull request comment failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	c := &Comment{}
+	if err := json.Unmarshal(res, c); err != nil {
+		return nil, fmt.Errorf("create pull request comment failed, unable to unmarshal comment json: %w", err)
+	}
+
+	c.Session.set(resp)
+
+	return c, nil
+}
+
+// Update changes the text of the comment with the given ID and version.
+// Update uses the endpoint "PUT /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}/comments/{commentId}".
+func (s *PullRequestCommentsService) Update(ctx context.Context, projectKey, repositorySlug string, prID int, comment *Comment) (*Comment, error) {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(&Comment{Text: comment.Text, Version: comment.Version})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall comment: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPut, newPullRequestURI(projectKey, repositorySlug, prID, commentsURI, strconv.FormatInt(comment.ID, 10)), WithBody(body), WithHeader(header))
+	if err != nil {
+		return nil, fmt.Errorf("update pull request comment request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("update pull request comment failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("update pull request comment failed with status code %d, error: %s", resp.StatusCode, res)
+	}
+
+	c := &Comment{}
+	if err := json.Unmarshal(res, c); err != nil {
+		return nil, fmt.Errorf("update pull request comment failed, unable to unmarshal comment json: %w", err)
+	}
+
+	c.Session.set(resp)
+
+	return c, nil
+}
+
+// Delete deletes the comment with the given ID and version.
+// Delete uses the endpoint "DELETE /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}/comments/{commentId}?version".
+func (s *PullRequestCommentsService) Delete(ctx context.Context, projectKey, repositorySlug string, prID int, commentID int64, version int) error {
+	query := url.Values{
+		"version": []string{strconv.Itoa(version)},
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodDelete, newPullRequestURI(projectKey, repositorySlug, prID, commentsURI, strconv.FormatInt(commentID, 10)), WithQuery(query))
+	if err != nil {
+		return fmt.Errorf("delete pull request comment request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("delete pull request comment failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	if resp != nil && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("delete pull request comment failed with status code %d, error: %s", resp.StatusCode, res)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAllPRComments(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj/%s/my-repo/%s/1/%s", stashURIprefix, projectsURI, RepositoriesURI, pullRequestsURI, activitiesURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		anchor := json.RawMessage(`{"path":"README.md","line":1}`)
+		// Activities are returned newest first
+		json.NewEncoder(w).Encode(&ActivityList{
+			Paging: Paging{IsLastPage: true},
+			Activities: []*Activity{
+				{ID: 5, Action: activityActionCommented, CommentAction: commentActionAdded, Comment: &Comment{ID: 3, Text: "second"}},
+				{ID: 4, Action: "APPROVED"},
+				{ID: 3, Action: activityActionCommented, CommentAction: commentActionAdded, Comment: &Comment{ID: 2, Text: "on the diff"}, CommentAnchor: &anchor},
+				{ID: 2, Action: activityActionCommented, CommentAction: "EDITED", Comment: &Comment{ID: 1, Text: "first"}},
+				{ID: 1, Action: activityActionCommented, CommentAction: commentActionAdded, Comment: &Comment{ID: 1, Text: "first"}},
+			},
+		})
+	})
+
+	comments, err := client.PullRequestComments.All(context.Background(), "prj", "my-repo", 1)
+	if err != nil {
+		t.Fatalf("PullRequestComments.All returned error: %v", err)
+	}
+	if len(comments) != 2 || comments[0].Text != "first" || comments[1].Text != "second" {
+		t.Errorf("PullRequestComments.All returned %+v, want the general comments oldest first", comments)
+	}
+}
+
+func TestUpdateAndDeletePRComment(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj/%s/my-repo/%s/1/%s/3", stashURIprefix, projectsURI, RepositoriesURI, pullRequestsURI, commentsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			c := &Comment{}
+			if err := json.NewDecoder(r.Body).Decode(c); err != nil || c.Version != 0 {
+				http.Error(w, "The comment is out of date", http.StatusConflict)
+				return
+			}
+			json.NewEncoder(w).Encode(&Comment{ID: 3, Version: 1, Text: c.Text})
+		case http.MethodDelete:
+			if r.URL.Query().Get("version") != "1" {
+				http.Error(w, "The comment is out of date", http.StatusConflict)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	ctx := context.Background()
+	c, err := client.PullRequestComments.Update(ctx, "prj", "my-repo", 1, &Comment{ID: 3, Text: "edited"})
+	if err != nil {
+		t.Fatalf("PullRequestComments.Update returned error: %v", err)
+	}
+	if c.Text != "edited" || c.Version != 1 {
+		t.Errorf("PullRequestComments.Update returned %+v, want the edited comment", c)
+	}
+	if err := client.PullRequestComments.Delete(ctx, "prj", "my-repo", 1, 3, 0); err == nil {
+		t.Error("PullRequestComments.Delete with an outdated version didn't return an error")
+	}
+	if err := client.PullRequestComments.Delete(ctx, "prj", "my-repo", 1, 3, c.Version); err != nil {
+		t.Errorf("PullRequestComments.Delete returned error: %v", err)
+	}
+}
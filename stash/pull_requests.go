@@ -19,6 +19,7 @@ package stash
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -28,18 +29,71 @@ import (
 const (
 	pullRequestsURI = "pull-requests"
 	mergeURI        = "merge"
+	declineURI      = "decline"
+	reopenURI       = "reopen"
+	participantsURI = "participants"
+	diffURI         = "diff"
+)
+
+const (
+	// PullRequestStateOpen is the state of an open pull request
+	PullRequestStateOpen = "OPEN"
+	// PullRequestStateDeclined is the state of a pull request closed without merging
+	PullRequestStateDeclined = "DECLINED"
+	// PullRequestStateMerged is the state of a merged pull request
+	PullRequestStateMerged = "MERGED"
+	// PullRequestStateAll is used to list pull requests in any state
+	PullRequestStateAll = "ALL"
+)
+
+const (
+	// ParticipantStatusApproved is the status of a participant that approved the pull request
+	ParticipantStatusApproved = "APPROVED"
+	// ParticipantStatusNeedsWork is the status of a participant that requested changes
+	ParticipantStatusNeedsWork = "NEEDS_WORK"
+	// ParticipantStatusUnapproved is the status of a participant that didn't review the pull request yet
+	ParticipantStatusUnapproved = "UNAPPROVED"
+)
+
+const (
+	// DiffSegmentTypeAdded is the type of diff segments with added lines
+	DiffSegmentTypeAdded = "ADDED"
+	// DiffSegmentTypeRemoved is the type of diff segments with removed lines
+	DiffSegmentTypeRemoved = "REMOVED"
+	// DiffSegmentTypeContext is the type of diff segments with unchanged lines
+	DiffSegmentTypeContext = "CONTEXT"
+)
+
+const (
+	// MergeStrategyNoFastForward always creates a merge commit
+	MergeStrategyNoFastForward = "no-ff"
+	// MergeStrategySquash squashes the changes into a single commit on the target branch
+	MergeStrategySquash = "squash"
+	// MergeStrategyRebaseNoFastForward rebases the source branch onto the target branch, and creates a merge commit
+	MergeStrategyRebaseNoFastForward = "rebase-no-ff"
+)
+
+var (
+	// ErrConflict is returned when merging a pull request fails as its version isn't the current one,
+	// or as it can't be merged, e.g. due to conflicts.
+	ErrConflict = errors.New("the pull request can't be merged in its current state")
 )
 
 // PullRequests interface defines the methods that can be used to
 // retrieve pull requests of a repository.
 type PullRequests interface {
 	Get(ctx context.Context, projectKey, repositorySlug string, prID int) (*PullRequest, error)
-	List(ctx context.Context, projectKey, repositorySlug string, opts *PagingOptions) (*PullRequestList, error)
-	All(ctx context.Context, projectKey, repositorySlug string) ([]*PullRequest, error)
+	List(ctx context.Context, projectKey, repositorySlug, state string, opts *PagingOptions) (*PullRequestList, error)
+	All(ctx context.Context, projectKey, repositorySlug, state string) ([]*PullRequest, error)
 	Create(ctx context.Context, projectKey, repositorySlug string, pr *CreatePullRequest) (*PullRequest, error)
 	Update(ctx context.Context, projectKey, repositorySlug string, pr *PullRequest) (*PullRequest, error)
-	Merge(ctx context.Context, projectKey, repositorySlug string, prID int, version int) (*PullRequest, error)
+	Merge(ctx context.Context, projectKey, repositorySlug string, prID int, version int, merge *PullRequestMerge) (*PullRequest, error)
+	Decline(ctx context.Context, projectKey, repositorySlug string, prID int, version int) (*PullRequest, error)
+	Reopen(ctx context.Context, projectKey, repositorySlug string, prID int, version int) (*PullRequest, error)
+	SetParticipantStatus(ctx context.Context, projectKey, repositorySlug string, prID int, userSlug, status string) (*Participant, error)
 	Delete(ctx context.Context, projectKey, repositorySlug string, IDVersion IDVersion) error
+	Diff(ctx context.Context, projectKey, repositorySlug string, prID int) (*Diff, error)
+	RawDiff(ctx context.Context, projectKey, repositorySlug string, prID int) (string, error)
 }
 
 // PullRequestsService is a client for communicating with stash pull requests endpoint
@@ -111,10 +165,14 @@ type PullRequest struct {
 	Author *Participant `json:"author,omitempty"`
 	// Closed indicates if the pull request is closed
 	Closed bool `json:"closed,omitempty"`
+	// ClosedDate is the date the pull request was merged or declined
+	ClosedDate int64 `json:"closedDate,omitempty"`
 	// CreatedDate is the creation date of the pull request
 	CreatedDate int64 `json:"createdDate,omitempty"`
 	// Description is the description of the pull request
 	Description string `json:"description,omitempty"`
+	// Draft indicates if the pull request is a draft, it's only supported since Bitbucket Server 8.18
+	Draft *bool `json:"draft,omitempty"`
 	// FromRef is the source branch or tag
 	FromRef Ref `json:"fromRef,omitempty"`
 	IDVersion
@@ -144,12 +202,31 @@ type PullRequest struct {
 type Properties struct {
 	// MergeResult is the merge result of the pull request
 	MergeResult MergeResult `json:"mergeResult,omitempty"`
+	// MergeCommit is the commit the pull request was merged with, it's only returned since Bitbucket Server 7.x
+	MergeCommit *MergeCommit `json:"mergeCommit,omitempty"`
 	// OpenTaskCount is the number of open tasks
 	OpenTaskCount float64 `json:"openTaskCount,omitempty"`
 	// ResolvedTaskCount is the number of resolved tasks
 	ResolvedTaskCount float64 `json:"resolvedTaskCount,omitempty"`
 }
 
+// MergeCommit is the commit a pull request was merged with
+type MergeCommit struct {
+	// DisplayID is the abbreviated commit SHA
+	DisplayID string `json:"displayId,omitempty"`
+	// ID is the commit SHA
+	ID string `json:"id,omitempty"`
+}
+
+// PullRequestMerge holds the optional parameters of a pull request merge
+type PullRequestMerge struct {
+	// Message is the message of the merge commit, a default one is generated if empty
+	Message string `json:"message,omitempty"`
+	// StrategyID is the merge strategy to use, e.g. MergeStrategySquash. The default strategy of the
+	// repository is used if empty.
+	StrategyID string `json:"strategyId,omitempty"`
+}
+
 // MergeResult is the merge result of a pull request
 type MergeResult struct {
 	// Current is the current merge result
@@ -158,6 +235,72 @@ type MergeResult struct {
 	Outcome string `json:"outcome,omitempty"`
 }
 
+// Diff is the diff of a pull request
+type Diff struct {
+	// FromHash is the commit the diff starts at
+	FromHash string `json:"fromHash,omitempty"`
+	// ToHash is the commit the diff ends at
+	ToHash string `json:"toHash,omitempty"`
+	// Diffs are the diffs of the changed files
+	Diffs []*FileDiff `json:"diffs,omitempty"`
+	// Truncated is true if the server didn't return all changes, as the diff exceeded its limits
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// FileDiff is the diff of a single file
+type FileDiff struct {
+	// Source is the path of the file before the change, nil if the file was added
+	Source *DiffPath `json:"source,omitempty"`
+	// Destination is the path of the file after the change, nil if the file was removed
+	Destination *DiffPath `json:"destination,omitempty"`
+	// Hunks are the changed parts of the file
+	Hunks []*DiffHunk `json:"hunks,omitempty"`
+	// Binary is true if the file is a binary file, which has no hunks
+	Binary bool `json:"binary,omitempty"`
+	// Truncated is true if the server didn't return all hunks of the file
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// DiffPath is the path of a file in a diff
+type DiffPath struct {
+	// ToString is the full path of the file
+	ToString string `json:"toString"`
+}
+
+// DiffHunk is a changed part of a file
+type DiffHunk struct {
+	// SourceLine is the first line of the hunk in the source file
+	SourceLine int `json:"sourceLine"`
+	// SourceSpan is the number of lines of the hunk in the source file
+	SourceSpan int `json:"sourceSpan"`
+	// DestinationLine is the first line of the hunk in the destination file
+	DestinationLine int `json:"destinationLine"`
+	// DestinationSpan is the number of lines of the hunk in the destination file
+	DestinationSpan int `json:"destinationSpan"`
+	// Segments are the consecutive lines of the hunk with the same type
+	Segments []*DiffSegment `json:"segments,omitempty"`
+	// Truncated is true if the server didn't return all lines of the hunk
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// DiffSegment is a set of consecutive lines of a hunk with the same type, i.e. ADDED, REMOVED or CONTEXT
+type DiffSegment struct {
+	// Type is the type of the lines
+	Type string `json:"type"`
+	// Lines are the lines of the segment
+	Lines []*DiffLine `json:"lines,omitempty"`
+	// Truncated is true if the server didn't return all lines of the segment
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// DiffLine is a line of a diff segment
+type DiffLine struct {
+	// Line is the content of the line
+	Line string `json:"line"`
+	// Truncated is true if the server didn't return the whole line
+	Truncated bool `json:"truncated,omitempty"`
+}
+
 // PullRequestList is a list of pull requests
 type PullRequestList struct {
 	// Paging is the paging information
@@ -172,12 +315,16 @@ func (p *PullRequestList) GetPullRequests() []*PullRequest {
 }
 
 // List returns the list of pull requests.
+// Only the open pull requests are returned, unless another state is given, e.g. PullRequestStateAll.
 // Paging is optional and is enabled by providing a PagingOptions struct.
 // A pointer to a PullRequestsList struct is returned to retrieve the next page of results.
 // List uses the endpoint "GET /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests".
 // https://docs.atlassian.com/bitbucket-server/rest/5.16.0/bitbucket-rest.html
-func (s *PullRequestsService) List(ctx context.Context, projectKey, repositorySlug string, opts *PagingOptions) (*PullRequestList, error) {
+func (s *PullRequestsService) List(ctx context.Context, projectKey, repositorySlug, state string, opts *PagingOptions) (*PullRequestList, error) {
 	query := addPaging(url.Values{}, opts)
+	if state != "" {
+		query.Add("state", state)
+	}
 	req, err := s.Client.NewRequest(ctx, http.MethodGet, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, pullRequestsURI), WithQuery(query))
 	if err != nil {
 		return nil, fmt.Errorf("list pull requests request creation failed: %w", err)
@@ -203,13 +350,13 @@ func (s *PullRequestsService) List(ctx context.Context, projectKey, repositorySl
 	return p, nil
 }
 
-// All retrieves all pull requests for a given repository.
+// All retrieves all pull requests in the given state for a given repository.
 // This function handles pagination, HTTP error wrapping, and validates the server result.
-func (s *PullRequestsService) All(ctx context.Context, projectKey, repositorySlug string) ([]*PullRequest, error) {
+func (s *PullRequestsService) All(ctx context.Context, projectKey, repositorySlug, state string) ([]*PullRequest, error) {
 	pr := []*PullRequest{}
 	opts := &PagingOptions{Limit: perPageLimit}
 	err := allPages(opts, func() (*Paging, error) {
-		list, err := s.List(ctx, projectKey, repositorySlug, opts)
+		list, err := s.List(ctx, projectKey, repositorySlug, state, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -312,20 +459,33 @@ func (s *PullRequestsService) Update(ctx context.Context, projectKey, repository
 	return p, nil
 }
 
-// Merge the pull request with the given ID and version.
+// Merge the pull request with the given ID and version, using the optional merge parameters.
+// ErrConflict is returned if the version isn't the current one, or if the pull request can't be merged.
 // Merge uses the endpoint "POST /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}/merge?version".
-func (s *PullRequestsService) Merge(ctx context.Context, projectKey, repositorySlug string, prID int, version int) (*PullRequest, error) {
+func (s *PullRequestsService) Merge(ctx context.Context, projectKey, repositorySlug string, prID int, version int, merge *PullRequestMerge) (*PullRequest, error) {
 	query := url.Values{
 		"version": []string{strconv.Itoa(version)},
 	}
 
 	header := http.Header{"X-Atlassian-Token": []string{"no-check"}}
+	opts := []RequestOptionFunc{WithQuery(query), WithHeader(header)}
+	if merge != nil {
+		body, err := marshallBody(merge)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshall pull request merge: %v", err)
+		}
+		header.Set("Content-Type", "application/json")
+		opts = append(opts, WithBody(body))
+	}
 
-	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, pullRequestsURI, strconv.Itoa(prID), mergeURI), WithQuery(query), WithHeader(header))
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, pullRequestsURI, strconv.Itoa(prID), mergeURI), opts...)
 	if err != nil {
 		return nil, fmt.Errorf("merge pull request request creation failed: %w", err)
 	}
 	res, resp, err := s.Client.Do(req)
+	if resp != nil && resp.StatusCode == http.StatusConflict {
+		return nil, fmt.Errorf("merge pull request failed: %s: %w", resp.Status, ErrConflict)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("merge pull request failed: %w", err)
 	}
@@ -335,7 +495,7 @@ func (s *PullRequestsService) Merge(ctx context.Context, projectKey, repositoryS
 	}
 
 	if resp != nil && resp.StatusCode == http.StatusBadRequest {
-		return nil, fmt.Errorf("list commits failed: %s", resp.Status)
+		return nil, fmt.Errorf("merge pull request failed: %s", resp.Status)
 	}
 
 	p := &PullRequest{}
@@ -348,6 +508,86 @@ func (s *PullRequestsService) Merge(ctx context.Context, projectKey, repositoryS
 	return p, nil
 }
 
+// Decline closes the pull request with the given ID and version without merging it.
+// Decline uses the endpoint "POST /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}/decline?version".
+func (s *PullRequestsService) Decline(ctx context.Context, projectKey, repositorySlug string, prID int, version int) (*PullRequest, error) {
+	return s.transition(ctx, projectKey, repositorySlug, prID, version, declineURI)
+}
+
+// Reopen reopens the declined pull request with the given ID and version.
+// Reopen uses the endpoint "POST /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}/reopen?version".
+func (s *PullRequestsService) Reopen(ctx context.Context, projectKey, repositorySlug string, prID int, version int) (*PullRequest, error) {
+	return s.transition(ctx, projectKey, repositorySlug, prID, version, reopenURI)
+}
+
+// transition changes the state of the pull request with the given ID and version, using the given action endpoint.
+func (s *PullRequestsService) transition(ctx context.Context, projectKey, repositorySlug string, prID int, version int, action string) (*PullRequest, error) {
+	query := url.Values{
+		"version": []string{strconv.Itoa(version)},
+	}
+
+	header := http.Header{"X-Atlassian-Token": []string{"no-check"}}
+
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, pullRequestsURI, strconv.Itoa(prID), action), WithQuery(query), WithHeader(header))
+	if err != nil {
+		return nil, fmt.Errorf("%s pull request request creation failed: %w", action, err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s pull request failed: %w", action, err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if resp != nil && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s pull request failed with status code %d, error: %s", action, resp.StatusCode, res)
+	}
+
+	p := &PullRequest{}
+	if err := json.Unmarshal(res, p); err != nil {
+		return nil, fmt.Errorf("%s pull request failed, unable to unmarshal pull request json: %w", action, err)
+	}
+
+	p.Session.set(resp)
+
+	return p, nil
+}
+
+// SetParticipantStatus sets the review status of the user with the given slug, adding the user as a
+// participant of the pull request if needed. Only the authenticated user's own status can be set.
+// SetParticipantStatus uses the endpoint "PUT /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}/participants/{userSlug}".
+func (s *PullRequestsService) SetParticipantStatus(ctx context.Context, projectKey, repositorySlug string, prID int, userSlug, status string) (*Participant, error) {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(&Participant{Status: status})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall participant: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPut, newPullRequestURI(projectKey, repositorySlug, prID, participantsURI, url.PathEscape(userSlug)), WithBody(body), WithHeader(header))
+	if err != nil {
+		return nil, fmt.Errorf("set participant status request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("set participant status failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("set participant status failed with status code %d, error: %s", resp.StatusCode, res)
+	}
+
+	p := &Participant{}
+	if err := json.Unmarshal(res, p); err != nil {
+		return nil, fmt.Errorf("set participant status failed, unable to unmarshal participant json: %w", err)
+	}
+
+	return p, nil
+}
+
 // Delete deletes the pull request with the given ID
 // Delete uses the endpoint "DELETE /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}".
 // To call this resource, users must:
@@ -377,3 +617,52 @@ func (s *PullRequestsService) Delete(ctx context.Context, projectKey, repository
 
 	return nil
 }
+
+// Diff retrieves the changes of the pull request, with the default number of context lines.
+// Bitbucket Server limits the size of the diff, it's marked as truncated if it exceeds the limits.
+// Diff uses the endpoint "GET /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}/diff".
+func (s *PullRequestsService) Diff(ctx context.Context, projectKey, repositorySlug string, prID int) (*Diff, error) {
+	query := url.Values{}
+	query.Add("withComments", "false")
+	req, err := s.Client.NewRequest(ctx, http.MethodGet, newPullRequestURI(projectKey, repositorySlug, prID, diffURI), WithQuery(query))
+	if err != nil {
+		return nil, fmt.Errorf("get pull request diff request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get pull request diff failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	d := &Diff{}
+	if err := json.Unmarshal(res, d); err != nil {
+		return nil, fmt.Errorf("get pull request diff failed, unable to unmarshal diff json: %w", err)
+	}
+
+	return d, nil
+}
+
+// RawDiff retrieves the changes of the pull request as a unified diff.
+// RawDiff uses the endpoint "GET /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/pull-requests/{pullRequestId}.diff".
+func (s *PullRequestsService) RawDiff(ctx context.Context, projectKey, repositorySlug string, prID int) (string, error) {
+	req, err := s.Client.NewRequest(ctx, http.MethodGet, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, pullRequestsURI, strconv.Itoa(prID)+".diff"))
+	if err != nil {
+		return "", fmt.Errorf("get pull request raw diff request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("get pull request raw diff failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("get pull request raw diff failed with status code %d, error: %s", resp.StatusCode, res)
+	}
+
+	return string(res), nil
+}
@@ -19,6 +19,7 @@ package stash
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"path"
@@ -134,7 +135,7 @@ func TestListPRs(t *testing.T) {
 
 	})
 	ctx := context.Background()
-	list, err := client.PullRequests.List(ctx, "prj1", "repo1", nil)
+	list, err := client.PullRequests.List(ctx, "prj1", "repo1", "", nil)
 	if err != nil {
 		t.Fatalf("PullRequests.List returned error: %v", err)
 	}
@@ -490,3 +491,139 @@ func TestDeletePR(t *testing.T) {
 		})
 	}
 }
+
+func TestDeclineAndReopenPR(t *testing.T) {
+	mux, client := setup(t)
+
+	for _, action := range []string{declineURI, reopenURI} {
+		action := action
+		path := fmt.Sprintf("%s/%s/prj/%s/my-repo/%s/1/%s", stashURIprefix, projectsURI, RepositoriesURI, pullRequestsURI, action)
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Query().Get("version") != "2" {
+				http.Error(w, "The pull request is out of date", http.StatusConflict)
+				return
+			}
+			state := PullRequestStateOpen
+			if action == declineURI {
+				state = PullRequestStateDeclined
+			}
+			json.NewEncoder(w).Encode(&PullRequest{IDVersion: IDVersion{ID: 1, Version: 3}, State: state})
+		})
+	}
+
+	ctx := context.Background()
+	p, err := client.PullRequests.Decline(ctx, "prj", "my-repo", 1, 2)
+	if err != nil {
+		t.Fatalf("PullRequests.Decline returned error: %v", err)
+	}
+	if p.State != PullRequestStateDeclined || p.Version != 3 {
+		t.Errorf("PullRequests.Decline returned %+v, want a declined pull request", p)
+	}
+	p, err = client.PullRequests.Reopen(ctx, "prj", "my-repo", 1, 2)
+	if err != nil {
+		t.Fatalf("PullRequests.Reopen returned error: %v", err)
+	}
+	if p.State != PullRequestStateOpen {
+		t.Errorf("PullRequests.Reopen returned %+v, want an open pull request", p)
+	}
+	if _, err := client.PullRequests.Decline(ctx, "prj", "my-repo", 1, 1); err == nil {
+		t.Error("PullRequests.Decline with an outdated version didn't return an error")
+	}
+}
+
+func TestMergePR(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj/%s/my-repo/%s/1/%s", stashURIprefix, projectsURI, RepositoriesURI, pullRequestsURI, mergeURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Query().Get("version") != "2" {
+			http.Error(w, "The pull request is out of date", http.StatusConflict)
+			return
+		}
+		m := &PullRequestMerge{}
+		if err := json.NewDecoder(r.Body).Decode(m); err != nil || m.StrategyID != MergeStrategySquash || m.Message != "squashed" {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(&PullRequest{
+			IDVersion:  IDVersion{ID: 1, Version: 3},
+			State:      PullRequestStateMerged,
+			Properties: Properties{MergeCommit: &MergeCommit{ID: "abc"}},
+		})
+	})
+
+	ctx := context.Background()
+	p, err := client.PullRequests.Merge(ctx, "prj", "my-repo", 1, 2, &PullRequestMerge{Message: "squashed", StrategyID: MergeStrategySquash})
+	if err != nil {
+		t.Fatalf("PullRequests.Merge returned error: %v", err)
+	}
+	if p.State != PullRequestStateMerged || p.Properties.MergeCommit == nil || p.Properties.MergeCommit.ID != "abc" {
+		t.Errorf("PullRequests.Merge returned %+v, want a pull request merged with commit abc", p)
+	}
+	if _, err := client.PullRequests.Merge(ctx, "prj", "my-repo", 1, 1, nil); !errors.Is(err, ErrConflict) {
+		t.Errorf("PullRequests.Merge with an outdated version returned error %v, want %v", err, ErrConflict)
+	}
+}
+
+func TestSetParticipantStatus(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj/%s/my-repo/%s/1/%s/alice", stashURIprefix, projectsURI, RepositoriesURI, pullRequestsURI, participantsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		p := &Participant{}
+		if r.Method != http.MethodPut || json.NewDecoder(r.Body).Decode(p) != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(&Participant{
+			User:     User{Name: "alice", Slug: "alice"},
+			Role:     "REVIEWER",
+			Status:   p.Status,
+			Approved: p.Status == ParticipantStatusApproved,
+		})
+	})
+
+	p, err := client.PullRequests.SetParticipantStatus(context.Background(), "prj", "my-repo", 1, "alice", ParticipantStatusNeedsWork)
+	if err != nil {
+		t.Fatalf("PullRequests.SetParticipantStatus returned error: %v", err)
+	}
+	if p.Status != ParticipantStatusNeedsWork || p.Approved {
+		t.Errorf("PullRequests.SetParticipantStatus returned %+v, want a participant needing work", p)
+	}
+}
+
+func TestPullRequestDiff(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj/%s/my-repo/%s/1/%s", stashURIprefix, projectsURI, RepositoriesURI, pullRequestsURI, diffURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"fromHash":"abc","toHash":"def","truncated":true,"diffs":[{
+			"source":{"toString":"old.txt"},"destination":{"toString":"new.txt"},
+			"hunks":[{"sourceLine":1,"sourceSpan":1,"destinationLine":1,"destinationSpan":2,"segments":[
+				{"type":"CONTEXT","lines":[{"line":"a"}]},
+				{"type":"ADDED","lines":[{"line":"b"}]}]}]}]}`))
+	})
+	rawPath := fmt.Sprintf("%s/%s/prj/%s/my-repo/%s/1.diff", stashURIprefix, projectsURI, RepositoriesURI, pullRequestsURI)
+	mux.HandleFunc(rawPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("diff --git a/old.txt b/new.txt\n"))
+	})
+
+	ctx := context.Background()
+	d, err := client.PullRequests.Diff(ctx, "prj", "my-repo", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.Diff returned error: %v", err)
+	}
+	if !d.Truncated || len(d.Diffs) != 1 || d.Diffs[0].Destination.ToString != "new.txt" || len(d.Diffs[0].Hunks[0].Segments) != 2 {
+		t.Errorf("PullRequests.Diff returned %+v, want the truncated diff of new.txt", d)
+	}
+	raw, err := client.PullRequests.RawDiff(ctx, "prj", "my-repo", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.RawDiff returned error: %v", err)
+	}
+	if raw != "diff --git a/old.txt b/new.txt\n" {
+		t.Errorf("PullRequests.RawDiff returned %q", raw)
+	}
+	if _, err := client.PullRequests.Diff(ctx, "prj", "my-repo", 2); !errors.Is(err, ErrNotFound) {
+		t.Errorf("PullRequests.Diff of a missing pull request returned error %v, want %v", err, ErrNotFound)
+	}
+}
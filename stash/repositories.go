@@ -62,6 +62,9 @@ type RepositoryPermissionManager interface {
 	AllGroupsPermission(ctx context.Context, projectKey, repositorySlug string) ([]*RepositoryGroupPermission, error)
 	UpdateRepositoryGroupPermission(ctx context.Context, projectKey, repositorySlug string, permission *RepositoryGroupPermission) error
 	ListRepositoryUsersPermission(ctx context.Context, projectKey, repositorySlug string, opts *PagingOptions) (*RepositoryUsers, error)
+	AllUsersPermission(ctx context.Context, projectKey, repositorySlug string) ([]*RepositoryUserPermission, error)
+	UpdateRepositoryUserPermission(ctx context.Context, projectKey, repositorySlug, userName, permission string) error
+	RevokeRepositoryUserPermission(ctx context.Context, projectKey, repositorySlug, userName string) error
 }
 
 // RepositoriesService is a client for communicating with stash repositories endpoints
@@ -495,3 +498,74 @@ func (s *RepositoriesService) ListRepositoryUsersPermission(ctx context.Context,
 
 	return users, nil
 }
+
+// AllUsersPermission retrieves all users that have been granted at least one permission for the specified repository.
+// This function handles pagination, HTTP error wrapping, and validates the server result.
+func (s *RepositoriesService) AllUsersPermission(ctx context.Context, projectKey, repositorySlug string) ([]*RepositoryUserPermission, error) {
+	p := []*RepositoryUserPermission{}
+	opts := &PagingOptions{Limit: perPageLimit}
+	err := allPages(opts, func() (*Paging, error) {
+		list, err := s.ListRepositoryUsersPermission(ctx, projectKey, repositorySlug, opts)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, list.GetUsers()...)
+		return &list.Paging, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// UpdateRepositoryUserPermission Promote or demote a user's permission level for the specified repository.
+// UpdateRepositoryUserPermission uses the endpoint "PUT /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/permissions/users?permission&name".
+// The authenticated user must have REPO_ADMIN permission for the specified repository to call this resource.
+func (s *RepositoriesService) UpdateRepositoryUserPermission(ctx context.Context, projectKey, repositorySlug, userName, permission string) error {
+	query := url.Values{
+		"name":       []string{userName},
+		"permission": []string{permission},
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPut, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, userPermisionsURI), WithQuery(query))
+	if err != nil {
+		return fmt.Errorf("add user permissions request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("add user permissions to repository failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusBadRequest {
+		return fmt.Errorf("add user permissions to repository failed: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// RevokeRepositoryUserPermission revokes all repository permissions of a user for the specified repository.
+// RevokeRepositoryUserPermission uses the endpoint "DELETE /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/permissions/users?name".
+// The authenticated user must have REPO_ADMIN permission for the specified repository to call this resource.
+func (s *RepositoriesService) RevokeRepositoryUserPermission(ctx context.Context, projectKey, repositorySlug, userName string) error {
+	query := url.Values{
+		"name": []string{userName},
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodDelete, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, userPermisionsURI), WithQuery(query))
+	if err != nil {
+		return fmt.Errorf("revoke user permissions request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("revoke user permissions to repository failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
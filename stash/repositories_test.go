@@ -19,8 +19,10 @@ package stash
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"path"
 	"strings"
 	"testing"
@@ -660,3 +662,52 @@ func TestListRepositoryUsersPermission(t *testing.T) {
 	}
 
 }
+
+func TestUpdateRepositoryUserPermission(t *testing.T) {
+	mux, client := setup(t)
+
+	var got url.Values
+	p := fmt.Sprintf("%s/%s/testProject/%s/repo1/%s", stashURIprefix, projectsURI, RepositoriesURI, userPermisionsURI)
+	mux.HandleFunc(p, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+			return
+		}
+		got = r.URL.Query()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if err := client.Repositories.UpdateRepositoryUserPermission(ctx, "testProject", "repo1", "jcitizen", "REPO_WRITE"); err != nil {
+		t.Fatalf("Repositories.UpdateRepositoryUserPermission returned error: %v", err)
+	}
+	want := url.Values{"name": []string{"jcitizen"}, "permission": []string{"REPO_WRITE"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Repositories.UpdateRepositoryUserPermission sent (want -> got): %s", diff)
+	}
+}
+
+func TestRevokeRepositoryUserPermission(t *testing.T) {
+	mux, client := setup(t)
+
+	p := fmt.Sprintf("%s/%s/testProject/%s/repo1/%s", stashURIprefix, projectsURI, RepositoriesURI, userPermisionsURI)
+	mux.HandleFunc(p, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+			return
+		}
+		if r.URL.Query().Get("name") != "jcitizen" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if err := client.Repositories.RevokeRepositoryUserPermission(ctx, "testProject", "repo1", "jcitizen"); err != nil {
+		t.Fatalf("Repositories.RevokeRepositoryUserPermission returned error: %v", err)
+	}
+	if err := client.Repositories.RevokeRepositoryUserPermission(ctx, "testProject", "repo1", "jcook"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Repositories.RevokeRepositoryUserPermission of an unknown user returned %v, want ErrNotFound", err)
+	}
+}
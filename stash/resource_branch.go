@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newBranch(c *BranchClient, branch *Branch, protected bool) *branchType {
+	return &branchType{
+		b:         *branch,
+		protected: protected,
+		c:         c,
+	}
+}
+
+var _ gitprovider.Branch = &branchType{}
+
+type branchType struct {
+	b Branch
+	// protected is true if at least one branch restriction applies to the branch
+	protected bool
+	c         *BranchClient
+}
+
+func (b *branchType) Get() gitprovider.BranchInfo {
+	return branchFromAPI(&b.b, b.protected)
+}
+
+func (b *branchType) APIObject() interface{} {
+	return &b.b
+}
+
+func validateBranchAPI(apiObj *Branch) error {
+	return validateAPIObject("Stash.Branch", func(validator validation.Validator) {
+		if apiObj.DisplayID == "" {
+			validator.Required("DisplayID")
+		}
+		if apiObj.LatestCommit == "" {
+			validator.Required("LatestCommit")
+		}
+	})
+}
+
+func branchFromAPI(apiObj *Branch, protected bool) gitprovider.BranchInfo {
+	return gitprovider.BranchInfo{
+		Name:      apiObj.DisplayID,
+		Sha:       apiObj.LatestCommit,
+		Protected: protected,
+	}
+}
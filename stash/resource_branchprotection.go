@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// BranchProtection is the set of branch restrictions applying to a single branch.
+type BranchProtection struct {
+	// Branch is the name of the protected branch
+	Branch string
+	// Restrictions are the restrictions applying to the branch
+	Restrictions []*BranchRestriction
+}
+
+func newBranchProtection(c *BranchProtectionClient, protection *BranchProtection) *branchProtection {
+	return &branchProtection{
+		p: *protection,
+		c: c,
+	}
+}
+
+var _ gitprovider.BranchProtection = &branchProtection{}
+
+type branchProtection struct {
+	p BranchProtection
+	c *BranchProtectionClient
+}
+
+func (bp *branchProtection) Get() gitprovider.BranchProtectionInfo {
+	return branchProtectionFromAPI(&bp.p)
+}
+
+func (bp *branchProtection) Set(info gitprovider.BranchProtectionInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	return branchProtectionInfoToAPIObj(&info, &bp.p)
+}
+
+func (bp *branchProtection) APIObject() interface{} {
+	return &bp.p
+}
+
+func (bp *branchProtection) Repository() gitprovider.RepositoryRef {
+	return bp.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (bp *branchProtection) Update(ctx context.Context) error {
+	if err := bp.c.update(ctx, &bp.p); err != nil {
+		// Log the error and return it
+		bp.c.log.V(1).Error(err, "failed to update branch protection", "org", bp.Repository().GetIdentity(), "repo", bp.Repository().GetRepository())
+		return err
+	}
+	return nil
+}
+
+// Delete removes all restrictions of the branch.
+// ErrNotFound is returned if the resource does not exist.
+func (bp *branchProtection) Delete(ctx context.Context) error {
+	return bp.c.delete(ctx, bp.p.Branch)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (bp *branchProtection) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := bp.c.get(ctx, bp.p.Branch)
+	if err != nil && !errors.Is(err, gitprovider.ErrNotFound) {
+		return false, fmt.Errorf("failed to reconcile branch protection %q: %w", bp.p.Branch, err)
+	}
+
+	// If the desired matches the actual state, do nothing
+	if actual != nil && bp.Get().Equals(branchProtectionFromAPI(actual)) {
+		return false, nil
+	}
+	// Create or update the restrictions which mis-match
+	return true, bp.Update(ctx)
+}
+
+func validateBranchRestrictionAPI(apiObj *BranchRestriction) error {
+	return validateAPIObject("Stash.BranchRestriction", func(validator validation.Validator) {
+		if apiObj.ID == 0 {
+			validator.Required("ID")
+		}
+		if apiObj.Type == "" {
+			validator.Required("Type")
+		}
+	})
+}
+
+// restrictionKey identifies a restriction by its type and exemptions, which
+// are all that's set when creating a restriction for a branch.
+func restrictionKey(r *BranchRestriction) string {
+	users := make([]string, 0, len(r.Users))
+	for _, user := range r.Users {
+		users = append(users, user.Name)
+	}
+	groups := append([]string{}, r.Groups...)
+	sort.Strings(users)
+	sort.Strings(groups)
+	return fmt.Sprintf("%s/%s/%s", r.Type, strings.Join(users, ","), strings.Join(groups, ","))
+}
+
+// findRestriction returns the restriction of the given type, or nil if there is none.
+func findRestriction(protection *BranchProtection, restrictionType string) *BranchRestriction {
+	for _, r := range protection.Restrictions {
+		if r.Type == restrictionType {
+			return r
+		}
+	}
+	return nil
+}
+
+func branchProtectionFromAPI(apiObj *BranchProtection) gitprovider.BranchProtectionInfo {
+	info := gitprovider.BranchProtectionInfo{
+		Branch: apiObj.Branch,
+		// Bitbucket Server has no per-branch review or status check requirements
+		RequiredApprovingReviewCount: gitprovider.IntVar(0),
+		RestrictPushes:               gitprovider.BoolVar(false),
+		AllowForcePushes:             gitprovider.BoolVar(findRestriction(apiObj, BranchRestrictionTypeFastForwardOnly) == nil),
+		AllowDeletions:               gitprovider.BoolVar(findRestriction(apiObj, BranchRestrictionTypeNoDeletes) == nil),
+		IncludeAdmins:                gitprovider.BoolVar(false),
+	}
+	if readOnly := findRestriction(apiObj, BranchRestrictionTypeReadOnly); readOnly != nil {
+		info.RestrictPushes = gitprovider.BoolVar(true)
+		for _, user := range readOnly.Users {
+			info.PushAllowances = append(info.PushAllowances, user.Name)
+		}
+		sort.Strings(info.PushAllowances)
+	}
+	return info
+}
+
+func branchProtectionInfoToAPIObj(info *gitprovider.BranchProtectionInfo, apiObj *BranchProtection) error {
+	if info.RequiredApprovingReviewCount != nil && *info.RequiredApprovingReviewCount > 0 {
+		return fmt.Errorf("required approving reviews: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if len(info.RequiredStatusChecks) > 0 {
+		return fmt.Errorf("required status checks: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.IncludeAdmins != nil && *info.IncludeAdmins {
+		return fmt.Errorf("enforcing branch protections for administrators: %w", gitprovider.ErrNoProviderSupport)
+	}
+	// Required fields, we assume info is validated, and hence these are set
+	apiObj.Branch = info.Branch
+	// optional fields, each of them toggles a restriction
+	if info.RestrictPushes != nil {
+		var restriction *BranchRestriction
+		if *info.RestrictPushes {
+			// Keep the exempted groups of an existing restriction
+			restriction = &BranchRestriction{Type: BranchRestrictionTypeReadOnly}
+			if existing := findRestriction(apiObj, BranchRestrictionTypeReadOnly); existing != nil {
+				restriction.Groups = existing.Groups
+			}
+			for _, login := range info.PushAllowances {
+				restriction.Users = append(restriction.Users, User{Name: login})
+			}
+		}
+		setRestriction(apiObj, BranchRestrictionTypeReadOnly, restriction)
+	}
+	if info.AllowForcePushes != nil {
+		setRestriction(apiObj, BranchRestrictionTypeFastForwardOnly, toggleRestriction(apiObj, BranchRestrictionTypeFastForwardOnly, !*info.AllowForcePushes))
+	}
+	if info.AllowDeletions != nil {
+		setRestriction(apiObj, BranchRestrictionTypeNoDeletes, toggleRestriction(apiObj, BranchRestrictionTypeNoDeletes, !*info.AllowDeletions))
+	}
+	return nil
+}
+
+// toggleRestriction returns the restriction of the given type if enabled, keeping an existing one.
+func toggleRestriction(protection *BranchProtection, restrictionType string, enabled bool) *BranchRestriction {
+	if !enabled {
+		return nil
+	}
+	if existing := findRestriction(protection, restrictionType); existing != nil {
+		return existing
+	}
+	return &BranchRestriction{Type: restrictionType}
+}
+
+// setRestriction replaces the restriction of the given type with r, or removes it if r is nil.
+func setRestriction(protection *BranchProtection, restrictionType string, r *BranchRestriction) {
+	restrictions := make([]*BranchRestriction, 0, len(protection.Restrictions)+1)
+	for _, existing := range protection.Restrictions {
+		if existing.Type != restrictionType {
+			restrictions = append(restrictions, existing)
+		}
+	}
+	if r != nil {
+		restrictions = append(restrictions, r)
+	}
+	protection.Restrictions = restrictions
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newCollaborator(c *CollaboratorClient, apiObj *RepositoryUserPermission) (*collaborator, error) {
+	permission, err := getGitProviderPermission(stashPriority[apiObj.Permission])
+	if err != nil {
+		return nil, err
+	}
+	return &collaborator{
+		u: *apiObj,
+		info: gitprovider.CollaboratorInfo{
+			Login:      apiObj.User.Name,
+			Permission: permission,
+		},
+		c: c,
+	}, nil
+}
+
+var _ gitprovider.Collaborator = &collaborator{}
+
+type collaborator struct {
+	u    RepositoryUserPermission
+	info gitprovider.CollaboratorInfo
+	c    *CollaboratorClient
+}
+
+func (c *collaborator) Get() gitprovider.CollaboratorInfo {
+	return c.info
+}
+
+func (c *collaborator) Set(info gitprovider.CollaboratorInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	c.info = info
+	return nil
+}
+
+func (c *collaborator) APIObject() interface{} {
+	return &c.u
+}
+
+func (c *collaborator) Repository() gitprovider.RepositoryRef {
+	return c.c.ref
+}
+
+// Delete revokes the repository permission of the user.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Delete(ctx context.Context) error {
+	projectKey, repoSlug := getStashRefs(c.c.ref)
+	err := c.c.client.Repositories.RevokeRepositoryUserPermission(ctx, projectKey, repoSlug, c.info.Login)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete repository collaborator: %w", err)
+	}
+	return nil
+}
+
+// Update will apply the desired permission to the user.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (c *collaborator) Update(ctx context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&c.info); err != nil {
+		return err
+	}
+	if err := c.c.update(ctx, c.info); err != nil {
+		// Log the error and return it
+		c.c.log.V(1).Error(err, "Error updating collaborator",
+			"org", c.Repository().GetIdentity(),
+			"repo", c.Repository().GetRepository())
+		return err
+	}
+	resp, err := c.c.get(ctx, c.info.Login)
+	if err != nil {
+		return err
+	}
+	*c = *resp
+	return nil
+}
+
+// Reconcile makes sure the given desired state (req) becomes the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (c *collaborator) Reconcile(ctx context.Context) (bool, error) {
+	resp, actionTaken, err := c.c.Reconcile(ctx, c.info)
+	if err != nil {
+		// Log the error and return it
+		c.c.log.V(1).Error(err, "Error reconciling collaborator",
+			"org", c.Repository().GetIdentity(),
+			"repo", c.Repository().GetRepository(),
+			"actionTaken", actionTaken)
+		return actionTaken, err
+	}
+	if actionTaken {
+		*c = *resp.(*collaborator)
+	}
+	return actionTaken, nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+// commitStatusStates maps the Bitbucket Server build states onto the generic commit status states.
+//
+//nolint:gochecknoglobals
+var commitStatusStates = map[string]gitprovider.CommitStatusState{
+	BuildStatusStateInProgress: gitprovider.CommitStatusStatePending,
+	BuildStatusStateSuccessful: gitprovider.CommitStatusStateSuccess,
+	BuildStatusStateFailed:     gitprovider.CommitStatusStateFailure,
+}
+
+func newCommitStatus(c *CommitStatusClient, status *BuildStatus) *commitStatus {
+	return &commitStatus{
+		s: *status,
+		c: c,
+	}
+}
+
+var _ gitprovider.CommitStatus = &commitStatus{}
+
+type commitStatus struct {
+	s BuildStatus
+	c *CommitStatusClient
+}
+
+func (s *commitStatus) Get() gitprovider.CommitStatusInfo {
+	return commitStatusFromAPI(&s.s)
+}
+
+func (s *commitStatus) APIObject() interface{} {
+	return &s.s
+}
+
+func (s *commitStatus) Repository() gitprovider.RepositoryRef {
+	return s.c.ref
+}
+
+func validateCommitStatusAPI(apiObj *BuildStatus) error {
+	return validateAPIObject("Stash.BuildStatus", func(validator validation.Validator) {
+		if apiObj.Key == "" {
+			validator.Required("Key")
+		}
+		if _, ok := commitStatusStates[apiObj.State]; !ok {
+			validator.Invalid(apiObj.State, "State")
+		}
+	})
+}
+
+func commitStatusFromAPI(apiObj *BuildStatus) gitprovider.CommitStatusInfo {
+	return gitprovider.CommitStatusInfo{
+		State:       commitStatusStates[apiObj.State],
+		Context:     apiObj.Key,
+		Description: apiObj.Description,
+		TargetURL:   apiObj.URL,
+		CreatedAt:   time.UnixMilli(apiObj.DateAdded),
+	}
+}
+
+func commitStatusToAPI(info *gitprovider.CommitStatusInfo) *BuildStatus {
+	// Bitbucket Server doesn't differentiate between errors and failures
+	state := BuildStatusStateFailed
+	switch info.State {
+	case gitprovider.CommitStatusStatePending:
+		state = BuildStatusStateInProgress
+	case gitprovider.CommitStatusStateSuccess:
+		state = BuildStatusStateSuccessful
+	}
+	return &BuildStatus{
+		State:       state,
+		Key:         info.Context,
+		Name:        info.Context,
+		URL:         info.TargetURL,
+		Description: info.Description,
+	}
+}
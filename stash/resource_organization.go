@@ -51,9 +51,25 @@ func (o *Organization) Teams() gitprovider.TeamsClient {
 }
 
 func organizationFromAPI(apiObj *Project) gitprovider.OrganizationInfo {
-	return gitprovider.OrganizationInfo{
+	info := gitprovider.OrganizationInfo{
 		Name:        &apiObj.Name,
 		Description: &apiObj.Description,
+		Visibility:  gitprovider.OrganizationVisibilityVar(gitprovider.OrganizationVisibilityPrivate),
+	}
+	if apiObj.Public {
+		info.Visibility = gitprovider.OrganizationVisibilityVar(gitprovider.OrganizationVisibilityPublic)
+	}
+	return info
+}
+
+// organizationInfoToAPI sets the description and public flag of apiObj to the ones in info.
+func organizationInfoToAPI(info gitprovider.OrganizationInfo, apiObj *Project) {
+	apiObj.Description = ""
+	if info.Description != nil {
+		apiObj.Description = *info.Description
+	}
+	if info.Visibility != nil {
+		apiObj.Public = *info.Visibility == gitprovider.OrganizationVisibilityPublic
 	}
 }
 
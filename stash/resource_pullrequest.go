@@ -17,22 +17,59 @@ limitations under the License.
 package stash
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
 
-// The value of the "State" field of a Stash pull request after it has been merged"
-const mergedState = "MERGED"
+// The values of the "Outcome" field of the merge result of an open Stash pull request
+const (
+	mergeOutcomeClean      = "CLEAN"
+	mergeOutcomeConflicted = "CONFLICTED"
+)
+
+func newPullRequest(c *PullRequestClient, apiObj *PullRequest) *pullrequest {
+	projectKey, repoSlug := getStashRefs(c.ref)
+
+	// check if it is a user repository
+	// if yes, we need to add a tilde to the user login and use it as the project key
+	if r, ok := c.ref.(gitprovider.UserRepositoryRef); ok {
+		projectKey = addTilde(r.UserLogin)
+	}
 
-func newPullRequest(apiObj *PullRequest) *pullrequest {
 	return &pullrequest{
-		pr: *apiObj,
+		clientContext: c.clientContext,
+		pr:            *apiObj,
+		projectKey:    projectKey,
+		repoSlug:      repoSlug,
+		comments: &PullRequestCommentClient{
+			clientContext: c.clientContext,
+			projectKey:    projectKey,
+			repoSlug:      repoSlug,
+			number:        apiObj.ID,
+		},
+		reviews: &PullRequestReviewClient{
+			clientContext: c.clientContext,
+			projectKey:    projectKey,
+			repoSlug:      repoSlug,
+			number:        apiObj.ID,
+		},
 	}
 }
 
 var _ gitprovider.PullRequest = &pullrequest{}
 
 type pullrequest struct {
-	pr PullRequest
+	*clientContext
+
+	pr         PullRequest
+	projectKey string
+	repoSlug   string
+	comments   *PullRequestCommentClient
+	reviews    *PullRequestReviewClient
 }
 
 func (pr *pullrequest) Get() gitprovider.PullRequestInfo {
@@ -43,15 +80,127 @@ func (pr *pullrequest) APIObject() interface{} {
 	return &pr.pr
 }
 
+func (pr *pullrequest) Comments() gitprovider.PullRequestCommentClient {
+	return pr.comments
+}
+
+func (pr *pullrequest) Reviews() gitprovider.PullRequestReviewClient {
+	return pr.reviews
+}
+
+func (pr *pullrequest) Files(ctx context.Context) (*gitprovider.PullRequestFiles, error) {
+	apiObj, err := pr.client.PullRequests.Diff(ctx, pr.projectKey, pr.repoSlug, pr.pr.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	files := &gitprovider.PullRequestFiles{
+		Files:     make([]gitprovider.PullRequestFile, 0, len(apiObj.Diffs)),
+		Truncated: apiObj.Truncated,
+	}
+	for _, diff := range apiObj.Diffs {
+		files.Files = append(files.Files, pullRequestFileFromAPI(diff))
+		files.Truncated = files.Truncated || diff.Truncated
+	}
+	return files, nil
+}
+
+func (pr *pullrequest) Diff(ctx context.Context) (string, error) {
+	return pr.client.PullRequests.RawDiff(ctx, pr.projectKey, pr.repoSlug, pr.pr.ID)
+}
+
 func pullrequestFromAPI(apiObj *PullRequest) gitprovider.PullRequestInfo {
-	return gitprovider.PullRequestInfo{
+	info := gitprovider.PullRequestInfo{
 		Title:        apiObj.Title,
 		Description:  apiObj.Description,
 		WebURL:       getSelfref(apiObj.Self),
 		Number:       apiObj.ID,
-		Merged:       apiObj.State == mergedState,
+		Merged:       apiObj.State == PullRequestStateMerged,
 		SourceBranch: apiObj.FromRef.DisplayID,
+		TargetBranch: apiObj.ToRef.DisplayID,
+		HeadSha:      apiObj.FromRef.LatestCommit,
+		BaseSha:      apiObj.ToRef.LatestCommit,
+		Draft:        apiObj.Draft != nil && *apiObj.Draft,
+		CreatedAt:    fromMillis(apiObj.CreatedDate),
+		UpdatedAt:    fromMillis(apiObj.UpdatedDate),
+	}
+	if apiObj.Author != nil {
+		info.Author = apiObj.Author.User.Name
+	}
+	for _, reviewer := range apiObj.Reviewers {
+		info.Reviewers = append(info.Reviewers, reviewer.User.Name)
+	}
+
+	switch apiObj.State {
+	case PullRequestStateMerged:
+		info.State = gitprovider.PullRequestStateMerged
+		if apiObj.ClosedDate != 0 {
+			mergedAt := fromMillis(apiObj.ClosedDate)
+			info.MergedAt = &mergedAt
+		}
+	case PullRequestStateDeclined:
+		info.State = gitprovider.PullRequestStateClosed
+	default:
+		info.State = gitprovider.PullRequestStateOpen
+		switch apiObj.Properties.MergeResult.Outcome {
+		case mergeOutcomeClean:
+			info.Mergeable = gitprovider.BoolVar(true)
+		case mergeOutcomeConflicted:
+			info.Mergeable = gitprovider.BoolVar(false)
+		}
+	}
+	return info
+}
+
+func pullRequestFileFromAPI(apiObj *FileDiff) gitprovider.PullRequestFile {
+	file := gitprovider.PullRequestFile{
+		Status: gitprovider.PullRequestFileStatusModified,
+	}
+	switch {
+	case apiObj.Source == nil:
+		file.Status = gitprovider.PullRequestFileStatusAdded
+		file.Path = apiObj.Destination.ToString
+	case apiObj.Destination == nil:
+		file.Status = gitprovider.PullRequestFileStatusRemoved
+		file.Path = apiObj.Source.ToString
+	case apiObj.Source.ToString != apiObj.Destination.ToString:
+		file.Status = gitprovider.PullRequestFileStatusRenamed
+		file.Path = apiObj.Destination.ToString
+		file.PreviousPath = apiObj.Source.ToString
+	default:
+		file.Path = apiObj.Destination.ToString
+	}
+
+	// Stash returns the lines of the hunks, hence build the patch from them
+	var patch strings.Builder
+	for _, hunk := range apiObj.Hunks {
+		fmt.Fprintf(&patch, "@@ -%d,%d +%d,%d @@\n", hunk.SourceLine, hunk.SourceSpan, hunk.DestinationLine, hunk.DestinationSpan)
+		for _, segment := range hunk.Segments {
+			prefix := " "
+			switch segment.Type {
+			case DiffSegmentTypeAdded:
+				prefix = "+"
+				file.Additions += len(segment.Lines)
+			case DiffSegmentTypeRemoved:
+				prefix = "-"
+				file.Deletions += len(segment.Lines)
+			}
+			for _, line := range segment.Lines {
+				patch.WriteString(prefix + line.Line + "\n")
+			}
+		}
+	}
+	file.Patch = patch.String()
+	return file
+}
+
+// fromMillis converts a Stash timestamp, in milliseconds since the epoch, to a time.Time.
+// The zero time is returned if the timestamp is unset.
+func fromMillis(millis int64) time.Time {
+	if millis == 0 {
+		return time.Time{}
 	}
+	return time.UnixMilli(millis).UTC()
 }
 
 func getSelfref(selves []Self) string {
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+func newPullRequestComment(c *PullRequestCommentClient, apiObj *Comment) *pullRequestComment {
+	return &pullRequestComment{
+		pc: *apiObj,
+		c:  c,
+	}
+}
+
+var _ gitprovider.PullRequestComment = &pullRequestComment{}
+
+type pullRequestComment struct {
+	pc Comment
+	c  *PullRequestCommentClient
+}
+
+func (pc *pullRequestComment) Get() gitprovider.PullRequestCommentInfo {
+	return pullRequestCommentFromAPI(&pc.pc)
+}
+
+func (pc *pullRequestComment) Set(info gitprovider.PullRequestCommentInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	pc.pc.Text = info.Body
+	return nil
+}
+
+func (pc *pullRequestComment) APIObject() interface{} {
+	return &pc.pc
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (pc *pullRequestComment) Update(ctx context.Context) error {
+	apiObj, err := pc.c.client.PullRequestComments.Update(ctx, pc.c.projectKey, pc.c.repoSlug, pc.c.number, &pc.pc)
+	if err != nil {
+		return fmt.Errorf("failed to update pull request comment: %w", err)
+	}
+	pc.pc = *apiObj
+	return nil
+}
+
+// Delete deletes the comment. Comments with replies can't be deleted.
+//
+// ErrNotFound is returned if the resource does not exist.
+func (pc *pullRequestComment) Delete(ctx context.Context) error {
+	if err := pc.c.client.PullRequestComments.Delete(ctx, pc.c.projectKey, pc.c.repoSlug, pc.c.number, pc.pc.ID, pc.pc.Version); err != nil {
+		return fmt.Errorf("failed to delete pull request comment: %w", err)
+	}
+	return nil
+}
+
+func pullRequestCommentFromAPI(apiObj *Comment) gitprovider.PullRequestCommentInfo {
+	info := gitprovider.PullRequestCommentInfo{
+		ID:        apiObj.ID,
+		Body:      apiObj.Text,
+		CreatedAt: fromMillis(apiObj.CreatedDate),
+		UpdatedAt: fromMillis(apiObj.UpdatedDate),
+	}
+	if apiObj.Author != nil {
+		info.Author = apiObj.Author.Name
+	}
+	return info
+}
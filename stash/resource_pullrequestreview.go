@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// reviewStates maps the participant statuses of reviewers to the review states.
+//
+//nolint:gochecknoglobals
+var reviewStates = map[string]gitprovider.PullRequestReviewState{
+	ParticipantStatusApproved:  gitprovider.PullRequestReviewStateApproved,
+	ParticipantStatusNeedsWork: gitprovider.PullRequestReviewStateChangesRequested,
+}
+
+// newParticipantReview returns a review for the status of a participant of a pull request.
+func newParticipantReview(apiObj *Participant) *pullRequestReview {
+	return &pullRequestReview{
+		apiObj: apiObj,
+		info: gitprovider.PullRequestReviewInfo{
+			State:  reviewStates[apiObj.Status],
+			Author: apiObj.User.Name,
+		},
+	}
+}
+
+// newCommentReview returns a commented review for a comment of a pull request.
+func newCommentReview(apiObj *Comment) *pullRequestReview {
+	info := gitprovider.PullRequestReviewInfo{
+		ID:    apiObj.ID,
+		State: gitprovider.PullRequestReviewStateCommented,
+		Body:  apiObj.Text,
+	}
+	if apiObj.Author != nil {
+		info.Author = apiObj.Author.Name
+	}
+	if apiObj.CreatedDate != 0 {
+		submittedAt := fromMillis(apiObj.CreatedDate)
+		info.SubmittedAt = &submittedAt
+	}
+	return &pullRequestReview{
+		apiObj: apiObj,
+		info:   info,
+	}
+}
+
+var _ gitprovider.PullRequestReview = &pullRequestReview{}
+
+// pullRequestReview is either the status of a participant or a comment, as Bitbucket Server
+// doesn't have reviews.
+type pullRequestReview struct {
+	apiObj interface{}
+	info   gitprovider.PullRequestReviewInfo
+}
+
+func (r *pullRequestReview) Get() gitprovider.PullRequestReviewInfo {
+	return r.info
+}
+
+// APIObject returns the *Participant of approvals and changes requests, or the *Comment
+// of commented reviews.
+func (r *pullRequestReview) APIObject() interface{} {
+	return r.apiObj
+}
@@ -32,6 +32,10 @@ func newUserRepository(ctx *clientContext, apiObj *Repository, ref gitprovider.R
 		},
 		repository: *apiObj,
 		ref:        ref,
+		collaborators: &CollaboratorClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 		deployKeys: &DeployKeyClient{
 			clientContext: ctx,
 			ref:           ref,
@@ -48,6 +52,14 @@ func newUserRepository(ctx *clientContext, apiObj *Repository, ref gitprovider.R
 			clientContext: ctx,
 			ref:           ref,
 		},
+		issues: &IssueClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		labels: &LabelClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 		files: &FileClient{
 			clientContext: ctx,
 			ref:           ref,
@@ -56,21 +68,49 @@ func newUserRepository(ctx *clientContext, apiObj *Repository, ref gitprovider.R
 			clientContext: ctx,
 			ref:           ref,
 		},
+		webhooks: &WebhookClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		commitStatuses: &CommitStatusClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		branchProtections: &BranchProtectionClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		tags: &TagClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
+		releases: &ReleaseClient{
+			clientContext: ctx,
+			ref:           ref,
+		},
 	}
 }
 
 var _ gitprovider.UserRepository = &userRepository{}
 
 type userRepository struct {
-	repository   Repository
-	ref          gitprovider.RepositoryRef
-	c            *UserRepositoriesClient
-	deployKeys   *DeployKeyClient
-	branches     *BranchClient
-	pullRequests *PullRequestClient
-	commits      *CommitClient
-	files        *FileClient
-	trees        *TreeClient
+	repository        Repository
+	ref               gitprovider.RepositoryRef
+	c                 *UserRepositoriesClient
+	collaborators     *CollaboratorClient
+	deployKeys        *DeployKeyClient
+	branches          *BranchClient
+	pullRequests      *PullRequestClient
+	issues            *IssueClient
+	labels            *LabelClient
+	commits           *CommitClient
+	files             *FileClient
+	trees             *TreeClient
+	webhooks          *WebhookClient
+	commitStatuses    *CommitStatusClient
+	branchProtections *BranchProtectionClient
+	tags              *TagClient
+	releases          *ReleaseClient
 }
 
 func (r *userRepository) Branches() gitprovider.BranchClient {
@@ -85,6 +125,14 @@ func (r *userRepository) PullRequests() gitprovider.PullRequestClient {
 	return r.pullRequests
 }
 
+func (r *userRepository) Issues() gitprovider.IssueClient {
+	return r.issues
+}
+
+func (r *userRepository) Labels() gitprovider.LabelClient {
+	return r.labels
+}
+
 func (r *userRepository) Files() gitprovider.FileClient {
 	return r.files
 }
@@ -93,6 +141,26 @@ func (r *userRepository) Trees() gitprovider.TreeClient {
 	return r.trees
 }
 
+func (r *userRepository) Webhooks() gitprovider.WebhookClient {
+	return r.webhooks
+}
+
+func (r *userRepository) CommitStatuses() gitprovider.CommitStatusClient {
+	return r.commitStatuses
+}
+
+func (r *userRepository) BranchProtections() gitprovider.BranchProtectionClient {
+	return r.branchProtections
+}
+
+func (r *userRepository) Tags() gitprovider.TagClient {
+	return r.tags
+}
+
+func (r *userRepository) Releases() gitprovider.ReleaseClient {
+	return r.releases
+}
+
 func (r *userRepository) Get() gitprovider.RepositoryInfo {
 	return repositoryFromAPI(&r.repository)
 }
@@ -101,6 +169,9 @@ func (r *userRepository) Set(info gitprovider.RepositoryInfo) error {
 	if err := info.ValidateInfo(); err != nil {
 		return err
 	}
+	if err := validateRepositorySupport(info); err != nil {
+		return err
+	}
 	repositoryInfoToAPIObj(&info, &r.repository)
 	return nil
 }
@@ -113,6 +184,10 @@ func (r *userRepository) Repository() gitprovider.RepositoryRef {
 	return r.ref
 }
 
+func (r *userRepository) Collaborators() gitprovider.CollaboratorClient {
+	return r.collaborators
+}
+
 func (r *userRepository) DeployKeys() gitprovider.DeployKeyClient {
 	return r.deployKeys
 }
@@ -297,6 +372,19 @@ func repositoryInfoToAPIObj(repo *gitprovider.RepositoryInfo, apiObj *Repository
 	}
 }
 
+// unsupportedRepositoryFields are the gitprovider.RepositoryInfo fields Bitbucket Server has no
+// equivalent for.
+var unsupportedRepositoryFields = []string{
+	"topics", "homepage", "archived", "hasIssues", "hasWiki", "hasProjects",
+	"allowedMergeMethods", "deleteBranchOnMerge", "isTemplate",
+}
+
+// validateRepositorySupport returns ErrNoProviderSupport if info sets fields Bitbucket Server has
+// no equivalent for.
+func validateRepositorySupport(info gitprovider.RepositoryInfo) error {
+	return gitprovider.ValidateUnsupportedFields(ProviderID, info, unsupportedRepositoryFields...)
+}
+
 // GetCloneURL returns a formatted string that can be used for cloning
 // from a remote Git provider.
 func (r *orgRepository) GetCloneURL(prefix string, transport gitprovider.TransportType) string {
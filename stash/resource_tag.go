@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+func newTag(c *TagClient, tag *Tag) *tagType {
+	return &tagType{
+		t: *tag,
+		c: c,
+	}
+}
+
+var _ gitprovider.Tag = &tagType{}
+
+type tagType struct {
+	t Tag
+	c *TagClient
+}
+
+func (t *tagType) Get() gitprovider.TagInfo {
+	return tagFromAPI(&t.t)
+}
+
+func (t *tagType) APIObject() interface{} {
+	return &t.t
+}
+
+func (t *tagType) Repository() gitprovider.RepositoryRef {
+	return t.c.ref
+}
+
+func validateTagAPI(apiObj *Tag) error {
+	return validateAPIObject("Stash.Tag", func(validator validation.Validator) {
+		if apiObj.DisplayID == "" {
+			validator.Required("DisplayID")
+		}
+		if apiObj.LatestCommit == "" {
+			validator.Required("LatestCommit")
+		}
+	})
+}
+
+func tagFromAPI(apiObj *Tag) gitprovider.TagInfo {
+	return gitprovider.TagInfo{
+		Name: apiObj.DisplayID,
+		Sha:  apiObj.LatestCommit,
+	}
+}
@@ -17,6 +17,10 @@ limitations under the License.
 package stash
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/fluxcd/go-git-providers/gitprovider"
 )
 
@@ -28,6 +32,7 @@ type Team struct {
 	users []*User
 	info  gitprovider.TeamInfo
 	ref   gitprovider.OrganizationRef
+	c     *TeamsClient
 }
 
 // Get returns the team's information, Name and members.
@@ -35,6 +40,22 @@ func (t *Team) Get() gitprovider.TeamInfo {
 	return t.info
 }
 
+// Set sets the desired state of the team. The name of the team can't be changed,
+// and stash groups have neither a description nor a privacy.
+func (t *Team) Set(info gitprovider.TeamInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	if info.Name != t.info.Name {
+		return fmt.Errorf("cannot rename team %q: %w", t.info.Name, gitprovider.ErrInvalidArgument)
+	}
+	if err := validateGroupInfo(info); err != nil {
+		return err
+	}
+	t.info = info
+	return nil
+}
+
 // APIObject returns the Users that ware part of this team.
 func (t *Team) APIObject() interface{} {
 	return t.users
@@ -44,3 +65,89 @@ func (t *Team) APIObject() interface{} {
 func (t *Team) Organization() gitprovider.OrganizationRef {
 	return t.ref
 }
+
+// Members returns the client operating on the members of the group.
+func (t *Team) Members() gitprovider.TeamMemberClient {
+	return &TeamMemberClient{
+		clientContext: t.c.clientContext,
+		team:          t.info.Name,
+	}
+}
+
+// Update is a no-op, as stash groups have nothing but a name which can't be changed.
+// An error is returned if the desired state has a description or is secret.
+func (t *Team) Update(_ context.Context) error {
+	if err := gitprovider.ValidateAndDefaultInfo(&t.info); err != nil {
+		return err
+	}
+	return validateGroupInfo(t.info)
+}
+
+// Delete deletes the group from the server.
+//
+// ErrDestructiveCallDisallowed is returned if the client wasn't created with
+// WithDestructiveAPICalls(true). ErrNotFound is returned if the resource does not exist.
+func (t *Team) Delete(ctx context.Context) error {
+	// Don't allow deleting groups if the user didn't explicitly allow dangerous API calls.
+	if !t.c.destructiveActions {
+		return fmt.Errorf("cannot delete group: %w", gitprovider.ErrDestructiveCallDisallowed)
+	}
+	if err := t.c.client.Groups.Delete(ctx, t.info.Name); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return gitprovider.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete group %s: %w", t.info.Name, err)
+	}
+	return nil
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+func (t *Team) Reconcile(ctx context.Context) (bool, error) {
+	if err := gitprovider.ValidateAndDefaultInfo(&t.info); err != nil {
+		return false, err
+	}
+
+	actual, err := t.c.Get(ctx, t.info.Name)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			if _, err := t.c.Create(ctx, t.info); err != nil {
+				return true, err
+			}
+			return true, nil
+		}
+
+		// Unexpected path, Get should succeed or return NotFound
+		return false, err
+	}
+
+	// If the desired matches the actual state, do nothing
+	if t.info.Equals(actual.Get()) {
+		return false, nil
+	}
+	return true, t.Update(ctx)
+}
+
+// groupInfo returns the information of the group called name, which only has a name.
+func groupInfo(name string) gitprovider.TeamInfo {
+	return gitprovider.TeamInfo{
+		Name:    name,
+		Privacy: gitprovider.TeamPrivacyVar(gitprovider.TeamPrivacyClosed),
+	}
+}
+
+// validateGroupInfo returns an error if info has a description or privacy stash groups can't have.
+func validateGroupInfo(info gitprovider.TeamInfo) error {
+	if info.Description != nil {
+		return fmt.Errorf("groups have no description: %w", gitprovider.ErrNoProviderSupport)
+	}
+	if info.Privacy != nil && *info.Privacy != gitprovider.TeamPrivacyClosed {
+		return fmt.Errorf("groups have no %q privacy: %w", *info.Privacy, gitprovider.ErrNoProviderSupport)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/go-git-providers/validation"
+)
+
+const (
+	// webhookSecretKey is the configuration key of the secret used to sign deliveries.
+	webhookSecretKey = "secret"
+)
+
+// webhookEvents maps the generic webhook events to Bitbucket Server's event keys, see
+// https://confluence.atlassian.com/bitbucketserver/event-payload-938025882.html
+// Tag pushes are part of "repo:refs_changed", hence can't be subscribed to separately.
+//
+//nolint:gochecknoglobals
+var webhookEvents = map[gitprovider.WebhookEvent][]string{
+	gitprovider.WebhookEventPush: {"repo:refs_changed"},
+	gitprovider.WebhookEventPullRequest: {
+		"pr:opened", "pr:from_ref_updated", "pr:modified", "pr:merged", "pr:declined", "pr:deleted",
+	},
+	gitprovider.WebhookEventComment: {"pr:comment:added", "pr:comment:edited", "pr:comment:deleted"},
+}
+
+func newWebhook(c *WebhookClient, hook *Webhook) *webhook {
+	return &webhook{
+		h: *hook,
+		c: c,
+	}
+}
+
+var _ gitprovider.Webhook = &webhook{}
+
+type webhook struct {
+	h Webhook
+	c *WebhookClient
+}
+
+func (wh *webhook) Get() gitprovider.WebhookInfo {
+	return webhookFromAPI(&wh.h)
+}
+
+func (wh *webhook) Set(info gitprovider.WebhookInfo) error {
+	if err := info.ValidateInfo(); err != nil {
+		return err
+	}
+	return webhookInfoToAPIObj(&info, &wh.h)
+}
+
+func (wh *webhook) APIObject() interface{} {
+	return &wh.h
+}
+
+func (wh *webhook) Repository() gitprovider.RepositoryRef {
+	return wh.c.ref
+}
+
+// Update will apply the desired state in this object to the server.
+// Only set fields will be respected (i.e. PATCH behaviour).
+// In order to apply changes to this object, use the .Set({Resource}Info) error
+// function, or cast .APIObject() to a pointer to the provider-specific type
+// and set custom fields there.
+//
+// ErrNotFound is returned if the resource does not exist.
+//
+// The internal API object will be overridden with the received server data.
+func (wh *webhook) Update(ctx context.Context) error {
+	// update by calling client
+	apiObj, err := wh.c.update(ctx, &wh.h)
+	if err != nil {
+		// Log the error and return it
+		wh.c.log.V(1).Error(err, "failed to update webhook", "org", wh.Repository().GetIdentity(), "repo", wh.Repository().GetRepository())
+		return err
+	}
+	wh.h = *apiObj
+	return nil
+}
+
+// Delete deletes a webhook from the repository.
+// ErrNotFound is returned if the resource does not exist.
+func (wh *webhook) Delete(ctx context.Context) error {
+	return wh.c.delete(ctx, &wh.h)
+}
+
+// Reconcile makes sure the desired state in this object (called "req" here) becomes
+// the actual state in the backing Git provider.
+//
+// If req doesn't exist under the hood, it is created (actionTaken == true).
+// If req doesn't equal the actual state, the resource will be updated (actionTaken == true).
+// If req is already the actual state, this is a no-op (actionTaken == false).
+//
+// The internal API object will be overridden with the received server data if actionTaken == true.
+func (wh *webhook) Reconcile(ctx context.Context) (bool, error) {
+	actual, err := wh.c.get(ctx, wh.h.URL)
+	if err != nil {
+		// Create if not found
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			projectKey, repoSlug := wh.c.repositoryKeys()
+			apiObj, err := wh.c.client.Webhooks.Create(ctx, projectKey, repoSlug, &wh.h)
+			if err != nil {
+				return true, fmt.Errorf("failed to create webhook: %w", err)
+			}
+			wh.h = *apiObj
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to reconcile webhook %q: %w", wh.h.URL, err)
+	}
+
+	// If the desired matches the actual state, do nothing
+	if newStashWebhookSpec(&wh.h).Equals(newStashWebhookSpec(actual)) {
+		return false, nil
+	}
+	// If desired and actual state mis-match, update
+	wh.h.ID = actual.ID
+	return true, wh.Update(ctx)
+}
+
+func validateWebhookAPI(apiObj *Webhook) error {
+	return validateAPIObject("Stash.Webhook", func(validator validation.Validator) {
+		if apiObj.ID == 0 {
+			validator.Required("ID")
+		}
+		if apiObj.URL == "" {
+			validator.Required("URL")
+		}
+	})
+}
+
+func webhookFromAPI(apiObj *Webhook) gitprovider.WebhookInfo {
+	return gitprovider.WebhookInfo{
+		URL: apiObj.URL,
+		// Bitbucket Server always delivers JSON
+		ContentType: gitprovider.WebhookContentTypeVar(gitprovider.WebhookContentTypeJSON),
+		Events:      webhookEventsFromAPI(apiObj.Events),
+		InsecureSSL: gitprovider.BoolVar(apiObj.SSLVerificationRequired != nil && !*apiObj.SSLVerificationRequired),
+		Active:      gitprovider.BoolVar(apiObj.Active),
+	}
+}
+
+func webhookInfoToAPIObj(info *gitprovider.WebhookInfo, apiObj *Webhook) error {
+	if info.ContentType != nil && *info.ContentType != gitprovider.WebhookContentTypeJSON {
+		return fmt.Errorf("webhook content type %q: %w", *info.ContentType, gitprovider.ErrNoProviderSupport)
+	}
+	// Required fields, we assume info is validated, and hence these are set
+	apiObj.URL = info.URL
+	if apiObj.Name == "" {
+		apiObj.Name = info.URL
+	}
+	// optional fields
+	if info.Secret != nil {
+		if apiObj.Configuration == nil {
+			apiObj.Configuration = map[string]string{}
+		}
+		apiObj.Configuration[webhookSecretKey] = *info.Secret
+	}
+	if info.Events != nil {
+		events, err := webhookEventsToAPI(info.Events)
+		if err != nil {
+			return err
+		}
+		apiObj.Events = events
+	}
+	if info.InsecureSSL != nil {
+		apiObj.SSLVerificationRequired = gitprovider.BoolVar(!*info.InsecureSSL)
+	}
+	if info.Active != nil {
+		apiObj.Active = *info.Active
+	}
+	return nil
+}
+
+func webhookEventsToAPI(events []gitprovider.WebhookEvent) ([]string, error) {
+	apiEvents := []string{}
+	for _, event := range events {
+		keys, ok := webhookEvents[event]
+		if !ok {
+			return nil, fmt.Errorf("webhook event %q: %w", event, gitprovider.ErrNoProviderSupport)
+		}
+		apiEvents = append(apiEvents, keys...)
+	}
+	return apiEvents, nil
+}
+
+// webhookEventsFromAPI returns the generic events for which all of Bitbucket Server's
+// corresponding event keys are subscribed to. Unknown event keys are ignored.
+func webhookEventsFromAPI(apiEvents []string) []gitprovider.WebhookEvent {
+	subscribed := make(map[string]struct{}, len(apiEvents))
+	for _, key := range apiEvents {
+		subscribed[key] = struct{}{}
+	}
+	events := []gitprovider.WebhookEvent{}
+	for event, keys := range webhookEvents {
+		found := true
+		for _, key := range keys {
+			if _, ok := subscribed[key]; !ok {
+				found = false
+			}
+		}
+		if found {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i] < events[j] })
+	return events
+}
+
+// This function copies over the fields that are part of create/update requests of a webhook
+// i.e. the desired spec of the webhook. This allows us to separate "spec" from "status" fields.
+func newStashWebhookSpec(hook *Webhook) *stashWebhookSpec {
+	events := append([]string{}, hook.Events...)
+	sort.Strings(events)
+	return &stashWebhookSpec{
+		&Webhook{
+			URL:                     hook.URL,
+			Events:                  events,
+			Active:                  hook.Active,
+			SSLVerificationRequired: hook.SSLVerificationRequired,
+		},
+	}
+}
+
+type stashWebhookSpec struct {
+	*Webhook
+}
+
+func (s *stashWebhookSpec) Equals(other *stashWebhookSpec) bool {
+	return reflect.DeepEqual(s, other)
+}
@@ -71,6 +71,9 @@ type ProviderClient struct {
 	orgs      *OrganizationsClient
 	orgRepos  *OrgRepositoriesClient
 	userRepos *UserRepositoriesClient
+
+	// dryRun is set if the client was created using gitprovider.WithDryRun(true).
+	dryRun *gitprovider.DryRun
 }
 
 // SupportedDomain returns the host endpoint for this client, e.g. "mystash.com:7990"
@@ -94,19 +97,34 @@ func (p *ProviderClient) Raw() interface{} {
 
 // Organizations returns the OrganizationsClient handling sets of organizations.
 func (p *ProviderClient) Organizations() gitprovider.OrganizationsClient {
+	if p.dryRun != nil {
+		return p.dryRun.Organizations(p.orgs)
+	}
 	return p.orgs
 }
 
 // OrgRepositories returns the OrgRepositoriesClient handling sets of repositories in an organization.
 func (p *ProviderClient) OrgRepositories() gitprovider.OrgRepositoriesClient {
+	if p.dryRun != nil {
+		return p.dryRun.OrgRepositories(p.orgRepos)
+	}
 	return p.orgRepos
 }
 
 // UserRepositories returns the UserRepositoriesClient handling sets of repositories for a user.
 func (p *ProviderClient) UserRepositories() gitprovider.UserRepositoriesClient {
+	if p.dryRun != nil {
+		return p.dryRun.UserRepositories(p.userRepos)
+	}
 	return p.userRepos
 }
 
+// DryRunPlan returns the Plan recording the write API calls skipped by this client, if it was
+// created using gitprovider.WithDryRun(true). Otherwise, nil is returned.
+func (p *ProviderClient) DryRunPlan() *gitprovider.Plan {
+	return p.dryRun.Plan()
+}
+
 // HasTokenPermission returns a boolean indicating whether the supplied token has the requested permission.
 func (p *ProviderClient) HasTokenPermission(_ context.Context, _ gitprovider.TokenPermission) (bool, error) {
 	return false, gitprovider.ErrNoProviderSupport
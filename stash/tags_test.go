@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetTag(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s/", stashURIprefix, projectsURI, RepositoriesURI, tagsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path+"v1.0.0" {
+			http.Error(w, "The specified tag does not exist", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&Tag{
+			ID:           "refs/tags/v1.0.0",
+			DisplayID:    "v1.0.0",
+			LatestCommit: "8d51122def5632836d1cb1026e879069e10a1e13",
+		})
+	})
+
+	ctx := context.Background()
+	tag, err := client.Tags.Get(ctx, "prj1", "repo1", "v1.0.0")
+	if err != nil {
+		t.Fatalf("Tags.Get returned error: %v", err)
+	}
+	if tag.ID != "refs/tags/v1.0.0" {
+		t.Errorf("Tags.Get returned tag:\n%s, want:\n%s", tag.ID, "refs/tags/v1.0.0")
+	}
+	if _, err := client.Tags.Get(ctx, "prj1", "repo1", "v2.0.0"); err != ErrNotFound {
+		t.Fatalf("Tags.Get returned error: %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestAllTags(t *testing.T) {
+	tags := []*Tag{
+		{ID: "refs/tags/v1.0.0"}, {ID: "refs/tags/v1.1.0"}, {ID: "refs/tags/v2.0.0"}}
+
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s", stashURIprefix, projectsURI, RepositoriesURI, tagsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("start") == "" {
+			json.NewEncoder(w).Encode(&TagList{
+				Paging: Paging{IsLastPage: false, NextPageStart: 2},
+				Tags:   tags[:2],
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(&TagList{
+			Paging: Paging{IsLastPage: true},
+			Tags:   tags[2:],
+		})
+	})
+
+	ctx := context.Background()
+	list, err := client.Tags.All(ctx, "prj1", "repo1")
+	if err != nil {
+		t.Fatalf("Tags.All returned error: %v", err)
+	}
+
+	if diff := cmp.Diff(tags, list); diff != "" {
+		t.Errorf("Tags.All returned diff (want -> got):\n%s", diff)
+	}
+}
+
+func TestCreateTag(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s", stashURIprefix, projectsURI, RepositoriesURI, tagsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("Tags.Create used method %s, want %s", r.Method, http.MethodPost)
+		}
+		req := &CreateTagRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&Tag{
+			ID:           "refs/tags/" + req.Name,
+			DisplayID:    req.Name,
+			LatestCommit: req.StartPoint,
+		})
+	})
+
+	ctx := context.Background()
+	tag, err := client.Tags.Create(ctx, "prj1", "repo1", &CreateTagRequest{
+		Name:       "v1.0.0",
+		StartPoint: "8d51122def5632836d1cb1026e879069e10a1e13",
+		Message:    "First release",
+	})
+	if err != nil {
+		t.Fatalf("Tags.Create returned error: %v", err)
+	}
+	if tag.DisplayID != "v1.0.0" || tag.LatestCommit != "8d51122def5632836d1cb1026e879069e10a1e13" {
+		t.Errorf("Tags.Create returned tag %s at %s", tag.DisplayID, tag.LatestCommit)
+	}
+}
+
+func TestDeleteTag(t *testing.T) {
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s/", gitURIprefix, projectsURI, RepositoriesURI, tagsURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("Tags.Delete used method %s, want %s", r.Method, http.MethodDelete)
+		}
+		if r.URL.Path != path+"v1.0.0" {
+			http.Error(w, "The specified tag does not exist", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if err := client.Tags.Delete(ctx, "prj1", "repo1", "v1.0.0"); err != nil {
+		t.Fatalf("Tags.Delete returned error: %v", err)
+	}
+	if err := client.Tags.Delete(ctx, "prj1", "repo1", "v2.0.0"); err != ErrNotFound {
+		t.Fatalf("Tags.Delete returned error: %v, want %v", err, ErrNotFound)
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	webhooksURI = "webhooks"
+)
+
+// Webhooks interface defines the methods that can be used to
+// manage the webhooks of a repository.
+type Webhooks interface {
+	List(ctx context.Context, projectKey, repositorySlug string, opts *PagingOptions) (*WebhookList, error)
+	All(ctx context.Context, projectKey, repositorySlug string) ([]*Webhook, error)
+	Get(ctx context.Context, projectKey, repositorySlug string, webhookID int) (*Webhook, error)
+	Create(ctx context.Context, projectKey, repositorySlug string, webhook *Webhook) (*Webhook, error)
+	Update(ctx context.Context, projectKey, repositorySlug string, webhookID int, webhook *Webhook) (*Webhook, error)
+	Delete(ctx context.Context, projectKey, repositorySlug string, webhookID int) error
+}
+
+// WebhooksService is a client for communicating with stash webhooks endpoint
+// bitbucket-server API docs: https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-rest.html
+type WebhooksService service
+
+// Webhook is a subscription of an HTTP endpoint to repository events.
+type Webhook struct {
+	// Session is the session object
+	Session `json:"sessionInfo,omitempty"`
+	// ID is the webhook id
+	ID int `json:"id,omitempty"`
+	// Name is the webhook name
+	Name string `json:"name,omitempty"`
+	// URL is the endpoint events are delivered to
+	URL string `json:"url,omitempty"`
+	// Events is the list of subscribed events, e.g. "repo:refs_changed"
+	Events []string `json:"events,omitempty"`
+	// Configuration holds additional settings, e.g. the "secret" used to sign deliveries
+	Configuration map[string]string `json:"configuration,omitempty"`
+	// Active specifies whether events are delivered
+	Active bool `json:"active"`
+	// SSLVerificationRequired specifies whether the TLS certificate of the endpoint is verified
+	SSLVerificationRequired *bool `json:"sslVerificationRequired,omitempty"`
+	// CreatedDate is the creation time of the webhook in milliseconds since epoch
+	CreatedDate int64 `json:"createdDate,omitempty"`
+	// UpdatedDate is the last update time of the webhook in milliseconds since epoch
+	UpdatedDate int64 `json:"updatedDate,omitempty"`
+}
+
+// WebhookList is a list of webhooks
+type WebhookList struct {
+	Paging
+	Webhooks []*Webhook `json:"values,omitempty"`
+}
+
+// GetWebhooks returns the list of webhooks
+func (w *WebhookList) GetWebhooks() []*Webhook {
+	return w.Webhooks
+}
+
+// List returns the list of webhooks for the repository.
+// Paging is optional and is enabled by providing a PagingOptions struct.
+// A pointer to a WebhookList struct is returned to retrieve the next page of results.
+// List uses the endpoint "GET /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/webhooks".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-rest.html
+func (s *WebhooksService) List(ctx context.Context, projectKey, repositorySlug string, opts *PagingOptions) (*WebhookList, error) {
+	query := addPaging(url.Values{}, opts)
+	req, err := s.Client.NewRequest(ctx, http.MethodGet, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, webhooksURI), WithQuery(query))
+	if err != nil {
+		return nil, fmt.Errorf("list webhooks for repository request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("list webhooks for repository requests failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	hooks := &WebhookList{}
+	if err := json.Unmarshal(res, hooks); err != nil {
+		return nil, fmt.Errorf("list webhooks for repository failed, unable to unmarshall json: %w", err)
+	}
+
+	for _, h := range hooks.GetWebhooks() {
+		h.Session.set(resp)
+	}
+
+	return hooks, nil
+}
+
+// All retrieves all repository webhooks.
+// This function handles pagination, HTTP error wrapping, and validates the server result.
+func (s *WebhooksService) All(ctx context.Context, projectKey, repositorySlug string) ([]*Webhook, error) {
+	h := []*Webhook{}
+	opts := &PagingOptions{Limit: perPageLimit}
+	err := allPages(opts, func() (*Paging, error) {
+		list, err := s.List(ctx, projectKey, repositorySlug, opts)
+		if err != nil {
+			return nil, err
+		}
+		h = append(h, list.GetWebhooks()...)
+		return &list.Paging, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// Get retrieves a webhook given it's ID.
+// Get uses the endpoint "GET /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/webhooks/{webhookId}".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-rest.html
+func (s *WebhooksService) Get(ctx context.Context, projectKey, repositorySlug string, webhookID int) (*Webhook, error) {
+	req, err := s.Client.NewRequest(ctx, http.MethodGet, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, webhooksURI, strconv.Itoa(webhookID)))
+	if err != nil {
+		return nil, fmt.Errorf("get webhook for repository request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get webhook for repository requests failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	hook := &Webhook{}
+	if err := json.Unmarshal(res, hook); err != nil {
+		return nil, fmt.Errorf("get webhook for repository failed, unable to unmarshall json: %w", err)
+	}
+
+	hook.Session.set(resp)
+
+	return hook, nil
+}
+
+// Create creates a webhook.
+// Create uses the endpoint "POST /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/webhooks".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-rest.html
+func (s *WebhooksService) Create(ctx context.Context, projectKey, repositorySlug string, webhook *Webhook) (*Webhook, error) {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall webhook: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPost, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, webhooksURI), WithBody(body), WithHeader(header))
+	if err != nil {
+		return nil, fmt.Errorf("create webhook for repository request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("create webhook for repository requests failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusBadRequest {
+		return nil, fmt.Errorf("create webhook for repository failed: %s", resp.Status)
+	}
+
+	hook := &Webhook{}
+	if err := json.Unmarshal(res, hook); err != nil {
+		return nil, fmt.Errorf("create webhook for repository failed, unable to unmarshall json: %w", err)
+	}
+
+	hook.Session.set(resp)
+
+	return hook, nil
+}
+
+// Update updates the webhook with the given ID.
+// Update uses the endpoint "PUT /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/webhooks/{webhookId}".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-rest.html
+func (s *WebhooksService) Update(ctx context.Context, projectKey, repositorySlug string, webhookID int, webhook *Webhook) (*Webhook, error) {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body, err := marshallBody(webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall webhook: %v", err)
+	}
+	req, err := s.Client.NewRequest(ctx, http.MethodPut, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, webhooksURI, strconv.Itoa(webhookID)), WithBody(body), WithHeader(header))
+	if err != nil {
+		return nil, fmt.Errorf("update webhook for repository request creation failed: %w", err)
+	}
+	res, resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("update webhook for repository requests failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusBadRequest {
+		return nil, fmt.Errorf("update webhook for repository failed: %s", resp.Status)
+	}
+
+	hook := &Webhook{}
+	if err := json.Unmarshal(res, hook); err != nil {
+		return nil, fmt.Errorf("update webhook for repository failed, unable to unmarshall json: %w", err)
+	}
+
+	hook.Session.set(resp)
+
+	return hook, nil
+}
+
+// Delete deletes the webhook with the given ID.
+// Delete uses the endpoint "DELETE /rest/api/1.0/projects/{projectKey}/repos/{repositorySlug}/webhooks/{webhookId}".
+// https://docs.atlassian.com/bitbucket-server/rest/7.21.0/bitbucket-rest.html
+func (s *WebhooksService) Delete(ctx context.Context, projectKey, repositorySlug string, webhookID int) error {
+	req, err := s.Client.NewRequest(ctx, http.MethodDelete, newURI(projectsURI, projectKey, RepositoriesURI, repositorySlug, webhooksURI, strconv.Itoa(webhookID)))
+	if err != nil {
+		return fmt.Errorf("delete webhook for repository request creation failed: %w", err)
+	}
+	_, resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("delete webhook for repository requests failed: %w", err)
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Flux CD contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetWebhook(t *testing.T) {
+	tests := []struct {
+		name      string
+		webhookID int
+	}{
+		{
+			name:      "test a webhook",
+			webhookID: 1,
+		},
+		{
+			name:      "test webhook does not exist",
+			webhookID: -1,
+		},
+	}
+
+	validIDs := []string{"1"}
+
+	mux, client := setup(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s/%s", stashURIprefix, projectsURI, RepositoriesURI, webhooksURI, strconv.Itoa(tt.webhookID))
+			mux.HandleFunc(p, func(w http.ResponseWriter, r *http.Request) {
+				for _, substr := range validIDs {
+					if path.Base(r.URL.String()) == substr {
+						w.WriteHeader(http.StatusOK)
+						h := &Webhook{
+							ID:     tt.webhookID,
+							Name:   "ci",
+							URL:    "https://example.com/hook",
+							Events: []string{"repo:refs_changed"},
+							Active: true,
+						}
+						json.NewEncoder(w).Encode(h)
+						return
+					}
+				}
+
+				http.Error(w, "The specified webhook does not exist", http.StatusNotFound)
+			})
+
+			ctx := context.Background()
+			h, err := client.Webhooks.Get(ctx, "prj1", "repo1", tt.webhookID)
+			if err != nil {
+				if err != ErrNotFound {
+					t.Fatalf("Webhooks.Get returned error: %v", err)
+				}
+				return
+			}
+
+			if h.ID != tt.webhookID {
+				t.Fatalf("Webhooks.Get returned:\n%d, want:\n%d", h.ID, tt.webhookID)
+			}
+		})
+	}
+}
+
+func TestListWebhooks(t *testing.T) {
+	hooks := []*Webhook{
+		{ID: 1, URL: "https://example.com/a"},
+		{ID: 2, URL: "https://example.com/b"},
+	}
+
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s", stashURIprefix, projectsURI, RepositoriesURI, webhooksURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		l := struct {
+			Values     []*Webhook `json:"values"`
+			IsLastPage bool       `json:"isLastPage"`
+		}{hooks, true}
+		json.NewEncoder(w).Encode(l)
+	})
+	ctx := context.Background()
+	list, err := client.Webhooks.All(ctx, "prj1", "repo1")
+	if err != nil {
+		t.Fatalf("Webhooks.All returned error: %v", err)
+	}
+
+	if diff := cmp.Diff(hooks, list); diff != "" {
+		t.Errorf("Webhooks.All returned diff (want -> got):\n%s", diff)
+	}
+}
+
+func TestCreateWebhook(t *testing.T) {
+	req := &Webhook{
+		Name:          "ci",
+		URL:           "https://example.com/hook",
+		Events:        []string{"repo:refs_changed", "pr:opened"},
+		Configuration: map[string]string{"secret": "s3cr3t"},
+		Active:        true,
+	}
+
+	mux, client := setup(t)
+
+	path := fmt.Sprintf("%s/%s/prj1/%s/repo1/%s", stashURIprefix, projectsURI, RepositoriesURI, webhooksURI)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+			return
+		}
+		h := &Webhook{}
+		json.NewDecoder(r.Body).Decode(h)
+		h.ID = 5
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(h)
+	})
+
+	ctx := context.Background()
+	h, err := client.Webhooks.Create(ctx, "prj1", "repo1", req)
+	if err != nil {
+		t.Fatalf("Webhooks.Create returned error: %v", err)
+	}
+
+	want := *req
+	want.ID = 5
+	h.Session = Session{}
+	if diff := cmp.Diff(&want, h); diff != "" {
+		t.Errorf("Webhooks.Create returned diff (want -> got):\n%s", diff)
+	}
+}